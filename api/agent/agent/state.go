@@ -5,6 +5,7 @@ package agent
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
@@ -12,10 +13,12 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/common/cloudspec"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -91,6 +94,79 @@ func (st *State) IsMaster() (bool, error) {
 	return results.Master, err
 }
 
+// SetLoggingOverride records a temporary logging configuration for the
+// given agent, reverting automatically after duration.
+func (st *State) SetLoggingOverride(tag names.Tag, loggingConfig string, duration time.Duration) error {
+	if st.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("logging override")
+	}
+	var results params.ErrorResults
+	args := params.LoggingOverrideArgs{
+		Overrides: []params.LoggingOverrideArg{{
+			Tag:           tag.String(),
+			LoggingConfig: loggingConfig,
+			Duration:      duration,
+		}},
+	}
+	err := st.facade.FacadeCall("SetLoggingOverride", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.OneError()
+}
+
+// LoggingOverride returns the active logging override for the given agent
+// along with its expiry time. An empty configuration means no override is
+// active; controllers too old to support overrides report none.
+func (st *State) LoggingOverride(tag names.Tag) (string, time.Time, error) {
+	if st.facade.BestAPIVersion() < 4 {
+		return "", time.Time{}, nil
+	}
+	var results params.LoggingOverrideResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	err := st.facade.FacadeCall("LoggingOverride", args, &results)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if len(results.Results) != 1 {
+		return "", time.Time{}, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		if params.IsCodeNotFound(result.Error) {
+			return "", time.Time{}, nil
+		}
+		return "", time.Time{}, result.Error
+	}
+	return result.LoggingConfig, result.Expiry, nil
+}
+
+// WatchLoggingOverride returns a watcher that notifies when the logging
+// override for the given agent is set or cleared.
+func (st *State) WatchLoggingOverride(tag names.Tag) (watcher.NotifyWatcher, error) {
+	if st.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("logging override")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	err := st.facade.FacadeCall("WatchLoggingOverride", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(st.facade.RawAPICaller(), result), nil
+}
+
 type Entity struct {
 	st  *State
 	tag names.Tag
@@ -59,3 +59,20 @@ func (st *State) ConnectionInfo() (result params.DeployerConnectionValues, err e
 	err = st.facade.FacadeCall("ConnectionInfo", nil, &result)
 	return result, err
 }
+
+// UnifiedAgentMode reports whether the model is configured to run unit
+// agents inside the machine agent process. Controllers too old to
+// report the mode are assumed to run in unified mode.
+func (st *State) UnifiedAgentMode() (bool, error) {
+	if st.facade.BestAPIVersion() < 2 {
+		return true, nil
+	}
+	var result params.BoolResult
+	if err := st.facade.FacadeCall("UnifiedAgentMode", nil, &result); err != nil {
+		return false, err
+	}
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.Result, nil
+}
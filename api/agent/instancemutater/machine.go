@@ -35,6 +35,12 @@ type MutaterMachine interface {
 	// SetCharmProfiles records the given slice of charm profile names.
 	SetCharmProfiles([]string) error
 
+	// SetProfileDrift records the difference between the lxd profiles
+	// applied to the machine's instance and the profiles expected from
+	// its charms. It returns whether the drift should be reconciled by
+	// reapplying the expected profiles.
+	SetProfileDrift(applied, expected []string) (bool, error)
+
 	// Tag returns the current machine tag
 	Tag() names.MachineTag
 
@@ -132,6 +138,32 @@ func (m *Machine) SetCharmProfiles(profiles []string) error {
 	return nil
 }
 
+// SetProfileDrift implements MutaterMachine.SetProfileDrift.
+func (m *Machine) SetProfileDrift(applied, expected []string) (bool, error) {
+	var results params.ProfileDriftResults
+	args := params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: m.tag.String()},
+				Applied:  applied,
+				Expected: expected,
+			},
+		},
+	}
+	err := m.facade.FacadeCall("SetProfileDrift", args, &results)
+	if err != nil {
+		return false, err
+	}
+	if len(results.Results) != 1 {
+		return false, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.Reconcile, nil
+}
+
 // Tag implements MutaterMachine.Tag.
 func (m *Machine) Tag() names.MachineTag {
 	return m.tag
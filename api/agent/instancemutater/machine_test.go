@@ -68,6 +68,29 @@ func (s *instanceMutaterMachineSuite) TestSetCharmProfilesError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "failed")
 }
 
+func (s *instanceMutaterMachineSuite) TestSetProfileDrift(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	m := s.machineForScenario(c,
+		s.expectSetProfileDriftFacadeCall,
+	)
+
+	reconcile, err := m.SetProfileDrift([]string{"default"}, s.profiles)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reconcile, jc.IsTrue)
+}
+
+func (s *instanceMutaterMachineSuite) TestSetProfileDriftError(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	m := s.machineForScenario(c,
+		s.expectSetProfileDriftFacadeCallReturnsError(errors.New("failed")),
+	)
+
+	_, err := m.SetProfileDrift([]string{"default"}, s.profiles)
+	c.Assert(err, gc.ErrorMatches, "failed")
+}
+
 func (s *instanceMutaterMachineSuite) TestWatchLXDProfileVerificationNeeded(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -278,6 +301,40 @@ func (s *instanceMutaterMachineSuite) expectSetCharmProfilesFacadeCallReturnsErr
 	}
 }
 
+func (s *instanceMutaterMachineSuite) setUpSetProfileDriftArgs() params.SetProfileDriftArgs {
+	return params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: s.tag.String()},
+				Applied:  []string{"default"},
+				Expected: s.profiles,
+			},
+		},
+	}
+}
+
+func (s *instanceMutaterMachineSuite) expectSetProfileDriftFacadeCall() {
+	results := params.ProfileDriftResults{Results: []params.ProfileDriftResult{{Reconcile: true}}}
+	args := s.setUpSetProfileDriftArgs()
+
+	fExp := s.fCaller.EXPECT()
+	fExp.FacadeCall("SetProfileDrift", args, gomock.Any()).SetArg(2, results).Return(nil)
+}
+
+func (s *instanceMutaterMachineSuite) expectSetProfileDriftFacadeCallReturnsError(err error) func() {
+	return func() {
+		results := params.ProfileDriftResults{
+			Results: []params.ProfileDriftResult{
+				{Error: &params.Error{Message: err.Error()}},
+			},
+		}
+		args := s.setUpSetProfileDriftArgs()
+
+		fExp := s.fCaller.EXPECT()
+		fExp.FacadeCall("SetProfileDrift", args, gomock.Any()).SetArg(2, results).Return(nil)
+	}
+}
+
 func (s *instanceMutaterMachineSuite) expectSetModificationFacadeCall(status status.Status, info string, data map[string]interface{}) func() {
 	return func() {
 		args := params.SetStatus{
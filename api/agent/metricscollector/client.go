@@ -0,0 +1,32 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client provides access to the MetricsCollector facade.
+type Client struct {
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the metricscollector API.
+func NewClient(caller base.APICaller) *Client {
+	return &Client{facade: base.NewFacadeCaller(caller, "MetricsCollector")}
+}
+
+// ScrapeConfig returns the model's scrape configuration, notably the
+// remote-write URL that collected samples are forwarded to. An empty
+// URL means forwarding is not configured.
+func (c *Client) ScrapeConfig() (params.MetricsScrapeConfigResult, error) {
+	var result params.MetricsScrapeConfigResult
+	if err := c.facade.FacadeCall("ScrapeConfig", nil, &result); err != nil {
+		return params.MetricsScrapeConfigResult{}, errors.Trace(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+const patchReporterFacade = "PatchReporter"
+
+// State provides access to a patchreporter worker's view of the state.
+type State struct {
+	facade base.FacadeCaller
+	tag    names.MachineTag
+}
+
+// NewState creates a new client-side PatchReporter facade.
+func NewState(caller base.APICaller, authTag names.MachineTag) *State {
+	return &State{
+		base.NewFacadeCaller(caller, patchReporterFacade),
+		authTag,
+	}
+}
+
+// SetPatchStatus reports the patch state of the machine identified by
+// the authenticated machine tag.
+func (st *State) SetPatchStatus(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error {
+	args := params.SetMachinePatchStatusArgs{
+		Statuses: []params.MachinePatchStatus{{
+			Tag:                    st.tag.String(),
+			PendingUpdates:         pendingUpdates,
+			PendingSecurityUpdates: pendingSecurityUpdates,
+			RebootRequired:         rebootRequired,
+		}},
+	}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall("SetPatchStatus", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.OneError()
+}
@@ -104,9 +104,9 @@ type MachineProvisioner interface {
 	// SetPassword sets the machine's password.
 	SetPassword(password string) error
 
-	// WatchContainers returns a StringsWatcher that notifies of changes
-	// to the lifecycles of containers of the specified type on the machine.
-	WatchContainers(ctype instance.ContainerType) (watcher.StringsWatcher, error)
+	// WatchContainers returns a watcher that notifies of changes to
+	// the lifecycles of containers of the specified type on the machine.
+	WatchContainers(ctype instance.ContainerType) (watcher.Watcher[[]string], error)
 
 	// SetSupportedContainers updates the list of containers supported by this machine.
 	SetSupportedContainers(containerTypes ...instance.ContainerType) error
@@ -445,7 +445,7 @@ func (m *Machine) SetPassword(password string) error {
 }
 
 // WatchContainers implements MachineProvisioner.WatchContainers.
-func (m *Machine) WatchContainers(ctype instance.ContainerType) (watcher.StringsWatcher, error) {
+func (m *Machine) WatchContainers(ctype instance.ContainerType) (watcher.Watcher[[]string], error) {
 	if string(ctype) == "" {
 		return nil, fmt.Errorf("container type must be specified")
 	}
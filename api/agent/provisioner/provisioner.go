@@ -109,10 +109,10 @@ func (st *State) Machines(tags ...names.MachineTag) ([]MachineResult, error) {
 	return machines, nil
 }
 
-// WatchModelMachines returns a StringsWatcher that notifies of
-// changes to the lifecycles of the machines (but not containers) in
-// the current model.
-func (st *State) WatchModelMachines() (watcher.StringsWatcher, error) {
+// WatchModelMachines returns a watcher that notifies of changes to
+// the lifecycles of the machines (but not containers) in the current
+// model.
+func (st *State) WatchModelMachines() (watcher.Watcher[[]string], error) {
 	var result params.StringsWatchResult
 	err := st.facade.FacadeCall("WatchModelMachines", nil, &result)
 	if err != nil {
@@ -125,7 +125,7 @@ func (st *State) WatchModelMachines() (watcher.StringsWatcher, error) {
 	return w, nil
 }
 
-func (st *State) WatchMachineErrorRetry() (watcher.NotifyWatcher, error) {
+func (st *State) WatchMachineErrorRetry() (watcher.Watcher[struct{}], error) {
 	var result params.NotifyWatchResult
 	err := st.facade.FacadeCall("WatchMachineErrorRetry", nil, &result)
 	if err != nil {
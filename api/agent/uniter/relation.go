@@ -89,6 +89,28 @@ func (r *Relation) SetStatus(status relation.Status) error {
 	return r.st.setRelationStatus(r.id, status)
 }
 
+// NetworkCharacteristics returns the network characteristics the
+// operator has recorded against the relation. An empty result is
+// returned if none have been recorded.
+func (r *Relation) NetworkCharacteristics() (params.RelationNetworkCharacteristics, error) {
+	nothing := params.RelationNetworkCharacteristics{}
+	args := params.RelationIds{
+		RelationIds: []int{r.id},
+	}
+	var results params.RelationNetworkResults
+	if err := r.st.facade.FacadeCall("RelationsNetworkCharacteristics", args, &results); err != nil {
+		return nothing, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nothing, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nothing, result.Error
+	}
+	return result.Characteristics, nil
+}
+
 func (r *Relation) toCharmRelation(cr params.CharmRelation) charm.Relation {
 	return charm.Relation{
 		Name:      cr.Name,
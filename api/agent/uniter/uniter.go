@@ -5,6 +5,7 @@ package uniter
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/charm/v8"
 	"github.com/juju/errors"
@@ -644,3 +645,60 @@ func (st *State) CloudSpec() (*params.CloudSpec, error) {
 	}
 	return result.Result, nil
 }
+
+// ScopedCredential returns a short-lived provider credential limited
+// to the given scope, minted by the controller for the model that the
+// calling unit resides in, along with the credential's expiry time.
+// The same authorisation applies as for CloudSpec.
+func (st *State) ScopedCredential(scope string) (*params.CloudCredential, *time.Time, error) {
+	if st.BestAPIVersion() < 19 {
+		// ScopedCredentials() was introduced in UniterAPI v19.
+		return nil, nil, errors.NotImplementedf("ScopedCredential() (need V19+)")
+	}
+	var results params.ScopedCredentialResults
+	args := params.ScopedCredentialArgs{
+		Args: []params.ScopedCredentialArg{{Scope: scope}},
+	}
+	err := st.facade.FacadeCall("ScopedCredentials", args, &results)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if err := result.Error; err != nil {
+		return nil, nil, err
+	}
+	return result.Result, result.ValidUntil, nil
+}
+
+// WorkloadIdentityToken returns a short-lived workload identity token
+// minted by the controller for the authenticated unit, identifying it
+// to the given audiences, along with the token's expiry time.
+func (st *State) WorkloadIdentityToken(audience []string) (string, time.Time, error) {
+	if st.BestAPIVersion() < 19 {
+		// WorkloadIdentityTokens() was introduced in UniterAPI v19.
+		return "", time.Time{}, errors.NotImplementedf("WorkloadIdentityToken() (need V19+)")
+	}
+	var results params.WorkloadIdentityTokenResults
+	args := params.WorkloadIdentityTokenArgs{
+		Args: []params.WorkloadIdentityTokenArg{{Audience: audience}},
+	}
+	err := st.facade.FacadeCall("WorkloadIdentityTokens", args, &results)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if len(results.Results) != 1 {
+		return "", time.Time{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if err := result.Error; err != nil {
+		return "", time.Time{}, err
+	}
+	var expiry time.Time
+	if result.ExpiresAt != nil {
+		expiry = *result.ExpiresAt
+	}
+	return result.Token, expiry, nil
+}
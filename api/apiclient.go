@@ -612,7 +612,7 @@ type dialOpts struct {
 //
 // It also returns the TLS configuration that it has derived from the Info.
 func dialAPI(ctx context.Context, info *Info, opts0 DialOpts) (*dialResult, error) {
-	if len(info.Addrs) == 0 {
+	if len(info.Addrs) == 0 && info.SRVDiscoveryDomain == "" {
 		return nil, errors.New("no API addresses to connect to")
 	}
 
@@ -661,11 +661,30 @@ func dialAPI(ctx context.Context, info *Info, opts0 DialOpts) (*dialResult, erro
 	if opts.DNSCache == nil {
 		opts.DNSCache = nopDNSCache{}
 	}
+	if opts.SRVResolver == nil {
+		opts.SRVResolver = net.DefaultResolver
+	}
 	path, err := apiPath(info.ModelTag, "/api")
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
+	if info.SRVDiscoveryDomain != "" && info.Proxier == nil {
+		srvAddrs, err := lookupSRVAddresses(ctx, opts.SRVResolver, info.SRVDiscoveryDomain)
+		if err != nil {
+			if len(addrs) == 0 {
+				return nil, errors.Annotatef(err, "discovering API addresses for %q", info.SRVDiscoveryDomain)
+			}
+			// We still have cached addresses to try, so a failed
+			// lookup shouldn't stop us connecting.
+			logger.Debugf("SRV discovery for %q failed: %v", info.SRVDiscoveryDomain, err)
+		}
+		addrs = mergeAddresses(addrs, srvAddrs)
+		if len(addrs) == 0 {
+			return nil, errors.Errorf("no API addresses discovered for %q", info.SRVDiscoveryDomain)
+		}
+	}
+
 	// Encourage load balancing by shuffling controller addresses.
 	rand.Shuffle(len(addrs), func(i, j int) { addrs[i], addrs[j] = addrs[j], addrs[i] })
 
@@ -689,6 +708,45 @@ func dialAPI(ctx context.Context, info *Info, opts0 DialOpts) (*dialResult, erro
 	return dialInfo, nil
 }
 
+// srvDiscoveryService is the DNS SRV service name used for controller
+// API address discovery, giving records of the form
+// _juju-api._tcp.<domain>.
+const srvDiscoveryService = "juju-api"
+
+// lookupSRVAddresses looks up the _juju-api._tcp SRV records for the
+// given domain and returns the advertised addresses in host:port form.
+func lookupSRVAddresses(ctx context.Context, resolver SRVResolver, domain string) ([]string, error) {
+	_, records, err := resolver.LookupSRV(ctx, srvDiscoveryService, "tcp", domain)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var addrs []string
+	for _, record := range records {
+		target := strings.TrimSuffix(record.Target, ".")
+		if target == "" {
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(target, fmt.Sprint(record.Port)))
+	}
+	return addrs, nil
+}
+
+// mergeAddresses appends the addresses in extra to addrs,
+// omitting any duplicates.
+func mergeAddresses(addrs, extra []string) []string {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		seen[addr] = true
+	}
+	for _, addr := range extra {
+		if !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
 // gorillaDialWebsocket makes a websocket connection using the
 // gorilla websocket package. The ipAddr parameter holds the
 // actual IP address that will be contacted - the host in urlStr
@@ -777,6 +777,65 @@ func (s *apiclientSuite) TestDNSCacheUsed(c *gc.C) {
 	c.Assert(conn.IPAddr(), gc.Equals, "0.1.1.1:1234")
 }
 
+func (s *apiclientSuite) TestOpenWithSRVDiscovery(c *gc.C) {
+	fakeDialer := func(ctx context.Context, urlStr string, tlsConfig *tls.Config, ipAddr string) (jsoncodec.JSONConn, error) {
+		return fakeConn{}, nil
+	}
+	conn, err := api.Open(&api.Info{
+		SRVDiscoveryDomain: "example.com",
+		SkipLogin:          true,
+		CACert:             jtesting.CACert,
+	}, api.DialOpts{
+		DialWebsocket: fakeDialer,
+		IPAddrResolver: apitesting.IPAddrResolverMap{
+			"controller1.example.com": {"0.1.1.1"},
+		},
+		SRVResolver: apitesting.SRVResolverMap{
+			"_juju-api._tcp.example.com": {{
+				Target: "controller1.example.com.",
+				Port:   17070,
+			}},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(conn, gc.NotNil)
+	c.Assert(conn.Addr(), gc.Equals, "controller1.example.com:17070")
+}
+
+func (s *apiclientSuite) TestOpenSRVDiscoveryFailureFallsBackToAddrs(c *gc.C) {
+	fakeDialer := func(ctx context.Context, urlStr string, tlsConfig *tls.Config, ipAddr string) (jsoncodec.JSONConn, error) {
+		return fakeConn{}, nil
+	}
+	conn, err := api.Open(&api.Info{
+		Addrs:              []string{"place1.example:1234"},
+		SRVDiscoveryDomain: "example.com",
+		SkipLogin:          true,
+		CACert:             jtesting.CACert,
+	}, api.DialOpts{
+		DialWebsocket: fakeDialer,
+		IPAddrResolver: apitesting.IPAddrResolverMap{
+			"place1.example": {"0.1.1.1"},
+		},
+		// No SRV records for the domain - the cached address
+		// should still be dialed.
+		SRVResolver: apitesting.SRVResolverMap{},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(conn, gc.NotNil)
+	c.Assert(conn.Addr(), gc.Equals, "place1.example:1234")
+}
+
+func (s *apiclientSuite) TestOpenSRVDiscoveryFailureNoAddrs(c *gc.C) {
+	_, err := api.Open(&api.Info{
+		SRVDiscoveryDomain: "example.com",
+		SkipLogin:          true,
+		CACert:             jtesting.CACert,
+	}, api.DialOpts{
+		SRVResolver: apitesting.SRVResolverMap{},
+	})
+	c.Assert(err, gc.ErrorMatches, `discovering API addresses for "example.com": mock resolver has no SRV records for "_juju-api._tcp.example.com"`)
+}
+
 func (s *apiclientSuite) TestNumericAddressIsNotAddedToCache(c *gc.C) {
 	fakeDialer := func(ctx context.Context, urlStr string, tlsConfig *tls.Config, ipAddr string) (jsoncodec.JSONConn, error) {
 		return fakeConn{}, nil
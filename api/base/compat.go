@@ -0,0 +1,79 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package base
+
+import (
+	"github.com/juju/errors"
+)
+
+// CompatVariant describes how a client method makes its facade call
+// against one span of facade versions, starting at MinVersion and
+// ending where the next variant begins. A variant either names a wire
+// call declaratively - Call, Params, NewResult and Handle - or, for
+// older facades whose shape cannot be expressed as a single call,
+// supplies Do to take over entirely.
+type CompatVariant struct {
+	// MinVersion is the lowest facade version this variant supports.
+	MinVersion int
+
+	// Call is the facade method name to invoke.
+	Call string
+
+	// Params returns the wire arguments for the call. A nil Params
+	// sends nil arguments.
+	Params func() (interface{}, error)
+
+	// NewResult returns the value the wire response is unmarshalled
+	// into, which is then given to Handle.
+	NewResult func() interface{}
+
+	// Handle consumes the unmarshalled response.
+	Handle func(result interface{}) error
+
+	// Do, if non-nil, is invoked instead of making a declarative
+	// call, and the other call fields are ignored.
+	Do func(facade FacadeCaller) error
+}
+
+// CompatCall selects the newest variant supported by the facade's
+// negotiated version and makes the call it describes. Variants must
+// be ordered by ascending MinVersion. If the negotiated version
+// predates all variants, an error satisfying errors.IsNotSupported is
+// returned, mentioning what (eg "get config") was attempted.
+func CompatCall(facade FacadeCaller, what string, variants []CompatVariant) error {
+	version := facade.BestAPIVersion()
+	var selected *CompatVariant
+	for i := range variants {
+		if i > 0 && variants[i].MinVersion <= variants[i-1].MinVersion {
+			return errors.Errorf("%s compat variants are not in ascending version order", what)
+		}
+		if version >= variants[i].MinVersion {
+			selected = &variants[i]
+		}
+	}
+	if selected == nil {
+		return errors.NotSupportedf("%s on API server version %v", what, version)
+	}
+	if selected.Do != nil {
+		return errors.Trace(selected.Do(facade))
+	}
+	var args interface{}
+	if selected.Params != nil {
+		var err error
+		if args, err = selected.Params(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	var result interface{}
+	if selected.NewResult != nil {
+		result = selected.NewResult()
+	}
+	if err := facade.FacadeCall(selected.Call, args, result); err != nil {
+		return errors.Trace(err)
+	}
+	if selected.Handle != nil {
+		return errors.Trace(selected.Handle(result))
+	}
+	return nil
+}
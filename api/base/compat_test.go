@@ -0,0 +1,123 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package base_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type compatSuite struct{}
+
+var _ = gc.Suite(&compatSuite{})
+
+func (s *compatSuite) facadeCaller(c *gc.C, version int, expectCall string, expectArgs interface{}, result params.StringResult) base.FacadeCaller {
+	caller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, v int, id, request string, args, response interface{}) error {
+				c.Check(objType, gc.Equals, "Testing")
+				c.Check(request, gc.Equals, expectCall)
+				c.Check(args, jc.DeepEquals, expectArgs)
+				*(response.(*params.StringResult)) = result
+				return nil
+			},
+		),
+		BestVersion: version,
+	}
+	return base.NewFacadeCaller(caller, "Testing")
+}
+
+func (s *compatSuite) variants(got *string) []base.CompatVariant {
+	handle := func(result interface{}) error {
+		res := result.(*params.StringResult)
+		if res.Error != nil {
+			return res.Error
+		}
+		*got = res.Result
+		return nil
+	}
+	return []base.CompatVariant{{
+		MinVersion: 2,
+		Call:       "OldCall",
+		Params:     func() (interface{}, error) { return params.Entities{}, nil },
+		NewResult:  func() interface{} { return &params.StringResult{} },
+		Handle:     handle,
+	}, {
+		MinVersion: 5,
+		Call:       "NewCall",
+		Params:     func() (interface{}, error) { return params.Entity{Tag: "machine-0"}, nil },
+		NewResult:  func() interface{} { return &params.StringResult{} },
+		Handle:     handle,
+	}}
+}
+
+func (s *compatSuite) TestSelectsNewestSupportedVariant(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 6, "NewCall", params.Entity{Tag: "machine-0"}, params.StringResult{Result: "new"})
+	err := base.CompatCall(facade, "test call", s.variants(&got))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.Equals, "new")
+}
+
+func (s *compatSuite) TestSelectsExactMinVersion(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 5, "NewCall", params.Entity{Tag: "machine-0"}, params.StringResult{Result: "new"})
+	err := base.CompatCall(facade, "test call", s.variants(&got))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.Equals, "new")
+}
+
+func (s *compatSuite) TestFallsBackToOlderVariant(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 4, "OldCall", params.Entities{}, params.StringResult{Result: "old"})
+	err := base.CompatCall(facade, "test call", s.variants(&got))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.Equals, "old")
+}
+
+func (s *compatSuite) TestVersionPredatesAllVariants(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 1, "", nil, params.StringResult{})
+	err := base.CompatCall(facade, "test call", s.variants(&got))
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `test call on API server version 1 not supported`)
+}
+
+func (s *compatSuite) TestDoOverridesCall(c *gc.C) {
+	called := false
+	facade := s.facadeCaller(c, 3, "", nil, params.StringResult{})
+	err := base.CompatCall(facade, "test call", []base.CompatVariant{{
+		MinVersion: 2,
+		Do: func(f base.FacadeCaller) error {
+			c.Check(f, gc.NotNil)
+			called = true
+			return nil
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *compatSuite) TestHandleError(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 6, "NewCall", params.Entity{Tag: "machine-0"}, params.StringResult{
+		Error: &params.Error{Message: "boom"},
+	})
+	err := base.CompatCall(facade, "test call", s.variants(&got))
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *compatSuite) TestVariantsOutOfOrder(c *gc.C) {
+	var got string
+	facade := s.facadeCaller(c, 6, "", nil, params.StringResult{})
+	variants := s.variants(&got)
+	variants[0], variants[1] = variants[1], variants[0]
+	err := base.CompatCall(facade, "test call", variants)
+	c.Assert(err, gc.ErrorMatches, "test call compat variants are not in ascending version order")
+}
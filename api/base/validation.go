@@ -0,0 +1,90 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package base
+
+import (
+	"fmt"
+
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Reason codes reported for batch entries rejected by client-side
+// validation. They are carried as the error code in per-entry results
+// so that automated callers can identify and fix the offending input
+// without parsing error messages.
+const (
+	ReasonInvalidApplicationName = "invalid application name"
+	ReasonInvalidMachineId       = "invalid machine id"
+	ReasonInvalidUnitName        = "invalid unit name"
+	ReasonInvalidStorageId       = "invalid storage id"
+)
+
+// reasonDescriptions maps each validation reason code to the
+// description of the offending name used in error messages.
+var reasonDescriptions = map[string]string{
+	ReasonInvalidApplicationName: "application name",
+	ReasonInvalidMachineId:       "machine ID",
+	ReasonInvalidUnitName:        "unit name",
+	ReasonInvalidStorageId:       "storage ID",
+}
+
+// InvalidEntry describes one entry of a batch argument that failed
+// client-side validation. No API call is made for such entries.
+type InvalidEntry struct {
+	// Index is the position of the entry in the caller's argument slice.
+	Index int
+	// Name is the entry as supplied by the caller.
+	Name string
+	// Reason is the code describing why the entry is not valid.
+	Reason string
+}
+
+// Error implements error.
+func (e InvalidEntry) Error() string {
+	return fmt.Sprintf("%s %q not valid", reasonDescriptions[e.Reason], e.Name)
+}
+
+// ParamsError returns the entry error in wire result form, carrying
+// the validation reason as the error code.
+func (e InvalidEntry) ParamsError() *params.Error {
+	return &params.Error{Message: e.Error(), Code: e.Reason}
+}
+
+// ValidateEntries checks each of the given entries with isValid and
+// returns an InvalidEntry carrying reason for each entry that fails.
+func ValidateEntries(entries []string, isValid func(string) bool, reason string) []InvalidEntry {
+	var invalid []InvalidEntry
+	for i, name := range entries {
+		if !isValid(name) {
+			invalid = append(invalid, InvalidEntry{Index: i, Name: name, Reason: reason})
+		}
+	}
+	return invalid
+}
+
+// ValidateApplicationNames returns an InvalidEntry for each of the
+// given names that is not a valid application name.
+func ValidateApplicationNames(appNames []string) []InvalidEntry {
+	return ValidateEntries(appNames, names.IsValidApplication, ReasonInvalidApplicationName)
+}
+
+// ValidateMachineIds returns an InvalidEntry for each of the given ids
+// that is not a valid machine id.
+func ValidateMachineIds(machineIds []string) []InvalidEntry {
+	return ValidateEntries(machineIds, names.IsValidMachine, ReasonInvalidMachineId)
+}
+
+// ValidateUnitNames returns an InvalidEntry for each of the given
+// names that is not a valid unit name.
+func ValidateUnitNames(unitNames []string) []InvalidEntry {
+	return ValidateEntries(unitNames, names.IsValidUnit, ReasonInvalidUnitName)
+}
+
+// ValidateStorageIds returns an InvalidEntry for each of the given ids
+// that is not a valid storage id.
+func ValidateStorageIds(storageIds []string) []InvalidEntry {
+	return ValidateEntries(storageIds, names.IsValidStorage, ReasonInvalidStorageId)
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package base_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+type validationSuite struct{}
+
+var _ = gc.Suite(&validationSuite{})
+
+func (s *validationSuite) TestValidateMachineIds(c *gc.C) {
+	invalid := base.ValidateMachineIds([]string{"0", "!", "1/lxd/2", "bad id"})
+	c.Assert(invalid, jc.DeepEquals, []base.InvalidEntry{
+		{Index: 1, Name: "!", Reason: base.ReasonInvalidMachineId},
+		{Index: 3, Name: "bad id", Reason: base.ReasonInvalidMachineId},
+	})
+	c.Assert(invalid[0].Error(), gc.Equals, `machine ID "!" not valid`)
+}
+
+func (s *validationSuite) TestValidateUnitNames(c *gc.C) {
+	invalid := base.ValidateUnitNames([]string{"mysql/0", "mysql"})
+	c.Assert(invalid, jc.DeepEquals, []base.InvalidEntry{
+		{Index: 1, Name: "mysql", Reason: base.ReasonInvalidUnitName},
+	})
+	c.Assert(invalid[0].Error(), gc.Equals, `unit name "mysql" not valid`)
+}
+
+func (s *validationSuite) TestValidateStorageIds(c *gc.C) {
+	invalid := base.ValidateStorageIds([]string{"data/0", "foo/bar"})
+	c.Assert(invalid, jc.DeepEquals, []base.InvalidEntry{
+		{Index: 1, Name: "foo/bar", Reason: base.ReasonInvalidStorageId},
+	})
+}
+
+func (s *validationSuite) TestValidateApplicationNames(c *gc.C) {
+	invalid := base.ValidateApplicationNames([]string{"wordpress", "!"})
+	c.Assert(invalid, jc.DeepEquals, []base.InvalidEntry{
+		{Index: 1, Name: "!", Reason: base.ReasonInvalidApplicationName},
+	})
+}
+
+func (s *validationSuite) TestParamsError(c *gc.C) {
+	entry := base.InvalidEntry{Index: 0, Name: "!", Reason: base.ReasonInvalidUnitName}
+	c.Assert(entry.ParamsError(), jc.DeepEquals, &params.Error{
+		Message: `unit name "!" not valid`,
+		Code:    base.ReasonInvalidUnitName,
+	})
+}
+
+func (s *validationSuite) TestNoInvalidEntries(c *gc.C) {
+	c.Assert(base.ValidateMachineIds([]string{"0", "1"}), gc.HasLen, 0)
+}
@@ -234,3 +234,28 @@ func (c *Client) WatchActionProgress(actionId string) (watcher.StringsWatcher, e
 	w := apiwatcher.NewStringsWatcher(c.facade.RawAPICaller(), result)
 	return w, nil
 }
+
+// ActionOutput fetches the complete output of an action stream that was
+// too large to store inline in the action results, identified by the
+// URL recorded in those results.
+func (c *Client) ActionOutput(url string) (string, error) {
+	if v := c.BestAPIVersion(); v < 8 {
+		return "", errors.Errorf("ActionOutput not supported by this version (%d) of Juju", v)
+	}
+	var results params.ActionOutputResults
+	args := params.ActionOutputRequests{
+		Requests: []params.ActionOutputRequest{{URL: url}},
+	}
+	err := c.facade.FacadeCall("ActionOutputs", args, &results)
+	if err != nil {
+		return "", err
+	}
+	if len(results.Results) != 1 {
+		return "", fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", result.Error
+	}
+	return result.Output, nil
+}
@@ -501,3 +501,55 @@ func (s *actionSuite) TestEnqueueOperationNotSupported(c *gc.C) {
 	_, err := client.EnqueueOperation([]action.Action{})
 	c.Assert(err, gc.ErrorMatches, "EnqueueOperation not supported by this version \\(5\\) of Juju")
 }
+
+func (s *actionSuite) TestActionOutput(c *gc.C) {
+	var called bool
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				called = true
+				c.Assert(request, gc.Equals, "ActionOutputs")
+				c.Assert(a, jc.DeepEquals, params.ActionOutputRequests{
+					Requests: []params.ActionOutputRequest{{
+						URL: "actionoutput/2/stdout",
+					}},
+				})
+				c.Assert(result, gc.FitsTypeOf, &params.ActionOutputResults{})
+				*(result.(*params.ActionOutputResults)) = params.ActionOutputResults{
+					Results: []params.ActionOutputResult{{
+						Output: "full output",
+					}},
+				}
+				return nil
+			},
+		),
+		BestVersion: 8,
+	}
+	client := action.NewClient(apiCaller)
+	output, err := client.ActionOutput("actionoutput/2/stdout")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(output, gc.Equals, "full output")
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *actionSuite) TestActionOutputNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				return nil
+			},
+		),
+		BestVersion: 7,
+	}
+	client := action.NewClient(apiCaller)
+	_, err := client.ActionOutput("actionoutput/2/stdout")
+	c.Assert(err, gc.ErrorMatches, "ActionOutput not supported by this version \\(7\\) of Juju")
+}
@@ -188,16 +188,26 @@ func (c *Client) Deploy(args DeployArgs) error {
 			Force:            args.Force,
 		}},
 	}
-	var results params.ErrorResults
+	var results params.ApplicationsDeployResults
 	var err error
 	err = c.facade.FacadeCall("Deploy", deployArgs, &results)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	err = results.OneError()
-	if err == nil {
+	if n := len(results.Results); n != 1 {
+		return errors.Errorf("expected 1 result, got %d", n)
+	}
+	result := results.Results[0]
+	for _, feasibility := range result.PrincipalFeasibility {
+		if !feasibility.Feasible {
+			logger.Warningf("subordinate %q cannot be placed alongside principal %q: %s",
+				args.ApplicationName, feasibility.Principal, feasibility.Message)
+		}
+	}
+	if result.Error == nil {
 		return nil
 	}
+	err = result.Error
 	if pErr, ok := errors.Cause(err).(*params.Error); ok {
 		switch pErr.Code {
 		case params.CodeAlreadyExists:
@@ -288,36 +298,97 @@ func (c *Client) GetCharmURLOrigin(branchName, applicationName string) (*charm.U
 // applications. If any of the applications are not found, an error is
 // returned.
 func (c *Client) GetConfig(branchName string, appNames ...string) ([]map[string]interface{}, error) {
-	v := c.BestAPIVersion()
-
-	if v < 5 {
-		settings, err := c.getConfigV4(branchName, appNames)
-		return settings, errors.Trace(err)
-	}
-
-	callName := "CharmConfig"
-	if v < 6 {
-		callName = "GetConfig"
-	}
-
-	var callArg interface{}
-	if v < 9 {
+	entityArgs := func() (interface{}, error) {
 		arg := params.Entities{Entities: make([]params.Entity, len(appNames))}
 		for i, appName := range appNames {
 			arg.Entities[i] = params.Entity{Tag: names.NewApplicationTag(appName).String()}
 		}
-		callArg = arg
-	} else {
-		// Version 9 of the API introduces generational config.
+		return arg, nil
+	}
+	// Version 9 of the API introduces generational config.
+	generationArgs := func() (interface{}, error) {
 		arg := params.ApplicationGetArgs{Args: make([]params.ApplicationGet, len(appNames))}
 		for i, appName := range appNames {
 			arg.Args[i] = params.ApplicationGet{ApplicationName: appName, BranchName: branchName}
 		}
-		callArg = arg
+		return arg, nil
+	}
+	newResult := func() interface{} { return &params.ApplicationGetConfigResults{} }
+
+	var settings []map[string]interface{}
+	handle := func(result interface{}) error {
+		results := result.(*params.ApplicationGetConfigResults)
+		for i, result := range results.Results {
+			if result.Error != nil {
+				return errors.Annotatef(result.Error, "unable to get settings for %q", appNames[i])
+			}
+			settings = append(settings, result.Config)
+		}
+		return nil
+	}
+
+	err := base.CompatCall(c.facade, "get config", []base.CompatVariant{{
+		MinVersion: 1,
+		Do: func(base.FacadeCaller) error {
+			var err error
+			settings, err = c.getConfigV4(branchName, appNames)
+			return errors.Trace(err)
+		},
+	}, {
+		MinVersion: 5,
+		Call:       "GetConfig",
+		Params:     entityArgs,
+		NewResult:  newResult,
+		Handle:     handle,
+	}, {
+		MinVersion: 6,
+		Call:       "CharmConfig",
+		Params:     entityArgs,
+		NewResult:  newResult,
+		Handle:     handle,
+	}, {
+		MinVersion: 9,
+		Call:       "CharmConfig",
+		Params:     generationArgs,
+		NewResult:  newResult,
+		Handle:     handle,
+	}})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return settings, nil
+}
+
+// GetConfigOptions alters how GetConfigWithOptions retrieves and
+// describes application configuration.
+type GetConfigOptions struct {
+	// ExcludeDefaults asks the controller to omit keys whose value is
+	// unset or comes from the charm defaults, so that only explicitly
+	// set keys are returned. Controllers that predate this option
+	// ignore it and return the full configuration.
+	ExcludeDefaults bool
+}
+
+// GetConfigWithOptions is like GetConfig, but lets the caller control
+// server-side filtering and provenance annotation of the results. The
+// returned settings carry a per-key "source" (default/user/branch/unset)
+// computed by the controller. It requires API version 9 or later.
+func (c *Client) GetConfigWithOptions(branchName string, options GetConfigOptions, appNames ...string) ([]map[string]interface{}, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("get config with options on API server version %v", c.BestAPIVersion())
+	}
+
+	arg := params.ApplicationGetArgs{Args: make([]params.ApplicationGet, len(appNames))}
+	for i, appName := range appNames {
+		arg.Args[i] = params.ApplicationGet{
+			ApplicationName: appName,
+			BranchName:      branchName,
+			ExcludeDefaults: options.ExcludeDefaults,
+		}
 	}
 
 	var results params.ApplicationGetConfigResults
-	err := c.facade.FacadeCall(callName, callArg, &results)
+	err := c.facade.FacadeCall("CharmConfig", arg, &results)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -325,7 +396,7 @@ func (c *Client) GetConfig(branchName string, appNames ...string) ([]map[string]
 	var settings []map[string]interface{}
 	for i, result := range results.Results {
 		if result.Error != nil {
-			return nil, errors.Annotatef(err, "unable to get settings for %q", appNames[i])
+			return nil, errors.Annotatef(result.Error, "unable to get settings for %q", appNames[i])
 		}
 		settings = append(settings, result.Config)
 	}
@@ -596,21 +667,32 @@ type DestroyUnitsParams struct {
 	// will wait before forcing the next step to kick-off. This parameter
 	// only makes sense in combination with 'force' set to 'true'.
 	MaxWait *time.Duration
+
+	// Selectors, if set, asks the controller to choose the units to
+	// destroy by criteria instead of naming them in Units. The chosen
+	// units are reported in each selector's result.
+	Selectors []params.DestroyUnitSelector
 }
 
 // DestroyUnits decreases the number of units dedicated to one or more
 // applications.
 func (c *Client) DestroyUnits(in DestroyUnitsParams) ([]params.DestroyUnitResult, error) {
+	if len(in.Selectors) > 0 && c.BestAPIVersion() < 16 {
+		return nil, errors.New("this controller does not support unit selection criteria")
+	}
 	argsV5 := params.DestroyUnitsParams{
-		Units: make([]params.DestroyUnitParams, 0, len(in.Units)),
+		Units:     make([]params.DestroyUnitParams, 0, len(in.Units)),
+		Selectors: in.Selectors,
+	}
+	allResults := make([]params.DestroyUnitResult, len(in.Units)+len(in.Selectors))
+	index := make([]int, 0, len(in.Units)+len(in.Selectors))
+	invalid := make(map[int]*params.Error)
+	for _, entry := range base.ValidateUnitNames(in.Units) {
+		invalid[entry.Index] = entry.ParamsError()
 	}
-	allResults := make([]params.DestroyUnitResult, len(in.Units))
-	index := make([]int, 0, len(in.Units))
 	for i, name := range in.Units {
-		if !names.IsValidUnit(name) {
-			allResults[i].Error = &params.Error{
-				Message: errors.NotValidf("unit ID %q", name).Error(),
-			}
+		if err, ok := invalid[i]; ok {
+			allResults[i].Error = err
 			continue
 		}
 		index = append(index, i)
@@ -621,7 +703,10 @@ func (c *Client) DestroyUnits(in DestroyUnitsParams) ([]params.DestroyUnitResult
 			MaxWait:        in.MaxWait,
 		})
 	}
-	if len(argsV5.Units) == 0 {
+	for i := range in.Selectors {
+		index = append(index, len(in.Units)+i)
+	}
+	if len(argsV5.Units)+len(argsV5.Selectors) == 0 {
 		return allResults, nil
 	}
 
@@ -643,8 +728,8 @@ func (c *Client) DestroyUnits(in DestroyUnitsParams) ([]params.DestroyUnitResult
 	if err := c.facade.FacadeCall("DestroyUnit", args, &result); err != nil {
 		return nil, errors.Trace(err)
 	}
-	if n := len(result.Results); n != len(argsV5.Units) {
-		return nil, errors.Errorf("expected %d result(s), got %d", len(argsV5.Units), n)
+	if n := len(result.Results); n != len(argsV5.Units)+len(argsV5.Selectors) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(argsV5.Units)+len(argsV5.Selectors), n)
 	}
 	for i, result := range result.Results {
 		allResults[index[i]] = result
@@ -652,6 +737,39 @@ func (c *Client) DestroyUnits(in DestroyUnitsParams) ([]params.DestroyUnitResult
 	return allResults, nil
 }
 
+// ReplaceUnit replaces the named unit with a fresh unit of the same
+// application, carrying over detachable storage and annotations. The
+// name of the replacement unit is returned.
+func (c *Client) ReplaceUnit(unitName string) (string, error) {
+	if c.BestAPIVersion() < 17 {
+		return "", errors.NotSupportedf("replacing units")
+	}
+	if !names.IsValidUnit(unitName) {
+		return "", errors.NotValidf("unit name %q", unitName)
+	}
+	args := params.ReplaceUnitsParams{
+		Units: []params.ReplaceUnitParams{{
+			UnitTag: names.NewUnitTag(unitName).String(),
+		}},
+	}
+	var results params.ReplaceUnitResults
+	if err := c.facade.FacadeCall("ReplaceUnit", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", n)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	tag, err := names.ParseUnitTag(result.ReplacementUnit)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return tag.Id(), nil
+}
+
 // DestroyDeprecated destroys a given application.
 //
 // NOTE(axw) this exists only for backwards compatibility,
@@ -685,20 +803,29 @@ type DestroyApplicationsParams struct {
 	// will wait before forcing the next step to kick-off. This parameter
 	// only makes sense in combination with 'force' set to 'true'.
 	MaxWait *time.Duration
+
+	// DryRun requests the projected effects of the removal without
+	// applying it.
+	DryRun bool
 }
 
 // DestroyApplications destroys the given applications.
 func (c *Client) DestroyApplications(in DestroyApplicationsParams) ([]params.DestroyApplicationResult, error) {
+	if in.DryRun && c.BestAPIVersion() < 18 {
+		return nil, errors.NotSupportedf("dry run for Application facade v%d", c.BestAPIVersion())
+	}
 	argsV5 := params.DestroyApplicationsParams{
 		Applications: make([]params.DestroyApplicationParams, 0, len(in.Applications)),
 	}
 	allResults := make([]params.DestroyApplicationResult, len(in.Applications))
 	index := make([]int, 0, len(in.Applications))
+	invalid := make(map[int]*params.Error)
+	for _, entry := range base.ValidateApplicationNames(in.Applications) {
+		invalid[entry.Index] = entry.ParamsError()
+	}
 	for i, name := range in.Applications {
-		if !names.IsValidApplication(name) {
-			allResults[i].Error = &params.Error{
-				Message: errors.NotValidf("application name %q", name).Error(),
-			}
+		if err, ok := invalid[i]; ok {
+			allResults[i].Error = err
 			continue
 		}
 		index = append(index, i)
@@ -707,6 +834,7 @@ func (c *Client) DestroyApplications(in DestroyApplicationsParams) ([]params.Des
 			DestroyStorage: in.DestroyStorage,
 			Force:          in.Force,
 			MaxWait:        in.MaxWait,
+			DryRun:         in.DryRun,
 		})
 	}
 	if len(argsV5.Applications) == 0 {
@@ -740,6 +868,36 @@ func (c *Client) DestroyApplications(in DestroyApplicationsParams) ([]params.Des
 	return allResults, nil
 }
 
+// SetTeardownOrder declares the applications that must be removed
+// from the model before the given application can be destroyed. An
+// empty list clears any previously declared ordering.
+func (c *Client) SetTeardownOrder(application string, after ...string) error {
+	if c.BestAPIVersion() < 17 {
+		return errors.NotSupportedf("setting teardown order")
+	}
+	if !names.IsValidApplication(application) {
+		return errors.NotValidf("application name %q", application)
+	}
+	afterTags := make([]string, len(after))
+	for i, name := range after {
+		if !names.IsValidApplication(name) {
+			return errors.NotValidf("application name %q", name)
+		}
+		afterTags[i] = names.NewApplicationTag(name).String()
+	}
+	args := params.ApplicationTeardownOrderArgs{
+		Args: []params.ApplicationTeardownOrder{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			After:          afterTags,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("SetTeardownOrder", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 type DestroyConsumedApplicationParams struct {
 	// SaasNames holds the names of the consumed applications
 	// that are being destroyed
@@ -922,6 +1080,22 @@ func (c *Client) Expose(application string, exposedEndpoints map[string]params.E
 	return c.facade.FacadeCall("Expose", args, nil)
 }
 
+// ExposeWithDuration behaves like Expose but additionally limits how long
+// the application remains exposed. Once the duration elapses the controller
+// clears the exposed flag again and the firewaller closes any ports that
+// were only open due to the application being exposed.
+func (c *Client) ExposeWithDuration(application string, exposedEndpoints map[string]params.ExposedEndpoint, duration time.Duration) error {
+	if c.BestAPIVersion() < 17 {
+		return errors.NotSupportedf("expose duration by this version of Juju")
+	}
+	args := params.ApplicationExpose{
+		ApplicationName:  application,
+		ExposedEndpoints: exposedEndpoints,
+		ExposeDuration:   &duration,
+	}
+	return c.facade.FacadeCall("Expose", args, nil)
+}
+
 func hasGranularExposeParameters(exposedEndpoints map[string]params.ExposedEndpoint) bool {
 	if len(exposedEndpoints) == 0 { // empty list; using non-granular expose like pre 2.9 juju
 		return false
@@ -1043,14 +1217,35 @@ func (c *Client) SetRelationSuspended(relationIds []int, suspended bool, message
 	return results.Combine()
 }
 
+// RelationInfo returns inspection details for the relations with the
+// specified ids: endpoints, life, and suspension state. If includeData
+// is true, current data bag snapshots are also requested; the server
+// only includes them for users with write access, and redacts
+// sensitive values.
+func (c *Client) RelationInfo(relationIds []int, includeData bool) ([]params.RelationInfoResult, error) {
+	args := params.RelationInfoArgs{
+		RelationIds: relationIds,
+		IncludeData: includeData,
+	}
+	var results params.RelationInfoResults
+	if err := c.facade.FacadeCall("RelationInfo", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(relationIds) {
+		return nil, errors.Errorf("expected %d results, got %d", len(relationIds), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // Consume adds a remote application to the model.
 func (c *Client) Consume(arg crossmodel.ConsumeApplicationArgs) (string, error) {
 	var consumeRes params.ErrorResults
 	args := params.ConsumeApplicationArgs{
 		Args: []params.ConsumeApplicationArg{{
-			ApplicationOfferDetails: arg.Offer,
-			ApplicationAlias:        arg.ApplicationAlias,
-			Macaroon:                arg.Macaroon,
+			ApplicationOfferDetails:  arg.Offer,
+			ApplicationAlias:         arg.ApplicationAlias,
+			Macaroon:                 arg.Macaroon,
+			PinnedEndpointInterfaces: arg.PinnedEndpointInterfaces,
 		}},
 	}
 	if arg.ControllerInfo != nil {
@@ -1156,11 +1351,11 @@ func (c *Client) ResolveUnitErrors(units []string, all, retry bool) error {
 		Retry: retry,
 	}
 	if !all {
+		if invalid := base.ValidateUnitNames(units); len(invalid) > 0 {
+			return errors.Trace(invalid[0])
+		}
 		entities := make([]params.Entity, len(units))
 		for i, unit := range units {
-			if !names.IsValidUnit(unit) {
-				return errors.NotValidf("unit name %q", unit)
-			}
 			entities[i].Tag = names.NewUnitTag(unit).String()
 		}
 		args.Tags = params.Entities{Entities: entities}
@@ -14,6 +14,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api/base"
 	basetesting "github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/api/client/application"
 	apicharm "github.com/juju/juju/api/common/charm"
@@ -108,8 +109,8 @@ func (s *applicationSuite) TestDeploy(c *gc.C) {
 				c.Assert(app.AttachStorage, gc.DeepEquals, []string{"storage-data-0"})
 				c.Assert(app.Resources, gc.DeepEquals, map[string]string{"foo": "bar"})
 
-				result := response.(*params.ErrorResults)
-				result.Results = make([]params.ErrorResult, 1)
+				result := response.(*params.ApplicationsDeployResults)
+				result.Results = make([]params.ApplicationDeployResult, 1)
 				return nil
 			},
 		),
@@ -148,8 +149,8 @@ func (s *applicationSuite) TestDeployAlreadyExists(c *gc.C) {
 				called = true
 				c.Assert(request, gc.Equals, "Deploy")
 
-				result := response.(*params.ErrorResults)
-				result.Results = []params.ErrorResult{
+				result := response.(*params.ApplicationsDeployResults)
+				result.Results = []params.ApplicationDeployResult{
 					{Error: &params.Error{
 						Message: "application already exists",
 						Code:    params.CodeAlreadyExists,
@@ -518,7 +519,10 @@ func (s *applicationSuite) TestDestroyApplicationsArity(c *gc.C) {
 
 func (s *applicationSuite) TestDestroyApplicationsInvalidIds(c *gc.C) {
 	expectedResults := []params.DestroyApplicationResult{{
-		Error: &params.Error{Message: `application name "!" not valid`},
+		Error: &params.Error{
+			Message: `application name "!" not valid`,
+			Code:    base.ReasonInvalidApplicationName,
+		},
 	}, {
 		Info: &params.DestroyApplicationInfo{},
 	}}
@@ -727,7 +731,10 @@ func (s *applicationSuite) TestDestroyUnitsArity(c *gc.C) {
 
 func (s *applicationSuite) TestDestroyUnitsInvalidIds(c *gc.C) {
 	expectedResults := []params.DestroyUnitResult{{
-		Error: &params.Error{Message: `unit ID "!" not valid`},
+		Error: &params.Error{
+			Message: `unit name "!" not valid`,
+			Code:    base.ReasonInvalidUnitName,
+		},
 	}, {
 		Info: &params.DestroyUnitInfo{},
 	}}
@@ -743,6 +750,43 @@ func (s *applicationSuite) TestDestroyUnitsInvalidIds(c *gc.C) {
 	c.Assert(results, jc.DeepEquals, expectedResults)
 }
 
+func (s *applicationSuite) TestDestroyUnitsSelectors(c *gc.C) {
+	expectedResults := []params.DestroyUnitResult{{
+		Info: &params.DestroyUnitInfo{
+			DestroyedUnits: []params.Entity{{Tag: "unit-foo-0"}, {Tag: "unit-foo-1"}},
+		},
+	}}
+	client := newClientWithVersion(func(objType string, version int, id, request string, a, response interface{}) error {
+		c.Assert(request, gc.Equals, "DestroyUnit")
+		c.Assert(a, jc.DeepEquals, params.DestroyUnitsParams{
+			Selectors: []params.DestroyUnitSelector{
+				{Application: "foo", Count: 2},
+			},
+		})
+		c.Assert(response, gc.FitsTypeOf, &params.DestroyUnitResults{})
+		out := response.(*params.DestroyUnitResults)
+		*out = params.DestroyUnitResults{expectedResults}
+		return nil
+	}, 16)
+	results, err := client.DestroyUnits(application.DestroyUnitsParams{
+		Selectors: []params.DestroyUnitSelector{
+			{Application: "foo", Count: 2},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, expectedResults)
+}
+
+func (s *applicationSuite) TestDestroyUnitsSelectorsNotSupported(c *gc.C) {
+	client := newClient(func(objType string, version int, id, request string, a, response interface{}) error {
+		return errors.New("unexpected call")
+	})
+	_, err := client.DestroyUnits(application.DestroyUnitsParams{
+		Selectors: []params.DestroyUnitSelector{{Application: "foo"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "this controller does not support unit selection criteria")
+}
+
 func (s *applicationSuite) TestConsume(c *gc.C) {
 	offer := params.ApplicationOfferDetails{
 		SourceModelTag:         "source model",
@@ -969,6 +1013,53 @@ func (s *applicationSuite) TestGetConfigV9(c *gc.C) {
 	s.assertGetConfig(c, 9, "CharmConfig", args)
 }
 
+func (s *applicationSuite) TestGetConfigWithOptions(c *gc.C) {
+	fooConfig := map[string]interface{}{
+		"skill-level": map[string]interface{}{
+			"description": "A number indicating skill.",
+			"source":      "user",
+			"type":        "int",
+			"value":       42,
+		}}
+
+	client := application.NewClient(basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, args, response interface{}) error {
+				c.Assert(request, gc.Equals, "CharmConfig")
+				c.Assert(args, jc.DeepEquals, params.ApplicationGetArgs{Args: []params.ApplicationGet{
+					{ApplicationName: "foo", BranchName: newBranchName, ExcludeDefaults: true},
+				}})
+
+				result, ok := response.(*params.ApplicationGetConfigResults)
+				c.Assert(ok, jc.IsTrue)
+				result.Results = []params.ConfigResult{{Config: fooConfig}}
+				return nil
+			},
+		),
+		BestVersion: 13,
+	})
+
+	results, err := client.GetConfigWithOptions(
+		newBranchName, application.GetConfigOptions{ExcludeDefaults: true}, "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []map[string]interface{}{fooConfig})
+}
+
+func (s *applicationSuite) TestGetConfigWithOptionsNotSupported(c *gc.C) {
+	client := application.NewClient(basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, args, response interface{}) error {
+				c.Fatal("facade call should not be made")
+				return nil
+			},
+		),
+		BestVersion: 8,
+	})
+
+	_, err := client.GetConfigWithOptions(newBranchName, application.GetConfigOptions{}, "foo")
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *applicationSuite) assertGetConfig(c *gc.C, version int, method string, expArgs interface{}) {
 	fooConfig := map[string]interface{}{
 		"outlook": map[string]interface{}{
@@ -2008,3 +2099,37 @@ func (s *applicationSuite) TestLeader(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(obtainedUnit, gc.Equals, "ubuntu/42")
 }
+
+func (s *applicationSuite) TestSetTeardownOrder(c *gc.C) {
+	var called bool
+	client := newClientWithVersion(func(objType string, version int, id, request string, a, response interface{}) error {
+		called = true
+		c.Check(objType, gc.Equals, "Application")
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "SetTeardownOrder")
+		args, ok := a.(params.ApplicationTeardownOrderArgs)
+		c.Assert(ok, jc.IsTrue)
+		c.Assert(args, jc.DeepEquals, params.ApplicationTeardownOrderArgs{
+			Args: []params.ApplicationTeardownOrder{{
+				ApplicationTag: "application-mysql",
+				After:          []string{"application-wordpress"},
+			}},
+		})
+		result, ok := response.(*params.ErrorResults)
+		c.Assert(ok, jc.IsTrue)
+		result.Results = []params.ErrorResult{{}}
+		return nil
+	}, 17)
+	err := client.SetTeardownOrder("mysql", "wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *applicationSuite) TestSetTeardownOrderNotSupported(c *gc.C) {
+	client := newClientWithVersion(func(objType string, version int, id, request string, a, response interface{}) error {
+		c.Errorf("facade called unexpectedly")
+		return nil
+	}, 16)
+	err := client.SetTeardownOrder("mysql", "wordpress")
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
@@ -4,6 +4,8 @@
 package applicationoffers
 
 import (
+	"time"
+
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/charm/v8"
 	"github.com/juju/errors"
@@ -150,12 +152,25 @@ func (c *Client) GrantOffer(user, access string, offerURLs ...string) error {
 	return c.modifyOfferUser(params.GrantOfferAccess, user, access, offerURLs)
 }
 
+// GrantOfferUntil grants a user access to the specified offers until the
+// given time, after which the access is removed again.
+func (c *Client) GrantOfferUntil(user, access string, expiry time.Time, offerURLs ...string) error {
+	if bestVer := c.BestAPIVersion(); bestVer < 7 {
+		return errors.NotImplementedf("GrantOfferUntil() (need v7+, have v%d)", bestVer)
+	}
+	return c.modifyOfferUserUntil(params.GrantOfferAccess, user, access, &expiry, offerURLs)
+}
+
 // RevokeOffer revokes a user's access to the specified offers.
 func (c *Client) RevokeOffer(user, access string, offerURLs ...string) error {
 	return c.modifyOfferUser(params.RevokeOfferAccess, user, access, offerURLs)
 }
 
 func (c *Client) modifyOfferUser(action params.OfferAction, user, access string, offerURLs []string) error {
+	return c.modifyOfferUserUntil(action, user, access, nil, offerURLs)
+}
+
+func (c *Client) modifyOfferUserUntil(action params.OfferAction, user, access string, expiresAt *time.Time, offerURLs []string) error {
 	var args params.ModifyOfferAccessRequest
 
 	if !names.IsValidUser(user) {
@@ -169,10 +184,11 @@ func (c *Client) modifyOfferUser(action params.OfferAction, user, access string,
 	}
 	for _, offerURL := range offerURLs {
 		args.Changes = append(args.Changes, params.ModifyOfferAccess{
-			UserTag:  userTag.String(),
-			Action:   action,
-			Access:   params.OfferAccessPermission(offerAccess),
-			OfferURL: offerURL,
+			UserTag:   userTag.String(),
+			Action:    action,
+			Access:    params.OfferAccessPermission(offerAccess),
+			OfferURL:  offerURL,
+			ExpiresAt: expiresAt,
 		})
 	}
 
@@ -329,3 +345,59 @@ func (c *Client) DestroyOffers(force bool, offerURLs ...string) error {
 	}
 	return result.Combine()
 }
+
+// RotateOfferMacaroons invalidates macaroons previously issued for the
+// specified offers so that consumers must obtain re-issued ones.
+func (c *Client) RotateOfferMacaroons(offerURLs ...string) error {
+	if len(offerURLs) == 0 {
+		return nil
+	}
+	if bestVer := c.BestAPIVersion(); bestVer < 5 {
+		return errors.NotImplementedf("RotateOfferMacaroons() (need v5+, have v%d)", bestVer)
+	}
+	args := params.OfferURLs{
+		OfferURLs: make([]string, len(offerURLs)),
+	}
+	for i, url := range offerURLs {
+		if _, err := crossmodel.ParseOfferURL(url); err != nil {
+			return errors.Trace(err)
+		}
+		args.OfferURLs[i] = url
+	}
+
+	var result params.ErrorResults
+	err := c.facade.FacadeCall("RotateOfferMacaroons", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(result.Results) != len(args.OfferURLs) {
+		return errors.Errorf("expected %d results, got %d", len(args.OfferURLs), len(result.Results))
+	}
+	return result.Combine()
+}
+
+// RefreshConsumedOffer discards any details of the given consumed
+// offer cached on the consuming controller and fetches the current
+// details from the offering controller.
+func (c *Client) RefreshConsumedOffer(offerURL string) (*params.ApplicationOfferAdminDetails, error) {
+	if bestVer := c.BestAPIVersion(); bestVer < 6 {
+		return nil, errors.NotImplementedf("RefreshConsumedOffer() (need v6+, have v%d)", bestVer)
+	}
+	if _, err := crossmodel.ParseOfferURL(offerURL); err != nil {
+		return nil, errors.Trace(err)
+	}
+	args := params.OfferURLs{OfferURLs: []string{offerURL}}
+
+	var result params.ApplicationOffersResults
+	err := c.facade.FacadeCall("RefreshConsumedOffer", args, &result)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(result.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(result.Results))
+	}
+	if err := result.Results[0].Error; err != nil {
+		return nil, err
+	}
+	return result.Results[0].Result, nil
+}
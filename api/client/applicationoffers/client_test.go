@@ -758,3 +758,109 @@ func (s *crossmodelMockSuite) TestDestroyOffersForce(c *gc.C) {
 
 	c.Assert(err, gc.ErrorMatches, "DestroyOffers\\(\\).* not implemented")
 }
+
+func (s *crossmodelMockSuite) TestRotateOfferMacaroons(c *gc.C) {
+	var called bool
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				called = true
+				c.Assert(request, gc.Equals, "RotateOfferMacaroons")
+				args, ok := a.(params.OfferURLs)
+				c.Assert(ok, jc.IsTrue)
+				c.Assert(args.OfferURLs, jc.DeepEquals, []string{"me/prod.app"})
+				if results, ok := result.(*params.ErrorResults); ok {
+					results.Results = []params.ErrorResult{{
+						Error: &params.Error{Message: "fail"},
+					}}
+				}
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	err := client.RotateOfferMacaroons("me/prod.app")
+	c.Assert(err, gc.ErrorMatches, "fail")
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *crossmodelMockSuite) TestRotateOfferMacaroonsNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Fail()
+				return nil
+			},
+		),
+		BestVersion: 4,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	err := client.RotateOfferMacaroons("me/prod.app")
+
+	c.Assert(err, gc.ErrorMatches, "RotateOfferMacaroons\\(\\).* not implemented")
+}
+
+func (s *crossmodelMockSuite) TestRefreshConsumedOffer(c *gc.C) {
+	var called bool
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				called = true
+				c.Assert(request, gc.Equals, "RefreshConsumedOffer")
+				args, ok := a.(params.OfferURLs)
+				c.Assert(ok, jc.IsTrue)
+				c.Assert(args.OfferURLs, jc.DeepEquals, []string{"me/prod.app"})
+				if results, ok := result.(*params.ApplicationOffersResults); ok {
+					results.Results = []params.ApplicationOfferResult{{
+						Result: &params.ApplicationOfferAdminDetails{
+							ApplicationOfferDetails: params.ApplicationOfferDetails{
+								OfferName: "app",
+								OfferURL:  "me/prod.app",
+							},
+						},
+					}}
+				}
+				return nil
+			},
+		),
+		BestVersion: 6,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	details, err := client.RefreshConsumedOffer("me/prod.app")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(details.OfferName, gc.Equals, "app")
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *crossmodelMockSuite) TestRefreshConsumedOfferNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Fail()
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	_, err := client.RefreshConsumedOffer("me/prod.app")
+
+	c.Assert(err, gc.ErrorMatches, "RefreshConsumedOffer\\(\\).* not implemented")
+}
@@ -0,0 +1,31 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Schedule returns the automated backup schedule, along with the
+// status of the most recent scheduled run.
+func (c *Client) Schedule() (*params.BackupsScheduleResult, error) {
+	var result params.BackupsScheduleResult
+	if err := c.facade.FacadeCall("Schedule", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
+
+// SetSchedule configures the automated backup schedule. An empty cron
+// expression removes any existing schedule.
+func (c *Client) SetSchedule(cron string, retentionCount int, uploadTarget string) error {
+	args := params.BackupsScheduleArgs{
+		Cron:           cron,
+		RetentionCount: retentionCount,
+		UploadTarget:   uploadTarget,
+	}
+	return errors.Trace(c.facade.FacadeCall("SetSchedule", args, nil))
+}
@@ -77,3 +77,24 @@ func (c *Client) ExportBundle(includeDefaults bool) (string, error) {
 
 	return result.Result, nil
 }
+
+// ExportScaffolding exports the current model as infrastructure-as-code
+// scaffolding in the given format ("terraform" or "pulumi"; an empty
+// format means terraform).
+func (c *Client) ExportScaffolding(format string) (string, error) {
+	var result params.StringResult
+	if bestVer := c.BestAPIVersion(); bestVer < 7 {
+		return "", errors.Errorf("this controller version does not support scaffolding export feature.")
+	}
+
+	arg := params.ExportScaffoldingParams{Format: format}
+	if err := c.facade.FacadeCall("ExportScaffolding", arg, &result); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+
+	return result.Result, nil
+}
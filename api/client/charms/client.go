@@ -54,6 +54,22 @@ func (c *Client) IsMetered(charmURL string) (bool, error) {
 	return metered.Metered, nil
 }
 
+// ListCharmsWithInterface returns the endpoints of deployed
+// applications whose charm declares a relation with the given
+// interface name, optionally restricted to the given role
+// ("provider", "requirer" or "peer").
+func (c *Client) ListCharmsWithInterface(interfaceName, role string) ([]params.CharmInterfaceMatch, error) {
+	if c.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("querying charms by interface")
+	}
+	args := params.CharmsWithInterfaceQuery{Interface: interfaceName, Role: role}
+	var result params.CharmsWithInterfaceResult
+	if err := c.facade.FacadeCall("ListCharmsWithInterface", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Results, nil
+}
+
 // CharmToResolve holds the charm url and it's channel to be resolved.
 type CharmToResolve struct {
 	URL         *charm.URL
@@ -0,0 +1,36 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigreload
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the ControllerConfigReload API endpoint,
+// which reports which controller config keys are applied by the
+// running controller without an API server restart.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the
+// ControllerConfigReload API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "ControllerConfigReload")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// HotReloadableConfigKeys returns the controller config keys whose
+// changes take effect without restarting jujud.
+func (c *Client) HotReloadableConfigKeys() ([]string, error) {
+	var result params.HotReloadableConfigKeysResult
+	if err := c.facade.FacadeCall("HotReloadableConfigKeys", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Keys, nil
+}
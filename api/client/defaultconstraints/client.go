@@ -0,0 +1,86 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package defaultconstraints
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the DefaultConstraints API endpoint, which
+// manages the default application constraints recorded per cloud and
+// region.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the DefaultConstraints API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "DefaultConstraints")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// DefaultConstraints returns the default application constraints
+// recorded for the given cloud, or cloud region if region is not
+// empty. Empty constraints are returned if no default is recorded.
+func (c *Client) DefaultConstraints(cloudName, region string) (constraints.Value, error) {
+	args := params.DefaultConstraintsArgs{
+		Args: []params.DefaultConstraintsArg{{
+			CloudTag: names.NewCloudTag(cloudName).String(),
+			Region:   region,
+		}},
+	}
+	var results params.DefaultConstraintsResults
+	if err := c.facade.FacadeCall("DefaultConstraints", args, &results); err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return constraints.Value{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return constraints.Value{}, result.Error
+	}
+	return result.Constraints, nil
+}
+
+// SetDefaultConstraints records cons as the default application
+// constraints for the given cloud, or cloud region if region is not
+// empty.
+func (c *Client) SetDefaultConstraints(cloudName, region string, cons constraints.Value) error {
+	args := params.SetDefaultConstraintsArgs{
+		Args: []params.SetDefaultConstraintsArg{{
+			CloudTag:    names.NewCloudTag(cloudName).String(),
+			Region:      region,
+			Constraints: cons,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("SetDefaultConstraints", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// UnsetDefaultConstraints removes the default application constraints
+// recorded for the given cloud, or cloud region if region is not
+// empty.
+func (c *Client) UnsetDefaultConstraints(cloudName, region string) error {
+	args := params.DefaultConstraintsArgs{
+		Args: []params.DefaultConstraintsArg{{
+			CloudTag: names.NewCloudTag(cloudName).String(),
+			Region:   region,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("UnsetDefaultConstraints", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
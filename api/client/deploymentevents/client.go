@@ -0,0 +1,65 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deploymentevents
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the DeploymentEvents API endpoint, which
+// manages the webhook endpoints registered on the controller to
+// receive deployment events.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the DeploymentEvents API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "DeploymentEvents")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RegisterWebhook registers a webhook endpoint to receive deployment
+// events. An empty filter delivers all events.
+func (c *Client) RegisterWebhook(name, url, secret string, eventFilters []string) error {
+	in := params.WebhookEndpointArgs{
+		Endpoints: []params.WebhookEndpoint{{
+			Name:         name,
+			URL:          url,
+			Secret:       secret,
+			EventFilters: eventFilters,
+		}},
+	}
+	out := params.ErrorResults{}
+	err := c.facade.FacadeCall("RegisterWebhooks", in, &out)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
+// UnregisterWebhook removes the named webhook endpoint.
+func (c *Client) UnregisterWebhook(name string) error {
+	in := params.WebhookEndpointNames{Names: []string{name}}
+	out := params.ErrorResults{}
+	err := c.facade.FacadeCall("UnregisterWebhooks", in, &out)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
+// ListWebhooks returns the webhook endpoints registered on the
+// controller, ordered by name. Endpoint secrets are not returned.
+func (c *Client) ListWebhooks() ([]params.WebhookEndpoint, error) {
+	out := params.ListWebhookEndpointsResult{}
+	if err := c.facade.FacadeCall("ListWebhooks", nil, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Result, nil
+}
@@ -27,14 +27,20 @@ func NewClient(caller base.APICallCloser) *Client {
 func (c *Client) EnableHA(
 	numControllers int, cons constraints.Value, placement []string,
 ) (params.ControllersChanges, error) {
+	return c.EnableHAWithSpec(params.ControllersSpec{
+		NumControllers: numControllers,
+		Constraints:    cons,
+		Placement:      placement,
+	})
+}
 
+// EnableHAWithSpec ensures the availability of Juju controllers,
+// giving full control over how any new controller machines are
+// provisioned: per-machine constraints, placement directives and
+// availability zone targets.
+func (c *Client) EnableHAWithSpec(spec params.ControllersSpec) (params.ControllersChanges, error) {
 	var results params.ControllersChangeResults
-	arg := params.ControllersSpecs{
-		Specs: []params.ControllersSpec{{
-			NumControllers: numControllers,
-			Constraints:    cons,
-			Placement:      placement,
-		}}}
+	arg := params.ControllersSpecs{Specs: []params.ControllersSpec{spec}}
 
 	err := c.facade.FacadeCall("EnableHA", arg, &results)
 	if err != nil {
@@ -57,7 +57,7 @@ func (c *Client) List(
 // Supports bulk saves for scenarios like cloud image metadata caching at bootstrap.
 func (c *Client) Save(metadata []params.CloudImageMetadata) error {
 	in := params.MetadataSaveParams{
-		Metadata: []params.CloudImageMetadataList{{metadata}},
+		Metadata: []params.CloudImageMetadataList{{Metadata: metadata}},
 	}
 	out := params.ErrorResults{}
 	err := c.facade.FacadeCall("Save", in, &out)
@@ -93,3 +93,68 @@ func (c *Client) Delete(imageId string) error {
 	}
 	return nil
 }
+
+// SaveSigned saves specified image metadata together with a
+// clearsigned PGP document covering it. A signature is required when
+// the controller has a signing key registered for the metadata's
+// source and stream.
+func (c *Client) SaveSigned(metadata []params.CloudImageMetadata, signature []byte) error {
+	if bestVer := c.BestAPIVersion(); bestVer < 2 {
+		return errors.NotImplementedf("SaveSigned() (need v2+, have v%d)", bestVer)
+	}
+	in := params.MetadataSaveParams{
+		Metadata: []params.CloudImageMetadataList{{Metadata: metadata, Signature: signature}},
+	}
+	out := params.ErrorResults{}
+	err := c.facade.FacadeCall("Save", in, &out)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
+// AddSigningKey registers a public signing key for image metadata
+// from the given simplestreams source and stream.
+func (c *Client) AddSigningKey(source, stream, publicKey string) error {
+	return c.signingKeyCall("AddSigningKeys", source, stream, publicKey)
+}
+
+// RotateSigningKey replaces the public signing key registered for the
+// given simplestreams source and stream.
+func (c *Client) RotateSigningKey(source, stream, publicKey string) error {
+	return c.signingKeyCall("RotateSigningKeys", source, stream, publicKey)
+}
+
+// RevokeSigningKey removes the public signing key registered for the
+// given simplestreams source and stream.
+func (c *Client) RevokeSigningKey(source, stream string) error {
+	return c.signingKeyCall("RevokeSigningKeys", source, stream, "")
+}
+
+// ListSigningKeys returns the image metadata signing keys registered
+// on the controller.
+func (c *Client) ListSigningKeys() ([]params.ImageSigningKey, error) {
+	if bestVer := c.BestAPIVersion(); bestVer < 2 {
+		return nil, errors.NotImplementedf("ListSigningKeys() (need v2+, have v%d)", bestVer)
+	}
+	out := params.ListImageSigningKeysResult{}
+	if err := c.facade.FacadeCall("ListSigningKeys", nil, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Result, nil
+}
+
+func (c *Client) signingKeyCall(method, source, stream, publicKey string) error {
+	if bestVer := c.BestAPIVersion(); bestVer < 2 {
+		return errors.NotImplementedf("%s() (need v2+, have v%d)", method, bestVer)
+	}
+	in := params.ImageSigningKeyArgs{
+		Keys: []params.ImageSigningKey{{Source: source, Stream: stream, PublicKey: publicKey}},
+	}
+	out := params.ErrorResults{}
+	err := c.facade.FacadeCall(method, in, &out)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
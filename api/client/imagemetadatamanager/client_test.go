@@ -143,7 +143,7 @@ func (s *imagemetadataSuite) TestSave(c *gc.C) {
 			args := a.(params.MetadataSaveParams)
 			c.Assert(args.Metadata, gc.HasLen, 1)
 			c.Assert(args.Metadata, jc.DeepEquals, []params.CloudImageMetadataList{
-				{[]params.CloudImageMetadata{m, m}},
+				{Metadata: []params.CloudImageMetadata{m, m}},
 			})
 
 			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
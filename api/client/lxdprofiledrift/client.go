@@ -0,0 +1,40 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lxdprofiledrift
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the LXDProfileDrift API endpoint, which
+// reports machines whose applied lxd profiles have drifted from the
+// profiles their charms declare.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the LXDProfileDrift API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "LXDProfileDrift")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// DriftReport returns the lxd profile drift recorded for the
+// machines of the model, ordered by machine id.
+func (c *Client) DriftReport() ([]params.LXDProfileDriftResult, error) {
+	var results params.LXDProfileDriftResults
+	if err := c.facade.FacadeCall("DriftReport", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, result := range results.Results {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+	}
+	return results.Results, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/api/base"
 	apiwatcher "github.com/juju/juju/api/watcher"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/constraints"
 	coreseries "github.com/juju/juju/core/series"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/rpc/params"
@@ -92,11 +93,13 @@ func (client *Client) DestroyMachinesWithParams(force, keep bool, maxWait *time.
 	}
 	allResults := make([]params.DestroyMachineResult, len(machines))
 	index := make([]int, 0, len(machines))
+	invalid := make(map[int]*params.Error)
+	for _, entry := range base.ValidateMachineIds(machines) {
+		invalid[entry.Index] = entry.ParamsError()
+	}
 	for i, machineId := range machines {
-		if !names.IsValidMachine(machineId) {
-			allResults[i].Error = &params.Error{
-				Message: errors.NotValidf("machine ID %q", machineId).Error(),
-			}
+		if err, ok := invalid[i]; ok {
+			allResults[i].Error = err
 			continue
 		}
 		index = append(index, i)
@@ -123,11 +126,13 @@ func (client *Client) destroyMachines(method string, machines []string) ([]param
 	}
 	allResults := make([]params.DestroyMachineResult, len(machines))
 	index := make([]int, 0, len(machines))
+	invalid := make(map[int]*params.Error)
+	for _, entry := range base.ValidateMachineIds(machines) {
+		invalid[entry.Index] = entry.ParamsError()
+	}
 	for i, machineId := range machines {
-		if !names.IsValidMachine(machineId) {
-			allResults[i].Error = &params.Error{
-				Message: errors.NotValidf("machine ID %q", machineId).Error(),
-			}
+		if err, ok := invalid[i]; ok {
+			allResults[i].Error = err
 			continue
 		}
 		index = append(index, i)
@@ -194,6 +199,37 @@ func (client *Client) UpgradeSeriesPrepare(machineName, series string, force boo
 		Channel: base.Channel.String(),
 		Force:   force,
 	}
+	return client.upgradeSeriesPrepare(args)
+}
+
+// UpgradeSeriesPrepareWithSkipHooks behaves as UpgradeSeriesPrepare, but
+// additionally holds the named applications: the controller leaves their
+// units out of the upgrade-series lock, so their pre/post upgrade hooks are
+// never awaited. It is for use when the operator has acknowledged that the
+// named charms do not implement the hooks.
+func (client *Client) UpgradeSeriesPrepareWithSkipHooks(
+	machineName, series string, force bool, skipHookApplications []string,
+) error {
+	if client.BestAPIVersion() < 9 {
+		return errors.NotSupportedf("skipping upgrade-series hooks")
+	}
+	base, err := coreseries.GetBaseFromSeries(series)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	args := params.UpdateChannelArg{
+		Entity: params.Entity{
+			Tag: names.NewMachineTag(machineName).String(),
+		},
+		Series:               series,
+		Channel:              base.Channel.String(),
+		Force:                force,
+		SkipHookApplications: skipHookApplications,
+	}
+	return client.upgradeSeriesPrepare(args)
+}
+
+func (client *Client) upgradeSeriesPrepare(args params.UpdateChannelArg) error {
 	var result params.ErrorResult
 	if err := client.facade.FacadeCall("UpgradeSeriesPrepare", args, &result); err != nil {
 		return errors.Trace(err)
@@ -257,9 +293,9 @@ func (client *Client) UpgradeSeriesValidate(machineName, series string) ([]strin
 	return results.Results[0].UnitNames, nil
 }
 
-// WatchUpgradeSeriesNotifications returns a NotifyWatcher for observing the state of
-// a series upgrade.
-func (client *Client) WatchUpgradeSeriesNotifications(machineName string) (watcher.NotifyWatcher, string, error) {
+// WatchUpgradeSeriesNotifications returns a watcher for observing the
+// state of a series upgrade.
+func (client *Client) WatchUpgradeSeriesNotifications(machineName string) (watcher.Watcher[struct{}], string, error) {
 	if client.BestAPIVersion() < 5 {
 		return nil, "", errors.NotSupportedf("WatchUpgradeSeriesNotifications")
 	}
@@ -311,3 +347,85 @@ func (client *Client) GetUpgradeSeriesMessages(machineName, watcherId string) ([
 
 	return result.Result, nil
 }
+
+// EffectiveProxyConfig returns the proxy and apt-mirror configuration
+// that each of the given machines received at provisioning time.
+func (client *Client) EffectiveProxyConfig(machineNames ...string) ([]params.ProxyConfigResult, error) {
+	args := params.Entities{
+		Entities: make([]params.Entity, len(machineNames)),
+	}
+	for i, name := range machineNames {
+		args.Entities[i] = params.Entity{Tag: names.NewMachineTag(name).String()}
+	}
+	var results params.ProxyConfigResults
+	if err := client.facade.FacadeCall("EffectiveProxyConfig", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(machineNames) {
+		return nil, errors.Errorf("expected %d results, got %d", len(machineNames), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// ProviderMetadata returns the provider-sourced instance metadata
+// recorded for each of the given machines by the instance poller, such
+// as an image end-of-life date or the current spot price.
+func (client *Client) ProviderMetadata(machineNames ...string) ([]params.ProviderMetadataResult, error) {
+	if client.BestAPIVersion() < 10 {
+		return nil, errors.NotSupportedf("provider metadata")
+	}
+	args := params.Entities{
+		Entities: make([]params.Entity, len(machineNames)),
+	}
+	for i, name := range machineNames {
+		args.Entities[i] = params.Entity{Tag: names.NewMachineTag(name).String()}
+	}
+	var results params.ProviderMetadataResults
+	if err := client.facade.FacadeCall("ProviderMetadata", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(machineNames) {
+		return nil, errors.Errorf("expected %d results, got %d", len(machineNames), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// RecommendInstanceTypes returns the candidate instance types that
+// provisioning would consider for the given constraints in the current
+// model's cloud and region, with their specs and cost where the
+// provider reports one, sorted by increasing cost.
+func (client *Client) RecommendInstanceTypes(cons constraints.Value) (params.InstanceTypesResult, error) {
+	if client.BestAPIVersion() < 11 {
+		return params.InstanceTypesResult{}, errors.NotSupportedf("instance type recommendation")
+	}
+	args := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &cons}},
+	}
+	var results params.InstanceTypesResults
+	if err := client.facade.FacadeCall("RecommendInstanceTypes", args, &results); err != nil {
+		return params.InstanceTypesResult{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.InstanceTypesResult{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.InstanceTypesResult{}, result.Error
+	}
+	return result, nil
+}
+
+// ListMachines returns a page of the machines in the model matching
+// the supplied filter. Filtering and pagination are done server-side,
+// so callers inventorying large models do not need to fetch and filter
+// a FullStatus themselves.
+func (client *Client) ListMachines(filter params.ListMachinesArgs) (params.ListMachinesResults, error) {
+	var results params.ListMachinesResults
+	if client.BestAPIVersion() < 11 {
+		return results, errors.NotSupportedf("listing machines with server-side filtering")
+	}
+	if err := client.facade.FacadeCall("ListMachines", filter, &results); err != nil {
+		return results, errors.Trace(err)
+	}
+	return results, nil
+}
@@ -14,6 +14,7 @@ import (
 
 	basetesting "github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/api/client/machinemanager"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/storage"
 	coretesting "github.com/juju/juju/testing"
@@ -268,3 +269,87 @@ func (s *MachinemanagerSuite) TestDestroyMachinesWithParamsNilWait(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(results, jc.DeepEquals, expected)
 }
+
+func (s *MachinemanagerSuite) TestProviderMetadata(c *gc.C) {
+	expectedResults := []params.ProviderMetadataResult{{
+		Metadata: map[string]string{
+			"image-eol":  "2024-04-30",
+			"spot-price": "0.0464",
+		},
+	}, {
+		Error: &params.Error{Message: "boo"},
+	}}
+	client := machinemanager.NewClient(
+		basetesting.BestVersionCaller{
+			BestVersion: 10,
+			APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, a, response interface{}) error {
+				c.Assert(request, gc.Equals, "ProviderMetadata")
+				c.Assert(version, gc.Equals, 10)
+				c.Assert(a, jc.DeepEquals, params.Entities{
+					Entities: []params.Entity{
+						{Tag: "machine-0"},
+						{Tag: "machine-1"},
+					},
+				})
+				c.Assert(response, gc.FitsTypeOf, &params.ProviderMetadataResults{})
+				out := response.(*params.ProviderMetadataResults)
+				*out = params.ProviderMetadataResults{Results: expectedResults}
+				return nil
+			})})
+	results, err := client.ProviderMetadata("0", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, expectedResults)
+}
+
+func (s *MachinemanagerSuite) TestProviderMetadataNotSupported(c *gc.C) {
+	client := machinemanager.NewClient(
+		basetesting.BestVersionCaller{
+			BestVersion: 9,
+			APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, a, response interface{}) error {
+				c.Fatalf("facade call was not expected")
+				return nil
+			})})
+	_, err := client.ProviderMetadata("0")
+	c.Assert(err, gc.ErrorMatches, "provider metadata not supported")
+}
+
+func (s *MachinemanagerSuite) TestRecommendInstanceTypes(c *gc.C) {
+	cons := constraints.MustParse("cores=4 mem=16G")
+	expectedResult := params.InstanceTypesResult{
+		InstanceTypes: []params.InstanceType{
+			{Name: "medium", CPUCores: 4, Memory: 16384, Cost: 40},
+			{Name: "large", CPUCores: 8, Memory: 32768, Cost: 80},
+		},
+		CostUnit:     "USD/h",
+		CostCurrency: "USD",
+	}
+	client := machinemanager.NewClient(
+		basetesting.BestVersionCaller{
+			BestVersion: 11,
+			APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, a, response interface{}) error {
+				c.Assert(request, gc.Equals, "RecommendInstanceTypes")
+				c.Assert(version, gc.Equals, 11)
+				c.Assert(a, jc.DeepEquals, params.ModelInstanceTypesConstraints{
+					Constraints: []params.ModelInstanceTypesConstraint{{Value: &cons}},
+				})
+				c.Assert(response, gc.FitsTypeOf, &params.InstanceTypesResults{})
+				out := response.(*params.InstanceTypesResults)
+				*out = params.InstanceTypesResults{Results: []params.InstanceTypesResult{expectedResult}}
+				return nil
+			})})
+	result, err := client.RecommendInstanceTypes(cons)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, expectedResult)
+}
+
+func (s *MachinemanagerSuite) TestRecommendInstanceTypesNotSupported(c *gc.C) {
+	client := machinemanager.NewClient(
+		basetesting.BestVersionCaller{
+			BestVersion: 10,
+			APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, a, response interface{}) error {
+				c.Fatalf("facade call was not expected")
+				return nil
+			})})
+	_, err := client.RecommendInstanceTypes(constraints.MustParse("cores=4"))
+	c.Assert(err, gc.ErrorMatches, "instance type recommendation not supported")
+}
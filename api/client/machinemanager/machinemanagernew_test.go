@@ -91,6 +91,34 @@ func (s *NewMachineManagerSuite) TestUpgradeSeriesPrepareAlreadyInProgress(c *gc
 	c.Assert(errors.IsAlreadyExists(err), jc.IsTrue)
 }
 
+func (s *NewMachineManagerSuite) TestUpgradeSeriesPrepareWithSkipHooks(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.clientFacade = mocks.NewMockClientFacade(ctrl)
+	s.facade = mocks.NewMockFacadeCaller(ctrl)
+	s.clientFacade.EXPECT().BestAPIVersion().Return(9)
+	s.client = machinemanager.ConstructClient(s.clientFacade, s.facade)
+
+	arg := params.UpdateChannelArg{
+		Entity:               params.Entity{Tag: s.tag.String()},
+		Series:               "xenial",
+		Channel:              "16.04/stable",
+		SkipHookApplications: []string{"legacy-app"},
+	}
+	s.facade.EXPECT().FacadeCall("UpgradeSeriesPrepare", arg, gomock.Any()).SetArg(2, params.ErrorResult{})
+
+	err := s.client.UpgradeSeriesPrepareWithSkipHooks(s.tag.Id(), "xenial", false, []string{"legacy-app"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *NewMachineManagerSuite) TestUpgradeSeriesPrepareWithSkipHooksNotSupported(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	err := s.client.UpgradeSeriesPrepareWithSkipHooks(s.tag.Id(), "xenial", false, []string{"legacy-app"})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *NewMachineManagerSuite) setup(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -85,6 +85,23 @@ func (c *Client) SetModelConstraints(constraints constraints.Value) error {
 	return c.facade.FacadeCall("SetModelConstraints", params, nil)
 }
 
+// ModelConstraintsHistory returns the recorded changes to the model
+// constraints, most recent first.
+func (c *Client) ModelConstraintsHistory() ([]params.ModelConstraintsChange, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("ModelConstraintsHistory on v3 facade")
+	}
+	var result params.ModelConstraintsHistoryResult
+	err := c.facade.FacadeCall("ModelConstraintsHistory", nil, &result)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	return result.Changes, nil
+}
+
 // SetSLALevel sets the support level for the given model.
 func (c *Client) SetSLALevel(level, owner string, creds []byte) error {
 	args := params.ModelSLA{
@@ -431,12 +431,25 @@ func (c *Client) GrantModel(user, access string, modelUUIDs ...string) error {
 	return c.modifyModelUser(params.GrantModelAccess, user, access, modelUUIDs)
 }
 
+// GrantModelUntil grants a user access to the specified models until the
+// given time, after which the access is removed again.
+func (c *Client) GrantModelUntil(user, access string, expiry time.Time, modelUUIDs ...string) error {
+	if bestVer := c.BestAPIVersion(); bestVer < 10 {
+		return errors.NotImplementedf("GrantModelUntil() (need v10+, have v%d)", bestVer)
+	}
+	return c.modifyModelUserUntil(params.GrantModelAccess, user, access, &expiry, modelUUIDs)
+}
+
 // RevokeModel revokes a user's access to the specified models.
 func (c *Client) RevokeModel(user, access string, modelUUIDs ...string) error {
 	return c.modifyModelUser(params.RevokeModelAccess, user, access, modelUUIDs)
 }
 
 func (c *Client) modifyModelUser(action params.ModelAction, user, access string, modelUUIDs []string) error {
+	return c.modifyModelUserUntil(action, user, access, nil, modelUUIDs)
+}
+
+func (c *Client) modifyModelUserUntil(action params.ModelAction, user, access string, expiresAt *time.Time, modelUUIDs []string) error {
 	var args params.ModifyModelAccessRequest
 
 	if !names.IsValidUser(user) {
@@ -454,10 +467,11 @@ func (c *Client) modifyModelUser(action params.ModelAction, user, access string,
 		}
 		modelTag := names.NewModelTag(m)
 		args.Changes = append(args.Changes, params.ModifyModelAccess{
-			UserTag:  userTag.String(),
-			Action:   action,
-			Access:   params.UserAccessPermission(modelAccess),
-			ModelTag: modelTag.String(),
+			UserTag:   userTag.String(),
+			Action:    action,
+			Access:    params.UserAccessPermission(modelAccess),
+			ModelTag:  modelTag.String(),
+			ExpiresAt: expiresAt,
 		})
 	}
 
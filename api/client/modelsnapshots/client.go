@@ -0,0 +1,98 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelsnapshots
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the ModelSnapshots API endpoint, which
+// manages named snapshots of model config and constraints.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the ModelSnapshots API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "ModelSnapshots")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// CreateSnapshot captures the model's current config and constraints
+// under the given name.
+func (c *Client) CreateSnapshot(name string) (params.ModelSnapshot, error) {
+	var results params.ModelSnapshotResults
+	args := params.ModelSnapshotArgs{Names: []string{name}}
+	if err := c.facade.FacadeCall("CreateSnapshots", args, &results); err != nil {
+		return params.ModelSnapshot{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.ModelSnapshot{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.ModelSnapshot{}, result.Error
+	}
+	return *result.Snapshot, nil
+}
+
+// ListSnapshots returns all snapshots taken of the model, oldest
+// first.
+func (c *Client) ListSnapshots() ([]params.ModelSnapshot, error) {
+	var results params.ModelSnapshotResults
+	if err := c.facade.FacadeCall("ListSnapshots", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshots := make([]params.ModelSnapshot, len(results.Results))
+	for i, result := range results.Results {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		snapshots[i] = *result.Snapshot
+	}
+	return snapshots, nil
+}
+
+// DiffSnapshot reports what rolling the model back to the named
+// snapshot would change.
+func (c *Client) DiffSnapshot(name string) (params.ModelSnapshotDiffResult, error) {
+	var results params.ModelSnapshotDiffResults
+	args := params.ModelSnapshotArgs{Names: []string{name}}
+	if err := c.facade.FacadeCall("DiffSnapshots", args, &results); err != nil {
+		return params.ModelSnapshotDiffResult{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.ModelSnapshotDiffResult{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.ModelSnapshotDiffResult{}, result.Error
+	}
+	return result, nil
+}
+
+// RollbackSnapshot restores the model's config and constraints to
+// the state captured in the named snapshot.
+func (c *Client) RollbackSnapshot(name string) error {
+	var results params.ErrorResults
+	args := params.ModelSnapshotArgs{Names: []string{name}}
+	if err := c.facade.FacadeCall("RollbackSnapshots", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemoveSnapshot deletes the named snapshot.
+func (c *Client) RemoveSnapshot(name string) error {
+	var results params.ErrorResults
+	args := params.ModelSnapshotArgs{Names: []string{name}}
+	if err := c.facade.FacadeCall("RemoveSnapshots", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationschemas
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the RelationSchemas API endpoint, which
+// manages the JSON schemas registered on the controller for relation
+// interfaces.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the RelationSchemas API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "RelationSchemas")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RelationSchema returns the JSON schema registered for the given
+// relation interface name and version.
+func (c *Client) RelationSchema(interfaceName string, version int) (map[string]interface{}, error) {
+	args := params.RelationSchemaArgs{
+		Args: []params.RelationSchemaArg{{
+			Interface: interfaceName,
+			Version:   version,
+		}},
+	}
+	var results params.RelationSchemaResults
+	if err := c.facade.FacadeCall("RelationSchemas", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Schema, nil
+}
+
+// SetRelationSchema registers the given JSON schema for the given
+// relation interface name and version, replacing any schema previously
+// registered for that version.
+func (c *Client) SetRelationSchema(interfaceName string, version int, schema map[string]interface{}) error {
+	args := params.SetRelationSchemaArgs{
+		Args: []params.SetRelationSchemaArg{{
+			Interface: interfaceName,
+			Version:   version,
+			Schema:    schema,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("SetRelationSchemas", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemoveRelationSchema removes the JSON schema registered for the
+// given relation interface name and version.
+func (c *Client) RemoveRelationSchema(interfaceName string, version int) error {
+	args := params.RelationSchemaArgs{
+		Args: []params.RelationSchemaArg{{
+			Interface: interfaceName,
+			Version:   version,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("RemoveRelationSchemas", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
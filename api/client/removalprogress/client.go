@@ -0,0 +1,64 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removalprogress
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the RemovalProgress API endpoint, which
+// reports the progress of cascading entity removals.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the RemovalProgress API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "RemovalProgress")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RemovalOperation returns the removal operation tracking the removal
+// of the entity with the given tag.
+func (c *Client) RemovalOperation(tag names.Tag) (*params.RemovalOperation, error) {
+	args := params.Entities{Entities: []params.Entity{{Tag: tag.String()}}}
+	var results params.RemovalOperationResults
+	if err := c.facade.FacadeCall("RemovalOperations", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Result, nil
+}
+
+// WatchRemovalOperation returns a watcher that notifies when the
+// removal operation for the entity with the given tag is created or
+// makes progress.
+func (c *Client) WatchRemovalOperation(tag names.Tag) (watcher.NotifyWatcher, error) {
+	args := params.Entities{Entities: []params.Entity{{Tag: tag.String()}}}
+	var results params.NotifyWatchResults
+	if err := c.facade.FacadeCall("WatchRemovalOperations", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result), nil
+}
@@ -121,6 +121,59 @@ func (c Client) Upload(application, name, filename string, reader io.ReadSeeker)
 	return nil
 }
 
+// DefaultUploadChunkSize is the chunk size used by UploadChunked when
+// the caller does not specify one.
+const DefaultUploadChunkSize = 8 * 1024 * 1024
+
+// UploadChunked sends the provided resource blob up to Juju in chunks
+// of the given size, so that an interrupted upload can be resumed from
+// the last chunk the server received rather than restarted. The server
+// verifies the assembled blob against the content's fingerprint before
+// storing it.
+func (c Client) UploadChunked(application, name, filename string, reader io.ReadSeeker, chunkSize int64) error {
+	if chunkSize <= 0 {
+		chunkSize = DefaultUploadChunkSize
+	}
+	uReq, err := NewUploadRequest(application, name, filename, reader)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if uReq.Size == 0 {
+		// There is nothing to chunk; send the single (empty) request.
+		return errors.Trace(c.Upload(application, name, filename, reader))
+	}
+
+	var offset int64
+	for {
+		length := chunkSize
+		if remaining := uReq.Size - offset; remaining < length {
+			length = remaining
+		}
+		if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+			return errors.Trace(err)
+		}
+		req, err := uReq.ChunkHTTPRequest(offset, length)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		var response params.UploadChunkResult
+		if err := c.httpClient.Do(c.facade.RawAPICaller().Context(), req, &response); err != nil {
+			return errors.Trace(err)
+		}
+		if response.Complete {
+			return nil
+		}
+		if response.Offset == offset {
+			return errors.Errorf("resource upload made no progress at offset %d", offset)
+		}
+		// The server reports how much it has staged, which both
+		// advances past an accepted chunk and skips over anything
+		// already staged by an earlier, interrupted attempt.
+		offset = response.Offset
+	}
+}
+
 // CharmID represents the underlying charm for a given application. This
 // includes both the URL and the origin.
 type CharmID struct {
@@ -3,7 +3,10 @@
 
 package resources
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 // NewHTTPDownloadRequest creates a new HTTP download request
 // for the given resource.
@@ -12,3 +15,19 @@ import "net/http"
 func NewHTTPDownloadRequest(resourceName string) (*http.Request, error) {
 	return http.NewRequest("GET", "/resources/"+resourceName, nil)
 }
+
+// NewHTTPResumeDownloadRequest creates an HTTP download request for the
+// given resource that resumes from the given byte offset, for use when
+// an earlier download was interrupted part way through.
+//
+// Intended for use on the client side.
+func NewHTTPResumeDownloadRequest(resourceName string, offset int64) (*http.Request, error) {
+	req, err := NewHTTPDownloadRequest(resourceName)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	return req, nil
+}
@@ -29,6 +29,10 @@ const (
 	// The params are formatted according to  RFC 2045 and RFC 2616 (see
 	// mime.ParseMediaType and mime.FormatMediaType).
 	HeaderContentDisposition = "Content-Disposition"
+	// HeaderContentRange is the header name identifying the byte range of
+	// a single chunk within a chunked resource upload, and the range
+	// served in a partial resource download.
+	HeaderContentRange = "Content-Range"
 )
 
 const (
@@ -108,3 +108,32 @@ func (ur UploadRequest) HTTPRequest() (*http.Request, error) {
 
 	return req, nil
 }
+
+// ChunkHTTPRequest generates an HTTP request for one chunk of the
+// upload, covering length bytes from the given offset. The request
+// body is read from the upload's content, which the caller must first
+// seek to the chunk offset.
+func (ur UploadRequest) ChunkHTTPRequest(offset, length int64) (*http.Request, error) {
+	urlStr := newEndpointPath(ur.Application, ur.Name)
+
+	req, err := http.NewRequest(http.MethodPut, urlStr, io.LimitReader(ur.Content, length))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	req.Header.Set(HeaderContentType, ContentTypeRaw)
+	req.Header.Set(HeaderContentSha384, ur.Fingerprint.String())
+	req.Header.Set(HeaderContentLength, fmt.Sprint(ur.Size))
+	req.Header.Set(HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, ur.Size))
+	setFilename(ur.Filename, req)
+
+	req.ContentLength = length
+
+	if ur.PendingID != "" {
+		query := req.URL.Query()
+		query.Set(QueryParamPendingID, ur.PendingID)
+		req.URL.RawQuery = query.Encode()
+	}
+
+	return req, nil
+}
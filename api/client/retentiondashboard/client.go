@@ -0,0 +1,39 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package retentiondashboard
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client allows access to the RetentionDashboard API endpoint, which
+// reports size and age statistics for the prunable collections.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the RetentionDashboard
+// API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "RetentionDashboard")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RetentionStats returns the size, document count, oldest record
+// timestamp and configured retention policy for each of the model's
+// prunable collections.
+func (c *Client) RetentionStats() ([]params.CollectionRetentionStats, error) {
+	var result params.RetentionStatsResult
+	if err := c.facade.FacadeCall("RetentionStats", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Collections, nil
+}
@@ -129,6 +129,21 @@ func (api *API) ReloadSpaces() error {
 	return err
 }
 
+// ReconcileSpaces returns a plan describing the differences between the
+// provider's subnets and spaces and those recorded in the model, without
+// applying any of them.
+func (api *API) ReconcileSpaces() (params.ReconcileSpacesResults, error) {
+	if api.facade.BestAPIVersion() < 7 {
+		return params.ReconcileSpacesResults{}, errors.NewNotSupported(nil, "controller does not support reconciling spaces")
+	}
+	var results params.ReconcileSpacesResults
+	err := api.facade.FacadeCall("ReconcileSpaces", nil, &results)
+	if params.IsCodeNotSupported(err) {
+		return params.ReconcileSpacesResults{}, errors.NewNotSupported(nil, err.Error())
+	}
+	return results, err
+}
+
 // RenameSpace attempts to rename a space from the old name to a new name.
 func (api *API) RenameSpace(oldName string, newName string) error {
 	var response params.ErrorResults
@@ -572,3 +572,58 @@ func (s *SpacesSuite) TestMoveSubnetsServerError(c *gc.C) {
 
 	s.testMoveSubnets(c, space, subnets, nil, errors.New("boom"), "boom")
 }
+
+func (s *SpacesSuite) TestReconcileSpaces(c *gc.C) {
+	expected := params.ReconcileSpacesResults{
+		NewSubnets: []params.ReconcileSubnet{{
+			CIDR:       "10.0.2.0/24",
+			ProviderId: "sn-2",
+			SpaceName:  "db",
+		}},
+		MovedCIDRs: []params.ReconcileMovedCIDR{{
+			CIDR:      "10.0.1.0/24",
+			FromSpace: "web",
+			ToSpace:   "db",
+		}},
+	}
+	apicaller := &apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "Spaces")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "ReconcileSpaces")
+				c.Check(a, gc.IsNil)
+				c.Assert(result, gc.FitsTypeOf, &params.ReconcileSpacesResults{})
+				*result.(*params.ReconcileSpacesResults) = expected
+				return nil
+			},
+		),
+		BestVersion: 7,
+	}
+	api := spaces.NewAPI(apicaller)
+	results, err := api.ReconcileSpaces()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, expected)
+}
+
+func (s *SpacesSuite) TestReconcileSpacesNotSupported(c *gc.C) {
+	apicaller := &apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				return errors.New("unexpected call")
+			},
+		),
+		BestVersion: 6,
+	}
+	api := spaces.NewAPI(apicaller)
+	_, err := api.ReconcileSpaces()
+	c.Assert(err, gc.ErrorMatches, "controller does not support reconciling spaces")
+}
@@ -82,6 +82,17 @@ func (c *Client) ListPools(providers, names []string) ([]params.StoragePool, err
 	return results.Results[0].Result, nil
 }
 
+// PoolUsage returns aggregate usage for each storage pool in use in
+// the model: volume and filesystem counts, total provisioned capacity,
+// and attribution per owning application or unit.
+func (c *Client) PoolUsage() ([]params.StoragePoolUsage, error) {
+	var results params.StoragePoolUsageResults
+	if err := c.facade.FacadeCall("PoolUsage", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
+
 // CreatePool creates pool with specified parameters.
 func (c *Client) CreatePool(pname, provider string, attrs map[string]interface{}) error {
 	// Older facade did not support bulk calls.
@@ -212,13 +223,13 @@ func (c *Client) Attach(unitId string, storageIds []string) ([]params.ErrorResul
 	in := params.StorageAttachmentIds{
 		make([]params.StorageAttachmentId, len(storageIds)),
 	}
-	if !names.IsValidUnit(unitId) {
-		return nil, errors.NotValidf("unit ID %q", unitId)
+	if invalid := base.ValidateUnitNames([]string{unitId}); len(invalid) > 0 {
+		return nil, errors.Trace(invalid[0])
+	}
+	if invalid := base.ValidateStorageIds(storageIds); len(invalid) > 0 {
+		return nil, errors.Trace(invalid[0])
 	}
 	for i, storageId := range storageIds {
-		if !names.IsValidStorage(storageId) {
-			return nil, errors.NotValidf("storage ID %q", storageId)
-		}
 		in.Ids[i] = params.StorageAttachmentId{
 			StorageTag: names.NewStorageTag(storageId).String(),
 			UnitTag:    names.NewUnitTag(unitId).String(),
@@ -237,13 +248,36 @@ func (c *Client) Attach(unitId string, storageIds []string) ([]params.ErrorResul
 	return out.Results, nil
 }
 
+// Retry requests that provisioning be attempted again for the
+// specified volumes or filesystems, which must be in error.
+func (c *Client) Retry(tags []names.Tag) ([]params.ErrorResult, error) {
+	in := params.Entities{Entities: make([]params.Entity, len(tags))}
+	for i, tag := range tags {
+		switch tag.(type) {
+		case names.VolumeTag, names.FilesystemTag:
+		default:
+			return nil, errors.NotValidf("retrying %q", tag)
+		}
+		in.Entities[i] = params.Entity{Tag: tag.String()}
+	}
+	out := params.ErrorResults{}
+	if err := c.facade.FacadeCall("Retry", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(out.Results) != len(tags) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(tags), len(out.Results),
+		)
+	}
+	return out.Results, nil
+}
+
 // Remove removes the specified storage entities from the model,
 // optionally destroying them.
 func (c *Client) Remove(storageIds []string, destroyAttachments, destroyStorage bool, force *bool, maxWait *time.Duration) ([]params.ErrorResult, error) {
-	for _, id := range storageIds {
-		if !names.IsValidStorage(id) {
-			return nil, errors.NotValidf("storage ID %q", id)
-		}
+	if invalid := base.ValidateStorageIds(storageIds); len(invalid) > 0 {
+		return nil, errors.Trace(invalid[0])
 	}
 	results := params.ErrorResults{}
 	var args interface{}
@@ -290,12 +324,12 @@ func (c *Client) Remove(storageIds []string, destroyAttachments, destroyStorage
 
 // Detach detaches the specified storage entities.
 func (c *Client) Detach(storageIds []string, force *bool, maxWait *time.Duration) ([]params.ErrorResult, error) {
+	if invalid := base.ValidateStorageIds(storageIds); len(invalid) > 0 {
+		return nil, errors.Trace(invalid[0])
+	}
 	results := params.ErrorResults{}
 	ids := make([]params.StorageAttachmentId, len(storageIds))
 	for i, id := range storageIds {
-		if !names.IsValidStorage(id) {
-			return nil, errors.NotValidf("storage ID %q", id)
-		}
 		ids[i] = params.StorageAttachmentId{
 			StorageTag: names.NewStorageTag(id).String(),
 		}
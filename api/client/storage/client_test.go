@@ -904,6 +904,51 @@ func (s *storageMockSuite) TestAttachArityMismatch(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `expected 2 result\(s\), got 3`)
 }
 
+func (s *storageMockSuite) TestRetry(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Retry")
+			c.Check(a, jc.DeepEquals, params.Entities{[]params.Entity{
+				{Tag: "volume-0"},
+				{Tag: "filesystem-1-2"},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			results := result.(*params.ErrorResults)
+			results.Results = []params.ErrorResult{
+				{},
+				{Error: &params.Error{Message: "qux"}},
+			}
+			return nil
+		},
+	)
+	client := storage.NewClient(apiCaller)
+	results, err := client.Retry([]names.Tag{
+		names.NewVolumeTag("0"),
+		names.NewFilesystemTag("1/2"),
+	})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[0].Error, gc.IsNil)
+	c.Assert(results[1].Error, jc.DeepEquals, &params.Error{Message: "qux"})
+}
+
+func (s *storageMockSuite) TestRetryInvalidTag(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, result interface{}) error {
+			return nil
+		},
+	)
+	client := storage.NewClient(apiCaller)
+	_, err := client.Retry([]names.Tag{names.NewMachineTag("0")})
+	c.Check(err, gc.ErrorMatches, `retrying "machine-0" not valid`)
+}
+
 func (s *storageMockSuite) TestImport(c *gc.C) {
 	apiCaller := basetesting.APICallerFunc(
 		func(objType string,
@@ -204,6 +204,83 @@ func (c *Client) SetPassword(username, password string) error {
 	return results.OneError()
 }
 
+// CreateAPIToken mints a named personal access token for the specified
+// user, returning the full token credential. The credential is shown
+// only once and cannot be recovered later.
+func (c *Client) CreateAPIToken(arg params.CreateAPITokenArg) (string, error) {
+	if c.BestAPIVersion() < 4 {
+		return "", errors.NotSupportedf("API tokens")
+	}
+	args := params.CreateAPITokenArgs{
+		Tokens: []params.CreateAPITokenArg{arg},
+	}
+	var results params.CreateAPITokenResults
+	err := c.facade.FacadeCall("CreateAPIToken", args, &results)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		logger.Errorf("expected 1 result, got %#v", results)
+		return "", errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.Token, nil
+}
+
+// ListAPITokens returns the personal access tokens of the specified
+// user, without their secrets.
+func (c *Client) ListAPITokens(username string) ([]params.APITokenInfo, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("API tokens")
+	}
+	if !names.IsValidUser(username) {
+		return nil, errors.Errorf("%q is not a valid username", username)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewUserTag(username).String()}},
+	}
+	var results params.APITokenResults
+	err := c.facade.FacadeCall("ListAPITokens", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		logger.Errorf("expected 1 result, got %#v", results)
+		return nil, errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	return result.Tokens, nil
+}
+
+// RevokeAPIToken revokes the named personal access token of the
+// specified user.
+func (c *Client) RevokeAPIToken(username, tokenName string) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("API tokens")
+	}
+	if !names.IsValidUser(username) {
+		return errors.Errorf("%q is not a valid username", username)
+	}
+	args := params.RevokeAPITokenArgs{
+		Tokens: []params.RevokeAPITokenArg{{
+			Tag:  names.NewUserTag(username).String(),
+			Name: tokenName,
+		}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall("RevokeAPIToken", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // ResetPassword resets password for the specified user.
 func (c *Client) ResetPassword(username string) ([]byte, error) {
 	if !names.IsValidUser(username) {
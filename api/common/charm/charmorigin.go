@@ -54,6 +54,10 @@ type Origin struct {
 	// Series describes the series of the OS intended to be used by the charm.
 	Series string
 
+	// BaseSelection records how the base for the charm was chosen when
+	// the user did not specify one.
+	BaseSelection string
+
 	// InstanceKey is a unique string associated with the application. To
 	// assist with keeping KPI data in charmhub, it must be the same for every
 	// charmhub Refresh action related to an application. Create with the
@@ -103,10 +107,11 @@ func (o Origin) ParamsCharmOrigin() params.CharmOrigin {
 		Architecture: o.Architecture,
 		Base:         params.Base{Name: o.Base.Name, Channel: o.Base.Channel.String()},
 		// TODO(juju3) - remove series, os, channel
-		Series:      o.Series,
-		OS:          o.Base.Name,
-		Channel:     o.Base.Channel.String(),
-		InstanceKey: o.InstanceKey,
+		Series:        o.Series,
+		OS:            o.Base.Name,
+		Channel:       o.Base.Channel.String(),
+		BaseSelection: o.BaseSelection,
+		InstanceKey:   o.InstanceKey,
 	}
 }
 
@@ -145,7 +150,8 @@ func (o Origin) CoreCharmOrigin() corecharm.Origin {
 			OS:           o.Base.Name,
 			Channel:      o.Base.Channel.Track,
 		},
-		InstanceKey: o.InstanceKey,
+		BaseSelection: o.BaseSelection,
+		InstanceKey:   o.InstanceKey,
 	}
 }
 
@@ -178,8 +184,9 @@ func APICharmOrigin(origin params.CharmOrigin) (Origin, error) {
 		Architecture: origin.Architecture,
 		Base:         base,
 		// TODO(juju3) - remove series
-		Series:      origin.Series,
-		InstanceKey: origin.InstanceKey,
+		Series:        origin.Series,
+		BaseSelection: origin.BaseSelection,
+		InstanceKey:   origin.InstanceKey,
 	}, nil
 }
 
@@ -214,17 +221,18 @@ func CoreCharmOrigin(origin corecharm.Origin) (Origin, error) {
 		}
 	}
 	return Origin{
-		Source:       OriginSource(origin.Source),
-		Type:         origin.Type,
-		ID:           origin.ID,
-		Hash:         origin.Hash,
-		Revision:     origin.Revision,
-		Risk:         string(ch.Risk),
-		Track:        track,
-		Branch:       branch,
-		Architecture: origin.Platform.Architecture,
-		Base:         chBase,
-		Series:       chSeries,
-		InstanceKey:  origin.InstanceKey,
+		Source:        OriginSource(origin.Source),
+		Type:          origin.Type,
+		ID:            origin.ID,
+		Hash:          origin.Hash,
+		Revision:      origin.Revision,
+		Risk:          string(ch.Risk),
+		Track:         track,
+		Branch:        branch,
+		Architecture:  origin.Platform.Architecture,
+		Base:          chBase,
+		Series:        chSeries,
+		BaseSelection: origin.BaseSelection,
+		InstanceKey:   origin.InstanceKey,
 	}, nil
 }
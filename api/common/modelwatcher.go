@@ -84,6 +84,17 @@ func (e *ModelWatcher) UpdateStatusHookInterval() (time.Duration, error) {
 	return modelConfig.UpdateStatusHookInterval(), nil
 }
 
+// HookDebounceWindow returns the current hook dispatch debounce window.
+func (e *ModelWatcher) HookDebounceWindow() (time.Duration, error) {
+	// TODO(wallyworld) - lp:1602237 - this needs to have it's own backend implementation.
+	// For now, we'll piggyback off the ModelConfig API.
+	modelConfig, err := e.ModelConfig()
+	if err != nil {
+		return 0, err
+	}
+	return modelConfig.HookDebounceWindow(), nil
+}
+
 // WatchUpdateStatusHookInterval returns a NotifyWatcher that fires when the
 // update status hook interval changes.
 func (e *ModelWatcher) WatchUpdateStatusHookInterval() (watcher.NotifyWatcher, error) {
@@ -15,8 +15,10 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/common/cloudspec"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/rpc/params"
 )
@@ -185,6 +187,26 @@ func (c *Client) DestroyController(args DestroyControllerParams) error {
 	}, nil)
 }
 
+// DestroyModels destroys the specified models. Models that consume
+// cross model offers are destroyed before the models offering them.
+// The per-model results are returned in destruction order, along with
+// a watcher that reports the models in the batch whose life changes as
+// the destruction progresses.
+func (c *Client) DestroyModels(args params.DestroyModelsParams) ([]params.DestroyModelResult, watcher.StringsWatcher, error) {
+	if c.BestAPIVersion() < 12 {
+		return nil, nil, errors.NotSupportedf("bulk model destruction by this Juju controller")
+	}
+	var results params.DestroyModelsResults
+	if err := c.facade.FacadeCall("DestroyModels", args, &results); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if results.Progress.Error != nil {
+		return results.Results, nil, results.Progress.Error
+	}
+	w := apiwatcher.NewStringsWatcher(c.facade.RawAPICaller(), results.Progress)
+	return results.Results, w, nil
+}
+
 // ListBlockedModels returns a list of all models within the controller
 // which have at least one block in place.
 func (c *Client) ListBlockedModels() ([]params.ModelBlockInfo, error) {
@@ -298,6 +320,28 @@ func (c *Client) ConfigSet(values map[string]interface{}) error {
 	)
 }
 
+// AgentIntrospection fetches the introspection report at the given
+// path (such as "depengine" or "debug/pprof/goroutine") from each of
+// the given agents, proxied through the controller. Requires
+// superuser access.
+func (c *Client) AgentIntrospection(tags []names.Tag, path string) ([]params.AgentIntrospectionResult, error) {
+	args := params.AgentIntrospectionArgs{
+		Entities: make([]params.Entity, len(tags)),
+		Path:     path,
+	}
+	for i, tag := range tags {
+		args.Entities[i] = params.Entity{Tag: tag.String()}
+	}
+	var results params.AgentIntrospectionResults
+	if err := c.facade.FacadeCall("AgentIntrospection", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(tags) {
+		return nil, errors.Errorf("expected %d results, got %d", len(tags), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // MigrationSpec holds the details required to start the migration of
 // a single model.
 type MigrationSpec struct {
@@ -0,0 +1,31 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package credentialchecker
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client provides access to the CredentialChecker facade.
+type Client struct {
+	facade base.FacadeCaller
+}
+
+// NewClient returns a client for the CredentialChecker facade, used by
+// the credential checker worker.
+func NewClient(caller base.APICaller) *Client {
+	return &Client{base.NewFacadeCaller(caller, "CredentialChecker")}
+}
+
+// ReportCredentialExpiry records the provider-reported expiry time of
+// the model's cloud credential with the controller.
+func (c *Client) ReportCredentialExpiry(expiry time.Time) error {
+	args := params.CredentialExpiryArg{Expiry: expiry}
+	return errors.Trace(c.facade.FacadeCall("ReportCredentialExpiry", args, nil))
+}
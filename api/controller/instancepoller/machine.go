@@ -152,6 +152,24 @@ func (m *Machine) SetInstanceStatus(status status.Status, message string, data m
 	return result.OneError()
 }
 
+// SetProviderMetadata records provider-sourced instance metadata for
+// this machine. Controllers too old to support the call simply do not
+// record the metadata.
+func (m *Machine) SetProviderMetadata(metadata map[string]string) error {
+	if m.facade.BestAPIVersion() < 5 {
+		return nil
+	}
+	var result params.ErrorResults
+	args := params.SetProviderMetadataArgs{Args: []params.SetProviderMetadataArg{
+		{Tag: m.tag.String(), Metadata: metadata},
+	}}
+	err := m.facade.FacadeCall("SetProviderMetadata", args, &result)
+	if err != nil {
+		return err
+	}
+	return result.OneError()
+}
+
 // SetProviderNetworkConfig updates the provider addresses for this machine.
 func (m *Machine) SetProviderNetworkConfig(ifList network.InterfaceInfos) (network.ProviderAddresses, bool, error) {
 	var results params.SetProviderNetworkConfigResults
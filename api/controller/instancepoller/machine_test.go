@@ -225,6 +225,53 @@ func (s *MachineSuite) TestSetInstanceStatusSuccess(c *gc.C) {
 	c.Check(apiCaller.CallCount, gc.Equals, 1)
 }
 
+func (s *MachineSuite) TestSetProviderMetadataSuccess(c *gc.C) {
+	metadata := map[string]string{
+		"image-eol":  "2024-04-30",
+		"spot-price": "0.0464",
+	}
+	expectArgs := params.SetProviderMetadataArgs{
+		Args: []params.SetProviderMetadataArg{{
+			Tag:      "machine-42",
+			Metadata: metadata,
+		}},
+	}
+	var callCount int
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(
+			func(objType string, version int, _, request string, arg, result interface{}) error {
+				c.Check(objType, gc.Equals, "InstancePoller")
+				c.Check(version, gc.Equals, 5)
+				c.Check(request, gc.Equals, "SetProviderMetadata")
+				c.Check(arg, jc.DeepEquals, expectArgs)
+				*(result.(*params.ErrorResults)) = params.ErrorResults{
+					Results: []params.ErrorResult{{Error: nil}},
+				}
+				callCount++
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	machine := instancepoller.NewMachine(apiCaller, s.tag, life.Alive)
+	c.Check(machine.SetProviderMetadata(metadata), jc.ErrorIsNil)
+	c.Check(callCount, gc.Equals, 1)
+}
+
+func (s *MachineSuite) TestSetProviderMetadataNotSupported(c *gc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		APICallerFunc: apitesting.APICallerFunc(
+			func(_ string, _ int, _, _ string, _, _ interface{}) error {
+				c.Fatalf("facade call was not expected")
+				return nil
+			},
+		),
+		BestVersion: 4,
+	}
+	machine := instancepoller.NewMachine(apiCaller, s.tag, life.Alive)
+	c.Check(machine.SetProviderMetadata(map[string]string{"image-eol": "2024-04-30"}), jc.ErrorIsNil)
+}
+
 func (s *MachineSuite) TestSetProviderNetworkConfigSuccess(c *gc.C) {
 	cfg := network.InterfaceInfos{{
 		DeviceIndex: 0,
@@ -0,0 +1,56 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/core/modelalerts"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client provides access to the ModelAlerts facade.
+type Client struct {
+	facade base.FacadeCaller
+}
+
+// NewClient returns a client for the ModelAlerts facade, used by the
+// model alerts worker.
+func NewClient(caller base.APICaller) *Client {
+	return &Client{base.NewFacadeCaller(caller, "ModelAlerts")}
+}
+
+// UnitReports returns the per-unit snapshots that the alert rules are
+// evaluated against.
+func (c *Client) UnitReports() ([]modelalerts.UnitReport, error) {
+	var result params.UnitAlertReportsResult
+	if err := c.facade.FacadeCall("UnitReports", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	reports := make([]modelalerts.UnitReport, len(result.Reports))
+	for i, report := range result.Reports {
+		reports[i] = modelalerts.UnitReport{
+			Tag:            report.Tag,
+			AgentStatus:    status.Status(report.AgentStatus),
+			StatusSince:    report.StatusSince,
+			HookRetryCount: report.HookRetryCount,
+		}
+	}
+	return reports, nil
+}
+
+// WebhookURL returns the model's configured alert webhook URL; the
+// result is empty when no webhook sink is configured.
+func (c *Client) WebhookURL() (string, error) {
+	var result params.StringResult
+	if err := c.facade.FacadeCall("WebhookURL", nil, &result); err != nil {
+		return "", errors.Trace(err)
+	}
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.Result, nil
+}
@@ -11,20 +11,21 @@ package api
 // New facades should start at 1.
 // Facades that existed before versioning start at 0.
 var facadeVersions = map[string]int{
-	"Action":                       7,
+	"Action":                       8,
 	"ActionPruner":                 1,
-	"Agent":                        3,
+	"Agent":                        4,
 	"AgentTools":                   1,
 	"AllModelWatcher":              4,
 	"AllWatcher":                   3,
 	"Annotations":                  2,
-	"Application":                  15,
-	"ApplicationOffers":            4,
+	"Application":                  18,
+	"ApplicationOffers":            7,
 	"ApplicationScaler":            1,
 	"Backups":                      3,
 	"Block":                        2,
-	"Bundle":                       6,
+	"Bundle":                       7,
 	"CAASAgent":                    2,
+	"CDCStream":                    1,
 	"CAASAdmission":                1,
 	"CAASApplication":              1,
 	"CAASApplicationProvisioner":   1,
@@ -43,16 +44,21 @@ var facadeVersions = map[string]int{
 	"Cleaner":                      2,
 	"Client":                       6,
 	"Cloud":                        7,
-	"Controller":                   11,
+	"Controller":                   12,
+	"ControllerConfigReload":       1,
+	"CredentialChecker":            1,
 	"CredentialManager":            1,
 	"CredentialValidator":          2,
 	"CrossController":              1,
 	"CrossModelRelations":          2,
-	"Deployer":                     1,
+	"DefaultConstraints":           1,
+	"Deployer":                     2,
+	"DeploymentEvents":             1,
 	"DiskManager":                  2,
 	"EntityWatcher":                2,
 	"EnvironUpgrader":              1,
 	"ExternalControllerUpdater":    1,
+	"FacadeDeprecations":           1,
 	"FanConfigurer":                1,
 	"FilesystemAttachmentsWatcher": 2,
 	"Firewaller":                   7,
@@ -61,21 +67,23 @@ var facadeVersions = map[string]int{
 	"HostKeyReporter":              1,
 	"ImageManager":                 2,
 	"ImageMetadata":                3,
-	"ImageMetadataManager":         1,
-	"InstanceMutater":              3,
-	"InstancePoller":               4,
+	"ImageMetadataManager":         2,
+	"InstanceMutater":              4,
+	"InstancePoller":               5,
 	"KeyManager":                   1,
 	"KeyUpdater":                   1,
 	"LeadershipService":            2,
+	"LXDProfileDrift":              1,
 	"LifeFlag":                     1,
 	"LogForwarding":                1,
 	"Logger":                       1,
 	"MachineActions":               1,
-	"MachineManager":               9,
+	"MachineManager":               11,
 	"MachineUndertaker":            1,
 	"Machiner":                     5,
 	"MeterStatus":                  2,
 	"MetricsAdder":                 2,
+	"MetricsCollector":             1,
 	"MetricsDebug":                 2,
 	"MetricsManager":               1,
 	"MigrationFlag":                1,
@@ -83,13 +91,16 @@ var facadeVersions = map[string]int{
 	"MigrationMinion":              1,
 	"MigrationStatusWatcher":       1,
 	"MigrationTarget":              1,
-	"ModelConfig":                  3,
+	"ModelAlerts":                  1,
+	"ModelConfig":                  4,
 	"ModelGeneration":              4,
-	"ModelManager":                 9,
+	"ModelManager":                 10,
+	"ModelSnapshots":               1,
 	"ModelSummaryWatcher":          1,
 	"ModelUpgrader":                1,
 	"NotifyWatcher":                1,
 	"OfferStatusWatcher":           1,
+	"PatchReporter":                1,
 	"Payloads":                     1,
 	"PayloadsHookContext":          1,
 	"Pinger":                       1,
@@ -98,17 +109,20 @@ var facadeVersions = map[string]int{
 	"RaftLease":                    2,
 	"Reboot":                       2,
 	"RelationStatusWatcher":        1,
+	"RelationSchemas":              1,
 	"RelationUnitsWatcher":         1,
 	"RemoteRelations":              2,
 	"RemoteRelationWatcher":        1,
+	"RemovalProgress":              1,
 	"Resources":                    3,
 	"ResourcesHookContext":         1,
 	"Resumer":                      2,
+	"RetentionDashboard":           1,
 	"RetryStrategy":                1,
 	"SecretsRotationWatcher":       1,
 	"SecretsManager":               1,
 	"Singular":                     2,
-	"Spaces":                       6,
+	"Spaces":                       7,
 	"SSHClient":                    4,
 	"StatusHistory":                2,
 	"Storage":                      6,
@@ -117,11 +131,11 @@ var facadeVersions = map[string]int{
 	"Subnets":                      5,
 	"Undertaker":                   1,
 	"UnitAssigner":                 1,
-	"Uniter":                       18,
+	"Uniter":                       19,
 	"Upgrader":                     1,
 	"UpgradeSeries":                3,
 	"UpgradeSteps":                 2,
-	"UserManager":                  3,
+	"UserManager":                  4,
 	"VolumeAttachmentsWatcher":     2,
 	"VolumeAttachmentPlansWatcher": 1,
 }
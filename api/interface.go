@@ -46,6 +46,13 @@ type Info struct {
 	// this field is ignored.
 	SNIHostName string
 
+	// SRVDiscoveryDomain optionally holds a DNS domain under which
+	// to look up _juju-api._tcp SRV records to discover controller
+	// API addresses. Discovered addresses are dialed alongside
+	// Addrs, so clients can still find the controller when the
+	// cached addresses are stale.
+	SRVDiscoveryDomain string
+
 	// CACert holds the CA certificate that will be used
 	// to validate the controller's certificate, in PEM format.
 	// If this is empty, the standard system root certificates
@@ -101,7 +108,7 @@ func (info *Info) Ports() []int {
 
 // Validate validates the API info.
 func (info *Info) Validate() error {
-	if len(info.Addrs) == 0 {
+	if len(info.Addrs) == 0 && info.SRVDiscoveryDomain == "" {
 		return errors.NotValidf("missing addresses")
 	}
 
@@ -175,6 +182,11 @@ type DialOpts struct {
 	// If it is nil, net.DefaultResolver will be used.
 	IPAddrResolver IPAddrResolver
 
+	// SRVResolver is used to look up SRV records when an
+	// SRVDiscoveryDomain is configured. If it is nil,
+	// net.DefaultResolver will be used.
+	SRVResolver SRVResolver
+
 	// DNSCache is consulted to find and store cached DNS lookups.
 	// If it is nil, no cache will be used or updated.
 	DNSCache DNSCache
@@ -197,6 +209,12 @@ type IPAddrResolver interface {
 	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
 }
 
+// SRVResolver looks up DNS SRV records for a service under a
+// domain. It is notably implemented by net.Resolver.
+type SRVResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
 // DNSCache implements a cache of DNS lookup results.
 type DNSCache interface {
 	// Lookup returns the IP addresses associated
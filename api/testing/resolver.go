@@ -19,6 +19,21 @@ var _ api.IPAddrResolver = IPAddrResolverMap(nil)
 // strings in the value slices should be valid IP addresses.
 type IPAddrResolverMap map[string][]string
 
+var _ api.SRVResolver = SRVResolverMap(nil)
+
+// SRVResolverMap implements SRVResolver by looking up the records in
+// the map, which maps "_service._proto.name" to SRV records.
+type SRVResolverMap map[string][]*net.SRV
+
+func (r SRVResolverMap) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	key := "_" + service + "._" + proto + "." + name
+	records := r[key]
+	if len(records) == 0 {
+		return "", nil, errors.Errorf("mock resolver has no SRV records for %q", key)
+	}
+	return key, records, nil
+}
+
 func (r IPAddrResolverMap) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
 	if ip := net.ParseIP(host); ip != nil {
 		return []net.IPAddr{{IP: ip}}, nil
@@ -286,7 +286,7 @@ func (s *watcherSuite) assertSetupRelationStatusWatch(
 	// discharge can occur.
 	err = s.State.CreateOfferAccess(
 		names.NewApplicationOfferTag("hosted-mysql"),
-		names.NewUserTag("fred"), permission.ConsumeAccess)
+		names.NewUserTag("fred"), permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create a macaroon for authorisation.
@@ -423,7 +423,7 @@ func (s *watcherSuite) setupOfferStatusWatch(
 	// discharge can occur.
 	err = s.State.CreateOfferAccess(
 		names.NewApplicationOfferTag("hosted-mysql"),
-		names.NewUserTag("fred"), permission.ConsumeAccess)
+		names.NewUserTag("fred"), permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create a macaroon for authorisation.
@@ -299,6 +299,7 @@ func (a *admin) authenticate(ctx context.Context, req params.LoginRequest) (*aut
 
 		// TODO(wallyworld) - we can't yet observe anonymous logins as entity must be non-nil
 		a.root.entity = authInfo.Entity
+		a.root.maxPermission = authInfo.MaxPermission
 		a.apiObserver.Login(authInfo.Entity.Tag(), a.root.model.ModelTag(), controllerConn, req.UserData)
 	} else if a.root.model == nil {
 		// Anonymous login to unknown model.
@@ -25,8 +25,10 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/machineactions"
 	"github.com/juju/juju/apiserver/facades/agent/meterstatus"
 	"github.com/juju/juju/apiserver/facades/agent/metricsadder"
+	"github.com/juju/juju/apiserver/facades/agent/metricscollector"
 	"github.com/juju/juju/apiserver/facades/agent/migrationflag"
 	"github.com/juju/juju/apiserver/facades/agent/migrationminion"
+	"github.com/juju/juju/apiserver/facades/agent/patchreporter"
 	"github.com/juju/juju/apiserver/facades/agent/payloadshookcontext"
 	"github.com/juju/juju/apiserver/facades/agent/provisioner"
 	"github.com/juju/juju/apiserver/facades/agent/proxyupdater"
@@ -50,24 +52,33 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/backups" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/block"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/bundle"
+	"github.com/juju/juju/apiserver/facades/client/cdc" // ModelUser Admin
 	"github.com/juju/juju/apiserver/facades/client/charmhub"
 	"github.com/juju/juju/apiserver/facades/client/charms"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/client"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/cloud"      // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/controller" // ModelUser Admin (although some methods check for read only)
+	"github.com/juju/juju/apiserver/facades/client/controllerconfigreload"
 	"github.com/juju/juju/apiserver/facades/client/credentialmanager"
+	"github.com/juju/juju/apiserver/facades/client/defaultconstraints"
+	"github.com/juju/juju/apiserver/facades/client/deploymentevents"
 	"github.com/juju/juju/apiserver/facades/client/firewallrules"
 	"github.com/juju/juju/apiserver/facades/client/highavailability" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/imagemanager"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/imagemetadatamanager"
-	"github.com/juju/juju/apiserver/facades/client/keymanager"     // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/keymanager" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/lxdprofiledrift"
 	"github.com/juju/juju/apiserver/facades/client/machinemanager" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/metricsdebug"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelconfig"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelgeneration"
 	"github.com/juju/juju/apiserver/facades/client/modelmanager" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/modelsnapshots"
 	"github.com/juju/juju/apiserver/facades/client/payloads"
+	"github.com/juju/juju/apiserver/facades/client/relationschemas"
+	"github.com/juju/juju/apiserver/facades/client/removalprogress"
 	"github.com/juju/juju/apiserver/facades/client/resources"
+	"github.com/juju/juju/apiserver/facades/client/retentiondashboard"
 	"github.com/juju/juju/apiserver/facades/client/spaces"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/sshclient" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/storage"
@@ -86,6 +97,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/controller/charmdownloader"
 	"github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater"
 	"github.com/juju/juju/apiserver/facades/controller/cleaner"
+	"github.com/juju/juju/apiserver/facades/controller/credentialchecker"
 	"github.com/juju/juju/apiserver/facades/controller/crosscontroller"
 	"github.com/juju/juju/apiserver/facades/controller/crossmodelrelations"
 	"github.com/juju/juju/apiserver/facades/controller/environupgrader"
@@ -99,6 +111,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/controller/metricsmanager"
 	"github.com/juju/juju/apiserver/facades/controller/migrationmaster"
 	"github.com/juju/juju/apiserver/facades/controller/migrationtarget"
+	"github.com/juju/juju/apiserver/facades/controller/modelalerts"
 	"github.com/juju/juju/apiserver/facades/controller/raftlease"
 	"github.com/juju/juju/apiserver/facades/controller/remoterelations"
 	"github.com/juju/juju/apiserver/facades/controller/resumer"
@@ -125,6 +138,7 @@ func AllFacades() *facade.Registry {
 	backups.Register(registry)
 	block.Register(registry)
 	bundle.Register(registry)
+	cdc.Register(registry)
 	charmhub.Register(registry)
 	charmdownloader.Register(registry)
 	charmrevisionupdater.Register(registry)
@@ -147,10 +161,14 @@ func AllFacades() *facade.Registry {
 	caasunitprovisioner.Register(registry)
 
 	controller.Register(registry)
+	controllerconfigreload.Register(registry)
 	crossmodelrelations.Register(registry)
 	crosscontroller.Register(registry)
+	credentialchecker.Register(registry)
 	credentialmanager.Register(registry)
 	credentialvalidator.Register(registry)
+	defaultconstraints.Register(registry)
+	deploymentevents.Register(registry)
 	externalcontrollerupdater.Register(registry)
 	deployer.Register(registry)
 	diskmanager.Register(registry)
@@ -169,6 +187,7 @@ func AllFacades() *facade.Registry {
 	keyupdater.Register(registry)
 	leadership.Register(registry)
 	lifeflag.Register(registry)
+	lxdprofiledrift.Register(registry)
 	loggerapi.Register(registry)
 	logfwd.Register(registry)
 	machineactions.Register(registry)
@@ -177,26 +196,33 @@ func AllFacades() *facade.Registry {
 	machine.Register(registry)
 	meterstatus.Register(registry)
 	metricsadder.Register(registry)
+	metricscollector.Register(registry)
 	metricsdebug.Register(registry)
 	metricsmanager.Register(registry)
 	migrationflag.Register(registry)
 	migrationmaster.Register(registry)
 	migrationminion.Register(registry)
 	migrationtarget.Register(registry)
+	modelalerts.Register(registry)
 	modelconfig.Register(registry)
 	modelgeneration.Register(registry)
 	modelmanager.Register(registry)
+	modelsnapshots.Register(registry)
 	modelupgrader.Register(registry)
 	payloads.Register(registry)
+	patchreporter.Register(registry)
 	payloadshookcontext.Register(registry)
 	provisioner.Register(registry)
 	proxyupdater.Register(registry)
 	raftlease.Register(registry)
 	reboot.Register(registry)
+	relationschemas.Register(registry)
 	remoterelations.Register(registry)
+	removalprogress.Register(registry)
 	resources.Register(registry)
 	resourceshookcontext.Register(registry)
 	resumer.Register(registry)
+	retentiondashboard.Register(registry)
 	retrystrategy.Register(registry)
 	singular.Register(registry)
 	secretsmanager.Register(registry)
@@ -219,6 +245,10 @@ func AllFacades() *facade.Registry {
 		return NewPinger(ctx)
 	}, reflect.TypeOf((*Pinger)(nil)).Elem())
 
+	registry.MustRegister("FacadeDeprecations", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacadeDeprecations(registry), nil
+	}, reflect.TypeOf((*facadeDeprecations)(nil)))
+
 	registry.MustRegister("AllWatcher", 1, NewAllWatcherV1, reflect.TypeOf((*SrvAllWatcherV1)(nil)))
 	registry.MustRegister("AllWatcher", 2, NewAllWatcher, reflect.TypeOf((*SrvAllWatcher)(nil)))
 	registry.MustRegister("AllWatcher", 3, NewAllWatcher, reflect.TypeOf((*SrvAllWatcher)(nil)))
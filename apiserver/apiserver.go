@@ -88,6 +88,7 @@ type Server struct {
 	mux                    *apiserverhttp.Mux
 	metricsCollector       *Collector
 	execEmbeddedCommand    ExecEmbeddedCommandFunc
+	rpcScheduler           *rpc.PriorityScheduler
 
 	// mu guards the fields below it.
 	mu sync.Mutex
@@ -291,6 +292,28 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 
 const readyTimeout = time.Second * 30
 
+// maxConcurrentLowPriorityRequests bounds the number of low priority
+// RPC requests (expensive client queries such as FullStatus) that may
+// run concurrently; the excess is queued so that agent liveness calls
+// are not starved on a loaded controller.
+const maxConcurrentLowPriorityRequests = 16
+
+// requestPriority classifies RPC requests so that agent liveness
+// calls are dispatched ahead of expensive client queries.
+func requestPriority(req rpc.Request) rpc.Priority {
+	switch req.Type {
+	case "Pinger":
+		return rpc.PriorityHigh
+	}
+	switch req.Type + "." + req.Action {
+	case "Client.FullStatus",
+		"ApplicationOffers.ListApplicationOffers",
+		"ApplicationOffers.FindApplicationOffers":
+		return rpc.PriorityLow
+	}
+	return rpc.PriorityNormal
+}
+
 func newServer(cfg ServerConfig) (_ *Server, err error) {
 	systemState, err := cfg.StatePool.SystemState()
 	if err != nil {
@@ -368,6 +391,12 @@ func newServer(cfg ServerConfig) (_ *Server, err error) {
 
 		healthStatus: "starting",
 	}
+	srv.rpcScheduler = rpc.NewPriorityScheduler(
+		0, maxConcurrentLowPriorityRequests,
+		func(p rpc.Priority, depth int) {
+			srv.metricsCollector.RPCQueueDepth.WithLabelValues(p.String()).Set(float64(depth))
+		},
+	)
 	srv.updateAgentRateLimiter(controllerConfig)
 	srv.updateResourceDownloadLimiters(controllerConfig)
 
@@ -692,6 +721,7 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	debugLogHandler := newDebugLogDBHandler(
 		httpCtxt, srv.authenticator,
 		tagKindAuthorizer{names.MachineTagKind, names.ControllerAgentTagKind, names.UserTagKind, names.ApplicationTagKind})
+	containerExecHandler := newContainerExecHandler(httpCtxt, srv.authenticator)
 	pubsubHandler := newPubSubHandler(httpCtxt, srv.shared.centralHub)
 	logSinkHandler := logsink.NewHTTPHandler(
 		newAgentLogWriteCloserFunc(httpCtxt, srv.logSinkWriter, &srv.apiServerLoggers),
@@ -821,6 +851,13 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		// The authentication is handled within the debugLogHandler in order
 		// for discharge required errors to be handled correctly.
 		unauthenticated: true,
+	}, {
+		pattern: modelRoutePrefix + "/containers/exec",
+		handler: containerExecHandler,
+		tracked: true,
+		// The authentication is handled within the containerExecHandler
+		// so that failures can be reported over the websocket.
+		unauthenticated: true,
 	}, {
 		pattern:    modelRoutePrefix + "/logsink",
 		handler:    logSinkHandler,
@@ -1066,6 +1103,7 @@ func (srv *Server) serveConn(
 	recorderFactory := observer.NewRecorderFactory(
 		apiObserver, nil, observer.NoCaptureArgs)
 	conn := rpc.NewConn(codec, recorderFactory)
+	conn.ServePriorities(srv.rpcScheduler, requestPriority)
 
 	// Note that we don't overwrite modelUUID here because
 	// newAPIHandler treats an empty modelUUID as signifying
@@ -39,6 +39,9 @@ const (
 
 	// MetricLabelVersion is the metric for the Juju Version of the controller
 	MetricLabelVersion = "version"
+
+	// MetricLabelClass defines a constant for the RPCQueueDepth Label
+	MetricLabelClass = "class"
 )
 
 // MetricAPIConnectionsLabelNames defines a series of labels for the
@@ -76,6 +79,12 @@ var MetricTotalRequestsLabelNames = []string{
 	MetricLabelHost,
 }
 
+// MetricRPCQueueDepthLabelNames defines a series of labels for the
+// RPCQueueDepth metric.
+var MetricRPCQueueDepthLabelNames = []string{
+	MetricLabelClass,
+}
+
 // Collector is a prometheus.Collector that collects metrics based
 // on apiserver status.
 type Collector struct {
@@ -94,6 +103,8 @@ type Collector struct {
 	TotalRequests         *prometheus.CounterVec
 	TotalRequestErrors    *prometheus.CounterVec
 	TotalRequestsDuration *prometheus.SummaryVec
+
+	RPCQueueDepth *prometheus.GaugeVec
 }
 
 // NewMetricsCollector returns a new Collector.
@@ -193,6 +204,12 @@ func NewMetricsCollector() *Collector {
 				0.99: 0.001,
 			},
 		}, MetricTotalRequestsLabelNames),
+		RPCQueueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "rpc_queue_depth",
+			Help:      "Current number of RPC requests queued per priority class",
+		}, MetricRPCQueueDepthLabelNames),
 		BuildInfo: buildInfo,
 	}
 }
@@ -209,6 +226,7 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.TotalRequests.Describe(ch)
 	c.TotalRequestErrors.Describe(ch)
 	c.TotalRequestsDuration.Describe(ch)
+	c.RPCQueueDepth.Describe(ch)
 	c.BuildInfo.Describe(ch)
 }
 
@@ -224,5 +242,6 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.TotalRequests.Collect(ch)
 	c.TotalRequestErrors.Collect(ch)
 	c.TotalRequestsDuration.Collect(ch)
+	c.RPCQueueDepth.Collect(ch)
 	c.BuildInfo.Collect(ch)
 }
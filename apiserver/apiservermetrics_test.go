@@ -38,7 +38,7 @@ func (s *apiservermetricsSuite) TestDescribe(c *gc.C) {
 	for desc := range ch {
 		descs = append(descs, desc)
 	}
-	c.Assert(descs, gc.HasLen, 11)
+	c.Assert(descs, gc.HasLen, 12)
 	c.Assert(descs[0].String(), gc.Matches, `.*fqName: "juju_apiserver_connections_total".*`)
 	c.Assert(descs[1].String(), gc.Matches, `.*fqName: "juju_apiserver_connections".*`)
 	c.Assert(descs[2].String(), gc.Matches, `.*fqName: "juju_apiserver_active_login_attempts".*`)
@@ -50,7 +50,8 @@ func (s *apiservermetricsSuite) TestDescribe(c *gc.C) {
 	c.Assert(descs[7].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_requests_total".*`)
 	c.Assert(descs[8].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_errors_total".*`)
 	c.Assert(descs[9].String(), gc.Matches, `.*fqName: "juju_apiserver_outbound_request_duration_seconds".*`)
-	build_info_description := descs[10].String()
+	c.Assert(descs[10].String(), gc.Matches, `.*fqName: "juju_apiserver_rpc_queue_depth".*`)
+	build_info_description := descs[11].String()
 	c.Check(build_info_description, gc.Matches, `.*fqName: "juju_apiserver_build_info".*`)
 	// Ensure that the current version of the Juju controller is one of the const labels on the
 	//build_info metric.
@@ -106,6 +107,11 @@ func (s *apiservermetricsSuite) TestLabelNames(c *gc.C) {
 			labels:  apiserver.MetricTotalRequestsLabelNames,
 			checker: jc.IsTrue,
 		},
+		{
+			name:    "rpc queue depth label names",
+			labels:  apiserver.MetricRPCQueueDepthLabelNames,
+			checker: jc.IsTrue,
+		},
 		{
 			name:    "invalid names",
 			labels:  []string{"model-uuid"},
@@ -4,14 +4,18 @@
 package charms
 
 import (
+	"strings"
+
 	"github.com/juju/charm/v8"
 	"github.com/juju/charm/v8/resource"
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/permission"
+	coreseries "github.com/juju/juju/core/series"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -173,7 +177,21 @@ func convertCharmMeta(meta *charm.Meta) *params.CharmMeta {
 		MinJujuVersion: meta.MinJujuVersion.String(),
 		Containers:     convertCharmContainers(meta.Containers),
 		AssumesExpr:    meta.Assumes,
+
+		SupportedOSFamilies: convertSupportedOSFamilies(meta.Series),
+	}
+}
+
+func convertSupportedOSFamilies(seriesList []string) []string {
+	families := set.NewStrings()
+	for _, s := range seriesList {
+		osType, err := coreseries.GetOSFromSeries(s)
+		if err != nil {
+			continue
+		}
+		families.Add(strings.ToLower(osType.String()))
 	}
+	return families.SortedValues()
 }
 
 func convertCharmManifest(manifest *charm.Manifest) *params.CharmManifest {
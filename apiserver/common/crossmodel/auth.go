@@ -28,6 +28,7 @@ const (
 	offeruuidKey   = "offer-uuid"
 	sourcemodelKey = "source-model-uuid"
 	relationKey    = "relation-key"
+	issuedatKey    = "issued-at"
 
 	offerPermissionCaveat = "has-offer-permission"
 
@@ -150,6 +151,7 @@ func (a *AuthContext) CheckLocalAccessRequest(details *offerPermissionCheck) ([]
 		checkers.DeclaredCaveat(sourcemodelKey, details.SourceModelUUID),
 		checkers.DeclaredCaveat(offeruuidKey, details.OfferUUID),
 		checkers.DeclaredCaveat(usernameKey, details.User),
+		checkers.DeclaredCaveat(issuedatKey, a.clock.Now().UTC().Format(time.RFC3339)),
 		checkers.TimeBeforeCaveat(a.clock.Now().Add(localOfferPermissionExpiryTime)),
 	}
 	if details.Relation != "" {
@@ -234,6 +236,7 @@ func (a *AuthContext) CreateConsumeOfferMacaroon(ctx context.Context, offer *par
 			checkers.DeclaredCaveat(sourcemodelKey, sourceModelTag.Id()),
 			checkers.DeclaredCaveat(offeruuidKey, offer.OfferUUID),
 			checkers.DeclaredCaveat(usernameKey, username),
+			checkers.DeclaredCaveat(issuedatKey, a.clock.Now().UTC().Format(time.RFC3339)),
 		}, crossModelConsumeOp(offer.OfferUUID))
 }
 
@@ -254,6 +257,7 @@ func (a *AuthContext) CreateRemoteRelationMacaroon(ctx context.Context, sourceMo
 			checkers.DeclaredCaveat(offeruuidKey, offerUUID),
 			checkers.DeclaredCaveat(usernameKey, username),
 			checkers.DeclaredCaveat(relationKey, rel.Id()),
+			checkers.DeclaredCaveat(issuedatKey, a.clock.Now().UTC().Format(time.RFC3339)),
 		}, crossModelRelateOp(rel.Id()))
 
 	return offerMacaroon, err
@@ -335,6 +339,30 @@ func (a *authenticator) checkMacaroonCaveats(op bakery.Op, relationId, offerUUID
 	return nil
 }
 
+// checkMacaroonRotation ensures that the macaroon was issued after the
+// offer's macaroons were last rotated. Macaroons minted before a rotation
+// fail with a verification error so that the consuming side gracefully
+// obtains a fresh discharge.
+func (a *authenticator) checkMacaroonRotation(declared map[string]string) error {
+	st, releaser, err := a.ctxt.pool.Get(a.sourceModelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer releaser()
+	rotatedAt, err := st.OfferMacaroonsRotatedAt(a.offerUUID)
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	if rotatedAt.IsZero() {
+		return nil
+	}
+	issuedAt, err := time.Parse(time.RFC3339, declared[issuedatKey])
+	if err != nil || issuedAt.Before(rotatedAt) {
+		return &bakery.VerificationError{Reason: errors.New("offer macaroons have been rotated")}
+	}
+	return nil
+}
+
 func (a *authenticator) checkMacaroons(
 	ctx context.Context, mac macaroon.Slice, version bakery.Version, requiredValues map[string]string, op bakery.Op,
 ) (map[string]string, error) {
@@ -359,6 +387,9 @@ func (a *authenticator) checkMacaroons(
 	ai, err := auth.Allow(ctx, op)
 	if err == nil && len(ai.Conditions()) > 0 {
 		if err = a.checkMacaroonCaveats(op, relation, offer); err == nil {
+			err = a.checkMacaroonRotation(declared)
+		}
+		if err == nil {
 			authlogger.Debugf("ok macaroon check ok, attr: %v, conditions: %v", declared, ai.Conditions())
 			return declared, nil
 		}
@@ -382,7 +413,7 @@ func (a *authenticator) checkMacaroons(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	keys := []string{usernameKey}
+	keys := []string{usernameKey, issuedatKey}
 	for k := range requiredValues {
 		keys = append(keys, k)
 	}
@@ -133,12 +133,13 @@ permission: consume
 	c.Assert(err, jc.ErrorIsNil)
 	cav, err := s.authContext.CheckLocalAccessRequest(opc)
 	c.Assert(err, jc.ErrorIsNil)
-	c.Assert(cav, gc.HasLen, 5)
+	c.Assert(cav, gc.HasLen, 6)
 	c.Assert(cav[0].Condition, gc.Equals, "declared source-model-uuid "+uuid.String())
 	c.Assert(cav[1].Condition, gc.Equals, "declared offer-uuid mysql-uuid")
 	c.Assert(cav[2].Condition, gc.Equals, "declared username mary")
-	c.Assert(strings.HasPrefix(cav[3].Condition, "time-before"), jc.IsTrue)
-	c.Assert(cav[4].Condition, gc.Equals, "declared relation-key mediawiki:db mysql:server")
+	c.Assert(strings.HasPrefix(cav[3].Condition, "declared issued-at"), jc.IsTrue)
+	c.Assert(strings.HasPrefix(cav[4].Condition, "time-before"), jc.IsTrue)
+	c.Assert(cav[5].Condition, gc.Equals, "declared relation-key mediawiki:db mysql:server")
 }
 
 func (s *authSuite) TestCheckLocalAccessRequestControllerAdmin(c *gc.C) {
@@ -213,11 +214,12 @@ func (s *authSuite) TestCreateConsumeOfferMacaroon(c *gc.C) {
 	mac, err := s.authContext.CreateConsumeOfferMacaroon(context.TODO(), offer, "mary", bakery.LatestVersion)
 	c.Assert(err, jc.ErrorIsNil)
 	cav := mac.M().Caveats()
-	c.Assert(cav, gc.HasLen, 4)
+	c.Assert(cav, gc.HasLen, 5)
 	c.Assert(bytes.HasPrefix(cav[0].Id, []byte("time-before")), jc.IsTrue)
 	c.Assert(cav[1].Id, jc.DeepEquals, []byte("declared source-model-uuid "+coretesting.ModelTag.Id()))
 	c.Assert(cav[2].Id, jc.DeepEquals, []byte("declared offer-uuid mysql-uuid"))
 	c.Assert(cav[3].Id, jc.DeepEquals, []byte("declared username mary"))
+	c.Assert(bytes.HasPrefix(cav[4].Id, []byte("declared issued-at")), jc.IsTrue)
 }
 
 func (s *authSuite) TestCreateRemoteRelationMacaroon(c *gc.C) {
@@ -226,15 +228,17 @@ func (s *authSuite) TestCreateRemoteRelationMacaroon(c *gc.C) {
 		coretesting.ModelTag.Id(), "mysql-uuid", "mary", names.NewRelationTag("mediawiki:db mysql:server"), bakery.LatestVersion)
 	c.Assert(err, jc.ErrorIsNil)
 	cav := mac.M().Caveats()
-	c.Assert(cav, gc.HasLen, 5)
+	c.Assert(cav, gc.HasLen, 6)
 	c.Assert(bytes.HasPrefix(cav[0].Id, []byte("time-before")), jc.IsTrue)
 	c.Assert(cav[1].Id, jc.DeepEquals, []byte("declared source-model-uuid "+coretesting.ModelTag.Id()))
 	c.Assert(cav[2].Id, jc.DeepEquals, []byte("declared offer-uuid mysql-uuid"))
 	c.Assert(cav[3].Id, jc.DeepEquals, []byte("declared username mary"))
 	c.Assert(cav[4].Id, jc.DeepEquals, []byte("declared relation-key mediawiki:db mysql:server"))
+	c.Assert(bytes.HasPrefix(cav[5].Id, []byte("declared issued-at")), jc.IsTrue)
 }
 
 func (s *authSuite) TestCheckOfferMacaroons(c *gc.C) {
+	s.mockStatePool.st[coretesting.ModelTag.Id()] = &mockState{tag: coretesting.ModelTag}
 	mac, err := s.bakery.NewMacaroon(
 		context.TODO(),
 		bakery.LatestVersion,
@@ -261,6 +265,64 @@ func (s *authSuite) TestCheckOfferMacaroons(c *gc.C) {
 	})
 }
 
+func (s *authSuite) TestCheckOfferMacaroonsIssuedBeforeRotation(c *gc.C) {
+	s.mockStatePool.st[coretesting.ModelTag.Id()] = &mockState{
+		tag:                coretesting.ModelTag,
+		macaroonsRotatedAt: time.Now(),
+	}
+	mac, err := s.bakery.NewMacaroon(
+		context.TODO(),
+		bakery.LatestVersion,
+		[]checkers.Caveat{
+			checkers.DeclaredCaveat("username", "mary"),
+			checkers.DeclaredCaveat("offer-uuid", "mysql-uuid"),
+			checkers.DeclaredCaveat("source-model-uuid", coretesting.ModelTag.Id()),
+			checkers.DeclaredCaveat("issued-at", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)),
+		}, bakery.Op{"consume", "mysql-uuid"})
+
+	c.Assert(err, jc.ErrorIsNil)
+	authContext := s.authContext.WithDischargeURL("http://thirdparty")
+	_, err = authContext.Authenticator(
+		coretesting.ModelTag.Id(), "mysql-uuid").CheckOfferMacaroons(
+		context.TODO(),
+		"mysql-uuid",
+		macaroon.Slice{mac.M()},
+		bakery.LatestVersion,
+	)
+	dischargeErr, ok := err.(*apiservererrors.DischargeRequiredError)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(dischargeErr.Cause, gc.ErrorMatches, ".*offer macaroons have been rotated")
+	cav := dischargeErr.LegacyMacaroon.Caveats()
+	c.Assert(cav, gc.HasLen, 2)
+	c.Assert(cav[0].Location, gc.Equals, "http://thirdparty")
+}
+
+func (s *authSuite) TestCheckOfferMacaroonsIssuedAfterRotation(c *gc.C) {
+	s.mockStatePool.st[coretesting.ModelTag.Id()] = &mockState{
+		tag:                coretesting.ModelTag,
+		macaroonsRotatedAt: time.Now().Add(-time.Hour),
+	}
+	mac, err := s.bakery.NewMacaroon(
+		context.TODO(),
+		bakery.LatestVersion,
+		[]checkers.Caveat{
+			checkers.DeclaredCaveat("username", "mary"),
+			checkers.DeclaredCaveat("offer-uuid", "mysql-uuid"),
+			checkers.DeclaredCaveat("source-model-uuid", coretesting.ModelTag.Id()),
+			checkers.DeclaredCaveat("issued-at", time.Now().UTC().Format(time.RFC3339)),
+		}, bakery.Op{"consume", "mysql-uuid"})
+
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.authContext.Authenticator(
+		coretesting.ModelTag.Id(), "mysql-uuid").CheckOfferMacaroons(
+		context.TODO(),
+		"mysql-uuid",
+		macaroon.Slice{mac.M()},
+		bakery.LatestVersion,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *authSuite) TestCheckOfferMacaroonsWrongOffer(c *gc.C) {
 	mac, err := s.bakery.NewMacaroon(
 		context.TODO(),
@@ -331,6 +393,7 @@ func (s *authSuite) TestCheckOfferMacaroonsDischargeRequired(c *gc.C) {
 }
 
 func (s *authSuite) TestCheckRelationMacaroons(c *gc.C) {
+	s.mockStatePool.st[coretesting.ModelTag.Id()] = &mockState{tag: coretesting.ModelTag}
 	relationTag := names.NewRelationTag("mediawiki:db mysql:server")
 	mac, err := s.bakery.NewMacaroon(
 		context.TODO(),
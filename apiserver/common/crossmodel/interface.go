@@ -86,6 +86,11 @@ type Backend interface {
 	// ApplicationOfferForUUID returns the application offer for the UUID.
 	ApplicationOfferForUUID(offerUUID string) (*crossmodel.ApplicationOffer, error)
 
+	// OfferMacaroonsRotatedAt returns the time before which macaroons
+	// issued for the offer are considered invalid. A zero time is
+	// returned if the offer's macaroons have never been rotated.
+	OfferMacaroonsRotatedAt(offerUUID string) (time.Time, error)
+
 	// WatchOfferStatus returns a watcher that notifies of changes to the status
 	// of the offer.
 	WatchOfferStatus(offerUUID string) (state.NotifyWatcher, error)
@@ -50,14 +50,19 @@ func (st *mockStatePool) Get(modelUUID string) (crossmodel.Backend, func(), erro
 
 type mockState struct {
 	crossmodel.Backend
-	tag         names.ModelTag
-	permissions map[string]permission.Access
+	tag                names.ModelTag
+	permissions        map[string]permission.Access
+	macaroonsRotatedAt time.Time
 }
 
 func (m *mockState) ApplicationOfferForUUID(offerUUID string) (*jujucrossmodel.ApplicationOffer, error) {
 	return &jujucrossmodel.ApplicationOffer{OfferUUID: offerUUID}, nil
 }
 
+func (m *mockState) OfferMacaroonsRotatedAt(offerUUID string) (time.Time, error) {
+	return m.macaroonsRotatedAt, nil
+}
+
 func (m *mockState) UserPermission(subject names.UserTag, target names.Tag) (permission.Access, error) {
 	perm, ok := m.permissions[target.Id()+":"+subject.Id()]
 	if !ok {
@@ -68,3 +68,24 @@ func InstanceTypes(cons instanceTypeConstraints) (params.InstanceTypesResult, er
 		CostDivisor:   instanceTypes.CostDivisor,
 	}, nil
 }
+
+// RecommendInstanceTypes returns the candidate instance types for the
+// passed constraints in the given region, selected the same way
+// provisioning selects them: filtered by the constraints and sorted by
+// increasing region-specific cost.
+func RecommendInstanceTypes(cons instanceTypeConstraints, region string) (params.InstanceTypesResult, error) {
+	instanceTypes, err := cons.environ.InstanceTypes(cons.context, constraints.Value{})
+	if err != nil {
+		return params.InstanceTypesResult{}, errors.Trace(err)
+	}
+	matching, err := instances.MatchingInstanceTypes(instanceTypes.InstanceTypes, region, cons.constraints)
+	if err != nil {
+		return params.InstanceTypesResult{}, errors.Trace(err)
+	}
+	return params.InstanceTypesResult{
+		InstanceTypes: toParamsInstanceTypeResult(matching),
+		CostUnit:      instanceTypes.CostUnit,
+		CostCurrency:  instanceTypes.CostCurrency,
+		CostDivisor:   instanceTypes.CostDivisor,
+	}, nil
+}
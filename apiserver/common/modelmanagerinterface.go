@@ -14,6 +14,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
@@ -110,6 +111,8 @@ type Model interface {
 	SLALevel() string
 	SLAOwner() string
 	MigrationMode() state.MigrationMode
+	FreezeMode() coremodel.FreezeMode
+	SetFreezeMode(coremodel.FreezeMode) error
 	Name() string
 	UUID() string
 	ControllerUUID() string
@@ -0,0 +1,31 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/policy"
+)
+
+// CheckPolicy evaluates the policy checks registered in the controller
+// configuration against the given operation. It returns a policy
+// violation error if a check rejects the operation, nil if all checks
+// pass or none are registered.
+func CheckPolicy(cfg controller.Config, check policy.Check) error {
+	var checkers []policy.Checker
+	if rules := cfg.PolicyRules(); len(rules) > 0 {
+		checkers = append(checkers, policy.NewRulesChecker(rules))
+	}
+	if url := cfg.PolicyWebhookURL(); url != "" {
+		checkers = append(checkers, policy.NewWebhookChecker(url))
+	}
+	for _, checker := range checkers {
+		if err := checker.Check(check); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
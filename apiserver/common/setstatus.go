@@ -104,6 +104,11 @@ func (s *ApplicationStatusSetter) SetStatus(args params.SetStatus) (params.Error
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
+		if err := status.ValidateStatusReasonData(status.Status(arg.Status), arg.Data); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
 		// TODO(perrito666) 2016-05-02 lp:1558657
 		now := time.Now()
 		sInfo := status.StatusInfo{
@@ -146,6 +151,9 @@ func (s *StatusSetter) setEntityStatus(tag names.Tag, entityStatus status.Status
 	case *state.Application:
 		return apiservererrors.ErrPerm
 	case status.StatusSetter:
+		if err := status.ValidateStatusReasonData(entityStatus, data); err != nil {
+			return err
+		}
 		sInfo := status.StatusInfo{
 			Status:  entityStatus,
 			Message: info,
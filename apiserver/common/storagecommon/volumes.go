@@ -49,6 +49,10 @@ func VolumeParams(
 	if err != nil {
 		return params.VolumeParams{}, errors.Trace(err)
 	}
+	var requireEncrypted bool
+	if environConfig != nil {
+		requireEncrypted = environConfig.RequireEncryptedVolumes()
+	}
 	return params.VolumeParams{
 		v.Tag().String(),
 		size,
@@ -56,6 +60,7 @@ func VolumeParams(
 		cfg.Attrs(),
 		volumeTags,
 		nil, // attachment params set by the caller
+		requireEncrypted,
 	}, nil
 }
 
@@ -110,6 +115,7 @@ func VolumeToState(v params.Volume) (names.VolumeTag, state.VolumeInfo, error) {
 		"", // pool is set by state
 		v.Info.VolumeId,
 		v.Info.Persistent,
+		v.Info.Encrypted,
 	}, nil
 }
 
@@ -134,6 +140,7 @@ func VolumeInfoFromState(info state.VolumeInfo) params.VolumeInfo {
 		info.Pool,
 		info.Size,
 		info.Persistent,
+		info.Encrypted,
 	}
 }
 
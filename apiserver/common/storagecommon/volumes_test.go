@@ -61,6 +61,34 @@ func (*volumesSuite) testVolumeParams(c *gc.C, volumeParams *state.VolumeParams,
 	})
 }
 
+func (*volumesSuite) TestVolumeParamsRequireEncrypted(c *gc.C) {
+	tag := names.NewVolumeTag("100")
+	p, err := storagecommon.VolumeParams(
+		&fakeVolume{tag: tag, params: &state.VolumeParams{
+			Pool: "loop", Size: 1024,
+		}},
+		nil, // StorageInstance
+		testing.ModelTag.Id(),
+		testing.ControllerTag.Id(),
+		testing.CustomModelConfig(c, testing.Attrs{
+			"require-encrypted-volumes": true,
+		}),
+		&fakePoolManager{},
+		provider.CommonStorageProviders(),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, jc.DeepEquals, params.VolumeParams{
+		VolumeTag: "volume-100",
+		Provider:  "loop",
+		Size:      1024,
+		Tags: map[string]string{
+			tags.JujuController: testing.ControllerTag.Id(),
+			tags.JujuModel:      testing.ModelTag.Id(),
+		},
+		RequireEncrypted: true,
+	})
+}
+
 func (*volumesSuite) TestVolumeParamsStorageTags(c *gc.C) {
 	volumeTag := names.NewVolumeTag("100")
 	storageTag := names.NewStorageTag("mystore/0")
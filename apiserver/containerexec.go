@@ -0,0 +1,270 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	gorillaws "github.com/gorilla/websocket"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/apiserver/websocket"
+	k8sexec "github.com/juju/juju/caas/kubernetes/provider/exec"
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/stateenvirons"
+)
+
+// containerExecHandler takes requests to run a command inside a CAAS
+// workload container, streaming stdin and output over the websocket.
+// It parallels juju ssh for IAAS models, except that the kubernetes
+// credential never leaves the controller, so clients do not need a
+// kubeconfig of their own.
+type containerExecHandler struct {
+	ctxt          httpContext
+	authenticator httpcontext.Authenticator
+
+	// execClientGetter is patched out in tests; in production it
+	// dials the cluster with the model's stored credential.
+	execClientGetter func(namespace string, spec cloudspec.CloudSpec) (k8sexec.Executor, error)
+}
+
+func newContainerExecHandler(ctxt httpContext, authenticator httpcontext.Authenticator) *containerExecHandler {
+	return &containerExecHandler{
+		ctxt:             ctxt,
+		authenticator:    authenticator,
+		execClientGetter: k8sexec.NewForJujuCloudSpec,
+	}
+}
+
+// containerExecParams holds the arguments of a container exec request.
+type containerExecParams struct {
+	unit      string
+	container string
+	commands  []string
+	tty       bool
+}
+
+// readContainerExecParams parses the query args of an exec request:
+//
+//	unit -> string - the tag of the unit to exec into (required)
+//	container -> string - the workload container name; if empty, the
+//	   cluster's default container for the pod is used
+//	command -> []string - the command and its arguments (required)
+//	tty -> bool - allocate a pseudo-terminal
+func readContainerExecParams(queryMap url.Values) (*containerExecParams, error) {
+	tag, err := names.ParseUnitTag(queryMap.Get("unit"))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p := &containerExecParams{
+		unit:      tag.Id(),
+		container: queryMap.Get("container"),
+		commands:  queryMap["command"],
+	}
+	if len(p.commands) == 0 {
+		return nil, errors.NotValidf("empty command")
+	}
+	if value := queryMap.Get("tty"); value != "" {
+		if p.tty, err = strconv.ParseBool(value); err != nil {
+			return nil, errors.NotValidf("tty value %q", value)
+		}
+	}
+	return p, nil
+}
+
+// ServeHTTP serves the container exec endpoint as a websocket.
+//
+// As with the debug-log endpoint, authentication and authorization are
+// done after the connection has been upgraded so that failures can be
+// reported in the initial error result read by the client.
+func (h *containerExecHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	handler := func(conn *websocket.Conn) {
+		defer conn.Close()
+		if err := h.serve(conn, req); err != nil {
+			if sendErr := conn.SendInitialErrorV0(err); sendErr != nil {
+				logger.Errorf("cannot send error to container exec client: %v", sendErr)
+			}
+		}
+	}
+	websocket.Serve(w, req, handler)
+}
+
+// serve runs a single exec request. Any error returned is sent to the
+// client as the initial error result; once the handshake has been sent
+// the command result is reported in a final error frame instead.
+func (h *containerExecHandler) serve(conn *websocket.Conn, req *http.Request) error {
+	authInfo, err := h.authenticator.Authenticate(req)
+	if err != nil {
+		return errors.Annotate(err, "authentication failed")
+	}
+	userTag, ok := authInfo.Entity.Tag().(names.UserTag)
+	if !ok {
+		return apiservererrors.ErrPerm
+	}
+
+	st, err := h.ctxt.stateForRequestUnauthenticated(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer st.Release()
+
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if model.Type() != state.ModelTypeCAAS {
+		return errors.NotSupportedf("exec into model %q, which is not a kubernetes model", model.Name())
+	}
+	if err := h.checkPermission(st.State, model, userTag); err != nil {
+		return errors.Trace(err)
+	}
+
+	p, err := readContainerExecParams(req.URL.Query())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	unit, err := st.Unit(p.unit)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	container, err := unit.ContainerInfo()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	podName := container.ProviderId()
+	if podName == "" {
+		return errors.NotProvisionedf("unit %q", p.unit)
+	}
+
+	if err := h.recordAudit(userTag, model, p); err != nil {
+		return errors.Trace(err)
+	}
+
+	spec, err := stateenvirons.CloudSpecForModel(model)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	execClient, err := h.execClientGetter(model.Name(), spec)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := conn.SendInitialErrorV0(nil); err != nil {
+		// The connection is broken; there is no-one to stream to.
+		return nil
+	}
+	output := &websocketContentWriter{conn: conn}
+	execErr := execClient.Exec(k8sexec.ExecParams{
+		Commands:      p.commands,
+		PodName:       podName,
+		ContainerName: p.container,
+		Stdin:         &websocketContentReader{conn: conn},
+		Stdout:        output,
+		Stderr:        output,
+		TTY:           p.tty,
+	}, h.ctxt.stop())
+	if err := conn.SendInitialErrorV0(execErr); err != nil {
+		logger.Errorf("cannot send exec result to container exec client: %v", err)
+	}
+	return nil
+}
+
+// checkPermission ensures the user is a model admin or a controller
+// superuser before letting them exec into the model's containers.
+func (h *containerExecHandler) checkPermission(st *state.State, model *state.Model, user names.UserTag) error {
+	admin, err := common.HasPermission(st.UserPermission, user, permission.AdminAccess, model.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if admin {
+		return nil
+	}
+	superuser, err := common.HasPermission(st.UserPermission, user, permission.SuperuserAccess, model.ControllerTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !superuser {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// recordAudit writes a conversation and request record for the exec to
+// the controller's audit log, when auditing is enabled.
+func (h *containerExecHandler) recordAudit(user names.UserTag, model *state.Model, p *containerExecParams) error {
+	cfg := h.ctxt.srv.GetAuditConfig()
+	if !cfg.Enabled {
+		return nil
+	}
+	recorder, err := auditlog.NewRecorder(cfg.Target, h.ctxt.srv.clock, auditlog.ConversationArgs{
+		Who:          user.Id(),
+		What:         fmt.Sprintf("exec into container %q of unit %q", p.container, p.unit),
+		ModelName:    model.Name(),
+		ModelUUID:    model.UUID(),
+		ConnectionID: atomic.AddUint64(&h.ctxt.srv.lastConnectionID, 1),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(recorder.AddRequest(auditlog.RequestArgs{
+		Facade:    "ContainerExec",
+		Method:    "Exec",
+		Args:      strings.Join(p.commands, " "),
+		RequestID: 1,
+	}))
+}
+
+// websocketContentReader adapts incoming websocket messages to the
+// io.Reader the exec client expects for stdin.
+type websocketContentReader struct {
+	conn *websocket.Conn
+	buf  []byte
+}
+
+// Read implements io.Reader.
+func (r *websocketContentReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		_, data, err := r.conn.ReadMessage()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// websocketContentWriter adapts the exec client's output streams to
+// binary websocket messages. The same writer is shared between stdout
+// and stderr, which the exec client copies in separate goroutines, so
+// writes are serialised: gorilla/websocket does not allow concurrent
+// writers on one connection.
+type websocketContentWriter struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+// Write implements io.Writer.
+func (w *websocketContentWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.conn.WriteMessage(gorillaws.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gorillaws "github.com/gorilla/websocket"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/websocket"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type containerExecIntSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&containerExecIntSuite{})
+
+func (s *containerExecIntSuite) TestReadParams(c *gc.C) {
+	p, err := readContainerExecParams(url.Values{
+		"unit":      []string{"unit-mariadb-0"},
+		"container": []string{"mariadb"},
+		"command":   []string{"ls", "-la"},
+		"tty":       []string{"true"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, jc.DeepEquals, &containerExecParams{
+		unit:      "mariadb/0",
+		container: "mariadb",
+		commands:  []string{"ls", "-la"},
+		tty:       true,
+	})
+}
+
+func (s *containerExecIntSuite) TestReadParamsDefaults(c *gc.C) {
+	p, err := readContainerExecParams(url.Values{
+		"unit":    []string{"unit-mariadb-0"},
+		"command": []string{"uptime"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, jc.DeepEquals, &containerExecParams{
+		unit:     "mariadb/0",
+		commands: []string{"uptime"},
+	})
+}
+
+func (s *containerExecIntSuite) TestReadParamsInvalidUnit(c *gc.C) {
+	_, err := readContainerExecParams(url.Values{
+		"unit":    []string{"machine-0"},
+		"command": []string{"uptime"},
+	})
+	c.Assert(err, gc.ErrorMatches, `"machine-0" is not a valid unit tag`)
+}
+
+func (s *containerExecIntSuite) TestReadParamsMissingCommand(c *gc.C) {
+	_, err := readContainerExecParams(url.Values{
+		"unit": []string{"unit-mariadb-0"},
+	})
+	c.Assert(err, gc.ErrorMatches, "empty command not valid")
+}
+
+func (s *containerExecIntSuite) TestReadParamsBadTTY(c *gc.C) {
+	_, err := readContainerExecParams(url.Values{
+		"unit":    []string{"unit-mariadb-0"},
+		"command": []string{"uptime"},
+		"tty":     []string{"sideways"},
+	})
+	c.Assert(err, gc.ErrorMatches, `tty value "sideways" not valid`)
+}
+
+// dialContentStreams upgrades a test connection and hands the server
+// side to the given function, returning the client side of the socket.
+func (s *containerExecIntSuite) dialContentStreams(c *gc.C, serve func(*websocket.Conn)) *gorillaws.Conn {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		websocket.Serve(w, req, serve)
+	}))
+	s.AddCleanup(func(*gc.C) { srv.Close() })
+	client, _, err := gorillaws.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddCleanup(func(*gc.C) { _ = client.Close() })
+	return client
+}
+
+func (s *containerExecIntSuite) TestContentWriterConcurrent(c *gc.C) {
+	// Stdout and stderr share one writer and are copied by the exec
+	// client in separate goroutines; interleaved output must not
+	// trip gorilla's concurrent write protection.
+	const messages = 50
+	client := s.dialContentStreams(c, func(conn *websocket.Conn) {
+		defer conn.Close()
+		writer := &websocketContentWriter{conn: conn}
+		var wg sync.WaitGroup
+		for _, stream := range []string{"stdout", "stderr"} {
+			wg.Add(1)
+			go func(stream string) {
+				defer wg.Done()
+				for i := 0; i < messages; i++ {
+					_, err := writer.Write([]byte(stream))
+					c.Check(err, jc.ErrorIsNil)
+				}
+			}(stream)
+		}
+		wg.Wait()
+	})
+
+	counts := make(map[string]int)
+	for i := 0; i < 2*messages; i++ {
+		_, data, err := client.ReadMessage()
+		c.Assert(err, jc.ErrorIsNil)
+		counts[string(data)]++
+	}
+	c.Assert(counts, jc.DeepEquals, map[string]int{
+		"stdout": messages,
+		"stderr": messages,
+	})
+}
+
+func (s *containerExecIntSuite) TestContentReaderBuffers(c *gc.C) {
+	read := make(chan string, 1)
+	client := s.dialContentStreams(c, func(conn *websocket.Conn) {
+		defer conn.Close()
+		reader := &websocketContentReader{conn: conn}
+		var out []byte
+		buf := make([]byte, 4)
+		for len(out) < len("hello world") {
+			n, err := reader.Read(buf)
+			if !c.Check(err, jc.ErrorIsNil) {
+				break
+			}
+			out = append(out, buf[:n]...)
+		}
+		read <- string(out)
+	})
+
+	err := client.WriteMessage(gorillaws.BinaryMessage, []byte("hello "))
+	c.Assert(err, jc.ErrorIsNil)
+	err = client.WriteMessage(gorillaws.BinaryMessage, []byte("world"))
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case content := <-read:
+		c.Assert(content, gc.Equals, "hello world")
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for the reader")
+	}
+}
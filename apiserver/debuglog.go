@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"syscall"
 	"time"
@@ -86,6 +87,9 @@ func newDebugLogHandler(
 //	replay -> string - one of [true, false], if true, start the file from the start
 //	noTail -> string - one of [true, false], if true, existing logs are sent back,
 //	   - but the command does not wait for new ones.
+//	regex -> string - a regular expression; only lines whose message matches
+//	   - are sent back
+//	maxRate -> uint - cap output at this many lines per second, 0 for no cap
 func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	handler := func(conn *websocket.Conn) {
 		socket := &debugLogSocketImpl{conn}
@@ -193,6 +197,15 @@ type debugLogParams struct {
 	excludeModule []string
 	includeLabel  []string
 	excludeLabel  []string
+
+	// matchRegex, if non-nil, restricts output to records whose message
+	// matches the expression. Matching happens server-side so that the
+	// full log volume never crosses the wire.
+	matchRegex *regexp.Regexp
+
+	// maxRate caps the number of records sent per second; zero means
+	// uncapped.
+	maxRate uint
 }
 
 func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
@@ -260,5 +273,21 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.excludeLabel = label
 	}
 
+	if value := queryMap.Get("regex"); value != "" {
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return params, errors.Errorf("regex value %q is not a valid regular expression", value)
+		}
+		params.matchRegex = re
+	}
+
+	if value := queryMap.Get("maxRate"); value != "" {
+		num, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return params, errors.Errorf("maxRate value %q is not a valid unsigned number", value)
+		}
+		params.maxRate = uint(num)
+	}
+
 	return params, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/ratelimit"
 
 	"github.com/juju/juju/apiserver/httpcontext"
 	corelogger "github.com/juju/juju/core/logger"
@@ -44,6 +45,17 @@ func handleDebugLogDBRequest(
 
 	timeout := clock.After(maxDuration)
 
+	// When a rate cap is requested, records are metered through a token
+	// bucket so that a chatty model cannot saturate the connection.
+	var bucket *ratelimit.Bucket
+	if reqParams.maxRate > 0 {
+		bucket = ratelimit.NewBucketWithClock(
+			time.Second/time.Duration(reqParams.maxRate),
+			int64(reqParams.maxRate),
+			ratelimitClock{clock},
+		)
+	}
+
 	var lineCount uint
 	for {
 		select {
@@ -56,6 +68,22 @@ func handleDebugLogDBRequest(
 				return errors.Annotate(tailer.Err(), "tailer stopped")
 			}
 
+			if reqParams.matchRegex != nil && !reqParams.matchRegex.MatchString(rec.Message) {
+				continue
+			}
+
+			if bucket != nil {
+				if wait := bucket.Take(1); wait > 0 {
+					select {
+					case <-stop:
+						return nil
+					case <-timeout:
+						return nil
+					case <-clock.After(wait):
+					}
+				}
+			}
+
 			if err := socket.sendLogRecord(formatLogRecord(rec)); err != nil {
 				return errors.Annotate(err, "sending failed")
 			}
@@ -99,6 +127,16 @@ func formatLogRecord(r *corelogger.LogRecord) *params.LogMessage {
 	}
 }
 
+// ratelimitClock adapts clock.Clock to ratelimit.Clock.
+type ratelimitClock struct {
+	clock.Clock
+}
+
+// Sleep implements ratelimit.Clock.
+func (c ratelimitClock) Sleep(d time.Duration) {
+	<-c.Clock.After(d)
+}
+
 var newLogTailer = _newLogTailer // For replacing in tests
 
 func _newLogTailer(st state.LogTailerState, params state.LogTailerParams) (state.LogTailer, error) {
@@ -5,6 +5,7 @@ package apiserver
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/juju/clock/testclock"
@@ -219,6 +220,81 @@ func (s *debugLogDBIntSuite) TestMaxLines(c *gc.C) {
 	s.assertStops(c, done, tailer)
 }
 
+func (s *debugLogDBIntSuite) TestRegexFilter(c *gc.C) {
+	tailer := newFakeLogTailer()
+	tailer.logsCh <- &corelogger.LogRecord{
+		Time:     time.Date(2015, 6, 19, 15, 34, 37, 0, time.UTC),
+		Entity:   "machine-99",
+		Module:   "some.where",
+		Location: "code.go:42",
+		Level:    loggo.INFO,
+		Message:  "stuff happened",
+	}
+	tailer.logsCh <- &corelogger.LogRecord{
+		Time:     time.Date(2015, 6, 19, 15, 36, 40, 0, time.UTC),
+		Entity:   "unit-foo-2",
+		Module:   "else.where",
+		Location: "go.go:22",
+		Level:    loggo.ERROR,
+		Message:  "whoops",
+	}
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params state.LogTailerParams) (state.LogTailer, error) {
+		return tailer, nil
+	})
+
+	done := s.runRequest(debugLogParams{
+		maxLines:   1,
+		matchRegex: regexp.MustCompile("who+ps"),
+	}, nil)
+
+	// The non-matching record is dropped server-side and does not
+	// count towards the line limit.
+	s.assertOutput(c, []string{
+		"ok",
+		"unit-foo-2: 2015-06-19 15:36:40 ERROR else.where go.go:22 whoops\n",
+	})
+
+	s.assertStops(c, done, tailer)
+}
+
+func (s *debugLogDBIntSuite) TestMaxRate(c *gc.C) {
+	// Set up a fake log tailer with 3 log records ready to send.
+	tailer := newFakeLogTailer()
+	for i := 0; i < 3; i++ {
+		tailer.logsCh <- &corelogger.LogRecord{
+			Time:     time.Date(2015, 6, 19, 15, 34, 37, 0, time.UTC),
+			Entity:   "machine-99",
+			Module:   "some.where",
+			Location: "code.go:42",
+			Level:    loggo.INFO,
+			Message:  "stuff happened",
+		}
+	}
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params state.LogTailerParams) (state.LogTailer, error) {
+		return tailer, nil
+	})
+
+	done := s.runRequest(debugLogParams{maxLines: 3, maxRate: 2}, nil)
+
+	// The bucket starts full so the first two records flow straight
+	// through.
+	s.assertOutput(c, []string{
+		"ok",
+		"machine-99: 2015-06-19 15:34:37 INFO some.where code.go:42 stuff happened\n",
+		"machine-99: 2015-06-19 15:34:37 INFO some.where code.go:42 stuff happened\n",
+	})
+
+	// The third record is held back until a token is available. Two
+	// waiters: the maxDuration timeout and the rate limit wait.
+	err := s.clock.WaitAdvance(time.Second, coretesting.LongWait, 2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertOutput(c, []string{
+		"machine-99: 2015-06-19 15:34:37 INFO some.where code.go:42 stuff happened\n",
+	})
+	s.assertStops(c, done, tailer)
+}
+
 func (s *debugLogDBIntSuite) runRequest(params debugLogParams, stop chan struct{}) chan error {
 	done := make(chan error)
 	go func() {
@@ -0,0 +1,39 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/rpc/params"
+)
+
+// facadeDeprecations serves the deprecation matrix recorded in the
+// facade registry, so that clients can plan their migration away from
+// facade versions scheduled for removal.
+type facadeDeprecations struct {
+	registry *facade.Registry
+}
+
+// newFacadeDeprecations returns a facade that reports which facade
+// versions have been marked deprecated.
+func newFacadeDeprecations(registry *facade.Registry) *facadeDeprecations {
+	return &facadeDeprecations{registry: registry}
+}
+
+// List returns every facade version that has been marked deprecated,
+// along with the release in which it is expected to be removed.
+func (f *facadeDeprecations) List() (params.FacadeDeprecationResults, error) {
+	deprecations := f.registry.Deprecations()
+	result := params.FacadeDeprecationResults{
+		Deprecations: make([]params.FacadeDeprecation, len(deprecations)),
+	}
+	for i, d := range deprecations {
+		result.Deprecations[i] = params.FacadeDeprecation{
+			Name:          d.Name,
+			Version:       d.Version,
+			RemovalTarget: d.RemovalTarget,
+		}
+	}
+	return result, nil
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/juju/loggo"
 	"github.com/juju/txn/v2"
 
+	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/policy"
 	"github.com/juju/juju/rpc/params"
 	stateerrors "github.com/juju/juju/state/errors"
 )
@@ -211,6 +213,12 @@ func ServerError(err error) *params.Error {
 		code = params.CodeNotValid
 	case errors.Is(err, IncompatibleSeriesError), errors.Is(err, stateerrors.IncompatibleSeriesError):
 		code = params.CodeIncompatibleSeries
+	case errors.Is(err, UnsupportedPlatformError):
+		code = params.CodeUnsupportedPlatform
+	case crossmodel.IsInterfaceMismatchError(err):
+		code = params.CodeInterfaceMismatch
+	case policy.IsViolation(err):
+		code = params.CodePolicyViolation
 	case errors.As(err, &dischargeRequiredError):
 		code = params.CodeDischargeRequired
 		info = params.DischargeRequiredErrorInfo{
@@ -26,6 +26,10 @@ const (
 
 	NoAddressSetError = errors.ConstError("no address set")
 
+	// UnsupportedPlatformError indicates the OS family of the workload is
+	// not one the target model is able to host.
+	UnsupportedPlatformError = errors.ConstError("unsupported platform")
+
 	// UnknownModelError is for when an operation failed to find a model by
 	// a given model uuid.
 	UnknownModelError = errors.ConstError("unknown model")
@@ -77,6 +81,15 @@ func NewErrIncompatibleSeries(seriesList []string, series, charmName string) err
 	)
 }
 
+func NewErrUnsupportedPlatform(osName, modelName string, supported []string) error {
+	return fmt.Errorf("OS %q is not supported by model %q, supported OS families are: %s%w",
+		osName,
+		modelName,
+		strings.Join(supported, ", "),
+		errors.Hide(UnsupportedPlatformError),
+	)
+}
+
 // RedirectError is the error returned when a model (previously accessible by
 // the user) has been migrated to a different controller.
 type RedirectError struct {
@@ -105,6 +105,14 @@ func TestingUpgradingRoot() rpc.Root {
 	return restrictRoot(r, upgradeMethodsOnly)
 }
 
+// TestingReadOnlyRoot returns a restricted srvRoot as served by a
+// read-only replica API server, answering write methods with the
+// given redirect error.
+func TestingReadOnlyRoot(redirectErr error) rpc.Root {
+	r := TestingAPIRoot(AllFacades())
+	return restrictRoot(r, readOnlyMethodsOnly(redirectErr))
+}
+
 // TestingMigratingRoot returns a resricted srvRoot in a migration
 // scenario.
 func TestingMigratingRoot() rpc.Root {
@@ -15,6 +15,12 @@ import (
 type record struct {
 	factory    Factory
 	facadeType reflect.Type
+
+	// deprecated marks a version that clients should migrate away
+	// from; removalTarget names the release in which it is expected
+	// to be removed.
+	deprecated    bool
+	removalTarget string
 }
 
 // versions is our internal structure for tracking specific versions of a
@@ -32,6 +38,11 @@ type FacadeRegistry interface {
 	// the API, and it must exactly match the actual object returned by the
 	// factory.
 	MustRegister(string, int, Factory, reflect.Type)
+
+	// MustDeprecate marks an already registered facade version as
+	// deprecated, recording the release in which it is expected to be
+	// removed.
+	MustDeprecate(string, int, string)
 }
 
 // Registry describes the API facades exposed by some API server.
@@ -74,6 +85,82 @@ func (f *Registry) MustRegister(name string, version int, factory Factory, facad
 	}
 }
 
+// Deprecate marks an already registered facade version as deprecated.
+// The removal target names the release in which the version is expected
+// to be removed, easing client migration planning.
+func (f *Registry) Deprecate(name string, version int, removalTarget string) error {
+	vers, ok := f.facades[name]
+	if !ok {
+		return errors.NotFoundf("%s(%d)", name, version)
+	}
+	record, ok := vers[version]
+	if !ok {
+		return errors.NotFoundf("%s(%d)", name, version)
+	}
+	record.deprecated = true
+	record.removalTarget = removalTarget
+	vers[version] = record
+	return nil
+}
+
+// MustDeprecate marks an already registered facade version as deprecated
+// and panics if the version is not registered.
+// See: Deprecate.
+func (f *Registry) MustDeprecate(name string, version int, removalTarget string) {
+	if err := f.Deprecate(name, version, removalTarget); err != nil {
+		panic(err)
+	}
+}
+
+// IsDeprecated returns whether the given facade version has been marked
+// deprecated, along with its removal target.
+func (f *Registry) IsDeprecated(name string, version int) (string, bool) {
+	record, err := f.lookup(name, version)
+	if err != nil {
+		return "", false
+	}
+	return record.removalTarget, record.deprecated
+}
+
+// Deprecation describes a facade version that has been marked
+// deprecated, and the release in which it is expected to be removed.
+type Deprecation struct {
+	Name          string
+	Version       int
+	RemovalTarget string
+}
+
+// Deprecations returns every facade version that has been marked
+// deprecated, ordered lexically by name and then by version.
+func (f *Registry) Deprecations() []Deprecation {
+	names := make([]string, 0, len(f.facades))
+	for name := range f.facades {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var deprecations []Deprecation
+	for _, name := range names {
+		vers := f.facades[name]
+		intVersions := make([]int, 0, len(vers))
+		for version := range vers {
+			intVersions = append(intVersions, version)
+		}
+		sort.Ints(intVersions)
+		for _, version := range intVersions {
+			record := vers[version]
+			if !record.deprecated {
+				continue
+			}
+			deprecations = append(deprecations, Deprecation{
+				Name:          name,
+				Version:       version,
+				RemovalTarget: record.removalTarget,
+			})
+		}
+	}
+	return deprecations
+}
+
 // lookup translates a facade name and version into a record.
 func (f *Registry) lookup(name string, version int) (record, error) {
 	if versions, ok := f.facades[name]; ok {
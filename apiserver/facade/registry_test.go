@@ -131,6 +131,52 @@ func (*RegistrySuite) TestRegisterAlreadyPresent(c *gc.C) {
 	c.Check(*asIntPtr, gc.Equals, 100)
 }
 
+func (*RegistrySuite) TestDeprecate(c *gc.C) {
+	registry := &facade.Registry{}
+	assertRegister(c, registry, "name", 0)
+	assertRegister(c, registry, "name", 1)
+
+	err := registry.Deprecate("name", 0, "3.0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	target, deprecated := registry.IsDeprecated("name", 0)
+	c.Check(deprecated, jc.IsTrue)
+	c.Check(target, gc.Equals, "3.0")
+
+	_, deprecated = registry.IsDeprecated("name", 1)
+	c.Check(deprecated, jc.IsFalse)
+}
+
+func (*RegistrySuite) TestDeprecateUnknownVersion(c *gc.C) {
+	registry := &facade.Registry{}
+	assertRegister(c, registry, "name", 0)
+
+	err := registry.Deprecate("name", 1, "3.0")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	c.Check(err, gc.ErrorMatches, `name\(1\) not found`)
+}
+
+func (*RegistrySuite) TestDeprecations(c *gc.C) {
+	registry := &facade.Registry{}
+	assertRegister(c, registry, "other", 0)
+	assertRegister(c, registry, "name", 2)
+	assertRegister(c, registry, "name", 1)
+	assertRegister(c, registry, "name", 0)
+
+	err := registry.Deprecate("name", 1, "3.0")
+	c.Assert(err, jc.ErrorIsNil)
+	err = registry.Deprecate("name", 0, "2.9")
+	c.Assert(err, jc.ErrorIsNil)
+	err = registry.Deprecate("other", 0, "3.0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(registry.Deprecations(), jc.DeepEquals, []facade.Deprecation{
+		{Name: "name", Version: 0, RemovalTarget: "2.9"},
+		{Name: "name", Version: 1, RemovalTarget: "3.0"},
+		{Name: "other", Version: 0, RemovalTarget: "3.0"},
+	})
+}
+
 func (*RegistrySuite) TestGetFactory(c *gc.C) {
 	registry := &facade.Registry{}
 	assertRegister(c, registry, "name", 0)
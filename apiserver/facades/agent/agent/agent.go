@@ -8,6 +8,7 @@ package agent
 
 import (
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/apiserver/common"
@@ -171,3 +172,106 @@ func (api *AgentAPIV3) WatchCredentials(args params.Entities) (params.NotifyWatc
 	}
 	return results, nil
 }
+
+// AgentAPI implements the version 4 of the API provided to an agent.
+type AgentAPI struct {
+	*AgentAPIV3
+}
+
+// SetLoggingOverride records a temporary logging configuration for the
+// given agents. Running agents pick the override up without a restart
+// and revert to the model's logging configuration once the supplied
+// duration has elapsed.
+func (api *AgentAPI) SetLoggingOverride(args params.LoggingOverrideArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Overrides)),
+	}
+	model, err := api.st.Model()
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	for i, arg := range args.Overrides {
+		tag, err := names.ParseTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		// Agents may override their own logging; controllers may
+		// override any agent's for on-call debugging.
+		if !api.auth.AuthOwner(tag) && !api.auth.AuthController() {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if _, err := loggo.ParseConfigString(arg.LoggingConfig); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(errors.NewNotValid(err, "invalid logging config"))
+			continue
+		}
+		if _, err := model.SetAgentLoggingOverride(tag, arg.LoggingConfig, arg.Duration); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// LoggingOverride returns the active logging override for each given
+// agent. Agents without an active override get a NotFound error.
+func (api *AgentAPI) LoggingOverride(args params.Entities) (params.LoggingOverrideResults, error) {
+	results := params.LoggingOverrideResults{
+		Results: make([]params.LoggingOverrideResult, len(args.Entities)),
+	}
+	model, err := api.st.Model()
+	if err != nil {
+		return params.LoggingOverrideResults{}, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !api.auth.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		loggingConfig, expiry, err := model.AgentLoggingOverride(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].LoggingConfig = loggingConfig
+		results.Results[i].Expiry = expiry
+	}
+	return results, nil
+}
+
+// WatchLoggingOverride watches for changes to the logging override of
+// each given agent.
+func (api *AgentAPI) WatchLoggingOverride(args params.Entities) (params.NotifyWatchResults, error) {
+	results := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	model, err := api.st.Model()
+	if err != nil {
+		return params.NotifyWatchResults{}, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !api.auth.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		watch := model.WatchAgentLoggingOverride(tag)
+		// Consume the initial event.
+		if _, ok := <-watch.Changes(); ok {
+			results.Results[i].NotifyWatcherId = api.resources.Register(watch)
+		} else {
+			err = watcher.EnsureErr(watch)
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
@@ -5,6 +5,7 @@ package agent_test
 
 import (
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
@@ -317,3 +318,78 @@ func (s *agentSuite) TestWatchAuthError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "permission denied")
 	c.Assert(s.resources.Count(), gc.Equals, 0)
 }
+
+func (s *agentSuite) TestSetLoggingOverride(c *gc.C) {
+	api, err := agent.NewAgentAPIV4(facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := api.SetLoggingOverride(params.LoggingOverrideArgs{
+		Overrides: []params.LoggingOverrideArg{
+			{Tag: s.machine1.Tag().String(), LoggingConfig: "juju.worker=TRACE", Duration: time.Minute},
+			{Tag: s.machine0.Tag().String(), LoggingConfig: "juju.worker=TRACE", Duration: time.Minute},
+			{Tag: s.machine1.Tag().String(), LoggingConfig: "bad config", Duration: time.Minute},
+			{Tag: s.machine1.Tag().String(), LoggingConfig: "juju.worker=TRACE", Duration: -time.Minute},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 4)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, "permission denied")
+	c.Assert(results.Results[2].Error, gc.ErrorMatches, "invalid logging config: .*")
+	c.Assert(results.Results[3].Error, gc.ErrorMatches, "non-positive duration not valid")
+
+	overrides, err := api.LoggingOverride(params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.machine1.Tag().String()},
+			{Tag: s.machine0.Tag().String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(overrides.Results, gc.HasLen, 2)
+	c.Assert(overrides.Results[0].Error, gc.IsNil)
+	c.Assert(overrides.Results[0].LoggingConfig, gc.Equals, "juju.worker=TRACE")
+	c.Assert(overrides.Results[0].Expiry.IsZero(), jc.IsFalse)
+	c.Assert(overrides.Results[1].Error, gc.ErrorMatches, "permission denied")
+}
+
+func (s *agentSuite) TestLoggingOverrideNoneSet(c *gc.C) {
+	api, err := agent.NewAgentAPIV4(facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	overrides, err := api.LoggingOverride(params.Entities{
+		Entities: []params.Entity{{Tag: s.machine1.Tag().String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(overrides.Results, gc.HasLen, 1)
+	c.Assert(overrides.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *agentSuite) TestWatchLoggingOverride(c *gc.C) {
+	api, err := agent.NewAgentAPIV4(facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := api.WatchLoggingOverride(params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.machine1.Tag().String()},
+			{Tag: s.machine0.Tag().String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].NotifyWatcherId, gc.Equals, "1")
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, "permission denied")
+	c.Assert(s.resources.Count(), gc.Equals, 1)
+}
@@ -5,4 +5,5 @@ package agent
 
 var (
 	NewAgentAPIV2 = newAgentAPIV2
+	NewAgentAPIV4 = newAgentAPIV4
 )
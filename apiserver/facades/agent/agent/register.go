@@ -22,6 +22,9 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Agent", 3, func(ctx facade.Context) (facade.Facade, error) {
 		return newAgentAPIV3(ctx)
 	}, reflect.TypeOf((*AgentAPIV3)(nil)))
+	registry.MustRegister("Agent", 4, func(ctx facade.Context) (facade.Facade, error) {
+		return newAgentAPIV4(ctx)
+	}, reflect.TypeOf((*AgentAPI)(nil)))
 }
 
 // newAgentAPIV2 returns an object implementing version 2 of the Agent API
@@ -49,6 +52,16 @@ func newAgentAPIV2(ctx facade.Context) (*AgentAPIV2, error) {
 	}, nil
 }
 
+// newAgentAPIV4 returns an object implementing version 4 of the Agent API
+// with the given authorizer representing the currently logged in client.
+func newAgentAPIV4(ctx facade.Context) (*AgentAPI, error) {
+	v3, err := newAgentAPIV3(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AgentAPI{v3}, nil
+}
+
 // newAgentAPIV3 returns an object implementing version 2 of the Agent API
 // with the given authorizer representing the currently logged in client.
 func newAgentAPIV3(ctx facade.Context) (*AgentAPIV3, error) {
@@ -111,6 +111,29 @@ func (d *DeployerAPI) ModelUUID() params.StringResult {
 	return params.StringResult{Result: d.st.ModelUUID()}
 }
 
+// UnifiedAgentMode returns whether the model is configured to run
+// unit agents inside the machine agent process.
+func (d *DeployerAPI) UnifiedAgentMode() (params.BoolResult, error) {
+	m, err := d.st.Model()
+	if err != nil {
+		return params.BoolResult{}, errors.Trace(err)
+	}
+	cfg, err := m.ModelConfig()
+	if err != nil {
+		return params.BoolResult{}, errors.Trace(err)
+	}
+	return params.BoolResult{Result: cfg.UnifiedAgentMode()}, nil
+}
+
+// DeployerAPIV1 implements version 1 of the Deployer facade, which
+// does not support UnifiedAgentMode.
+type DeployerAPIV1 struct {
+	*DeployerAPI
+}
+
+// UnifiedAgentMode is not supported in version 1 of the facade.
+func (*DeployerAPIV1) UnifiedAgentMode(_, _ struct{}) {}
+
 // getAllUnits returns a list of all principal and subordinate units
 // assigned to the given machine.
 func getAllUnits(st *state.State, tag names.Tag) ([]string, error) {
@@ -12,10 +12,22 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Deployer", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return newAPIV1(ctx)
+	}, reflect.TypeOf((*DeployerAPIV1)(nil)))
+	registry.MustRegister("Deployer", 2, func(ctx facade.Context) (facade.Facade, error) {
 		return newAPI(ctx)
 	}, reflect.TypeOf((*DeployerAPI)(nil)))
 }
 
+// newAPIV1 creates a new server-side version 1 DeployerAPI facade.
+func newAPIV1(ctx facade.Context) (*DeployerAPIV1, error) {
+	api, err := newAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerAPIV1{api}, nil
+}
+
 // newAPI creates a new server-side DeployerAPI facade.
 func newAPI(ctx facade.Context) (*DeployerAPI, error) {
 	return NewDeployerAPI(ctx)
@@ -5,6 +5,7 @@ package instancemutater
 
 import (
 	"github.com/juju/charm/v8"
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
@@ -44,6 +45,10 @@ type InstanceMutaterAPIV1 struct {
 }
 
 type InstanceMutaterAPIV2 struct {
+	*InstanceMutaterAPIV3
+}
+
+type InstanceMutaterAPIV3 struct {
 	*InstanceMutaterAPI
 }
 
@@ -149,6 +154,52 @@ func (api *InstanceMutaterAPI) SetModificationStatus(args params.SetStatus) (par
 	return result, nil
 }
 
+// SetProfileDrift is not available via the V3 API.
+func (api *InstanceMutaterAPIV3) SetProfileDrift(_ struct{}) {}
+
+// SetProfileDrift records, for each given machine, the difference
+// between the lxd profiles applied to the machine's instance and the
+// profiles expected from its charms. The results report whether the
+// agent should reconcile the drift by reapplying the expected
+// profiles, which is governed by the lxd-profile-auto-reconcile model
+// config attribute.
+func (api *InstanceMutaterAPI) SetProfileDrift(args params.SetProfileDriftArgs) (params.ProfileDriftResults, error) {
+	results := make([]params.ProfileDriftResult, len(args.Args))
+	canAccess, err := api.getAuthFunc()
+	if err != nil {
+		return params.ProfileDriftResults{}, errors.Trace(err)
+	}
+	cfg, err := api.st.ModelConfig()
+	if err != nil {
+		return params.ProfileDriftResults{}, errors.Trace(err)
+	}
+	autoReconcile := cfg.LXDProfileAutoReconcile()
+	for i, a := range args.Args {
+		reconcile, err := api.setOneProfileDrift(canAccess, a, autoReconcile)
+		results[i].Reconcile = reconcile
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ProfileDriftResults{Results: results}, nil
+}
+
+func (api *InstanceMutaterAPI) setOneProfileDrift(canAccess common.AuthFunc, arg params.SetProfileDriftArg, autoReconcile bool) (bool, error) {
+	tag, err := names.ParseMachineTag(arg.Entity.Tag)
+	if err != nil {
+		return false, apiservererrors.ErrPerm
+	}
+	machine, err := api.getMachine(canAccess, tag)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if err := machine.SetLXDProfileDrift(arg.Applied, arg.Expected); err != nil {
+		return false, errors.Trace(err)
+	}
+	applied := set.NewStrings(arg.Applied...)
+	expected := set.NewStrings(arg.Expected...)
+	drifted := !applied.Difference(expected).IsEmpty() || !expected.Difference(applied).IsEmpty()
+	return drifted && autoReconcile, nil
+}
+
 // SetCharmProfiles records the given slice of charm profile names.
 func (api *InstanceMutaterAPI) SetCharmProfiles(args params.SetProfileArgs) (params.ErrorResults, error) {
 	results := make([]params.ErrorResult, len(args.Args))
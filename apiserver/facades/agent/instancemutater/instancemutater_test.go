@@ -524,6 +524,140 @@ func (s *InstanceMutaterAPISetCharmProfilesSuite) expectSetProfiles(profiles []s
 	s.machine.EXPECT().SetCharmProfiles(profiles).Return(err)
 }
 
+type InstanceMutaterAPISetProfileDriftSuite struct {
+	instanceMutaterAPISuite
+
+	machine *mocks.MockMachine
+}
+
+var _ = gc.Suite(&InstanceMutaterAPISetProfileDriftSuite{})
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := s.instanceMutaterAPISuite.setup(c)
+
+	s.machine = mocks.NewMockMachine(ctrl)
+
+	return ctrl
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) TestSetProfileDrift(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	applied := []string{"default"}
+	expected := []string{"default", "juju-model-app-0"}
+
+	s.expectAuthMachineAgent()
+	s.expectLife(s.machineTag)
+	s.expectModelConfig(c, testing.Attrs{})
+	s.expectMachine(s.machineTag, s.machine)
+	s.expectSetDrift(applied, expected, nil)
+	facade := s.facadeAPIForScenario(c)
+
+	results, err := facade.SetProfileDrift(params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: "machine-0"},
+				Applied:  applied,
+				Expected: expected,
+			},
+		},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.ProfileDriftResult{{}})
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) TestSetProfileDriftAutoReconcile(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	applied := []string{"default"}
+	expected := []string{"default", "juju-model-app-0"}
+
+	s.expectAuthMachineAgent()
+	s.expectLife(s.machineTag)
+	s.expectModelConfig(c, testing.Attrs{"lxd-profile-auto-reconcile": true})
+	s.expectMachine(s.machineTag, s.machine)
+	s.expectSetDrift(applied, expected, nil)
+	facade := s.facadeAPIForScenario(c)
+
+	results, err := facade.SetProfileDrift(params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: "machine-0"},
+				Applied:  applied,
+				Expected: expected,
+			},
+		},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.ProfileDriftResult{{Reconcile: true}})
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) TestSetProfileDriftNoDrift(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	profiles := []string{"default", "juju-model-app-0"}
+
+	s.expectAuthMachineAgent()
+	s.expectLife(s.machineTag)
+	s.expectModelConfig(c, testing.Attrs{"lxd-profile-auto-reconcile": true})
+	s.expectMachine(s.machineTag, s.machine)
+	s.expectSetDrift(profiles, profiles, nil)
+	facade := s.facadeAPIForScenario(c)
+
+	results, err := facade.SetProfileDrift(params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: "machine-0"},
+				Applied:  profiles,
+				Expected: profiles,
+			},
+		},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.ProfileDriftResult{{}})
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) TestSetProfileDriftWithError(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	applied := []string{"default"}
+	expected := []string{"default", "juju-model-app-0"}
+
+	s.expectAuthMachineAgent()
+	s.expectLife(s.machineTag)
+	s.expectModelConfig(c, testing.Attrs{})
+	s.expectMachine(s.machineTag, s.machine)
+	s.expectSetDrift(applied, expected, errors.New("Failure"))
+	facade := s.facadeAPIForScenario(c)
+
+	results, err := facade.SetProfileDrift(params.SetProfileDriftArgs{
+		Args: []params.SetProfileDriftArg{
+			{
+				Entity:   params.Entity{Tag: "machine-0"},
+				Applied:  applied,
+				Expected: expected,
+			},
+		},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.ProfileDriftResult{
+		{
+			Error: &params.Error{
+				Message: "Failure",
+			},
+		},
+	})
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) expectModelConfig(c *gc.C, attrs testing.Attrs) {
+	cfg := testing.CustomModelConfig(c, attrs)
+	s.state.EXPECT().ModelConfig().Return(cfg, nil)
+}
+
+func (s *InstanceMutaterAPISetProfileDriftSuite) expectSetDrift(applied, expected []string, err error) {
+	s.machine.EXPECT().SetLXDProfileDrift(applied, expected).Return(err)
+}
+
 type InstanceMutaterAPISetModificationStatusSuite struct {
 	instanceMutaterAPISuite
 
@@ -653,7 +787,7 @@ func (s *InstanceMutaterAPIWatchMachinesSuite) TestWatchMachines(c *gc.C) {
 	s.expectAuthController()
 	s.expectWatchMachinesWithNotify(1)
 	facade := s.facadeAPIForScenario(c)
-	facadev2 := &instancemutater.InstanceMutaterAPIV2{facade}
+	facadev2 := &instancemutater.InstanceMutaterAPIV2{&instancemutater.InstanceMutaterAPIV3{facade}}
 
 	result, err := facadev2.WatchMachines()
 	c.Assert(err, gc.IsNil)
@@ -671,7 +805,7 @@ func (s *InstanceMutaterAPIWatchMachinesSuite) TestWatchMachinesWithClosedChanne
 	s.expectAuthController()
 	s.expectWatchMachinesWithClosedChannel()
 	facade := s.facadeAPIForScenario(c)
-	facadev2 := &instancemutater.InstanceMutaterAPIV2{facade}
+	facadev2 := &instancemutater.InstanceMutaterAPIV2{&instancemutater.InstanceMutaterAPIV3{facade}}
 
 	_, err := facadev2.WatchMachines()
 	c.Assert(err, gc.ErrorMatches, "cannot obtain initial model machines")
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/lxdprofile"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 )
 
@@ -19,6 +20,7 @@ type InstanceMutaterState interface {
 	state.EntityFinder
 
 	ModelName() (string, error)
+	ModelConfig() (*config.Config, error)
 	Application(appName string) (Application, error)
 	Charm(curl *charm.URL) (Charm, error)
 	Machine(id string) (Machine, error)
@@ -40,6 +42,7 @@ type Machine interface {
 	IsManual() (bool, error)
 	CharmProfiles() ([]string, error)
 	SetCharmProfiles([]string) error
+	SetLXDProfileDrift(applied, expected []string) error
 	SetModificationStatus(status.StatusInfo) error
 	Units() ([]Unit, error)
 	WatchContainers(instance.ContainerType) state.StringsWatcher
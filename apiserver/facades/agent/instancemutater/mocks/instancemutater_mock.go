@@ -14,6 +14,7 @@ import (
 	instance "github.com/juju/juju/core/instance"
 	lxdprofile "github.com/juju/juju/core/lxdprofile"
 	status "github.com/juju/juju/core/status"
+	config "github.com/juju/juju/environs/config"
 	state "github.com/juju/juju/state"
 	names "github.com/juju/names/v4"
 )
@@ -154,6 +155,21 @@ func (mr *MockInstanceMutaterStateMockRecorder) Machine(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Machine", reflect.TypeOf((*MockInstanceMutaterState)(nil).Machine), arg0)
 }
 
+// ModelConfig mocks base method.
+func (m *MockInstanceMutaterState) ModelConfig() (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig")
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockInstanceMutaterStateMockRecorder) ModelConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockInstanceMutaterState)(nil).ModelConfig))
+}
+
 // ModelName mocks base method.
 func (m *MockInstanceMutaterState) ModelName() (string, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +380,20 @@ func (mr *MockMachineMockRecorder) SetCharmProfiles(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCharmProfiles", reflect.TypeOf((*MockMachine)(nil).SetCharmProfiles), arg0)
 }
 
+// SetLXDProfileDrift mocks base method.
+func (m *MockMachine) SetLXDProfileDrift(arg0, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLXDProfileDrift", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLXDProfileDrift indicates an expected call of SetLXDProfileDrift.
+func (mr *MockMachineMockRecorder) SetLXDProfileDrift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLXDProfileDrift", reflect.TypeOf((*MockMachine)(nil).SetLXDProfileDrift), arg0, arg1)
+}
+
 // SetModificationStatus mocks base method.
 func (m *MockMachine) SetModificationStatus(arg0 status.StatusInfo) error {
 	m.ctrl.T.Helper()
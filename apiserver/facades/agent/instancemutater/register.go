@@ -19,17 +19,29 @@ func Register(registry facade.FacadeRegistry) {
 	}, reflect.TypeOf((*InstanceMutaterAPIV2)(nil)))
 	registry.MustRegister("InstanceMutater", 3, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacadeV3(ctx)
+	}, reflect.TypeOf((*InstanceMutaterAPIV3)(nil)))
+	registry.MustRegister("InstanceMutater", 4, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacadeV4(ctx)
 	}, reflect.TypeOf((*InstanceMutaterAPI)(nil)))
 }
 
-// newFacadeV3 is used for API registration.
-func newFacadeV3(ctx facade.Context) (*InstanceMutaterAPI, error) {
+// newFacadeV4 is used for API registration.
+func newFacadeV4(ctx facade.Context) (*InstanceMutaterAPI, error) {
 	st := &instanceMutaterStateShim{State: ctx.State()}
 
 	watcher := &instanceMutatorWatcher{st: st}
 	return NewInstanceMutaterAPI(st, watcher, ctx.Resources(), ctx.Auth())
 }
 
+// newFacadeV3 is used for API registration.
+func newFacadeV3(ctx facade.Context) (*InstanceMutaterAPIV3, error) {
+	v4, err := newFacadeV4(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &InstanceMutaterAPIV3{v4}, nil
+}
+
 // newFacadeV2 is used for API registration.
 func newFacadeV2(ctx facade.Context) (*InstanceMutaterAPIV2, error) {
 	v3, err := newFacadeV3(ctx)
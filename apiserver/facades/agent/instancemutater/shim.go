@@ -8,6 +8,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/lxdprofile"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 )
 
@@ -25,6 +26,14 @@ func (s *instanceMutaterStateShim) ModelName() (string, error) {
 	return m.Name(), err
 }
 
+func (s *instanceMutaterStateShim) ModelConfig() (*config.Config, error) {
+	m, err := s.State.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return m.ModelConfig()
+}
+
 func (s *instanceMutaterStateShim) Application(appName string) (Application, error) {
 	app, err := s.State.Application(appName)
 	if err != nil {
@@ -0,0 +1,32 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package metricscollector serves the model configuration consumed by
+// the metrics collector worker running in unit agents.
+package metricscollector
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// MetricsCollectorAPI is the concrete implementation of the
+// MetricsCollector facade.
+type MetricsCollectorAPI struct {
+	model *state.Model
+}
+
+// ScrapeConfig returns the model configuration that drives metric
+// scraping, such as the remote-write target that collected samples are
+// forwarded to.
+func (api *MetricsCollectorAPI) ScrapeConfig() (params.MetricsScrapeConfigResult, error) {
+	cfg, err := api.model.ModelConfig()
+	if err != nil {
+		return params.MetricsScrapeConfigResult{}, errors.Trace(err)
+	}
+	return params.MetricsScrapeConfigResult{
+		RemoteWriteURL: cfg.MetricsRemoteWriteURL(),
+	}, nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"reflect"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("MetricsCollector", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return newMetricsCollectorAPI(ctx)
+	}, reflect.TypeOf((*MetricsCollectorAPI)(nil)))
+}
+
+// newMetricsCollectorAPI creates a new API endpoint serving scrape
+// configuration to unit agents.
+func newMetricsCollectorAPI(ctx facade.Context) (*MetricsCollectorAPI, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthUnitAgent() {
+		return nil, apiservererrors.ErrPerm
+	}
+	model, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &MetricsCollectorAPI{model: model}, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// Backend contains the state.State methods used by the PatchReporter
+// facade, allowing stubs to be created for testing.
+type Backend interface {
+	Machine(id string) (Machine, error)
+	ModelTag() names.ModelTag
+}
+
+// Machine is the subset of state.Machine used by this facade.
+type Machine interface {
+	SetPatchStatus(state.PatchStatus) error
+	PatchStatus() (state.PatchStatus, error)
+}
+
+type stateShim struct {
+	*state.State
+}
+
+func (s stateShim) Machine(id string) (Machine, error) {
+	return s.State.Machine(id)
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return names.NewModelTag(s.State.ModelUUID())
+}
+
+// PatchReporterAPI implements the PatchReporter facade. Machine agents
+// use it to report the patch state of their host; clients with read
+// access to the model use it to query that state in bulk, so fleet
+// patching tools can target machines through Juju.
+type PatchReporterAPI struct {
+	backend Backend
+	auth    facade.Authorizer
+}
+
+// NewPatchReporterAPI creates a new server-side PatchReporter facade.
+func NewPatchReporterAPI(backend Backend, authorizer facade.Authorizer) (*PatchReporterAPI, error) {
+	if !authorizer.AuthMachineAgent() && !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &PatchReporterAPI{
+		backend: backend,
+		auth:    authorizer,
+	}, nil
+}
+
+// SetPatchStatus records the pending update counts and reboot-required
+// flag reported by the calling machine agent. A machine agent may only
+// report for its own machine.
+func (api *PatchReporterAPI) SetPatchStatus(args params.SetMachinePatchStatusArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Statuses)),
+	}
+	for i, arg := range args.Statuses {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !api.auth.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		machine, err := api.backend.Machine(tag.Id())
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = machine.SetPatchStatus(state.PatchStatus{
+			PendingUpdates:         arg.PendingUpdates,
+			PendingSecurityUpdates: arg.PendingSecurityUpdates,
+			RebootRequired:         arg.RebootRequired,
+		})
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// PatchStatus returns the recorded patch state for each of the given
+// machines. Machine agents may only query their own machine; clients
+// need read access to the model.
+func (api *PatchReporterAPI) PatchStatus(args params.Entities) (params.MachinePatchStatusResults, error) {
+	results := params.MachinePatchStatusResults{
+		Results: make([]params.MachinePatchStatusResult, len(args.Entities)),
+	}
+	canRead, err := api.canRead()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseMachineTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canRead && !api.auth.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		machine, err := api.backend.Machine(tag.Id())
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		status, err := machine.PatchStatus()
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = &params.MachinePatchStatus{
+			Tag:                    tag.String(),
+			PendingUpdates:         status.PendingUpdates,
+			PendingSecurityUpdates: status.PendingSecurityUpdates,
+			RebootRequired:         status.RebootRequired,
+		}
+	}
+	return results, nil
+}
+
+func (api *PatchReporterAPI) canRead() (bool, error) {
+	if !api.auth.AuthClient() {
+		return false, nil
+	}
+	canRead, err := api.auth.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return canRead, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/agent/patchreporter"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
+
+type patchReporterSuite struct {
+	backend    *mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&patchReporterSuite{})
+
+func (s *patchReporterSuite) SetUpTest(c *gc.C) {
+	s.backend = &mockBackend{
+		machines: map[string]*mockMachine{
+			"0": {id: "0"},
+			"1": {id: "1"},
+		},
+	}
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+}
+
+func (s *patchReporterSuite) api(c *gc.C) *patchreporter.PatchReporterAPI {
+	api, err := patchreporter.NewPatchReporterAPI(s.backend, &s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *patchReporterSuite) TestSetPatchStatus(c *gc.C) {
+	results, err := s.api(c).SetPatchStatus(params.SetMachinePatchStatusArgs{
+		Statuses: []params.MachinePatchStatus{{
+			Tag:                    "machine-0",
+			PendingUpdates:         12,
+			PendingSecurityUpdates: 3,
+			RebootRequired:         true,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), jc.ErrorIsNil)
+	c.Assert(s.backend.machines["0"].status, gc.NotNil)
+	c.Assert(*s.backend.machines["0"].status, jc.DeepEquals, state.PatchStatus{
+		PendingUpdates:         12,
+		PendingSecurityUpdates: 3,
+		RebootRequired:         true,
+	})
+}
+
+func (s *patchReporterSuite) TestSetPatchStatusOtherMachine(c *gc.C) {
+	results, err := s.api(c).SetPatchStatus(params.SetMachinePatchStatusArgs{
+		Statuses: []params.MachinePatchStatus{{
+			Tag: "machine-1",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), gc.ErrorMatches, "permission denied")
+	c.Assert(s.backend.machines["1"].status, gc.IsNil)
+}
+
+func (s *patchReporterSuite) TestPatchStatusAsMachine(c *gc.C) {
+	s.backend.machines["0"].status = &state.PatchStatus{
+		PendingUpdates: 2,
+		RebootRequired: true,
+	}
+	results, err := s.api(c).PatchStatus(params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Result, jc.DeepEquals, &params.MachinePatchStatus{
+		Tag:            "machine-0",
+		PendingUpdates: 2,
+		RebootRequired: true,
+	})
+	// A machine agent cannot query other machines.
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, "permission denied")
+}
+
+func (s *patchReporterSuite) TestPatchStatusAsClient(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:      names.NewUserTag("fred"),
+		AdminTag: names.NewUserTag("fred"),
+	}
+	s.backend.machines["1"].status = &state.PatchStatus{
+		PendingSecurityUpdates: 7,
+	}
+	results, err := s.api(c).PatchStatus(params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	// Machine 0 has never reported.
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "patch status for machine 0 not found")
+	c.Assert(results.Results[1].Result, jc.DeepEquals, &params.MachinePatchStatus{
+		Tag:                    "machine-1",
+		PendingSecurityUpdates: 7,
+	})
+}
+
+func (s *patchReporterSuite) TestUnitAgentDenied(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUnitTag("mysql/0"),
+	}
+	_, err := patchreporter.NewPatchReporterAPI(s.backend, &s.authorizer)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+type mockBackend struct {
+	machines map[string]*mockMachine
+}
+
+func (b *mockBackend) Machine(id string) (patchreporter.Machine, error) {
+	machine, ok := b.machines[id]
+	if !ok {
+		return nil, errors.NotFoundf("machine %s", id)
+	}
+	return machine, nil
+}
+
+func (b *mockBackend) ModelTag() names.ModelTag {
+	return coretesting.ModelTag
+}
+
+type mockMachine struct {
+	id     string
+	status *state.PatchStatus
+}
+
+func (m *mockMachine) SetPatchStatus(status state.PatchStatus) error {
+	m.status = &status
+	return nil
+}
+
+func (m *mockMachine) PatchStatus() (state.PatchStatus, error) {
+	if m.status == nil {
+		return state.PatchStatus{}, errors.NotFoundf("patch status for machine %s", m.id)
+	}
+	return *m.status, nil
+}
@@ -0,0 +1,22 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter
+
+import (
+	"reflect"
+
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("PatchReporter", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return newPatchReporterAPI(ctx)
+	}, reflect.TypeOf((*PatchReporterAPI)(nil)))
+}
+
+// newPatchReporterAPI creates a new server-side PatchReporter facade.
+func newPatchReporterAPI(ctx facade.Context) (*PatchReporterAPI, error) {
+	return NewPatchReporterAPI(stateShim{ctx.State()}, ctx.Auth())
+}
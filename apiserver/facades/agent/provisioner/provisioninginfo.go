@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/cloudimagemetadata"
+	"github.com/juju/juju/state/stateenvirons"
 	"github.com/juju/juju/storage"
 )
 
@@ -215,6 +216,18 @@ func (api *ProvisionerAPI) getProvisioningInfoBase(m *state.Machine,
 		return result, errors.Annotate(err, "cannot get available image metadata")
 	}
 
+	if result.ImageChannel, err = api.machineImageChannel(m); err != nil {
+		return result, errors.Annotate(err, "cannot determine image channel")
+	}
+
+	if result.NICRequests, err = api.machineNICRequests(m); err != nil {
+		return result, errors.Annotate(err, "cannot determine NIC requests")
+	}
+
+	if result.CloudSpec, err = api.machineCloudSpec(m); err != nil {
+		return result, errors.Annotate(err, "cannot determine machine cloud")
+	}
+
 	if result.ControllerConfig, err = api.st.ControllerConfig(); err != nil {
 		return result, errors.Annotate(err, "cannot get controller configuration")
 	}
@@ -530,6 +543,125 @@ func (api *ProvisionerAPI) subnetsAndZonesForSpace(machineID string, spaceName s
 	return subnetsToZones, nil
 }
 
+// machineImageChannel returns the base image channel pinned by the
+// application(s) with principal units assigned to the machine, or empty
+// if no application pins one. Conflicting pins are an error.
+func (api *ProvisionerAPI) machineImageChannel(m *state.Machine) (string, error) {
+	units, err := m.Units()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	var channel string
+	for _, unit := range units {
+		if !unit.IsPrincipal() {
+			continue
+		}
+		app, err := unit.Application()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		ch := app.ImageChannel()
+		if ch == "" {
+			continue
+		}
+		if channel != "" && channel != ch {
+			return "", errors.Errorf(
+				"applications on machine %q pin conflicting image channels %q and %q",
+				m.Id(), channel, ch)
+		}
+		channel = ch
+	}
+	return channel, nil
+}
+
+// machineNICRequests aggregates the NIC requests of the application(s)
+// with principal units assigned to the machine. Requests for the same
+// interface from different applications are deduplicated.
+func (api *ProvisionerAPI) machineNICRequests(m *state.Machine) ([]params.NICRequest, error) {
+	units, err := m.Units()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var requests []params.NICRequest
+	seen := set.NewStrings()
+	for _, unit := range units {
+		if !unit.IsPrincipal() {
+			continue
+		}
+		app, err := unit.Application()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, req := range app.NICRequests() {
+			key := fmt.Sprintf("%s:%s:%d", req.Type, strings.Join(req.Parents, ","), req.Count)
+			if seen.Contains(key) {
+				continue
+			}
+			seen.Add(key)
+			requests = append(requests, params.NICRequest{
+				Type:    string(req.Type),
+				Parents: req.Parents,
+				Count:   req.Count,
+			})
+		}
+	}
+	return requests, nil
+}
+
+// machineCloudSpec returns the spec of the registered cloud the machine
+// must be provisioned in, or nil if the machine follows the model's own
+// cloud. The credential is resolved from the model owner's credentials
+// for that cloud, of which there must be exactly one.
+func (api *ProvisionerAPI) machineCloudSpec(m *state.Machine) (*params.CloudSpec, error) {
+	cloudName := m.Cloud()
+	if cloudName == "" {
+		return nil, nil
+	}
+	machineCloud, err := api.st.Cloud(cloudName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	owner := api.m.Owner()
+	credentials, err := api.st.CloudCredentials(owner, cloudName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(credentials) != 1 {
+		return nil, errors.Errorf(
+			"expected 1 credential of %q for cloud %q, got %d",
+			owner.Id(), cloudName, len(credentials))
+	}
+	var credential *state.Credential
+	for _, value := range credentials {
+		value := value
+		credential = &value
+	}
+	spec, err := stateenvirons.CloudSpec(machineCloud, "", credential)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var paramsCredential *params.CloudCredential
+	if spec.Credential != nil && spec.Credential.AuthType() != "" {
+		paramsCredential = &params.CloudCredential{
+			AuthType:   string(spec.Credential.AuthType()),
+			Attributes: spec.Credential.Attributes(),
+		}
+	}
+	return &params.CloudSpec{
+		Type:             spec.Type,
+		Name:             spec.Name,
+		Region:           spec.Region,
+		Endpoint:         spec.Endpoint,
+		IdentityEndpoint: spec.IdentityEndpoint,
+		StorageEndpoint:  spec.StorageEndpoint,
+		Credential:       paramsCredential,
+		CACertificates:   spec.CACertificates,
+		SkipTLSVerify:    spec.SkipTLSVerify,
+	}, nil
+}
+
 // machineLXDProfileNames give the environ info to write lxd profiles needed for
 // the given machine and returns the names of profiles. Unlike
 // containerLXDProfilesInfo which returns the info necessary to write lxd profiles
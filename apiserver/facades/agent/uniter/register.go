@@ -60,6 +60,9 @@ func Register(registry facade.FacadeRegistry) {
 		return newUniterAPIV17(ctx)
 	}, reflect.TypeOf((*UniterAPIV17)(nil)))
 	registry.MustRegister("Uniter", 18, func(ctx facade.Context) (facade.Facade, error) {
+		return newUniterAPIV18(ctx)
+	}, reflect.TypeOf((*UniterAPIV18)(nil)))
+	registry.MustRegister("Uniter", 19, func(ctx facade.Context) (facade.Facade, error) {
 		return newUniterAPI(ctx)
 	}, reflect.TypeOf((*UniterAPI)(nil)))
 }
@@ -158,9 +161,20 @@ func newUniterAPI(context facade.Context) (*UniterAPI, error) {
 	}, nil
 }
 
+// newUniterAPIV18 creates an instance of the V18 uniter API.
+func newUniterAPIV18(context facade.Context) (*UniterAPIV18, error) {
+	uniterAPI, err := newUniterAPI(context)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV18{
+		UniterAPI: *uniterAPI,
+	}, nil
+}
+
 // newUniterAPIV17 creates an instance of the V17 uniter API.
 func newUniterAPIV17(context facade.Context) (*UniterAPIV17, error) {
-	uniterAPI, err := newUniterAPI(context)
+	uniterAPI, err := newUniterAPIV18(context)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +192,7 @@ func newUniterAPIV17(context facade.Context) (*UniterAPIV17, error) {
 		common.AuthFuncForTag(m.ModelTag()),
 	)
 	return &UniterAPIV17{
-		UniterAPI: *uniterAPI,
+		UniterAPIV18: *uniterAPI,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/juju/charm/v8"
 	"github.com/juju/clock"
@@ -27,6 +28,9 @@ import (
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/workloadidentity"
+	"github.com/juju/juju/environs"
+	environscontext "github.com/juju/juju/environs/context"
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
@@ -80,10 +84,17 @@ type UniterAPI struct {
 	cloudSpecer     cloudspec.CloudSpecer
 }
 
+// UniterAPIV18 implements version (v18) of the Uniter API, which does
+// not have the ScopedCredentials and WorkloadIdentityTokens calls
+// added in v19.
+type UniterAPIV18 struct {
+	UniterAPI
+}
+
 // UniterAPIV17 implements version (v17) of the Uniter API, which adds
 // CloudSpec v2
 type UniterAPIV17 struct {
-	UniterAPI
+	UniterAPIV18
 }
 
 // UniterAPIV16 implements version (v16) of the Uniter API, which adds
@@ -1216,6 +1227,49 @@ func (u *UniterAPI) RelationById(args params.RelationIds) (params.RelationResult
 	return result, nil
 }
 
+// RelationsNetworkCharacteristics returns the network characteristics
+// recorded against each specified relation, so charms can tune
+// themselves for the network path the relation traverses. Relations
+// with no recorded characteristics yield an empty result.
+func (u *UniterAPI) RelationsNetworkCharacteristics(args params.RelationIds) (params.RelationNetworkResults, error) {
+	result := params.RelationNetworkResults{
+		Results: make([]params.RelationNetworkResult, len(args.RelationIds)),
+	}
+	for i, relId := range args.RelationIds {
+		char, err := u.getOneRelationNetworkCharacteristics(relId)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Characteristics = char
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) getOneRelationNetworkCharacteristics(relId int) (params.RelationNetworkCharacteristics, error) {
+	nothing := params.RelationNetworkCharacteristics{}
+	// Check that the authenticated unit's application is part of the
+	// relation before exposing anything about it.
+	if _, err := u.getOneRelationById(relId); err != nil {
+		return nothing, err
+	}
+	rel, err := u.st.Relation(relId)
+	if err != nil {
+		return nothing, errors.Trace(err)
+	}
+	char, err := rel.NetworkCharacteristics()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nothing, nil
+		}
+		return nothing, errors.Trace(err)
+	}
+	return params.RelationNetworkCharacteristics{
+		LatencyClass:  char.LatencyClass,
+		BandwidthMbps: char.BandwidthMbps,
+	}, nil
+}
+
 // JoinedRelations returns the tags of all relations for which each supplied unit
 // has entered scope.
 // TODO(wallyworld) - this API is replaced by RelationsStatus
@@ -1767,6 +1821,9 @@ func (u *UniterAPI) updateUnitSettingsOp(relUnit *state.RelationUnit, newSetting
 			settings.Set(k, v)
 		}
 	}
+	if err := u.st.ValidateRelationData(relUnit.Endpoint().Interface, settings.Map()); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return settings.WriteOperation(), nil
 }
 
@@ -1780,6 +1837,27 @@ func (u *UniterAPI) updateApplicationSettingsOp(rel *state.Relation, unit *state
 		settingsMap[k] = v
 	}
 
+	// Validate the settings as they will be once the update is applied:
+	// keys with empty values are deleted by the update operation.
+	current, err := rel.ApplicationSettings(unit.ApplicationName())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for k, v := range settings {
+		if v == "" {
+			delete(current, k)
+		} else {
+			current[k] = v
+		}
+	}
+	ep, err := rel.Endpoint(unit.ApplicationName())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := u.st.ValidateRelationData(ep.Interface, current); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	return rel.UpdateApplicationSettingsOperation(unit.ApplicationName(), token, settingsMap)
 }
 
@@ -2888,6 +2966,94 @@ func (u *UniterAPI) CloudSpec() (params.CloudSpecResult, error) {
 	return u.cloudSpecer.GetCloudSpec(u.m.Tag().(names.ModelTag)), nil
 }
 
+// ScopedCredentials mints short-lived provider credentials limited to
+// the requested scopes for the model in which the authenticated unit
+// resides. The same trust check as CloudSpec applies. Providers that
+// cannot mint scoped credentials return a NotSupported error.
+func (u *UniterAPI) ScopedCredentials(args params.ScopedCredentialArgs) (params.ScopedCredentialResults, error) {
+	canAccess, err := u.accessCloudSpec()
+	if err != nil {
+		return params.ScopedCredentialResults{}, err
+	}
+	if !canAccess() {
+		return params.ScopedCredentialResults{}, apiservererrors.ErrPerm
+	}
+
+	results := params.ScopedCredentialResults{
+		Results: make([]params.ScopedCredentialResult, len(args.Args)),
+	}
+	configGetter := stateenvirons.EnvironConfigGetter{Model: u.m}
+	env, err := environs.GetEnviron(configGetter, environs.New)
+	if err != nil {
+		return params.ScopedCredentialResults{}, errors.Trace(err)
+	}
+	minter, ok := env.(environs.ScopedCredentialMinter)
+	callCtx := environscontext.CallContext(u.st)
+	for i, arg := range args.Args {
+		if !ok {
+			results.Results[i].Error = apiservererrors.ServerError(
+				errors.NotSupportedf("scoped credentials on cloud type %q", env.Config().Type()))
+			continue
+		}
+		cred, validUntil, err := minter.MintScopedCredential(callCtx, arg.Scope)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = &params.CloudCredential{
+			AuthType:   string(cred.AuthType()),
+			Attributes: cred.Attributes(),
+		}
+		results.Results[i].ValidUntil = &validUntil
+	}
+	return results, nil
+}
+
+// Mask ScopedCredentials from the v18 API and below. The API
+// reflection code in rpc/rpcreflect/type.go:newMethod skips 2-argument
+// methods, so this removes the method as far as the RPC machinery is
+// concerned.
+func (u *UniterAPIV18) ScopedCredentials(_, _ struct{}) {}
+
+// WorkloadIdentityTokens mints short-lived workload identity tokens
+// (JWT-SVIDs carrying unit, application and model claims) for the
+// authenticated unit, so that charmed workloads can authenticate to
+// external services without baked-in secrets.
+func (u *UniterAPI) WorkloadIdentityTokens(args params.WorkloadIdentityTokenArgs) (params.WorkloadIdentityTokenResults, error) {
+	results := params.WorkloadIdentityTokenResults{
+		Results: make([]params.WorkloadIdentityTokenResult, len(args.Args)),
+	}
+	tag, ok := u.auth.GetAuthTag().(names.UnitTag)
+	if !ok {
+		return params.WorkloadIdentityTokenResults{}, apiservererrors.ErrPerm
+	}
+	key, err := u.st.WorkloadIdentityKey()
+	if err != nil {
+		return params.WorkloadIdentityTokenResults{}, errors.Trace(err)
+	}
+	issuer, err := workloadidentity.NewIssuer(key, u.st.ControllerUUID(), u.clock)
+	if err != nil {
+		return params.WorkloadIdentityTokenResults{}, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		var lifetime time.Duration
+		if arg.Lifetime != nil {
+			lifetime = *arg.Lifetime
+		}
+		token, expiry, err := issuer.IssueUnitToken(u.m.UUID(), tag.Id(), arg.Audience, lifetime)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Token = token
+		results.Results[i].ExpiresAt = &expiry
+	}
+	return results, nil
+}
+
+// Mask WorkloadIdentityTokens from the v18 API and below.
+func (u *UniterAPIV18) WorkloadIdentityTokens(_, _ struct{}) {}
+
 // GoalStates returns information of charm units and relations.
 func (u *UniterAPI) GoalStates(args params.Entities) (params.GoalStateResults, error) {
 	result := params.GoalStateResults{
@@ -329,6 +329,31 @@ func (a *ActionAPI) ApplicationsCharmsActions(args params.Entities) (params.Appl
 	return result, nil
 }
 
+// ActionOutputs is not available in version 7.
+func (a *APIv7) ActionOutputs(_ struct{}) {}
+
+// ActionOutputs fetches the complete output of actions whose results
+// were too large to store inline and were spilled to the controller
+// blob store. Each request identifies the output by the URL recorded
+// in the action results.
+func (a *ActionAPI) ActionOutputs(args params.ActionOutputRequests) (params.ActionOutputResults, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.ActionOutputResults{}, errors.Trace(err)
+	}
+	results := params.ActionOutputResults{
+		Results: make([]params.ActionOutputResult, len(args.Requests)),
+	}
+	for i, req := range args.Requests {
+		output, err := a.model.ActionOutput(req.URL)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Output = string(output)
+	}
+	return results, nil
+}
+
 // WatchActionsProgress creates a watcher that reports on action log messages.
 func (api *ActionAPI) WatchActionsProgress(actions params.Entities) (params.StringsWatchResults, error) {
 	results := params.StringsWatchResults{
@@ -0,0 +1,74 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/apiserver/facades/client/action"
+	"github.com/juju/juju/apiserver/facades/client/action/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+type actionOutputSuite struct {
+	authorizer *facademocks.MockAuthorizer
+	model      *mocks.MockModel
+	state      *mocks.MockState
+
+	modelTag names.ModelTag
+}
+
+var _ = gc.Suite(&actionOutputSuite{})
+
+func (s *actionOutputSuite) SetUpSuite(c *gc.C) {
+	s.modelTag = names.NewModelTag("model-tag")
+}
+
+func (s *actionOutputSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	s.authorizer.EXPECT().AuthClient().Return(true)
+
+	s.model = mocks.NewMockModel(ctrl)
+	s.model.EXPECT().ModelTag().Return(s.modelTag)
+
+	s.state = mocks.NewMockState(ctrl)
+	s.state.EXPECT().Model().Return(s.model, nil)
+
+	return ctrl
+}
+
+func (s *actionOutputSuite) getAPI(c *gc.C) *action.ActionAPI {
+	api, err := action.NewActionAPIForMockTest(s.state, nil, s.authorizer, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *actionOutputSuite) TestActionOutputs(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.model.EXPECT().ActionOutput("actionoutput/2/stdout").Return([]byte("full output"), nil)
+	s.model.EXPECT().ActionOutput("actionoutput/3/stdout").Return(nil, errors.NotFoundf("action output %q", "actionoutput/3/stdout"))
+
+	api := s.getAPI(c)
+
+	r, err := api.ActionOutputs(params.ActionOutputRequests{
+		Requests: []params.ActionOutputRequest{
+			{URL: "actionoutput/2/stdout"},
+			{URL: "actionoutput/3/stdout"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 2)
+	c.Assert(r.Results[0].Error, gc.IsNil)
+	c.Assert(r.Results[0].Output, gc.Equals, "full output")
+	c.Assert(r.Results[1].Error, gc.ErrorMatches, `action output "actionoutput/3/stdout" not found`)
+}
@@ -25,6 +25,7 @@ type State interface {
 // Model describes model state used by the action facade.
 type Model interface {
 	ActionByTag(tag names.ActionTag) (state.Action, error)
+	ActionOutput(url string) ([]byte, error)
 	AddAction(receiver state.ActionReceiver, operationID, name string, payload map[string]interface{}) (state.Action, error)
 	EnqueueOperation(summary string, count int) (string, error)
 	FailOperationEnqueuing(operationID, failMessage string, count int) error
@@ -194,6 +194,21 @@ func (mr *MockModelMockRecorder) ActionByTag(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionByTag", reflect.TypeOf((*MockModel)(nil).ActionByTag), arg0)
 }
 
+// ActionOutput mocks base method.
+func (m *MockModel) ActionOutput(arg0 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionOutput", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActionOutput indicates an expected call of ActionOutput.
+func (mr *MockModelMockRecorder) ActionOutput(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionOutput", reflect.TypeOf((*MockModel)(nil).ActionOutput), arg0)
+}
+
 // AddAction mocks base method.
 func (m *MockModel) AddAction(arg0 state.ActionReceiver, arg1, arg2 string, arg3 map[string]interface{}) (state.Action, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,9 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Action", 7, func(ctx facade.Context) (facade.Facade, error) {
 		return newActionAPIV7(ctx)
 	}, reflect.TypeOf((*APIv7)(nil)))
+	registry.MustRegister("Action", 8, func(ctx facade.Context) (facade.Facade, error) {
+		return newActionAPIV8(ctx)
+	}, reflect.TypeOf((*ActionAPI)(nil)))
 }
 
 // newActionAPIV2 returns an initialized ActionAPI for version 2.
@@ -80,10 +83,19 @@ func newActionAPIV6(ctx facade.Context) (*APIv6, error) {
 
 // newActionAPIV7 returns an initialized ActionAPI for version 7.
 func newActionAPIV7(ctx facade.Context) (*APIv7, error) {
+	api, err := newActionAPIV8(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv7{api}, nil
+}
+
+// newActionAPIV8 returns an initialized ActionAPI for version 8.
+func newActionAPIV8(ctx facade.Context) (*ActionAPI, error) {
 	st := ctx.State()
 	api, err := newActionAPI(&stateShim{st: st}, ctx.Resources(), ctx.Auth())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &APIv7{api}, nil
+	return api, nil
 }
@@ -8,10 +8,14 @@ import (
 	"math"
 	"net"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/juju/charm/v8"
 	csparams "github.com/juju/charmrepo/v6/csclient/params"
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
@@ -42,9 +46,12 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/os"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/policy"
 	"github.com/juju/juju/core/series"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/webhook"
 	"github.com/juju/juju/environs"
 	environsconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
@@ -124,6 +131,24 @@ type APIv14 struct {
 
 // APIv15 provides the Application API facade for version 15.
 type APIv15 struct {
+	*APIv16
+}
+
+// APIv16 provides the Application API facade for version 16.
+// The DestroyUnit call accepts server-side unit selection criteria.
+type APIv16 struct {
+	*APIv17
+}
+
+// APIv17 provides the Application API facade for version 17.
+// It adds SetTeardownOrder.
+type APIv17 struct {
+	*APIv18
+}
+
+// APIv18 provides the Application API facade for version 18.
+// The DestroyApplication call accepts a dry-run flag.
+type APIv18 struct {
 	*APIBase
 }
 
@@ -297,6 +322,20 @@ func (api *APIBase) checkCanWrite() error {
 	return api.checkPermission(api.model.ModelTag(), permission.WriteAccess)
 }
 
+// checkPolicy evaluates any policy checks registered in the controller
+// configuration against the given operation.
+func (api *APIBase) checkPolicy(op policy.Operation, attrs map[string]interface{}) error {
+	cfg, err := api.backend.ControllerConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return common.CheckPolicy(cfg, policy.Check{
+		Operation:  op,
+		ModelUUID:  api.model.UUID(),
+		Attributes: attrs,
+	})
+}
+
 // SetMetricCredentials sets credentials on the application.
 func (api *APIBase) SetMetricCredentials(args params.ApplicationMetricCredentials) (params.ErrorResults, error) {
 	if err := api.checkCanWrite(); err != nil {
@@ -346,7 +385,8 @@ func (api *APIv5) Deploy(args params.ApplicationsDeployV5) (params.ErrorResults,
 			Resources:        value.Resources,
 		})
 	}
-	return api.APIBase.Deploy(newArgs)
+	results, err := api.APIBase.Deploy(newArgs)
+	return legacyDeployResults(results), err
 }
 
 // Deploy fetches the charms from the charm store and deploys them
@@ -373,7 +413,8 @@ func (api *APIv6) Deploy(args params.ApplicationsDeployV6) (params.ErrorResults,
 			Resources:        value.Resources,
 		})
 	}
-	return api.APIBase.Deploy(newArgs)
+	results, err := api.APIBase.Deploy(newArgs)
+	return legacyDeployResults(results), err
 }
 
 // Deploy fetches the charms from the charm store and deploys them
@@ -400,23 +441,32 @@ func (api *APIv12) Deploy(args params.ApplicationsDeployV12) (params.ErrorResult
 			Resources:        value.Resources,
 		})
 	}
-	return api.APIBase.Deploy(newArgs)
+	results, err := api.APIBase.Deploy(newArgs)
+	return legacyDeployResults(results), err
 }
 
 // Deploy fetches the charms from the charm store and deploys them
 // using the specified placement directives.
-func (api *APIBase) Deploy(args params.ApplicationsDeploy) (params.ErrorResults, error) {
+func (api *APIBase) Deploy(args params.ApplicationsDeploy) (params.ApplicationsDeployResults, error) {
 	if err := api.checkCanWrite(); err != nil {
-		return params.ErrorResults{}, errors.Trace(err)
+		return params.ApplicationsDeployResults{}, errors.Trace(err)
 	}
-	result := params.ErrorResults{
-		Results: make([]params.ErrorResult, len(args.Applications)),
+	result := params.ApplicationsDeployResults{
+		Results: make([]params.ApplicationDeployResult, len(args.Applications)),
 	}
 	if err := api.check.ChangeAllowed(); err != nil {
 		return result, errors.Trace(err)
 	}
 
+	var deployed []string
 	for i, arg := range args.Applications {
+		if err := api.checkPolicy(policy.OpDeploy, map[string]interface{}{
+			"application": arg.ApplicationName,
+			"charm-url":   arg.CharmURL,
+		}); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
 		err := deployApplication(
 			api.backend,
 			api.model,
@@ -429,6 +479,19 @@ func (api *APIBase) Deploy(args params.ApplicationsDeploy) (params.ErrorResults,
 		)
 		result.Results[i].Error = apiservererrors.ServerError(err)
 
+		if err == nil {
+			deployed = append(deployed, arg.ApplicationName)
+			feasibility, err := api.subordinateFeasibility(arg)
+			if err != nil {
+				// The application deployed successfully; failure to
+				// assess its co-location prospects is not worth
+				// failing the request for.
+				logger.Warningf("cannot assess principal feasibility for %q: %v", arg.ApplicationName, err)
+			} else {
+				result.Results[i].PrincipalFeasibility = feasibility
+			}
+		}
+
 		if err != nil && len(arg.Resources) != 0 {
 			// Remove any pending resources - these would have been
 			// converted into real resources if the application had
@@ -444,9 +507,146 @@ func (api *APIBase) Deploy(args params.ApplicationsDeploy) (params.ErrorResults,
 			}
 		}
 	}
+	api.publishDeployedEvents(deployed)
 	return result, nil
 }
 
+// publishDeployedEvents notifies the webhook endpoints registered on
+// the controller that the named applications have been deployed.
+// Delivery is best effort and does not affect the deploy result.
+func (api *APIBase) publishDeployedEvents(appNames []string) {
+	if len(appNames) == 0 {
+		return
+	}
+	endpoints, err := api.backend.AllWebhookEndpoints()
+	if err != nil {
+		logger.Warningf("cannot load webhook endpoints: %v", err)
+		return
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+	hooks := make([]webhook.Endpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		hooks[i] = webhook.Endpoint{
+			Name:         endpoint.Name,
+			URL:          endpoint.URL,
+			Secret:       endpoint.Secret,
+			EventFilters: endpoint.EventFilters,
+		}
+	}
+	now := time.Now().UTC()
+	for _, appName := range appNames {
+		webhook.NotifyAll(hooks, webhook.Event{
+			Type:      webhook.EventApplicationDeployed,
+			ModelUUID: api.model.UUID(),
+			Entity:    appName,
+			Timestamp: now,
+		})
+	}
+}
+
+// legacyDeployResults flattens deploy results to the error-only form
+// returned by versions of the facade that predate per-principal
+// feasibility reporting.
+func legacyDeployResults(results params.ApplicationsDeployResults) params.ErrorResults {
+	out := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(results.Results)),
+	}
+	for i, result := range results.Results {
+		out.Results[i].Error = result.Error
+	}
+	return out
+}
+
+// subordinateFeasibility reports, for each principal application in the
+// model, whether the machines hosting its units satisfy the series and
+// architecture requirements of the subordinate being deployed. It
+// returns nothing for principal charms and for CAAS models, where
+// co-location is managed by the substrate rather than by machine.
+func (api *APIBase) subordinateFeasibility(arg params.ApplicationDeploy) ([]params.PrincipalFeasibility, error) {
+	if api.modelType != state.ModelTypeIAAS {
+		return nil, nil
+	}
+	curl, err := charm.ParseURL(arg.CharmURL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ch, err := api.backend.Charm(curl)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !ch.Meta().Subordinate {
+		return nil, nil
+	}
+	supportedSeries, err := corecharm.ComputedSeries(ch)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var wantArch string
+	if arg.CharmOrigin != nil {
+		wantArch = arg.CharmOrigin.Architecture
+	}
+	apps, err := api.backend.AllApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results []params.PrincipalFeasibility
+	for _, app := range apps {
+		if !app.IsPrincipal() || app.Name() == arg.ApplicationName {
+			continue
+		}
+		feasibility := params.PrincipalFeasibility{
+			Principal: app.Name(),
+			Feasible:  true,
+		}
+		units, err := app.AllUnits()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, unit := range units {
+			machineID, err := unit.AssignedMachineId()
+			if err != nil {
+				// An unassigned unit has no machine to judge yet.
+				continue
+			}
+			machine, err := api.backend.Machine(machineID)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if reason := machineFeasibilityReason(machine, machineID, supportedSeries, wantArch); reason != "" {
+				feasibility.Feasible = false
+				feasibility.Message = reason
+				break
+			}
+		}
+		results = append(results, feasibility)
+	}
+	return results, nil
+}
+
+// machineFeasibilityReason returns a reason why the given machine cannot
+// host a subordinate with the given series and architecture requirements,
+// or an empty string if it can.
+func machineFeasibilityReason(machine Machine, machineID string, supportedSeries []string, wantArch string) string {
+	if len(supportedSeries) > 0 && !set.NewStrings(supportedSeries...).Contains(machine.Series()) {
+		return fmt.Sprintf("machine %s runs series %q, which the charm does not support (%s)",
+			machineID, machine.Series(), strings.Join(supportedSeries, ", "))
+	}
+	if wantArch != "" {
+		hc, err := machine.HardwareCharacteristics()
+		if err != nil {
+			// Not provisioned yet; its architecture cannot be judged.
+			return ""
+		}
+		if hc.Arch != nil && *hc.Arch != wantArch {
+			return fmt.Sprintf("machine %s has architecture %q, but the charm requires %q",
+				machineID, *hc.Arch, wantArch)
+		}
+	}
+	return ""
+}
+
 func applicationConfigSchema(modelType state.ModelType) (environschema.Fields, schema.Defaults, error) {
 	if modelType != state.ModelTypeCAAS {
 		return trustFields, trustDefaults, nil
@@ -649,6 +849,10 @@ func deployApplication(
 		return errors.Trace(err)
 	}
 
+	if err := validateWorkloadOS(model, ch, args.Series); err != nil {
+		return errors.Trace(err)
+	}
+
 	modelType := model.Type()
 	if modelType != state.ModelTypeIAAS {
 		cfg, err := backend.ControllerConfig()
@@ -686,6 +890,16 @@ func deployApplication(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// If no constraints were supplied, apply any default application
+	// constraints recorded for the model's cloud and region.
+	// Subordinates are always deployed without constraints.
+	cons := args.Constraints
+	if constraints.IsEmpty(&cons) && !ch.Meta().Subordinate {
+		cons, err = backend.ResolveDefaultApplicationConstraints(model.CloudName(), model.CloudRegion())
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 	_, err = deployApplicationFunc(backend, model, DeployApplicationParams{
 		ApplicationName:   args.ApplicationName,
 		Series:            args.Series,
@@ -695,7 +909,7 @@ func deployApplication(
 		NumUnits:          args.NumUnits,
 		ApplicationConfig: appConfig,
 		CharmConfig:       charmSettings,
-		Constraints:       args.Constraints,
+		Constraints:       cons,
 		Placement:         args.Placement,
 		Storage:           args.Storage,
 		Devices:           args.Devices,
@@ -707,6 +921,54 @@ func deployApplication(
 	return errors.Trace(err)
 }
 
+// validateWorkloadOS checks the OS family of the workload being deployed
+// against those the target model is able to host, so that an unsupported
+// platform is reported at deploy time rather than when the unit agent
+// fails to start.
+func validateWorkloadOS(m Model, ch Charm, requestedSeries string) error {
+	supported := model.ModelType(m.Type()).SupportedOSFamilies()
+	supportedNames := make([]string, len(supported))
+	for i, family := range supported {
+		supportedNames[i] = strings.ToLower(family.String())
+	}
+	isSupported := func(t os.OSType) bool {
+		for _, family := range supported {
+			if t == family {
+				return true
+			}
+		}
+		return false
+	}
+	if requestedSeries != "" {
+		osType, err := series.GetOSFromSeries(requestedSeries)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !isSupported(osType) {
+			return apiservererrors.NewErrUnsupportedPlatform(strings.ToLower(osType.String()), m.Name(), supportedNames)
+		}
+		return nil
+	}
+	// Without a requested series the charm must declare at least one
+	// series the model is able to host; series selection proper happens
+	// further down the deploy path.
+	lastUnsupported := os.Unknown
+	for _, charmSeries := range ch.Meta().Series {
+		osType, err := series.GetOSFromSeries(charmSeries)
+		if err != nil {
+			continue
+		}
+		if isSupported(osType) {
+			return nil
+		}
+		lastUnsupported = osType
+	}
+	if lastUnsupported != os.Unknown {
+		return apiservererrors.NewErrUnsupportedPlatform(strings.ToLower(lastUnsupported.String()), m.Name(), supportedNames)
+	}
+	return nil
+}
+
 func convertCharmOrigin(origin *params.CharmOrigin, curl *charm.URL, charmStoreChannel string) (corecharm.Origin, error) {
 	var (
 		originType string
@@ -770,13 +1032,14 @@ func convertCharmOrigin(origin *params.CharmOrigin, curl *charm.URL, charmStoreC
 	}
 
 	return corecharm.Origin{
-		Type:     originType,
-		Source:   corecharm.Source(origin.Source),
-		ID:       origin.ID,
-		Hash:     origin.Hash,
-		Revision: origin.Revision,
-		Channel:  channel,
-		Platform: platform,
+		Type:          originType,
+		Source:        corecharm.Source(origin.Source),
+		ID:            origin.ID,
+		Hash:          origin.Hash,
+		Revision:      origin.Revision,
+		Channel:       channel,
+		Platform:      platform,
+		BaseSelection: origin.BaseSelection,
 	}, nil
 }
 
@@ -1032,18 +1295,30 @@ func (api *APIBase) setConfig(app Application, generation, settingsYAML string,
 	}
 
 	var configChanged bool
+	var recorded []state.ConfigValueChange
 	if len(charmSettings) != 0 {
+		oldCharmSettings, err := app.CharmConfig(generation)
+		if err != nil {
+			return errors.Annotate(err, "obtaining charm config settings")
+		}
 		if err = app.UpdateCharmConfig(generation, charmSettings); err != nil {
 			return errors.Annotate(err, "updating charm config settings")
 		}
+		recorded = append(recorded, charmSettingChanges(oldCharmSettings, charmSettings)...)
 		configChanged = true
 	}
 	if cfgAttrs := appConfig.Attributes(); len(cfgAttrs) > 0 {
+		oldAppConfig, err := app.ApplicationConfig()
+		if err != nil {
+			return errors.Annotate(err, "obtaining application config settings")
+		}
 		if err = app.UpdateApplicationConfig(cfgAttrs, nil, appConfigSchema, defaults); err != nil {
 			return errors.Annotate(err, "updating application config settings")
 		}
+		recorded = append(recorded, appConfigChanges(appConfigSchema, oldAppConfig, cfgAttrs)...)
 		configChanged = true
 	}
+	api.recordConfigChange(app.Name(), recorded)
 
 	// If the config change is generational, add the app to the generation.
 	if configChanged && generation != model.GenerationMaster {
@@ -1483,9 +1758,10 @@ func (api *APIBase) GetCharmURLOrigin(args params.ApplicationGet) (params.CharmU
 
 func makeParamsCharmOrigin(origin *state.CharmOrigin) (params.CharmOrigin, error) {
 	retOrigin := params.CharmOrigin{
-		Source: origin.Source,
-		ID:     origin.ID,
-		Hash:   origin.Hash,
+		Source:        origin.Source,
+		ID:            origin.ID,
+		Hash:          origin.Hash,
+		BaseSelection: origin.BaseSelection,
 	}
 	if origin.Revision != nil {
 		retOrigin.Revision = origin.Revision
@@ -1640,9 +1916,25 @@ func (api *APIBase) Expose(args params.ApplicationExpose) error {
 		}
 	}
 
+	var exposedCIDRs []string
+	for _, exposeParams := range mappedExposeParams {
+		exposedCIDRs = append(exposedCIDRs, exposeParams.ExposeToCIDRs...)
+	}
+	if err := api.checkPolicy(policy.OpExpose, map[string]interface{}{
+		"application":     args.ApplicationName,
+		"expose-to-cidrs": exposedCIDRs,
+	}); err != nil {
+		return errors.Trace(err)
+	}
+
 	if err = app.MergeExposeSettings(mappedExposeParams); err != nil {
 		return apiservererrors.ServerError(err)
 	}
+	if args.ExposeDuration != nil {
+		if err = app.SetExposeDuration(*args.ExposeDuration); err != nil {
+			return apiservererrors.ServerError(err)
+		}
+	}
 	return nil
 }
 
@@ -1908,7 +2200,7 @@ func (api *APIBase) DestroyUnit(args params.DestroyUnitsParams) (params.DestroyU
 		}
 		return &info, nil
 	}
-	results := make([]params.DestroyUnitResult, len(args.Units))
+	results := make([]params.DestroyUnitResult, len(args.Units), len(args.Units)+len(args.Selectors))
 	for i, entity := range args.Units {
 		info, err := destroyUnit(entity)
 		if err != nil {
@@ -1917,11 +2209,173 @@ func (api *APIBase) DestroyUnit(args params.DestroyUnitsParams) (params.DestroyU
 		}
 		results[i].Info = info
 	}
+	for _, selector := range args.Selectors {
+		var result params.DestroyUnitResult
+		units, err := api.selectUnitsToDestroy(selector)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+			results = append(results, result)
+			continue
+		}
+		info := &params.DestroyUnitInfo{}
+		for _, unit := range units {
+			unitInfo, err := destroyUnit(params.DestroyUnitParams{
+				UnitTag:        unit.UnitTag().String(),
+				DestroyStorage: selector.DestroyStorage,
+				Force:          selector.Force,
+				MaxWait:        selector.MaxWait,
+			})
+			if err != nil {
+				info = nil
+				result.Error = apiservererrors.ServerError(errors.Annotatef(err, "destroying unit %q", unit.Name()))
+				break
+			}
+			info.DestroyedUnits = append(info.DestroyedUnits, params.Entity{Tag: unit.UnitTag().String()})
+			info.DestroyedStorage = append(info.DestroyedStorage, unitInfo.DestroyedStorage...)
+			info.DetachedStorage = append(info.DetachedStorage, unitInfo.DetachedStorage...)
+		}
+		result.Info = info
+		results = append(results, result)
+	}
 	return params.DestroyUnitResults{
 		Results: results,
 	}, nil
 }
 
+// selectUnitsToDestroy resolves a destroy-unit selector to the concrete
+// units it matches.
+func (api *APIBase) selectUnitsToDestroy(selector params.DestroyUnitSelector) ([]Unit, error) {
+	if !names.IsValidApplication(selector.Application) {
+		return nil, errors.NotValidf("application name %q", selector.Application)
+	}
+	if selector.Count < 0 {
+		return nil, errors.NotValidf("negative count")
+	}
+	app, err := api.backend.Application(selector.Application)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	units, err := app.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	inError := make(map[string]bool)
+	if selector.InError {
+		errorUnits, err := api.backend.UnitsInError()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, unit := range errorUnits {
+			inError[unit.Name()] = true
+		}
+	}
+	var matched []Unit
+	for _, unit := range units {
+		if unit.Life() != state.Alive || !unit.IsPrincipal() {
+			continue
+		}
+		if selector.InError && !inError[unit.Name()] {
+			continue
+		}
+		if selector.Machine != "" || selector.AvailabilityZone != "" {
+			machineID, err := unit.AssignedMachineId()
+			if errors.IsNotAssigned(err) {
+				continue
+			} else if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if selector.Machine != "" && machineID != selector.Machine {
+				continue
+			}
+			if selector.AvailabilityZone != "" {
+				machine, err := api.backend.Machine(machineID)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				zone, err := machine.AvailabilityZone()
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				if zone != selector.AvailabilityZone {
+					continue
+				}
+			}
+		}
+		matched = append(matched, unit)
+	}
+	if len(matched) == 0 {
+		return nil, errors.NotFoundf("units matching selector")
+	}
+	// Unit ids are allocated sequentially, so the lowest ordinals
+	// identify the longest standing units.
+	sort.Slice(matched, func(i, j int) bool {
+		return unitOrdinal(matched[i].Name()) < unitOrdinal(matched[j].Name())
+	})
+	if selector.Count > 0 && len(matched) > selector.Count {
+		matched = matched[:selector.Count]
+	}
+	return matched, nil
+}
+
+// ReplaceUnit replaces units with fresh units of the same
+// application. Detachable storage and annotations are carried over to
+// the replacement; the replaced unit is then removed. This recovers a
+// unit with a corrupted agent without manual storage reattachment.
+func (api *APIBase) ReplaceUnit(args params.ReplaceUnitsParams) (params.ReplaceUnitResults, error) {
+	if api.modelType == state.ModelTypeCAAS {
+		return params.ReplaceUnitResults{}, errors.NotSupportedf("replacing units on a container-based model")
+	}
+	if err := api.checkCanWrite(); err != nil {
+		return params.ReplaceUnitResults{}, errors.Trace(err)
+	}
+	// Replacement both adds and removes a unit, so it honours both
+	// the change and removal blocks.
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ReplaceUnitResults{}, errors.Trace(err)
+	}
+	if err := api.check.RemoveAllowed(); err != nil {
+		return params.ReplaceUnitResults{}, errors.Trace(err)
+	}
+	replaceUnit := func(arg params.ReplaceUnitParams) (string, error) {
+		unitTag, err := names.ParseUnitTag(arg.UnitTag)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		appName, err := names.UnitApplication(unitTag.Id())
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		app, err := api.backend.Application(appName)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		newUnit, err := app.ReplaceUnit(unitTag.Id())
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		return newUnit.UnitTag().String(), nil
+	}
+	results := make([]params.ReplaceUnitResult, len(args.Units))
+	for i, arg := range args.Units {
+		replacement, err := replaceUnit(arg)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].ReplacementUnit = replacement
+	}
+	return params.ReplaceUnitResults{Results: results}, nil
+}
+
+// unitOrdinal returns the numeric suffix of a unit name.
+func unitOrdinal(name string) int {
+	ordinal, err := strconv.Atoi(name[strings.LastIndex(name, "/")+1:])
+	if err != nil {
+		return -1
+	}
+	return ordinal
+}
+
 // Destroy destroys a given application, local or remote.
 //
 // NOTE(axw) this exists only for backwards compatibility,
@@ -2028,6 +2482,11 @@ func (api *APIBase) DestroyApplication(args params.DestroyApplicationsParams) (p
 				info.DetachedStorage = append(info.DetachedStorage, detached...)
 			}
 		}
+		if arg.DryRun {
+			// The projected effects have been computed; stop short
+			// of actually destroying anything.
+			return &info, nil
+		}
 		op := app.DestroyOperation()
 		op.DestroyStorage = arg.DestroyStorage
 		op.Force = arg.Force
@@ -2056,6 +2515,47 @@ func (api *APIBase) DestroyApplication(args params.DestroyApplicationsParams) (p
 	}, nil
 }
 
+// SetTeardownOrder isn't on the v16 API.
+func (api *APIv16) SetTeardownOrder(_, _ struct{}) {}
+
+// SetTeardownOrder records, for each given application, the
+// applications that must be removed from the model before it can be
+// destroyed. DestroyApplication and model destruction will not remove
+// an application without force while any of the applications it is
+// waiting for remain.
+func (api *APIBase) SetTeardownOrder(args params.ApplicationTeardownOrderArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, err
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	setOrder := func(arg params.ApplicationTeardownOrder) error {
+		tag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		after := make([]string, len(arg.After))
+		for i, afterTag := range arg.After {
+			t, err := names.ParseApplicationTag(afterTag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			after[i] = t.Id()
+		}
+		app, err := api.backend.Application(tag.Id())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(app.SetTeardownAfter(after))
+	}
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		results[i].Error = apiservererrors.ServerError(setOrder(arg))
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
 // DestroyConsumedApplications removes a given set of consumed (remote) applications.
 func (api *APIBase) DestroyConsumedApplications(args params.DestroyConsumedApplicationsParams) (params.ErrorResults, error) {
 	if err := api.checkCanWrite(); err != nil {
@@ -2404,7 +2904,7 @@ func (api *APIBase) consumeOne(arg params.ConsumeApplicationArg) error {
 	if appName == "" {
 		appName = arg.OfferName
 	}
-	_, err = api.saveRemoteApplication(sourceModelTag, appName, externalControllerUUID, arg.ApplicationOfferDetails, arg.Macaroon)
+	_, err = api.saveRemoteApplication(sourceModelTag, appName, externalControllerUUID, arg.ApplicationOfferDetails, arg.Macaroon, arg.PinnedEndpointInterfaces)
 	return err
 }
 
@@ -2416,13 +2916,29 @@ func (api *APIBase) saveRemoteApplication(
 	externalControllerUUID string,
 	offer params.ApplicationOfferDetails,
 	mac *macaroon.Macaroon,
+	pinnedInterfaces map[string]string,
 ) (RemoteApplication, error) {
 	remoteEps := make([]charm.Relation, len(offer.Endpoints))
 	for j, ep := range offer.Endpoints {
+		iface := ep.Interface
+		if pinned, ok := pinnedInterfaces[ep.Name]; ok {
+			// Check the pin against what the offer declares now, and
+			// record the pinned interface so the offering side can
+			// re-validate it each time a relation is registered.
+			if err := crossmodel.CheckCompatibleInterface(ep.Name, ep.Interface, pinned); err != nil {
+				return nil, errors.Trace(err)
+			}
+			iface = pinned
+		}
 		remoteEps[j] = charm.Relation{
 			Name:      ep.Name,
 			Role:      ep.Role,
-			Interface: ep.Interface,
+			Interface: iface,
+		}
+	}
+	for name := range pinnedInterfaces {
+		if !hasEndpoint(offer.Endpoints, name) {
+			return nil, errors.NotFoundf("pinned endpoint %q in offer %q", name, offer.OfferName)
 		}
 	}
 
@@ -2465,6 +2981,15 @@ func (api *APIBase) saveRemoteApplication(
 	})
 }
 
+func hasEndpoint(endpoints []params.RemoteEndpoint, name string) bool {
+	for _, ep := range endpoints {
+		if ep.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // providerSpaceInfoFromParams converts a params.RemoteSpace to the
 // equivalent ProviderSpaceInfo.
 func providerSpaceInfoFromParams(space params.RemoteSpace) *environs.ProviderSpaceInfo {
@@ -2607,13 +3132,38 @@ func (api *APIBase) CharmConfig(args params.ApplicationGetArgs) (params.Applicat
 		Results: make([]params.ConfigResult, len(args.Args)),
 	}
 	for i, arg := range args.Args {
-		config, err := api.getCharmConfig(arg.BranchName, arg.ApplicationName)
+		config, err := api.getCharmConfigForArg(arg)
 		results.Results[i].Config = config
 		results.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return results, nil
 }
 
+// getCharmConfigForArg returns the described charm config for a single
+// CharmConfig argument, annotating per-key provenance for non-master
+// branches and honouring the exclude-defaults option.
+func (api *APIBase) getCharmConfigForArg(arg params.ApplicationGet) (map[string]interface{}, error) {
+	config, err := api.getCharmConfig(arg.BranchName, arg.ApplicationName)
+	if err != nil {
+		return nil, err
+	}
+	if arg.BranchName != "" && arg.BranchName != model.GenerationMaster {
+		app, err := api.backend.Application(arg.ApplicationName)
+		if err != nil {
+			return nil, err
+		}
+		masterSettings, err := app.CharmConfig(model.GenerationMaster)
+		if err != nil {
+			return nil, err
+		}
+		annotateBranchProvenance(config, masterSettings)
+	}
+	if arg.ExcludeDefaults {
+		excludeDefaultSettings(config)
+	}
+	return config, nil
+}
+
 // GetConfig returns the charm config for each of the input applications.
 func (api *APIBase) GetConfig(args params.Entities) (params.ApplicationGetConfigResults, error) {
 	if err := api.checkCanRead(); err != nil {
@@ -2763,10 +3313,20 @@ func (api *APIBase) unsetApplicationConfig(arg params.ApplicationUnset) error {
 		}
 	}
 
+	var recorded []state.ConfigValueChange
 	if len(appConfigKeys) > 0 {
+		oldAppConfig, err := app.ApplicationConfig()
+		if err != nil {
+			return errors.Annotate(err, "obtaining application config values")
+		}
 		if err := app.UpdateApplicationConfig(nil, appConfigKeys, configSchema, defaults); err != nil {
 			return errors.Annotate(err, "updating application config values")
 		}
+		unset := make(config.ConfigAttributes)
+		for _, key := range appConfigKeys {
+			unset[key] = nil
+		}
+		recorded = append(recorded, appConfigChanges(configSchema, oldAppConfig, unset)...)
 	}
 
 	if len(charmSettings) > 0 {
@@ -2776,10 +3336,16 @@ func (api *APIBase) unsetApplicationConfig(arg params.ApplicationUnset) error {
 		if arg.BranchName == "" {
 			arg.BranchName = model.GenerationMaster
 		}
+		oldCharmSettings, err := app.CharmConfig(arg.BranchName)
+		if err != nil {
+			return errors.Annotate(err, "obtaining application charm settings")
+		}
 		if err := app.UpdateCharmConfig(arg.BranchName, charmSettings); err != nil {
 			return errors.Annotate(err, "updating application charm settings")
 		}
+		recorded = append(recorded, charmSettingChanges(oldCharmSettings, charmSettings)...)
 	}
+	api.recordConfigChange(arg.ApplicationName, recorded)
 	return nil
 }
 
@@ -2982,6 +3548,94 @@ func (api *APIBase) MergeBindings(in params.ApplicationMergeBindingsArgs) (param
 	return params.ErrorResults{Results: res}, nil
 }
 
+// SetAssignmentStrategy sets the strategy used to assign units without
+// explicit placement to machines, per application. Units already
+// assigned are not moved.
+func (api *APIBase) SetAssignmentStrategy(in params.ApplicationAssignmentStrategyArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	res := make([]params.ErrorResult, len(in.Args))
+	for i, arg := range in.Args {
+		app, err := api.backend.Application(arg.ApplicationName)
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := app.SetAssignmentStrategy(state.AssignmentStrategy(arg.Strategy)); err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: res}, nil
+}
+
+// SetImageChannel pins the base image channel used when provisioning
+// machines dedicated to an application, overriding the model default.
+// An empty channel removes the pin. Machines already provisioned are
+// not affected.
+func (api *APIBase) SetImageChannel(in params.ApplicationImageChannelArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	res := make([]params.ErrorResult, len(in.Args))
+	for i, arg := range in.Args {
+		app, err := api.backend.Application(arg.ApplicationName)
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := app.SetImageChannel(arg.ImageChannel); err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: res}, nil
+}
+
+// SetNICRequests records the network interfaces, such as SR-IOV or
+// bonded interfaces, that machines provisioned for an application must
+// be equipped with. The requests replace any previously set; an empty
+// set removes them. Machines already provisioned are not affected.
+func (api *APIBase) SetNICRequests(in params.ApplicationNICRequestsArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	res := make([]params.ErrorResult, len(in.Args))
+	for i, arg := range in.Args {
+		app, err := api.backend.Application(arg.ApplicationName)
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		requests := make([]network.NICRequest, len(arg.Requests))
+		for j, req := range arg.Requests {
+			requests[j] = network.NICRequest{
+				Type:    network.NICRequestType(req.Type),
+				Parents: req.Parents,
+				Count:   req.Count,
+			}
+		}
+		if err := app.SetNICRequests(requests); err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: res}, nil
+}
+
 // lxdCharmProfiler massages a *state.Charm into a LXDProfiler
 // inside of the core package.
 type lxdCharmProfiler struct {
@@ -3188,9 +3842,60 @@ func (api *APIBase) unitResultForUnit(unit Unit) (*params.UnitResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	if principalName, ok := unit.PrincipalName(); ok {
+		principal, err := api.unitSummary(principalName)
+		if err != nil {
+			return nil, err
+		}
+		result.Principal = principal
+	}
+	for _, subName := range unit.SubordinateNames() {
+		sub, err := api.unitSummary(subName)
+		if err != nil {
+			return nil, err
+		}
+		result.Subordinates = append(result.Subordinates, *sub)
+	}
+	return result, nil
+}
+
+// unitSummary builds a summary of the named unit, used to describe the
+// subordinates of a principal unit and vice versa.
+func (api *APIBase) unitSummary(unitName string) (*params.UnitSummary, error) {
+	unit, err := api.backend.Unit(unitName)
+	if err != nil {
+		return nil, err
+	}
+	app, err := api.backend.Application(unit.ApplicationName())
+	if err != nil {
+		return nil, err
+	}
+	curl, _ := app.CharmURL()
+	if curl == nil {
+		return nil, errors.NotValidf("application charm url")
+	}
+	result := &params.UnitSummary{
+		Tag:   unit.Tag().String(),
+		Charm: *curl,
+	}
+	if workloadStatus, err := unit.Status(); err == nil {
+		result.WorkloadStatus = unitDetailedStatus(workloadStatus)
+	}
+	if agentStatus, err := unit.AgentStatus(); err == nil {
+		result.AgentStatus = unitDetailedStatus(agentStatus)
+	}
 	return result, nil
 }
 
+func unitDetailedStatus(in status.StatusInfo) *params.DetailedStatus {
+	return &params.DetailedStatus{
+		Status: in.Status.String(),
+		Info:   in.Message,
+		Data:   in.Data,
+		Since:  in.Since,
+	}
+}
+
 // openPortsOnMachineForUnit returns the unique set of opened ports for the
 // specified unit and machine arguments without distinguishing between port
 // ranges across subnets. This method is provided for backwards compatibility
@@ -142,7 +142,7 @@ func (s *applicationSuite) makeAPI(c *gc.C) *application.APIv15 {
 		nil, // CAAS Broker not used in this suite.
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	return &application.APIv15{api}
+	return &application.APIv15{&application.APIv16{&application.APIv17{&application.APIv18{api}}}}
 }
 
 func (s *applicationSuite) TestCharmConfig(c *gc.C) {
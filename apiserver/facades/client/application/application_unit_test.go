@@ -153,6 +153,7 @@ func (s *ApplicationSuite) setup(c *gc.C) *gomock.Controller {
 		controller.NewConfig(coretesting.ControllerTag.Id(), coretesting.CACert, map[string]interface{}{}),
 	).AnyTimes()
 	s.backend.EXPECT().ControllerTag().Return(coretesting.ControllerTag).AnyTimes()
+	s.backend.EXPECT().AllWebhookEndpoints().Return(nil, nil).AnyTimes()
 
 	s.storageAccess = mocks.NewMockStorageInterface(ctrl)
 	s.storageAccess.EXPECT().VolumeAccess().Return(nil).AnyTimes()
@@ -201,7 +202,7 @@ func (s *ApplicationSuite) setup(c *gc.C) *gomock.Controller {
 		s.caasBroker,
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = &application.APIv15{api}
+	s.api = &application.APIv15{&application.APIv16{&application.APIv17{&application.APIv18{api}}}}
 	return ctrl
 }
 
@@ -726,6 +727,8 @@ func (s *ApplicationSuite) expectUnit(ctrl *gomock.Controller, name string) *moc
 	unit.EXPECT().AssignedMachineId().Return(machineId, nil).AnyTimes()
 	unit.EXPECT().WorkloadVersion().Return("666", nil).AnyTimes()
 	unit.EXPECT().Life().Return(state.Alive).AnyTimes()
+	unit.EXPECT().PrincipalName().Return("", false).AnyTimes()
+	unit.EXPECT().SubordinateNames().Return(nil).AnyTimes()
 	return unit
 }
 
@@ -1026,6 +1029,96 @@ func (s *ApplicationSuite) TestDestroyUnit(c *gc.C) {
 	}})
 }
 
+func (s *ApplicationSuite) TestReplaceUnit(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	app := mocks.NewMockApplication(ctrl)
+	newUnit := s.expectUnit(ctrl, "postgresql/1")
+	app.EXPECT().ReplaceUnit("postgresql/0").Return(newUnit, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	results, err := s.api.ReplaceUnit(params.ReplaceUnitsParams{
+		Units: []params.ReplaceUnitParams{{UnitTag: "unit-postgresql-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].ReplacementUnit, gc.Equals, "unit-postgresql-1")
+}
+
+func (s *ApplicationSuite) TestReplaceUnitInvalidTag(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	results, err := s.api.ReplaceUnit(params.ReplaceUnitsParams{
+		Units: []params.ReplaceUnitParams{{UnitTag: "machine-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `"machine-0" is not a valid unit tag`)
+}
+
+func (s *ApplicationSuite) TestReplaceUnitCAASNotSupported(c *gc.C) {
+	s.modelType = state.ModelTypeCAAS
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	_, err := s.api.ReplaceUnit(params.ReplaceUnitsParams{
+		Units: []params.ReplaceUnitParams{{UnitTag: "unit-postgresql-0"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "replacing units on a container-based model not supported")
+}
+
+func (s *ApplicationSuite) TestDestroyUnitSelector(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	unit0 := s.expectUnit(ctrl, "postgresql/0")
+	unit0.EXPECT().IsPrincipal().Return(true).Times(2)
+	unit0.EXPECT().DestroyOperation().Return(&state.DestroyUnitOperation{})
+	unit1 := s.expectUnit(ctrl, "postgresql/1")
+	unit1.EXPECT().IsPrincipal().Return(true)
+
+	app := mocks.NewMockApplication(ctrl)
+	// Units deliberately out of age order; the oldest is selected first.
+	app.EXPECT().AllUnits().Return([]application.Unit{unit1, unit0}, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	s.backend.EXPECT().Unit("postgresql/0").Return(unit0, nil)
+	s.storageAccess.EXPECT().UnitStorageAttachments(names.NewUnitTag("postgresql/0")).Return(nil, nil)
+	s.backend.EXPECT().ApplyOperation(&state.DestroyUnitOperation{}).Return(nil)
+
+	results, err := s.api.DestroyUnit(params.DestroyUnitsParams{
+		Selectors: []params.DestroyUnitSelector{{
+			Application: "postgresql",
+			Count:       1,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.DestroyUnitResult{{
+		Info: &params.DestroyUnitInfo{
+			DestroyedUnits: []params.Entity{{Tag: "unit-postgresql-0"}},
+		},
+	}})
+}
+
+func (s *ApplicationSuite) TestDestroyUnitSelectorNoMatch(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	app := mocks.NewMockApplication(ctrl)
+	app.EXPECT().AllUnits().Return(nil, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	results, err := s.api.DestroyUnit(params.DestroyUnitsParams{
+		Selectors: []params.DestroyUnitSelector{{Application: "postgresql"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "units matching selector not found")
+}
+
 func (s *ApplicationSuite) TestForceDestroyUnit(c *gc.C) {
 	ctrl := s.setup(c)
 	defer ctrl.Finish()
@@ -1092,7 +1185,7 @@ func (s *ApplicationSuite) TestDeployAttachStorage(c *gc.C) {
 	defer ctrl.Finish()
 
 	ch := s.expectDefaultCharm(ctrl)
-	s.backend.EXPECT().Charm(gomock.Any()).Return(ch, nil).Times(3)
+	s.backend.EXPECT().Charm(gomock.Any()).Return(ch, nil).Times(4)
 	s.backend.EXPECT().AllSpaceInfos().Return(network.SpaceInfos{}, nil).MinTimes(1)
 
 	args := params.ApplicationsDeploy{
@@ -1129,7 +1222,7 @@ func (s *ApplicationSuite) TestDeployCharmOrigin(c *gc.C) {
 	defer ctrl.Finish()
 
 	ch := s.expectDefaultCharm(ctrl)
-	s.backend.EXPECT().Charm(gomock.Any()).Return(ch, nil).Times(3)
+	s.backend.EXPECT().Charm(gomock.Any()).Return(ch, nil).Times(6)
 	s.backend.EXPECT().AllSpaceInfos().Return(network.SpaceInfos{}, nil).MinTimes(1)
 
 	track := "latest"
@@ -1172,6 +1265,62 @@ func (s *ApplicationSuite) TestDeployCharmOrigin(c *gc.C) {
 	c.Assert(s.deployParams["hub"].CharmOrigin.Source, gc.Equals, corecharm.Source("charm-hub"))
 }
 
+func (s *ApplicationSuite) TestDeploySubordinateFeasibility(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	ch := s.expectCharm(ctrl, &charm.Meta{
+		Name:        "charm-nrpe",
+		Subordinate: true,
+		Series:      []string{"bionic"},
+	}, &charm.Manifest{}, &charm.Config{})
+	// Once for the deploy itself, once for the feasibility report.
+	s.backend.EXPECT().Charm(gomock.Any()).Return(ch, nil).Times(2)
+	s.backend.EXPECT().AllSpaceInfos().Return(network.SpaceInfos{}, nil).MinTimes(1)
+
+	unit0 := mocks.NewMockUnit(ctrl)
+	unit0.EXPECT().AssignedMachineId().Return("0", nil)
+	unit1 := mocks.NewMockUnit(ctrl)
+	unit1.EXPECT().AssignedMachineId().Return("1", nil)
+
+	mysql := mocks.NewMockApplication(ctrl)
+	mysql.EXPECT().IsPrincipal().Return(true)
+	mysql.EXPECT().Name().Return("mysql").AnyTimes()
+	mysql.EXPECT().AllUnits().Return([]application.Unit{unit0}, nil)
+	wordpress := mocks.NewMockApplication(ctrl)
+	wordpress.EXPECT().IsPrincipal().Return(true)
+	wordpress.EXPECT().Name().Return("wordpress").AnyTimes()
+	wordpress.EXPECT().AllUnits().Return([]application.Unit{unit1}, nil)
+	s.backend.EXPECT().AllApplications().Return([]application.Application{mysql, wordpress}, nil)
+
+	machine0 := mocks.NewMockMachine(ctrl)
+	machine0.EXPECT().Series().Return("bionic")
+	machine1 := mocks.NewMockMachine(ctrl)
+	machine1.EXPECT().Series().Return("focal")
+	s.backend.EXPECT().Machine("0").Return(machine0, nil)
+	s.backend.EXPECT().Machine("1").Return(machine1, nil)
+
+	args := params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			ApplicationName: "nrpe",
+			CharmURL:        "local:nrpe-0",
+			CharmOrigin:     &params.CharmOrigin{Source: "local", Series: "bionic"},
+		}},
+	}
+	results, err := s.api.Deploy(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].PrincipalFeasibility, jc.DeepEquals, []params.PrincipalFeasibility{{
+		Principal: "mysql",
+		Feasible:  true,
+	}, {
+		Principal: "wordpress",
+		Feasible:  false,
+		Message:   `machine 1 runs series "focal", which the charm does not support (bionic)`,
+	}})
+}
+
 func (s *ApplicationSuite) expectDefaultK8sModelConfig() {
 	attrs := coretesting.FakeConfig().Merge(map[string]interface{}{
 		"operator-storage": "k8s-operator-storage",
@@ -2760,6 +2909,57 @@ func (s *ApplicationSuite) TestUnitsInfo(c *gc.C) {
 	})
 }
 
+func (s *ApplicationSuite) TestUnitsInfoSubordinates(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	unit := mocks.NewMockUnit(ctrl)
+	unit.EXPECT().Name().Return("postgresql/0").AnyTimes()
+	unit.EXPECT().Tag().Return(names.NewUnitTag("postgresql/0")).AnyTimes()
+	unit.EXPECT().ApplicationName().Return("postgresql").AnyTimes()
+	unit.EXPECT().AssignedMachineId().Return("0", nil).AnyTimes()
+	unit.EXPECT().WorkloadVersion().Return("666", nil).AnyTimes()
+	unit.EXPECT().Life().Return(state.Alive).AnyTimes()
+	unit.EXPECT().PrincipalName().Return("", false)
+	unit.EXPECT().SubordinateNames().Return([]string{"nrpe/0"})
+	unit.EXPECT().ContainerInfo().Return(nil, errors.NotFoundf("container"))
+	s.backend.EXPECT().Unit("postgresql/0").Return(unit, nil)
+
+	app := s.expectDefaultApplication(ctrl)
+	curl := "cs:postgresql-42"
+	app.EXPECT().CharmURL().Return(&curl, true)
+	app.EXPECT().Relations().Return(nil, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	s.backend.EXPECT().Machine("0").Return(s.expectMachine(ctrl, "10.0.0.1"), nil)
+	s.model.EXPECT().OpenedPortRangesForMachine("0").Return(s.expectMachineUnitPortRange(ctrl, "postgresql/0", "100-102/tcp"), nil)
+
+	now := time.Now()
+	sub := mocks.NewMockUnit(ctrl)
+	sub.EXPECT().Tag().Return(names.NewUnitTag("nrpe/0")).AnyTimes()
+	sub.EXPECT().ApplicationName().Return("nrpe").AnyTimes()
+	sub.EXPECT().Status().Return(status.StatusInfo{Status: status.Active, Message: "ok", Since: &now}, nil)
+	sub.EXPECT().AgentStatus().Return(status.StatusInfo{Status: status.Idle, Since: &now}, nil)
+	s.backend.EXPECT().Unit("nrpe/0").Return(sub, nil)
+
+	subApp := mocks.NewMockApplication(ctrl)
+	subCurl := "cs:nrpe-12"
+	subApp.EXPECT().CharmURL().Return(&subCurl, true)
+	s.backend.EXPECT().Application("nrpe").Return(subApp, nil)
+
+	result, err := s.api.UnitsInfo(params.Entities{Entities: []params.Entity{{Tag: "unit-postgresql-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[0].Result.Principal, gc.IsNil)
+	c.Assert(result.Results[0].Result.Subordinates, jc.DeepEquals, []params.UnitSummary{{
+		Tag:            "unit-nrpe-0",
+		Charm:          "cs:nrpe-12",
+		WorkloadStatus: &params.DetailedStatus{Status: "active", Info: "ok", Since: &now},
+		AgentStatus:    &params.DetailedStatus{Status: "idle", Since: &now},
+	}})
+}
+
 func (s *ApplicationSuite) TestUnitsInfoForApplication(c *gc.C) {
 	ctrl := s.setup(c)
 	defer ctrl.Finish()
@@ -3039,3 +3239,298 @@ func (s *ApplicationSuite) TestApplicationGetCharmURLOriginMissingOS(c *gc.C) {
 		InstanceKey:  charmhub.CreateInstanceKey(app.ApplicationTag(), s.model.ModelTag()),
 	})
 }
+
+func (s *ApplicationSuite) TestExportImportRelationDataRoundTrip(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.model.EXPECT().UUID().Return(coretesting.ModelTag.Id()).AnyTimes()
+
+	unit := mocks.NewMockUnit(ctrl)
+	unit.EXPECT().Name().Return("postgresql/0").AnyTimes()
+
+	relUnit := mocks.NewMockRelationUnit(ctrl)
+	relUnit.EXPECT().InScope().Return(true, nil)
+	relUnit.EXPECT().Settings().Return(map[string]interface{}{"private-address": "10.0.0.1"}, nil)
+
+	rel := mocks.NewMockRelation(ctrl)
+	rel.EXPECT().Tag().Return(names.NewRelationTag("wordpress:db postgresql:server"))
+	rel.EXPECT().ApplicationSettings("postgresql").Return(map[string]interface{}{"hello": "world"}, nil)
+	rel.EXPECT().Unit("postgresql/0").Return(relUnit, nil)
+
+	app := mocks.NewMockApplication(ctrl)
+	app.EXPECT().AllUnits().Return([]application.Unit{unit}, nil)
+	app.EXPECT().Relations().Return([]application.Relation{rel}, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	result, err := s.api.ExportRelationData(params.ExportRelationDataArgs{ApplicationName: "postgresql"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+
+	doc := result.Document
+	c.Check(doc.ModelUUID, gc.Equals, coretesting.ModelTag.Id())
+	c.Check(doc.Application, gc.Equals, "postgresql")
+	c.Check(doc.Signature, gc.Not(gc.Equals), "")
+	c.Assert(doc.Relations, gc.HasLen, 1)
+	c.Check(doc.Relations[0].Relation, gc.Equals, "wordpress:db postgresql:server")
+	c.Check(doc.Relations[0].ApplicationSettings, jc.DeepEquals, map[string]interface{}{"hello": "world"})
+	c.Check(doc.Relations[0].UnitSettings, jc.DeepEquals, map[string]map[string]interface{}{
+		"postgresql/0": {"private-address": "10.0.0.1"},
+	})
+
+	// Import the document we just exported, as if the application and
+	// its relations had been recreated in the meantime.
+	importRelUnit := mocks.NewMockRelationUnit(ctrl)
+	importRelUnit.EXPECT().InScope().Return(true, nil)
+	importRelUnit.EXPECT().UpdateSettings(map[string]interface{}{"private-address": "10.0.0.1"}).Return(nil)
+
+	importRel := mocks.NewMockRelation(ctrl)
+	importRel.EXPECT().UpdateApplicationSettings("postgresql", map[string]interface{}{"hello": "world"}).Return(nil)
+	importRel.EXPECT().Unit("postgresql/0").Return(importRelUnit, nil)
+	s.backend.EXPECT().KeyRelation("wordpress:db postgresql:server").Return(importRel, nil)
+
+	errResult, err := s.api.ImportRelationData(params.ImportRelationDataArgs{Document: doc})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errResult.Error, gc.IsNil)
+}
+
+func (s *ApplicationSuite) TestImportRelationDataBadSignature(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.model.EXPECT().UUID().Return(coretesting.ModelTag.Id()).AnyTimes()
+
+	doc := params.RelationDataDocument{
+		ModelUUID:   coretesting.ModelTag.Id(),
+		Application: "postgresql",
+		Signature:   "mangled",
+	}
+	result, err := s.api.ImportRelationData(params.ImportRelationDataArgs{Document: doc})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, "relation data document signature not valid")
+}
+
+func (s *ApplicationSuite) TestImportRelationDataWrongModel(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.model.EXPECT().UUID().Return(coretesting.ModelTag.Id()).AnyTimes()
+
+	doc := params.RelationDataDocument{
+		ModelUUID:   "not-this-model",
+		Application: "postgresql",
+	}
+	result, err := s.api.ImportRelationData(params.ImportRelationDataArgs{Document: doc})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, `relation data document exported from model "not-this-model" not valid`)
+}
+
+func (s *ApplicationSuite) TestSetTeardownOrder(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	app := mocks.NewMockApplication(ctrl)
+	app.EXPECT().SetTeardownAfter([]string{"wordpress"}).Return(nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	results, err := s.api.APIv16.APIv17.SetTeardownOrder(params.ApplicationTeardownOrderArgs{
+		Args: []params.ApplicationTeardownOrder{{
+			ApplicationTag: "application-postgresql",
+			After:          []string{"application-wordpress"},
+		}, {
+			ApplicationTag: "machine-0",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `"machine-0" is not a valid application tag`)
+}
+
+func (s *ApplicationSuite) TestSetTeardownOrderBlocked(c *gc.C) {
+	s.changeAllowed = errors.New("change blocked")
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	_, err := s.api.APIv16.APIv17.SetTeardownOrder(params.ApplicationTeardownOrderArgs{})
+	c.Assert(err, gc.ErrorMatches, "change blocked")
+}
+
+func (s *ApplicationSuite) TestConfigChangeHistory(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	later := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	earlier := later.Add(-time.Hour)
+	s.backend.EXPECT().ConfigChangeHistory("postgresql").Return([]state.ConfigChange{{
+		Application: "postgresql",
+		Author:      "user-admin",
+		Timestamp:   later,
+		Changes:     []state.ConfigValueChange{{Key: "admin-password", Redacted: true}},
+	}, {
+		Application: "postgresql",
+		Author:      "user-admin",
+		Timestamp:   earlier,
+		Changes:     []state.ConfigValueChange{{Key: "hostname", OldValue: "a", NewValue: "b"}},
+	}}, nil)
+
+	results, err := s.api.ConfigChangeHistory(params.ConfigChangeHistoryArgs{
+		Applications: []string{"postgresql"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	// The backend's most-recent-first ordering is preserved, and
+	// redacted entries stay redacted.
+	c.Assert(results.Results[0].Changes, jc.DeepEquals, []params.ApplicationConfigChange{{
+		Author:    "user-admin",
+		Timestamp: later,
+		Changes:   []params.ConfigValueChange{{Key: "admin-password", Redacted: true}},
+	}, {
+		Author:    "user-admin",
+		Timestamp: earlier,
+		Changes:   []params.ConfigValueChange{{Key: "hostname", OldValue: "a", NewValue: "b"}},
+	}})
+}
+
+func (s *ApplicationSuite) TestConfigChangeHistoryError(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.backend.EXPECT().ConfigChangeHistory("mysql").Return(nil, errors.NotFoundf("application mysql"))
+
+	results, err := s.api.ConfigChangeHistory(params.ConfigChangeHistoryArgs{
+		Applications: []string{"mysql"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "application mysql not found")
+}
+
+func (s *ApplicationSuite) expectRelationInfoRelation(ctrl *gomock.Controller, appName string) *mocks.MockRelation {
+	rel := mocks.NewMockRelation(ctrl)
+	rel.EXPECT().Tag().Return(names.NewRelationTag("wordpress:db " + appName + ":server"))
+	rel.EXPECT().Life().Return(state.Alive)
+	rel.EXPECT().Suspended().Return(false)
+	rel.EXPECT().Endpoints().Return([]state.Endpoint{{
+		ApplicationName: appName,
+		Relation: charm.Relation{
+			Name:      "server",
+			Role:      charm.RoleProvider,
+			Interface: "pgsql",
+			Scope:     charm.ScopeGlobal,
+		},
+	}})
+	return rel
+}
+
+func (s *ApplicationSuite) TestRelationInfoIncludeData(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	unit := mocks.NewMockUnit(ctrl)
+	unit.EXPECT().Name().Return("postgresql/0").AnyTimes()
+
+	relUnit := mocks.NewMockRelationUnit(ctrl)
+	relUnit.EXPECT().InScope().Return(true, nil)
+	relUnit.EXPECT().Settings().Return(map[string]interface{}{
+		"private-address": "10.0.0.1",
+		"db-password":     "sekrit",
+	}, nil)
+
+	rel := s.expectRelationInfoRelation(ctrl, "postgresql")
+	rel.EXPECT().ApplicationSettings("postgresql").Return(map[string]interface{}{
+		"hostname":  "db.internal",
+		"api-token": "tok",
+	}, nil)
+	rel.EXPECT().Unit("postgresql/0").Return(relUnit, nil)
+	s.backend.EXPECT().Relation(0).Return(rel, nil)
+
+	app := mocks.NewMockApplication(ctrl)
+	app.EXPECT().AllUnits().Return([]application.Unit{unit}, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	results, err := s.api.RelationInfo(params.RelationInfoArgs{
+		RelationIds: []int{0},
+		IncludeData: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, gc.IsNil)
+	c.Check(result.Key, gc.Equals, "wordpress:db postgresql:server")
+	c.Assert(result.Data, jc.DeepEquals, []params.RelationInfoData{{
+		Application: "postgresql",
+		ApplicationSettings: map[string]interface{}{
+			"hostname":  "db.internal",
+			"api-token": "<redacted>",
+		},
+		UnitSettings: map[string]map[string]interface{}{
+			"postgresql/0": {
+				"private-address": "10.0.0.1",
+				"db-password":     "<redacted>",
+			},
+		},
+	}})
+}
+
+func (s *ApplicationSuite) TestRelationInfoDataRequiresWriteAccess(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("readaccess"),
+	}
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	// No data bag expectations: a read-only user asking for data is
+	// downgraded to the data-free result rather than refused.
+	rel := s.expectRelationInfoRelation(ctrl, "postgresql")
+	s.backend.EXPECT().Relation(0).Return(rel, nil)
+
+	results, err := s.api.RelationInfo(params.RelationInfoArgs{
+		RelationIds: []int{0},
+		IncludeData: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[0].Data, gc.IsNil)
+}
+
+func (s *ApplicationSuite) TestRelationInfoCrossModelApplication(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	rel := s.expectRelationInfoRelation(ctrl, "remote-mysql")
+	rel.EXPECT().ApplicationSettings("remote-mysql").Return(map[string]interface{}{
+		"hostname": "mysql.other",
+	}, nil)
+	s.backend.EXPECT().Relation(0).Return(rel, nil)
+	// The application lives on the other side of a cross model
+	// relation, so it is not in this model.
+	s.backend.EXPECT().Application("remote-mysql").Return(nil, errors.NotFoundf("application remote-mysql"))
+
+	results, err := s.api.RelationInfo(params.RelationInfoArgs{
+		RelationIds: []int{0},
+		IncludeData: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.Data, jc.DeepEquals, []params.RelationInfoData{{
+		Application: "remote-mysql",
+		ApplicationSettings: map[string]interface{}{
+			"hostname": "mysql.other",
+		},
+	}})
+}
+
+func (s *ApplicationSuite) TestRelationInfoNotFound(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.backend.EXPECT().Relation(42).Return(nil, errors.NotFoundf("relation 42"))
+
+	results, err := s.api.RelationInfo(params.RelationInfoArgs{RelationIds: []int{42}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0].Error, gc.ErrorMatches, "relation 42 not found")
+}
@@ -32,6 +32,7 @@ import (
 // facade. For details on the methods, see the methods on state.State
 // with the same names.
 type Backend interface {
+	AllApplications() ([]Application, error)
 	AllModelUUIDs() ([]string, error)
 	Application(string) (Application, error)
 	ApplyOperation(state.ModelOperation) error
@@ -41,6 +42,7 @@ type Backend interface {
 	AddRelation(...state.Endpoint) (Relation, error)
 	Charm(*charm.URL) (Charm, error)
 	Relation(int) (Relation, error)
+	KeyRelation(string) (Relation, error)
 	InferEndpoints(...string) ([]state.Endpoint, error)
 	InferActiveRelation(...string) (Relation, error)
 	Machine(string) (Machine, error)
@@ -49,11 +51,15 @@ type Backend interface {
 	UnitsInError() ([]Unit, error)
 	SaveController(info crossmodel.ControllerInfo, modelUUID string) (ExternalController, error)
 	ControllerTag() names.ControllerTag
+	AllWebhookEndpoints() ([]state.WebhookEndpoint, error)
 	ControllerConfig() (controller.Config, error)
 	Resources() Resources
 	OfferConnectionForRelation(string) (OfferConnection, error)
 	SaveEgressNetworks(relationKey string, cidrs []string) (state.RelationNetworks, error)
 	Branch(string) (Generation, error)
+	ResolveDefaultApplicationConstraints(cloudName, regionName string) (constraints.Value, error)
+	RecordConfigChange(state.ConfigChange) error
+	ConfigChangeHistory(string) ([]state.ConfigChange, error)
 	state.EndpointBinding
 }
 
@@ -96,8 +102,13 @@ type Application interface {
 	SetConstraints(constraints.Value) error
 	MergeExposeSettings(map[string]state.ExposedEndpoint) error
 	UnsetExposeSettings([]string) error
+	SetAssignmentStrategy(state.AssignmentStrategy) error
+	SetExposeDuration(time.Duration) error
+	SetImageChannel(string) error
 	SetMetricCredentials([]byte) error
 	SetMinUnits(int) error
+	SetNICRequests([]network.NICRequest) error
+	SetTeardownAfter([]string) error
 	UpdateApplicationSeries(string, bool) error
 	UpdateCharmConfig(string, charm.Settings) error
 	UpdateApplicationConfig(coreconfig.ConfigAttributes, []string, environschema.Fields, schema.Defaults) error
@@ -106,6 +117,7 @@ type Application interface {
 	AgentTools() (*tools.Tools, error)
 	MergeBindings(*state.Bindings, bool) error
 	Relations() ([]Relation, error)
+	ReplaceUnit(string) (Unit, error)
 }
 
 // Bindings defines a subset of the functionality provided by the
@@ -134,7 +146,10 @@ type Charm interface {
 // details on the methods, see the methods on state.Machine with
 // the same names.
 type Machine interface {
+	AvailabilityZone() (string, error)
+	HardwareCharacteristics() (*instance.HardwareCharacteristics, error)
 	PublicAddress() (network.SpaceAddress, error)
+	Series() string
 	IsLockedForSeriesUpgrade() (bool, error)
 	IsParentLockedForSeriesUpgrade() (bool, error)
 }
@@ -149,21 +164,26 @@ type Relation interface {
 	Destroy() error
 	DestroyWithForce(bool, time.Duration) ([]error, error)
 	Id() int
+	Life() state.Life
 	Endpoints() []state.Endpoint
 	RelatedEndpoints(applicationname string) ([]state.Endpoint, error)
 	ApplicationSettings(appName string) (map[string]interface{}, error)
+	UpdateApplicationSettings(appName string, settings map[string]interface{}) error
 	AllRemoteUnits(appName string) ([]RelationUnit, error)
 	Unit(string) (RelationUnit, error)
 	Endpoint(string) (state.Endpoint, error)
 	SetSuspended(bool, string) error
 	Suspended() bool
 	SuspendedReason() string
+	SetNetworkCharacteristics(state.RelationNetworkCharacteristics) error
+	NetworkCharacteristics() (state.RelationNetworkCharacteristics, error)
 }
 
 type RelationUnit interface {
 	UnitName() string
 	InScope() (bool, error)
 	Settings() (map[string]interface{}, error)
+	UpdateSettings(map[string]interface{}) error
 }
 
 // Unit defines a subset of the functionality provided by the
@@ -178,9 +198,13 @@ type Unit interface {
 	Destroy() error
 	DestroyOperation() *state.DestroyUnitOperation
 	IsPrincipal() bool
+	PrincipalName() (string, bool)
+	SubordinateNames() []string
 	Life() state.Life
 	Resolve(retryHooks bool) error
 	AgentTools() (*tools.Tools, error)
+	Status() (status.StatusInfo, error)
+	AgentStatus() (status.StatusInfo, error)
 
 	AssignedMachineId() (string, error)
 	WorkloadVersion() (string, error)
@@ -293,6 +317,18 @@ func CharmToStateCharm(ch Charm) *state.Charm {
 	return ch.(stateCharmShim).Charm
 }
 
+func (s stateShim) AllApplications() ([]Application, error) {
+	apps, err := s.State.AllApplications()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Application, len(apps))
+	for i, a := range apps {
+		out[i] = stateApplicationShim{a, s.State}
+	}
+	return out, nil
+}
+
 func (s stateShim) Application(name string) (Application, error) {
 	a, err := s.State.Application(name)
 	if err != nil {
@@ -373,6 +409,14 @@ func (s stateShim) Relation(id int) (Relation, error) {
 	return stateRelationShim{r, s.State}, nil
 }
 
+func (s stateShim) KeyRelation(key string) (Relation, error) {
+	r, err := s.State.KeyRelation(key)
+	if err != nil {
+		return nil, err
+	}
+	return stateRelationShim{r, s.State}, nil
+}
+
 func (s stateShim) InferActiveRelation(names ...string) (Relation, error) {
 	r, err := s.State.InferActiveRelation(names...)
 	if err != nil {
@@ -440,6 +484,14 @@ func (a stateApplicationShim) AddUnit(args state.AddUnitParams) (Unit, error) {
 	return stateUnitShim{u, a.st}, nil
 }
 
+func (a stateApplicationShim) ReplaceUnit(unitName string) (Unit, error) {
+	u, err := a.Application.ReplaceUnit(unitName)
+	if err != nil {
+		return nil, err
+	}
+	return stateUnitShim{u, a.st}, nil
+}
+
 func (a stateApplicationShim) Charm() (Charm, bool, error) {
 	ch, force, err := a.Application.Charm()
 	if err != nil {
@@ -501,6 +553,21 @@ func (r stateRelationShim) Unit(unitName string) (RelationUnit, error) {
 	return stateRelationUnitShim{ru}, nil
 }
 
+// UpdateApplicationSettings updates the given application's data bag in
+// this relation. The update is made with the authority of the facade
+// rather than a unit leadership token, so it is only suitable for
+// client-driven administrative operations.
+func (r stateRelationShim) UpdateApplicationSettings(appName string, settings map[string]interface{}) error {
+	return errors.Trace(r.Relation.UpdateApplicationSettings(appName, successfulToken{}, settings))
+}
+
+type successfulToken struct{}
+
+// Check is all of the lease.Token interface.
+func (t successfulToken) Check(attempt int, key interface{}) error {
+	return nil
+}
+
 func (r stateRelationShim) AllRemoteUnits(appName string) ([]RelationUnit, error) {
 	rus, err := r.Relation.AllRemoteUnits(appName)
 	if err != nil {
@@ -525,6 +592,16 @@ func (ru stateRelationUnitShim) Settings() (map[string]interface{}, error) {
 	return s.Map(), nil
 }
 
+func (ru stateRelationUnitShim) UpdateSettings(updates map[string]interface{}) error {
+	s, err := ru.RelationUnit.Settings()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	s.Update(updates)
+	_, err = s.Write()
+	return errors.Trace(err)
+}
+
 type stateUnitShim struct {
 	*state.Unit
 	st *state.State
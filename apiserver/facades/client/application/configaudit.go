@@ -0,0 +1,154 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/juju/charm/v8"
+	"github.com/juju/errors"
+	"gopkg.in/juju/environschema.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// secretKeyHints are substrings that mark a config key as likely to
+// hold a secret. Charm metadata has no way to declare an option as
+// secret, so charm settings are redacted on naming convention alone.
+var secretKeyHints = []string{
+	"password",
+	"secret",
+	"token",
+	"private-key",
+	"credential",
+}
+
+func isSecretConfigKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, hint := range secretKeyHints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// charmSettingChanges diffs the applied charm settings against the
+// previous values, redacting any key that looks like a secret.
+func charmSettingChanges(old, applied charm.Settings) []state.ConfigValueChange {
+	keys := make([]string, 0, len(applied))
+	for key := range applied {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []state.ConfigValueChange
+	for _, key := range keys {
+		oldValue := old[key]
+		newValue := applied[key]
+		if oldValue == newValue {
+			continue
+		}
+		change := state.ConfigValueChange{Key: key}
+		if isSecretConfigKey(key) {
+			change.Redacted = true
+		} else {
+			change.OldValue = oldValue
+			change.NewValue = newValue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// appConfigChanges diffs the applied application config against the
+// previous values, redacting keys the schema declares secret as well
+// as any key that looks like one.
+func appConfigChanges(schema environschema.Fields, old, applied coreconfig.ConfigAttributes) []state.ConfigValueChange {
+	keys := make([]string, 0, len(applied))
+	for key := range applied {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []state.ConfigValueChange
+	for _, key := range keys {
+		oldValue := old[key]
+		newValue := applied[key]
+		if oldValue == newValue {
+			continue
+		}
+		change := state.ConfigValueChange{Key: key}
+		if schema[key].Secret || isSecretConfigKey(key) {
+			change.Redacted = true
+		} else {
+			change.OldValue = oldValue
+			change.NewValue = newValue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// recordConfigChange writes an audit entry for a completed config
+// change. Failure to record is logged, not returned: the history is
+// advisory and must not fail the change that triggered it.
+func (api *APIBase) recordConfigChange(appName string, changes []state.ConfigValueChange) {
+	if len(changes) == 0 {
+		return
+	}
+	var author string
+	if tag := api.authorizer.GetAuthTag(); tag != nil {
+		author = tag.String()
+	}
+	err := api.backend.RecordConfigChange(state.ConfigChange{
+		Application: appName,
+		Author:      author,
+		Changes:     changes,
+	})
+	if err != nil {
+		logger.Warningf("recording config change for application %q: %v", appName, err)
+	}
+}
+
+// ConfigChangeHistory returns the recorded configuration changes for
+// each named application, most recent first.
+func (api *APIBase) ConfigChangeHistory(args params.ConfigChangeHistoryArgs) (params.ConfigChangeHistoryResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.ConfigChangeHistoryResults{}, errors.Trace(err)
+	}
+	results := params.ConfigChangeHistoryResults{
+		Results: make([]params.ConfigChangeHistoryResult, len(args.Applications)),
+	}
+	for i, name := range args.Applications {
+		history, err := api.backend.ConfigChangeHistory(name)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		changes := make([]params.ApplicationConfigChange, len(history))
+		for j, entry := range history {
+			values := make([]params.ConfigValueChange, len(entry.Changes))
+			for k, value := range entry.Changes {
+				values[k] = params.ConfigValueChange{
+					Key:      value.Key,
+					OldValue: value.OldValue,
+					NewValue: value.NewValue,
+					Redacted: value.Redacted,
+				}
+			}
+			changes[j] = params.ApplicationConfigChange{
+				Author:    entry.Author,
+				Timestamp: entry.Timestamp,
+				Changes:   values,
+			}
+		}
+		results.Results[i].Changes = changes
+	}
+	return results, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/charm/v8"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/environschema.v1"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/state"
+)
+
+type ConfigAuditSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ConfigAuditSuite{})
+
+func (s *ConfigAuditSuite) TestIsSecretConfigKey(c *gc.C) {
+	for key, expect := range map[string]bool{
+		"admin-password":  true,
+		"Token":           true,
+		"client-secret":   true,
+		"tls-private-key": true,
+		"aws-credentials": true,
+		"hostname":        false,
+		"port":            false,
+		"pass-through":    false,
+	} {
+		c.Check(isSecretConfigKey(key), gc.Equals, expect, gc.Commentf("key %q", key))
+	}
+}
+
+func (s *ConfigAuditSuite) TestCharmSettingChanges(c *gc.C) {
+	old := charm.Settings{
+		"hostname":       "old.example.com",
+		"admin-password": "sekrit",
+		"port":           8080,
+	}
+	applied := charm.Settings{
+		"hostname":       "new.example.com",
+		"admin-password": "hunter2",
+		"port":           8080,
+	}
+	// Unchanged keys are skipped, secret-looking keys are redacted,
+	// and changes come back sorted by key.
+	c.Assert(charmSettingChanges(old, applied), jc.DeepEquals, []state.ConfigValueChange{{
+		Key:      "admin-password",
+		Redacted: true,
+	}, {
+		Key:      "hostname",
+		OldValue: "old.example.com",
+		NewValue: "new.example.com",
+	}})
+}
+
+func (s *ConfigAuditSuite) TestCharmSettingChangesEmpty(c *gc.C) {
+	settings := charm.Settings{"hostname": "example.com"}
+	c.Assert(charmSettingChanges(settings, settings), gc.HasLen, 0)
+}
+
+func (s *ConfigAuditSuite) TestAppConfigChangesSchemaSecret(c *gc.C) {
+	schema := environschema.Fields{
+		"juju-external-hostname": {Type: environschema.Tstring},
+		"registry-pull-auth":     {Type: environschema.Tstring, Secret: true},
+	}
+	old := coreconfig.ConfigAttributes{
+		"registry-pull-auth": "old-auth",
+	}
+	applied := coreconfig.ConfigAttributes{
+		"juju-external-hostname": "app.example.com",
+		"registry-pull-auth":     "new-auth",
+	}
+	// Keys the schema declares secret are redacted even when their
+	// names give no hint.
+	c.Assert(appConfigChanges(schema, old, applied), jc.DeepEquals, []state.ConfigValueChange{{
+		Key:      "juju-external-hostname",
+		NewValue: "app.example.com",
+	}, {
+		Key:      "registry-pull-auth",
+		Redacted: true,
+	}})
+}
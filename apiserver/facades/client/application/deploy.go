@@ -229,6 +229,7 @@ func stateCharmOrigin(origin corecharm.Origin) (*state.CharmOrigin, error) {
 			OS:           origin.Platform.OS,
 			Series:       series,
 		},
+		BaseSelection: origin.BaseSelection,
 	}, nil
 }
 
@@ -203,6 +203,39 @@ func describe(settings charm.Settings, config *charm.Config) map[string]interfac
 	return results
 }
 
+// annotateBranchProvenance rewrites the "source" of any described config
+// key whose effective value differs from the master generation value,
+// marking it as coming from the in-flight branch.
+func annotateBranchProvenance(described map[string]interface{}, masterSettings charm.Settings) {
+	for name, value := range described {
+		info, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if info["source"] != "user" {
+			continue
+		}
+		if masterValue := masterSettings[name]; masterValue != info["value"] {
+			info["source"] = "branch"
+		}
+	}
+}
+
+// excludeDefaultSettings removes described config keys whose value is
+// unset or comes from the charm defaults, leaving only keys an operator
+// (or branch) has explicitly set.
+func excludeDefaultSettings(described map[string]interface{}) {
+	for name, value := range described {
+		info, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if source := info["source"]; source == "default" || source == "unset" {
+			delete(described, name)
+		}
+	}
+}
+
 func describeV4(settings charm.Settings, config *charm.Config) map[string]interface{} {
 	results := make(map[string]interface{})
 	for name, option := range config.Options {
@@ -64,7 +64,7 @@ func (s *getSuite) SetUpTest(c *gc.C) {
 		nil, // CAAS Broker not used in this suite.
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	s.applicationAPI = &application.APIv15{api}
+	s.applicationAPI = &application.APIv15{&application.APIv16{&application.APIv17{&application.APIv18{api}}}}
 }
 
 func (s *getSuite) TestClientApplicationGetSmokeTestV4(c *gc.C) {
@@ -276,7 +276,13 @@ func (s *getSuite) TestClientApplicationGetCAASModelSmokeTest(c *gc.C) {
 						APIv13: &application.APIv13{
 							APIv14: &application.APIv14{
 								APIv15: &application.APIv15{
-									api,
+									APIv16: &application.APIv16{
+										APIv17: &application.APIv17{
+											APIv18: &application.APIv18{
+												api,
+											},
+										},
+									},
 								},
 							},
 						},
@@ -102,6 +102,21 @@ func (mr *MockBackendMockRecorder) AddRemoteApplication(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteApplication", reflect.TypeOf((*MockBackend)(nil).AddRemoteApplication), arg0)
 }
 
+// AllApplications mocks base method.
+func (m *MockBackend) AllApplications() ([]application.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllApplications")
+	ret0, _ := ret[0].([]application.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllApplications indicates an expected call of AllApplications.
+func (mr *MockBackendMockRecorder) AllApplications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllApplications", reflect.TypeOf((*MockBackend)(nil).AllApplications))
+}
+
 // AllModelUUIDs mocks base method.
 func (m *MockBackend) AllModelUUIDs() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,21 @@ func (mr *MockBackendMockRecorder) AllSpaceInfos() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllSpaceInfos", reflect.TypeOf((*MockBackend)(nil).AllSpaceInfos))
 }
 
+// AllWebhookEndpoints mocks base method.
+func (m *MockBackend) AllWebhookEndpoints() ([]state.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllWebhookEndpoints")
+	ret0, _ := ret[0].([]state.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllWebhookEndpoints indicates an expected call of AllWebhookEndpoints.
+func (mr *MockBackendMockRecorder) AllWebhookEndpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllWebhookEndpoints", reflect.TypeOf((*MockBackend)(nil).AllWebhookEndpoints))
+}
+
 // Application mocks base method.
 func (m *MockBackend) Application(arg0 string) (application.Application, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +221,21 @@ func (mr *MockBackendMockRecorder) Charm(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charm", reflect.TypeOf((*MockBackend)(nil).Charm), arg0)
 }
 
+// ConfigChangeHistory mocks base method.
+func (m *MockBackend) ConfigChangeHistory(arg0 string) ([]state.ConfigChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigChangeHistory", arg0)
+	ret0, _ := ret[0].([]state.ConfigChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigChangeHistory indicates an expected call of ConfigChangeHistory.
+func (mr *MockBackendMockRecorder) ConfigChangeHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigChangeHistory", reflect.TypeOf((*MockBackend)(nil).ConfigChangeHistory), arg0)
+}
+
 // ControllerConfig mocks base method.
 func (m *MockBackend) ControllerConfig() (controller.Config, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +318,21 @@ func (mr *MockBackendMockRecorder) InferEndpoints(arg0 ...interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InferEndpoints", reflect.TypeOf((*MockBackend)(nil).InferEndpoints), arg0...)
 }
 
+// KeyRelation mocks base method.
+func (m *MockBackend) KeyRelation(arg0 string) (application.Relation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyRelation", arg0)
+	ret0, _ := ret[0].(application.Relation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KeyRelation indicates an expected call of KeyRelation.
+func (mr *MockBackendMockRecorder) KeyRelation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyRelation", reflect.TypeOf((*MockBackend)(nil).KeyRelation), arg0)
+}
+
 // Machine mocks base method.
 func (m *MockBackend) Machine(arg0 string) (application.Machine, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +378,20 @@ func (mr *MockBackendMockRecorder) OfferConnectionForRelation(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferConnectionForRelation", reflect.TypeOf((*MockBackend)(nil).OfferConnectionForRelation), arg0)
 }
 
+// RecordConfigChange mocks base method.
+func (m *MockBackend) RecordConfigChange(arg0 state.ConfigChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordConfigChange", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordConfigChange indicates an expected call of RecordConfigChange.
+func (mr *MockBackendMockRecorder) RecordConfigChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordConfigChange", reflect.TypeOf((*MockBackend)(nil).RecordConfigChange), arg0)
+}
+
 // Relation mocks base method.
 func (m *MockBackend) Relation(arg0 int) (application.Relation, error) {
 	m.ctrl.T.Helper()
@@ -348,6 +422,21 @@ func (mr *MockBackendMockRecorder) RemoteApplication(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoteApplication", reflect.TypeOf((*MockBackend)(nil).RemoteApplication), arg0)
 }
 
+// ResolveDefaultApplicationConstraints mocks base method.
+func (m *MockBackend) ResolveDefaultApplicationConstraints(arg0, arg1 string) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDefaultApplicationConstraints", arg0, arg1)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDefaultApplicationConstraints indicates an expected call of ResolveDefaultApplicationConstraints.
+func (mr *MockBackendMockRecorder) ResolveDefaultApplicationConstraints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDefaultApplicationConstraints", reflect.TypeOf((*MockBackend)(nil).ResolveDefaultApplicationConstraints), arg0, arg1)
+}
+
 // Resources mocks base method.
 func (m *MockBackend) Resources() application.Resources {
 	m.ctrl.T.Helper()
@@ -1261,6 +1350,21 @@ func (mr *MockApplicationMockRecorder) Relations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockApplication)(nil).Relations))
 }
 
+// ReplaceUnit mocks base method.
+func (m *MockApplication) ReplaceUnit(arg0 string) (application.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUnit", arg0)
+	ret0, _ := ret[0].(application.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUnit indicates an expected call of ReplaceUnit.
+func (mr *MockApplicationMockRecorder) ReplaceUnit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUnit", reflect.TypeOf((*MockApplication)(nil).ReplaceUnit), arg0)
+}
+
 // Series mocks base method.
 func (m *MockApplication) Series() string {
 	m.ctrl.T.Helper()
@@ -1275,6 +1379,20 @@ func (mr *MockApplicationMockRecorder) Series() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockApplication)(nil).Series))
 }
 
+// SetAssignmentStrategy mocks base method.
+func (m *MockApplication) SetAssignmentStrategy(arg0 state.AssignmentStrategy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAssignmentStrategy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAssignmentStrategy indicates an expected call of SetAssignmentStrategy.
+func (mr *MockApplicationMockRecorder) SetAssignmentStrategy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssignmentStrategy", reflect.TypeOf((*MockApplication)(nil).SetAssignmentStrategy), arg0)
+}
+
 // SetCharm mocks base method.
 func (m *MockApplication) SetCharm(arg0 state.SetCharmConfig) error {
 	m.ctrl.T.Helper()
@@ -1303,6 +1421,34 @@ func (mr *MockApplicationMockRecorder) SetConstraints(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConstraints", reflect.TypeOf((*MockApplication)(nil).SetConstraints), arg0)
 }
 
+// SetExposeDuration mocks base method.
+func (m *MockApplication) SetExposeDuration(arg0 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExposeDuration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExposeDuration indicates an expected call of SetExposeDuration.
+func (mr *MockApplicationMockRecorder) SetExposeDuration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExposeDuration", reflect.TypeOf((*MockApplication)(nil).SetExposeDuration), arg0)
+}
+
+// SetImageChannel mocks base method.
+func (m *MockApplication) SetImageChannel(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImageChannel", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImageChannel indicates an expected call of SetImageChannel.
+func (mr *MockApplicationMockRecorder) SetImageChannel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImageChannel", reflect.TypeOf((*MockApplication)(nil).SetImageChannel), arg0)
+}
+
 // SetMetricCredentials mocks base method.
 func (m *MockApplication) SetMetricCredentials(arg0 []byte) error {
 	m.ctrl.T.Helper()
@@ -1331,6 +1477,20 @@ func (mr *MockApplicationMockRecorder) SetMinUnits(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinUnits", reflect.TypeOf((*MockApplication)(nil).SetMinUnits), arg0)
 }
 
+// SetNICRequests mocks base method.
+func (m *MockApplication) SetNICRequests(arg0 []network.NICRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNICRequests", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNICRequests indicates an expected call of SetNICRequests.
+func (mr *MockApplicationMockRecorder) SetNICRequests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNICRequests", reflect.TypeOf((*MockApplication)(nil).SetNICRequests), arg0)
+}
+
 // SetScale mocks base method.
 func (m *MockApplication) SetScale(arg0 int, arg1 int64, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -1345,6 +1505,20 @@ func (mr *MockApplicationMockRecorder) SetScale(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScale", reflect.TypeOf((*MockApplication)(nil).SetScale), arg0, arg1, arg2)
 }
 
+// SetTeardownAfter mocks base method.
+func (m *MockApplication) SetTeardownAfter(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeardownAfter", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeardownAfter indicates an expected call of SetTeardownAfter.
+func (mr *MockApplicationMockRecorder) SetTeardownAfter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeardownAfter", reflect.TypeOf((*MockApplication)(nil).SetTeardownAfter), arg0)
+}
+
 // UnsetExposeSettings mocks base method.
 func (m *MockApplication) UnsetExposeSettings(arg0 []string) error {
 	m.ctrl.T.Helper()
@@ -1784,6 +1958,35 @@ func (mr *MockRelationMockRecorder) Id() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockRelation)(nil).Id))
 }
 
+// Life mocks base method.
+func (m *MockRelation) Life() state.Life {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Life")
+	ret0, _ := ret[0].(state.Life)
+	return ret0
+}
+
+// Life indicates an expected call of Life.
+func (mr *MockRelationMockRecorder) Life() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Life", reflect.TypeOf((*MockRelation)(nil).Life))
+}
+
+// NetworkCharacteristics mocks base method.
+func (m *MockRelation) NetworkCharacteristics() (state.RelationNetworkCharacteristics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkCharacteristics")
+	ret0, _ := ret[0].(state.RelationNetworkCharacteristics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkCharacteristics indicates an expected call of NetworkCharacteristics.
+func (mr *MockRelationMockRecorder) NetworkCharacteristics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkCharacteristics", reflect.TypeOf((*MockRelation)(nil).NetworkCharacteristics))
+}
+
 // RelatedEndpoints mocks base method.
 func (m *MockRelation) RelatedEndpoints(arg0 string) ([]state.Endpoint, error) {
 	m.ctrl.T.Helper()
@@ -1799,6 +2002,20 @@ func (mr *MockRelationMockRecorder) RelatedEndpoints(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedEndpoints", reflect.TypeOf((*MockRelation)(nil).RelatedEndpoints), arg0)
 }
 
+// SetNetworkCharacteristics mocks base method.
+func (m *MockRelation) SetNetworkCharacteristics(arg0 state.RelationNetworkCharacteristics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetworkCharacteristics", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetworkCharacteristics indicates an expected call of SetNetworkCharacteristics.
+func (mr *MockRelationMockRecorder) SetNetworkCharacteristics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetworkCharacteristics", reflect.TypeOf((*MockRelation)(nil).SetNetworkCharacteristics), arg0)
+}
+
 // SetStatus mocks base method.
 func (m *MockRelation) SetStatus(arg0 status.StatusInfo) error {
 	m.ctrl.T.Helper()
@@ -1884,6 +2101,20 @@ func (mr *MockRelationMockRecorder) Unit(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unit", reflect.TypeOf((*MockRelation)(nil).Unit), arg0)
 }
 
+// UpdateApplicationSettings mocks base method.
+func (m *MockRelation) UpdateApplicationSettings(arg0 string, arg1 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationSettings indicates an expected call of UpdateApplicationSettings.
+func (mr *MockRelationMockRecorder) UpdateApplicationSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationSettings", reflect.TypeOf((*MockRelation)(nil).UpdateApplicationSettings), arg0, arg1)
+}
+
 // MockUnit is a mock of Unit interface.
 type MockUnit struct {
 	ctrl     *gomock.Controller
@@ -1907,6 +2138,21 @@ func (m *MockUnit) EXPECT() *MockUnitMockRecorder {
 	return m.recorder
 }
 
+// AgentStatus mocks base method.
+func (m *MockUnit) AgentStatus() (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgentStatus")
+	ret0, _ := ret[0].(status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentStatus indicates an expected call of AgentStatus.
+func (mr *MockUnitMockRecorder) AgentStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentStatus", reflect.TypeOf((*MockUnit)(nil).AgentStatus))
+}
+
 // AgentTools mocks base method.
 func (m *MockUnit) AgentTools() (*tools.Tools, error) {
 	m.ctrl.T.Helper()
@@ -2064,6 +2310,21 @@ func (mr *MockUnitMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockUnit)(nil).Name))
 }
 
+// PrincipalName mocks base method.
+func (m *MockUnit) PrincipalName() (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrincipalName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// PrincipalName indicates an expected call of PrincipalName.
+func (mr *MockUnitMockRecorder) PrincipalName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrincipalName", reflect.TypeOf((*MockUnit)(nil).PrincipalName))
+}
+
 // Resolve mocks base method.
 func (m *MockUnit) Resolve(arg0 bool) error {
 	m.ctrl.T.Helper()
@@ -2078,6 +2339,35 @@ func (mr *MockUnitMockRecorder) Resolve(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockUnit)(nil).Resolve), arg0)
 }
 
+// Status mocks base method.
+func (m *MockUnit) Status() (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockUnitMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockUnit)(nil).Status))
+}
+
+// SubordinateNames mocks base method.
+func (m *MockUnit) SubordinateNames() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubordinateNames")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// SubordinateNames indicates an expected call of SubordinateNames.
+func (mr *MockUnitMockRecorder) SubordinateNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubordinateNames", reflect.TypeOf((*MockUnit)(nil).SubordinateNames))
+}
+
 // Tag mocks base method.
 func (m *MockUnit) Tag() names.Tag {
 	m.ctrl.T.Helper()
@@ -2188,6 +2478,20 @@ func (mr *MockRelationUnitMockRecorder) UnitName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitName", reflect.TypeOf((*MockRelationUnit)(nil).UnitName))
 }
 
+// UpdateSettings mocks base method.
+func (m *MockRelationUnit) UpdateSettings(arg0 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockRelationUnitMockRecorder) UpdateSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockRelationUnit)(nil).UpdateSettings), arg0)
+}
+
 // MockMachine is a mock of Machine interface.
 type MockMachine struct {
 	ctrl     *gomock.Controller
@@ -2211,6 +2515,36 @@ func (m *MockMachine) EXPECT() *MockMachineMockRecorder {
 	return m.recorder
 }
 
+// AvailabilityZone mocks base method.
+func (m *MockMachine) AvailabilityZone() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilityZone")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailabilityZone indicates an expected call of AvailabilityZone.
+func (mr *MockMachineMockRecorder) AvailabilityZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilityZone", reflect.TypeOf((*MockMachine)(nil).AvailabilityZone))
+}
+
+// HardwareCharacteristics mocks base method.
+func (m *MockMachine) HardwareCharacteristics() (*instance.HardwareCharacteristics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardwareCharacteristics")
+	ret0, _ := ret[0].(*instance.HardwareCharacteristics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardwareCharacteristics indicates an expected call of HardwareCharacteristics.
+func (mr *MockMachineMockRecorder) HardwareCharacteristics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardwareCharacteristics", reflect.TypeOf((*MockMachine)(nil).HardwareCharacteristics))
+}
+
 // IsLockedForSeriesUpgrade mocks base method.
 func (m *MockMachine) IsLockedForSeriesUpgrade() (bool, error) {
 	m.ctrl.T.Helper()
@@ -2256,6 +2590,20 @@ func (mr *MockMachineMockRecorder) PublicAddress() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicAddress", reflect.TypeOf((*MockMachine)(nil).PublicAddress))
 }
 
+// Series mocks base method.
+func (m *MockMachine) Series() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Series")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Series indicates an expected call of Series.
+func (mr *MockMachineMockRecorder) Series() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockMachine)(nil).Series))
+}
+
 // MockGeneration is a mock of Generation interface.
 type MockGeneration struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,182 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ExportRelationData returns a document containing the relation data
+// bags (application and unit settings) for every relation the input
+// application participates in. The document carries a signature binding
+// it to this model, so that a tampered or mismatched document is
+// rejected on import. It is intended for disaster recovery: export the
+// data before removing an application, then import it after the
+// application and its relations have been recreated.
+func (api *APIBase) ExportRelationData(args params.ExportRelationDataArgs) (params.RelationDataExportResult, error) {
+	var result params.RelationDataExportResult
+	if err := api.checkCanRead(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	app, err := api.backend.Application(args.ApplicationName)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	units, err := app.AllUnits()
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	rels, err := app.Relations()
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+
+	doc := params.RelationDataDocument{
+		ModelUUID:   api.model.UUID(),
+		Application: args.ApplicationName,
+	}
+	for _, rel := range rels {
+		bag := params.RelationDataBag{Relation: rel.Tag().Id()}
+
+		appSettings, err := rel.ApplicationSettings(args.ApplicationName)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+			return result, nil
+		}
+		if len(appSettings) > 0 {
+			bag.ApplicationSettings = appSettings
+		}
+
+		for _, unit := range units {
+			ru, err := rel.Unit(unit.Name())
+			if err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+			inScope, err := ru.InScope()
+			if err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+			if !inScope {
+				continue
+			}
+			unitSettings, err := ru.Settings()
+			if err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+			if bag.UnitSettings == nil {
+				bag.UnitSettings = make(map[string]map[string]interface{})
+			}
+			bag.UnitSettings[unit.Name()] = unitSettings
+		}
+
+		doc.Relations = append(doc.Relations, bag)
+	}
+
+	sig, err := signRelationDataDocument(doc)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	doc.Signature = sig
+	result.Document = doc
+	return result, nil
+}
+
+// ImportRelationData applies a previously exported relation data
+// document to this model. The application and its relations must have
+// been recreated first; each relation in the document is matched by its
+// key, the application data bags are restored, and unit data bags are
+// restored for units that have re-entered the relation's scope.
+func (api *APIBase) ImportRelationData(args params.ImportRelationDataArgs) (params.ErrorResult, error) {
+	var result params.ErrorResult
+	if err := api.checkCanWrite(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	doc := args.Document
+	if doc.ModelUUID != api.model.UUID() {
+		result.Error = apiservererrors.ServerError(errors.NotValidf(
+			"relation data document exported from model %q", doc.ModelUUID))
+		return result, nil
+	}
+	sig, err := signRelationDataDocument(doc)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !hmac.Equal([]byte(sig), []byte(doc.Signature)) {
+		result.Error = apiservererrors.ServerError(errors.NotValidf(
+			"relation data document signature"))
+		return result, nil
+	}
+
+	for _, bag := range doc.Relations {
+		rel, err := api.backend.KeyRelation(bag.Relation)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(errors.Annotatef(
+				err, "relation %q must be recreated before importing its data", bag.Relation))
+			return result, nil
+		}
+		if len(bag.ApplicationSettings) > 0 {
+			if err := rel.UpdateApplicationSettings(doc.Application, bag.ApplicationSettings); err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+		}
+		for unitName, settings := range bag.UnitSettings {
+			ru, err := rel.Unit(unitName)
+			if err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+			inScope, err := ru.InScope()
+			if err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+			if !inScope {
+				// The unit has not rejoined the relation yet; its
+				// data can be imported again once it has.
+				logger.Warningf("not importing settings for unit %q: not in scope of relation %q", unitName, bag.Relation)
+				continue
+			}
+			if err := ru.UpdateSettings(settings); err != nil {
+				result.Error = apiservererrors.ServerError(err)
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+// signRelationDataDocument computes the signature for the input
+// document, ignoring any signature it already carries. The HMAC key is
+// derived from the model UUID, which ties the document to the model it
+// was exported from without requiring extra key management.
+func signRelationDataDocument(doc params.RelationDataDocument) (string, error) {
+	doc.Signature = ""
+	payload, err := json.Marshal(doc)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	mac := hmac.New(sha256.New, []byte("juju-relation-data:"+doc.ModelUUID))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
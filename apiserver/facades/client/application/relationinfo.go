@@ -0,0 +1,159 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// redactedValue replaces sensitive setting values in the data bag
+// snapshots returned by RelationInfo.
+const redactedValue = "<redacted>"
+
+// sensitiveSettingFragments lists key fragments that mark a relation
+// setting as sensitive. Matching is case-insensitive.
+var sensitiveSettingFragments = []string{
+	"password",
+	"secret",
+	"token",
+	"credential",
+	"private-key",
+}
+
+// RelationInfo returns inspection details for each of the input
+// relation ids: endpoints, life, and suspension state. Data bag
+// snapshots are included on request for users with write access, with
+// sensitive values redacted.
+func (api *APIBase) RelationInfo(args params.RelationInfoArgs) (params.RelationInfoResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.RelationInfoResults{}, errors.Trace(err)
+	}
+	includeData := args.IncludeData && api.checkCanWrite() == nil
+
+	results := make([]params.RelationInfoResult, len(args.RelationIds))
+	for i, id := range args.RelationIds {
+		result, err := api.oneRelationInfo(id, includeData)
+		if err != nil {
+			results[i] = params.RelationInfoResult{Id: id, Error: apiservererrors.ServerError(err)}
+			continue
+		}
+		results[i] = result
+	}
+	return params.RelationInfoResults{Results: results}, nil
+}
+
+func (api *APIBase) oneRelationInfo(id int, includeData bool) (params.RelationInfoResult, error) {
+	rel, err := api.backend.Relation(id)
+	if err != nil {
+		return params.RelationInfoResult{}, errors.Trace(err)
+	}
+	result := params.RelationInfoResult{
+		Id:        id,
+		Key:       rel.Tag().Id(),
+		Life:      rel.Life().Value(),
+		Suspended: rel.Suspended(),
+	}
+	if result.Suspended {
+		result.SuspendedReason = rel.SuspendedReason()
+	}
+	for _, ep := range rel.Endpoints() {
+		result.Endpoints = append(result.Endpoints, params.Endpoint{
+			ApplicationName: ep.ApplicationName,
+			Relation: params.CharmRelation{
+				Name:      ep.Relation.Name,
+				Role:      string(ep.Relation.Role),
+				Interface: ep.Relation.Interface,
+				Optional:  ep.Relation.Optional,
+				Limit:     ep.Relation.Limit,
+				Scope:     string(ep.Relation.Scope),
+			},
+		})
+		if !includeData {
+			continue
+		}
+		data, err := api.relationDataSnapshot(rel, ep.ApplicationName)
+		if err != nil {
+			return params.RelationInfoResult{}, errors.Trace(err)
+		}
+		result.Data = append(result.Data, data)
+	}
+	return result, nil
+}
+
+// relationDataSnapshot captures the current application and unit data
+// bags for one application in the relation, redacting sensitive values.
+func (api *APIBase) relationDataSnapshot(rel Relation, appName string) (params.RelationInfoData, error) {
+	data := params.RelationInfoData{Application: appName}
+	appSettings, err := rel.ApplicationSettings(appName)
+	if err != nil {
+		return data, errors.Trace(err)
+	}
+	if len(appSettings) > 0 {
+		data.ApplicationSettings = redactSettings(appSettings)
+	}
+
+	app, err := api.backend.Application(appName)
+	if errors.IsNotFound(err) {
+		// The application is the remote side of a cross model
+		// relation; its units are not in this model.
+		return data, nil
+	} else if err != nil {
+		return data, errors.Trace(err)
+	}
+	units, err := app.AllUnits()
+	if err != nil {
+		return data, errors.Trace(err)
+	}
+	for _, unit := range units {
+		ru, err := rel.Unit(unit.Name())
+		if err != nil {
+			return data, errors.Trace(err)
+		}
+		inScope, err := ru.InScope()
+		if err != nil {
+			return data, errors.Trace(err)
+		}
+		if !inScope {
+			continue
+		}
+		unitSettings, err := ru.Settings()
+		if err != nil {
+			return data, errors.Trace(err)
+		}
+		if data.UnitSettings == nil {
+			data.UnitSettings = make(map[string]map[string]interface{})
+		}
+		data.UnitSettings[unit.Name()] = redactSettings(unitSettings)
+	}
+	return data, nil
+}
+
+// redactSettings returns a copy of the input settings with the values
+// of sensitive keys replaced.
+func redactSettings(settings map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		if sensitiveSettingKey(key) {
+			out[key] = redactedValue
+		} else {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func sensitiveSettingKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, fragment := range sensitiveSettingFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	return false
+}
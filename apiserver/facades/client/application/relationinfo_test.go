@@ -0,0 +1,47 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type RelationInfoSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&RelationInfoSuite{})
+
+func (s *RelationInfoSuite) TestRedactSettings(c *gc.C) {
+	settings := map[string]interface{}{
+		"private-address": "10.0.0.1",
+		"DB-Password":     "sekrit",
+		"oauth-token":     "tok",
+		"tls-private-key": "key",
+		"ca-cert":         "cert",
+	}
+	c.Assert(redactSettings(settings), jc.DeepEquals, map[string]interface{}{
+		"private-address": "10.0.0.1",
+		"DB-Password":     redactedValue,
+		"oauth-token":     redactedValue,
+		"tls-private-key": redactedValue,
+		"ca-cert":         "cert",
+	})
+	// The input settings are left untouched.
+	c.Check(settings["DB-Password"], gc.Equals, "sekrit")
+}
+
+func (s *RelationInfoSuite) TestSensitiveSettingKey(c *gc.C) {
+	for key, expect := range map[string]bool{
+		"password":        true,
+		"Admin-Secret":    true,
+		"aws-credentials": true,
+		"hostname":        false,
+		"port":            false,
+	} {
+		c.Check(sensitiveSettingKey(key), gc.Equals, expect, gc.Commentf("key %q", key))
+	}
+}
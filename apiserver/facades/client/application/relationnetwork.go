@@ -0,0 +1,71 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// SetRelationsNetworkCharacteristics records operator-supplied network
+// characteristics (latency class, bandwidth cap) against the specified
+// relations. Charms can retrieve the characteristics with the
+// relation-network-get hook tool and tune themselves for WAN
+// relations. Zero-valued characteristics clear the record.
+func (api *APIBase) SetRelationsNetworkCharacteristics(args params.SetRelationNetworkArgs) (params.ErrorResults, error) {
+	var results params.ErrorResults
+	if err := api.checkCanWrite(); err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	results.Results = make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		rel, err := api.backend.Relation(arg.RelationId)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{
+			LatencyClass:  arg.Characteristics.LatencyClass,
+			BandwidthMbps: arg.Characteristics.BandwidthMbps,
+		})
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RelationsNetworkCharacteristics returns the network characteristics
+// recorded against the specified relations. Relations with no recorded
+// characteristics yield an empty result rather than an error.
+func (api *APIBase) RelationsNetworkCharacteristics(args params.RelationIds) (params.RelationNetworkResults, error) {
+	var results params.RelationNetworkResults
+	if err := api.checkCanRead(); err != nil {
+		return results, errors.Trace(err)
+	}
+	results.Results = make([]params.RelationNetworkResult, len(args.RelationIds))
+	for i, relId := range args.RelationIds {
+		rel, err := api.backend.Relation(relId)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		char, err := rel.NetworkCharacteristics()
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				results.Results[i].Error = apiservererrors.ServerError(err)
+			}
+			continue
+		}
+		results.Results[i].Characteristics = params.RelationNetworkCharacteristics{
+			LatencyClass:  char.LatencyClass,
+			BandwidthMbps: char.BandwidthMbps,
+		}
+	}
+	return results, nil
+}
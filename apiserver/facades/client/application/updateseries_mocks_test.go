@@ -7,6 +7,7 @@ package application
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	v8 "github.com/juju/charm/v8"
@@ -15,6 +16,7 @@ import (
 	transport "github.com/juju/juju/charmhub/transport"
 	config "github.com/juju/juju/core/config"
 	constraints "github.com/juju/juju/core/constraints"
+	network "github.com/juju/juju/core/network"
 	state "github.com/juju/juju/state"
 	tools "github.com/juju/juju/tools"
 	v4 "github.com/juju/names/v4"
@@ -422,6 +424,21 @@ func (mr *MockApplicationMockRecorder) Relations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockApplication)(nil).Relations))
 }
 
+// ReplaceUnit mocks base method.
+func (m *MockApplication) ReplaceUnit(arg0 string) (Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUnit", arg0)
+	ret0, _ := ret[0].(Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUnit indicates an expected call of ReplaceUnit.
+func (mr *MockApplicationMockRecorder) ReplaceUnit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUnit", reflect.TypeOf((*MockApplication)(nil).ReplaceUnit), arg0)
+}
+
 // Series mocks base method.
 func (m *MockApplication) Series() string {
 	m.ctrl.T.Helper()
@@ -478,6 +495,48 @@ func (mr *MockApplicationMockRecorder) SetMetricCredentials(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetricCredentials", reflect.TypeOf((*MockApplication)(nil).SetMetricCredentials), arg0)
 }
 
+// SetAssignmentStrategy mocks base method.
+func (m *MockApplication) SetAssignmentStrategy(arg0 state.AssignmentStrategy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAssignmentStrategy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAssignmentStrategy indicates an expected call of SetAssignmentStrategy.
+func (mr *MockApplicationMockRecorder) SetAssignmentStrategy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssignmentStrategy", reflect.TypeOf((*MockApplication)(nil).SetAssignmentStrategy), arg0)
+}
+
+// SetExposeDuration mocks base method.
+func (m *MockApplication) SetExposeDuration(arg0 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExposeDuration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExposeDuration indicates an expected call of SetExposeDuration.
+func (mr *MockApplicationMockRecorder) SetExposeDuration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExposeDuration", reflect.TypeOf((*MockApplication)(nil).SetExposeDuration), arg0)
+}
+
+// SetImageChannel mocks base method.
+func (m *MockApplication) SetImageChannel(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImageChannel", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImageChannel indicates an expected call of SetImageChannel.
+func (mr *MockApplicationMockRecorder) SetImageChannel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImageChannel", reflect.TypeOf((*MockApplication)(nil).SetImageChannel), arg0)
+}
+
 // SetMinUnits mocks base method.
 func (m *MockApplication) SetMinUnits(arg0 int) error {
 	m.ctrl.T.Helper()
@@ -492,6 +551,20 @@ func (mr *MockApplicationMockRecorder) SetMinUnits(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinUnits", reflect.TypeOf((*MockApplication)(nil).SetMinUnits), arg0)
 }
 
+// SetNICRequests mocks base method.
+func (m *MockApplication) SetNICRequests(arg0 []network.NICRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNICRequests", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNICRequests indicates an expected call of SetNICRequests.
+func (mr *MockApplicationMockRecorder) SetNICRequests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNICRequests", reflect.TypeOf((*MockApplication)(nil).SetNICRequests), arg0)
+}
+
 // SetScale mocks base method.
 func (m *MockApplication) SetScale(arg0 int, arg1 int64, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -506,6 +579,20 @@ func (mr *MockApplicationMockRecorder) SetScale(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScale", reflect.TypeOf((*MockApplication)(nil).SetScale), arg0, arg1, arg2)
 }
 
+// SetTeardownAfter mocks base method.
+func (m *MockApplication) SetTeardownAfter(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeardownAfter", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeardownAfter indicates an expected call of SetTeardownAfter.
+func (mr *MockApplicationMockRecorder) SetTeardownAfter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeardownAfter", reflect.TypeOf((*MockApplication)(nil).SetTeardownAfter), arg0)
+}
+
 // UnsetExposeSettings mocks base method.
 func (m *MockApplication) UnsetExposeSettings(arg0 []string) error {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@ package applicationoffers_test
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -118,7 +119,7 @@ func (s *offerAccessSuite) TestRevokeAdminLeavesReadAccess(c *gc.C) {
 
 	user := names.NewUserTag("foobar")
 	offer := names.NewApplicationOfferTag("someoffer")
-	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	err = s.revoke(c, user, params.OfferConsumeAccess, "test.someoffer")
@@ -136,7 +137,7 @@ func (s *offerAccessSuite) TestRevokeReadRemovesPermission(c *gc.C) {
 
 	user := names.NewUserTag("foobar")
 	offer := names.NewApplicationOfferTag("someoffer")
-	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	err = s.revoke(c, user, params.OfferReadAccess, "test.someoffer")
@@ -226,7 +227,7 @@ func (s *offerAccessSuite) TestGrantIncreaseAccess(c *gc.C) {
 	s.authorizer.AdminTag = user
 
 	offer := names.NewApplicationOfferTag("someoffer")
-	err := st.CreateOfferAccess(offer, user, permission.ReadAccess)
+	err := st.CreateOfferAccess(offer, user, permission.ReadAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	err = s.grant(c, user, params.OfferConsumeAccess, "other/test.someoffer")
@@ -261,7 +262,7 @@ func (s *offerAccessSuite) assertGrantToOffer(c *gc.C, userAccess permission.Acc
 	s.authorizer.Tag = user
 
 	offer := names.NewApplicationOfferTag("someoffer")
-	err := st.CreateOfferAccess(offer, user, userAccess)
+	err := st.CreateOfferAccess(offer, user, userAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	other := names.NewUserTag("other@remote")
@@ -287,7 +288,7 @@ func (s *offerAccessSuite) TestGrantToOfferAdminAccess(c *gc.C) {
 	s.authorizer.Tag = user
 	s.authorizer.AdminTag = user
 	offer := names.NewApplicationOfferTag("someoffer")
-	err := st.CreateOfferAccess(offer, user, permission.AdminAccess)
+	err := st.CreateOfferAccess(offer, user, permission.AdminAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	other := names.NewUserTag("other")
@@ -395,3 +396,70 @@ func (s *offerAccessSuite) TestModifyOfferAccessInvalidAction(c *gc.C) {
 	expectedErr := `unknown action "dance"`
 	c.Assert(result.OneError(), gc.ErrorMatches, expectedErr)
 }
+
+func (s *offerAccessSuite) modifyAccessWithExpiry(
+	c *gc.C, user names.UserTag,
+	action params.OfferAction,
+	access params.OfferAccessPermission,
+	offerURL string,
+	expiresAt *time.Time,
+) error {
+	args := params.ModifyOfferAccessRequest{
+		Changes: []params.ModifyOfferAccess{{
+			UserTag:   user.String(),
+			Action:    action,
+			Access:    access,
+			OfferURL:  offerURL,
+			ExpiresAt: expiresAt,
+		}}}
+
+	result, err := s.api.ModifyOfferAccess(args)
+	if err != nil {
+		return err
+	}
+	return result.OneError()
+}
+
+func (s *offerAccessSuite) TestGrantWithExpiry(c *gc.C) {
+	s.setupOffer("uuid", "test", "admin", "someoffer")
+	st := s.mockStatePool.st["uuid"].(*mockState)
+	st.users["foobar"] = &mockUser{"foobar"}
+
+	user := names.NewUserTag("foobar")
+	expiry := time.Now().Add(time.Hour)
+	err := s.modifyAccessWithExpiry(
+		c, user, params.GrantOfferAccess, params.OfferConsumeAccess, "test.someoffer", &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	access, err := st.GetOfferAccess("someoffer-uuid", user)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(access, gc.Equals, permission.ConsumeAccess)
+	c.Assert(st.accessExpiry[offerAccess{user: user, offerUUID: "someoffer-uuid"}], gc.Equals, expiry)
+}
+
+func (s *offerAccessSuite) TestRevokeWithExpiryFails(c *gc.C) {
+	s.setupOffer("uuid", "test", "admin", "someoffer")
+	st := s.mockStatePool.st["uuid"].(*mockState)
+	st.users["foobar"] = &mockUser{"foobar"}
+
+	user := names.NewUserTag("foobar")
+	expiry := time.Now().Add(time.Hour)
+	err := s.modifyAccessWithExpiry(
+		c, user, params.RevokeOfferAccess, params.OfferConsumeAccess, "test.someoffer", &expiry)
+	c.Assert(err, gc.ErrorMatches, `expiry time can only be set when granting access`)
+}
+
+func (s *offerAccessSuite) TestGrantExistingAccessWithExpiryFails(c *gc.C) {
+	s.setupOffer("uuid", "test", "admin", "someoffer")
+	st := s.mockStatePool.st["uuid"].(*mockState)
+	st.users["foobar"] = &mockUser{"foobar"}
+
+	user := names.NewUserTag("foobar")
+	err := st.CreateOfferAccess(names.NewApplicationOfferTag("someoffer"), user, permission.ReadAccess, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	expiry := time.Now().Add(time.Hour)
+	err = s.modifyAccessWithExpiry(
+		c, user, params.GrantOfferAccess, params.OfferConsumeAccess, "test.someoffer", &expiry)
+	c.Assert(err, gc.ErrorMatches, `cannot set an expiry time on existing access`)
+}
@@ -6,6 +6,7 @@ package applicationoffers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -49,6 +50,21 @@ type OffersAPIV4 struct {
 	*OffersAPIV3
 }
 
+// OffersAPIV5 implements the cross model interface V5.
+type OffersAPIV5 struct {
+	*OffersAPIV4
+}
+
+// OffersAPIV6 implements the cross model interface V6.
+type OffersAPIV6 struct {
+	*OffersAPIV5
+}
+
+// OffersAPIV7 implements the cross model interface V7.
+type OffersAPIV7 struct {
+	*OffersAPIV6
+}
+
 // createAPI returns a new application offers OffersAPI facade.
 func createOffersAPI(
 	getApplicationOffers func(interface{}) jujucrossmodel.ApplicationOffers,
@@ -251,7 +267,7 @@ func (api *OffersAPI) modifyOneOfferAccess(user names.UserTag, modelUUID string,
 	if err != nil {
 		return errors.Annotate(err, "could not modify offer access")
 	}
-	return api.changeOfferAccess(backend, offerTag, targetUserTag, arg.Action, offerAccess)
+	return api.changeOfferAccess(backend, offerTag, targetUserTag, arg.Action, offerAccess, arg.ExpiresAt)
 }
 
 // changeOfferAccess performs the requested access grant or revoke action for the
@@ -262,14 +278,18 @@ func (api *OffersAPI) changeOfferAccess(
 	targetUserTag names.UserTag,
 	action params.OfferAction,
 	access permission.Access,
+	expiresAt *time.Time,
 ) error {
 	_, err := backend.ApplicationOffer(offerTag.Name)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if expiresAt != nil && action != params.GrantOfferAccess {
+		return errors.New("expiry time can only be set when granting access")
+	}
 	switch action {
 	case params.GrantOfferAccess:
-		return api.grantOfferAccess(backend, offerTag, targetUserTag, access)
+		return api.grantOfferAccess(backend, offerTag, targetUserTag, access, expiresAt)
 	case params.RevokeOfferAccess:
 		return api.revokeOfferAccess(backend, offerTag, targetUserTag, access)
 	default:
@@ -277,9 +297,12 @@ func (api *OffersAPI) changeOfferAccess(
 	}
 }
 
-func (api *OffersAPI) grantOfferAccess(backend Backend, offerTag names.ApplicationOfferTag, targetUserTag names.UserTag, access permission.Access) error {
-	err := backend.CreateOfferAccess(offerTag, targetUserTag, access)
+func (api *OffersAPI) grantOfferAccess(backend Backend, offerTag names.ApplicationOfferTag, targetUserTag names.UserTag, access permission.Access, expiresAt *time.Time) error {
+	err := backend.CreateOfferAccess(offerTag, targetUserTag, access, expiresAt)
 	if errors.IsAlreadyExists(err) {
+		if expiresAt != nil {
+			return errors.New("cannot set an expiry time on existing access")
+		}
 		offer, err := backend.ApplicationOffer(offerTag.Id())
 		if err != nil {
 			return apiservererrors.ErrPerm
@@ -594,3 +617,48 @@ func destroyOffers(api *OffersAPI, offerURLs []string, force bool) (params.Error
 	}
 	return params.ErrorResults{Results: result}, nil
 }
+
+// RotateOfferMacaroons invalidates macaroons previously issued for the
+// offers specified by the given URLs. Consuming controllers presenting
+// stale macaroons receive a discharge required error and transparently
+// obtain re-issued ones.
+func (api *OffersAPIV5) RotateOfferMacaroons(args params.OfferURLs) (params.ErrorResults, error) {
+	result := make([]params.ErrorResult, len(args.OfferURLs))
+
+	user := api.Authorizer.GetAuthTag().(names.UserTag)
+	models, err := api.getModelsFromOffers(user, args.OfferURLs...)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	for i, one := range args.OfferURLs {
+		url, err := jujucrossmodel.ParseOfferURL(one)
+		if err != nil {
+			result[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if models[i].err != nil {
+			result[i].Error = apiservererrors.ServerError(models[i].err)
+			continue
+		}
+		backend, releaser, err := api.StatePool.Get(models[i].model.UUID())
+		if err != nil {
+			result[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		defer releaser()
+
+		if err := api.checkAdmin(user, backend); err != nil {
+			result[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		offer, err := backend.ApplicationOffer(url.ApplicationName)
+		if err != nil {
+			result[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		_, err = backend.RotateOfferMacaroons(offer.OfferUUID)
+		result[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: result}, nil
+}
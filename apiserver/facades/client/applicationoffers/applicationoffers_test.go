@@ -254,7 +254,7 @@ func (s *applicationOffersSuite) assertList(c *gc.C, expectedErr error, expected
 	s.mockState.users["mary"] = &mockUser{"mary"}
 	s.mockState.CreateOfferAccess(
 		names.NewApplicationOfferTag("hosted-db2"),
-		names.NewUserTag("mary"), permission.ConsumeAccess)
+		names.NewUserTag("mary"), permission.ConsumeAccess, nil)
 	filter := params.OfferFilters{
 		Filters: []params.OfferFilter{
 			{
@@ -404,7 +404,7 @@ func (s *applicationOffersSuite) assertShow(c *gc.C, url string, expected []para
 	s.mockState.users["mary"] = &mockUser{"mary"}
 	s.mockState.CreateOfferAccess(
 		names.NewApplicationOfferTag("hosted-db2"),
-		names.NewUserTag("mary"), permission.ConsumeAccess)
+		names.NewUserTag("mary"), permission.ConsumeAccess, nil)
 	filter := params.OfferURLs{[]string{url}, bakery.LatestVersion}
 
 	found, err := s.api.ApplicationOffers(filter)
@@ -474,7 +474,7 @@ func (s *applicationOffersSuite) TestShowNoPermission(c *gc.C) {
 	s.mockState.users["someone"] = &mockUser{"someone"}
 	user := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-db2")
-	err := s.mockState.CreateOfferAccess(offer, user, permission.NoAccess)
+	err := s.mockState.CreateOfferAccess(offer, user, permission.NoAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.authorizer.Tag = user
@@ -510,7 +510,7 @@ func (s *applicationOffersSuite) TestShowPermission(c *gc.C) {
 			},
 		}}}
 	s.mockState.users[user.Name()] = &mockUser{user.Name()}
-	s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ReadAccess)
+	s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ReadAccess, nil)
 	s.assertShow(c, "fred@external/prod.hosted-db2", expected)
 }
 
@@ -643,7 +643,7 @@ func (s *applicationOffersSuite) TestShowFoundMultiple(c *gc.C) {
 	user := names.NewUserTag("someone")
 	s.authorizer.Tag = user
 	s.mockState.users[user.Name()] = &mockUser{user.Name()}
-	s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-test"), user, permission.ReadAccess)
+	s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-test"), user, permission.ReadAccess, nil)
 
 	anotherState := &mockState{
 		modelUUID:   "uuid2",
@@ -664,7 +664,7 @@ func (s *applicationOffersSuite) TestShowFoundMultiple(c *gc.C) {
 		},
 	}
 	anotherState.users[user.Name()] = &mockUser{user.Name()}
-	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-testagain"), user, permission.ConsumeAccess)
+	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-testagain"), user, permission.ConsumeAccess, nil)
 	s.mockStatePool.st["uuid2"] = anotherState
 
 	found, err := s.api.ApplicationOffers(filter)
@@ -779,11 +779,217 @@ func (s *applicationOffersSuite) TestFind(c *gc.C) {
 	s.assertFind(c, expected)
 }
 
+func (s *applicationOffersSuite) TestFindFederatedOffers(c *gc.C) {
+	s.setupOffers(c, "", true)
+	s.authorizer.Tag = names.NewUserTag("admin")
+	s.mockState.externalControllers = []jujucrossmodel.ControllerInfo{{
+		ControllerTag: names.NewControllerTag("ffffffff-0bad-400d-8000-4b1d0d06f00d"),
+		Alias:         "other",
+		Addrs:         []string{"10.0.0.1:17070"},
+	}}
+
+	remoteOffer := params.ApplicationOfferAdminDetails{
+		ApplicationOfferDetails: params.ApplicationOfferDetails{
+			OfferName: "remote-db",
+			OfferURL:  "admin/prod.remote-db",
+		},
+	}
+	s.PatchValue(applicationoffers.FindRemoteOffers, func(
+		info jujucrossmodel.ControllerInfo, filters params.OfferFilters,
+	) ([]params.ApplicationOfferAdminDetails, error) {
+		c.Check(info.Alias, gc.Equals, "other")
+		return []params.ApplicationOfferAdminDetails{remoteOffer}, nil
+	})
+
+	found, err := s.api.FindFederatedOffers(params.OfferFilters{
+		Filters: []params.OfferFilter{{
+			OfferName: "hosted-db2",
+			Endpoints: []params.EndpointFilterAttributes{{Interface: "db2"}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 2)
+
+	local := found.Results[0]
+	c.Check(local.ControllerTag, gc.Equals, testing.ControllerTag.String())
+	c.Check(local.Error, gc.IsNil)
+	c.Assert(local.Offers, gc.HasLen, 1)
+	c.Check(local.Offers[0].OfferName, gc.Equals, "hosted-db2")
+
+	remote := found.Results[1]
+	c.Check(remote.ControllerTag, gc.Equals, "controller-ffffffff-0bad-400d-8000-4b1d0d06f00d")
+	c.Check(remote.ControllerAlias, gc.Equals, "other")
+	c.Check(remote.Error, gc.IsNil)
+	c.Check(remote.Offers, jc.DeepEquals, []params.ApplicationOfferAdminDetails{remoteOffer})
+}
+
+func (s *applicationOffersSuite) TestFindFederatedOffersUnreachableController(c *gc.C) {
+	s.setupOffers(c, "", true)
+	s.authorizer.Tag = names.NewUserTag("admin")
+	s.mockState.externalControllers = []jujucrossmodel.ControllerInfo{{
+		ControllerTag: names.NewControllerTag("ffffffff-0bad-400d-8000-4b1d0d06f00d"),
+		Alias:         "other",
+	}}
+
+	s.PatchValue(applicationoffers.FindRemoteOffers, func(
+		jujucrossmodel.ControllerInfo, params.OfferFilters,
+	) ([]params.ApplicationOfferAdminDetails, error) {
+		return nil, errors.New("no route to host")
+	})
+
+	found, err := s.api.FindFederatedOffers(params.OfferFilters{
+		Filters: []params.OfferFilter{{
+			OfferName: "hosted-db2",
+			Endpoints: []params.EndpointFilterAttributes{{Interface: "db2"}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 2)
+
+	// An unreachable controller does not fail the whole search.
+	c.Assert(found.Results[0].Offers, gc.HasLen, 1)
+	c.Check(found.Results[1].Error, gc.ErrorMatches, "no route to host")
+}
+
+func (s *applicationOffersSuite) setupConsumedOffer(c *gc.C) *applicationoffers.OffersAPIV6 {
+	applicationoffers.ResetConsumedOffers()
+	s.authorizer.Tag = names.NewUserTag("admin")
+	s.mockState.remoteApplications = []crossmodel.RemoteApplication{&mockRemoteApplication{
+		url:         "fred@external/prod.hosted-mysql",
+		sourceModel: names.NewModelTag("uuid2"),
+	}}
+	s.mockState.controllersByModel = map[string]jujucrossmodel.ControllerInfo{
+		"uuid2": {
+			ControllerTag: names.NewControllerTag("ffffffff-0bad-400d-8000-4b1d0d06f00d"),
+			Alias:         "other",
+			Addrs:         []string{"10.0.0.1:17070"},
+		},
+	}
+	return &applicationoffers.OffersAPIV6{&applicationoffers.OffersAPIV5{&applicationoffers.OffersAPIV4{&applicationoffers.OffersAPIV3{s.api}}}}
+}
+
+func (s *applicationOffersSuite) TestConsumedOfferDetailsCached(c *gc.C) {
+	api := s.setupConsumedOffer(c)
+
+	fetched := 0
+	s.PatchValue(applicationoffers.FetchConsumedOffer, func(
+		info jujucrossmodel.ControllerInfo, url *jujucrossmodel.OfferURL,
+	) (*params.ApplicationOfferAdminDetails, error) {
+		c.Check(info.Alias, gc.Equals, "other")
+		c.Check(url.String(), gc.Equals, "fred@external/prod.hosted-mysql")
+		fetched++
+		return &params.ApplicationOfferAdminDetails{
+			ApplicationOfferDetails: params.ApplicationOfferDetails{
+				OfferName: "hosted-mysql",
+				OfferURL:  "fred@external/prod.hosted-mysql",
+			},
+		}, nil
+	})
+
+	args := params.OfferURLs{OfferURLs: []string{"fred@external/prod.hosted-mysql"}}
+	for i := 0; i < 2; i++ {
+		found, err := api.ConsumedOfferDetails(args)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(found.Results, gc.HasLen, 1)
+		c.Check(found.Results[0].Error, gc.IsNil)
+		c.Check(found.Results[0].Result.OfferName, gc.Equals, "hosted-mysql")
+	}
+	// The second query is served from the cache.
+	c.Check(fetched, gc.Equals, 1)
+}
+
+func (s *applicationOffersSuite) TestRefreshConsumedOffer(c *gc.C) {
+	api := s.setupConsumedOffer(c)
+
+	fetched := 0
+	s.PatchValue(applicationoffers.FetchConsumedOffer, func(
+		jujucrossmodel.ControllerInfo, *jujucrossmodel.OfferURL,
+	) (*params.ApplicationOfferAdminDetails, error) {
+		fetched++
+		return &params.ApplicationOfferAdminDetails{
+			ApplicationOfferDetails: params.ApplicationOfferDetails{
+				OfferName:              "hosted-mysql",
+				OfferURL:               "fred@external/prod.hosted-mysql",
+				ApplicationDescription: fmt.Sprintf("fetch %d", fetched),
+			},
+		}, nil
+	})
+
+	args := params.OfferURLs{OfferURLs: []string{"fred@external/prod.hosted-mysql"}}
+	_, err := api.ConsumedOfferDetails(args)
+	c.Assert(err, jc.ErrorIsNil)
+
+	found, err := api.RefreshConsumedOffer(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Check(found.Results[0].Error, gc.IsNil)
+	c.Check(found.Results[0].Result.ApplicationDescription, gc.Equals, "fetch 2")
+
+	// The refreshed details replace the cached entry.
+	found, err = api.ConsumedOfferDetails(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(found.Results[0].Result.ApplicationDescription, gc.Equals, "fetch 2")
+	c.Check(fetched, gc.Equals, 2)
+}
+
+func (s *applicationOffersSuite) TestConsumedOfferDetailsStaleOnOutage(c *gc.C) {
+	api := s.setupConsumedOffer(c)
+
+	fetchErr := error(nil)
+	s.PatchValue(applicationoffers.FetchConsumedOffer, func(
+		jujucrossmodel.ControllerInfo, *jujucrossmodel.OfferURL,
+	) (*params.ApplicationOfferAdminDetails, error) {
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return &params.ApplicationOfferAdminDetails{
+			ApplicationOfferDetails: params.ApplicationOfferDetails{
+				OfferName: "hosted-mysql",
+				OfferURL:  "fred@external/prod.hosted-mysql",
+			},
+		}, nil
+	})
+
+	args := params.OfferURLs{OfferURLs: []string{"fred@external/prod.hosted-mysql"}}
+	_, err := api.ConsumedOfferDetails(args)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// An explicit refresh fails while the offering controller is
+	// unreachable, but a plain query falls back to the cached entry.
+	fetchErr = errors.New("no route to host")
+	found, err := api.RefreshConsumedOffer(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(found.Results[0].Error, gc.ErrorMatches, "no route to host")
+
+	found, err = api.ConsumedOfferDetails(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(found.Results[0].Error, gc.IsNil)
+	c.Check(found.Results[0].Result.OfferName, gc.Equals, "hosted-mysql")
+}
+
+func (s *applicationOffersSuite) TestConsumedOfferDetailsNotFound(c *gc.C) {
+	api := s.setupConsumedOffer(c)
+	found, err := api.ConsumedOfferDetails(params.OfferURLs{
+		OfferURLs: []string{"fred@external/prod.hosted-db2"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(found.Results[0].Error, gc.ErrorMatches, `consumed offer "fred@external/prod.hosted-db2" not found`)
+}
+
+func (s *applicationOffersSuite) TestConsumedOfferDetailsPermission(c *gc.C) {
+	api := s.setupConsumedOffer(c)
+	s.authorizer.Tag = names.NewUserTag("someone")
+	_, err := api.ConsumedOfferDetails(params.OfferURLs{
+		OfferURLs: []string{"fred@external/prod.hosted-mysql"},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
 func (s *applicationOffersSuite) TestFindNoPermission(c *gc.C) {
 	s.mockState.users["someone"] = &mockUser{"someone"}
 	user := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-db2")
-	err := s.mockState.CreateOfferAccess(offer, user, permission.NoAccess)
+	err := s.mockState.CreateOfferAccess(offer, user, permission.NoAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.setupOffers(c, "", true)
@@ -818,7 +1024,7 @@ func (s *applicationOffersSuite) TestFindPermission(c *gc.C) {
 		},
 	}
 	s.mockState.users[user.Name()] = &mockUser{user.Name()}
-	_ = s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ReadAccess)
+	_ = s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ReadAccess, nil)
 	s.assertFind(c, expected)
 }
 
@@ -929,7 +1135,7 @@ func (s *applicationOffersSuite) TestFindMulti(c *gc.C) {
 	user := names.NewUserTag("someone")
 	s.authorizer.Tag = user
 	s.mockState.users[user.Name()] = &mockUser{user.Name()}
-	_ = s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ConsumeAccess)
+	_ = s.mockState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-db2"), user, permission.ConsumeAccess, nil)
 
 	anotherState := &mockState{
 		modelUUID:   "uuid2",
@@ -988,8 +1194,8 @@ func (s *applicationOffersSuite) TestFindMulti(c *gc.C) {
 		},
 	}
 	anotherState.users[user.Name()] = &mockUser{user.Name()}
-	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-mysql"), user, permission.ReadAccess)
-	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-postgresql"), user, permission.AdminAccess)
+	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-mysql"), user, permission.ReadAccess, nil)
+	anotherState.CreateOfferAccess(names.NewApplicationOfferTag("hosted-postgresql"), user, permission.AdminAccess, nil)
 
 	s.mockState.allmodels = []applicationoffers.Model{
 		s.mockState.model,
@@ -1176,7 +1382,7 @@ func (s *consumeSuite) TestConsumeDetailsNoPermission(c *gc.C) {
 	st.(*mockState).users["someone"] = &mockUser{"someone"}
 	apiUser := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-mysql")
-	err := st.CreateOfferAccess(offer, apiUser, permission.NoAccess)
+	err := st.CreateOfferAccess(offer, apiUser, permission.NoAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.authorizer.Tag = apiUser
@@ -1205,7 +1411,7 @@ func (s *consumeSuite) assertConsumeDetailsWithPermission(c *gc.C, specifiedUser
 	st.(*mockState).users["someone"] = &mockUser{"someone"}
 	apiUser := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-mysql")
-	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	userTag := ""
@@ -1252,11 +1458,12 @@ func (s *consumeSuite) assertConsumeDetailsWithPermission(c *gc.C, specifiedUser
 	c.Assert(results.Results[0].Macaroon.Id(), jc.DeepEquals, []byte("id"))
 
 	cav := s.bakery.caveats[string(results.Results[0].Macaroon.Id())]
-	c.Check(cav, gc.HasLen, 4)
+	c.Check(cav, gc.HasLen, 5)
 	c.Check(strings.HasPrefix(cav[0].Condition, "time-before "), jc.IsTrue)
 	c.Check(cav[1].Condition, gc.Equals, "declared source-model-uuid deadbeef-0bad-400d-8000-4b1d0d06f00d")
 	c.Check(cav[2].Condition, gc.Equals, "declared offer-uuid hosted-mysql-uuid")
 	c.Check(cav[3].Condition, gc.Equals, "declared username someone")
+	c.Check(strings.HasPrefix(cav[4].Condition, "declared issued-at "), jc.IsTrue)
 }
 
 func (s *consumeSuite) TestConsumeDetailsNonAdminSpecifiedUser(c *gc.C) {
@@ -1265,7 +1472,7 @@ func (s *consumeSuite) TestConsumeDetailsNonAdminSpecifiedUser(c *gc.C) {
 	st.(*mockState).users["someone"] = &mockUser{"someone"}
 	apiUser := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-mysql")
-	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.authorizer.Tag = names.NewUserTag("joe-blow")
@@ -1292,7 +1499,7 @@ func (s *consumeSuite) TestConsumeDetailsDefaultEndpoint(c *gc.C) {
 
 	apiUser := names.NewUserTag("someone")
 	offer := names.NewApplicationOfferTag("hosted-mysql")
-	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, apiUser, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.authorizer.Tag = apiUser
@@ -1383,7 +1590,7 @@ func (s *consumeSuite) TestRemoteApplicationInfo(c *gc.C) {
 	// Give user permission to see the offer.
 	user := names.NewUserTag("foobar")
 	offer := names.NewApplicationOfferTag("hosted-mysql")
-	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess)
+	err := st.CreateOfferAccess(offer, user, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.authorizer.Tag = user
@@ -1507,6 +1714,39 @@ func (s *consumeSuite) TestDestroyOffersForce(c *gc.C) {
 	c.Assert(found.Results[0].Error.Error(), gc.Matches, `application offer "fred@external/prod.hosted-db2" not found`)
 }
 
+func (s *consumeSuite) TestRotateOfferMacaroons(c *gc.C) {
+	s.setupOffer()
+	st := s.mockStatePool.st[testing.ModelTag.Id()]
+	st.(*mockState).users["foobar"] = &mockUser{"foobar"}
+
+	s.authorizer.Tag = names.NewUserTag("admin")
+	api := &applicationoffers.OffersAPIV5{&applicationoffers.OffersAPIV4{s.api}}
+	results, err := api.RotateOfferMacaroons(params.OfferURLs{
+		OfferURLs: []string{"fred@external/prod.hosted-mysql", "fred@external/prod.unknown"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `application offer "unknown" not found`)
+	c.Assert(st.(*mockState).macaroonsRotated, jc.DeepEquals, []string{"hosted-mysql-uuid"})
+}
+
+func (s *consumeSuite) TestRotateOfferMacaroonsPermission(c *gc.C) {
+	s.setupOffer()
+	s.authorizer.Tag = names.NewUserTag("mary")
+	st := s.mockStatePool.st[testing.ModelTag.Id()]
+	st.(*mockState).users["foobar"] = &mockUser{"foobar"}
+
+	api := &applicationoffers.OffersAPIV5{&applicationoffers.OffersAPIV4{s.api}}
+	results, err := api.RotateOfferMacaroons(params.OfferURLs{
+		OfferURLs: []string{"fred@external/prod.hosted-mysql"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, apiservererrors.ErrPerm.Error())
+	c.Assert(st.(*mockState).macaroonsRotated, gc.HasLen, 0)
+}
+
 func (s *consumeSuite) TestDestroyOffersPermission(c *gc.C) {
 	s.setupOffer()
 	s.authorizer.Tag = names.NewUserTag("mary")
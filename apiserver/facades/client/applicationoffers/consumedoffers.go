@@ -0,0 +1,186 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/api"
+	commoncrossmodel "github.com/juju/juju/apiserver/common/crossmodel"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	jujucrossmodel "github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// consumedOfferCacheTTL is how long cached details of a consumed
+// offer stay fresh before a query dials the offering controller
+// again.
+const consumedOfferCacheTTL = 10 * time.Minute
+
+// fetchConsumedOffer dials the external controller hosting the given
+// offer and queries the current offer details there.
+var fetchConsumedOffer = func(info jujucrossmodel.ControllerInfo, url *jujucrossmodel.OfferURL) (*params.ApplicationOfferAdminDetails, error) {
+	conn, err := api.Open(&api.Info{
+		Addrs:  info.Addrs,
+		CACert: info.CACert,
+	}, api.DialOpts{
+		Timeout:    30 * time.Second,
+		RetryDelay: 2 * time.Second,
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "connecting to external controller %q", info.ControllerTag.Id())
+	}
+	defer func() { _ = conn.Close() }()
+
+	var results params.QueryApplicationOffersResults
+	err = conn.APICall(
+		"ApplicationOffers", conn.BestFacadeVersion("ApplicationOffers"), "",
+		"FindApplicationOffers", params.OfferFilters{Filters: []params.OfferFilter{{
+			OwnerName: url.User,
+			ModelName: url.ModelName,
+			OfferName: url.ApplicationName,
+		}}}, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) == 0 {
+		return nil, errors.NotFoundf("application offer %q", url.String())
+	}
+	if len(results.Results) > 1 {
+		return nil, errors.Errorf("unexpected: %d matching offers for %q", len(results.Results), url.String())
+	}
+	return &results.Results[0], nil
+}
+
+// consumedOfferEntry is a cached copy of the details of one consumed
+// offer.
+type consumedOfferEntry struct {
+	details params.ApplicationOfferAdminDetails
+	fetched time.Time
+}
+
+// consumedOfferCache holds offer details fetched from offering
+// controllers, keyed by offer URL. The cache is shared by all API
+// connections to this controller so that consuming models do not
+// re-query the offering controller on every request.
+type consumedOfferCache struct {
+	clock clock.Clock
+
+	mu      sync.Mutex
+	entries map[string]consumedOfferEntry
+}
+
+var consumedOffers = &consumedOfferCache{
+	clock:   clock.WallClock,
+	entries: make(map[string]consumedOfferEntry),
+}
+
+// get returns the cached details for the given offer URL. A non
+// positive ttl returns an entry regardless of its age.
+func (c *consumedOfferCache) get(url string, ttl time.Duration) (params.ApplicationOfferAdminDetails, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[url]
+	if !ok {
+		return params.ApplicationOfferAdminDetails{}, false
+	}
+	if ttl > 0 && c.clock.Now().After(entry.fetched.Add(ttl)) {
+		return params.ApplicationOfferAdminDetails{}, false
+	}
+	return entry.details, true
+}
+
+func (c *consumedOfferCache) put(url string, details params.ApplicationOfferAdminDetails) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[url] = consumedOfferEntry{details: details, fetched: c.clock.Now()}
+}
+
+// ConsumedOfferDetails returns the details of offers consumed by the
+// model. Details are served from the consuming controller's cache
+// while fresh; only entries older than the cache TTL cause the
+// offering controller to be queried again. If the offering controller
+// cannot be reached, previously cached details are returned even when
+// stale.
+func (api *OffersAPIV6) ConsumedOfferDetails(args params.OfferURLs) (params.ApplicationOffersResults, error) {
+	return api.consumedOfferDetails(args, false)
+}
+
+// RefreshConsumedOffer bypasses any cached details of the given
+// consumed offers and fetches the current details from the offering
+// controllers, updating the cache for subsequent queries.
+func (api *OffersAPIV6) RefreshConsumedOffer(args params.OfferURLs) (params.ApplicationOffersResults, error) {
+	return api.consumedOfferDetails(args, true)
+}
+
+func (api *OffersAPI) consumedOfferDetails(args params.OfferURLs, refresh bool) (params.ApplicationOffersResults, error) {
+	user := api.Authorizer.GetAuthTag().(names.UserTag)
+	if err := api.checkPermission(user, api.ControllerModel.ModelTag(), permission.ReadAccess); err != nil {
+		return params.ApplicationOffersResults{}, errors.Trace(err)
+	}
+	results := params.ApplicationOffersResults{
+		Results: make([]params.ApplicationOfferResult, len(args.OfferURLs)),
+	}
+	for i, urlStr := range args.OfferURLs {
+		details, err := api.oneConsumedOffer(urlStr, refresh)
+		results.Results[i].Result = details
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *OffersAPI) oneConsumedOffer(urlStr string, refresh bool) (*params.ApplicationOfferAdminDetails, error) {
+	url, err := jujucrossmodel.ParseOfferURL(urlStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !refresh {
+		if details, ok := consumedOffers.get(url.String(), consumedOfferCacheTTL); ok {
+			return &details, nil
+		}
+	}
+	app, err := api.consumedOffer(url.String())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	controllerInfo, err := api.ControllerModel.ExternalControllerForModel(app.SourceModel().Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	details, err := fetchConsumedOffer(controllerInfo, url)
+	if err != nil {
+		if !refresh {
+			// A stale entry is better than failing the query outright
+			// while the offering controller is unreachable.
+			if details, ok := consumedOffers.get(url.String(), 0); ok {
+				logger.Warningf("cannot fetch consumed offer %q, serving cached details: %v", url.String(), err)
+				return &details, nil
+			}
+		}
+		return nil, errors.Trace(err)
+	}
+	consumedOffers.put(url.String(), *details)
+	return details, nil
+}
+
+// consumedOffer returns the remote application record of the consumed
+// offer with the given URL.
+func (api *OffersAPI) consumedOffer(urlStr string) (commoncrossmodel.RemoteApplication, error) {
+	apps, err := api.ControllerModel.AllRemoteApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, app := range apps {
+		if url, ok := app.URL(); ok && url == urlStr {
+			return app, nil
+		}
+	}
+	return nil, errors.NotFoundf("consumed offer %q", urlStr)
+}
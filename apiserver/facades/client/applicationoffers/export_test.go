@@ -4,5 +4,15 @@
 package applicationoffers
 
 var (
-	CreateOffersAPI = createOffersAPI
+	CreateOffersAPI    = createOffersAPI
+	FindRemoteOffers   = &findRemoteOffers
+	FetchConsumedOffer = &fetchConsumedOffer
 )
+
+// ResetConsumedOffers clears the package level consumed offer cache
+// so that tests do not observe entries cached by earlier tests.
+func ResetConsumedOffers() {
+	consumedOffers.mu.Lock()
+	defer consumedOffers.mu.Unlock()
+	consumedOffers.entries = make(map[string]consumedOfferEntry)
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	jujucrossmodel "github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/rpc/params"
+)
+
+// findRemoteOffers dials the input external controller and runs the
+// offer query there. Login relies on the external controller trusting
+// the same identity provider as this one, which is the expected
+// arrangement for a federated controller fleet.
+var findRemoteOffers = func(info jujucrossmodel.ControllerInfo, filters params.OfferFilters) ([]params.ApplicationOfferAdminDetails, error) {
+	conn, err := api.Open(&api.Info{
+		Addrs:  info.Addrs,
+		CACert: info.CACert,
+	}, api.DialOpts{
+		Timeout:    30 * time.Second,
+		RetryDelay: 2 * time.Second,
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "connecting to external controller %q", info.ControllerTag.Id())
+	}
+	defer func() { _ = conn.Close() }()
+
+	var results params.QueryApplicationOffersResults
+	err = conn.APICall(
+		"ApplicationOffers", conn.BestFacadeVersion("ApplicationOffers"), "",
+		"FindApplicationOffers", filters, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
+
+// FindFederatedOffers searches this controller and every external
+// controller registered with it for offers matching the supplied
+// filters, returning one result set per controller. A controller that
+// cannot be searched contributes an error to its own result set rather
+// than failing the whole search.
+func (api *OffersAPI) FindFederatedOffers(filters params.OfferFilters) (params.FederatedOfferResults, error) {
+	var result params.FederatedOfferResults
+
+	local, err := api.FindApplicationOffers(filters)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = append(result.Results, params.FederatedOfferResult{
+		ControllerTag: api.ControllerModel.ControllerTag().String(),
+		Offers:        local.Results,
+	})
+
+	controllers, err := api.ControllerModel.AllExternalControllers()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, info := range controllers {
+		one := params.FederatedOfferResult{
+			ControllerTag:   info.ControllerTag.String(),
+			ControllerAlias: info.Alias,
+		}
+		offers, err := findRemoteOffers(info, filters)
+		if err != nil {
+			one.Error = apiservererrors.ServerError(err)
+		} else {
+			one.Offers = offers
+		}
+		result.Results = append(result.Results, one)
+	}
+	return result, nil
+}
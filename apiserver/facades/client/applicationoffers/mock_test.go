@@ -275,18 +275,53 @@ type offerAccess struct {
 type mockState struct {
 	crossmodel.Backend
 	common.APIAddressAccessor
-	modelUUID         string
-	model             *mockModel
-	AdminTag          names.UserTag
-	allmodels         []applicationoffers.Model
-	users             map[string]applicationoffers.User
-	applications      map[string]crossmodel.Application
-	applicationOffers map[string]jujucrossmodel.ApplicationOffer
-	spaces            map[string]applicationoffers.Space
-	relations         map[string]crossmodel.Relation
-	connections       []applicationoffers.OfferConnection
-	accessPerms       map[offerAccess]permission.Access
-	relationNetworks  state.RelationNetworks
+	modelUUID           string
+	model               *mockModel
+	AdminTag            names.UserTag
+	allmodels           []applicationoffers.Model
+	users               map[string]applicationoffers.User
+	applications        map[string]crossmodel.Application
+	applicationOffers   map[string]jujucrossmodel.ApplicationOffer
+	spaces              map[string]applicationoffers.Space
+	relations           map[string]crossmodel.Relation
+	connections         []applicationoffers.OfferConnection
+	accessPerms         map[offerAccess]permission.Access
+	accessExpiry        map[offerAccess]time.Time
+	relationNetworks    state.RelationNetworks
+	externalControllers []jujucrossmodel.ControllerInfo
+	remoteApplications  []crossmodel.RemoteApplication
+	controllersByModel  map[string]jujucrossmodel.ControllerInfo
+	macaroonsRotated    []string
+}
+
+func (m *mockState) AllExternalControllers() ([]jujucrossmodel.ControllerInfo, error) {
+	return m.externalControllers, nil
+}
+
+func (m *mockState) AllRemoteApplications() ([]crossmodel.RemoteApplication, error) {
+	return m.remoteApplications, nil
+}
+
+func (m *mockState) ExternalControllerForModel(modelUUID string) (jujucrossmodel.ControllerInfo, error) {
+	info, ok := m.controllersByModel[modelUUID]
+	if !ok {
+		return jujucrossmodel.ControllerInfo{}, errors.NotFoundf("external controller for model %q", modelUUID)
+	}
+	return info, nil
+}
+
+type mockRemoteApplication struct {
+	crossmodel.RemoteApplication
+	url         string
+	sourceModel names.ModelTag
+}
+
+func (a *mockRemoteApplication) URL() (string, bool) {
+	return a.url, a.url != ""
+}
+
+func (a *mockRemoteApplication) SourceModel() names.ModelTag {
+	return a.sourceModel
 }
 
 func (m *mockState) GetAddressAndCertGetter() common.APIAddressAccessor {
@@ -317,6 +352,11 @@ func (m *mockState) ApplicationOffer(name string) (*jujucrossmodel.ApplicationOf
 	return &offer, nil
 }
 
+func (m *mockState) RotateOfferMacaroons(offerUUID string) (time.Time, error) {
+	m.macaroonsRotated = append(m.macaroonsRotated, offerUUID)
+	return time.Now(), nil
+}
+
 func (m *mockState) SpaceByName(name string) (applicationoffers.Space, error) {
 	space, ok := m.spaces[name]
 	if !ok {
@@ -404,7 +444,7 @@ func (m *mockState) GetOfferAccess(offerUUID string, user names.UserTag) (permis
 	return access, nil
 }
 
-func (m *mockState) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error {
+func (m *mockState) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access, expiresAt *time.Time) error {
 	if _, ok := m.users[user.Name()]; !ok {
 		return errors.NotFoundf("user %q", user.Name())
 	}
@@ -412,6 +452,12 @@ func (m *mockState) CreateOfferAccess(offer names.ApplicationOfferTag, user name
 		return errors.NewAlreadyExists(nil, fmt.Sprintf("offer user %s", user.Name()))
 	}
 	m.accessPerms[offerAccess{user: user, offerUUID: offer.Id() + "-uuid"}] = access
+	if expiresAt != nil {
+		if m.accessExpiry == nil {
+			m.accessExpiry = make(map[offerAccess]time.Time)
+		}
+		m.accessExpiry[offerAccess{user: user, offerUUID: offer.Id() + "-uuid"}] = *expiresAt
+	}
 	return nil
 }
 
@@ -28,6 +28,15 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("ApplicationOffers", 4, func(ctx facade.Context) (facade.Facade, error) {
 		return newOffersAPIV4(ctx)
 	}, reflect.TypeOf((*OffersAPIV4)(nil)))
+	registry.MustRegister("ApplicationOffers", 5, func(ctx facade.Context) (facade.Facade, error) {
+		return newOffersAPIV5(ctx) // Adds RotateOfferMacaroons.
+	}, reflect.TypeOf((*OffersAPIV5)(nil)))
+	registry.MustRegister("ApplicationOffers", 6, func(ctx facade.Context) (facade.Facade, error) {
+		return newOffersAPIV6(ctx) // Adds ConsumedOfferDetails and RefreshConsumedOffer.
+	}, reflect.TypeOf((*OffersAPIV6)(nil)))
+	registry.MustRegister("ApplicationOffers", 7, func(ctx facade.Context) (facade.Facade, error) {
+		return newOffersAPIV7(ctx) // ModifyOfferAccess gains an optional expiry time on grants.
+	}, reflect.TypeOf((*OffersAPIV7)(nil)))
 }
 
 // newOffersAPI returns a new application offers OffersAPI facade.
@@ -94,3 +103,30 @@ func newOffersAPIV4(ctx facade.Context) (*OffersAPIV4, error) {
 	}
 	return &OffersAPIV4{OffersAPIV3: apiV3}, nil
 }
+
+// newOffersAPIV5 returns a new application offers OffersAPIV5 facade.
+func newOffersAPIV5(ctx facade.Context) (*OffersAPIV5, error) {
+	apiV4, err := newOffersAPIV4(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &OffersAPIV5{OffersAPIV4: apiV4}, nil
+}
+
+// newOffersAPIV6 returns a new application offers OffersAPIV6 facade.
+func newOffersAPIV6(ctx facade.Context) (*OffersAPIV6, error) {
+	apiV5, err := newOffersAPIV5(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &OffersAPIV6{OffersAPIV5: apiV5}, nil
+}
+
+// newOffersAPIV7 returns a new application offers OffersAPIV7 facade.
+func newOffersAPIV7(ctx facade.Context) (*OffersAPIV7, error) {
+	apiV6, err := newOffersAPIV6(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &OffersAPIV7{OffersAPIV6: apiV6}, nil
+}
@@ -4,6 +4,8 @@
 package applicationoffers
 
 import (
+	"time"
+
 	"github.com/juju/charm/v8"
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
@@ -63,7 +65,11 @@ type Backend interface {
 	SpaceByName(string) (Space, error)
 	User(names.UserTag) (User, error)
 
-	CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error
+	// RotateOfferMacaroons invalidates macaroons previously issued for
+	// the offer, returning the rotation time.
+	RotateOfferMacaroons(offerUUID string) (time.Time, error)
+
+	CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access, expiresAt *time.Time) error
 	UpdateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error
 	RemoveOfferAccess(offer names.ApplicationOfferTag, user names.UserTag) error
 	GetOfferUsers(offerUUID string) (map[string]permission.Access, error)
@@ -72,6 +78,18 @@ type Backend interface {
 	GetModelCallContext() context.ProviderCallContext
 
 	AllSpaceInfos() (network.SpaceInfos, error)
+
+	// AllExternalControllers returns the connection details of every
+	// external controller registered with this one.
+	AllExternalControllers() ([]crossmodel.ControllerInfo, error)
+
+	// AllRemoteApplications returns the remote applications
+	// (consumed offers) in the model.
+	AllRemoteApplications() ([]commoncrossmodel.RemoteApplication, error)
+
+	// ExternalControllerForModel returns the connection details of
+	// the external controller hosting the given model.
+	ExternalControllerForModel(modelUUID string) (crossmodel.ControllerInfo, error)
 }
 
 var GetStateAccess = func(st *state.State) Backend {
@@ -90,8 +108,8 @@ func (s stateShim) UserPermission(subject names.UserTag, target names.Tag) (perm
 	return s.st.UserPermission(subject, target)
 }
 
-func (s stateShim) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error {
-	return s.st.CreateOfferAccess(offer, user, access)
+func (s stateShim) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access, expiresAt *time.Time) error {
+	return s.st.CreateOfferAccess(offer, user, access, expiresAt)
 }
 
 func (s stateShim) UpdateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error {
@@ -123,6 +141,48 @@ func (s *stateShim) AllSpaceInfos() (network.SpaceInfos, error) {
 	return s.st.AllSpaceInfos()
 }
 
+func (s *stateShim) AllExternalControllers() ([]crossmodel.ControllerInfo, error) {
+	controllers, err := state.NewExternalControllers(s.st).AllControllers()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]crossmodel.ControllerInfo, len(controllers))
+	for i, controller := range controllers {
+		result[i] = controller.ControllerInfo()
+	}
+	return result, nil
+}
+
+// remoteApplicationShim adapts a state RemoteApplication to the
+// commoncrossmodel interface of the same name.
+type remoteApplicationShim struct {
+	*state.RemoteApplication
+}
+
+func (a remoteApplicationShim) DestroyOperation(force bool) state.ModelOperation {
+	return a.RemoteApplication.DestroyOperation(force)
+}
+
+func (s *stateShim) AllRemoteApplications() ([]commoncrossmodel.RemoteApplication, error) {
+	apps, err := s.st.AllRemoteApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]commoncrossmodel.RemoteApplication, len(apps))
+	for i, app := range apps {
+		result[i] = remoteApplicationShim{app}
+	}
+	return result, nil
+}
+
+func (s *stateShim) ExternalControllerForModel(modelUUID string) (crossmodel.ControllerInfo, error) {
+	controller, err := s.st.ExternalControllerForModel(modelUUID)
+	if err != nil {
+		return crossmodel.ControllerInfo{}, errors.Trace(err)
+	}
+	return controller.ControllerInfo(), nil
+}
+
 type stateCharmShim struct {
 	*state.Charm
 }
@@ -140,6 +200,10 @@ func (s *stateShim) ApplicationOffer(name string) (*crossmodel.ApplicationOffer,
 	return offers.ApplicationOffer(name)
 }
 
+func (s *stateShim) RotateOfferMacaroons(offerUUID string) (time.Time, error) {
+	return s.st.RotateOfferMacaroons(offerUUID)
+}
+
 var GetApplicationOffers = func(backend interface{}) crossmodel.ApplicationOffers {
 	switch st := backend.(type) {
 	case *state.State:
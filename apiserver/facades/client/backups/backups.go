@@ -35,6 +35,10 @@ type Backend interface {
 	StateServingInfo() (controller.StateServingInfo, error)
 	RestoreInfo() *state.RestoreInfo
 	ControllerNodes() ([]state.ControllerNode, error)
+	BackupSchedule() (*state.BackupSchedule, error)
+	BackupScheduleStatus() (*state.BackupScheduleStatus, error)
+	SetBackupSchedule(state.BackupSchedule) error
+	RemoveBackupSchedule() error
 }
 
 // API provides backup-specific API methods.
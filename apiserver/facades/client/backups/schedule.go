@@ -0,0 +1,67 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"net/url"
+
+	"github.com/juju/errors"
+	"github.com/robfig/cron/v3"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// Schedule returns the automated backup schedule and the status of the
+// most recent scheduled run. It returns a not-found error if no
+// schedule is configured.
+func (a *API) Schedule() (params.BackupsScheduleResult, error) {
+	var result params.BackupsScheduleResult
+	schedule, err := a.backend.BackupSchedule()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	status, err := a.backend.BackupScheduleStatus()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Cron = schedule.Cron
+	result.RetentionCount = schedule.RetentionCount
+	result.UploadTarget = schedule.UploadTarget
+	result.LastBackupID = status.LastBackupID
+	result.LastRunError = status.LastRunError
+	if !status.LastRun.IsZero() {
+		lastRun := status.LastRun
+		result.LastRun = &lastRun
+	}
+	return result, nil
+}
+
+// SetSchedule configures the automated backup schedule. An empty cron
+// expression removes any existing schedule.
+func (a *API) SetSchedule(args params.BackupsScheduleArgs) error {
+	if args.Cron == "" {
+		return errors.Trace(a.backend.RemoveBackupSchedule())
+	}
+	if _, err := cron.ParseStandard(args.Cron); err != nil {
+		return errors.NewNotValid(err, "invalid cron expression")
+	}
+	if args.RetentionCount < 0 {
+		return errors.NotValidf("negative retention count")
+	}
+	if args.UploadTarget != "" {
+		target, err := url.Parse(args.UploadTarget)
+		if err != nil {
+			return errors.NewNotValid(err, "invalid upload target")
+		}
+		if target.Scheme != "https" && target.Scheme != "http" {
+			return errors.NotValidf("upload target scheme %q", target.Scheme)
+		}
+	}
+	return errors.Trace(a.backend.SetBackupSchedule(state.BackupSchedule{
+		Cron:           args.Cron,
+		RetentionCount: args.RetentionCount,
+		UploadTarget:   args.UploadTarget,
+	}))
+}
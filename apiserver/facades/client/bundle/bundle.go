@@ -472,6 +472,10 @@ func bundleOutputFromBundleData(bd *charm.BundleData) *bundleOutput {
 // Mask the new method from V1 API.
 func (u *APIv1) ExportBundle() (_, _ struct{}) { return }
 
+// ExportScaffolding is not in V6 API.
+// Mask the new method from V6 API or less.
+func (u *APIv6) ExportScaffolding() (_, _ struct{}) { return }
+
 func (b *BundleAPI) fillBundleData(model description.Model, includeCharmDefaults bool, backend Backend) (*charm.BundleData, error) {
 	cfg := model.Config()
 	value, ok := cfg["default-series"]
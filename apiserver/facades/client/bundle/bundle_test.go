@@ -2540,3 +2540,122 @@ applications:
 		c.Assert(result, gc.Equals, exp)
 	}
 }
+
+func (s *bundleSuite) TestExportScaffoldingTerraform(c *gc.C) {
+	model := s.newModel("iaas", "wordpress", "mysql")
+	model.SetStatus(description.StatusArgs{Value: "available"})
+
+	result, err := s.facade.BundleAPI.ExportScaffolding(params.ExportScaffoldingParams{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	output := `
+# Terraform scaffolding for model "awesome".
+# Review before applying: existing deployments are not imported
+# automatically; use "terraform import" to adopt them.
+
+resource "juju_model" "awesome" {
+  name = "awesome"
+}
+
+resource "juju_machine" "machine_0" {
+  model = juju_model.awesome.name
+}
+
+resource "juju_machine" "machine_1" {
+  model = juju_model.awesome.name
+}
+
+resource "juju_application" "mysql" {
+  name  = "mysql"
+  model = juju_model.awesome.name
+
+  charm {
+    name = "mysql"
+  }
+
+  units = 1
+}
+
+resource "juju_application" "wordpress" {
+  name  = "wordpress"
+  model = juju_model.awesome.name
+
+  charm {
+    name = "wordpress"
+  }
+
+  units = 2
+}
+
+resource "juju_integration" "wordpress_db_mysql_mysql" {
+  model = juju_model.awesome.name
+
+  application {
+    name = juju_application.wordpress.name
+    endpoint = "db"
+  }
+
+  application {
+    name = juju_application.mysql.name
+    endpoint = "mysql"
+  }
+}
+`[1:]
+	c.Assert(result, gc.Equals, params.StringResult{Result: output})
+	s.st.CheckCall(c, 0, "ExportPartial", s.st.GetExportConfig())
+}
+
+func (s *bundleSuite) TestExportScaffoldingPulumi(c *gc.C) {
+	s.st.model = description.NewModel(description.ModelArgs{Owner: names.NewUserTag("magic"),
+		Config: map[string]interface{}{
+			"name": "awesome",
+			"uuid": "some-uuid",
+		},
+		CloudRegion: "some-region"})
+
+	app := s.st.model.AddApplication(s.minimalApplicationArgs(description.IAAS))
+	app.SetCharmOrigin(description.CharmOriginArgs{Platform: "amd64/ubuntu/16.04/stable"})
+	app.SetStatus(minimalStatusArgs())
+
+	u := app.AddUnit(minimalUnitArgs(app.Type()))
+	u.SetAgentStatus(minimalStatusArgs())
+
+	s.st.model.SetStatus(description.StatusArgs{Value: "available"})
+
+	result, err := s.facade.BundleAPI.ExportScaffolding(params.ExportScaffoldingParams{
+		Format: "pulumi",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	output := `
+name: awesome
+runtime: yaml
+description: Pulumi scaffolding for model "awesome".
+resources:
+  model:
+    type: juju:Model
+    properties:
+      name: awesome
+  ubuntu:
+    type: juju:Application
+    properties:
+      name: ubuntu
+      model: ${model.name}
+      charm:
+        name: ubuntu
+        channel: stable
+      units: 1
+      config:
+        key: value
+`[1:]
+	c.Assert(result, gc.Equals, params.StringResult{Result: output})
+	s.st.CheckCall(c, 0, "ExportPartial", s.st.GetExportConfig())
+}
+
+func (s *bundleSuite) TestExportScaffoldingBadFormat(c *gc.C) {
+	result, err := s.facade.BundleAPI.ExportScaffolding(params.ExportScaffoldingParams{
+		Format: "ansible",
+	})
+	c.Assert(err, gc.ErrorMatches, `scaffolding format "ansible" not valid`)
+	c.Assert(result, gc.Equals, params.StringResult{})
+}
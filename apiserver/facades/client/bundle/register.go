@@ -30,6 +30,9 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Bundle", 6, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacadeV6(ctx)
 	}, reflect.TypeOf((*APIv6)(nil)))
+	registry.MustRegister("Bundle", 7, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacade(ctx)
+	}, reflect.TypeOf((*BundleAPI)(nil)))
 }
 
 // newFacadeV1 provides the signature required for facade registration
@@ -0,0 +1,371 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/charm/v8"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Scaffolding formats supported by ExportScaffolding.
+const (
+	// ScaffoldingFormatTerraform emits terraform-provider-juju
+	// resources in HCL.
+	ScaffoldingFormatTerraform = "terraform"
+
+	// ScaffoldingFormatPulumi emits a Pulumi YAML program.
+	ScaffoldingFormatPulumi = "pulumi"
+)
+
+// ExportScaffolding exports the current model's applications,
+// relations, offers and machines as infrastructure-as-code
+// scaffolding, easing adoption of Terraform or Pulumi for existing
+// hand-built models. The output is a starting point, not a faithful
+// import: operators are expected to review it and bring the
+// deployment under IaC control with their tool's import mechanism.
+func (b *BundleAPI) ExportScaffolding(arg params.ExportScaffoldingParams) (params.StringResult, error) {
+	fail := func(failErr error) (params.StringResult, error) {
+		return params.StringResult{}, apiservererrors.ServerError(failErr)
+	}
+
+	if err := b.checkCanRead(); err != nil {
+		return fail(err)
+	}
+
+	switch arg.Format {
+	case "", ScaffoldingFormatTerraform, ScaffoldingFormatPulumi:
+	default:
+		return fail(errors.NotValidf("scaffolding format %q", arg.Format))
+	}
+
+	exportConfig := b.backend.GetExportConfig()
+	model, err := b.backend.ExportPartial(exportConfig)
+	if err != nil {
+		return fail(err)
+	}
+
+	bundleData, err := b.fillBundleData(model, false, b.backend)
+	if err != nil {
+		return fail(err)
+	}
+
+	modelName, _ := model.Config()["name"].(string)
+	if modelName == "" {
+		modelName = "exported-model"
+	}
+
+	var output string
+	if arg.Format == ScaffoldingFormatPulumi {
+		output, err = pulumiScaffolding(modelName, bundleData)
+		if err != nil {
+			return fail(err)
+		}
+	} else {
+		output = terraformScaffolding(modelName, bundleData)
+	}
+	return params.StringResult{Result: output}, nil
+}
+
+// terraformScaffolding renders the bundle data as
+// terraform-provider-juju resources.
+func terraformScaffolding(modelName string, data *charm.BundleData) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# Terraform scaffolding for model %q.\n", modelName)
+	buf.WriteString("# Review before applying: existing deployments are not imported\n")
+	buf.WriteString("# automatically; use \"terraform import\" to adopt them.\n")
+
+	modelLabel := scaffoldingName(modelName)
+	fmt.Fprintf(&buf, "\nresource \"juju_model\" %q {\n", modelLabel)
+	fmt.Fprintf(&buf, "  name = %q\n", modelName)
+	buf.WriteString("}\n")
+
+	for _, id := range machineNames(data) {
+		spec := data.Machines[id]
+		fmt.Fprintf(&buf, "\nresource \"juju_machine\" \"machine_%s\" {\n", scaffoldingName(id))
+		fmt.Fprintf(&buf, "  model = juju_model.%s.name\n", modelLabel)
+		if spec != nil && spec.Series != "" {
+			fmt.Fprintf(&buf, "  series = %q\n", spec.Series)
+		}
+		if spec != nil && spec.Constraints != "" {
+			fmt.Fprintf(&buf, "  constraints = %q\n", spec.Constraints)
+		}
+		buf.WriteString("}\n")
+	}
+
+	for _, name := range applicationNames(data) {
+		app := data.Applications[name]
+		fmt.Fprintf(&buf, "\nresource \"juju_application\" %q {\n", scaffoldingName(name))
+		fmt.Fprintf(&buf, "  name  = %q\n", name)
+		fmt.Fprintf(&buf, "  model = juju_model.%s.name\n", modelLabel)
+		buf.WriteString("\n  charm {\n")
+		fmt.Fprintf(&buf, "    name = %q\n", charmNameFromURL(app.Charm))
+		if app.Channel != "" {
+			fmt.Fprintf(&buf, "    channel = %q\n", app.Channel)
+		}
+		if app.Revision != nil {
+			fmt.Fprintf(&buf, "    revision = %d\n", *app.Revision)
+		}
+		if app.Series != "" {
+			fmt.Fprintf(&buf, "    series = %q\n", app.Series)
+		}
+		buf.WriteString("  }\n")
+		if app.NumUnits > 0 {
+			fmt.Fprintf(&buf, "\n  units = %d\n", app.NumUnits)
+		}
+		if len(app.Options) > 0 {
+			buf.WriteString("\n  config = {\n")
+			for _, key := range sortedOptionKeys(app.Options) {
+				fmt.Fprintf(&buf, "    %q = %q\n", key, fmt.Sprintf("%v", app.Options[key]))
+			}
+			buf.WriteString("  }\n")
+		}
+		if app.Constraints != "" {
+			fmt.Fprintf(&buf, "\n  constraints = %q\n", app.Constraints)
+		}
+		buf.WriteString("}\n")
+	}
+
+	for _, rel := range data.Relations {
+		if len(rel) != 2 {
+			continue
+		}
+		app1, ep1 := splitScaffoldingEndpoint(rel[0])
+		app2, ep2 := splitScaffoldingEndpoint(rel[1])
+		label := fmt.Sprintf("%s_%s", scaffoldingName(rel[0]), scaffoldingName(rel[1]))
+		fmt.Fprintf(&buf, "\nresource \"juju_integration\" %q {\n", label)
+		fmt.Fprintf(&buf, "  model = juju_model.%s.name\n", modelLabel)
+		for _, pair := range [][2]string{{app1, ep1}, {app2, ep2}} {
+			buf.WriteString("\n  application {\n")
+			fmt.Fprintf(&buf, "    name = juju_application.%s.name\n", scaffoldingName(pair[0]))
+			if pair[1] != "" {
+				fmt.Fprintf(&buf, "    endpoint = %q\n", pair[1])
+			}
+			buf.WriteString("  }\n")
+		}
+		buf.WriteString("}\n")
+	}
+
+	for _, name := range applicationNames(data) {
+		app := data.Applications[name]
+		for _, offerName := range offerNames(app) {
+			offer := app.Offers[offerName]
+			fmt.Fprintf(&buf, "\nresource \"juju_offer\" %q {\n", scaffoldingName(offerName))
+			fmt.Fprintf(&buf, "  name             = %q\n", offerName)
+			fmt.Fprintf(&buf, "  model            = juju_model.%s.name\n", modelLabel)
+			fmt.Fprintf(&buf, "  application_name = juju_application.%s.name\n", scaffoldingName(name))
+			if len(offer.Endpoints) > 0 {
+				fmt.Fprintf(&buf, "  endpoint         = %q\n", strings.Join(offer.Endpoints, ","))
+			}
+			buf.WriteString("}\n")
+		}
+	}
+
+	return buf.String()
+}
+
+// pulumiScaffolding renders the bundle data as a Pulumi YAML
+// program.
+func pulumiScaffolding(modelName string, data *charm.BundleData) (string, error) {
+	resources := yaml.MapSlice{{
+		Key: "model",
+		Value: yaml.MapSlice{
+			{Key: "type", Value: "juju:Model"},
+			{Key: "properties", Value: yaml.MapSlice{
+				{Key: "name", Value: modelName},
+			}},
+		},
+	}}
+
+	for _, id := range machineNames(data) {
+		spec := data.Machines[id]
+		props := yaml.MapSlice{{Key: "model", Value: "${model.name}"}}
+		if spec != nil && spec.Series != "" {
+			props = append(props, yaml.MapItem{Key: "series", Value: spec.Series})
+		}
+		if spec != nil && spec.Constraints != "" {
+			props = append(props, yaml.MapItem{Key: "constraints", Value: spec.Constraints})
+		}
+		resources = append(resources, yaml.MapItem{
+			Key: fmt.Sprintf("machine-%s", scaffoldingName(id)),
+			Value: yaml.MapSlice{
+				{Key: "type", Value: "juju:Machine"},
+				{Key: "properties", Value: props},
+			},
+		})
+	}
+
+	for _, name := range applicationNames(data) {
+		app := data.Applications[name]
+		charmProps := yaml.MapSlice{{Key: "name", Value: charmNameFromURL(app.Charm)}}
+		if app.Channel != "" {
+			charmProps = append(charmProps, yaml.MapItem{Key: "channel", Value: app.Channel})
+		}
+		if app.Revision != nil {
+			charmProps = append(charmProps, yaml.MapItem{Key: "revision", Value: *app.Revision})
+		}
+		if app.Series != "" {
+			charmProps = append(charmProps, yaml.MapItem{Key: "series", Value: app.Series})
+		}
+		props := yaml.MapSlice{
+			{Key: "name", Value: name},
+			{Key: "model", Value: "${model.name}"},
+			{Key: "charm", Value: charmProps},
+		}
+		if app.NumUnits > 0 {
+			props = append(props, yaml.MapItem{Key: "units", Value: app.NumUnits})
+		}
+		if len(app.Options) > 0 {
+			config := yaml.MapSlice{}
+			for _, key := range sortedOptionKeys(app.Options) {
+				config = append(config, yaml.MapItem{Key: key, Value: fmt.Sprintf("%v", app.Options[key])})
+			}
+			props = append(props, yaml.MapItem{Key: "config", Value: config})
+		}
+		if app.Constraints != "" {
+			props = append(props, yaml.MapItem{Key: "constraints", Value: app.Constraints})
+		}
+		resources = append(resources, yaml.MapItem{
+			Key: name,
+			Value: yaml.MapSlice{
+				{Key: "type", Value: "juju:Application"},
+				{Key: "properties", Value: props},
+			},
+		})
+	}
+
+	for _, rel := range data.Relations {
+		if len(rel) != 2 {
+			continue
+		}
+		var endpoints []yaml.MapSlice
+		for _, endpoint := range rel {
+			app, ep := splitScaffoldingEndpoint(endpoint)
+			entry := yaml.MapSlice{{Key: "name", Value: fmt.Sprintf("${%s.name}", app)}}
+			if ep != "" {
+				entry = append(entry, yaml.MapItem{Key: "endpoint", Value: ep})
+			}
+			endpoints = append(endpoints, entry)
+		}
+		resources = append(resources, yaml.MapItem{
+			Key: fmt.Sprintf("%s-%s", scaffoldingName(rel[0]), scaffoldingName(rel[1])),
+			Value: yaml.MapSlice{
+				{Key: "type", Value: "juju:Integration"},
+				{Key: "properties", Value: yaml.MapSlice{
+					{Key: "model", Value: "${model.name}"},
+					{Key: "applications", Value: endpoints},
+				}},
+			},
+		})
+	}
+
+	for _, name := range applicationNames(data) {
+		app := data.Applications[name]
+		for _, offerName := range offerNames(app) {
+			offer := app.Offers[offerName]
+			props := yaml.MapSlice{
+				{Key: "name", Value: offerName},
+				{Key: "model", Value: "${model.name}"},
+				{Key: "applicationName", Value: fmt.Sprintf("${%s.name}", name)},
+			}
+			if len(offer.Endpoints) > 0 {
+				props = append(props, yaml.MapItem{Key: "endpoint", Value: strings.Join(offer.Endpoints, ",")})
+			}
+			resources = append(resources, yaml.MapItem{
+				Key: offerName,
+				Value: yaml.MapSlice{
+					{Key: "type", Value: "juju:Offer"},
+					{Key: "properties", Value: props},
+				},
+			})
+		}
+	}
+
+	doc := yaml.MapSlice{
+		{Key: "name", Value: modelName},
+		{Key: "runtime", Value: "yaml"},
+		{Key: "description", Value: fmt.Sprintf("Pulumi scaffolding for model %q.", modelName)},
+		{Key: "resources", Value: resources},
+	}
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(out), nil
+}
+
+// scaffoldingName converts an arbitrary juju name into an identifier
+// acceptable to both HCL resource labels and Pulumi resource names.
+func scaffoldingName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// splitScaffoldingEndpoint splits an "application:endpoint" pair,
+// tolerating a bare application name.
+func splitScaffoldingEndpoint(endpoint string) (string, string) {
+	parts := strings.SplitN(endpoint, ":", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
+}
+
+// charmNameFromURL extracts the charm name from a charm URL,
+// returning the input unchanged if it does not parse.
+func charmNameFromURL(url string) string {
+	curl, err := charm.ParseURL(url)
+	if err != nil {
+		return url
+	}
+	return curl.Name
+}
+
+func applicationNames(data *charm.BundleData) []string {
+	names := make([]string, 0, len(data.Applications))
+	for name := range data.Applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func machineNames(data *charm.BundleData) []string {
+	names := make([]string, 0, len(data.Machines))
+	for id := range data.Machines {
+		names = append(names, id)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func offerNames(app *charm.ApplicationSpec) []string {
+	names := make([]string, 0, len(app.Offers))
+	for name := range app.Offers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedOptionKeys(options map[string]interface{}) []string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
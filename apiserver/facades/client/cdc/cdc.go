@@ -0,0 +1,64 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package cdc exposes the model's change data capture stream, so that
+// external systems such as CMDBs and inventories can mirror Juju state
+// without polling status.
+package cdc
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
+)
+
+// API serves the CDC change stream for a model.
+type API struct {
+	state     *state.State
+	resources facade.Resources
+}
+
+// NewFacade provides the signature required for facade registration.
+// The stream mirrors every entity in the model, so it is restricted to
+// model admins.
+func NewFacade(ctx facade.Context) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	st := ctx.State()
+	model, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	isAdmin, err := authorizer.HasPermission(permission.AdminAccess, model.ModelTag())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !isAdmin {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		state:     st,
+		resources: ctx.Resources(),
+	}, nil
+}
+
+// WatchChanges returns a watcher that streams JSON-encoded state.CDCEvent
+// values for committed document changes in the model. The initial event
+// is empty: the stream starts at the point of watching.
+func (api *API) WatchChanges() (params.StringsWatchResult, error) {
+	w := api.state.WatchCDCEvents()
+	if changes, ok := <-w.Changes(); ok {
+		return params.StringsWatchResult{
+			StringsWatcherId: api.resources.Register(w),
+			Changes:          changes,
+		}, nil
+	}
+	return params.StringsWatchResult{}, watcher.EnsureErr(w)
+}
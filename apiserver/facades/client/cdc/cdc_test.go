@@ -0,0 +1,78 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cdc_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade/facadetest"
+	"github.com/juju/juju/apiserver/facades/client/cdc"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	jujutesting "github.com/juju/juju/juju/testing"
+)
+
+type cdcSuite struct {
+	jujutesting.JujuConnSuite
+
+	resources  *common.Resources
+	authorizer apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&cdcSuite{})
+
+func (s *cdcSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+	s.resources = common.NewResources()
+	s.AddCleanup(func(_ *gc.C) { s.resources.StopAll() })
+
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: s.AdminUserTag(c),
+	}
+}
+
+func (s *cdcSuite) newFacade(c *gc.C, authorizer apiservertesting.FakeAuthorizer) (*cdc.API, error) {
+	return cdc.NewFacade(facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      authorizer,
+	})
+}
+
+func (s *cdcSuite) TestNewFacadeAcceptsModelAdmin(c *gc.C) {
+	api, err := s.newFacade(c, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(api, gc.NotNil)
+}
+
+func (s *cdcSuite) TestNewFacadeRefusesNonAdmin(c *gc.C) {
+	anAuthorizer := s.authorizer
+	anAuthorizer.Tag = names.NewUserTag("readonly")
+	api, err := s.newFacade(c, anAuthorizer)
+	c.Assert(api, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *cdcSuite) TestNewFacadeRefusesNonClient(c *gc.C) {
+	anAuthorizer := s.authorizer
+	anAuthorizer.Tag = names.NewUnitTag("mysql/0")
+	api, err := s.newFacade(c, anAuthorizer)
+	c.Assert(api, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *cdcSuite) TestWatchChanges(c *gc.C) {
+	api, err := s.newFacade(c, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := api.WatchChanges()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.StringsWatcherId, gc.Equals, "1")
+	// The stream starts at the point of watching, so the initial
+	// event carries no changes.
+	c.Assert(result.Changes, gc.HasLen, 0)
+	c.Assert(s.resources.Get("1"), gc.NotNil)
+}
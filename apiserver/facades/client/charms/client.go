@@ -6,6 +6,7 @@ package charms
 import (
 	"net/http"
 	"net/url"
+	"sort"
 	"sync"
 	"time"
 
@@ -149,6 +150,72 @@ func (a *API) List(args params.CharmsList) (params.CharmsListResult, error) {
 	return params.CharmsListResult{CharmURLs: charmURLs}, nil
 }
 
+// ListCharmsWithInterface returns the endpoints of deployed
+// applications whose charm declares a relation with the given
+// interface, optionally restricted by role. It lets relation
+// automation find candidate endpoints without downloading and
+// inspecting every charm's metadata client-side.
+func (a *API) ListCharmsWithInterface(args params.CharmsWithInterfaceQuery) (params.CharmsWithInterfaceResult, error) {
+	logger.Tracef("ListCharmsWithInterface %+v", args)
+	var result params.CharmsWithInterfaceResult
+	if err := a.checkCanRead(); err != nil {
+		return result, errors.Trace(err)
+	}
+	if args.Interface == "" {
+		return result, errors.NotValidf("empty interface name")
+	}
+	role := charm.RelationRole(args.Role)
+	switch role {
+	case "", charm.RoleProvider, charm.RoleRequirer, charm.RolePeer:
+	default:
+		return result, errors.NotValidf("role %q", args.Role)
+	}
+	apps, err := a.backendState.AllApplications()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, app := range apps {
+		ch, _, err := app.Charm()
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		meta := ch.Meta()
+		if meta == nil {
+			continue
+		}
+		for _, rel := range matchingRelations(meta, args.Interface, role) {
+			result.Results = append(result.Results, params.CharmInterfaceMatch{
+				ApplicationName: app.Name(),
+				CharmURL:        ch.URL().String(),
+				EndpointName:    rel.Name,
+				Role:            string(rel.Role),
+				Interface:       rel.Interface,
+			})
+		}
+	}
+	return result, nil
+}
+
+// matchingRelations returns the relations declared in meta with the
+// given interface, ordered by endpoint name. An empty role matches
+// any role.
+func matchingRelations(meta *charm.Meta, interfaceName string, role charm.RelationRole) []charm.Relation {
+	var matches []charm.Relation
+	for _, relations := range []map[string]charm.Relation{meta.Provides, meta.Requires, meta.Peers} {
+		for _, rel := range relations {
+			if rel.Interface != interfaceName {
+				continue
+			}
+			if role != "" && rel.Role != role {
+				continue
+			}
+			matches = append(matches, rel)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Name < matches[j].Name })
+	return matches
+}
+
 // GetDownloadInfos is not available via the V2 API.
 func (a *APIv2) GetDownloadInfos(_ struct{}) {}
 
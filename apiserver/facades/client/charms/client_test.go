@@ -670,6 +670,77 @@ func (s *charmsMockSuite) TestCheckCharmPlacementWithHeterogeneous(c *gc.C) {
 	c.Assert(result.OneError(), gc.ErrorMatches, "charm can not be placed in a heterogeneous environment")
 }
 
+func (s *charmsMockSuite) TestListCharmsWithInterface(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.api(c)
+
+	mysqlCurl := charm.MustParseURL("ch:mysql-1")
+	s.application.EXPECT().Name().Return("mysql").AnyTimes()
+	s.application.EXPECT().Charm().Return(fakeCharm{
+		url: mysqlCurl,
+		meta: &charm.Meta{
+			Provides: map[string]charm.Relation{
+				"db": {
+					Name:      "db",
+					Role:      charm.RoleProvider,
+					Interface: "mysql",
+				},
+			},
+			Requires: map[string]charm.Relation{
+				"proxy": {
+					Name:      "proxy",
+					Role:      charm.RoleRequirer,
+					Interface: "http",
+				},
+			},
+		},
+	}, false, nil).AnyTimes()
+	s.state.EXPECT().AllApplications().Return(
+		[]interfaces.Application{s.application}, nil).AnyTimes()
+
+	result, err := api.ListCharmsWithInterface(params.CharmsWithInterfaceQuery{
+		Interface: "mysql",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.DeepEquals, []params.CharmInterfaceMatch{{
+		ApplicationName: "mysql",
+		CharmURL:        "ch:mysql-1",
+		EndpointName:    "db",
+		Role:            "provider",
+		Interface:       "mysql",
+	}})
+
+	// Restricting by role excludes endpoints with other roles.
+	result, err = api.ListCharmsWithInterface(params.CharmsWithInterfaceQuery{
+		Interface: "mysql",
+		Role:      "requirer",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 0)
+}
+
+func (s *charmsMockSuite) TestListCharmsWithInterfaceValidation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.api(c)
+
+	_, err := api.ListCharmsWithInterface(params.CharmsWithInterfaceQuery{})
+	c.Assert(err, gc.ErrorMatches, "empty interface name not valid")
+
+	_, err = api.ListCharmsWithInterface(params.CharmsWithInterfaceQuery{
+		Interface: "mysql",
+		Role:      "observer",
+	})
+	c.Assert(err, gc.ErrorMatches, `role "observer" not valid`)
+}
+
+type fakeCharm struct {
+	url  *charm.URL
+	meta *charm.Meta
+}
+
+func (f fakeCharm) Meta() *charm.Meta { return f.meta }
+func (f fakeCharm) URL() *charm.URL   { return f.url }
+
 func (s *charmsMockSuite) api(c *gc.C) *charms.API {
 	api, err := charms.NewCharmsAPI(
 		s.authorizer,
@@ -46,8 +46,9 @@ func convertOrigin(origin corecharm.Origin) (params.CharmOrigin, error) {
 		OS:           origin.Platform.OS,
 		Channel:      origin.Platform.Channel,
 		// TODO(juju3) - remove series
-		Series:      chSeries,
-		InstanceKey: origin.InstanceKey,
+		Series:        chSeries,
+		BaseSelection: origin.BaseSelection,
+		InstanceKey:   origin.InstanceKey,
 	}, nil
 }
 
@@ -82,7 +83,8 @@ func ConvertParamsOrigin(origin params.CharmOrigin) (corecharm.Origin, error) {
 			OS:           base.Name,
 			Channel:      base.Channel.Track,
 		},
-		InstanceKey: origin.InstanceKey,
+		BaseSelection: origin.BaseSelection,
+		InstanceKey:   origin.InstanceKey,
 	}, nil
 }
 
@@ -47,6 +47,18 @@ func (s stateShim) Application(name string) (interfaces.Application, error) {
 	return stateApplicationShim{Application: app}, nil
 }
 
+func (s stateShim) AllApplications() ([]interfaces.Application, error) {
+	apps, err := s.State.AllApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]interfaces.Application, len(apps))
+	for i, app := range apps {
+		results[i] = stateApplicationShim{Application: app}
+	}
+	return results, nil
+}
+
 func (s stateShim) Machine(machineID string) (interfaces.Machine, error) {
 	machine, err := s.State.Machine(machineID)
 	return machine, errors.Trace(err)
@@ -56,6 +68,14 @@ type stateApplicationShim struct {
 	*state.Application
 }
 
+func (s stateApplicationShim) Charm() (interfaces.Charm, bool, error) {
+	ch, force, err := s.Application.Charm()
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	return ch, force, nil
+}
+
 func (s stateApplicationShim) AllUnits() ([]interfaces.Unit, error) {
 	units, err := s.Application.AllUnits()
 	if err != nil {
@@ -28,6 +28,7 @@ type BackendModel interface {
 
 type BackendState interface {
 	AddCharmMetadata(state.CharmInfo) (*state.Charm, error)
+	AllApplications() ([]Application, error)
 	AllCharms() ([]*state.Charm, error)
 	Application(string) (Application, error)
 	Charm(curl *charm.URL) (*state.Charm, error)
@@ -47,8 +48,17 @@ type BackendState interface {
 // the same names.
 type Application interface {
 	AllUnits() ([]Unit, error)
+	Charm() (Charm, bool, error)
 	Constraints() (constraints.Value, error)
 	IsPrincipal() bool
+	Name() string
+}
+
+// Charm defines a subset of the functionality provided by the
+// state.Charm type, as required by the charms facade.
+type Charm interface {
+	Meta() *charm.Meta
+	URL() *charm.URL
 }
 
 // Machine defines a subset of the functionality provided by the
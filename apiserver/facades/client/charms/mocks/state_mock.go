@@ -61,6 +61,21 @@ func (mr *MockBackendStateMockRecorder) AddCharmMetadata(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharmMetadata", reflect.TypeOf((*MockBackendState)(nil).AddCharmMetadata), arg0)
 }
 
+// AllApplications mocks base method.
+func (m *MockBackendState) AllApplications() ([]interfaces.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllApplications")
+	ret0, _ := ret[0].([]interfaces.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllApplications indicates an expected call of AllApplications.
+func (mr *MockBackendStateMockRecorder) AllApplications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllApplications", reflect.TypeOf((*MockBackendState)(nil).AllApplications))
+}
+
 // AllCharms mocks base method.
 func (m *MockBackendState) AllCharms() ([]*state.Charm, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +401,22 @@ func (mr *MockApplicationMockRecorder) AllUnits() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUnits", reflect.TypeOf((*MockApplication)(nil).AllUnits))
 }
 
+// Charm mocks base method.
+func (m *MockApplication) Charm() (interfaces.Charm, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Charm")
+	ret0, _ := ret[0].(interfaces.Charm)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Charm indicates an expected call of Charm.
+func (mr *MockApplicationMockRecorder) Charm() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charm", reflect.TypeOf((*MockApplication)(nil).Charm))
+}
+
 // Constraints mocks base method.
 func (m *MockApplication) Constraints() (constraints.Value, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +446,20 @@ func (mr *MockApplicationMockRecorder) IsPrincipal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrincipal", reflect.TypeOf((*MockApplication)(nil).IsPrincipal))
 }
 
+// Name mocks base method.
+func (m *MockApplication) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockApplicationMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockApplication)(nil).Name))
+}
+
 // MockMachine is a mock of Machine interface.
 type MockMachine struct {
 	ctrl     *gomock.Controller
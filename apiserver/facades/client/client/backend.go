@@ -90,6 +90,7 @@ type Model interface {
 	CloudName() string
 	CloudRegion() string
 	CloudCredentialTag() (names.CloudCredentialTag, bool)
+	CloudCredential() (state.Credential, bool, error)
 	Config() (*config.Config, error)
 	Owner() names.UserTag
 	AddUser(state.UserAccessSpec) (permission.UserAccess, error)
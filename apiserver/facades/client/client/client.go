@@ -187,7 +187,7 @@ func NewFacade(ctx facade.Context) (*Client, error) {
 	blockChecker := common.NewBlockChecker(st)
 	backend := modelconfig.NewStateBackend(model)
 	// The modelConfigAPI exposed here is V1.
-	modelConfigAPI, err := modelconfig.NewModelConfigAPI(backend, authorizer)
+	modelConfigAPI, err := modelconfig.NewModelConfigAPI(backend, resources, authorizer)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -206,7 +206,9 @@ func NewFacade(ctx facade.Context) (*Client, error) {
 		&stateShim{st, model, nil},
 		&poolShim{ctx.StatePool()},
 		&modelconfig.ModelConfigAPIV1{
-			&modelconfig.ModelConfigAPIV2{modelConfigAPI},
+			&modelconfig.ModelConfigAPIV2{
+				&modelconfig.ModelConfigAPIV3{modelConfigAPI},
+			},
 		},
 		resources,
 		authorizer,
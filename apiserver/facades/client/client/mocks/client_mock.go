@@ -781,6 +781,22 @@ func (mr *MockModelMockRecorder) AddUser(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockModel)(nil).AddUser), arg0)
 }
 
+// CloudCredential mocks base method.
+func (m *MockModel) CloudCredential() (state.Credential, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudCredential")
+	ret0, _ := ret[0].(state.Credential)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CloudCredential indicates an expected call of CloudCredential.
+func (mr *MockModelMockRecorder) CloudCredential() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudCredential", reflect.TypeOf((*MockModel)(nil).CloudCredential))
+}
+
 // CloudCredentialTag mocks base method.
 func (m *MockModel) CloudCredentialTag() (names.CloudCredentialTag, bool) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"time"
@@ -245,6 +246,11 @@ func (c *Client) FullStatus(args params.StatusParams) (params.FullStatus, error)
 	if context.status, err = context.model.LoadModelStatus(); err != nil {
 		return noStatus, errors.Annotate(err, "could not load model status values")
 	}
+	if args.IncludeFlapping {
+		if context.statusTransitions, err = context.model.LoadStatusTransitions(statusFlapWindow); err != nil {
+			return noStatus, errors.Annotate(err, "could not load status transitions")
+		}
+	}
 	if context.allAppsUnitsCharmBindings, err =
 		fetchAllApplicationsAndUnits(c.api.stateAccessor, context.model, context.spaceInfos); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch applications and units")
@@ -556,9 +562,48 @@ func (c *Client) modelStatus() (params.ModelStatusInfo, error) {
 		}
 	}
 
+	info.CredentialWarning = credentialWarning(m)
+
 	return info, nil
 }
 
+// credentialExpiryWarningWindow is how close to expiry the model's
+// cloud credential must be before status starts warning about it. It
+// matches the window used by the credential checker worker.
+const credentialExpiryWarningWindow = 30 * 24 * time.Hour
+
+// credentialWarning returns a warning about the model's cloud
+// credential when it has been marked invalid, or when the credential
+// checker worker has recorded an expiry time that is near or past.
+func credentialWarning(m Model) string {
+	credential, ok, err := m.CloudCredential()
+	if err != nil || !ok {
+		// Status is best effort here; a missing credential is not an
+		// error worth failing the whole status call for.
+		return ""
+	}
+	if !credential.IsValid() {
+		if credential.InvalidReason != "" {
+			return fmt.Sprintf("cloud credential is invalid: %s", credential.InvalidReason)
+		}
+		return "cloud credential is invalid"
+	}
+	if credential.Expiry == nil {
+		return ""
+	}
+	remaining := time.Until(*credential.Expiry)
+	if remaining <= 0 {
+		return fmt.Sprintf("cloud credential expired at %s", credential.Expiry.Format(time.RFC3339))
+	}
+	if remaining < credentialExpiryWarningWindow {
+		return fmt.Sprintf(
+			"cloud credential expires at %s; renew it before workloads start failing",
+			credential.Expiry.Format(time.RFC3339),
+		)
+	}
+	return ""
+}
+
 type applicationStatusInfo struct {
 	// application: application name -> application
 	applications map[string]*state.Application
@@ -583,6 +628,11 @@ type statusContext struct {
 	status       *state.ModelStatus
 	presence     common.ModelPresenceContext
 
+	// statusTransitions holds recent status transition counts for
+	// every entity in the model, loaded in one query when the client
+	// asks for flapping detection; nil otherwise.
+	statusTransitions *state.StatusTransitions
+
 	// machines: top-level machine id -> list of machines nested in
 	// this machine.
 	machines map[string][]*state.Machine
@@ -1062,6 +1112,15 @@ func (c *statusContext) makeMachineStatus(machine *state.Machine,
 	sInstInfo, err := c.status.MachineInstance(machineID)
 	populateStatusFromStatusInfoAndErr(&status.InstanceStatus, sInstInfo, err)
 
+	// Include the patch status reported by the machine agent, if any.
+	if patchStatus, err := machine.PatchStatus(); err == nil {
+		status.PendingUpdates = patchStatus.PendingUpdates
+		status.PendingSecurityUpdates = patchStatus.PendingSecurityUpdates
+		status.RebootRequired = patchStatus.RebootRequired
+	} else if !errors.IsNotFound(err) {
+		logger.Debugf("error fetching patch status for machine %q: %v", machineID, err)
+	}
+
 	// Fetch the machine modification status information
 	sModInfo, err := c.status.MachineModification(machineID)
 	populateStatusFromStatusInfoAndErr(&status.ModificationStatus, sModInfo, err)
@@ -1307,6 +1366,9 @@ func (context *statusContext) processApplication(application *state.Application)
 		ExposedEndpoints: mappedExposedEndpoints,
 		Life:             processLife(application),
 	}
+	if expiry, ok := application.ExposeExpiry(); ok {
+		processedStatus.ExposeExpiry = &expiry
+	}
 
 	if latestCharm, ok := context.allAppsUnitsCharmBindings.latestCharms[*applicationCharm.URL().WithRevision(-1)]; ok && latestCharm != nil {
 		if latestCharm.Revision() > applicationCharm.URL().Revision {
@@ -1724,6 +1786,10 @@ func (c *statusContext) processUnitAndAgentStatus(unit *state.Unit,
 	agent, workload := c.presence.UnitStatus(wrapped)
 	populateStatusFromStatusInfoAndErr(&agentStatus, agent.Status, agent.Err)
 	populateStatusFromStatusInfoAndErr(&workloadStatus, workload.Status, workload.Err)
+	if c.statusTransitions != nil {
+		populateStatusFlapping(&agentStatus, c.statusTransitions.UnitAgent(unit.Name()))
+		populateStatusFlapping(&workloadStatus, c.statusTransitions.Unit(unit.Name()))
+	}
 
 	agentStatus.Life = processLife(unit)
 
@@ -1742,6 +1808,27 @@ func populateStatusFromStatusInfoAndErr(agent *params.DetailedStatus, statusInfo
 	agent.Info = statusInfo.Message
 	agent.Data = filterStatusData(statusInfo.Data)
 	agent.Since = statusInfo.Since
+	if statusInfo.Since != nil {
+		agent.SinceDuration = time.Since(*statusInfo.Since).Round(time.Second)
+	}
+}
+
+const (
+	// statusFlapWindow is how far back status history is inspected
+	// when computing RecentTransitions for a status result.
+	statusFlapWindow = 15 * time.Minute
+
+	// statusFlapThreshold is the number of transitions within
+	// statusFlapWindow at which an entity is reported as flapping.
+	statusFlapThreshold = 5
+)
+
+// populateStatusFlapping fills in RecentTransitions and Flapping on a
+// status result from the transition counts loaded up front for the
+// whole model, saving clients from fetching the history themselves.
+func populateStatusFlapping(out *params.DetailedStatus, transitions int) {
+	out.RecentTransitions = transitions
+	out.Flapping = transitions >= statusFlapThreshold
 }
 
 // contextMachine overloads the Status call to use the cached status values,
@@ -1762,6 +1849,9 @@ func (c *statusContext) processMachine(machine *state.Machine) (out params.Detai
 	wrapped := &contextMachine{machine, c}
 	statusInfo, err := c.presence.MachineStatus(wrapped)
 	populateStatusFromStatusInfoAndErr(&out, statusInfo, err)
+	if c.statusTransitions != nil {
+		populateStatusFlapping(&out, c.statusTransitions.Machine(machine.Id()))
+	}
 
 	out.Life = processLife(machine)
 
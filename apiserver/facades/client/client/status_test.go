@@ -95,6 +95,51 @@ func (s *statusSuite) TestUnsupportedNoModelMeterStatus(c *gc.C) {
 	c.Check(status.Model.MeterStatus.Message, gc.Equals, "")
 }
 
+func (s *statusSuite) statusFacadeForTest(c *gc.C) *client.Client {
+	s.State.StartSync()
+	s.WaitForModelWatchersIdle(c, s.State.ModelUUID())
+
+	ctx := &facadetest.Context{
+		Controller_: s.Controller,
+		State_:      s.State,
+		StatePool_:  s.StatePool,
+		Auth_: apiservertesting.FakeAuthorizer{
+			Tag:        s.AdminUserTag(c),
+			Controller: true,
+		},
+		Resources_:        common.NewResources(),
+		LeadershipReader_: mockLeadershipReader{leaders: map[string]string{}},
+	}
+	facade, err := client.NewFacade(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	return facade
+}
+
+func (s *statusSuite) TestFullStatusFlappingOptIn(c *gc.C) {
+	machine := s.addMachine(c)
+	// pending -> started -> stopped -> started -> stopped -> started:
+	// five transitions, enough to be reported as flapping.
+	for _, st := range []status.Status{
+		status.Started, status.Stopped, status.Started, status.Stopped, status.Started,
+	} {
+		c.Assert(machine.SetStatus(status.StatusInfo{Status: st}), jc.ErrorIsNil)
+	}
+	facade := s.statusFacadeForTest(c)
+
+	// Flapping detection is off by default: no history is read.
+	result, err := facade.FullStatus(params.StatusParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	agent := result.Machines[machine.Id()].AgentStatus
+	c.Check(agent.RecentTransitions, gc.Equals, 0)
+	c.Check(agent.Flapping, jc.IsFalse)
+
+	result, err = facade.FullStatus(params.StatusParams{IncludeFlapping: true})
+	c.Assert(err, jc.ErrorIsNil)
+	agent = result.Machines[machine.Id()].AgentStatus
+	c.Check(agent.RecentTransitions, gc.Equals, 5)
+	c.Check(agent.Flapping, jc.IsTrue)
+}
+
 func (s *statusSuite) TestFullStatusUnitLeadership(c *gc.C) {
 	u := s.Factory.MakeUnit(c, nil)
 	claimer, err := s.LeaseManager.Claimer("application-leadership", s.State.ModelUUID())
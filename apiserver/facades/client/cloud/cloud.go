@@ -1141,6 +1141,9 @@ func (api *CloudAPI) internalCredentialContents(args params.CloudCredentialArgs,
 		if includeValidity {
 			valid := credential.IsValid()
 			info.Content.Valid = &valid
+			if !valid {
+				info.Content.InvalidReason = credential.InvalidReason
+			}
 		}
 
 		// get models
@@ -1782,6 +1782,7 @@ func (s *cloudSuite) TestCredentialContentsAllNoSecrets(c *gc.C) {
 		}}, c)
 
 	credentialTwo.Invalid = true
+	credentialTwo.InvalidReason = "access-denied(listing instances): cloud denied access"
 	creds := []state.Credential{
 		credentialOne,
 		credentialTwo,
@@ -1813,10 +1814,11 @@ func (s *cloudSuite) TestCredentialContentsAllNoSecrets(c *gc.C) {
 			Attributes: map[string]string{},
 		},
 		"two": {
-			Name:     "two",
-			Cloud:    "meep",
-			AuthType: "userpass",
-			Valid:    &_false,
+			Name:          "two",
+			Cloud:         "meep",
+			AuthType:      "userpass",
+			Valid:         &_false,
+			InvalidReason: "access-denied(listing instances): cloud denied access",
 			Attributes: map[string]string{
 				"username": "admin",
 			},
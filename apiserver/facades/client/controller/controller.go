@@ -56,10 +56,16 @@ type ControllerAPI struct {
 	multiwatcherFactory multiwatcher.Factory
 }
 
+// ControllerAPIv11 provides the v11 controller API. The only difference
+// between this and v12 is that v11 doesn't have the DestroyModels method.
+type ControllerAPIv11 struct {
+	*ControllerAPI
+}
+
 // ControllerAPIv10 provides the v10 controller API. The only difference between
 // this and the v11 is that v10 doesn't support force destroy.
 type ControllerAPIv10 struct {
-	*ControllerAPI
+	*ControllerAPIv11
 }
 
 // ControllerAPIv9 provides the v9 controller API. The only difference between
@@ -106,7 +112,7 @@ type ControllerAPIv3 struct {
 
 // LatestAPI is used for testing purposes to create the latest
 // controller API.
-var LatestAPI = newControllerAPIv11
+var LatestAPI = newControllerAPIv12
 
 // TestingAPI is an escape hatch for requesting a controller API that won't
 // allow auth to correctly happen for ModelStatus. I'm not convicned this
@@ -684,6 +690,23 @@ func (c *ControllerAPI) ConfigSet(args params.ControllerConfigSet) error {
 // ConfigSet isn't on the v4 API.
 func (c *ControllerAPIv4) ConfigSet(_, _ struct{}) {}
 
+// RotateControllerCertificates requests an immediate reissue of the
+// controller TLS serving certificates (API server and mongo), for
+// example after a suspected key compromise. The rotation itself is
+// performed by the certificate rotation worker on each controller
+// machine, which picks the request up over the forwarded hub.
+func (c *ControllerAPI) RotateControllerCertificates(args params.CertRotateArgs) error {
+	if err := c.checkIsSuperUser(); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := c.hub.Publish(
+		controller.CertRotateRequest,
+		controller.CertRotateRequestMessage{Reason: args.Reason}); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // runMigrationPrechecks runs prechecks on the migration and updates
 // information in targetInfo as needed based on information
 // retrieved from the target controller.
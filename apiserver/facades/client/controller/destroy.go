@@ -4,7 +4,10 @@
 package controller
 
 import (
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -12,6 +15,7 @@ import (
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
 )
 
 // DestroyController destroys the controller.
@@ -73,6 +77,232 @@ func destroyController(
 	))
 }
 
+// DestroyModels isn't on the v11 API.
+func (c *ControllerAPIv11) DestroyModels(_, _ struct{}) {}
+
+// DestroyModels destroys the specified models.
+//
+// Models that consume offers from other models in the batch are
+// destroyed before the offering models, so that cross model relations
+// are unwound from the consuming side first. The per-model results are
+// returned in destruction order, along with a strings watcher that
+// reports the models in the batch whose life changes as the
+// destruction progresses.
+func (c *ControllerAPI) DestroyModels(args params.DestroyModelsParams) (params.DestroyModelsResults, error) {
+	var results params.DestroyModelsResults
+	if err := c.checkIsSuperUser(); err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := ensureNotBlocked(c.state); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	byUUID := make(map[string]params.DestroyModelParams)
+	var requested []string
+	for _, arg := range args.Models {
+		tag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			results.Results = append(results.Results, params.DestroyModelResult{
+				ModelTag: arg.ModelTag,
+				Error:    apiservererrors.ServerError(err),
+			})
+			continue
+		}
+		if _, ok := byUUID[tag.Id()]; ok {
+			continue
+		}
+		byUUID[tag.Id()] = arg
+		requested = append(requested, tag.Id())
+	}
+
+	order, err := c.destructionOrder(requested)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	for _, uuid := range order {
+		result := params.DestroyModelResult{ModelTag: names.NewModelTag(uuid).String()}
+		if err := c.destroyModel(uuid, byUUID[uuid]); err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		}
+		results.Results = append(results.Results, result)
+	}
+
+	w := newDestructionProgressWatcher(c.state.WatchModelLives(), set.NewStrings(requested...))
+	if changes, ok := <-w.Changes(); ok {
+		results.Progress.StringsWatcherId = c.resources.Register(w)
+		results.Progress.Changes = changes
+	} else {
+		return results, errors.Trace(watcher.EnsureErr(w))
+	}
+	return results, nil
+}
+
+func (c *ControllerAPI) destroyModel(uuid string, arg params.DestroyModelParams) error {
+	st, err := c.statePool.Get(uuid)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer st.Release()
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	backend := common.NewModelManagerBackend(model, c.statePool)
+	return errors.Trace(common.DestroyModel(backend, arg.DestroyStorage, arg.Force, arg.MaxWait, arg.Timeout))
+}
+
+// destructionOrder sorts the given model UUIDs so that models consuming
+// offers from other models in the batch appear before the models
+// offering them.
+func (c *ControllerAPI) destructionOrder(uuids []string) ([]string, error) {
+	batch := set.NewStrings(uuids...)
+	// consumers maps an offering model to the batch models consuming
+	// its offers; an offering model is only destroyed once they are
+	// all gone.
+	consumers := make(map[string]set.Strings)
+	for _, uuid := range uuids {
+		st, err := c.statePool.Get(uuid)
+		if err != nil {
+			// The model may already be gone; let the destruction
+			// attempt report the error in the natural place.
+			continue
+		}
+		remoteApps, err := st.AllRemoteApplications()
+		st.Release()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, app := range remoteApps {
+			source := app.SourceModel().Id()
+			if source == uuid || !batch.Contains(source) {
+				continue
+			}
+			if consumers[source] == nil {
+				consumers[source] = set.NewStrings()
+			}
+			consumers[source].Add(uuid)
+		}
+	}
+
+	return orderForDestruction(uuids, consumers), nil
+}
+
+// orderForDestruction sorts the given model UUIDs so that each model
+// appears after the models consuming its offers.
+func orderForDestruction(uuids []string, consumers map[string]set.Strings) []string {
+	remaining := set.NewStrings(uuids...)
+	var order []string
+	for remaining.Size() > 0 {
+		progress := false
+		for _, uuid := range uuids {
+			if !remaining.Contains(uuid) {
+				continue
+			}
+			if consumers[uuid].Intersection(remaining).Size() > 0 {
+				// Still has live consumers in the batch.
+				continue
+			}
+			order = append(order, uuid)
+			remaining.Remove(uuid)
+			progress = true
+		}
+		if !progress {
+			// A cycle of mutual consumption; fall back to the
+			// requested order for the remainder.
+			logger.Warningf("cross model dependency cycle between models %v", remaining.SortedValues())
+			for _, uuid := range uuids {
+				if remaining.Contains(uuid) {
+					order = append(order, uuid)
+				}
+			}
+			break
+		}
+	}
+	return order
+}
+
+// destructionProgressWatcher wraps a model lives watcher, reporting
+// only the models from a destroyed batch.
+type destructionProgressWatcher struct {
+	tomb  tomb.Tomb
+	inner state.StringsWatcher
+	uuids set.Strings
+	out   chan []string
+}
+
+func newDestructionProgressWatcher(inner state.StringsWatcher, uuids set.Strings) state.StringsWatcher {
+	w := &destructionProgressWatcher{
+		inner: inner,
+		uuids: uuids,
+		out:   make(chan []string),
+	}
+	w.tomb.Go(func() error {
+		defer close(w.out)
+		defer func() { _ = inner.Stop() }()
+		return w.loop()
+	})
+	return w
+}
+
+// Stop stops the watcher, and returns any error encountered while running
+// or shutting down.
+func (w *destructionProgressWatcher) Stop() error {
+	w.Kill()
+	return w.Wait()
+}
+
+// Kill kills the watcher without waiting for it to shut down.
+func (w *destructionProgressWatcher) Kill() {
+	w.tomb.Kill(nil)
+}
+
+// Wait waits for the watcher to die and returns any
+// error encountered when it was running.
+func (w *destructionProgressWatcher) Wait() error {
+	return w.tomb.Wait()
+}
+
+// Err returns any error encountered while running or shutting down, or
+// tomb.ErrStillAlive if the watcher is still running.
+func (w *destructionProgressWatcher) Err() error {
+	return w.tomb.Err()
+}
+
+// Changes returns the event channel for the destructionProgressWatcher.
+func (w *destructionProgressWatcher) Changes() <-chan []string {
+	return w.out
+}
+
+func (w *destructionProgressWatcher) loop() error {
+	var out chan []string
+	var pending []string
+	sentInitial := false
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case changes, ok := <-w.inner.Changes():
+			if !ok {
+				return watcher.EnsureErr(w.inner)
+			}
+			for _, uuid := range changes {
+				if w.uuids.Contains(uuid) {
+					pending = append(pending, uuid)
+				}
+			}
+			// The initial event is always sent, even when empty,
+			// to honour the watcher contract.
+			if len(pending) > 0 || !sentInitial {
+				out = w.out
+			}
+		case out <- pending:
+			sentInitial = true
+			pending = nil
+			out = nil
+		}
+	}
+}
+
 func ensureNotBlocked(st *state.State) error {
 	// If there are blocks let the user know.
 	blocks, err := st.AllBlocksForController()
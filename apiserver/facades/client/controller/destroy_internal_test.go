@@ -0,0 +1,95 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"time"
+
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&destroyOrderSuite{})
+
+type destroyOrderSuite struct{}
+
+func (s *destroyOrderSuite) TestOrderConsumersFirst(c *gc.C) {
+	consumers := map[string]set.Strings{
+		"offerer": set.NewStrings("consumer-a", "consumer-b"),
+	}
+	order := orderForDestruction([]string{"offerer", "consumer-a", "consumer-b"}, consumers)
+	c.Assert(order, jc.DeepEquals, []string{"consumer-a", "consumer-b", "offerer"})
+}
+
+func (s *destroyOrderSuite) TestOrderChain(c *gc.C) {
+	consumers := map[string]set.Strings{
+		"b": set.NewStrings("a"),
+		"c": set.NewStrings("b"),
+	}
+	order := orderForDestruction([]string{"c", "b", "a"}, consumers)
+	c.Assert(order, jc.DeepEquals, []string{"a", "b", "c"})
+}
+
+func (s *destroyOrderSuite) TestOrderCycleFallsBack(c *gc.C) {
+	consumers := map[string]set.Strings{
+		"a": set.NewStrings("b"),
+		"b": set.NewStrings("a"),
+	}
+	order := orderForDestruction([]string{"a", "b"}, consumers)
+	c.Assert(order, jc.DeepEquals, []string{"a", "b"})
+}
+
+func (s *destroyOrderSuite) TestProgressWatcherFilters(c *gc.C) {
+	inner := &fakeStringsWatcher{changes: make(chan []string, 1)}
+	inner.changes <- []string{"uuid-a", "uuid-x"}
+	w := newDestructionProgressWatcher(inner, set.NewStrings("uuid-a", "uuid-b"))
+	defer func() {
+		c.Assert(w.Stop(), jc.ErrorIsNil)
+	}()
+
+	assertWatcherChanges(c, w, []string{"uuid-a"})
+
+	// Changes for models outside the batch are suppressed.
+	inner.changes <- []string{"uuid-x"}
+	assertNoWatcherChanges(c, w)
+
+	inner.changes <- []string{"uuid-b"}
+	assertWatcherChanges(c, w, []string{"uuid-b"})
+}
+
+func assertWatcherChanges(c *gc.C, w state.StringsWatcher, expect []string) {
+	select {
+	case changes, ok := <-w.Changes():
+		c.Assert(ok, jc.IsTrue)
+		c.Assert(changes, jc.DeepEquals, expect)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for change")
+	}
+}
+
+func assertNoWatcherChanges(c *gc.C, w state.StringsWatcher) {
+	select {
+	case changes := <-w.Changes():
+		c.Fatalf("unexpected change %v", changes)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+type fakeStringsWatcher struct {
+	changes chan []string
+}
+
+func (w *fakeStringsWatcher) Changes() <-chan []string { return w.changes }
+
+func (w *fakeStringsWatcher) Kill() {}
+
+func (w *fakeStringsWatcher) Wait() error { return nil }
+
+func (w *fakeStringsWatcher) Stop() error { return nil }
+
+func (w *fakeStringsWatcher) Err() error { return nil }
@@ -4,6 +4,8 @@
 package controller
 
 import (
+	"github.com/juju/names/v4"
+
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/migration"
 	"github.com/juju/juju/state"
@@ -19,9 +21,14 @@ func SetPrecheckResult(p patcher, err error) {
 	})
 }
 
+func PatchIntrospectionSocketName(p patcher, f func(names.Tag) string) {
+	p.PatchValue(&introspectionSocketName, f)
+}
+
 var (
 	NewControllerAPIv3  = newControllerAPIv3
 	NewControllerAPIv4  = newControllerAPIv4
 	NewControllerAPIv5  = newControllerAPIv5
 	NewControllerAPIv11 = newControllerAPIv11
+	NewControllerAPIv12 = newControllerAPIv12
 )
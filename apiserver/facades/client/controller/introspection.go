@@ -0,0 +1,97 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/agent/addons"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// introspectionSocketName returns the introspection socket name for an
+// agent tag. It is a variable so tests can point queries at their own
+// sockets.
+var introspectionSocketName = addons.DefaultIntrospectionSocketName
+
+// AgentIntrospection fetches the requested introspection report
+// (dependency engine report, goroutine dump, metrics, ...) from each
+// of the given agents, proxying the agent's local introspection socket
+// over the API so engine deadlocks can be debugged without SSH access
+// to the machine. Only agents whose socket is on this API server's
+// host can be queried: the controller machine agent itself and any
+// agents co-located with it. The caller must be a controller
+// superuser, and like any other Controller facade call the request is
+// recorded in the audit log.
+func (c *ControllerAPI) AgentIntrospection(args params.AgentIntrospectionArgs) (params.AgentIntrospectionResults, error) {
+	results := params.AgentIntrospectionResults{
+		Results: make([]params.AgentIntrospectionResult, len(args.Entities)),
+	}
+	if err := c.checkIsSuperUser(); err != nil {
+		return results, errors.Trace(err)
+	}
+	path := strings.TrimPrefix(args.Path, "/")
+	if path == "" {
+		return results, errors.NotValidf("empty introspection path")
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		switch tag.Kind() {
+		case names.MachineTagKind, names.UnitTagKind, names.ControllerAgentTagKind, names.ApplicationTagKind:
+		default:
+			results.Results[i].Error = apiservererrors.ServerError(
+				errors.NotSupportedf("introspecting %s agents", tag.Kind()))
+			continue
+		}
+		report, err := fetchIntrospectionReport(tag, path)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Report = report
+	}
+	return results, nil
+}
+
+// fetchIntrospectionReport queries the agent's introspection socket,
+// in the same way the juju-introspect command does on the machine.
+func fetchIntrospectionReport(tag names.Tag, path string) (string, error) {
+	socketName := "@" + introspectionSocketName(tag)
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketName)
+			},
+		},
+	}
+	targetURL := &url.URL{Scheme: "http", Host: "unix.socket", Path: "/" + path}
+	resp, err := client.Get(targetURL.String())
+	if err != nil {
+		return "", errors.Annotatef(err, "introspecting %s", names.ReadableString(tag))
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("introspecting %s: %s: %s",
+			names.ReadableString(tag), resp.Status, strings.TrimSpace(string(body)))
+	}
+	return string(body), nil
+}
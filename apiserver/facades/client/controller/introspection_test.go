@@ -0,0 +1,75 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/controller"
+	"github.com/juju/juju/rpc/params"
+)
+
+// serveFakeIntrospectionSocket serves the given report on an abstract
+// unix socket, and patches the facade's socket lookup to use it for
+// every agent tag.
+func (s *controllerSuite) serveFakeIntrospectionSocket(c *gc.C, path, report string) {
+	socketName := fmt.Sprintf("juju-introspection-test-%d", os.Getpid())
+	controller.PatchIntrospectionSocketName(s, func(names.Tag) string {
+		return socketName
+	})
+	listener, err := net.Listen("unix", "@"+socketName)
+	c.Assert(err, jc.ErrorIsNil)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+path, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, report)
+	})
+	srv := &http.Server{Handler: mux}
+	go func() { _ = srv.Serve(listener) }()
+	s.AddCleanup(func(*gc.C) { _ = srv.Close() })
+}
+
+func (s *controllerSuite) TestAgentIntrospection(c *gc.C) {
+	s.serveFakeIntrospectionSocket(c, "depengine", "engine report")
+
+	results, err := s.controller.AgentIntrospection(params.AgentIntrospectionArgs{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "user-admin"},
+			{Tag: "not-a-tag"},
+		},
+		Path: "depengine",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[0].Report, gc.Equals, "engine report")
+	c.Check(results.Results[1].Error, gc.ErrorMatches, "introspecting user agents not supported")
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `"not-a-tag" is not a valid tag`)
+}
+
+func (s *controllerSuite) TestAgentIntrospectionUnknownPath(c *gc.C) {
+	s.serveFakeIntrospectionSocket(c, "depengine", "engine report")
+
+	results, err := s.controller.AgentIntrospection(params.AgentIntrospectionArgs{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+		Path:     "nonsense",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0].Error, gc.ErrorMatches, "introspecting machine 0: 404 Not Found: 404 page not found")
+}
+
+func (s *controllerSuite) TestAgentIntrospectionEmptyPath(c *gc.C) {
+	_, err := s.controller.AgentIntrospection(params.AgentIntrospectionArgs{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "empty introspection path not valid")
+}
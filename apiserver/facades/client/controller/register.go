@@ -40,11 +40,14 @@ func Register(registry facade.FacadeRegistry) {
 	}, reflect.TypeOf((*ControllerAPIv10)(nil)))
 	registry.MustRegister("Controller", 11, func(ctx facade.Context) (facade.Facade, error) {
 		return newControllerAPIv11(ctx)
+	}, reflect.TypeOf((*ControllerAPIv11)(nil)))
+	registry.MustRegister("Controller", 12, func(ctx facade.Context) (facade.Facade, error) {
+		return newControllerAPIv12(ctx)
 	}, reflect.TypeOf((*ControllerAPI)(nil)))
 }
 
-// newControllerAPIv11 creates a new ControllerAPIv11
-func newControllerAPIv11(ctx facade.Context) (*ControllerAPI, error) {
+// newControllerAPIv12 creates a new ControllerAPIv12
+func newControllerAPIv12(ctx facade.Context) (*ControllerAPI, error) {
 	st := ctx.State()
 	authorizer := ctx.Auth()
 	pool := ctx.StatePool()
@@ -66,6 +69,15 @@ func newControllerAPIv11(ctx facade.Context) (*ControllerAPI, error) {
 	)
 }
 
+// newControllerAPIv11 creates a new ControllerAPIv11.
+func newControllerAPIv11(ctx facade.Context) (*ControllerAPIv11, error) {
+	v12, err := newControllerAPIv12(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ControllerAPIv11{v12}, nil
+}
+
 // newControllerAPIv10 creates a new ControllerAPIv10.
 func newControllerAPIv10(ctx facade.Context) (*ControllerAPIv10, error) {
 	v11, err := newControllerAPIv11(ctx)
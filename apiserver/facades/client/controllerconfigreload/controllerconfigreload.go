@@ -0,0 +1,56 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigreload
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API provides the ControllerConfigReload facade, which reports which
+// controller config keys running workers can apply without an API
+// server restart.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new ControllerConfigReload API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkAdmin() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.backend.ControllerTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// HotReloadableConfigKeys returns the controller config keys whose
+// changes take effect on the running controller without restarting
+// jujud. Changes to any other updatable key require a restart.
+func (api *API) HotReloadableConfigKeys() (params.HotReloadableConfigKeysResult, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.HotReloadableConfigKeysResult{}, errors.Trace(err)
+	}
+	return params.HotReloadableConfigKeysResult{
+		Keys: controller.HotReloadableConfigAttributes.SortedValues(),
+	}, nil
+}
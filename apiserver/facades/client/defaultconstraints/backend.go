@@ -0,0 +1,31 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package defaultconstraints
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// DefaultConstraints facade.
+type Backend interface {
+	ControllerTag() names.ControllerTag
+	Cloud(name string) (cloud.Cloud, error)
+	DefaultApplicationConstraints(cloudName, regionName string) (constraints.Value, error)
+	SetDefaultApplicationConstraints(cloudName, regionName string, cons constraints.Value) error
+	RemoveDefaultApplicationConstraints(cloudName, regionName string) error
+}
+
+type stateShim struct {
+	*state.State
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
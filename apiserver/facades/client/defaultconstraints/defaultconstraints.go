@@ -0,0 +1,141 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package defaultconstraints
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API provides the DefaultConstraints facade, which manages the
+// default application constraints recorded per cloud and region.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new DefaultConstraints API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkAdmin() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.backend.ControllerTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// validCloudRegion checks that the given cloud tag identifies a known
+// cloud, and that region, if not empty, is one of its regions. The
+// cloud name is returned.
+func (api *API) validCloudRegion(cloudTag, region string) (string, error) {
+	tag, err := names.ParseCloudTag(cloudTag)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	aCloud, err := api.backend.Cloud(tag.Id())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if region != "" {
+		var found bool
+		for _, r := range aCloud.Regions {
+			if r.Name == region {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", errors.NotFoundf("region %q in cloud %q", region, aCloud.Name)
+		}
+	}
+	return aCloud.Name, nil
+}
+
+// DefaultConstraints returns the default application constraints
+// recorded for each given cloud or cloud region. Clouds or regions
+// without a recorded default return empty constraints.
+func (api *API) DefaultConstraints(args params.DefaultConstraintsArgs) (params.DefaultConstraintsResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.DefaultConstraintsResults{}, errors.Trace(err)
+	}
+	results := params.DefaultConstraintsResults{
+		Results: make([]params.DefaultConstraintsResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		cloudName, err := api.validCloudRegion(arg.CloudTag, arg.Region)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		cons, err := api.backend.DefaultApplicationConstraints(cloudName, arg.Region)
+		if errors.IsNotFound(err) {
+			cons = constraints.Value{}
+		} else if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Constraints = cons
+	}
+	return results, nil
+}
+
+// SetDefaultConstraints records the given default application
+// constraints for each given cloud or cloud region.
+func (api *API) SetDefaultConstraints(args params.SetDefaultConstraintsArgs) (params.ErrorResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		cloudName, err := api.validCloudRegion(arg.CloudTag, arg.Region)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.backend.SetDefaultApplicationConstraints(cloudName, arg.Region, arg.Constraints)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// UnsetDefaultConstraints removes the default application constraints
+// recorded for each given cloud or cloud region.
+func (api *API) UnsetDefaultConstraints(args params.DefaultConstraintsArgs) (params.ErrorResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		cloudName, err := api.validCloudRegion(arg.CloudTag, arg.Region)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.backend.RemoveDefaultApplicationConstraints(cloudName, arg.Region)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package defaultconstraints_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/defaultconstraints"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	defaults map[string]constraints.Value
+}
+
+func (m *mockBackend) key(cloudName, regionName string) string {
+	return cloudName + "#" + regionName
+}
+
+func (m *mockBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
+
+func (m *mockBackend) Cloud(name string) (cloud.Cloud, error) {
+	m.MethodCall(m, "Cloud", name)
+	if name != "dummy" {
+		return cloud.Cloud{}, errors.NotFoundf("cloud %q", name)
+	}
+	return cloud.Cloud{
+		Name:    "dummy",
+		Regions: []cloud.Region{{Name: "dummy-region"}},
+	}, m.NextErr()
+}
+
+func (m *mockBackend) DefaultApplicationConstraints(cloudName, regionName string) (constraints.Value, error) {
+	m.MethodCall(m, "DefaultApplicationConstraints", cloudName, regionName)
+	cons, ok := m.defaults[m.key(cloudName, regionName)]
+	if !ok {
+		return constraints.Value{}, errors.NotFoundf("default application constraints")
+	}
+	return cons, m.NextErr()
+}
+
+func (m *mockBackend) SetDefaultApplicationConstraints(cloudName, regionName string, cons constraints.Value) error {
+	m.MethodCall(m, "SetDefaultApplicationConstraints", cloudName, regionName, cons)
+	m.defaults[m.key(cloudName, regionName)] = cons
+	return m.NextErr()
+}
+
+func (m *mockBackend) RemoveDefaultApplicationConstraints(cloudName, regionName string) error {
+	m.MethodCall(m, "RemoveDefaultApplicationConstraints", cloudName, regionName)
+	delete(m.defaults, m.key(cloudName, regionName))
+	return m.NextErr()
+}
+
+type DefaultConstraintsSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *defaultconstraints.API
+}
+
+var _ = gc.Suite(&DefaultConstraintsSuite{})
+
+func (s *DefaultConstraintsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("superuser-admin"),
+	}
+	s.backend = mockBackend{
+		defaults: make(map[string]constraints.Value),
+	}
+	api, err := defaultconstraints.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *DefaultConstraintsSuite) TestSetDefaultConstraints(c *gc.C) {
+	cons := constraints.MustParse("arch=arm64")
+	results, err := s.api.SetDefaultConstraints(params.SetDefaultConstraintsArgs{
+		Args: []params.SetDefaultConstraintsArg{{
+			CloudTag:    "cloud-dummy",
+			Region:      "dummy-region",
+			Constraints: cons,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.backend.CheckCall(c, 1, "SetDefaultApplicationConstraints", "dummy", "dummy-region", cons)
+}
+
+func (s *DefaultConstraintsSuite) TestSetDefaultConstraintsUnknownRegion(c *gc.C) {
+	results, err := s.api.SetDefaultConstraints(params.SetDefaultConstraintsArgs{
+		Args: []params.SetDefaultConstraintsArg{{
+			CloudTag:    "cloud-dummy",
+			Region:      "no-such-region",
+			Constraints: constraints.MustParse("arch=arm64"),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `region "no-such-region" in cloud "dummy" not found`)
+}
+
+func (s *DefaultConstraintsSuite) TestDefaultConstraints(c *gc.C) {
+	cons := constraints.MustParse("mem=4G")
+	s.backend.defaults[s.backend.key("dummy", "")] = cons
+	results, err := s.api.DefaultConstraints(params.DefaultConstraintsArgs{
+		Args: []params.DefaultConstraintsArg{
+			{CloudTag: "cloud-dummy"},
+			{CloudTag: "cloud-dummy", Region: "dummy-region"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Constraints, gc.DeepEquals, cons)
+	// No default recorded for the region: empty constraints, no error.
+	c.Assert(results.Results[1].Error, gc.IsNil)
+	c.Assert(results.Results[1].Constraints, gc.DeepEquals, constraints.Value{})
+}
+
+func (s *DefaultConstraintsSuite) TestUnsetDefaultConstraints(c *gc.C) {
+	s.backend.defaults[s.backend.key("dummy", "")] = constraints.MustParse("mem=4G")
+	results, err := s.api.UnsetDefaultConstraints(params.DefaultConstraintsArgs{
+		Args: []params.DefaultConstraintsArg{{CloudTag: "cloud-dummy"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(s.backend.defaults, gc.HasLen, 0)
+}
+
+func (s *DefaultConstraintsSuite) TestNotAdmin(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("read-only")
+	api, err := defaultconstraints.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.SetDefaultConstraints(params.SetDefaultConstraintsArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.DefaultConstraints(params.DefaultConstraintsArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
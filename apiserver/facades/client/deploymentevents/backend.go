@@ -0,0 +1,28 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deploymentevents
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// DeploymentEvents facade.
+type Backend interface {
+	ControllerTag() names.ControllerTag
+	AddWebhookEndpoint(state.WebhookEndpoint) error
+	RemoveWebhookEndpoint(name string) error
+	AllWebhookEndpoints() ([]state.WebhookEndpoint, error)
+}
+
+type stateShim struct {
+	*state.State
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
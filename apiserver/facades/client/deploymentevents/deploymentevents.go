@@ -0,0 +1,132 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deploymentevents
+
+import (
+	"net/url"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/webhook"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// API provides the DeploymentEvents facade, which lets controller
+// admins register webhook endpoints that receive structured
+// deployment events without polling the AllWatcher.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new DeploymentEvents API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanAdmin() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.backend.ControllerTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// RegisterWebhooks registers webhook endpoints to receive deployment
+// events. It supports bulk calls.
+func (api *API) RegisterWebhooks(args params.WebhookEndpointArgs) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Endpoints)),
+	}
+	for i, endpoint := range args.Endpoints {
+		err := validateEndpoint(endpoint)
+		if err == nil {
+			err = api.backend.AddWebhookEndpoint(state.WebhookEndpoint{
+				Name:         endpoint.Name,
+				URL:          endpoint.URL,
+				Secret:       endpoint.Secret,
+				EventFilters: endpoint.EventFilters,
+			})
+		}
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// UnregisterWebhooks removes the named webhook endpoints. It supports
+// bulk calls.
+func (api *API) UnregisterWebhooks(args params.WebhookEndpointNames) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := api.backend.RemoveWebhookEndpoint(name)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// ListWebhooks returns the webhook endpoints registered on the
+// controller, ordered by name. Endpoint secrets are not returned.
+func (api *API) ListWebhooks() (params.ListWebhookEndpointsResult, error) {
+	if err := api.checkCanAdmin(); err != nil {
+		return params.ListWebhookEndpointsResult{}, errors.Trace(err)
+	}
+	endpoints, err := api.backend.AllWebhookEndpoints()
+	if err != nil {
+		return params.ListWebhookEndpointsResult{}, errors.Trace(err)
+	}
+	result := params.ListWebhookEndpointsResult{
+		Result: make([]params.WebhookEndpoint, len(endpoints)),
+	}
+	for i, endpoint := range endpoints {
+		result.Result[i] = params.WebhookEndpoint{
+			Name:         endpoint.Name,
+			URL:          endpoint.URL,
+			EventFilters: endpoint.EventFilters,
+		}
+	}
+	return result, nil
+}
+
+// validateEndpoint checks that a webhook endpoint registration names
+// the endpoint, targets an http or https URL and filters on known
+// event types.
+func validateEndpoint(endpoint params.WebhookEndpoint) error {
+	if endpoint.Name == "" {
+		return errors.NotValidf("webhook endpoint with empty name")
+	}
+	parsed, err := url.Parse(endpoint.URL)
+	if err != nil {
+		return errors.NotValidf("webhook endpoint URL %q", endpoint.URL)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.NotValidf("webhook endpoint URL %q without http or https scheme", endpoint.URL)
+	}
+	for _, filter := range endpoint.EventFilters {
+		if !webhook.ValidEvent(filter) {
+			return errors.NotValidf("event filter %q", filter)
+		}
+	}
+	return nil
+}
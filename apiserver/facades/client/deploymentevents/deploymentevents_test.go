@@ -0,0 +1,164 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deploymentevents_test
+
+import (
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/deploymentevents"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/webhook"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	endpoints []state.WebhookEndpoint
+}
+
+func (m *mockBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
+
+func (m *mockBackend) AddWebhookEndpoint(endpoint state.WebhookEndpoint) error {
+	m.MethodCall(m, "AddWebhookEndpoint", endpoint)
+	return m.NextErr()
+}
+
+func (m *mockBackend) RemoveWebhookEndpoint(name string) error {
+	m.MethodCall(m, "RemoveWebhookEndpoint", name)
+	return m.NextErr()
+}
+
+func (m *mockBackend) AllWebhookEndpoints() ([]state.WebhookEndpoint, error) {
+	m.MethodCall(m, "AllWebhookEndpoints")
+	return m.endpoints, m.NextErr()
+}
+
+type DeploymentEventsSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *deploymentevents.API
+}
+
+var _ = gc.Suite(&DeploymentEventsSuite{})
+
+func (s *DeploymentEventsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.backend = mockBackend{}
+	api, err := deploymentevents.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *DeploymentEventsSuite) TestRegisterWebhooks(c *gc.C) {
+	results, err := s.api.RegisterWebhooks(params.WebhookEndpointArgs{
+		Endpoints: []params.WebhookEndpoint{{
+			Name:         "ci",
+			URL:          "https://ci.example.com/juju",
+			Secret:       "sekrit",
+			EventFilters: []string{webhook.EventApplicationDeployed},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.backend.CheckCalls(c, []testing.StubCall{{
+		FuncName: "AddWebhookEndpoint",
+		Args: []interface{}{state.WebhookEndpoint{
+			Name:         "ci",
+			URL:          "https://ci.example.com/juju",
+			Secret:       "sekrit",
+			EventFilters: []string{webhook.EventApplicationDeployed},
+		}},
+	}})
+}
+
+func (s *DeploymentEventsSuite) TestRegisterWebhooksEmptyName(c *gc.C) {
+	results, err := s.api.RegisterWebhooks(params.WebhookEndpointArgs{
+		Endpoints: []params.WebhookEndpoint{{
+			URL: "https://ci.example.com/juju",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "webhook endpoint with empty name not valid")
+	s.backend.CheckCallNames(c)
+}
+
+func (s *DeploymentEventsSuite) TestRegisterWebhooksBadURL(c *gc.C) {
+	results, err := s.api.RegisterWebhooks(params.WebhookEndpointArgs{
+		Endpoints: []params.WebhookEndpoint{{
+			Name: "ci",
+			URL:  "ftp://ci.example.com/juju",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches,
+		`webhook endpoint URL "ftp://ci.example.com/juju" without http or https scheme not valid`)
+}
+
+func (s *DeploymentEventsSuite) TestRegisterWebhooksBadFilter(c *gc.C) {
+	results, err := s.api.RegisterWebhooks(params.WebhookEndpointArgs{
+		Endpoints: []params.WebhookEndpoint{{
+			Name:         "ci",
+			URL:          "https://ci.example.com/juju",
+			EventFilters: []string{"model-destroyed"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `event filter "model-destroyed" not valid`)
+}
+
+func (s *DeploymentEventsSuite) TestUnregisterWebhooks(c *gc.C) {
+	results, err := s.api.UnregisterWebhooks(params.WebhookEndpointNames{
+		Names: []string{"ci"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.backend.CheckCalls(c, []testing.StubCall{{
+		FuncName: "RemoveWebhookEndpoint",
+		Args:     []interface{}{"ci"},
+	}})
+}
+
+func (s *DeploymentEventsSuite) TestListWebhooksOmitsSecrets(c *gc.C) {
+	s.backend.endpoints = []state.WebhookEndpoint{{
+		Name:         "ci",
+		URL:          "https://ci.example.com/juju",
+		Secret:       "sekrit",
+		EventFilters: []string{webhook.EventApplicationDeployed},
+	}}
+	result, err := s.api.ListWebhooks()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Result, jc.DeepEquals, []params.WebhookEndpoint{{
+		Name:         "ci",
+		URL:          "https://ci.example.com/juju",
+		EventFilters: []string{webhook.EventApplicationDeployed},
+	}})
+}
+
+func (s *DeploymentEventsSuite) TestPermission(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("unauthorized"),
+	}
+	api, err := deploymentevents.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.ListWebhooks()
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.RegisterWebhooks(params.WebhookEndpointArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.UnregisterWebhooks(params.WebhookEndpointNames{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -21,8 +21,12 @@ import (
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs"
+	environscontext "github.com/juju/juju/environs/context"
+	providercommon "github.com/juju/juju/provider/common"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/stateenvirons"
 )
 
 var logger = loggo.GetLogger("juju.apiserver.highavailability")
@@ -116,12 +120,28 @@ func (api *HighAvailabilityAPI) enableHASingle(st *state.State, spec params.Cont
 	}
 	spec.Constraints.Spaces = cfg.AsSpaceConstraints(spec.Constraints.Spaces)
 
+	for i := range spec.MachineConstraints {
+		spec.MachineConstraints[i].Spaces = cfg.AsSpaceConstraints(spec.MachineConstraints[i].Spaces)
+	}
+
+	// Convert availability zone targets into zone placement directives,
+	// checking them against the provider first so a typo fails here
+	// rather than leaving a stuck provisioning request.
+	if len(spec.AvailabilityZones) > 0 {
+		if err := validateAvailabilityZones(st, spec.AvailabilityZones); err != nil {
+			return params.ControllersChanges{}, errors.Trace(err)
+		}
+		for _, zone := range spec.AvailabilityZones {
+			spec.Placement = append(spec.Placement, "zone="+zone)
+		}
+	}
+
 	if err = validatePlacementForSpaces(st, spec.Constraints.Spaces, spec.Placement); err != nil {
 		return params.ControllersChanges{}, errors.Trace(err)
 	}
 
 	// Might be nicer to pass the spec itself to this method.
-	changes, err := st.EnableHA(spec.NumControllers, spec.Constraints, spec.Series, spec.Placement)
+	changes, err := st.EnableHA(spec.NumControllers, spec.Constraints, spec.Series, spec.Placement, spec.MachineConstraints...)
 	if err != nil {
 		return params.ControllersChanges{}, err
 	}
@@ -191,6 +211,41 @@ func validateCurrentControllers(st *state.State, cfg controller.Config, machineI
 	return nil
 }
 
+// validateAvailabilityZones checks that each of the given availability
+// zones is known to the provider and currently available.
+func validateAvailabilityZones(st *state.State, zones []string) error {
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	env, err := environs.GetEnviron(stateenvirons.EnvironConfigGetter{Model: model}, environs.New)
+	if err != nil {
+		return errors.Annotate(err, "getting environ")
+	}
+	zoned, ok := env.(providercommon.ZonedEnviron)
+	if !ok {
+		return errors.NotSupportedf("availability zone placement by the provider")
+	}
+	known, err := zoned.AvailabilityZones(environscontext.CallContext(st))
+	if err != nil {
+		return errors.Annotate(err, "getting provider availability zones")
+	}
+	byName := make(map[string]network.AvailabilityZone, len(known))
+	for _, zone := range known {
+		byName[zone.Name()] = zone
+	}
+	for _, name := range zones {
+		zone, ok := byName[name]
+		if !ok {
+			return errors.NotFoundf("availability zone %q", name)
+		}
+		if !zone.Available() {
+			return errors.Errorf("availability zone %q is unavailable", name)
+		}
+	}
+	return nil
+}
+
 // validatePlacementForSpaces checks whether there are both space constraints
 // and machine placement directives.
 // If there are, checks are made to ensure that the machines specified have at
@@ -358,6 +358,81 @@ func (s *clientSuite) TestEnableHAPlacement(c *gc.C) {
 	}
 }
 
+func (s *clientSuite) enableHASpec(c *gc.C, spec params.ControllersSpec) (params.ControllersChanges, error) {
+	results, err := s.haServer.EnableHA(params.ControllersSpecs{
+		Specs: []params.ControllersSpec{spec},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	result := results.Results[0]
+	// We explicitly return nil here so we can do typed nil checking
+	// of the result like normal.
+	err = nil
+	if result.Error != nil {
+		err = result.Error
+	}
+	return result.Result, err
+}
+
+func (s *clientSuite) TestEnableHAAvailabilityZones(c *gc.C) {
+	enableHAResult, err := s.enableHASpec(c, params.ControllersSpec{
+		NumControllers:    3,
+		AvailabilityZones: []string{"zone1", "zone3"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(enableHAResult.Added, gc.DeepEquals, []string{"machine-1", "machine-2"})
+
+	machines, err := s.State.AllMachines()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, gc.HasLen, 3)
+	expectedPlacement := []string{"", "zone=zone1", "zone=zone3"}
+	for i, m := range machines {
+		c.Check(m.Placement(), gc.Equals, expectedPlacement[i])
+	}
+}
+
+func (s *clientSuite) TestEnableHAUnknownAvailabilityZone(c *gc.C) {
+	_, err := s.enableHASpec(c, params.ControllersSpec{
+		NumControllers:    3,
+		AvailabilityZones: []string{"zone9"},
+	})
+	c.Assert(err, gc.ErrorMatches, `availability zone "zone9" not found`)
+}
+
+func (s *clientSuite) TestEnableHAUnavailableAvailabilityZone(c *gc.C) {
+	_, err := s.enableHASpec(c, params.ControllersSpec{
+		NumControllers:    3,
+		AvailabilityZones: []string{"zone2"},
+	})
+	c.Assert(err, gc.ErrorMatches, `availability zone "zone2" is unavailable`)
+}
+
+func (s *clientSuite) TestEnableHAMachineConstraints(c *gc.C) {
+	enableHAResult, err := s.enableHASpec(c, params.ControllersSpec{
+		NumControllers: 3,
+		MachineConstraints: []constraints.Value{
+			constraints.MustParse("mem=8G"),
+			constraints.MustParse("cores=4"),
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(enableHAResult.Added, gc.DeepEquals, []string{"machine-1", "machine-2"})
+
+	machines, err := s.State.AllMachines()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, gc.HasLen, 3)
+	expectedCons := []constraints.Value{
+		controllerCons,
+		constraints.MustParse("mem=8G"),
+		constraints.MustParse("cores=4"),
+	}
+	for i, m := range machines {
+		cons, err := m.Constraints()
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(cons, gc.DeepEquals, expectedCons[i])
+	}
+}
+
 func (s *clientSuite) TestEnableHAPlacementTo(c *gc.C) {
 	machine1Cons := constraints.MustParse("mem=8G")
 	_, err := s.State.AddMachines(state.MachineTemplate{
@@ -25,6 +25,23 @@ type API struct {
 	newEnviron func() (environs.Environ, error)
 }
 
+// APIv1 hides the signing key management methods added in v2.
+type APIv1 struct {
+	*API
+}
+
+// AddSigningKeys is not available via the V1 API.
+func (api *APIv1) AddSigningKeys(_ struct{}) {}
+
+// RotateSigningKeys is not available via the V1 API.
+func (api *APIv1) RotateSigningKeys(_ struct{}) {}
+
+// RevokeSigningKeys is not available via the V1 API.
+func (api *APIv1) RevokeSigningKeys(_ struct{}) {}
+
+// ListSigningKeys is not available via the V1 API.
+func (api *APIv1) ListSigningKeys(_ struct{}) {}
+
 // createAPI returns a new image metadata API facade.
 func createAPI(
 	st metadataAccess,
@@ -92,25 +109,43 @@ func (api *API) List(filter params.ImageMetadataFilter) (params.ListCloudImageMe
 }
 
 // Save stores given cloud image metadata.
+// Metadata from a source and stream with a registered signing key
+// must carry a valid signature over the metadata being saved.
 // It supports bulk calls.
 func (api *API) Save(metadata params.MetadataSaveParams) (params.ErrorResults, error) {
 	model, err := api.metadata.Model()
 	if err != nil {
 		return params.ErrorResults{}, errors.Trace(err)
 	}
-	for _, mList := range metadata.Metadata {
-		for i, m := range mList.Metadata {
+	results := make([]params.ErrorResult, len(metadata.Metadata))
+	var (
+		toSave  params.MetadataSaveParams
+		indices []int
+	)
+	for i, mList := range metadata.Metadata {
+		// Signatures are verified before any region defaulting so
+		// that they cover the metadata exactly as submitted.
+		if err := api.verifySignedMetadata(mList); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		for j, m := range mList.Metadata {
 			if m.Region == "" {
 				m.Region = model.CloudRegion()
-				mList.Metadata[i] = m
+				mList.Metadata[j] = m
 			}
 		}
+		toSave.Metadata = append(toSave.Metadata, mList)
+		indices = append(indices, i)
 	}
-	all, err := imagecommon.Save(api.metadata, metadata)
+	saved, err := imagecommon.Save(api.metadata, toSave)
 	if err != nil {
 		return params.ErrorResults{}, errors.Trace(err)
 	}
-	return params.ErrorResults{Results: all}, nil
+	for j, result := range saved {
+		results[indices[j]] = result
+	}
+	return params.ErrorResults{Results: results}, nil
 }
 
 // Delete deletes cloud image metadata for given image ids.
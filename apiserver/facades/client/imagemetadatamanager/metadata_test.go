@@ -144,7 +144,9 @@ func (s *metadataSuite) TestSave(c *gc.C) {
 	c.Assert(errs.Results[0].Error, gc.IsNil)
 	c.Assert(errs.Results[1].Error, gc.IsNil)
 	c.Assert(errs.Results[2].Error, gc.ErrorMatches, msg)
-	s.assertCalls(c, controllerTag, model, modelConfig, saveMetadata, saveMetadata, saveMetadata)
+	s.assertCalls(c, controllerTag, model,
+		imageSigningKey, imageSigningKey, imageSigningKey,
+		modelConfig, saveMetadata, saveMetadata, saveMetadata)
 }
 
 func (s *metadataSuite) TestDeleteEmpty(c *gc.C) {
@@ -6,6 +6,7 @@ package imagemetadatamanager_test
 import (
 	stdtesting "testing"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	gitjujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -19,6 +20,7 @@ import (
 	imagetesting "github.com/juju/juju/environs/imagemetadata/testing"
 	"github.com/juju/juju/environs/simplestreams"
 	"github.com/juju/juju/provider/dummy"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/cloudimagemetadata"
 	coretesting "github.com/juju/juju/testing"
 )
@@ -61,12 +63,17 @@ func (s *baseImageMetadataSuite) assertCalls(c *gc.C, expectedCalls ...string) {
 }
 
 const (
-	findMetadata   = "findMetadata"
-	saveMetadata   = "saveMetadata"
-	deleteMetadata = "deleteMetadata"
-	modelConfig    = "modelConfig"
-	controllerTag  = "controllerTag"
-	model          = "model"
+	findMetadata     = "findMetadata"
+	saveMetadata     = "saveMetadata"
+	deleteMetadata   = "deleteMetadata"
+	modelConfig      = "modelConfig"
+	controllerTag    = "controllerTag"
+	model            = "model"
+	addSigningKey    = "addSigningKey"
+	updateSigningKey = "updateSigningKey"
+	removeSigningKey = "removeSigningKey"
+	imageSigningKey  = "imageSigningKey"
+	allSigningKeys   = "allSigningKeys"
 )
 
 func (s *baseImageMetadataSuite) constructState(cfg *config.Config) *mockState {
@@ -87,17 +94,37 @@ func (s *baseImageMetadataSuite) constructState(cfg *config.Config) *mockState {
 		controllerTag: func() names.ControllerTag {
 			return names.NewControllerTag("deadbeef-2f18-4fd2-967d-db9663db7bea")
 		},
+		addImageSigningKey: func(key state.ImageSigningKey) error {
+			return nil
+		},
+		updateImageSigningKey: func(key state.ImageSigningKey) error {
+			return nil
+		},
+		removeImageSigningKey: func(source, stream string) error {
+			return nil
+		},
+		imageSigningKey: func(source, stream string) (state.ImageSigningKey, error) {
+			return state.ImageSigningKey{}, errors.NotFoundf("signing key for source %q stream %q", source, stream)
+		},
+		allImageSigningKeys: func() ([]state.ImageSigningKey, error) {
+			return nil, nil
+		},
 	}
 }
 
 type mockState struct {
 	*gitjujutesting.Stub
 
-	findMetadata   func(f cloudimagemetadata.MetadataFilter) (map[string][]cloudimagemetadata.Metadata, error)
-	saveMetadata   func(m []cloudimagemetadata.Metadata) error
-	deleteMetadata func(imageId string) error
-	modelConfig    func() (*config.Config, error)
-	controllerTag  func() names.ControllerTag
+	findMetadata          func(f cloudimagemetadata.MetadataFilter) (map[string][]cloudimagemetadata.Metadata, error)
+	saveMetadata          func(m []cloudimagemetadata.Metadata) error
+	deleteMetadata        func(imageId string) error
+	modelConfig           func() (*config.Config, error)
+	controllerTag         func() names.ControllerTag
+	addImageSigningKey    func(key state.ImageSigningKey) error
+	updateImageSigningKey func(key state.ImageSigningKey) error
+	removeImageSigningKey func(source, stream string) error
+	imageSigningKey       func(source, stream string) (state.ImageSigningKey, error)
+	allImageSigningKeys   func() ([]state.ImageSigningKey, error)
 }
 
 func (st *mockState) FindMetadata(f cloudimagemetadata.MetadataFilter) (map[string][]cloudimagemetadata.Metadata, error) {
@@ -125,6 +152,31 @@ func (st *mockState) ControllerTag() names.ControllerTag {
 	return st.controllerTag()
 }
 
+func (st *mockState) AddImageSigningKey(key state.ImageSigningKey) error {
+	st.Stub.MethodCall(st, addSigningKey, key)
+	return st.addImageSigningKey(key)
+}
+
+func (st *mockState) UpdateImageSigningKey(key state.ImageSigningKey) error {
+	st.Stub.MethodCall(st, updateSigningKey, key)
+	return st.updateImageSigningKey(key)
+}
+
+func (st *mockState) RemoveImageSigningKey(source, stream string) error {
+	st.Stub.MethodCall(st, removeSigningKey, source, stream)
+	return st.removeImageSigningKey(source, stream)
+}
+
+func (st *mockState) ImageSigningKey(source, stream string) (state.ImageSigningKey, error) {
+	st.Stub.MethodCall(st, imageSigningKey, source, stream)
+	return st.imageSigningKey(source, stream)
+}
+
+func (st *mockState) AllImageSigningKeys() ([]state.ImageSigningKey, error) {
+	st.Stub.MethodCall(st, allSigningKeys)
+	return st.allImageSigningKeys()
+}
+
 func (st *mockState) Model() (imagemetadatamanager.Model, error) {
 	st.Stub.MethodCall(st, model)
 	return &mockModel{}, nil
@@ -15,10 +15,22 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("ImageMetadataManager", 1, func(ctx facade.Context) (facade.Facade, error) {
-		return newAPI(ctx)
+		return newAPIV1(ctx)
+	}, reflect.TypeOf((*APIv1)(nil)))
+	registry.MustRegister("ImageMetadataManager", 2, func(ctx facade.Context) (facade.Facade, error) {
+		return newAPI(ctx) // Adds signing key management.
 	}, reflect.TypeOf((*API)(nil)))
 }
 
+// newAPIV1 returns a v1 cloud image metadata API facade.
+func newAPIV1(ctx facade.Context) (*APIv1, error) {
+	api, err := newAPI(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv1{api}, nil
+}
+
 // newAPI returns a new cloud image metadata API facade.
 func newAPI(ctx facade.Context) (*API, error) {
 	st := ctx.State()
@@ -0,0 +1,124 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package imagemetadatamanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/environs/simplestreams"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// AddSigningKeys registers public signing keys for the given metadata
+// sources and streams. Metadata subsequently saved for a source and
+// stream with a registered key must carry a valid signature.
+// It supports bulk calls.
+func (api *API) AddSigningKeys(args params.ImageSigningKeyArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Keys)),
+	}
+	for i, key := range args.Keys {
+		err := api.metadata.AddImageSigningKey(state.ImageSigningKey{
+			Source:    key.Source,
+			Stream:    key.Stream,
+			PublicKey: key.PublicKey,
+		})
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RotateSigningKeys replaces the public signing keys registered for
+// the given metadata sources and streams. It supports bulk calls.
+func (api *API) RotateSigningKeys(args params.ImageSigningKeyArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Keys)),
+	}
+	for i, key := range args.Keys {
+		err := api.metadata.UpdateImageSigningKey(state.ImageSigningKey{
+			Source:    key.Source,
+			Stream:    key.Stream,
+			PublicKey: key.PublicKey,
+		})
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RevokeSigningKeys removes the public signing keys registered for
+// the given metadata sources and streams. It supports bulk calls.
+func (api *API) RevokeSigningKeys(args params.ImageSigningKeyArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Keys)),
+	}
+	for i, key := range args.Keys {
+		err := api.metadata.RemoveImageSigningKey(key.Source, key.Stream)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// ListSigningKeys returns the public signing keys registered on the
+// controller, ordered by source and stream.
+func (api *API) ListSigningKeys() (params.ListImageSigningKeysResult, error) {
+	keys, err := api.metadata.AllImageSigningKeys()
+	if err != nil {
+		return params.ListImageSigningKeysResult{}, errors.Trace(err)
+	}
+	result := params.ListImageSigningKeysResult{
+		Result: make([]params.ImageSigningKey, len(keys)),
+	}
+	for i, key := range keys {
+		result.Result[i] = params.ImageSigningKey{
+			Source:    key.Source,
+			Stream:    key.Stream,
+			PublicKey: key.PublicKey,
+		}
+	}
+	return result, nil
+}
+
+// verifySignedMetadata checks that metadata from a source and stream
+// with a registered signing key carries a signature over the metadata
+// that verifies against that key.
+func (api *API) verifySignedMetadata(mList params.CloudImageMetadataList) error {
+	type sourceStream struct {
+		source, stream string
+	}
+	seen := make(map[sourceStream]bool)
+	for _, m := range mList.Metadata {
+		pair := sourceStream{m.Source, m.Stream}
+		if seen[pair] {
+			continue
+		}
+		seen[pair] = true
+		key, err := api.metadata.ImageSigningKey(pair.source, pair.stream)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		if len(mList.Signature) == 0 {
+			return errors.Errorf("source %q stream %q requires signed metadata", pair.source, pair.stream)
+		}
+		payload, err := simplestreams.DecodeCheckSignature(bytes.NewReader(mList.Signature), key.PublicKey)
+		if err != nil {
+			return errors.Annotatef(err, "verifying metadata signature for source %q stream %q", pair.source, pair.stream)
+		}
+		var signed []params.CloudImageMetadata
+		if err := json.Unmarshal(payload, &signed); err != nil {
+			return errors.Annotate(err, "decoding signed metadata")
+		}
+		if !reflect.DeepEqual(signed, mList.Metadata) {
+			return errors.Errorf("signed metadata does not match the metadata being saved")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package imagemetadatamanager_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/simplestreams"
+	sstesting "github.com/juju/juju/environs/simplestreams/testing"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type signingKeysSuite struct {
+	baseImageMetadataSuite
+}
+
+var _ = gc.Suite(&signingKeysSuite{})
+
+func (s *signingKeysSuite) TestAddSigningKeys(c *gc.C) {
+	results, err := s.api.AddSigningKeys(params.ImageSigningKeyArgs{
+		Keys: []params.ImageSigningKey{{
+			Source:    "custom",
+			Stream:    "released",
+			PublicKey: sstesting.SignedMetadataPublicKey,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.assertCalls(c, controllerTag, addSigningKey)
+}
+
+func (s *signingKeysSuite) TestAddSigningKeysError(c *gc.C) {
+	s.state.addImageSigningKey = func(key state.ImageSigningKey) error {
+		return errors.AlreadyExistsf("signing key for source %q stream %q", key.Source, key.Stream)
+	}
+	results, err := s.api.AddSigningKeys(params.ImageSigningKeyArgs{
+		Keys: []params.ImageSigningKey{{Source: "custom", Stream: "released"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `signing key for source "custom" stream "released" already exists`)
+}
+
+func (s *signingKeysSuite) TestRotateSigningKeys(c *gc.C) {
+	results, err := s.api.RotateSigningKeys(params.ImageSigningKeyArgs{
+		Keys: []params.ImageSigningKey{{
+			Source:    "custom",
+			Stream:    "released",
+			PublicKey: sstesting.SignedMetadataPublicKey,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.assertCalls(c, controllerTag, updateSigningKey)
+}
+
+func (s *signingKeysSuite) TestRevokeSigningKeys(c *gc.C) {
+	results, err := s.api.RevokeSigningKeys(params.ImageSigningKeyArgs{
+		Keys: []params.ImageSigningKey{{Source: "custom", Stream: "released"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.assertCalls(c, controllerTag, removeSigningKey)
+}
+
+func (s *signingKeysSuite) TestListSigningKeys(c *gc.C) {
+	s.state.allImageSigningKeys = func() ([]state.ImageSigningKey, error) {
+		return []state.ImageSigningKey{{
+			Source:    "custom",
+			Stream:    "released",
+			PublicKey: sstesting.SignedMetadataPublicKey,
+		}}, nil
+	}
+	result, err := s.api.ListSigningKeys()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Result, jc.DeepEquals, []params.ImageSigningKey{{
+		Source:    "custom",
+		Stream:    "released",
+		PublicKey: sstesting.SignedMetadataPublicKey,
+	}})
+	s.assertCalls(c, controllerTag, allSigningKeys)
+}
+
+func (s *signingKeysSuite) registerKey() {
+	s.state.imageSigningKey = func(source, stream string) (state.ImageSigningKey, error) {
+		if source == "custom" && stream == "released" {
+			return state.ImageSigningKey{
+				Source:    source,
+				Stream:    stream,
+				PublicKey: sstesting.SignedMetadataPublicKey,
+			}, nil
+		}
+		return state.ImageSigningKey{}, errors.NotFoundf("signing key for source %q stream %q", source, stream)
+	}
+}
+
+func (s *signingKeysSuite) signMetadata(c *gc.C, metadata []params.CloudImageMetadata) []byte {
+	payload, err := json.Marshal(metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	signature, err := simplestreams.Encode(
+		bytes.NewReader(payload), sstesting.SignedMetadataPrivateKey, sstesting.PrivateKeyPassphrase)
+	c.Assert(err, jc.ErrorIsNil)
+	return signature
+}
+
+func (s *signingKeysSuite) TestSaveRequiresSignature(c *gc.C) {
+	s.registerKey()
+	errs, err := s.api.Save(params.MetadataSaveParams{
+		Metadata: []params.CloudImageMetadataList{{
+			Metadata: []params.CloudImageMetadata{{
+				Source: "custom",
+				Stream: "released",
+				Region: "east",
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results, gc.HasLen, 1)
+	c.Assert(errs.Results[0].Error, gc.ErrorMatches, `source "custom" stream "released" requires signed metadata`)
+	s.assertCalls(c, controllerTag, model, imageSigningKey)
+}
+
+func (s *signingKeysSuite) TestSaveVerifiesSignature(c *gc.C) {
+	s.registerKey()
+	metadata := []params.CloudImageMetadata{{
+		Source: "custom",
+		Stream: "released",
+		Region: "east",
+	}}
+	errs, err := s.api.Save(params.MetadataSaveParams{
+		Metadata: []params.CloudImageMetadataList{{
+			Metadata:  metadata,
+			Signature: s.signMetadata(c, metadata),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results, gc.HasLen, 1)
+	c.Assert(errs.Results[0].Error, gc.IsNil)
+	s.assertCalls(c, controllerTag, model, imageSigningKey, modelConfig, saveMetadata)
+}
+
+func (s *signingKeysSuite) TestSaveRejectsMismatchedSignature(c *gc.C) {
+	s.registerKey()
+	signed := []params.CloudImageMetadata{{
+		Source: "custom",
+		Stream: "released",
+		Region: "east",
+	}}
+	tampered := []params.CloudImageMetadata{{
+		Source:  "custom",
+		Stream:  "released",
+		Region:  "east",
+		ImageId: "ami-bad",
+	}}
+	errs, err := s.api.Save(params.MetadataSaveParams{
+		Metadata: []params.CloudImageMetadataList{{
+			Metadata:  tampered,
+			Signature: s.signMetadata(c, signed),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results, gc.HasLen, 1)
+	c.Assert(errs.Results[0].Error, gc.ErrorMatches, "signed metadata does not match the metadata being saved")
+}
+
+func (s *signingKeysSuite) TestSaveUnsignedSourceUnaffected(c *gc.C) {
+	s.registerKey()
+	errs, err := s.api.Save(params.MetadataSaveParams{
+		Metadata: []params.CloudImageMetadataList{{
+			Metadata: []params.CloudImageMetadata{{
+				Source: "public",
+				Stream: "released",
+				Region: "east",
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results, gc.HasLen, 1)
+	c.Assert(errs.Results[0].Error, gc.IsNil)
+	s.assertCalls(c, controllerTag, model, imageSigningKey, modelConfig, saveMetadata)
+}
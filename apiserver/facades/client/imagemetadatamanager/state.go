@@ -19,6 +19,24 @@ type metadataAccess interface {
 	ModelConfig() (*config.Config, error)
 	ControllerTag() names.ControllerTag
 	Model() (Model, error)
+
+	// AddImageSigningKey registers a public signing key for image
+	// metadata from a simplestreams source and stream.
+	AddImageSigningKey(state.ImageSigningKey) error
+
+	// UpdateImageSigningKey replaces a registered signing key.
+	UpdateImageSigningKey(state.ImageSigningKey) error
+
+	// RemoveImageSigningKey revokes a registered signing key.
+	RemoveImageSigningKey(source, stream string) error
+
+	// ImageSigningKey returns the signing key registered for the
+	// given source and stream.
+	ImageSigningKey(source, stream string) (state.ImageSigningKey, error)
+
+	// AllImageSigningKeys returns the signing keys registered on
+	// the controller.
+	AllImageSigningKeys() ([]state.ImageSigningKey, error)
 }
 
 type Model interface {
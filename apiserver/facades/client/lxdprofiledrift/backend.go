@@ -0,0 +1,39 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lxdprofiledrift
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// LXDProfileDrift facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	AllLXDProfileDrifts() ([]state.LXDProfileDrift, error)
+}
+
+type stateShim struct {
+	st    *state.State
+	model *state.Model
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) (Backend, error) {
+	model, err := st.Model()
+	if err != nil {
+		return nil, err
+	}
+	return stateShim{st: st, model: model}, nil
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.model.ModelTag()
+}
+
+func (s stateShim) AllLXDProfileDrifts() ([]state.LXDProfileDrift, error) {
+	return s.st.AllLXDProfileDrifts()
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lxdprofiledrift
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API provides the LXDProfileDrift facade, which reports machines
+// whose applied lxd profiles have drifted from the profiles their
+// charms declare.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new LXDProfileDrift API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// DriftReport returns, for every machine in the model known to have
+// drifted, the lxd profiles applied to the machine's instance and the
+// profiles expected from its charms, ordered by machine id.
+func (api *API) DriftReport() (params.LXDProfileDriftResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.LXDProfileDriftResults{}, errors.Trace(err)
+	}
+	drifts, err := api.backend.AllLXDProfileDrifts()
+	if err != nil {
+		return params.LXDProfileDriftResults{}, errors.Trace(err)
+	}
+	results := params.LXDProfileDriftResults{
+		Results: make([]params.LXDProfileDriftResult, len(drifts)),
+	}
+	for i, drift := range drifts {
+		results.Results[i] = params.LXDProfileDriftResult{
+			MachineTag: names.NewMachineTag(drift.MachineId).String(),
+			Applied:    drift.Applied,
+			Expected:   drift.Expected,
+			Detected:   drift.Detected,
+		}
+	}
+	return results, nil
+}
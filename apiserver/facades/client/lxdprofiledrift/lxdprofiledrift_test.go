@@ -0,0 +1,91 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lxdprofiledrift_test
+
+import (
+	"time"
+
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/lxdprofiledrift"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	drifts []state.LXDProfileDrift
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return coretesting.ModelTag
+}
+
+func (m *mockBackend) AllLXDProfileDrifts() ([]state.LXDProfileDrift, error) {
+	m.MethodCall(m, "AllLXDProfileDrifts")
+	return m.drifts, m.NextErr()
+}
+
+type LXDProfileDriftSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *lxdprofiledrift.API
+}
+
+var _ = gc.Suite(&LXDProfileDriftSuite{})
+
+func (s *LXDProfileDriftSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.backend = mockBackend{
+		drifts: []state.LXDProfileDrift{{
+			MachineId: "0",
+			Applied:   []string{"default", "juju-testmodel"},
+			Expected:  []string{"default", "juju-testmodel", "juju-testmodel-app-1"},
+			Detected:  time.Unix(0, 123),
+		}},
+	}
+	api, err := lxdprofiledrift.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *LXDProfileDriftSuite) TestDriftReport(c *gc.C) {
+	results, err := s.api.DriftReport()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.LXDProfileDriftResult{{
+		MachineTag: "machine-0",
+		Applied:    []string{"default", "juju-testmodel"},
+		Expected:   []string{"default", "juju-testmodel", "juju-testmodel-app-1"},
+		Detected:   time.Unix(0, 123),
+	}})
+	s.backend.CheckCallNames(c, "AllLXDProfileDrifts")
+}
+
+func (s *LXDProfileDriftSuite) TestDriftReportEmpty(c *gc.C) {
+	s.backend.drifts = nil
+	results, err := s.api.DriftReport()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 0)
+}
+
+func (s *LXDProfileDriftSuite) TestDriftReportPermission(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("unauthorized"),
+	}
+	api, err := lxdprofiledrift.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.DriftReport()
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -4,6 +4,7 @@
 package machinemanager
 
 var InstanceTypes = instanceTypes
+var RecommendInstanceTypes = recommendInstanceTypes
 var IsSeriesLessThan = isSeriesLessThan
 
 func NewTestUpgradeSeriesValidator(localValidator, remoteValidator ApplicationValidator) upgradeSeriesValidator {
@@ -65,3 +65,53 @@ func instanceTypes(mm *MachineManagerAPI,
 
 	return params.InstanceTypesResults{Results: result}, nil
 }
+
+// RecommendInstanceTypes returns, for each supplied set of constraints,
+// the candidate instance types that provisioning would consider in the
+// current model's cloud and region, sorted by increasing cost.
+func (mm *MachineManagerAPI) RecommendInstanceTypes(cons params.ModelInstanceTypesConstraints) (params.InstanceTypesResults, error) {
+	return recommendInstanceTypes(mm, environs.GetEnviron, cons)
+}
+
+func recommendInstanceTypes(mm *MachineManagerAPI,
+	getEnviron environGetFunc,
+	cons params.ModelInstanceTypesConstraints,
+) (params.InstanceTypesResults, error) {
+	model, err := mm.st.Model()
+	if err != nil {
+		return params.InstanceTypesResults{}, errors.Trace(err)
+	}
+
+	cloudSpec := func() (environscloudspec.CloudSpec, error) {
+		return stateenvirons.CloudSpecForModel(model)
+	}
+	backend := common.EnvironConfigGetterFuncs{
+		CloudSpecFunc:   cloudSpec,
+		ModelConfigFunc: model.Config,
+	}
+
+	env, err := getEnviron(backend, environs.New)
+	if err != nil {
+		return params.InstanceTypesResults{}, errors.Trace(err)
+	}
+	region := model.CloudRegion()
+	result := make([]params.InstanceTypesResult, len(cons.Constraints))
+	for i, c := range cons.Constraints {
+		value := constraints.Value{}
+		if c.Value != nil {
+			value = *c.Value
+		}
+		itCons := common.NewInstanceTypeConstraints(
+			env,
+			mm.callContext,
+			value,
+		)
+		it, err := common.RecommendInstanceTypes(itCons, region)
+		if err != nil {
+			it = params.InstanceTypesResult{Error: apiservererrors.ServerError(err)}
+		}
+		result[i] = it
+	}
+
+	return params.InstanceTypesResults{Results: result}, nil
+}
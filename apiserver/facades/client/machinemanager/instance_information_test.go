@@ -118,3 +118,50 @@ func (s *instanceTypesSuite) TestInstanceTypes(c *gc.C) {
 	}
 	c.Assert(r.Results, gc.DeepEquals, expected)
 }
+
+func (s *instanceTypesSuite) TestRecommendInstanceTypes(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	model := mocks.NewMockModel(ctrl)
+	s.st.EXPECT().Model().Return(model, nil)
+	model.EXPECT().CloudRegion().Return("nether-west")
+
+	env := mocks.NewMockEnviron(ctrl)
+	env.EXPECT().InstanceTypes(gomock.Any(), constraints.Value{}).Return(instances.InstanceTypesWithCostMetadata{
+		CostUnit:     "USD/h",
+		CostCurrency: "USD",
+		InstanceTypes: []instances.InstanceType{
+			{Name: "small", CpuCores: 2, Mem: 2048, Cost: 10},
+			{Name: "large", CpuCores: 8, Mem: 16384, Cost: 80},
+			{Name: "medium", CpuCores: 4, Mem: 8192, Cost: 40},
+		},
+	}, nil).MinTimes(1)
+
+	fakeEnvironGet := func(st environs.EnvironConfigGetter,
+		newEnviron environs.NewEnvironFunc,
+	) (environs.Environ, error) {
+		return env, nil
+	}
+
+	fourCores := uint64(4)
+	cons := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{
+			{Value: &constraints.Value{CpuCores: &fourCores}},
+			{Value: &constraints.Value{CpuCores: &over9kCPUCores}},
+		},
+	}
+
+	r, err := machinemanager.RecommendInstanceTypes(s.api, fakeEnvironGet, cons)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 2)
+	c.Assert(r.Results[0], gc.DeepEquals, params.InstanceTypesResult{
+		InstanceTypes: []params.InstanceType{
+			{Name: "medium", CPUCores: 4, Memory: 8192, Cost: 40},
+			{Name: "large", CPUCores: 8, Memory: 16384, Cost: 80},
+		},
+		CostUnit:     "USD/h",
+		CostCurrency: "USD",
+	})
+	c.Assert(r.Results[1].Error, gc.ErrorMatches, `no instance types in nether-west matching constraints "cores=9001"`)
+}
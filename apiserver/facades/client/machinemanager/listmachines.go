@@ -0,0 +1,207 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/series"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ListMachines returns a summary of the machines in the model matching
+// the supplied filter, ordered by machine id and paginated with the
+// filter's offset and limit. Filtering is done server-side so that
+// inventory tooling does not have to pull a FullStatus of a large model
+// and filter it client-side.
+func (mm *MachineManagerAPI) ListMachines(args params.ListMachinesArgs) (params.ListMachinesResults, error) {
+	var results params.ListMachinesResults
+	if err := mm.authorizer.CanRead(); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	var filterBase *series.Base
+	if args.Base != nil {
+		parsed, err := series.ParseBase(args.Base.Name, args.Base.Channel)
+		if err != nil {
+			return results, errors.Trace(err)
+		}
+		filterBase = &parsed
+	}
+
+	machines, err := mm.st.AllMachines()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	var matched []params.MachineSummary
+	for _, machine := range machines {
+		summary, ok, err := machineSummary(machine, args, filterBase)
+		if err != nil {
+			return results, errors.Trace(err)
+		}
+		if ok {
+			matched = append(matched, summary)
+		}
+	}
+
+	results.Total = len(matched)
+	offset := args.Offset
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+	matched = matched[offset:]
+	if args.Limit > 0 && args.Limit < len(matched) {
+		matched = matched[:args.Limit]
+	}
+	results.Machines = matched
+	return results, nil
+}
+
+// machineSummary returns the summary for the input machine, along with
+// whether it matches the supplied filter.
+func machineSummary(
+	machine Machine, args params.ListMachinesArgs, filterBase *series.Base,
+) (params.MachineSummary, bool, error) {
+	var empty params.MachineSummary
+
+	lifeValue := machine.Life().Value()
+	if args.Life != "" && args.Life != lifeValue {
+		return empty, false, nil
+	}
+
+	agentStatus, err := machine.Status()
+	if err != nil {
+		return empty, false, errors.Trace(err)
+	}
+	if args.AgentStatus != "" && args.AgentStatus != agentStatus.Status.String() {
+		return empty, false, nil
+	}
+
+	machineBase, err := series.GetBaseFromSeries(machine.Series())
+	if err != nil {
+		return empty, false, errors.Trace(err)
+	}
+	if filterBase != nil {
+		if filterBase.Name != machineBase.Name || filterBase.Channel.Track != machineBase.Channel.Track {
+			return empty, false, nil
+		}
+	}
+
+	// An unprovisioned machine has no availability zone, which only
+	// matches an empty zone filter.
+	zone, err := machine.AvailabilityZone()
+	if err != nil && !errors.IsNotProvisioned(err) {
+		return empty, false, errors.Trace(err)
+	}
+	if args.AvailabilityZone != "" && args.AvailabilityZone != zone {
+		return empty, false, nil
+	}
+
+	cons, err := machine.Constraints()
+	if err != nil {
+		return empty, false, errors.Trace(err)
+	}
+	if !constraintsSubset(args.Constraints, cons) {
+		return empty, false, nil
+	}
+
+	var instanceStatus string
+	if sInfo, err := machine.InstanceStatus(); err == nil {
+		instanceStatus = sInfo.Status.String()
+	} else if !errors.IsNotProvisioned(err) {
+		return empty, false, errors.Trace(err)
+	}
+
+	return params.MachineSummary{
+		Id:   machine.Id(),
+		Life: lifeValue,
+		Base: params.Base{
+			Name:    machineBase.Name,
+			Channel: machineBase.Channel.String(),
+		},
+		Series:           machine.Series(),
+		AvailabilityZone: zone,
+		Constraints:      cons,
+		AgentStatus:      agentStatus.Status.String(),
+		InstanceStatus:   instanceStatus,
+	}, true, nil
+}
+
+// constraintsSubset reports whether every attribute set in filter is
+// present in cons with an equal value. List-valued attributes (tags,
+// spaces and zones) match when the filter's entries are a subset of the
+// machine's.
+func constraintsSubset(filter, cons constraints.Value) bool {
+	if filter.Arch != nil && !strPtrEqual(filter.Arch, cons.Arch) {
+		return false
+	}
+	if filter.Container != nil && (cons.Container == nil || *filter.Container != *cons.Container) {
+		return false
+	}
+	if filter.CpuCores != nil && !uintPtrEqual(filter.CpuCores, cons.CpuCores) {
+		return false
+	}
+	if filter.CpuPower != nil && !uintPtrEqual(filter.CpuPower, cons.CpuPower) {
+		return false
+	}
+	if filter.Mem != nil && !uintPtrEqual(filter.Mem, cons.Mem) {
+		return false
+	}
+	if filter.RootDisk != nil && !uintPtrEqual(filter.RootDisk, cons.RootDisk) {
+		return false
+	}
+	if filter.RootDiskSource != nil && !strPtrEqual(filter.RootDiskSource, cons.RootDiskSource) {
+		return false
+	}
+	if filter.InstanceRole != nil && !strPtrEqual(filter.InstanceRole, cons.InstanceRole) {
+		return false
+	}
+	if filter.InstanceType != nil && !strPtrEqual(filter.InstanceType, cons.InstanceType) {
+		return false
+	}
+	if filter.VirtType != nil && !strPtrEqual(filter.VirtType, cons.VirtType) {
+		return false
+	}
+	if filter.AllocatePublicIP != nil && (cons.AllocatePublicIP == nil || *filter.AllocatePublicIP != *cons.AllocatePublicIP) {
+		return false
+	}
+	if filter.Tags != nil && !listSubset(*filter.Tags, cons.Tags) {
+		return false
+	}
+	if filter.Spaces != nil && !listSubset(*filter.Spaces, cons.Spaces) {
+		return false
+	}
+	if filter.Zones != nil && !listSubset(*filter.Zones, cons.Zones) {
+		return false
+	}
+	return true
+}
+
+func strPtrEqual(a, b *string) bool {
+	return b != nil && *a == *b
+}
+
+func uintPtrEqual(a, b *uint64) bool {
+	return b != nil && *a == *b
+}
+
+func listSubset(want []string, have *[]string) bool {
+	if len(want) == 0 {
+		return true
+	}
+	if have == nil {
+		return false
+	}
+	haveSet := make(map[string]bool, len(*have))
+	for _, v := range *have {
+		haveSet[v] = true
+	}
+	for _, v := range want {
+		if !haveSet[v] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type listMachinesSuite struct {
+	authorizer *apiservertesting.FakeAuthorizer
+	st         *mocks.MockBackend
+	api        *machinemanager.MachineManagerAPI
+}
+
+var _ = gc.Suite(&listMachinesSuite{})
+
+func (s *listMachinesSuite) SetUpTest(c *gc.C) {
+	s.authorizer = &apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("admin"), Controller: true}
+}
+
+func (s *listMachinesSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.st = mocks.NewMockBackend(ctrl)
+	var err error
+	s.api, err = machinemanager.NewMachineManagerAPI(s.st,
+		nil,
+		nil,
+		machinemanager.ModelAuthorizer{
+			Authorizer: s.authorizer,
+			ModelTag:   names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		},
+		context.NewEmptyCloudCallContext(),
+		common.NewResources(),
+		mocks.NewMockLeadership(ctrl),
+		nil,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	return ctrl
+}
+
+type machineArgs struct {
+	id          string
+	life        state.Life
+	series      string
+	zone        string
+	agentStatus status.Status
+	constraints constraints.Value
+}
+
+func (s *listMachinesSuite) machine(ctrl *gomock.Controller, args machineArgs) *mocks.MockMachine {
+	m := mocks.NewMockMachine(ctrl)
+	m.EXPECT().Id().Return(args.id).AnyTimes()
+	m.EXPECT().Life().Return(args.life).AnyTimes()
+	m.EXPECT().Series().Return(args.series).AnyTimes()
+	m.EXPECT().AvailabilityZone().Return(args.zone, nil).AnyTimes()
+	m.EXPECT().Status().Return(status.StatusInfo{Status: args.agentStatus}, nil).AnyTimes()
+	m.EXPECT().InstanceStatus().Return(status.StatusInfo{Status: status.Running}, nil).AnyTimes()
+	m.EXPECT().Constraints().Return(args.constraints, nil).AnyTimes()
+	return m
+}
+
+func (s *listMachinesSuite) expectMachines(ctrl *gomock.Controller) {
+	s.st.EXPECT().AllMachines().Return([]machinemanager.Machine{
+		s.machine(ctrl, machineArgs{
+			id: "0", life: state.Alive, series: "focal", zone: "az1",
+			agentStatus: status.Started,
+			constraints: constraints.MustParse("cores=2 mem=4096M tags=foo,bar"),
+		}),
+		s.machine(ctrl, machineArgs{
+			id: "1", life: state.Alive, series: "jammy", zone: "az2",
+			agentStatus: status.Started,
+			constraints: constraints.MustParse("cores=4"),
+		}),
+		s.machine(ctrl, machineArgs{
+			id: "2", life: state.Dying, series: "focal", zone: "az1",
+			agentStatus: status.Down,
+			constraints: constraints.Value{},
+		}),
+	}, nil)
+}
+
+func (s *listMachinesSuite) TestListMachinesUnfiltered(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 3)
+	c.Assert(results.Machines, gc.HasLen, 3)
+	c.Check(results.Machines[0], gc.DeepEquals, params.MachineSummary{
+		Id:               "0",
+		Life:             life.Alive,
+		Base:             params.Base{Name: "ubuntu", Channel: "20.04/stable"},
+		Series:           "focal",
+		AvailabilityZone: "az1",
+		Constraints:      constraints.MustParse("cores=2 mem=4096M tags=foo,bar"),
+		AgentStatus:      "started",
+		InstanceStatus:   "running",
+	})
+}
+
+func (s *listMachinesSuite) TestListMachinesByZone(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{AvailabilityZone: "az2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 1)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Id, gc.Equals, "1")
+}
+
+func (s *listMachinesSuite) TestListMachinesByBase(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{
+		Base: &params.Base{Name: "ubuntu", Channel: "20.04"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 2)
+	c.Check(results.Machines[0].Id, gc.Equals, "0")
+	c.Check(results.Machines[1].Id, gc.Equals, "2")
+}
+
+func (s *listMachinesSuite) TestListMachinesByLifeAndStatus(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{
+		Life:        life.Dying,
+		AgentStatus: "down",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 1)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Id, gc.Equals, "2")
+}
+
+func (s *listMachinesSuite) TestListMachinesByConstraintsSubset(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{
+		Constraints: constraints.MustParse("cores=2 tags=foo"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 1)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Id, gc.Equals, "0")
+}
+
+func (s *listMachinesSuite) TestListMachinesPagination(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{Offset: 1, Limit: 1})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 3)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Id, gc.Equals, "1")
+}
+
+func (s *listMachinesSuite) TestListMachinesOffsetPastEnd(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+	s.expectMachines(ctrl)
+
+	results, err := s.api.ListMachines(params.ListMachinesArgs{Offset: 5})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Total, gc.Equals, 3)
+	c.Check(results.Machines, gc.HasLen, 0)
+}
+
+func (s *listMachinesSuite) TestListMachinesPermissionDenied(c *gc.C) {
+	s.authorizer = &apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("fred")}
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	_, err := s.api.ListMachines(params.ListMachinesArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
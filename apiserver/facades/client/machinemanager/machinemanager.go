@@ -301,6 +301,13 @@ func (mm *MachineManagerAPI) addOneMachine(p params.AddMachineParams) (*state.Ma
 	}
 
 	var placementDirective string
+	var machineCloud string
+	if p.Placement != nil && p.Placement.Scope == instance.CloudScope {
+		// The machine is to be provisioned in another registered
+		// cloud than the model's own.
+		machineCloud = p.Placement.Directive
+		p.Placement = nil
+	}
 	if p.Placement != nil {
 		model, err := mm.st.Model()
 		if err != nil {
@@ -353,6 +360,7 @@ func (mm *MachineManagerAPI) addOneMachine(p params.AddMachineParams) (*state.Ma
 		HardwareCharacteristics: p.HardwareCharacteristics,
 		Addresses:               sAddrs,
 		Placement:               placementDirective,
+		Cloud:                   machineCloud,
 	}
 	if p.ContainerType == "" {
 		return mm.st.AddOneMachine(template)
@@ -773,7 +781,7 @@ func (mm *MachineManagerAPI) UpgradeSeriesPrepare(arg params.UpdateChannelArg) (
 	if err != nil {
 		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
 	}
-	err = mm.upgradeSeriesAPI.Prepare(arg.Entity.Tag, argSeries, arg.Force)
+	err = mm.upgradeSeriesAPI.Prepare(arg.Entity.Tag, argSeries, arg.Force, arg.SkipHookApplications)
 	if err != nil {
 		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
 	}
@@ -868,6 +876,36 @@ func (mm *MachineManagerAPI) GetUpgradeSeriesMessages(args params.UpgradeSeriesN
 	return results, nil
 }
 
+// ProviderMetadata is not available via the V9 API.
+func (api *MachineManagerAPIV9) ProviderMetadata(_ struct{}) {}
+
+// ProviderMetadata returns the provider-sourced instance metadata
+// recorded for each given machine by the instance poller, such as an
+// image end-of-life date or the current spot price. Only machine tags
+// are accepted.
+func (mm *MachineManagerAPI) ProviderMetadata(args params.Entities) (params.ProviderMetadataResults, error) {
+	if err := mm.authorizer.CanRead(); err != nil {
+		return params.ProviderMetadataResults{}, err
+	}
+	results := params.ProviderMetadataResults{
+		Results: make([]params.ProviderMetadataResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		machine, err := mm.machineFromTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(errors.Trace(err))
+			continue
+		}
+		metadata, err := machine.ProviderMetadata()
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Metadata = metadata
+	}
+	return results, nil
+}
+
 // TODO (stickupkid): This will eventually be removed once we extract all the
 // other methods to commands.
 func (mm *MachineManagerAPI) machineFromTag(tag string) (Machine, error) {
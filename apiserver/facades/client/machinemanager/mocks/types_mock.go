@@ -16,6 +16,7 @@ import (
 	charmhub "github.com/juju/juju/charmhub"
 	transport "github.com/juju/juju/charmhub/transport"
 	cloud "github.com/juju/juju/cloud"
+	constraints "github.com/juju/juju/core/constraints"
 	instance "github.com/juju/juju/core/instance"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
@@ -541,6 +542,21 @@ func (mr *MockMachineMockRecorder) ApplicationNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationNames", reflect.TypeOf((*MockMachine)(nil).ApplicationNames))
 }
 
+// AvailabilityZone mocks base method.
+func (m *MockMachine) AvailabilityZone() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilityZone")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailabilityZone indicates an expected call of AvailabilityZone.
+func (mr *MockMachineMockRecorder) AvailabilityZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilityZone", reflect.TypeOf((*MockMachine)(nil).AvailabilityZone))
+}
+
 // CompleteUpgradeSeries mocks base method.
 func (m *MockMachine) CompleteUpgradeSeries() error {
 	m.ctrl.T.Helper()
@@ -555,6 +571,21 @@ func (mr *MockMachineMockRecorder) CompleteUpgradeSeries() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpgradeSeries", reflect.TypeOf((*MockMachine)(nil).CompleteUpgradeSeries))
 }
 
+// Constraints mocks base method.
+func (m *MockMachine) Constraints() (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Constraints")
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Constraints indicates an expected call of Constraints.
+func (mr *MockMachineMockRecorder) Constraints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Constraints", reflect.TypeOf((*MockMachine)(nil).Constraints))
+}
+
 // Containers mocks base method.
 func (m *MockMachine) Containers() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +732,20 @@ func (mr *MockMachineMockRecorder) IsManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManager", reflect.TypeOf((*MockMachine)(nil).IsManager))
 }
 
+// Life mocks base method.
+func (m *MockMachine) Life() state.Life {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Life")
+	ret0, _ := ret[0].(state.Life)
+	return ret0
+}
+
+// Life indicates an expected call of Life.
+func (mr *MockMachineMockRecorder) Life() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Life", reflect.TypeOf((*MockMachine)(nil).Life))
+}
+
 // Principals mocks base method.
 func (m *MockMachine) Principals() []string {
 	m.ctrl.T.Helper()
@@ -715,6 +760,21 @@ func (mr *MockMachineMockRecorder) Principals() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Principals", reflect.TypeOf((*MockMachine)(nil).Principals))
 }
 
+// ProviderMetadata mocks base method.
+func (m *MockMachine) ProviderMetadata() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProviderMetadata")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProviderMetadata indicates an expected call of ProviderMetadata.
+func (mr *MockMachineMockRecorder) ProviderMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProviderMetadata", reflect.TypeOf((*MockMachine)(nil).ProviderMetadata))
+}
+
 // RemoveUpgradeSeriesLock mocks base method.
 func (m *MockMachine) RemoveUpgradeSeriesLock() error {
 	m.ctrl.T.Helper()
@@ -799,6 +859,21 @@ func (mr *MockMachineMockRecorder) SetUpgradeSeriesStatus(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpgradeSeriesStatus", reflect.TypeOf((*MockMachine)(nil).SetUpgradeSeriesStatus), arg0, arg1)
 }
 
+// Status mocks base method.
+func (m *MockMachine) Status() (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockMachineMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockMachine)(nil).Status))
+}
+
 // Tag mocks base method.
 func (m *MockMachine) Tag() names.Tag {
 	m.ctrl.T.Helper()
@@ -114,17 +114,17 @@ func (mr *MockUpgradeSeriesMockRecorder) Complete(arg0 interface{}) *gomock.Call
 }
 
 // Prepare mocks base method.
-func (m *MockUpgradeSeries) Prepare(arg0, arg1 string, arg2 bool) error {
+func (m *MockUpgradeSeries) Prepare(arg0, arg1 string, arg2 bool, arg3 []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Prepare", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Prepare", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Prepare indicates an expected call of Prepare.
-func (mr *MockUpgradeSeriesMockRecorder) Prepare(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUpgradeSeriesMockRecorder) Prepare(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockUpgradeSeries)(nil).Prepare), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockUpgradeSeries)(nil).Prepare), arg0, arg1, arg2, arg3)
 }
 
 // Validate mocks base method.
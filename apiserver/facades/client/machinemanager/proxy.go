@@ -0,0 +1,63 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/proxy"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// EffectiveProxyConfig returns the proxy and apt-mirror configuration
+// that each of the given machines received at provisioning time. These
+// settings come from the model config; the container broker renders
+// them into cloud-init when starting LXD and KVM containers, so this
+// lets the effective configuration be verified without inspecting the
+// machine itself.
+func (mm *MachineManagerAPI) EffectiveProxyConfig(args params.Entities) (params.ProxyConfigResults, error) {
+	if err := mm.authorizer.CanRead(); err != nil {
+		return params.ProxyConfigResults{}, errors.Trace(err)
+	}
+	model, err := mm.st.Model()
+	if err != nil {
+		return params.ProxyConfigResults{}, errors.Trace(err)
+	}
+	cfg, err := model.Config()
+	if err != nil {
+		return params.ProxyConfigResults{}, errors.Trace(err)
+	}
+	modelResult := params.ProxyConfigResult{
+		LegacyProxySettings:      proxyParams(cfg.LegacyProxySettings()),
+		JujuProxySettings:        proxyParams(cfg.JujuProxySettings()),
+		APTProxySettings:         proxyParams(cfg.AptProxySettings()),
+		SnapProxySettings:        proxyParams(cfg.SnapProxySettings()),
+		SnapStoreProxyId:         cfg.SnapStoreProxy(),
+		SnapStoreProxyAssertions: cfg.SnapStoreAssertions(),
+		SnapStoreProxyURL:        cfg.SnapStoreProxyURL(),
+		AptMirror:                cfg.AptMirror(),
+	}
+
+	results := params.ProxyConfigResults{
+		Results: make([]params.ProxyConfigResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		if _, err := mm.machineFromTag(entity.Tag); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i] = modelResult
+	}
+	return results, nil
+}
+
+func proxyParams(settings proxy.Settings) params.ProxyConfig {
+	return params.ProxyConfig{
+		HTTP:    settings.Http,
+		HTTPS:   settings.Https,
+		FTP:     settings.Ftp,
+		NoProxy: settings.FullNoProxy(),
+	}
+}
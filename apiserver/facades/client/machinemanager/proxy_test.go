@@ -0,0 +1,102 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager"
+	"github.com/juju/juju/apiserver/facades/client/machinemanager/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&ProxyConfigSuite{})
+
+type ProxyConfigSuite struct {
+	authorizer *apiservertesting.FakeAuthorizer
+	st         *mocks.MockBackend
+	model      *mocks.MockModel
+	api        *machinemanager.MachineManagerAPI
+
+	callContext context.ProviderCallContext
+}
+
+func (s *ProxyConfigSuite) SetUpTest(c *gc.C) {
+	s.authorizer = &apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("admin")}
+	s.callContext = context.NewEmptyCloudCallContext()
+}
+
+func (s *ProxyConfigSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.st = mocks.NewMockBackend(ctrl)
+	s.model = mocks.NewMockModel(ctrl)
+
+	var err error
+	s.api, err = machinemanager.NewMachineManagerAPI(s.st,
+		nil,
+		nil,
+		machinemanager.ModelAuthorizer{
+			Authorizer: s.authorizer,
+		},
+		s.callContext,
+		common.NewResources(),
+		nil,
+		nil,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	return ctrl
+}
+
+func (s *ProxyConfigSuite) TestEffectiveProxyConfig(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	modelConfig := coretesting.CustomModelConfig(c, coretesting.Attrs{
+		"http-proxy": "http://proxy.example.com:3128",
+		"no-proxy":   "localhost",
+		"apt-mirror": "http://mirror.example.com/ubuntu",
+	})
+	s.st.EXPECT().Model().Return(s.model, nil)
+	s.model.EXPECT().Config().Return(modelConfig, nil)
+	s.st.EXPECT().Machine("0").Return(mocks.NewMockMachine(gomock.NewController(c)), nil)
+	s.st.EXPECT().Machine("0/lxd/0").Return(mocks.NewMockMachine(gomock.NewController(c)), nil)
+
+	results, err := s.api.EffectiveProxyConfig(params.Entities{Entities: []params.Entity{
+		{Tag: "machine-0"},
+		{Tag: "machine-0-lxd-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	for _, result := range results.Results {
+		c.Assert(result.Error, gc.IsNil)
+		c.Assert(result.LegacyProxySettings.HTTP, gc.Equals, "http://proxy.example.com:3128")
+		c.Assert(result.LegacyProxySettings.NoProxy, gc.Equals, "localhost")
+		c.Assert(result.AptMirror, gc.Equals, "http://mirror.example.com/ubuntu")
+	}
+}
+
+func (s *ProxyConfigSuite) TestEffectiveProxyConfigMachineNotFound(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	modelConfig := coretesting.CustomModelConfig(c, coretesting.Attrs{})
+	s.st.EXPECT().Model().Return(s.model, nil)
+	s.model.EXPECT().Config().Return(modelConfig, nil)
+	s.st.EXPECT().Machine("42").Return(nil, errors.NotFoundf("machine 42"))
+
+	results, err := s.api.EffectiveProxyConfig(params.Entities{Entities: []params.Entity{
+		{Tag: "machine-42"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "machine 42 not found")
+}
@@ -37,6 +37,12 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("MachineManager", 9, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacadeV9(ctx) // Use base not series.
 	}, reflect.TypeOf((*MachineManagerAPIV9)(nil)))
+	registry.MustRegister("MachineManager", 10, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacade(ctx) // Adds ProviderMetadata.
+	}, reflect.TypeOf((*MachineManagerAPI)(nil)))
+	registry.MustRegister("MachineManager", 11, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacade(ctx) // Adds RecommendInstanceTypes.
+	}, reflect.TypeOf((*MachineManagerAPI)(nil)))
 }
 
 // newFacade creates a new server-side MachineManager API facade.
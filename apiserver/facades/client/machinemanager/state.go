@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/state/binarystorage"
 
 	"github.com/juju/juju/apiserver/common/storagecommon"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
@@ -83,6 +84,10 @@ type Machine interface {
 	Destroy() error
 	ForceDestroy(time.Duration) error
 	Series() string
+	Life() state.Life
+	Constraints() (constraints.Value, error)
+	Status() (status.StatusInfo, error)
+	AvailabilityZone() (string, error)
 	Containers() ([]string, error)
 	Units() ([]Unit, error)
 	SetKeepInstance(keepInstance bool) error
@@ -99,6 +104,7 @@ type Machine interface {
 	ApplicationNames() ([]string, error)
 	InstanceStatus() (status.StatusInfo, error)
 	SetInstanceStatus(sInfo status.StatusInfo) error
+	ProviderMetadata() (map[string]string, error)
 }
 
 type Application interface {
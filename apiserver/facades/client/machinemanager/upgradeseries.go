@@ -32,8 +32,10 @@ type UpgradeSeries interface {
 
 	// Prepare attempts to prepare a machine for a OS series upgrade.
 	// It is expected that a validate call has been performed before the prepare
-	// step.
-	Prepare(string, string, bool) error
+	// step. Applications named in the final argument are held: their units
+	// are excluded from the upgrade-series lock so their pre/post upgrade
+	// hooks are never awaited.
+	Prepare(string, string, bool, []string) error
 
 	// Complete will complete the upgrade series.
 	Complete(string) error
@@ -166,7 +168,7 @@ func (a *UpgradeSeriesAPI) Validate(entities []ValidationEntity) ([]ValidationRe
 	return results, nil
 }
 
-func (a *UpgradeSeriesAPI) Prepare(tag, series string, force bool) (retErr error) {
+func (a *UpgradeSeriesAPI) Prepare(tag, series string, force bool, skipHookApps []string) (retErr error) {
 	if series == "" {
 		return errors.BadRequestf("series missing from args")
 	}
@@ -180,13 +182,36 @@ func (a *UpgradeSeriesAPI) Prepare(tag, series string, force bool) (retErr error
 		return errors.Trace(err)
 	}
 
+	// Held applications must actually be on the machine; an unknown name
+	// indicates the acknowledgement was made against stale information.
+	held := set.NewStrings(skipHookApps...)
+	if !held.IsEmpty() {
+		appNames, err := machine.ApplicationNames()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if unknown := held.Difference(set.NewStrings(appNames...)); !unknown.IsEmpty() {
+			return errors.NotFoundf("application(s) %q on machine %q", unknown.SortedValues(), machine.Id())
+		}
+	}
+
 	units, err := machine.Units()
 	if err != nil {
 		return errors.Trace(err)
 	}
-	unitNames := make([]string, len(units))
-	for i, unit := range units {
-		unitNames[i] = unit.UnitTag().Id()
+	// Units of held applications are left out of the upgrade-series lock,
+	// so the machine upgrade never waits on their hooks.
+	unitNames := make([]string, 0, len(units))
+	for _, unit := range units {
+		unitName := unit.UnitTag().Id()
+		appName, err := names.UnitApplication(unitName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if held.Contains(appName) {
+			continue
+		}
+		unitNames = append(unitNames, unitName)
 	}
 
 	// TODO 2018-06-28 managed series upgrade
@@ -166,10 +166,70 @@ func (s UpgradeSeriesSuitePrepare) TestPrepare(c *gc.C) {
 	authorizer := mocks.NewMockAuthorizer(ctrl)
 
 	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
-	err := api.Prepare("machine-0", "focal", false)
+	err := api.Prepare("machine-0", "focal", false, nil)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s UpgradeSeriesSuitePrepare) TestPrepareSkipHookApplications(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	application := mocks.NewMockApplication(ctrl)
+	applications := []machinemanager.Application{application}
+
+	unit := mocks.NewMockUnit(ctrl)
+	unit.EXPECT().UnitTag().Return(names.NewUnitTag("app/0"))
+	heldUnit := mocks.NewMockUnit(ctrl)
+	heldUnit.EXPECT().UnitTag().Return(names.NewUnitTag("held/0"))
+
+	units := []machinemanager.Unit{unit, heldUnit}
+
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().ApplicationNames().Return([]string{"app", "held"}, nil)
+	machine.EXPECT().Units().Return(units, nil)
+	// The held application's unit is left out of the lock.
+	machine.EXPECT().CreateUpgradeSeriesLock([]string{"app/0"}, "focal")
+	machine.EXPECT().Series().Return("bionic").Times(2)
+	machine.EXPECT().Tag().Return(names.NewMachineTag("0"))
+	machine.EXPECT().SetUpgradeSeriesStatus(model.UpgradeSeriesPrepareStarted, `started upgrade series from "bionic" to "focal"`)
+
+	state := mocks.NewMockUpgradeSeriesState(ctrl)
+	state.EXPECT().MachineFromTag("machine-0").Return(machine, nil)
+	state.EXPECT().ApplicationsFromMachine(machine).Return(applications, nil)
+
+	validator := mocks.NewMockUpgradeSeriesValidator(ctrl)
+	validator.EXPECT().ValidateSeries("focal", "bionic", "machine-0")
+	validator.EXPECT().ValidateApplications(applications, "focal", false)
+	validator.EXPECT().ValidateMachine(machine).Return(nil)
+
+	authorizer := mocks.NewMockAuthorizer(ctrl)
+
+	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
+	err := api.Prepare("machine-0", "focal", false, []string{"held"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s UpgradeSeriesSuitePrepare) TestPrepareSkipHookApplicationsUnknown(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().ApplicationNames().Return([]string{"app"}, nil)
+	machine.EXPECT().Id().Return("0")
+
+	state := mocks.NewMockUpgradeSeriesState(ctrl)
+	state.EXPECT().MachineFromTag("machine-0").Return(machine, nil)
+
+	validator := mocks.NewMockUpgradeSeriesValidator(ctrl)
+	validator.EXPECT().ValidateMachine(machine).Return(nil)
+
+	authorizer := mocks.NewMockAuthorizer(ctrl)
+
+	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
+	err := api.Prepare("machine-0", "focal", false, []string{"ghost"})
+	c.Assert(err, gc.ErrorMatches, `application\(s\) \["ghost"\] on machine "0" not found`)
+}
+
 func (s UpgradeSeriesSuitePrepare) TestPrepareWithRollback(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -196,7 +256,7 @@ func (s UpgradeSeriesSuitePrepare) TestPrepareWithRollback(c *gc.C) {
 	authorizer := mocks.NewMockAuthorizer(ctrl)
 
 	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
-	err := api.Prepare("machine-0", "focal", false)
+	err := api.Prepare("machine-0", "focal", false, nil)
 	c.Assert(err, gc.ErrorMatches, `bad`)
 }
 
@@ -226,7 +286,7 @@ func (s UpgradeSeriesSuitePrepare) TestPrepareWithRollbackError(c *gc.C) {
 	authorizer := mocks.NewMockAuthorizer(ctrl)
 
 	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
-	err := api.Prepare("machine-0", "focal", false)
+	err := api.Prepare("machine-0", "focal", false, nil)
 	c.Assert(err, gc.ErrorMatches, `boom occurred while cleaning up from: bad`)
 }
 
@@ -245,7 +305,7 @@ func (s UpgradeSeriesSuitePrepare) TestPrepareValidationFailure(c *gc.C) {
 	authorizer := mocks.NewMockAuthorizer(ctrl)
 
 	api := machinemanager.NewUpgradeSeriesAPI(state, validator, authorizer)
-	err := api.Prepare("machine-0", "focal", false)
+	err := api.Prepare("machine-0", "focal", false, nil)
 	c.Assert(err, gc.ErrorMatches, `bad`)
 }
 
@@ -28,6 +28,9 @@ type Backend interface {
 	ControllerConfig() (controller.Config, error)
 	SetModelConstraints(value constraints.Value) error
 	ModelConstraints() (constraints.Value, error)
+	RecordModelConstraintsChange(state.ConstraintsChange) error
+	ModelConstraintsChangeHistory() ([]state.ConstraintsChange, error)
+	WatchModelConstraints() state.NotifyWatcher
 }
 
 type stateShim struct {
@@ -52,6 +55,10 @@ func (st stateShim) ModelTag() names.ModelTag {
 	return m.ModelTag()
 }
 
+func (st stateShim) WatchModelConstraints() state.NotifyWatcher {
+	return st.model.WatchModelConstraints()
+}
+
 func (st stateShim) SpaceByName(name string) error {
 	_, err := st.State.SpaceByName(name)
 	return err
@@ -10,26 +10,37 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/policy"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
 )
 
+var logger = loggo.GetLogger("juju.apiserver.modelconfig")
+
 // ModelConfigAPI provides the base implementation of the methods
 // for the V2 and V1 api calls.
 type ModelConfigAPI struct {
-	backend Backend
-	auth    facade.Authorizer
-	check   *common.BlockChecker
+	backend   Backend
+	resources facade.Resources
+	auth      facade.Authorizer
+	check     *common.BlockChecker
 }
 
-// ModelConfigAPIV3 is currently the latest.
-type ModelConfigAPIV3 struct {
+// ModelConfigAPIV4 is currently the latest.
+type ModelConfigAPIV4 struct {
 	*ModelConfigAPI
 }
 
+// ModelConfigAPIV3 hides V4 functionality
+type ModelConfigAPIV3 struct {
+	*ModelConfigAPIV4
+}
+
 // ModelConfigAPIV2 hides V3 functionality
 type ModelConfigAPIV2 struct {
 	*ModelConfigAPIV3
@@ -41,16 +52,17 @@ type ModelConfigAPIV1 struct {
 }
 
 // NewModelConfigAPI creates a new instance of the ModelConfig Facade.
-func NewModelConfigAPI(backend Backend, authorizer facade.Authorizer) (*ModelConfigAPIV3, error) {
+func NewModelConfigAPI(backend Backend, resources facade.Resources, authorizer facade.Authorizer) (*ModelConfigAPIV4, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
 	client := &ModelConfigAPI{
-		backend: backend,
-		auth:    authorizer,
-		check:   common.NewBlockChecker(backend),
+		backend:   backend,
+		resources: resources,
+		auth:      authorizer,
+		check:     common.NewBlockChecker(backend),
 	}
-	return &ModelConfigAPIV3{client}, nil
+	return &ModelConfigAPIV4{client}, nil
 }
 
 func (c *ModelConfigAPI) checkCanWrite() error {
@@ -147,6 +159,19 @@ func (c *ModelConfigAPI) ModelSet(args params.ModelSet) error {
 
 	// Replace any deprecated attributes with their new values.
 	attrs := config.ProcessDeprecatedAttributes(args.Config)
+
+	controllerCfg, err := c.backend.ControllerConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := common.CheckPolicy(controllerCfg, policy.Check{
+		Operation:  policy.OpModelConfig,
+		ModelUUID:  c.backend.ModelTag().Id(),
+		Attributes: attrs,
+	}); err != nil {
+		return errors.Trace(err)
+	}
+
 	return c.backend.UpdateModelConfig(attrs, nil,
 		checkAgentVersion, checkLogTrace, checkDefaultSpace, checkCharmhubURL, checkLoggingConfig)
 }
@@ -287,7 +312,66 @@ func (c *ModelConfigAPI) SetModelConstraints(args params.SetConstraints) error {
 	if err := c.check.ChangeAllowed(); err != nil {
 		return errors.Trace(err)
 	}
-	return c.backend.SetModelConstraints(args.Constraints)
+	if err := c.backend.SetModelConstraints(args.Constraints); err != nil {
+		return errors.Trace(err)
+	}
+	c.recordConstraintsChange(args.Constraints)
+	return nil
+}
+
+// recordConstraintsChange writes a history entry for a completed
+// constraints change. Failure to record is logged, not returned: the
+// history is advisory and must not fail the change that triggered it.
+func (c *ModelConfigAPI) recordConstraintsChange(cons constraints.Value) {
+	var author string
+	if tag := c.auth.GetAuthTag(); tag != nil {
+		author = tag.String()
+	}
+	if err := c.backend.RecordModelConstraintsChange(state.ConstraintsChange{
+		Author:      author,
+		Constraints: cons,
+	}); err != nil {
+		logger.Warningf("recording model constraints change: %v", err)
+	}
+}
+
+// WatchModelConstraints returns a watcher that notifies when the model
+// constraints change.
+func (c *ModelConfigAPI) WatchModelConstraints() (params.NotifyWatchResult, error) {
+	result := params.NotifyWatchResult{}
+	if err := c.canReadModel(); err != nil {
+		return result, errors.Trace(err)
+	}
+	watch := c.backend.WatchModelConstraints()
+	// Consume the initial event. Technically, API calls to Watch
+	// 'transmit' the initial event in the Watch response.
+	if _, ok := <-watch.Changes(); ok {
+		result.NotifyWatcherId = c.resources.Register(watch)
+	} else {
+		return result, watcher.EnsureErr(watch)
+	}
+	return result, nil
+}
+
+// ModelConstraintsHistory returns the recorded changes to the model
+// constraints, most recent first.
+func (c *ModelConfigAPI) ModelConstraintsHistory() (params.ModelConstraintsHistoryResult, error) {
+	result := params.ModelConstraintsHistoryResult{}
+	if err := c.canReadModel(); err != nil {
+		return result, errors.Trace(err)
+	}
+	history, err := c.backend.ModelConstraintsChangeHistory()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, change := range history {
+		result.Changes = append(result.Changes, params.ModelConstraintsChange{
+			Author:      change.Author,
+			Timestamp:   change.Timestamp,
+			Constraints: change.Constraints,
+		})
+	}
+	return result, nil
 }
 
 // SetSLALevel sets the sla level on the model.
@@ -332,3 +416,9 @@ func (c *ModelConfigAPI) Sequences() (params.ModelSequencesResult, error) {
 
 // Sequences isn't on the V1 API.
 func (a *ModelConfigAPIV1) Sequences(_, _ struct{}) {}
+
+// WatchModelConstraints isn't on the V3 API.
+func (a *ModelConfigAPIV3) WatchModelConstraints(_, _ struct{}) {}
+
+// ModelConstraintsHistory isn't on the V3 API.
+func (a *ModelConfigAPIV3) ModelConstraintsHistory(_, _ struct{}) {}
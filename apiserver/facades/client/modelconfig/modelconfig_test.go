@@ -4,12 +4,15 @@
 package modelconfig_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 	gitjujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facades/client/modelconfig"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/controller"
@@ -26,8 +29,9 @@ import (
 type modelconfigSuite struct {
 	gitjujutesting.IsolationSuite
 	backend    *mockBackend
+	resources  *common.Resources
 	authorizer apiservertesting.FakeAuthorizer
-	api        *modelconfig.ModelConfigAPIV3
+	api        *modelconfig.ModelConfigAPIV4
 }
 
 var _ = gc.Suite(&modelconfigSuite{})
@@ -38,6 +42,8 @@ func (s *modelconfigSuite) SetUpTest(c *gc.C) {
 		Tag:      names.NewUserTag("bruce@local"),
 		AdminTag: names.NewUserTag("bruce@local"),
 	}
+	s.resources = common.NewResources()
+	s.AddCleanup(func(_ *gc.C) { s.resources.StopAll() })
 	s.backend = &mockBackend{
 		cfg: config.ConfigValues{
 			"type":            {"dummy", "model"},
@@ -48,7 +54,7 @@ func (s *modelconfigSuite) SetUpTest(c *gc.C) {
 		},
 	}
 	var err error
-	s.api, err = modelconfig.NewModelConfigAPI(s.backend, &s.authorizer)
+	s.api, err = modelconfig.NewModelConfigAPI(s.backend, s.resources, &s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -254,6 +260,9 @@ func (s *modelconfigSuite) TestClientSetModelConstraints(c *gc.C) {
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(s.backend.cons, gc.DeepEquals, cons)
+	c.Assert(s.backend.history, gc.HasLen, 1)
+	c.Assert(s.backend.history[0].Author, gc.Equals, "user-bruce")
+	c.Assert(s.backend.history[0].Constraints, gc.DeepEquals, cons)
 }
 
 func (s *modelconfigSuite) assertSetModelConstraintsBlocked(c *gc.C, msg string) {
@@ -282,6 +291,30 @@ func (s *modelconfigSuite) TestClientGetModelConstraints(c *gc.C) {
 	c.Assert(obtained.Constraints, gc.DeepEquals, cons)
 }
 
+func (s *modelconfigSuite) TestModelConstraintsHistory(c *gc.C) {
+	cons, err := constraints.Parse("mem=4096", "cores=2")
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.history = []state.ConstraintsChange{{
+		Author:      "user-admin",
+		Timestamp:   time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC),
+		Constraints: cons,
+	}}
+	result, err := s.api.ModelConstraintsHistory()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Changes, jc.DeepEquals, []params.ModelConstraintsChange{{
+		Author:      "user-admin",
+		Timestamp:   time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC),
+		Constraints: cons,
+	}})
+}
+
+func (s *modelconfigSuite) TestWatchModelConstraints(c *gc.C) {
+	result, err := s.api.WatchModelConstraints()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.NotifyWatcherId, gc.Equals, "1")
+	c.Assert(s.resources.Get("1"), gc.Equals, s.backend.watcher)
+}
+
 type mockBackend struct {
 	cfg      config.ConfigValues
 	old      *config.Config
@@ -289,6 +322,8 @@ type mockBackend struct {
 	features string
 	msg      string
 	cons     constraints.Value
+	history  []state.ConstraintsChange
+	watcher  *apiservertesting.FakeNotifyWatcher
 }
 
 func (m *mockBackend) SetModelConstraints(value constraints.Value) error {
@@ -300,6 +335,20 @@ func (m *mockBackend) ModelConstraints() (constraints.Value, error) {
 	return m.cons, nil
 }
 
+func (m *mockBackend) RecordModelConstraintsChange(change state.ConstraintsChange) error {
+	m.history = append(m.history, change)
+	return nil
+}
+
+func (m *mockBackend) ModelConstraintsChangeHistory() ([]state.ConstraintsChange, error) {
+	return m.history, nil
+}
+
+func (m *mockBackend) WatchModelConstraints() state.NotifyWatcher {
+	m.watcher = apiservertesting.NewFakeNotifyWatcher()
+	return m.watcher
+}
+
 func (m *mockBackend) ModelConfigValues() (config.ConfigValues, error) {
 	return m.cfg, nil
 }
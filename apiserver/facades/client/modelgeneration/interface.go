@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/juju/core/cache"
 	"github.com/juju/juju/core/settings"
+	"github.com/juju/juju/core/status"
 )
 
 //go:generate go run github.com/golang/mock/mockgen -package mocks -destination mocks/package_mock.go github.com/juju/juju/apiserver/facades/client/modelgeneration State,Model,Generation,Application,ModelCache
@@ -59,4 +60,8 @@ type Application interface {
 	// DefaultCharmConfig is the only abstraction in these shims.
 	// It saves us having to shim out Charm as well.
 	DefaultCharmConfig() (charm.Settings, error)
+
+	// UnitStatuses returns the workload status of each of the
+	// application's units, keyed by unit name.
+	UnitStatuses() (map[string]status.StatusInfo, error)
 }
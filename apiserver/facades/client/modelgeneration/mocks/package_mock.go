@@ -12,6 +12,7 @@ import (
 	modelgeneration "github.com/juju/juju/apiserver/facades/client/modelgeneration"
 	cache "github.com/juju/juju/core/cache"
 	settings "github.com/juju/juju/core/settings"
+	status "github.com/juju/juju/core/status"
 	names "github.com/juju/names/v4"
 )
 
@@ -452,6 +453,21 @@ func (mr *MockApplicationMockRecorder) UnitNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitNames", reflect.TypeOf((*MockApplication)(nil).UnitNames))
 }
 
+// UnitStatuses mocks base method.
+func (m *MockApplication) UnitStatuses() (map[string]status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnitStatuses")
+	ret0, _ := ret[0].(map[string]status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnitStatuses indicates an expected call of UnitStatuses.
+func (mr *MockApplicationMockRecorder) UnitStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitStatuses", reflect.TypeOf((*MockApplication)(nil).UnitStatuses))
+}
+
 // MockModelCache is a mock of ModelCache interface.
 type MockModelCache struct {
 	ctrl     *gomock.Controller
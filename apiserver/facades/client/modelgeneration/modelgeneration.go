@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -177,6 +178,127 @@ func (api *API) CommitBranch(arg params.BranchArg) (params.IntResult, error) {
 	return result, nil
 }
 
+// DiffBranch returns the configuration changes made under the input branch
+// relative to the master generation, per application, along with the units
+// set to track the branch (the canaries).
+func (api *API) DiffBranch(arg params.BranchArg) (params.BranchDiffResult, error) {
+	result := params.BranchDiffResult{}
+
+	isModelAdmin, err := api.hasAdminAccess()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !isModelAdmin && !api.isControllerAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+
+	branch, err := api.model.Branch(arg.BranchName)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+
+	result.BranchName = branch.BranchName()
+	deltas := branch.Config()
+	tracking := branch.AssignedUnits()
+
+	// Include every application with changes or canaries on the branch.
+	appNames := set.NewStrings()
+	for appName := range deltas {
+		appNames.Add(appName)
+	}
+	for appName := range tracking {
+		appNames.Add(appName)
+	}
+
+	for _, appName := range appNames.SortedValues() {
+		diff := params.BranchApplicationDiff{
+			ApplicationName: appName,
+			UnitsTracking:   set.NewStrings(tracking[appName]...).SortedValues(),
+		}
+		for _, change := range deltas[appName] {
+			delta := params.ConfigItemDelta{
+				Key: change.Key,
+				Old: change.OldValue,
+				New: change.NewValue,
+			}
+			switch {
+			case change.IsAddition():
+				delta.Type = "addition"
+			case change.IsDeletion():
+				delta.Type = "deletion"
+			default:
+				delta.Type = "modification"
+			}
+			diff.Deltas = append(diff.Deltas, delta)
+		}
+		result.Applications = append(result.Applications, diff)
+	}
+	return result, nil
+}
+
+// PromoteBranch commits the input branch as CommitBranch does, but first
+// gates on the health of the units tracking it: if any canary unit has a
+// workload status of error or blocked, promotion is refused. The gate can
+// be skipped with the force flag.
+func (api *API) PromoteBranch(arg params.BranchPromoteArg) (params.IntResult, error) {
+	result := params.IntResult{}
+
+	isModelAdmin, err := api.hasAdminAccess()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !isModelAdmin && !api.isControllerAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+
+	branch, err := api.model.Branch(arg.BranchName)
+	if err != nil {
+		return intResultsError(err)
+	}
+
+	if !arg.Force {
+		if err := api.checkCanaryHealth(branch); err != nil {
+			return intResultsError(err)
+		}
+	}
+
+	if genId, err := branch.Commit(api.apiUser.Name()); err != nil {
+		result.Error = apiservererrors.ServerError(err)
+	} else {
+		result.Result = genId
+	}
+	return result, nil
+}
+
+func (api *API) checkCanaryHealth(branch Generation) error {
+	for appName, tracking := range branch.AssignedUnits() {
+		if len(tracking) == 0 {
+			continue
+		}
+		app, err := api.st.Application(appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		statuses, err := app.UnitStatuses()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, unitName := range tracking {
+			sInfo, ok := statuses[unitName]
+			if !ok {
+				continue
+			}
+			if sInfo.Status == status.Error || sInfo.Status == status.Blocked {
+				return errors.Errorf(
+					"cannot promote branch %q: unit %q tracking the branch is %q: %s",
+					branch.BranchName(), unitName, sInfo.Status, sInfo.Message)
+			}
+		}
+	}
+	return nil
+}
+
 // AbortBranch aborts the input branch, marking it complete.  However no
 // changes are made applicable to the whole model.  No units may be assigned
 // to the branch when aborting.
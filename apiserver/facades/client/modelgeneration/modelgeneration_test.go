@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/modelgeneration/mocks"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/settings"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -229,6 +230,82 @@ func (s *modelGenerationSuite) testBranchInfo(c *gc.C, branchNames []string, det
 	}
 }
 
+func (s *modelGenerationSuite) TestDiffBranch(c *gc.C) {
+	defer s.setupModelGenerationAPI(c).Finish()
+
+	units := []string{"redis/1", "redis/0"}
+
+	s.expectBranch()
+	s.expectBranchName()
+	s.expectConfig()
+	s.expectAssignedUnits(units)
+
+	result, err := s.api.DiffBranch(s.newBranchArg())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	c.Check(result.BranchName, gc.Equals, s.newBranchName)
+	c.Assert(result.Applications, gc.HasLen, 1)
+
+	appDiff := result.Applications[0]
+	c.Check(appDiff.ApplicationName, gc.Equals, "redis")
+	c.Check(appDiff.UnitsTracking, gc.DeepEquals, []string{"redis/0", "redis/1"})
+	c.Check(appDiff.Deltas, gc.DeepEquals, []params.ConfigItemDelta{
+		{Key: "password", Type: "addition", New: "added-pass"},
+		{Key: "databases", Type: "deletion", Old: 100},
+		{Key: "port", Type: "modification", Old: 7000, New: 8000},
+	})
+}
+
+func (s *modelGenerationSuite) TestPromoteBranchSuccess(c *gc.C) {
+	ctrl := s.setupModelGenerationAPI(c)
+	defer ctrl.Finish()
+
+	units := []string{"redis/0", "redis/1"}
+
+	s.expectBranch()
+	s.expectAssignedUnits(units)
+	s.setupMockAppStatuses(ctrl, map[string]status.StatusInfo{
+		"redis/0": {Status: status.Active},
+		"redis/1": {Status: status.Active},
+	})
+	s.expectCommit()
+
+	result, err := s.api.PromoteBranch(params.BranchPromoteArg{BranchName: s.newBranchName})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.IntResult{Result: 3, Error: nil})
+}
+
+func (s *modelGenerationSuite) TestPromoteBranchUnhealthyCanary(c *gc.C) {
+	ctrl := s.setupModelGenerationAPI(c)
+	defer ctrl.Finish()
+
+	units := []string{"redis/0", "redis/1"}
+
+	s.expectBranch()
+	s.expectBranchName()
+	s.expectAssignedUnits(units)
+	s.setupMockAppStatuses(ctrl, map[string]status.StatusInfo{
+		"redis/0": {Status: status.Active},
+		"redis/1": {Status: status.Error, Message: "hook failed: config-changed"},
+	})
+
+	result, err := s.api.PromoteBranch(params.BranchPromoteArg{BranchName: s.newBranchName})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches,
+		`cannot promote branch "new-branch": unit "redis/1" tracking the branch is "error": hook failed: config-changed`)
+}
+
+func (s *modelGenerationSuite) TestPromoteBranchForceSkipsHealthCheck(c *gc.C) {
+	defer s.setupModelGenerationAPI(c).Finish()
+
+	s.expectBranch()
+	s.expectCommit()
+
+	result, err := s.api.PromoteBranch(params.BranchPromoteArg{BranchName: s.newBranchName, Force: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.IntResult{Result: 3, Error: nil})
+}
+
 func (s *modelGenerationSuite) setupModelGenerationAPI(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -319,6 +396,13 @@ func (s *modelGenerationSuite) expectConfig() {
 	}})
 }
 
+func (s *modelGenerationSuite) setupMockAppStatuses(ctrl *gomock.Controller, statuses map[string]status.StatusInfo) {
+	mockApp := mocks.NewMockApplication(ctrl)
+	mockApp.EXPECT().UnitStatuses().Return(statuses, nil)
+
+	s.mockState.EXPECT().Application("redis").Return(mockApp, nil)
+}
+
 func (s *modelGenerationSuite) setupMockApp(ctrl *gomock.Controller, units []string) {
 	mockApp := mocks.NewMockApplication(ctrl)
 	mockApp.EXPECT().DefaultCharmConfig().Return(map[string]interface{}{
@@ -7,6 +7,7 @@ import (
 	"github.com/juju/charm/v8"
 	"github.com/juju/errors"
 	"github.com/juju/juju/core/cache"
+	"github.com/juju/juju/core/status"
 
 	"github.com/juju/juju/state"
 )
@@ -73,6 +74,24 @@ func (a *applicationShim) DefaultCharmConfig() (charm.Settings, error) {
 	return ch.Config().DefaultSettings(), nil
 }
 
+// UnitStatuses returns the workload status of each of the application's
+// units, keyed by unit name.
+func (a *applicationShim) UnitStatuses() (map[string]status.StatusInfo, error) {
+	units, err := a.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	statuses := make(map[string]status.StatusInfo, len(units))
+	for _, unit := range units {
+		sInfo, err := unit.Status()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		statuses[unit.Name()] = sInfo
+	}
+	return statuses, nil
+}
+
 type stateShim struct {
 	*state.State
 }
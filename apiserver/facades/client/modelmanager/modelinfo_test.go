@@ -25,6 +25,7 @@ import (
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
@@ -201,7 +202,9 @@ func (s *modelInfoSuite) setAPIUser(c *gc.C, user names.UserTag) {
 func (s *modelInfoSuite) TestModelInfoV7(c *gc.C) {
 	api := &modelmanager.ModelManagerAPIV7{
 		&modelmanager.ModelManagerAPIV8{
-			s.modelmanager,
+			&modelmanager.ModelManagerAPIV9{
+				s.modelmanager,
+			},
 		},
 	}
 
@@ -1107,6 +1110,7 @@ type mockModel struct {
 	cfg                 *config.Config
 	users               []*mockModelUser
 	migrationStatus     state.MigrationMode
+	freezeMode          coremodel.FreezeMode
 	controllerUUID      string
 	isController        bool
 	cloud               cloud.Cloud
@@ -1223,6 +1227,20 @@ func (m *mockModel) MigrationMode() state.MigrationMode {
 	return m.migrationStatus
 }
 
+func (m *mockModel) FreezeMode() coremodel.FreezeMode {
+	m.MethodCall(m, "FreezeMode")
+	return m.freezeMode
+}
+
+func (m *mockModel) SetFreezeMode(mode coremodel.FreezeMode) error {
+	m.MethodCall(m, "SetFreezeMode", mode)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	m.freezeMode = mode
+	return nil
+}
+
 func (m *mockModel) AddUser(spec state.UserAccessSpec) (permission.UserAccess, error) {
 	m.MethodCall(m, "AddUser", spec)
 	return permission.UserAccess{}, m.NextErr()
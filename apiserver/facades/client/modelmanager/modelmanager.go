@@ -7,6 +7,7 @@ import (
 	stdcontext "context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/juju/description/v3"
@@ -25,6 +26,7 @@ import (
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller/modelmanager"
 	"github.com/juju/juju/core/life"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/environs"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
@@ -45,6 +47,13 @@ var (
 	supportedFeaturesGetter = stateenvirons.SupportedFeatures
 )
 
+// ModelManagerV10 defines the methods on the version 10 facade for the
+// modelmanager API endpoint.
+type ModelManagerV10 interface {
+	ModelManagerV9
+	// ModifyModelAccess gains an optional expiry time on grants.
+}
+
 // ModelManagerV9 defines the methods on the version 9 facade for the
 // modelmanager API endpoint.
 type ModelManagerV9 interface {
@@ -142,10 +151,16 @@ type ModelManagerAPI struct {
 	callContext context.ProviderCallContext
 }
 
+// ModelManagerAPIV9 provides a way to wrap the different calls between
+// version 9 and version 10 of the model manager API
+type ModelManagerAPIV9 struct {
+	*ModelManagerAPI
+}
+
 // ModelManagerAPIV8 provides a way to wrap the different calls between
 // version 8 and version 8 of the model manager API
 type ModelManagerAPIV8 struct {
-	*ModelManagerAPI
+	*ModelManagerAPIV9
 }
 
 // ModelManagerAPIV7 provides a way to wrap the different calls between
@@ -185,14 +200,15 @@ type ModelManagerAPIV2 struct {
 }
 
 var (
-	_ ModelManagerV9 = (*ModelManagerAPI)(nil)
-	_ ModelManagerV8 = (*ModelManagerAPIV8)(nil)
-	_ ModelManagerV7 = (*ModelManagerAPIV7)(nil)
-	_ ModelManagerV6 = (*ModelManagerAPIV6)(nil)
-	_ ModelManagerV5 = (*ModelManagerAPIV5)(nil)
-	_ ModelManagerV4 = (*ModelManagerAPIV4)(nil)
-	_ ModelManagerV3 = (*ModelManagerAPIV3)(nil)
-	_ ModelManagerV2 = (*ModelManagerAPIV2)(nil)
+	_ ModelManagerV10 = (*ModelManagerAPI)(nil)
+	_ ModelManagerV9  = (*ModelManagerAPIV9)(nil)
+	_ ModelManagerV8  = (*ModelManagerAPIV8)(nil)
+	_ ModelManagerV7  = (*ModelManagerAPIV7)(nil)
+	_ ModelManagerV6  = (*ModelManagerAPIV6)(nil)
+	_ ModelManagerV5  = (*ModelManagerAPIV5)(nil)
+	_ ModelManagerV4  = (*ModelManagerAPIV4)(nil)
+	_ ModelManagerV3  = (*ModelManagerAPIV3)(nil)
+	_ ModelManagerV2  = (*ModelManagerAPIV2)(nil)
 )
 
 // NewModelManagerAPI creates a new api server endpoint for managing
@@ -1063,6 +1079,11 @@ func (m *ModelManagerAPI) getModelInfo(tag names.ModelTag) (params.ModelInfo, er
 	if cloudCredentialTag, ok := model.CloudCredentialTag(); ok {
 		info.CloudCredentialTag = cloudCredentialTag.String()
 	}
+	info.Frozen = model.FreezeMode() == coremodel.FreezeReadOnly
+
+	for _, family := range coremodel.ModelType(model.Type()).SupportedOSFamilies() {
+		info.SupportedOSFamilies = append(info.SupportedOSFamilies, strings.ToLower(family.String()))
+	}
 
 	// All users with access to the model can see the SLA information.
 	info.SLA = &params.ModelSLAInfo{
@@ -1237,7 +1258,7 @@ func (m *ModelManagerAPI) ModifyModelAccess(args params.ModifyModelAccessRequest
 		}
 
 		result.Results[i].Error = apiservererrors.ServerError(
-			changeModelAccess(m.state, modelTag, m.apiUser, targetUserTag, arg.Action, modelAccess, m.isAdmin))
+			changeModelAccess(m.state, modelTag, m.apiUser, targetUserTag, arg.Action, modelAccess, arg.ExpiresAt, m.isAdmin))
 	}
 	return result, nil
 }
@@ -1270,7 +1291,7 @@ func userAuthorizedToChangeAccess(st common.ModelManagerBackend, userIsAdmin boo
 
 // changeModelAccess performs the requested access grant or revoke action for the
 // specified user on the specified model.
-func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.ModelTag, apiUser, targetUserTag names.UserTag, action params.ModelAction, access permission.Access, userIsAdmin bool) error {
+func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.ModelTag, apiUser, targetUserTag names.UserTag, action params.ModelAction, access permission.Access, expiresAt *time.Time, userIsAdmin bool) error {
 	st, release, err := accessor.GetBackend(modelTag.Id())
 	if err != nil {
 		return errors.Annotate(err, "could not lookup model")
@@ -1281,6 +1302,10 @@ func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.Model
 		return errors.Trace(err)
 	}
 
+	if expiresAt != nil && action != params.GrantModelAccess {
+		return errors.New("expiry time can only be set when granting access")
+	}
+
 	model, err := st.Model()
 	if err != nil {
 		return errors.Trace(err)
@@ -1288,8 +1313,11 @@ func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.Model
 
 	switch action {
 	case params.GrantModelAccess:
-		_, err = model.AddUser(state.UserAccessSpec{User: targetUserTag, CreatedBy: apiUser, Access: access})
+		_, err = model.AddUser(state.UserAccessSpec{User: targetUserTag, CreatedBy: apiUser, Access: access, ExpiresAt: expiresAt})
 		if errors.IsAlreadyExists(err) {
+			if expiresAt != nil {
+				return errors.New("cannot set an expiry time on existing access")
+			}
 			modelUser, err := st.UserAccess(targetUserTag, modelTag)
 			if errors.IsNotFound(err) {
 				// Conflicts with prior check, must be inconsistent state.
@@ -1568,6 +1596,66 @@ func (m *ModelManagerAPI) ChangeModelCredential(args params.ChangeModelCredentia
 	return params.ErrorResults{Results: results}, nil
 }
 
+// FreezeModels makes the given models fully read-only, for example for
+// a migration cutover or an audit. Frozen models refuse every mutating
+// client operation until they are thawed again.
+func (m *ModelManagerAPI) FreezeModels(args params.Entities) (params.ErrorResults, error) {
+	return m.setFreezeMode(args, coremodel.FreezeReadOnly)
+}
+
+// ThawModels restores write access to the given models.
+func (m *ModelManagerAPI) ThawModels(args params.Entities) (params.ErrorResults, error) {
+	return m.setFreezeMode(args, coremodel.FreezeNone)
+}
+
+// setFreezeMode applies the input freeze mode to each of the supplied
+// models. Deliberately no block check is made; freezing and thawing are
+// administrative operations like switching blocks on and off.
+func (m *ModelManagerAPI) setFreezeMode(args params.Entities, mode coremodel.FreezeMode) (params.ErrorResults, error) {
+	controllerAdmin, err := m.authorizer.HasPermission(permission.SuperuserAccess, m.state.ControllerTag())
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	// Only controller or model admins can freeze or thaw a model.
+	checkModelAccess := func(tag names.ModelTag) error {
+		if controllerAdmin {
+			return nil
+		}
+		modelAdmin, err := m.authorizer.HasPermission(permission.AdminAccess, tag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if modelAdmin {
+			return nil
+		}
+		return apiservererrors.ErrPerm
+	}
+
+	setOne := func(arg params.Entity) error {
+		modelTag, err := names.ParseModelTag(arg.Tag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := checkModelAccess(modelTag); err != nil {
+			return errors.Trace(err)
+		}
+		model, releaser, err := m.state.GetModel(modelTag.Id())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer releaser()
+		return errors.Trace(model.SetFreezeMode(mode))
+	}
+
+	results := make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		if err := setOne(arg); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
 // ValidateModelUpgrades validates if a model is allowed to perform an upgrade.
 // Examples of why you would want to block a model upgrade, would be situations
 // like upgrade-series. If performing an upgrade-series we don't know the
@@ -724,7 +724,9 @@ func (s *modelManagerSuite) TestDumpModelV2(c *gc.C) {
 					&modelmanager.ModelManagerAPIV6{
 						&modelmanager.ModelManagerAPIV7{
 							&modelmanager.ModelManagerAPIV8{
-								s.api,
+								&modelmanager.ModelManagerAPIV9{
+									s.api,
+								},
 							},
 						},
 					},
@@ -892,7 +894,9 @@ func (s *modelManagerSuite) TestDestroyModelsV3(c *gc.C) {
 				&modelmanager.ModelManagerAPIV6{
 					&modelmanager.ModelManagerAPIV7{
 						&modelmanager.ModelManagerAPIV8{
-							s.api,
+							&modelmanager.ModelManagerAPIV9{
+								s.api,
+							},
 						},
 					},
 				},
@@ -1769,7 +1773,9 @@ func (s *modelManagerSuite) TestModelStatusV2(c *gc.C) {
 					&modelmanager.ModelManagerAPIV6{
 						&modelmanager.ModelManagerAPIV7{
 							&modelmanager.ModelManagerAPIV8{
-								s.api,
+								&modelmanager.ModelManagerAPIV9{
+									s.api,
+								},
 							},
 						},
 					},
@@ -1810,7 +1816,9 @@ func (s *modelManagerSuite) TestModelStatusV3(c *gc.C) {
 				&modelmanager.ModelManagerAPIV6{
 					&modelmanager.ModelManagerAPIV7{
 						&modelmanager.ModelManagerAPIV8{
-							s.api,
+							&modelmanager.ModelManagerAPIV9{
+								s.api,
+							},
 						},
 					},
 				},
@@ -42,11 +42,14 @@ func Register(registry facade.FacadeRegistry) {
 	}, reflect.TypeOf((*ModelManagerAPIV8)(nil)))
 	registry.MustRegister("ModelManager", 9, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacadeV9(ctx) // Adds ValidateModelUpgrade
+	}, reflect.TypeOf((*ModelManagerAPIV9)(nil)))
+	registry.MustRegister("ModelManager", 10, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacadeV10(ctx) // ModifyModelAccess gains an optional expiry time on grants.
 	}, reflect.TypeOf((*ModelManagerAPI)(nil)))
 }
 
-// newFacadeV9 is used for API registration.
-func newFacadeV9(ctx facade.Context) (*ModelManagerAPI, error) {
+// newFacadeV10 is used for API registration.
+func newFacadeV10(ctx facade.Context) (*ModelManagerAPI, error) {
 	st := ctx.State()
 	pool := ctx.StatePool()
 	ctlrSt, err := pool.SystemState()
@@ -96,6 +99,15 @@ func newFacadeV9(ctx facade.Context) (*ModelManagerAPI, error) {
 	)
 }
 
+// newFacadeV9 is used for API registration.
+func newFacadeV9(ctx facade.Context) (*ModelManagerAPIV9, error) {
+	v10, err := newFacadeV10(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ModelManagerAPIV9{v10}, nil
+}
+
 // newFacadeV8 is used for API registration.
 func newFacadeV8(ctx facade.Context) (*ModelManagerAPIV8, error) {
 	v9, err := newFacadeV9(ctx)
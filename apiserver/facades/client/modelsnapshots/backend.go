@@ -0,0 +1,76 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelsnapshots
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// ModelSnapshots facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	GetBlockForType(t state.BlockType) (state.Block, bool, error)
+	CreateModelSnapshot(name string) (state.ModelSnapshot, error)
+	ModelSnapshot(name string) (state.ModelSnapshot, error)
+	ModelSnapshots() ([]state.ModelSnapshot, error)
+	RemoveModelSnapshot(name string) error
+	RollbackModelSnapshot(name string) error
+	ModelConfig() (*config.Config, error)
+	ModelConstraints() (constraints.Value, error)
+}
+
+type stateShim struct {
+	st    *state.State
+	model *state.Model
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) (Backend, error) {
+	model, err := st.Model()
+	if err != nil {
+		return nil, err
+	}
+	return stateShim{st: st, model: model}, nil
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.model.ModelTag()
+}
+
+func (s stateShim) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
+	return s.st.GetBlockForType(t)
+}
+
+func (s stateShim) CreateModelSnapshot(name string) (state.ModelSnapshot, error) {
+	return s.model.CreateModelSnapshot(name)
+}
+
+func (s stateShim) ModelSnapshot(name string) (state.ModelSnapshot, error) {
+	return s.model.ModelSnapshot(name)
+}
+
+func (s stateShim) ModelSnapshots() ([]state.ModelSnapshot, error) {
+	return s.model.ModelSnapshots()
+}
+
+func (s stateShim) RemoveModelSnapshot(name string) error {
+	return s.model.RemoveModelSnapshot(name)
+}
+
+func (s stateShim) RollbackModelSnapshot(name string) error {
+	return s.model.RollbackModelSnapshot(name)
+}
+
+func (s stateShim) ModelConfig() (*config.Config, error) {
+	return s.model.ModelConfig()
+}
+
+func (s stateShim) ModelConstraints() (constraints.Value, error) {
+	return s.st.ModelConstraints()
+}
@@ -0,0 +1,207 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelsnapshots
+
+import (
+	"reflect"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// API provides the ModelSnapshots facade, which manages named
+// snapshots of model config and constraints so that a batch of risky
+// changes can be reverted in one operation.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+	check      *common.BlockChecker
+}
+
+// NewAPI returns a new ModelSnapshots API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+		check:      common.NewBlockChecker(backend),
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+func (api *API) checkCanWrite() error {
+	canWrite, err := api.authorizer.HasPermission(permission.WriteAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canWrite {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+func snapshotParam(snap state.ModelSnapshot) *params.ModelSnapshot {
+	return &params.ModelSnapshot{
+		Name:        snap.Name,
+		Created:     snap.Created,
+		Config:      snap.Config,
+		Constraints: snap.Constraints.String(),
+	}
+}
+
+// CreateSnapshots captures the model's current config and constraints
+// under each of the given names.
+func (api *API) CreateSnapshots(args params.ModelSnapshotArgs) (params.ModelSnapshotResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ModelSnapshotResults{}, errors.Trace(err)
+	}
+	results := params.ModelSnapshotResults{
+		Results: make([]params.ModelSnapshotResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		snap, err := api.backend.CreateModelSnapshot(name)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Snapshot = snapshotParam(snap)
+	}
+	return results, nil
+}
+
+// ListSnapshots returns all snapshots taken of the model, oldest
+// first.
+func (api *API) ListSnapshots() (params.ModelSnapshotResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.ModelSnapshotResults{}, errors.Trace(err)
+	}
+	snapshots, err := api.backend.ModelSnapshots()
+	if err != nil {
+		return params.ModelSnapshotResults{}, errors.Trace(err)
+	}
+	results := params.ModelSnapshotResults{
+		Results: make([]params.ModelSnapshotResult, len(snapshots)),
+	}
+	for i, snap := range snapshots {
+		results.Results[i].Snapshot = snapshotParam(snap)
+	}
+	return results, nil
+}
+
+// DiffSnapshots reports, for each named snapshot, what rolling the
+// model back to it would change.
+func (api *API) DiffSnapshots(args params.ModelSnapshotArgs) (params.ModelSnapshotDiffResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.ModelSnapshotDiffResults{}, errors.Trace(err)
+	}
+	cfg, err := api.backend.ModelConfig()
+	if err != nil {
+		return params.ModelSnapshotDiffResults{}, errors.Trace(err)
+	}
+	cons, err := api.backend.ModelConstraints()
+	if err != nil {
+		return params.ModelSnapshotDiffResults{}, errors.Trace(err)
+	}
+	current := cfg.AllAttrs()
+	results := params.ModelSnapshotDiffResults{
+		Results: make([]params.ModelSnapshotDiffResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		snap, err := api.backend.ModelSnapshot(name)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i] = diffSnapshot(snap, current, cons.String())
+	}
+	return results, nil
+}
+
+// diffSnapshot returns the config attributes and constraints a
+// rollback to snap would change.
+func diffSnapshot(snap state.ModelSnapshot, current map[string]interface{}, currentCons string) params.ModelSnapshotDiffResult {
+	keys := set.NewStrings()
+	for key := range snap.Config {
+		keys.Add(key)
+	}
+	for key := range current {
+		keys.Add(key)
+	}
+	var changes []params.ModelSnapshotChange
+	for _, key := range keys.SortedValues() {
+		snapValue, inSnap := snap.Config[key]
+		currentValue, inCurrent := current[key]
+		if inSnap && inCurrent && reflect.DeepEqual(snapValue, currentValue) {
+			continue
+		}
+		change := params.ModelSnapshotChange{Key: key}
+		if inSnap {
+			change.Snapshot = snapValue
+		}
+		if inCurrent {
+			change.Current = currentValue
+		}
+		changes = append(changes, change)
+	}
+	result := params.ModelSnapshotDiffResult{Changes: changes}
+	if snapCons := snap.Constraints.String(); snapCons != currentCons {
+		result.ConstraintsChanged = true
+		result.SnapshotConstraints = snapCons
+		result.CurrentConstraints = currentCons
+	}
+	return result
+}
+
+// RollbackSnapshots restores the model's config and constraints to
+// the state captured in each named snapshot, in order.
+func (api *API) RollbackSnapshots(args params.ModelSnapshotArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := api.backend.RollbackModelSnapshot(name)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RemoveSnapshots deletes each named snapshot.
+func (api *API) RemoveSnapshots(args params.ModelSnapshotArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := api.backend.RemoveModelSnapshot(name)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelsnapshots_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/modelsnapshots"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	config      *config.Config
+	constraints constraints.Value
+	snapshots   map[string]state.ModelSnapshot
+	order       []string
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return coretesting.ModelTag
+}
+
+func (m *mockBackend) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
+	return nil, false, nil
+}
+
+func (m *mockBackend) CreateModelSnapshot(name string) (state.ModelSnapshot, error) {
+	m.MethodCall(m, "CreateModelSnapshot", name)
+	if err := m.NextErr(); err != nil {
+		return state.ModelSnapshot{}, err
+	}
+	if _, ok := m.snapshots[name]; ok {
+		return state.ModelSnapshot{}, errors.AlreadyExistsf("model snapshot %q", name)
+	}
+	snap := state.ModelSnapshot{
+		Name:        name,
+		Created:     time.Unix(0, 123),
+		Config:      m.config.AllAttrs(),
+		Constraints: m.constraints,
+	}
+	m.snapshots[name] = snap
+	m.order = append(m.order, name)
+	return snap, nil
+}
+
+func (m *mockBackend) ModelSnapshot(name string) (state.ModelSnapshot, error) {
+	m.MethodCall(m, "ModelSnapshot", name)
+	snap, ok := m.snapshots[name]
+	if !ok {
+		return state.ModelSnapshot{}, errors.NotFoundf("model snapshot %q", name)
+	}
+	return snap, m.NextErr()
+}
+
+func (m *mockBackend) ModelSnapshots() ([]state.ModelSnapshot, error) {
+	m.MethodCall(m, "ModelSnapshots")
+	var snapshots []state.ModelSnapshot
+	for _, name := range m.order {
+		snapshots = append(snapshots, m.snapshots[name])
+	}
+	return snapshots, m.NextErr()
+}
+
+func (m *mockBackend) RemoveModelSnapshot(name string) error {
+	m.MethodCall(m, "RemoveModelSnapshot", name)
+	if _, ok := m.snapshots[name]; !ok {
+		return errors.NotFoundf("model snapshot %q", name)
+	}
+	delete(m.snapshots, name)
+	return m.NextErr()
+}
+
+func (m *mockBackend) RollbackModelSnapshot(name string) error {
+	m.MethodCall(m, "RollbackModelSnapshot", name)
+	if _, ok := m.snapshots[name]; !ok {
+		return errors.NotFoundf("model snapshot %q", name)
+	}
+	return m.NextErr()
+}
+
+func (m *mockBackend) ModelConfig() (*config.Config, error) {
+	return m.config, nil
+}
+
+func (m *mockBackend) ModelConstraints() (constraints.Value, error) {
+	return m.constraints, nil
+}
+
+type ModelSnapshotsSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *modelsnapshots.API
+}
+
+var _ = gc.Suite(&ModelSnapshotsSuite{})
+
+func (s *ModelSnapshotsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.backend = mockBackend{
+		config:      coretesting.ModelConfig(c),
+		constraints: constraints.MustParse("mem=4G"),
+		snapshots:   make(map[string]state.ModelSnapshot),
+	}
+	api, err := modelsnapshots.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *ModelSnapshotsSuite) TestCreateAndListSnapshots(c *gc.C) {
+	results, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"before-upgrade"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Snapshot.Name, gc.Equals, "before-upgrade")
+	c.Assert(results.Results[0].Snapshot.Constraints, gc.Equals, "mem=4096M")
+
+	list, err := s.api.ListSnapshots()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(list.Results, gc.HasLen, 1)
+	c.Assert(list.Results[0].Snapshot.Name, gc.Equals, "before-upgrade")
+}
+
+func (s *ModelSnapshotsSuite) TestCreateSnapshotAlreadyExists(c *gc.C) {
+	_, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"dupe"}})
+	c.Assert(err, jc.ErrorIsNil)
+	results, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"dupe"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `model snapshot "dupe" already exists`)
+}
+
+func (s *ModelSnapshotsSuite) TestDiffSnapshots(c *gc.C) {
+	_, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline"}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Change the current config and constraints after the snapshot.
+	newConfig, err := s.backend.config.Apply(map[string]interface{}{
+		"development": true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.config = newConfig
+	s.backend.constraints = constraints.MustParse("mem=8G")
+
+	results, err := s.api.DiffSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline", "missing"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Changes, gc.DeepEquals, []params.ModelSnapshotChange{{
+		Key:      "development",
+		Snapshot: false,
+		Current:  true,
+	}})
+	c.Assert(results.Results[0].ConstraintsChanged, jc.IsTrue)
+	c.Assert(results.Results[0].SnapshotConstraints, gc.Equals, "mem=4096M")
+	c.Assert(results.Results[0].CurrentConstraints, gc.Equals, "mem=8192M")
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `model snapshot "missing" not found`)
+}
+
+func (s *ModelSnapshotsSuite) TestRollbackSnapshots(c *gc.C) {
+	_, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline"}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.RollbackSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline", "missing"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `model snapshot "missing" not found`)
+	s.backend.CheckCall(c, 1, "RollbackModelSnapshot", "baseline")
+}
+
+func (s *ModelSnapshotsSuite) TestRemoveSnapshots(c *gc.C) {
+	_, err := s.api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline"}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.RemoveSnapshots(params.ModelSnapshotArgs{Names: []string{"baseline"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(s.backend.snapshots, gc.HasLen, 0)
+}
+
+func (s *ModelSnapshotsSuite) TestPermission(c *gc.C) {
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("read"),
+	}
+	api, err := modelsnapshots.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.CreateSnapshots(params.ModelSnapshotArgs{Names: []string{"nope"}})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.ListSnapshots()
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,28 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationschemas
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// RelationSchemas facade.
+type Backend interface {
+	ControllerTag() names.ControllerTag
+	RelationInterfaceSchema(interfaceName string, version int) (map[string]interface{}, error)
+	SetRelationInterfaceSchema(interfaceName string, version int, schema map[string]interface{}) error
+	RemoveRelationInterfaceSchema(interfaceName string, version int) error
+}
+
+type stateShim struct {
+	*state.State
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
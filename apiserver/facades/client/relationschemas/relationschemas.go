@@ -0,0 +1,97 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationschemas
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API provides the RelationSchemas facade, which manages the JSON
+// schemas registered on the controller for relation interfaces.
+// Registered schemas are used to validate relation data written by
+// units of endpoints with a matching interface.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new RelationSchemas API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkAdmin() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.backend.ControllerTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// RelationSchemas returns the JSON schema registered for each given
+// relation interface name and version.
+func (api *API) RelationSchemas(args params.RelationSchemaArgs) (params.RelationSchemaResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.RelationSchemaResults{}, errors.Trace(err)
+	}
+	results := params.RelationSchemaResults{
+		Results: make([]params.RelationSchemaResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		schema, err := api.backend.RelationInterfaceSchema(arg.Interface, arg.Version)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Schema = schema
+	}
+	return results, nil
+}
+
+// SetRelationSchemas registers the given JSON schema for each given
+// relation interface name and version, replacing any schema previously
+// registered for that version.
+func (api *API) SetRelationSchemas(args params.SetRelationSchemaArgs) (params.ErrorResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		err := api.backend.SetRelationInterfaceSchema(arg.Interface, arg.Version, arg.Schema)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RemoveRelationSchemas removes the JSON schema registered for each
+// given relation interface name and version.
+func (api *API) RemoveRelationSchemas(args params.RelationSchemaArgs) (params.ErrorResults, error) {
+	if err := api.checkAdmin(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		err := api.backend.RemoveRelationInterfaceSchema(arg.Interface, arg.Version)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationschemas_test
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/relationschemas"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	schemas map[string]map[string]interface{}
+}
+
+func (m *mockBackend) key(interfaceName string, version int) string {
+	return fmt.Sprintf("%s#%d", interfaceName, version)
+}
+
+func (m *mockBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
+
+func (m *mockBackend) RelationInterfaceSchema(interfaceName string, version int) (map[string]interface{}, error) {
+	m.MethodCall(m, "RelationInterfaceSchema", interfaceName, version)
+	schema, ok := m.schemas[m.key(interfaceName, version)]
+	if !ok {
+		return nil, errors.NotFoundf("schema for interface %q version %d", interfaceName, version)
+	}
+	return schema, m.NextErr()
+}
+
+func (m *mockBackend) SetRelationInterfaceSchema(interfaceName string, version int, schema map[string]interface{}) error {
+	m.MethodCall(m, "SetRelationInterfaceSchema", interfaceName, version, schema)
+	m.schemas[m.key(interfaceName, version)] = schema
+	return m.NextErr()
+}
+
+func (m *mockBackend) RemoveRelationInterfaceSchema(interfaceName string, version int) error {
+	m.MethodCall(m, "RemoveRelationInterfaceSchema", interfaceName, version)
+	delete(m.schemas, m.key(interfaceName, version))
+	return m.NextErr()
+}
+
+type RelationSchemasSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *relationschemas.API
+}
+
+var _ = gc.Suite(&RelationSchemasSuite{})
+
+func (s *RelationSchemasSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("superuser-admin"),
+	}
+	s.backend = mockBackend{
+		schemas: make(map[string]map[string]interface{}),
+	}
+	api, err := relationschemas.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *RelationSchemasSuite) TestSetRelationSchemas(c *gc.C) {
+	schema := map[string]interface{}{"type": "object"}
+	results, err := s.api.SetRelationSchemas(params.SetRelationSchemaArgs{
+		Args: []params.SetRelationSchemaArg{{
+			Interface: "mysql",
+			Version:   1,
+			Schema:    schema,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	s.backend.CheckCall(c, 0, "SetRelationInterfaceSchema", "mysql", 1, schema)
+}
+
+func (s *RelationSchemasSuite) TestRelationSchemas(c *gc.C) {
+	schema := map[string]interface{}{"type": "object"}
+	s.backend.schemas[s.backend.key("mysql", 1)] = schema
+	results, err := s.api.RelationSchemas(params.RelationSchemaArgs{
+		Args: []params.RelationSchemaArg{
+			{Interface: "mysql", Version: 1},
+			{Interface: "http", Version: 1},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Schema, jc.DeepEquals, schema)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `schema for interface "http" version 1 not found`)
+}
+
+func (s *RelationSchemasSuite) TestRemoveRelationSchemas(c *gc.C) {
+	s.backend.schemas[s.backend.key("mysql", 1)] = map[string]interface{}{"type": "object"}
+	results, err := s.api.RemoveRelationSchemas(params.RelationSchemaArgs{
+		Args: []params.RelationSchemaArg{{Interface: "mysql", Version: 1}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(s.backend.schemas, gc.HasLen, 0)
+}
+
+func (s *RelationSchemasSuite) TestNotAdmin(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("read-only")
+	api, err := relationschemas.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.SetRelationSchemas(params.SetRelationSchemaArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.RelationSchemas(params.RelationSchemaArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removalprogress
+
+import (
+	"time"
+
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// RemovalProgress facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	RemovalOperation(names.Tag) (RemovalOperation, error)
+	WatchRemovalOperation(names.Tag) state.NotifyWatcher
+}
+
+// RemovalOperation describes the progress of a cascading entity
+// removal.
+type RemovalOperation interface {
+	EntityTag() string
+	Force() bool
+	Started() time.Time
+	Completed() (time.Time, bool)
+	Steps() []life.RemovalStepProgress
+}
+
+type stateShim struct {
+	*state.State
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return names.NewModelTag(s.State.ModelUUID())
+}
+
+func (s stateShim) RemovalOperation(tag names.Tag) (RemovalOperation, error) {
+	op, err := s.State.RemovalOperation(tag)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package removalprogress exposes the progress of cascading entity
+// removals, so that clients waiting on a removal can show live
+// progress and identify stuck steps.
+package removalprogress
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state/watcher"
+)
+
+// API provides the RemovalProgress facade.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+	resources  facade.Resources
+}
+
+// NewAPI returns a new RemovalProgress API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer, resources facade.Resources) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+		resources:  resources,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// RemovalOperations returns the removal operation tracking the removal
+// of each given entity.
+func (api *API) RemovalOperations(args params.Entities) (params.RemovalOperationResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.RemovalOperationResults{}, errors.Trace(err)
+	}
+	results := params.RemovalOperationResults{
+		Results: make([]params.RemovalOperationResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		op, err := api.backend.RemovalOperation(tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = removalOperationResult(op)
+	}
+	return results, nil
+}
+
+// WatchRemovalOperations returns a watcher for each given entity that
+// notifies when its removal operation is created or makes progress.
+func (api *API) WatchRemovalOperations(args params.Entities) (params.NotifyWatchResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.NotifyWatchResults{}, errors.Trace(err)
+	}
+	results := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		w := api.backend.WatchRemovalOperation(tag)
+		if _, ok := <-w.Changes(); !ok {
+			results.Results[i].Error = apiservererrors.ServerError(watcher.EnsureErr(w))
+			continue
+		}
+		results.Results[i].NotifyWatcherId = api.resources.Register(w)
+	}
+	return results, nil
+}
+
+func removalOperationResult(op RemovalOperation) *params.RemovalOperation {
+	result := &params.RemovalOperation{
+		EntityTag: op.EntityTag(),
+		Force:     op.Force(),
+		Started:   op.Started(),
+	}
+	if completed, ok := op.Completed(); ok {
+		result.Completed = &completed
+	}
+	for _, step := range op.Steps() {
+		result.Steps = append(result.Steps, params.RemovalStepProgress{
+			Name:      string(step.Step),
+			Status:    string(step.Status),
+			Total:     step.Total,
+			Remaining: step.Remaining,
+			Updated:   step.Updated,
+		})
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removalprogress_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/removalprogress"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockRemovalOperation struct {
+	entityTag string
+	force     bool
+	started   time.Time
+	completed *time.Time
+	steps     []life.RemovalStepProgress
+}
+
+func (m *mockRemovalOperation) EntityTag() string { return m.entityTag }
+func (m *mockRemovalOperation) Force() bool       { return m.force }
+
+func (m *mockRemovalOperation) Started() time.Time { return m.started }
+
+func (m *mockRemovalOperation) Completed() (time.Time, bool) {
+	if m.completed == nil {
+		return time.Time{}, false
+	}
+	return *m.completed, true
+}
+
+func (m *mockRemovalOperation) Steps() []life.RemovalStepProgress { return m.steps }
+
+type mockBackend struct {
+	testing.Stub
+
+	operations map[string]*mockRemovalOperation
+	watcher    *apiservertesting.FakeNotifyWatcher
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return coretesting.ModelTag
+}
+
+func (m *mockBackend) RemovalOperation(tag names.Tag) (removalprogress.RemovalOperation, error) {
+	m.MethodCall(m, "RemovalOperation", tag)
+	op, ok := m.operations[tag.String()]
+	if !ok {
+		return nil, errors.NotFoundf("removal operation for %q", tag)
+	}
+	return op, m.NextErr()
+}
+
+func (m *mockBackend) WatchRemovalOperation(tag names.Tag) state.NotifyWatcher {
+	m.MethodCall(m, "WatchRemovalOperation", tag)
+	return m.watcher
+}
+
+type RemovalProgressSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	resources  *common.Resources
+	api        *removalprogress.API
+}
+
+var _ = gc.Suite(&RemovalProgressSuite{})
+
+func (s *RemovalProgressSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("read"),
+	}
+	s.resources = common.NewResources()
+	s.AddCleanup(func(*gc.C) { s.resources.StopAll() })
+	s.backend = mockBackend{
+		operations: make(map[string]*mockRemovalOperation),
+		watcher:    apiservertesting.NewFakeNotifyWatcher(),
+	}
+	api, err := removalprogress.NewAPI(&s.backend, s.authorizer, s.resources)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *RemovalProgressSuite) TestRemovalOperations(c *gc.C) {
+	started := time.Now().Truncate(time.Second)
+	s.backend.operations["application-mysql"] = &mockRemovalOperation{
+		entityTag: "application-mysql",
+		force:     true,
+		started:   started,
+		steps: []life.RemovalStepProgress{{
+			Step:      life.RemovalStepUnitsDying,
+			Status:    life.RemovalStepRunning,
+			Total:     3,
+			Remaining: 1,
+			Updated:   started,
+		}},
+	}
+	results, err := s.api.RemovalOperations(params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-mysql"},
+			{Tag: "application-wordpress"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Result, jc.DeepEquals, &params.RemovalOperation{
+		EntityTag: "application-mysql",
+		Force:     true,
+		Started:   started,
+		Steps: []params.RemovalStepProgress{{
+			Name:      "units-dying",
+			Status:    "running",
+			Total:     3,
+			Remaining: 1,
+			Updated:   started,
+		}},
+	})
+	c.Assert(results.Results[1].Error, gc.ErrorMatches,
+		`removal operation for "application-wordpress" not found`)
+}
+
+func (s *RemovalProgressSuite) TestWatchRemovalOperations(c *gc.C) {
+	results, err := s.api.WatchRemovalOperations(params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].NotifyWatcherId, gc.Not(gc.Equals), "")
+	s.backend.CheckCall(c, 0, "WatchRemovalOperation", names.NewMachineTag("0"))
+}
+
+func (s *RemovalProgressSuite) TestInvalidTag(c *gc.C) {
+	results, err := s.api.RemovalOperations(params.Entities{
+		Entities: []params.Entity{{Tag: "not-a-tag"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `"not-a-tag" is not a valid tag`)
+}
+
+func (s *RemovalProgressSuite) TestNoReadAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("dave")
+	api, err := removalprogress.NewAPI(&s.backend, s.authorizer, s.resources)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.RemovalOperations(params.Entities{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+	_, err = api.WatchRemovalOperations(params.Entities{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package retentiondashboard
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// RetentionDashboard facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	PrunableCollectionStats() ([]state.RetentionStats, error)
+	ModelConfig() (*config.Config, error)
+	ControllerConfig() (controller.Config, error)
+}
+
+type stateShim struct {
+	*state.State
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return names.NewModelTag(s.State.ModelUUID())
+}
+
+func (s stateShim) ModelConfig() (*config.Config, error) {
+	m, err := s.State.Model()
+	if err != nil {
+		return nil, err
+	}
+	return m.ModelConfig()
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package retentiondashboard reports size, document count and age
+// statistics for the prunable collections (status history, actions and
+// logs), so that operators can see what drives database growth and
+// verify that their retention policies are effective.
+package retentiondashboard
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API provides the RetentionDashboard facade.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewAPI returns a new RetentionDashboard API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// RetentionStats returns the size, document count, oldest record
+// timestamp and configured retention policy for each of the model's
+// prunable collections.
+func (api *API) RetentionStats() (params.RetentionStatsResult, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.RetentionStatsResult{}, errors.Trace(err)
+	}
+	stats, err := api.backend.PrunableCollectionStats()
+	if err != nil {
+		return params.RetentionStatsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	policies, err := api.retentionPolicies()
+	if err != nil {
+		return params.RetentionStatsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	var result params.RetentionStatsResult
+	for _, collStats := range stats {
+		entry := params.CollectionRetentionStats{
+			Collection: collStats.Collection,
+			SizeKiB:    collStats.SizeKiB,
+			Count:      collStats.Count,
+			Policy:     policies[collStats.Collection],
+		}
+		if !collStats.Oldest.IsZero() {
+			oldest := collStats.Oldest
+			entry.Oldest = &oldest
+		}
+		result.Collections = append(result.Collections, entry)
+	}
+	return result, nil
+}
+
+// retentionPolicies describes the retention policy configured for each
+// prunable collection, keyed by the collection's user-facing name.
+func (api *API) retentionPolicies() (map[string]string, error) {
+	modelConfig, err := api.backend.ModelConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	controllerConfig, err := api.backend.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return map[string]string{
+		"status-history": fmt.Sprintf("max age %v, max size %dM",
+			modelConfig.MaxStatusHistoryAge(), modelConfig.MaxStatusHistorySizeMB()),
+		"actions": fmt.Sprintf("max age %v, max size %dM",
+			modelConfig.MaxActionResultsAge(), modelConfig.MaxActionResultsSizeMB()),
+		"logs": fmt.Sprintf("capped at %dM", controllerConfig.ModelLogsSizeMB()),
+	}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package retentiondashboard_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/retentiondashboard"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type mockBackend struct {
+	testing.Stub
+
+	stats []state.RetentionStats
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return coretesting.ModelTag
+}
+
+func (m *mockBackend) PrunableCollectionStats() ([]state.RetentionStats, error) {
+	m.MethodCall(m, "PrunableCollectionStats")
+	return m.stats, m.NextErr()
+}
+
+func (m *mockBackend) ModelConfig() (*config.Config, error) {
+	attrs := coretesting.FakeConfig().Merge(map[string]interface{}{
+		"max-status-history-age":  "336h",
+		"max-status-history-size": "5G",
+		"max-action-results-age":  "336h",
+		"max-action-results-size": "5G",
+	})
+	return config.New(config.UseDefaults, attrs)
+}
+
+func (m *mockBackend) ControllerConfig() (controller.Config, error) {
+	return controller.NewConfig(
+		coretesting.ControllerTag.Id(), coretesting.CACert,
+		map[string]interface{}{"model-logs-size": "30M"},
+	)
+}
+
+type RetentionDashboardSuite struct {
+	testing.IsolationSuite
+
+	backend    mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+	api        *retentiondashboard.API
+}
+
+var _ = gc.Suite(&RetentionDashboardSuite{})
+
+func (s *RetentionDashboardSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("read"),
+	}
+	s.backend = mockBackend{}
+	api, err := retentiondashboard.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api = api
+}
+
+func (s *RetentionDashboardSuite) TestRetentionStats(c *gc.C) {
+	oldest := time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC)
+	s.backend.stats = []state.RetentionStats{{
+		Collection: "status-history",
+		SizeKiB:    2048,
+		Count:      1000,
+		Oldest:     oldest,
+	}, {
+		Collection: "actions",
+	}, {
+		Collection: "logs",
+		SizeKiB:    512,
+		Count:      300,
+		Oldest:     oldest.Add(time.Hour),
+	}}
+	result, err := s.api.RetentionStats()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	oldestLogs := oldest.Add(time.Hour)
+	c.Assert(result.Collections, jc.DeepEquals, []params.CollectionRetentionStats{{
+		Collection: "status-history",
+		SizeKiB:    2048,
+		Count:      1000,
+		Oldest:     &oldest,
+		Policy:     "max age 336h0m0s, max size 5120M",
+	}, {
+		Collection: "actions",
+		Policy:     "max age 336h0m0s, max size 5120M",
+	}, {
+		Collection: "logs",
+		SizeKiB:    512,
+		Count:      300,
+		Oldest:     &oldestLogs,
+		Policy:     "capped at 30M",
+	}})
+}
+
+func (s *RetentionDashboardSuite) TestStatsError(c *gc.C) {
+	s.backend.SetErrors(errors.New("boom"))
+	result, err := s.api.RetentionStats()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, "boom")
+}
+
+func (s *RetentionDashboardSuite) TestNoReadAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("dave")
+	api, err := retentiondashboard.NewAPI(&s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = api.RetentionStats()
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
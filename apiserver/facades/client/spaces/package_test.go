@@ -37,6 +37,7 @@ type APISuite struct {
 	OpFactory   *MockOpFactory
 
 	cloudCallContext *context.CloudCallContext
+	NetworkEnviron   *environmocks.MockNetworkingEnviron
 	API              *API
 
 	AuthorizerState     *MockAuthorizerState
@@ -81,12 +82,12 @@ func (s *APISuite) SetupMocks(c *gc.C, supportSpaces bool, providerSpaces bool)
 	bExp.ModelConfig().Return(nil, nil).AnyTimes()
 	bExp.CloudSpec().Return(cloudSpec, nil).AnyTimes()
 
-	mockNetworkEnviron := environmocks.NewMockNetworkingEnviron(ctrl)
-	mockNetworkEnviron.EXPECT().SupportsSpaces(gomock.Any()).Return(supportSpaces, nil).AnyTimes()
-	mockNetworkEnviron.EXPECT().SupportsSpaceDiscovery(gomock.Any()).Return(providerSpaces, nil).AnyTimes()
+	s.NetworkEnviron = environmocks.NewMockNetworkingEnviron(ctrl)
+	s.NetworkEnviron.EXPECT().SupportsSpaces(gomock.Any()).Return(supportSpaces, nil).AnyTimes()
+	s.NetworkEnviron.EXPECT().SupportsSpaceDiscovery(gomock.Any()).Return(providerSpaces, nil).AnyTimes()
 
 	mockProvider := environmocks.NewMockCloudEnvironProvider(ctrl)
-	mockProvider.EXPECT().Open(gomock.Any(), gomock.Any()).Return(mockNetworkEnviron, nil).AnyTimes()
+	mockProvider.EXPECT().Open(gomock.Any(), gomock.Any()).Return(s.NetworkEnviron, nil).AnyTimes()
 
 	unReg := environs.RegisterProvider("mock-provider", mockProvider)
 
@@ -0,0 +1,177 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces
+
+import (
+	"sort"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/rpc/params"
+)
+
+// providerSubnet is a subnet reported by the provider, along with the
+// provider ID of the space it was reported in (if any).
+type providerSubnet struct {
+	params.ReconcileSubnet
+
+	spaceProviderId network.Id
+}
+
+// ReconcileSpaces is not available via APIs older than version 7.
+func (api *APIv6) ReconcileSpaces(_, _ struct{}) {}
+
+// ReconcileSpaces compares the subnets and spaces reported by the provider
+// with those recorded in the model, and returns a plan describing the
+// differences without applying any of them. Unlike ReloadSpaces, operators
+// can then apply the changes they want selectively.
+func (api *API) ReconcileSpaces() (params.ReconcileSpacesResults, error) {
+	var results params.ReconcileSpacesResults
+
+	canRead, err := api.auth.HasPermission(permission.ReadAccess, api.backing.ModelTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return results, errors.Trace(err)
+	}
+	if !canRead {
+		return results, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+
+	env, err := environs.GetEnviron(api.backing, environs.New)
+	if err != nil {
+		return results, errors.Annotate(err, "getting environ")
+	}
+	netEnv, ok := environs.SupportsNetworking(env)
+	if !ok {
+		return results, errors.NotSupportedf("spaces reconciliation in a non-networking environ")
+	}
+
+	providerSubnets, err := api.providerSubnets(netEnv)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+
+	stateSpaces, err := api.backing.AllSpaceInfos()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+
+	// Index the model's subnets by CIDR so that we can align them with
+	// the provider's report.
+	type modelSubnet struct {
+		spaceName       string
+		spaceProviderId network.Id
+	}
+	modelSubnets := make(map[string]modelSubnet)
+	for _, space := range stateSpaces {
+		for _, subnet := range space.Subnets {
+			modelSubnets[subnet.CIDR] = modelSubnet{
+				spaceName:       string(space.Name),
+				spaceProviderId: space.ProviderId,
+			}
+		}
+	}
+
+	seen := set.NewStrings()
+	for _, sub := range providerSubnets {
+		seen.Add(sub.CIDR)
+		known, ok := modelSubnets[sub.CIDR]
+		if !ok {
+			results.NewSubnets = append(results.NewSubnets, sub.ReconcileSubnet)
+			continue
+		}
+		// A moved CIDR is one that the provider reports in a different
+		// space to the one recorded in the model. We compare provider
+		// IDs rather than names, because provider space names are
+		// converted when first saved, and spaces can be renamed by the
+		// operator thereafter.
+		if sub.SpaceName != "" && known.spaceProviderId != sub.spaceProviderId {
+			results.MovedCIDRs = append(results.MovedCIDRs, params.ReconcileMovedCIDR{
+				CIDR:      sub.CIDR,
+				FromSpace: known.spaceName,
+				ToSpace:   sub.SpaceName,
+			})
+		}
+	}
+
+	for _, space := range stateSpaces {
+		for _, subnet := range space.Subnets {
+			// Subnets without a provider ID were added by the operator,
+			// so their absence from the provider's report is expected.
+			if subnet.ProviderId == "" || seen.Contains(subnet.CIDR) {
+				continue
+			}
+			results.RemovedSubnets = append(results.RemovedSubnets, params.ReconcileSubnet{
+				CIDR:       subnet.CIDR,
+				ProviderId: string(subnet.ProviderId),
+				SpaceName:  string(space.Name),
+			})
+		}
+	}
+
+	sortReconcileSubnets(results.NewSubnets)
+	sortReconcileSubnets(results.RemovedSubnets)
+	sort.Slice(results.MovedCIDRs, func(i, j int) bool {
+		return results.MovedCIDRs[i].CIDR < results.MovedCIDRs[j].CIDR
+	})
+	return results, nil
+}
+
+// providerSubnets returns the subnets reported by the provider.
+// When the provider supports space discovery, each subnet carries the
+// space that the provider reports it in; otherwise the space fields
+// are left empty and only subnet presence can be reconciled.
+func (api *API) providerSubnets(netEnv environs.NetworkingEnviron) ([]providerSubnet, error) {
+	canDiscoverSpaces, err := netEnv.SupportsSpaceDiscovery(api.context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if canDiscoverSpaces {
+		spaceInfos, err := netEnv.Spaces(api.context)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		var subnets []providerSubnet
+		for _, spaceInfo := range spaceInfos {
+			for _, subnet := range spaceInfo.Subnets {
+				subnets = append(subnets, providerSubnet{
+					ReconcileSubnet: params.ReconcileSubnet{
+						CIDR:       subnet.CIDR,
+						ProviderId: string(subnet.ProviderId),
+						SpaceName:  string(spaceInfo.Name),
+					},
+					spaceProviderId: spaceInfo.ProviderId,
+				})
+			}
+		}
+		return subnets, nil
+	}
+
+	subnetInfos, err := netEnv.Subnets(api.context, instance.UnknownId, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	subnets := make([]providerSubnet, len(subnetInfos))
+	for i, subnet := range subnetInfos {
+		subnets[i] = providerSubnet{
+			ReconcileSubnet: params.ReconcileSubnet{
+				CIDR:       subnet.CIDR,
+				ProviderId: string(subnet.ProviderId),
+			},
+		}
+	}
+	return subnets, nil
+}
+
+func sortReconcileSubnets(subnets []params.ReconcileSubnet) {
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].CIDR < subnets[j].CIDR
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces_test
+
+import (
+	"github.com/golang/mock/gomock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/spaces"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ReconcileSuite tests the dry-run reconciliation of provider
+// subnets/spaces against the model's records.
+type ReconcileSuite struct {
+	spaces.APISuite
+}
+
+var _ = gc.Suite(&ReconcileSuite{})
+
+func (s *ReconcileSuite) TestReconcileSpacesWithSpaceDiscovery(c *gc.C) {
+	ctrl, unReg := s.SetupMocks(c, true, true)
+	defer ctrl.Finish()
+	defer unReg()
+
+	s.NetworkEnviron.EXPECT().Spaces(gomock.Any()).Return(network.SpaceInfos{{
+		Name:       "db",
+		ProviderId: "sp-db",
+		Subnets: network.SubnetInfos{
+			{CIDR: "10.0.0.0/24", ProviderId: "sn-0"},
+			{CIDR: "10.0.1.0/24", ProviderId: "sn-1"},
+			{CIDR: "10.0.2.0/24", ProviderId: "sn-2"},
+		},
+	}}, nil)
+
+	s.Backing.EXPECT().AllSpaceInfos().Return(network.SpaceInfos{{
+		ID:         "1",
+		Name:       "db",
+		ProviderId: "sp-db",
+		Subnets: network.SubnetInfos{
+			{CIDR: "10.0.0.0/24", ProviderId: "sn-0"},
+			{CIDR: "10.0.9.0/24", ProviderId: "sn-9"},
+		},
+	}, {
+		ID:         "2",
+		Name:       "web",
+		ProviderId: "sp-web",
+		Subnets: network.SubnetInfos{
+			{CIDR: "10.0.1.0/24", ProviderId: "sn-1"},
+		},
+	}}, nil)
+
+	res, err := s.API.ReconcileSpaces()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(res.NewSubnets, jc.DeepEquals, []params.ReconcileSubnet{{
+		CIDR:       "10.0.2.0/24",
+		ProviderId: "sn-2",
+		SpaceName:  "db",
+	}})
+	c.Check(res.RemovedSubnets, jc.DeepEquals, []params.ReconcileSubnet{{
+		CIDR:       "10.0.9.0/24",
+		ProviderId: "sn-9",
+		SpaceName:  "db",
+	}})
+	c.Check(res.MovedCIDRs, jc.DeepEquals, []params.ReconcileMovedCIDR{{
+		CIDR:      "10.0.1.0/24",
+		FromSpace: "web",
+		ToSpace:   "db",
+	}})
+}
+
+func (s *ReconcileSuite) TestReconcileSpacesSubnetsOnly(c *gc.C) {
+	ctrl, unReg := s.SetupMocks(c, true, false)
+	defer ctrl.Finish()
+	defer unReg()
+
+	s.NetworkEnviron.EXPECT().Subnets(gomock.Any(), instance.UnknownId, gomock.Nil()).Return([]network.SubnetInfo{
+		{CIDR: "10.0.0.0/24", ProviderId: "sn-0"},
+		{CIDR: "10.0.2.0/24", ProviderId: "sn-2"},
+	}, nil)
+
+	s.Backing.EXPECT().AllSpaceInfos().Return(network.SpaceInfos{{
+		ID:   "0",
+		Name: "alpha",
+		Subnets: network.SubnetInfos{
+			{CIDR: "10.0.0.0/24", ProviderId: "sn-0"},
+			// Operator-added subnets have no provider ID and are
+			// never candidates for removal.
+			{CIDR: "192.168.0.0/24"},
+		},
+	}}, nil)
+
+	res, err := s.API.ReconcileSpaces()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(res.NewSubnets, jc.DeepEquals, []params.ReconcileSubnet{{
+		CIDR:       "10.0.2.0/24",
+		ProviderId: "sn-2",
+	}})
+	c.Check(res.RemovedSubnets, gc.HasLen, 0)
+	c.Check(res.MovedCIDRs, gc.HasLen, 0)
+}
@@ -28,8 +28,11 @@ func Register(registry facade.FacadeRegistry) {
 		return newAPIv5(ctx)
 	}, reflect.TypeOf((*APIv5)(nil)))
 	registry.MustRegister("Spaces", 6, func(ctx facade.Context) (facade.Facade, error) {
+		return newAPIv6(ctx)
+	}, reflect.TypeOf((*APIv6)(nil)))
+	registry.MustRegister("Spaces", 7, func(ctx facade.Context) (facade.Facade, error) {
 		return newAPI(ctx)
-	}, reflect.TypeOf((*API)(nil)))
+	}, reflect.TypeOf((*API)(nil))) // Adds ReconcileSpaces
 }
 
 // newAPIv2 is a wrapper that creates a V2 spaces API.
@@ -61,13 +64,22 @@ func newAPIv4(ctx facade.Context) (*APIv4, error) {
 
 // newAPIv5 is a wrapper that creates a V5 spaces API.
 func newAPIv5(ctx facade.Context) (*APIv5, error) {
-	api, err := newAPI(ctx)
+	api, err := newAPIv6(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv5{api}, nil
 }
 
+// newAPIv6 is a wrapper that creates a V6 spaces API.
+func newAPIv6(ctx facade.Context) (*APIv6, error) {
+	api, err := newAPI(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv6{api}, nil
+}
+
 // newAPI creates a new Space API server-side facade with a
 // state.State backing.
 func newAPI(ctx facade.Context) (*API, error) {
@@ -41,10 +41,15 @@ type APIv4 struct {
 
 // APIv5 provides the spaces API facade for version 5.
 type APIv5 struct {
+	*APIv6
+}
+
+// APIv6 provides the spaces API facade for version 6.
+type APIv6 struct {
 	*API
 }
 
-// API provides the spaces API facade for version 6.
+// API provides the spaces API facade for version 7.
 type API struct {
 	reloadSpacesAPI ReloadSpaces
 
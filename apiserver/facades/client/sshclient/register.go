@@ -30,6 +30,12 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("SSHClient", 4, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacade(ctx) // v4 adds ModelCredentialForSSH() method.
 	}, reflect.TypeOf((*Facade)(nil)))
+
+	// Clients should move to v4; the older versions are scheduled for
+	// removal in Juju 3.0.
+	registry.MustDeprecate("SSHClient", 1, "3.0")
+	registry.MustDeprecate("SSHClient", 2, "3.0")
+	registry.MustDeprecate("SSHClient", 3, "3.0")
 }
 
 func newFacade(ctx facade.Context) (*Facade, error) {
@@ -257,6 +257,13 @@ func (m *mockFilesystem) Volume() (names.VolumeTag, error) {
 	return names.VolumeTag{}, state.ErrNoBackingVolume
 }
 
+func (m *mockFilesystem) Params() (state.FilesystemParams, bool) {
+	return state.FilesystemParams{
+		Pool: "loop",
+		Size: 1024,
+	}, true
+}
+
 func (m *mockFilesystem) Info() (state.FilesystemInfo, error) {
 	if m.info != nil {
 		return *m.info, nil
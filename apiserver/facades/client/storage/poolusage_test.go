@@ -0,0 +1,106 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type poolUsageSuite struct {
+	baseStorageSuite
+}
+
+var _ = gc.Suite(&poolUsageSuite{})
+
+func (s *poolUsageSuite) TestPoolUsageAggregates(c *gc.C) {
+	s.volume.info = &state.VolumeInfo{Pool: "ebs", Size: 1024}
+	s.filesystem.info = &state.FilesystemInfo{Pool: "rootfs", Size: 512}
+
+	results, err := s.api.PoolUsage()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.StoragePoolUsage{{
+		Name:         "ebs",
+		VolumeCount:  1,
+		TotalSizeMiB: 1024,
+		Consumers: []params.StoragePoolConsumer{{
+			OwnerTag:     s.unitTag.String(),
+			VolumeCount:  1,
+			TotalSizeMiB: 1024,
+		}},
+	}, {
+		Name:            "rootfs",
+		FilesystemCount: 1,
+		TotalSizeMiB:    512,
+		Consumers: []params.StoragePoolConsumer{{
+			OwnerTag:        s.unitTag.String(),
+			FilesystemCount: 1,
+			TotalSizeMiB:    512,
+		}},
+	}})
+}
+
+func (s *poolUsageSuite) TestPoolUsageSamePool(c *gc.C) {
+	s.volume.info = &state.VolumeInfo{Pool: "ebs", Size: 1024}
+	s.filesystem.info = &state.FilesystemInfo{Pool: "ebs", Size: 512}
+
+	results, err := s.api.PoolUsage()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.StoragePoolUsage{{
+		Name:            "ebs",
+		VolumeCount:     1,
+		FilesystemCount: 1,
+		TotalSizeMiB:    1536,
+		Consumers: []params.StoragePoolConsumer{{
+			OwnerTag:        s.unitTag.String(),
+			VolumeCount:     1,
+			FilesystemCount: 1,
+			TotalSizeMiB:    1536,
+		}},
+	}})
+}
+
+func (s *poolUsageSuite) TestPoolUsageUnprovisionedVolume(c *gc.C) {
+	// An unprovisioned volume counts against the pool named in its
+	// params, but contributes no provisioned capacity.
+	s.filesystem.info = &state.FilesystemInfo{Pool: "rootfs", Size: 512}
+
+	results, err := s.api.PoolUsage()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0], gc.DeepEquals, params.StoragePoolUsage{
+		Name:        "loop",
+		VolumeCount: 1,
+		Consumers: []params.StoragePoolConsumer{{
+			OwnerTag:    s.unitTag.String(),
+			VolumeCount: 1,
+		}},
+	})
+}
+
+func (s *poolUsageSuite) TestPoolUsageVolumeBackedFilesystem(c *gc.C) {
+	// A filesystem backed by a juju-managed volume has its capacity
+	// attributed to the backing volume.
+	s.volume.info = &state.VolumeInfo{Pool: "ebs", Size: 1024}
+	s.filesystem.info = &state.FilesystemInfo{Pool: "ebs", Size: 1024}
+	s.filesystem.volume = &s.volumeTag
+
+	results, err := s.api.PoolUsage()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.DeepEquals, []params.StoragePoolUsage{{
+		Name:            "ebs",
+		VolumeCount:     1,
+		FilesystemCount: 1,
+		TotalSizeMiB:    1024,
+		Consumers: []params.StoragePoolConsumer{{
+			OwnerTag:        s.unitTag.String(),
+			VolumeCount:     1,
+			FilesystemCount: 1,
+			TotalSizeMiB:    1024,
+		}},
+	}})
+}
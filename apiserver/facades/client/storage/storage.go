@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"sort"
 	"time"
 
 	"github.com/juju/collections/set"
@@ -999,6 +1000,60 @@ func (a *StorageAPI) attachStorage(storageTag names.StorageTag, unitTag names.Un
 	return a.storageAccess.AttachStorage(storageTag, unitTag)
 }
 
+// Retry returns volumes and filesystems whose provisioning failed
+// permanently to pending status, so that provisioning is attempted
+// again. Entities that are not currently in error are rejected.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) Retry(args params.Entities) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.backend)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	retryOne := func(arg params.Entity) error {
+		tag, err := names.ParseTag(arg.Tag)
+		if err != nil {
+			return err
+		}
+		var entity interface {
+			status.StatusGetter
+			status.StatusSetter
+		}
+		switch tag := tag.(type) {
+		case names.VolumeTag:
+			entity, err = a.storageAccess.VolumeAccess().Volume(tag)
+		case names.FilesystemTag:
+			entity, err = a.storageAccess.FilesystemAccess().Filesystem(tag)
+		default:
+			return errors.NotSupportedf("retrying provisioning of %s", names.ReadableString(tag))
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		current, err := entity.Status()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if current.Status != status.Error {
+			return errors.Errorf("%s is not in error", names.ReadableString(tag))
+		}
+		return entity.SetStatus(status.StatusInfo{
+			Status:  status.Pending,
+			Message: "provisioning retry requested",
+		})
+	}
+
+	result := make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		result[i].Error = apiservererrors.ServerError(retryOne(arg))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) Import(args params.BulkImportStorageParams) (params.ImportStorageResults, error) {
@@ -1164,6 +1219,174 @@ func (a *StorageAPI) UpdatePool(p params.StoragePoolArgs) (params.ErrorResults,
 	return results, nil
 }
 
+// PoolUsage returns, for each storage pool in use in the model, the
+// number of volumes and filesystems allocated from it, the total
+// provisioned capacity, and an attribution of that usage to the
+// applications and units owning the storage. Aggregation is done
+// server-side so capacity planning tooling does not have to pull the
+// full volume and filesystem lists.
+func (a *StorageAPI) PoolUsage() (params.StoragePoolUsageResults, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.StoragePoolUsageResults{}, errors.Trace(err)
+	}
+
+	agg := newPoolUsageAggregator(a.storageAccess)
+	if stVolume := a.storageAccess.VolumeAccess(); stVolume != nil {
+		volumes, err := stVolume.AllVolumes()
+		if err != nil {
+			return params.StoragePoolUsageResults{}, errors.Trace(err)
+		}
+		for _, v := range volumes {
+			if err := agg.addVolume(v); err != nil {
+				return params.StoragePoolUsageResults{}, errors.Trace(err)
+			}
+		}
+	}
+	if stFile := a.storageAccess.FilesystemAccess(); stFile != nil {
+		filesystems, err := stFile.AllFilesystems()
+		if err != nil {
+			return params.StoragePoolUsageResults{}, errors.Trace(err)
+		}
+		for _, f := range filesystems {
+			if err := agg.addFilesystem(f); err != nil {
+				return params.StoragePoolUsageResults{}, errors.Trace(err)
+			}
+		}
+	}
+	return params.StoragePoolUsageResults{Results: agg.results()}, nil
+}
+
+// poolUsageAggregator accumulates per-pool usage from the model's
+// volumes and filesystems.
+type poolUsageAggregator struct {
+	st        storageAccess
+	pools     map[string]*params.StoragePoolUsage
+	consumers map[string]map[string]*params.StoragePoolConsumer
+}
+
+func newPoolUsageAggregator(st storageAccess) *poolUsageAggregator {
+	return &poolUsageAggregator{
+		st:        st,
+		pools:     make(map[string]*params.StoragePoolUsage),
+		consumers: make(map[string]map[string]*params.StoragePoolConsumer),
+	}
+}
+
+func (agg *poolUsageAggregator) addVolume(v state.Volume) error {
+	var pool string
+	var size uint64
+	if info, err := v.Info(); err == nil {
+		pool, size = info.Pool, info.Size
+	} else if volParams, ok := v.Params(); ok {
+		// Unprovisioned volumes count against the pool, but
+		// contribute no provisioned capacity.
+		pool = volParams.Pool
+	} else {
+		return nil
+	}
+	owner, err := agg.storageOwner(v.StorageInstance())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	agg.add(pool, owner, 1, 0, size)
+	return nil
+}
+
+func (agg *poolUsageAggregator) addFilesystem(f state.Filesystem) error {
+	var pool string
+	var size uint64
+	if info, err := f.Info(); err == nil {
+		pool, size = info.Pool, info.Size
+	} else if fsParams, ok := f.Params(); ok {
+		pool = fsParams.Pool
+	} else {
+		return nil
+	}
+	// A filesystem backed by a juju-managed volume has its capacity
+	// attributed to the backing volume; count only the filesystem.
+	if _, err := f.Volume(); err == nil {
+		size = 0
+	}
+	owner, err := agg.storageOwner(f.Storage())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	agg.add(pool, owner, 0, 1, size)
+	return nil
+}
+
+// storageOwner resolves the owner tag of the storage instance a volume
+// or filesystem is assigned to. It returns an empty string for
+// machine-scoped storage not assigned to any storage instance.
+func (agg *poolUsageAggregator) storageOwner(storageTag names.StorageTag, err error) (string, error) {
+	if errors.IsNotAssigned(err) || errors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	instance, err := agg.st.StorageInstance(storageTag)
+	if errors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	owner, ok := instance.Owner()
+	if !ok {
+		return "", nil
+	}
+	return owner.String(), nil
+}
+
+func (agg *poolUsageAggregator) add(pool, owner string, volumes, filesystems int, size uint64) {
+	if pool == "" {
+		return
+	}
+	usage, ok := agg.pools[pool]
+	if !ok {
+		usage = &params.StoragePoolUsage{Name: pool}
+		agg.pools[pool] = usage
+		agg.consumers[pool] = make(map[string]*params.StoragePoolConsumer)
+	}
+	usage.VolumeCount += volumes
+	usage.FilesystemCount += filesystems
+	usage.TotalSizeMiB += size
+	if owner == "" {
+		return
+	}
+	consumer, ok := agg.consumers[pool][owner]
+	if !ok {
+		consumer = &params.StoragePoolConsumer{OwnerTag: owner}
+		agg.consumers[pool][owner] = consumer
+	}
+	consumer.VolumeCount += volumes
+	consumer.FilesystemCount += filesystems
+	consumer.TotalSizeMiB += size
+}
+
+// results returns the aggregated usage, sorted by pool name, with each
+// pool's consumers sorted by owner tag.
+func (agg *poolUsageAggregator) results() []params.StoragePoolUsage {
+	names := make([]string, 0, len(agg.pools))
+	for name := range agg.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	results := make([]params.StoragePoolUsage, len(names))
+	for i, name := range names {
+		usage := *agg.pools[name]
+		owners := make([]string, 0, len(agg.consumers[name]))
+		for owner := range agg.consumers[name] {
+			owners = append(owners, owner)
+		}
+		sort.Strings(owners)
+		for _, owner := range owners {
+			usage.Consumers = append(usage.Consumers, *agg.consumers[name][owner])
+		}
+		results[i] = usage
+	}
+	return results
+}
+
 // Mask out old methods from the new API versions. The API reflection
 // code in rpc/rpcreflect/type.go:newMethod skips 2-argument methods,
 // so this removes the method as far as the RPC machinery is concerned.
@@ -1171,6 +1394,9 @@ func (a *StorageAPI) UpdatePool(p params.StoragePoolArgs) (params.ErrorResults,
 // DetachStorage added in v6 api version
 func (*StorageAPIv5) DetachStorage(_, _ struct{}) {}
 
+// Retry isn't on the v5 API.
+func (*StorageAPIv5) Retry(_, _ struct{}) {}
+
 // RemovePool etc added in v5 api version
 func (*StorageAPIv4) RemovePool(_, _ struct{}) {}
 func (*StorageAPIv4) UpdatePool(_, _ struct{}) {}
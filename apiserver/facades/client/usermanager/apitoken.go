@@ -0,0 +1,154 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// checkCanManageTokens implements the permission rule for personal
+// access tokens: users manage their own tokens, admins can manage
+// anyone's.
+func (api *UserManagerAPI) checkCanManageTokens(userTag names.UserTag) error {
+	isSuperUser, err := api.hasControllerAdminAccess()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if api.apiUser != userTag && !api.isAdmin && !isSuperUser {
+		return errors.Trace(apiservererrors.ErrPerm)
+	}
+	return nil
+}
+
+// CreateAPIToken mints personal access tokens for the specified users.
+// The full token credential is returned once and cannot be recovered
+// later.
+func (api *UserManagerAPI) CreateAPIToken(args params.CreateAPITokenArgs) (params.CreateAPITokenResults, error) {
+	var result params.CreateAPITokenResults
+
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	if len(args.Tokens) == 0 {
+		return result, nil
+	}
+
+	result.Results = make([]params.CreateAPITokenResult, len(args.Tokens))
+	for i, arg := range args.Tokens {
+		token, err := api.createAPIToken(arg)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Token = token
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) createAPIToken(arg params.CreateAPITokenArg) (string, error) {
+	userTag, err := names.ParseUserTag(arg.Tag)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := api.checkCanManageTokens(userTag); err != nil {
+		return "", errors.Trace(err)
+	}
+	_, credential, err := api.state.AddAPIToken(state.AddAPITokenArgs{
+		Name:   arg.Name,
+		User:   userTag,
+		Models: arg.Models,
+		Access: permission.Access(arg.Access),
+		Expiry: arg.Expiry,
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to create API token")
+	}
+	return credential, nil
+}
+
+// ListAPITokens returns the personal access tokens of the specified
+// users, without their secrets.
+func (api *UserManagerAPI) ListAPITokens(args params.Entities) (params.APITokenResults, error) {
+	var result params.APITokenResults
+
+	if len(args.Entities) == 0 {
+		return result, nil
+	}
+
+	result.Results = make([]params.APITokenResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		tokens, err := api.listAPITokens(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Tokens = tokens
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) listAPITokens(tag string) ([]params.APITokenInfo, error) {
+	userTag, err := names.ParseUserTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := api.checkCanManageTokens(userTag); err != nil {
+		return nil, errors.Trace(err)
+	}
+	tokens, err := api.state.APITokens(userTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	infos := make([]params.APITokenInfo, len(tokens))
+	for i, token := range tokens {
+		infos[i] = params.APITokenInfo{
+			Name:    token.Name(),
+			Models:  token.Models(),
+			Access:  string(token.Access()),
+			Created: token.Created(),
+			Expiry:  token.Expiry(),
+		}
+	}
+	return infos, nil
+}
+
+// RevokeAPIToken revokes the named personal access tokens. Logins
+// presenting a revoked token fail immediately.
+func (api *UserManagerAPI) RevokeAPIToken(args params.RevokeAPITokenArgs) (params.ErrorResults, error) {
+	var result params.ErrorResults
+
+	if err := api.check.ChangeAllowed(); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	if len(args.Tokens) == 0 {
+		return result, nil
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Tokens))
+	for i, arg := range args.Tokens {
+		if err := api.revokeAPIToken(arg); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) revokeAPIToken(arg params.RevokeAPITokenArg) error {
+	userTag, err := names.ParseUserTag(arg.Tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := api.checkCanManageTokens(userTag); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(api.state.RemoveAPIToken(userTag, arg.Name))
+}
@@ -24,10 +24,23 @@ func Register(registry facade.FacadeRegistry) {
 		return newUserManagerAPI(ctx) // Adds ResetPassword
 	}, reflect.TypeOf((*UserManagerAPI)(nil)))
 	registry.MustRegister("UserManager", 3, func(ctx facade.Context) (facade.Facade, error) {
-		return newUserManagerAPI(ctx) // Adds ModelUserInfo
+		return newUserManagerAPIV3(ctx) // Adds ModelUserInfo
+	}, reflect.TypeOf((*UserManagerAPIV3)(nil)))
+	registry.MustRegister("UserManager", 4, func(ctx facade.Context) (facade.Facade, error) {
+		return newUserManagerAPI(ctx) // Adds CreateAPIToken, ListAPITokens, RevokeAPIToken
 	}, reflect.TypeOf((*UserManagerAPI)(nil)))
 }
 
+// newUserManagerAPIV3 provides the signature required for the v3 facade
+// registration.
+func newUserManagerAPIV3(ctx facade.Context) (*UserManagerAPIV3, error) {
+	api, err := newUserManagerAPI(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &UserManagerAPIV3{api}, nil
+}
+
 // newUserManagerAPI provides the signature required for facade registration.
 func newUserManagerAPI(ctx facade.Context) (*UserManagerAPI, error) {
 	authorizer := ctx.Auth()
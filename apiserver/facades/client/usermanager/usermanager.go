@@ -31,6 +31,21 @@ type UserManagerAPI struct {
 	isAdmin    bool
 }
 
+// UserManagerAPIV3 implements the v3 usermanager interface, which does
+// not have the personal access token methods added in v4.
+type UserManagerAPIV3 struct {
+	*UserManagerAPI
+}
+
+// CreateAPIToken isn't on the v3 API.
+func (*UserManagerAPIV3) CreateAPIToken(_ struct{}) {}
+
+// ListAPITokens isn't on the v3 API.
+func (*UserManagerAPIV3) ListAPITokens(_ struct{}) {}
+
+// RevokeAPIToken isn't on the v3 API.
+func (*UserManagerAPIV3) RevokeAPIToken(_ struct{}) {}
+
 func (api *UserManagerAPI) hasControllerAdminAccess() (bool, error) {
 	isAdmin, err := api.authorizer.HasPermission(permission.SuperuserAccess, api.state.ControllerTag())
 	if errors.IsNotFound(err) {
@@ -26,6 +26,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/docker"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
@@ -43,6 +44,7 @@ type mockState struct {
 	app                     *mockApplication
 	resource                *mockResources
 	operatorRepo            string
+	mirrorRepo              string
 	controllerConfigWatcher *statetesting.MockNotifyWatcher
 }
 
@@ -67,6 +69,9 @@ func (st *mockState) WatchApplications() state.StringsWatcher {
 func (st *mockState) ControllerConfig() (controller.Config, error) {
 	cfg := coretesting.FakeControllerConfig()
 	cfg[controller.CAASImageRepo] = st.operatorRepo
+	if st.mirrorRepo != "" {
+		cfg[controller.CAASImageMirrorRepo] = st.mirrorRepo
+	}
 	return cfg, nil
 }
 
@@ -121,6 +126,20 @@ func (m *mockResources) OpenResource(applicationID string, name string) (resourc
 	return resources.Resource{}, ioutil.NopCloser(bytes.NewBuffer(out)), err
 }
 
+type mockImageMirrorer struct {
+	testing.Stub
+	mirror   docker.ImageRepoDetails
+	mirrored string
+}
+
+func (m *mockImageMirrorer) Mirror(sourcePath string, auth docker.BasicAuthConfig) (string, error) {
+	m.MethodCall(m, "Mirror", sourcePath, auth)
+	if err := m.NextErr(); err != nil {
+		return "", err
+	}
+	return m.mirrored, nil
+}
+
 type mockStorageRegistry struct {
 	storage.ProviderRegistry
 }
@@ -33,6 +33,8 @@ import (
 	"github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/series"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/docker"
+	"github.com/juju/juju/docker/imagemirror"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/resource"
@@ -66,6 +68,7 @@ type API struct {
 	ctrlSt             CAASApplicationControllerState
 	state              CAASApplicationProvisionerState
 	newResourceOpener  NewResourceOpenerFunc
+	newImageMirrorer   imagemirror.NewMirrorerFunc
 	storage            StorageBackend
 	storagePoolManager poolmanager.PoolManager
 	registry           storage.ProviderRegistry
@@ -116,6 +119,7 @@ func NewStateCAASApplicationProvisionerAPI(ctx facade.Context) (*APIGroup, error
 		stateShim{st},
 		ctx.Resources(),
 		newResourceOpener,
+		imagemirror.New,
 		authorizer,
 		sb,
 		pm,
@@ -164,6 +168,7 @@ func NewCAASApplicationProvisionerAPI(
 	st CAASApplicationProvisionerState,
 	resources facade.Resources,
 	newResourceOpener NewResourceOpenerFunc,
+	newImageMirrorer imagemirror.NewMirrorerFunc,
 	authorizer facade.Authorizer,
 	sb StorageBackend,
 	storagePoolManager poolmanager.PoolManager,
@@ -178,6 +183,7 @@ func NewCAASApplicationProvisionerAPI(
 		auth:               authorizer,
 		resources:          resources,
 		newResourceOpener:  newResourceOpener,
+		newImageMirrorer:   newImageMirrorer,
 		ctrlSt:             ctrlSt,
 		state:              st,
 		storage:            sb,
@@ -775,6 +781,15 @@ func (a *API) ApplicationOCIResources(args params.Entities) (params.CAASApplicat
 	res := params.CAASApplicationOCIResourceResults{
 		Results: make([]params.CAASApplicationOCIResourceResult, len(args.Entities)),
 	}
+	cfg, err := a.ctrlSt.ControllerConfig()
+	if err != nil {
+		return params.CAASApplicationOCIResourceResults{}, errors.Trace(err)
+	}
+	var mirrorer imagemirror.Mirrorer
+	mirrorRepo := cfg.CAASImageMirrorRepo()
+	if !mirrorRepo.Empty() {
+		mirrorer = a.newImageMirrorer(mirrorRepo)
+	}
 	for i, entity := range args.Entities {
 		appTag, err := names.ParseApplicationTag(entity.Tag)
 		if err != nil {
@@ -814,6 +829,14 @@ func (a *API) ApplicationOCIResources(args params.Entities) (params.CAASApplicat
 				res.Results[i].Error = apiservererrors.ServerError(err)
 				break
 			}
+			if mirrorer != nil {
+				rsc, err = mirrorImageResource(mirrorer, mirrorRepo, rsc)
+				if err != nil {
+					res.Results[i].Error = apiservererrors.ServerError(
+						errors.Annotatef(err, "mirroring image resource %q", v.Name))
+					break
+				}
+			}
 			imageResources.Images[v.Name] = rsc
 		}
 		if res.Results[i].Error != nil {
@@ -824,6 +847,25 @@ func (a *API) ApplicationOCIResources(args params.Entities) (params.CAASApplicat
 	return res, nil
 }
 
+// mirrorImageResource copies the image resource into the mirror repo
+// and returns details pointing the broker at the mirrored reference.
+func mirrorImageResource(
+	mirrorer imagemirror.Mirrorer, mirrorRepo docker.ImageRepoDetails, rsc params.DockerImageInfo,
+) (params.DockerImageInfo, error) {
+	mirrored, err := mirrorer.Mirror(rsc.RegistryPath, docker.BasicAuthConfig{
+		Username: rsc.Username,
+		Password: rsc.Password,
+	})
+	if err != nil {
+		return params.DockerImageInfo{}, errors.Trace(err)
+	}
+	return params.DockerImageInfo{
+		RegistryPath: mirrored,
+		Username:     mirrorRepo.Username,
+		Password:     mirrorRepo.Password,
+	}, nil
+}
+
 func readDockerImageResource(reader io.Reader) (params.DockerImageInfo, error) {
 	var details resources.DockerImageDetails
 	contents, err := ioutil.ReadAll(reader)
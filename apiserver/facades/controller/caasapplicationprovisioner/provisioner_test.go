@@ -24,6 +24,7 @@ import (
 	jujuresource "github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/docker"
+	"github.com/juju/juju/docker/imagemirror"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
@@ -41,6 +42,7 @@ type CAASApplicationProvisionerSuite struct {
 	authorizer         *apiservertesting.FakeAuthorizer
 	api                *caasapplicationprovisioner.API
 	st                 *mockState
+	mirrorer           *mockImageMirrorer
 	storage            *mockStorage
 	storagePoolManager *mockStoragePoolManager
 	registry           *mockStorageRegistry
@@ -71,8 +73,13 @@ func (s *CAASApplicationProvisionerSuite) SetUpTest(c *gc.C) {
 	newResourceOpener := func(appName string) (jujuresource.Opener, error) {
 		return &mockResourceOpener{appName: appName, resources: s.st.resource}, nil
 	}
+	s.mirrorer = &mockImageMirrorer{}
+	newImageMirrorer := func(mirror docker.ImageRepoDetails) imagemirror.Mirrorer {
+		s.mirrorer.mirror = mirror
+		return s.mirrorer
+	}
 	api, err := caasapplicationprovisioner.NewCAASApplicationProvisionerAPI(
-		s.st, s.st, s.resources, newResourceOpener, s.authorizer, s.storage, s.storagePoolManager, s.registry, s.clock)
+		s.st, s.st, s.resources, newResourceOpener, newImageMirrorer, s.authorizer, s.storage, s.storagePoolManager, s.registry, s.clock)
 	c.Assert(err, jc.ErrorIsNil)
 	s.api = api
 }
@@ -82,7 +89,7 @@ func (s *CAASApplicationProvisionerSuite) TestPermission(c *gc.C) {
 		Tag: names.NewMachineTag("0"),
 	}
 	_, err := caasapplicationprovisioner.NewCAASApplicationProvisionerAPI(
-		s.st, s.st, s.resources, nil, s.authorizer, s.storage, s.storagePoolManager, s.registry, s.clock)
+		s.st, s.st, s.resources, nil, nil, s.authorizer, s.storage, s.storagePoolManager, s.registry, s.clock)
 	c.Assert(err, gc.ErrorMatches, "permission denied")
 }
 
@@ -568,6 +575,63 @@ func (s *CAASApplicationProvisionerSuite) TestApplicationOCIResources(c *gc.C) {
 	})
 }
 
+func (s *CAASApplicationProvisionerSuite) TestApplicationOCIResourcesMirrored(c *gc.C) {
+	s.st.app = &mockApplication{
+		tag:  names.NewApplicationTag("gitlab"),
+		life: state.Alive,
+		charm: &mockCharm{
+			meta: &charm.Meta{
+				Resources: map[string]charmresource.Meta{
+					"gitlab-image": {
+						Name: "gitlab-image",
+						Type: charmresource.TypeContainerImage,
+					},
+				},
+			},
+			url: &charm.URL{
+				Schema:   "cs",
+				Name:     "gitlab",
+				Revision: -1,
+			},
+		},
+	}
+	s.st.resource = &mockResources{
+		resource: &resources.DockerImageDetails{
+			RegistryPath: "gitlab:latest",
+			ImageRepoDetails: docker.ImageRepoDetails{
+				BasicAuthConfig: docker.BasicAuthConfig{
+					Username: "jujuqa",
+					Password: "pwd",
+				},
+			},
+		},
+	}
+	s.st.mirrorRepo = `{"repository":"registry.local/jujucache","username":"cacheuser","password":"cachepwd"}`
+	s.mirrorer.mirrored = "registry.local/jujucache/gitlab:latest"
+
+	result, err := s.api.ApplicationOCIResources(params.Entities{
+		Entities: []params.Entity{{
+			Tag: "application-gitlab",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[0].Result, gc.DeepEquals, &params.CAASApplicationOCIResources{
+		Images: map[string]params.DockerImageInfo{
+			"gitlab-image": {
+				RegistryPath: "registry.local/jujucache/gitlab:latest",
+				Username:     "cacheuser",
+				Password:     "cachepwd",
+			},
+		},
+	})
+	s.mirrorer.CheckCall(c, 0, "Mirror", "gitlab:latest", docker.BasicAuthConfig{
+		Username: "jujuqa",
+		Password: "pwd",
+	})
+	c.Assert(s.mirrorer.mirror.Repository, gc.Equals, "registry.local/jujucache")
+}
+
 func (s *CAASApplicationProvisionerSuite) TestUpdateApplicationsUnitsWithStorage(c *gc.C) {
 	s.st.app = &mockApplication{
 		tag:  names.NewApplicationTag("gitlab"),
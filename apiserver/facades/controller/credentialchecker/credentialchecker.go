@@ -0,0 +1,81 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package credentialchecker records the credential validity data
+// gathered by the credential checker worker and exposes it to clients
+// querying the health of a model's cloud credential.
+package credentialchecker
+
+import (
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// API serves the CredentialChecker facade for a model.
+type API struct {
+	model        *state.Model
+	isController bool
+}
+
+// NewFacade provides the signature required for facade registration.
+// The facade is used by the credential checker worker, and may also be
+// queried by any user with read access to the model.
+func NewFacade(ctx facade.Context) (*API, error) {
+	authorizer := ctx.Auth()
+	model, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	isController := authorizer.AuthController()
+	if !isController {
+		if !authorizer.AuthClient() {
+			return nil, apiservererrors.ErrPerm
+		}
+		canRead, err := authorizer.HasPermission(permission.ReadAccess, model.ModelTag())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !canRead {
+			return nil, apiservererrors.ErrPerm
+		}
+	}
+	return &API{model: model, isController: isController}, nil
+}
+
+// ReportCredentialExpiry records the provider-reported expiry time of
+// the model's cloud credential. It is used by the credential checker
+// worker.
+func (api *API) ReportCredentialExpiry(args params.CredentialExpiryArg) error {
+	if !api.isController {
+		return apiservererrors.ErrPerm
+	}
+	tag, ok := api.model.CloudCredentialTag()
+	if !ok {
+		// The model runs on a cloud with no credential; there is
+		// nothing to record.
+		return nil
+	}
+	return errors.Trace(api.model.State().SetCloudCredentialExpiry(tag, args.Expiry))
+}
+
+// CredentialStatus returns the recorded validity and expiry data for
+// the model's cloud credential.
+func (api *API) CredentialStatus() (params.CredentialCheckResult, error) {
+	credential, ok, err := api.model.CloudCredential()
+	if err != nil {
+		return params.CredentialCheckResult{}, errors.Trace(err)
+	}
+	if !ok {
+		return params.CredentialCheckResult{Valid: true}, nil
+	}
+	return params.CredentialCheckResult{
+		Valid:         credential.IsValid(),
+		InvalidReason: credential.InvalidReason,
+		Expiry:        credential.Expiry,
+	}, nil
+}
@@ -0,0 +1,17 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package credentialchecker
+
+import (
+	"reflect"
+
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("CredentialChecker", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return NewFacade(ctx)
+	}, reflect.TypeOf((*API)(nil)))
+}
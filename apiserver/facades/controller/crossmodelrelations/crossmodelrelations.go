@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/apiserver/common/firewall"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	corecrossmodel "github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/life"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/rpc/params"
@@ -196,6 +197,15 @@ func (api *CrossModelRelationsAPI) registerRemoteRelation(relation params.Regist
 		return nil, errors.NotFoundf("relation endpoint %v", relation.LocalEndpointName)
 	}
 
+	// The consumer records the interface it was offered, possibly pinned
+	// to a specific version. Refuse to register the relation if the charm
+	// behind the offer no longer declares a compatible interface.
+	if err := corecrossmodel.CheckCompatibleInterface(
+		relation.LocalEndpointName, localEndpoint.Interface, relation.RemoteEndpoint.Interface,
+	); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	// Add the remote application reference.
 	// We construct a unique, opaque application name based on the token passed
 	// in from the consuming model. This model, which is offering the
@@ -205,8 +215,11 @@ func (api *CrossModelRelationsAPI) registerRemoteRelation(relation params.Regist
 	remoteEndpoint := state.Endpoint{
 		ApplicationName: uniqueRemoteApplicationName,
 		Relation: charm.Relation{
-			Name:      relation.RemoteEndpoint.Name,
-			Interface: relation.RemoteEndpoint.Interface,
+			Name: relation.RemoteEndpoint.Name,
+			// Use the offering charm's interface: a compatible pinned
+			// interface may differ textually (eg unversioned) and the
+			// relation endpoints must agree to be joined.
+			Interface: localEndpoint.Interface,
 			Role:      relation.RemoteEndpoint.Role,
 		},
 	}
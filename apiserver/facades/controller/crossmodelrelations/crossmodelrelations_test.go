@@ -263,6 +263,8 @@ func (s *crossmodelRelationsSuite) assertRegisterRemoteRelations(c *gc.C) {
 	c.Assert(result.Error, gc.IsNil)
 	c.Check(result.Result.Token, gc.Equals, "token-offered")
 	declared := checkers.InferDeclared(nil, macaroon.Slice{result.Result.Macaroon})
+	c.Check(declared["issued-at"], gc.Not(gc.Equals), "")
+	delete(declared, "issued-at")
 	c.Assert(declared, jc.DeepEquals, map[string]string{
 		"source-model-uuid": "deadbeef-0bad-400d-8000-4b1d0d06f00d",
 		"relation-key":      "offeredapp:local remote-apptoken:remote",
@@ -270,12 +272,13 @@ func (s *crossmodelRelationsSuite) assertRegisterRemoteRelations(c *gc.C) {
 		"offer-uuid":        "offer-uuid",
 	})
 	cav := result.Result.Macaroon.Caveats()
-	c.Check(cav, gc.HasLen, 5)
+	c.Check(cav, gc.HasLen, 6)
 	c.Check(bytes.HasPrefix(cav[0].Id, []byte("time-before ")), jc.IsTrue)
 	c.Check(cav[1].Id, jc.DeepEquals, []byte("declared source-model-uuid deadbeef-0bad-400d-8000-4b1d0d06f00d"))
 	c.Check(cav[2].Id, jc.DeepEquals, []byte("declared offer-uuid offer-uuid"))
 	c.Check(cav[3].Id, jc.DeepEquals, []byte("declared username mary"))
 	c.Check(cav[4].Id, jc.DeepEquals, []byte("declared relation-key offeredapp:local remote-apptoken:remote"))
+	c.Check(bytes.HasPrefix(cav[5].Id, []byte("declared issued-at ")), jc.IsTrue)
 
 	expectedRemoteApp := s.st.remoteApplications["remote-apptoken"]
 	expectedRemoteApp.Stub = testing.Stub{} // don't care about api calls
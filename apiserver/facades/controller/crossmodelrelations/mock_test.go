@@ -82,6 +82,10 @@ func (st *mockState) ApplicationOfferForUUID(offerUUID string) (*crossmodel.Appl
 	return offer, nil
 }
 
+func (st *mockState) OfferMacaroonsRotatedAt(offerUUID string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
 func (st *mockState) ApplicationOffer(offerName string) (*crossmodel.ApplicationOffer, error) {
 	for _, offer := range st.offers {
 		if offer.OfferName == offerName {
@@ -385,6 +385,27 @@ func (a *InstancePollerAPI) SetInstanceStatus(args params.SetStatus) (params.Err
 	return result, nil
 }
 
+// SetProviderMetadata records provider-sourced instance metadata, such
+// as an image end-of-life date or the current spot price, for each
+// given machine. Only machine tags are accepted.
+func (a *InstancePollerAPI) SetProviderMetadata(args params.SetProviderMetadataArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canAccess, err := a.accessMachine()
+	if err != nil {
+		return result, err
+	}
+	for i, arg := range args.Args {
+		machine, err := a.getOneMachine(arg.Tag, canAccess)
+		if err == nil {
+			err = machine.SetProviderMetadata(arg.Metadata)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
 // AreManuallyProvisioned returns whether each given entity is
 // manually provisioned or not. Only machine tags are accepted.
 func (a *InstancePollerAPI) AreManuallyProvisioned(args params.Entities) (params.BoolResults, error) {
@@ -405,10 +426,19 @@ func (a *InstancePollerAPI) AreManuallyProvisioned(args params.Entities) (params
 	return result, nil
 }
 
+// InstancePollerAPIV4 implements the V4 API used by the instance poller
+// worker. Compared to V5, it lacks the SetProviderMetadata method.
+type InstancePollerAPIV4 struct {
+	*InstancePollerAPI
+}
+
+// SetProviderMetadata is not available in V4.
+func (*InstancePollerAPIV4) SetProviderMetadata(_, _ struct{}) {}
+
 // InstancePollerAPIV3 implements the V3 API used by the instance poller
 // worker. Compared to V4, it lacks the SetProviderNetworkConfig method.
 type InstancePollerAPIV3 struct {
-	*InstancePollerAPI
+	*InstancePollerAPIV4
 }
 
 // SetProviderNetworkConfig is not available in V3.
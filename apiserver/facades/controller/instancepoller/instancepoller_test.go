@@ -699,6 +699,54 @@ func (s *InstancePollerSuite) TestSetInstanceStatusFailure(c *gc.C) {
 	s.st.CheckMachineCall(c, 3, "3")
 }
 
+func (s *InstancePollerSuite) TestSetProviderMetadataSuccess(c *gc.C) {
+	s.st.SetMachineInfo(c, machineInfo{id: "1"})
+
+	metadata := map[string]string{
+		"image-eol":  "2024-04-30",
+		"spot-price": "0.0464",
+	}
+	result, err := s.api.SetProviderMetadata(params.SetProviderMetadataArgs{
+		Args: []params.SetProviderMetadataArg{
+			{Tag: "machine-1", Metadata: metadata},
+			{Tag: "machine-42", Metadata: metadata},
+			{Tag: "application-unknown"},
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: nil},
+			{Error: apiservertesting.NotFoundError("machine 42")},
+			{Error: apiservertesting.ServerError(`"application-unknown" is not a valid machine tag`)},
+		}},
+	)
+
+	s.st.CheckMachineCall(c, 0, "1")
+	s.st.CheckCall(c, 1, "SetProviderMetadata", metadata)
+	s.st.CheckMachineCall(c, 2, "42")
+}
+
+func (s *InstancePollerSuite) TestSetProviderMetadataFailure(c *gc.C) {
+	s.st.SetErrors(
+		nil,                // m1 := FindEntity("1")
+		errors.New("FAIL"), // m1.SetProviderMetadata()
+	)
+	s.st.SetMachineInfo(c, machineInfo{id: "1"})
+
+	result, err := s.api.SetProviderMetadata(params.SetProviderMetadataArgs{
+		Args: []params.SetProviderMetadataArg{
+			{Tag: "machine-1", Metadata: map[string]string{"image-eol": "2024-04-30"}},
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: apiservertesting.ServerError("FAIL")},
+		}},
+	)
+}
+
 func (s *InstancePollerSuite) TestAreManuallyProvisionedSuccess(c *gc.C) {
 	s.st.SetMachineInfo(c, machineInfo{id: "1", isManual: true})
 	s.st.SetMachineInfo(c, machineInfo{id: "2", isManual: false})
@@ -269,6 +269,7 @@ type machineInfo struct {
 	status            status.StatusInfo
 	instanceStatus    status.StatusInfo
 	providerAddresses []network.SpaceAddress
+	providerMetadata  map[string]string
 	life              state.Life
 	isManual          bool
 
@@ -393,6 +394,19 @@ func (m *mockMachine) SetInstanceStatus(instanceStatus status.StatusInfo) error
 	return nil
 }
 
+// SetProviderMetadata implements StateMachine.
+func (m *mockMachine) SetProviderMetadata(metadata map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.MethodCall(m, "SetProviderMetadata", metadata)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	m.providerMetadata = metadata
+	return nil
+}
+
 // Life implements StateMachine.
 func (m *mockMachine) Life() state.Life {
 	m.mu.Lock()
@@ -17,24 +17,31 @@ func Register(registry facade.FacadeRegistry) {
 		return newFacadeV3(ctx)
 	}, reflect.TypeOf((*InstancePollerAPIV3)(nil)))
 	registry.MustRegister("InstancePoller", 4, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacadeV4(ctx)
+	}, reflect.TypeOf((*InstancePollerAPIV4)(nil)))
+	registry.MustRegister("InstancePoller", 5, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacade(ctx)
 	}, reflect.TypeOf((*InstancePollerAPI)(nil)))
 }
 
 // newFacadeV3 creates a new instance of the V3 InstancePoller API.
 func newFacadeV3(ctx facade.Context) (*InstancePollerAPIV3, error) {
-	st := ctx.State()
-	m, err := st.Model()
+	api, err := newFacadeV4(ctx)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return nil, err
 	}
 
-	api, err := NewInstancePollerAPI(st, m, ctx.Resources(), ctx.Auth(), clock.WallClock)
+	return &InstancePollerAPIV3{api}, nil
+}
+
+// newFacadeV4 creates a new instance of the V4 InstancePoller API.
+func newFacadeV4(ctx facade.Context) (*InstancePollerAPIV4, error) {
+	api, err := newFacade(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &InstancePollerAPIV3{api}, nil
+	return &InstancePollerAPIV4{api}, nil
 }
 
 // newFacade wraps NewInstancePollerAPI for facade registration.
@@ -21,6 +21,7 @@ type StateMachine interface {
 	SetProviderAddresses(...network.SpaceAddress) error
 	InstanceStatus() (status.StatusInfo, error)
 	SetInstanceStatus(status.StatusInfo) error
+	SetProviderMetadata(map[string]string) error
 	SetStatus(status.StatusInfo) error
 	String() string
 	Refresh() error
@@ -0,0 +1,135 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package modelalerts exposes the model alert rule evaluation to the
+// model alerts worker and to clients querying the current alerts for a
+// model.
+package modelalerts
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/modelalerts"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// retryCountWindow is how far back the facade looks in status history
+// when counting agent errors for the hook retry storm rule.
+const retryCountWindow = 15 * time.Minute
+
+// API serves the ModelAlerts facade for a model.
+type API struct {
+	model *state.Model
+}
+
+// NewFacade provides the signature required for facade registration.
+// The facade is used by the model alerts worker, and may also be
+// queried by any user with read access to the model.
+func NewFacade(ctx facade.Context) (*API, error) {
+	authorizer := ctx.Auth()
+	model, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !authorizer.AuthController() {
+		if !authorizer.AuthClient() {
+			return nil, apiservererrors.ErrPerm
+		}
+		canRead, err := authorizer.HasPermission(permission.ReadAccess, model.ModelTag())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !canRead {
+			return nil, apiservererrors.ErrPerm
+		}
+	}
+	return &API{model: model}, nil
+}
+
+// Alerts evaluates the alert rules against the model's units and
+// returns the set of active alerts.
+func (api *API) Alerts() (params.ModelAlertsResult, error) {
+	reports, err := api.unitReports()
+	if err != nil {
+		return params.ModelAlertsResult{}, errors.Trace(err)
+	}
+	now, err := api.model.State().ControllerTimestamp()
+	if err != nil {
+		return params.ModelAlertsResult{}, errors.Trace(err)
+	}
+	alerts := modelalerts.DefaultRules().Evaluate(*now, reports)
+	result := params.ModelAlertsResult{}
+	for _, alert := range alerts {
+		result.Alerts = append(result.Alerts, params.ModelAlert{
+			Rule:     alert.Rule,
+			Entity:   alert.Entity,
+			Severity: string(alert.Severity),
+			Message:  alert.Message,
+			Since:    alert.Since,
+		})
+	}
+	return result, nil
+}
+
+// UnitReports returns the per-unit snapshots that the alert rules are
+// evaluated against. It is used by the model alerts worker.
+func (api *API) UnitReports() (params.UnitAlertReportsResult, error) {
+	reports, err := api.unitReports()
+	if err != nil {
+		return params.UnitAlertReportsResult{}, errors.Trace(err)
+	}
+	result := params.UnitAlertReportsResult{}
+	for _, report := range reports {
+		result.Reports = append(result.Reports, params.UnitAlertReport{
+			Tag:            report.Tag,
+			AgentStatus:    report.AgentStatus.String(),
+			StatusSince:    report.StatusSince,
+			HookRetryCount: report.HookRetryCount,
+		})
+	}
+	return result, nil
+}
+
+// WebhookURL returns the model's configured alert webhook URL; the
+// result is empty when no webhook sink is configured.
+func (api *API) WebhookURL() (params.StringResult, error) {
+	cfg, err := api.model.ModelConfig()
+	if err != nil {
+		return params.StringResult{}, errors.Trace(err)
+	}
+	return params.StringResult{Result: cfg.AlertWebhookURL()}, nil
+}
+
+func (api *API) unitReports() ([]modelalerts.UnitReport, error) {
+	units, err := api.model.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	errorCounts, err := api.model.LoadUnitAgentErrorCounts(retryCountWindow)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var reports []modelalerts.UnitReport
+	for _, unit := range units {
+		agentStatus, err := unit.AgentStatus()
+		if err != nil {
+			return nil, errors.Annotatef(err, "retrieving agent status for unit %q", unit.Name())
+		}
+		report := modelalerts.UnitReport{
+			Tag:            unit.Tag().String(),
+			AgentStatus:    agentStatus.Status,
+			HookRetryCount: errorCounts[unit.Name()],
+		}
+		if agentStatus.Since != nil {
+			report.StatusSince = *agentStatus.Since
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
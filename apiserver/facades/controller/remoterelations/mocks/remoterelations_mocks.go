@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	crossmodel "github.com/juju/juju/apiserver/common/crossmodel"
@@ -314,6 +315,21 @@ func (mr *MockRemoteRelationsStateMockRecorder) ModelUUID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelUUID", reflect.TypeOf((*MockRemoteRelationsState)(nil).ModelUUID))
 }
 
+// OfferMacaroonsRotatedAt mocks base method.
+func (m *MockRemoteRelationsState) OfferMacaroonsRotatedAt(arg0 string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferMacaroonsRotatedAt", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferMacaroonsRotatedAt indicates an expected call of OfferMacaroonsRotatedAt.
+func (mr *MockRemoteRelationsStateMockRecorder) OfferMacaroonsRotatedAt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferMacaroonsRotatedAt", reflect.TypeOf((*MockRemoteRelationsState)(nil).OfferMacaroonsRotatedAt), arg0)
+}
+
 // OfferNameForRelation mocks base method.
 func (m *MockRemoteRelationsState) OfferNameForRelation(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	"gopkg.in/macaroon.v2"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -89,6 +90,12 @@ type AuthInfo struct {
 	// Controller reports whether or not the authenticated
 	// entity is a controller agent.
 	Controller bool
+
+	// MaxPermission, when non-empty, caps the permission level
+	// granted by the credential the entity authenticated with (for
+	// example a scoped personal access token), regardless of the
+	// permissions the entity itself holds.
+	MaxPermission permission.Access
 }
 
 // BasicAuthHandler is an http.Handler that authenticates requests that
@@ -6,6 +6,7 @@ package apiserver
 import (
 	"io"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -198,6 +199,7 @@ func (s *agentLoggingStrategy) Close() error {
 // WriteLog is part of the logsink.LogWriteCloser interface.
 func (s *agentLoggingStrategy) WriteLog(m params.LogRecord) error {
 	level, _ := loggo.ParseLevel(m.Level)
+	m.Labels = mergeFieldsIntoLabels(m.Labels, m.Fields)
 	dbErr := errors.Annotate(s.recordLogger.Log([]corelogger.LogRecord{{
 		Time:      m.Time,
 		Entity:    s.entity,
@@ -237,6 +239,27 @@ func (s *agentLoggingStrategy) WriteLog(m params.LogRecord) error {
 	return err
 }
 
+// mergeFieldsIntoLabels folds structured key/value fields received with
+// a log record into its labels as sorted "key=value" entries, so that
+// structured data survives storage and can be filtered like any other
+// label.
+func mergeFieldsIntoLabels(labels []string, fields map[string]string) []string {
+	if len(fields) == 0 {
+		return labels
+	}
+	merged := make([]string, 0, len(labels)+len(fields))
+	merged = append(merged, labels...)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		merged = append(merged, k+"="+fields[k])
+	}
+	return merged
+}
+
 // logToFile writes a single log message to the logsink log file.
 func logToFile(writer io.Writer, prefix string, m params.LogRecord) error {
 	_, err := writer.Write([]byte(strings.Join([]string{
@@ -38,6 +38,52 @@ func (s *loggingStrategySuite) TestLoggingOfDBInsertFailures(c *gc.C) {
 	c.Assert(logBuf.String(), gc.Matches, "(?m).*spawn more overlords.*")
 }
 
+func (s *loggingStrategySuite) TestMergeFieldsIntoLabels(c *gc.C) {
+	c.Check(mergeFieldsIntoLabels(nil, nil), gc.IsNil)
+
+	labels := []string{"http"}
+	c.Check(mergeFieldsIntoLabels(labels, nil), gc.DeepEquals, []string{"http"})
+
+	merged := mergeFieldsIntoLabels(labels, map[string]string{
+		"request-id": "42",
+		"method":     "GET",
+	})
+	// Fields are appended in sorted key order after existing labels.
+	c.Check(merged, gc.DeepEquals, []string{"http", "method=GET", "request-id=42"})
+
+	// The input label slice is not mutated.
+	c.Check(labels, gc.DeepEquals, []string{"http"})
+}
+
+func (s *loggingStrategySuite) TestWriteLogFoldsFieldsIntoLabels(c *gc.C) {
+	var logBuf bytes.Buffer
+	var recorded []corelogger.LogRecord
+	strategy := &agentLoggingStrategy{
+		recordLogger: recordingLogger{records: &recorded},
+		fileLogger:   &logBuf,
+	}
+
+	err := strategy.WriteLog(params.LogRecord{
+		Time:    time.Now(),
+		Level:   "INFO",
+		Message: "request served",
+		Labels:  []string{"http"},
+		Fields:  map[string]string{"request-id": "42"},
+	})
+	c.Assert(err, gc.IsNil)
+	c.Assert(recorded, gc.HasLen, 1)
+	c.Check(recorded[0].Labels, gc.DeepEquals, []string{"http", "request-id=42"})
+}
+
+type recordingLogger struct {
+	records *[]corelogger.LogRecord
+}
+
+func (l recordingLogger) Log(records []corelogger.LogRecord) error {
+	*l.records = append(*l.records, records...)
+	return nil
+}
+
 type failingRecordLogger struct{}
 
 func (failingRecordLogger) Log([]corelogger.LogRecord) error {
@@ -127,6 +127,12 @@ func MakeInterestingRequestFilter(excludeMethods set.Strings) func(auditlog.Requ
 	}
 }
 
+// IsReadonlyMethod reports whether the given "Facade.Method" name is
+// in the fixed set of read-only methods.
+func IsReadonlyMethod(methodName string) bool {
+	return readonlyMethods.Contains(methodName)
+}
+
 var readonlyMethods = set.NewStrings(
 	// Collected by running read-only commands.
 	"Action.Actions",
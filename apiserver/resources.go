@@ -6,11 +6,13 @@ package apiserver
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime"
 	"net/http"
 	"net/url"
 	"path"
 	"strconv"
+	"strings"
 
 	charmresource "github.com/juju/charm/v8/resource"
 	"github.com/juju/errors"
@@ -45,6 +47,9 @@ type ResourcesBackend interface {
 type ResourcesHandler struct {
 	StateAuthFunc     func(*http.Request, ...string) (ResourcesBackend, state.PoolHelper, names.Tag, error)
 	ChangeAllowedFunc func(*http.Request) error
+
+	// staging accumulates the chunks of in-progress chunked uploads.
+	staging resourceUploadStager
 }
 
 // ServeHTTP implements http.Handler.
@@ -60,7 +65,7 @@ func (h *ResourcesHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request
 
 	switch req.Method {
 	case "GET":
-		reader, size, err := h.download(backend, req)
+		reader, size, contentRange, err := h.download(backend, req)
 		if err != nil {
 			if err := sendError(resp, err); err != nil {
 				logger.Errorf("%v", err)
@@ -71,7 +76,12 @@ func (h *ResourcesHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request
 		header := resp.Header()
 		header.Set("Content-Type", params.ContentTypeRaw)
 		header.Set("Content-Length", fmt.Sprint(size))
-		resp.WriteHeader(http.StatusOK)
+		status := http.StatusOK
+		if contentRange != "" {
+			header.Set(api.HeaderContentRange, contentRange)
+			status = http.StatusPartialContent
+		}
+		resp.WriteHeader(status)
 		if _, err := io.Copy(resp, reader); err != nil {
 			logger.Errorf("resource download failed: %v", err)
 		}
@@ -82,7 +92,25 @@ func (h *ResourcesHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request
 			}
 			return
 		}
-		response, err := h.upload(backend, req, tagToUsername(tag))
+		username := tagToUsername(tag)
+
+		// A Content-Range header indicates one chunk of a chunked,
+		// resumable upload rather than the whole blob.
+		if req.Header.Get(api.HeaderContentRange) != "" {
+			response, err := h.uploadChunk(backend, req, username)
+			if err != nil {
+				if err := sendError(resp, err); err != nil {
+					logger.Errorf("%v", err)
+				}
+				return
+			}
+			if err := sendStatusAndJSON(resp, http.StatusOK, &response); err != nil {
+				logger.Errorf("%v", err)
+			}
+			return
+		}
+
+		response, err := h.upload(backend, req, username)
 		if err != nil {
 			if err := sendError(resp, err); err != nil {
 				logger.Errorf("%v", err)
@@ -99,7 +127,7 @@ func (h *ResourcesHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request
 	}
 }
 
-func (h *ResourcesHandler) download(backend ResourcesBackend, req *http.Request) (io.ReadCloser, int64, error) {
+func (h *ResourcesHandler) download(backend ResourcesBackend, req *http.Request) (io.ReadCloser, int64, string, error) {
 	defer req.Body.Close()
 
 	query := req.URL.Query()
@@ -107,7 +135,51 @@ func (h *ResourcesHandler) download(backend ResourcesBackend, req *http.Request)
 	name := query.Get(":resource")
 
 	resource, reader, err := backend.OpenResource(application, name)
-	return reader, resource.Size, errors.Trace(err)
+	if err != nil {
+		return nil, 0, "", errors.Trace(err)
+	}
+
+	offset, err := parseRangeOffset(req)
+	if err != nil {
+		_ = reader.Close()
+		return nil, 0, "", errors.Trace(err)
+	}
+	if offset == 0 {
+		return reader, resource.Size, "", nil
+	}
+	if offset >= resource.Size {
+		_ = reader.Close()
+		return nil, 0, "", errors.BadRequestf(
+			"download offset %d out of range for resource of size %d", offset, resource.Size)
+	}
+
+	// The blob store does not expose a seekable reader,
+	// so skip over the bytes the client already has.
+	if _, err := io.CopyN(ioutil.Discard, reader, offset); err != nil {
+		_ = reader.Close()
+		return nil, 0, "", errors.Trace(err)
+	}
+	contentRange := fmt.Sprintf("bytes %d-%d/%d", offset, resource.Size-1, resource.Size)
+	return reader, resource.Size - offset, contentRange, nil
+}
+
+// parseRangeOffset returns the resume offset from the request's Range
+// header, or zero if the header is not set. Only a single open-ended
+// byte range ("bytes=N-") is supported; anything else is an error.
+func parseRangeOffset(req *http.Request) (int64, error) {
+	value := req.Header.Get("Range")
+	if value == "" {
+		return 0, nil
+	}
+	spec := strings.TrimPrefix(value, "bytes=")
+	if spec == value || !strings.HasSuffix(spec, "-") {
+		return 0, errors.BadRequestf("unsupported Range header %q", value)
+	}
+	offset, err := strconv.ParseInt(strings.TrimSuffix(spec, "-"), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errors.BadRequestf("unsupported Range header %q", value)
+	}
+	return offset, nil
 }
 
 func (h *ResourcesHandler) upload(backend ResourcesBackend, req *http.Request, username string) (*params.UploadResult, error) {
@@ -158,34 +230,145 @@ func (h *ResourcesHandler) readResource(backend ResourcesBackend, req *http.Requ
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	chRes, err := resolveResourceUpload(backend, uReq)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &uploadedResource{
+		Application: uReq.Application,
+		PendingID:   uReq.PendingID,
+		Resource:    chRes,
+		Data:        req.Body,
+	}, nil
+}
+
+// uploadChunk stages one chunk of a chunked, resumable resource upload.
+// Chunks must arrive in order; an out-of-order chunk is not an error,
+// but is discarded, with the response telling the client the offset to
+// resume from. Once the final chunk arrives, the assembled blob is
+// verified against the declared fingerprint and stored.
+func (h *ResourcesHandler) uploadChunk(backend ResourcesBackend, req *http.Request, username string) (*params.UploadChunkResult, error) {
+	defer req.Body.Close()
+
+	start, end, total, err := parseContentRange(req.Header.Get(api.HeaderContentRange))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	uReq, err := extractUploadRequest(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if uReq.Size != total {
+		return nil, errors.BadRequestf(
+			"declared resource size %d does not match Content-Range total %d", uReq.Size, total)
+	}
+
+	key := strings.Join([]string{
+		req.URL.Query().Get(":modeluuid"), uReq.Application, uReq.Name, uReq.PendingID, username,
+	}, "/")
+
+	staged, err := h.staging.offsetFor(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if start != staged {
+		return &params.UploadChunkResult{Offset: staged}, nil
+	}
+
+	size, err := h.staging.append(key, io.LimitReader(req.Body, end-start+1))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if size < total {
+		return &params.UploadChunkResult{Offset: size}, nil
+	}
+
+	// That was the final chunk; verify the assembled blob against the
+	// declared fingerprint before storing it.
+	data, err := h.staging.open(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	fp, err := charmresource.GenerateFingerprint(data)
+	_ = data.Close()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if fp.String() != uReq.Fingerprint.String() {
+		_ = h.staging.remove(key)
+		return nil, errors.Errorf("resource upload failed fingerprint verification; retry the upload")
+	}
+
+	chRes, err := resolveResourceUpload(backend, uReq)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	data, err = h.staging.open(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer data.Close()
+
+	var stored resources.Resource
+	if uReq.PendingID != "" {
+		stored, err = backend.UpdatePendingResource(uReq.Application, uReq.PendingID, username, chRes, data)
+	} else {
+		stored, err = backend.SetResource(uReq.Application, username, chRes, data, state.IncrementCharmModifiedVersion)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	_ = h.staging.remove(key)
+
+	return &params.UploadChunkResult{
+		Offset:   total,
+		Complete: true,
+		Resource: api.Resource2API(stored),
+	}, nil
+}
+
+// parseContentRange parses a Content-Range value of the form
+// "bytes start-end/total" as sent with each chunk of a chunked upload.
+func parseContentRange(value string) (start, end, total int64, err error) {
+	if _, err := fmt.Sscanf(value, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		return 0, 0, 0, errors.BadRequestf("invalid Content-Range %q", value)
+	}
+	if start < 0 || end < start || total <= end {
+		return 0, 0, 0, errors.BadRequestf("invalid Content-Range %q", value)
+	}
+	return start, end, total, nil
+}
+
+// resolveResourceUpload looks up the resource identified by the upload
+// request and returns its metadata updated with the uploaded content's
+// fingerprint and size.
+func resolveResourceUpload(backend ResourcesBackend, uReq api.UploadRequest) (charmresource.Resource, error) {
 	var res resources.Resource
+	var err error
 	if uReq.PendingID != "" {
 		res, err = backend.GetPendingResource(uReq.Application, uReq.Name, uReq.PendingID)
 	} else {
 		res, err = backend.GetResource(uReq.Application, uReq.Name)
 	}
 	if err != nil {
-		return nil, errors.Trace(err)
+		return charmresource.Resource{}, errors.Trace(err)
 	}
 
 	switch res.Type {
 	case charmresource.TypeFile:
 		ext := path.Ext(res.Path)
 		if path.Ext(uReq.Filename) != ext {
-			return nil, errors.Errorf("incorrect extension on resource upload %q, expected %q", uReq.Filename, ext)
+			return charmresource.Resource{}, errors.Errorf(
+				"incorrect extension on resource upload %q, expected %q", uReq.Filename, ext)
 		}
 	}
 
 	chRes, err := updateResource(res.Resource, uReq.Fingerprint, uReq.Size)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return charmresource.Resource{}, errors.Trace(err)
 	}
-	return &uploadedResource{
-		Application: uReq.Application,
-		PendingID:   uReq.PendingID,
-		Resource:    chRes,
-		Data:        req.Body,
-	}, nil
+	return chRes, nil
 }
 
 // updateResource returns a copy of the provided resource, updated with
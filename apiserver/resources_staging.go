@@ -0,0 +1,114 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// resourceUploadStager accumulates the chunks of in-progress resource
+// uploads in local files until the full blob has been received. Staged
+// data survives individual request failures, which is what allows a
+// client to resume an interrupted upload from the last staged offset.
+type resourceUploadStager struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// stagingPath returns the file path used to stage the upload identified
+// by the input key, creating the staging directory if required.
+// The caller must hold the mutex.
+func (s *resourceUploadStager) stagingPath(key string) (string, error) {
+	if s.dir == "" {
+		dir, err := ioutil.TempDir("", "juju-resource-upload")
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		s.dir = dir
+	}
+	// The key contains caller-supplied components, so hash it rather
+	// than using it as a file name directly.
+	return filepath.Join(s.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(key)))), nil
+}
+
+// offsetFor returns the number of contiguous bytes staged so far for
+// the identified upload, which is zero if nothing has been staged.
+func (s *resourceUploadStager) offsetFor(key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.stagingPath(key)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return info.Size(), nil
+}
+
+// append adds the input data to the end of the staged upload,
+// returning the new staged size.
+func (s *resourceUploadStager) append(key string, r io.Reader) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.stagingPath(key)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return 0, errors.Trace(err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return info.Size(), nil
+}
+
+// open returns a reader over the staged upload data.
+func (s *resourceUploadStager) open(key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.stagingPath(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	f, err := os.Open(path)
+	return f, errors.Trace(err)
+}
+
+// remove discards any staged data for the identified upload.
+func (s *resourceUploadStager) remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.stagingPath(key)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
@@ -120,6 +120,92 @@ func (s *ResourcesHandlerSuite) TestGetSuccess(c *gc.C) {
 	s.checkResp(c, http.StatusOK, "application/octet-stream", resourceBody)
 }
 
+func (s *ResourcesHandlerSuite) TestGetRangeResume(c *gc.C) {
+	s.req.Method = "GET"
+	s.req.Header = make(http.Header)
+	s.req.Header.Set("Range", "bytes=2-")
+
+	s.handler.ServeHTTP(s.recorder, s.req)
+
+	s.checkResp(c, http.StatusPartialContent, "application/octet-stream", resourceBody[2:])
+	c.Check(s.recorder.Header().Get("Content-Range"), gc.Equals, "bytes 2-3/4")
+}
+
+func (s *ResourcesHandlerSuite) TestGetRangeOutOfBounds(c *gc.C) {
+	s.req.Method = "GET"
+	s.req.Header = make(http.Header)
+	s.req.Header.Set("Range", "bytes=42-")
+
+	s.handler.ServeHTTP(s.recorder, s.req)
+
+	_, expected := apiFailure("download offset 42 out of range for resource of size 4", params.CodeBadRequest)
+	s.checkResp(c, http.StatusBadRequest, "application/json", expected)
+}
+
+func (s *ResourcesHandlerSuite) TestPutChunked(c *gc.C) {
+	uploadContent := "<some data>"
+	res, apiRes := newResource(c, "spam", "a-user", uploadContent)
+	stored, _ := newResource(c, "spam", "", "")
+	s.backend.ReturnGetResource = stored
+	s.backend.ReturnSetResource = res
+
+	// Send the content in three chunks; the first two only stage data.
+	for _, chunk := range []struct {
+		start, end int
+		offset     int64
+	}{
+		{0, 3, 4},
+		{4, 7, 8},
+	} {
+		req := newChunkUploadRequest(c, "spam", "a-application", uploadContent, chunk.start, chunk.end)
+		recorder := httptest.NewRecorder()
+		s.handler.ServeHTTP(recorder, req)
+
+		expected := mustMarshalJSON(&params.UploadChunkResult{Offset: chunk.offset})
+		checkHTTPResp(c, recorder, http.StatusOK, "application/json", string(expected))
+	}
+
+	req := newChunkUploadRequest(c, "spam", "a-application", uploadContent, 8, len(uploadContent)-1)
+	s.handler.ServeHTTP(s.recorder, req)
+
+	expected := mustMarshalJSON(&params.UploadChunkResult{
+		Offset:   int64(len(uploadContent)),
+		Complete: true,
+		Resource: apiRes,
+	})
+	s.checkResp(c, http.StatusOK, "application/json", string(expected))
+}
+
+func (s *ResourcesHandlerSuite) TestPutChunkedOutOfOrder(c *gc.C) {
+	uploadContent := "<some data>"
+	stored, _ := newResource(c, "spam", "", "")
+	s.backend.ReturnGetResource = stored
+
+	req := newChunkUploadRequest(c, "spam", "a-application", uploadContent, 0, 3)
+	s.handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Repeating the first chunk reports the staged offset to resume
+	// from without consuming anything.
+	req = newChunkUploadRequest(c, "spam", "a-application", uploadContent, 0, 3)
+	s.handler.ServeHTTP(s.recorder, req)
+
+	expected := mustMarshalJSON(&params.UploadChunkResult{Offset: 4})
+	s.checkResp(c, http.StatusOK, "application/json", string(expected))
+}
+
+func (s *ResourcesHandlerSuite) TestPutChunkedFingerprintMismatch(c *gc.C) {
+	uploadContent := "<some data>"
+	stored, _ := newResource(c, "spam", "", "")
+	s.backend.ReturnGetResource = stored
+
+	req := newChunkUploadRequest(c, "spam", "a-application", uploadContent, 0, len(uploadContent)-1)
+	req.Body = ioutil.NopCloser(strings.NewReader("<other data"))
+	s.handler.ServeHTTP(s.recorder, req)
+
+	_, expected := apiFailure("resource upload failed fingerprint verification; retry the upload", "")
+	s.checkResp(c, http.StatusInternalServerError, "application/json", expected)
+}
+
 func (s *ResourcesHandlerSuite) TestPutSuccess(c *gc.C) {
 	uploadContent := "<some data>"
 	res, _ := newResource(c, "spam", "a-user", content)
@@ -333,6 +419,13 @@ func newUploadRequest(c *gc.C, name, service, content string) (*http.Request, io
 	return req, body
 }
 
+func newChunkUploadRequest(c *gc.C, name, service, content string, start, end int) *http.Request {
+	req, _ := newUploadRequest(c, name, service, content)
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+	req.Body = ioutil.NopCloser(strings.NewReader(content[start : end+1]))
+	return req
+}
+
 func apiFailure(msg, code string) (error, string) {
 	failure := errors.New(msg)
 	data := mustMarshalJSON(params.ErrorResult{
@@ -31,6 +31,7 @@ var controllerFacadeNames = set.NewStrings(
 var commonFacadeNames = set.NewStrings(
 	"Pinger",
 	"Bundle",
+	"FacadeDeprecations",
 
 	// TODO(mjs) - bug 1632172 - Exposed for model logins for
 	// backwards compatibility. Remove once we're sure no non-Juju
@@ -0,0 +1,136 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/mongo"
+)
+
+// readOnlyMethodsOnly returns a check function for restrictRoot that
+// allows only methods which cannot modify model or controller state.
+// Anything else is answered with the given error, which directs the
+// caller at the primary API server.
+func readOnlyMethodsOnly(redirectErr error) func(string, string) error {
+	return func(facadeName, methodName string) error {
+		if isReadOnlyMethod(facadeName, methodName) {
+			return nil
+		}
+		return redirectErr
+	}
+}
+
+func isReadOnlyMethod(facadeName, methodName string) bool {
+	if readOnlyFacades.Contains(facadeName) {
+		return true
+	}
+	if strings.HasPrefix(methodName, "Watch") ||
+		strings.HasPrefix(methodName, "List") {
+		return true
+	}
+	return observer.IsReadonlyMethod(fmt.Sprintf("%s.%s", facadeName, methodName))
+}
+
+// readOnlyFacades are facades none of whose methods modify model or
+// controller state: the watcher facades used to deliver change
+// notifications, and the pinger used to keep connections alive.
+var readOnlyFacades = set.NewStrings(
+	"AllWatcher",
+	"AllModelWatcher",
+	"NotifyWatcher",
+	"StringsWatcher",
+	"RelationUnitsWatcher",
+	"RelationStatusWatcher",
+	"RemoteRelationWatcher",
+	"OfferStatusWatcher",
+	"SecretsRotationWatcher",
+	"VolumeAttachmentsWatcher",
+	"VolumeAttachmentPlansWatcher",
+	"FilesystemAttachmentsWatcher",
+	"MigrationStatusWatcher",
+	"ModelSummaryWatcher",
+	"EntityWatcher",
+	"Pinger",
+)
+
+// readOnlyReplicaRedirect returns the error used to answer requests
+// that could modify state when this API server is configured as a
+// read-only replica. It returns nil if writes should be served here:
+// the feature is disabled, this controller hosts the mongo primary,
+// or the primary cannot be determined.
+func (srv *Server) readOnlyReplicaRedirect() error {
+	srv.shared.configMutex.RLock()
+	cfg := srv.shared.controllerConfig
+	srv.shared.configMutex.RUnlock()
+	if !cfg.APIReadOnlyReplicas() {
+		return nil
+	}
+	machineTag, ok := srv.tag.(names.MachineTag)
+	if !ok {
+		// Only machine controllers can host mongo secondaries.
+		return nil
+	}
+	st, err := srv.shared.statePool.SystemState()
+	if err != nil {
+		logger.Warningf("read-only replica check: %v", err)
+		return nil
+	}
+	machine, err := st.Machine(machineTag.Id())
+	if err != nil {
+		logger.Warningf("read-only replica check: %v", err)
+		return nil
+	}
+	session := st.MongoSession()
+	isPrimary, err := mongo.IsMaster(session, machine)
+	if err != nil {
+		logger.Warningf("read-only replica check: %v", err)
+		return nil
+	}
+	if isPrimary {
+		return nil
+	}
+
+	caCert, _ := cfg.CACert()
+	redirect := &apiservererrors.RedirectError{
+		CACert:        caCert,
+		ControllerTag: names.NewControllerTag(cfg.ControllerUUID()),
+	}
+	// Find the primary's API addresses so that clients know where to
+	// send their writes.
+	controllerIds, err := st.ControllerIds()
+	if err != nil {
+		logger.Warningf("finding primary API server: %v", err)
+		return redirect
+	}
+	for _, id := range controllerIds {
+		if id == machineTag.Id() {
+			continue
+		}
+		m, err := st.Machine(id)
+		if err != nil {
+			continue
+		}
+		primary, err := mongo.IsMaster(session, m)
+		if err != nil || !primary {
+			continue
+		}
+		hostPorts := network.SpaceAddressesWithPort(m.Addresses(), cfg.APIPort())
+		providerHostPorts, err := hostPorts.ToProviderHostPorts(st)
+		if err != nil {
+			logger.Warningf("finding primary API server: %v", err)
+			break
+		}
+		redirect.Servers = []network.ProviderHostPorts{providerHostPorts}
+		break
+	}
+	return redirect
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/testing"
+)
+
+type restrictReadOnlySuite struct {
+	testing.BaseSuite
+	redirectErr error
+}
+
+var _ = gc.Suite(&restrictReadOnlySuite{})
+
+func (s *restrictReadOnlySuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.redirectErr = &apiservererrors.RedirectError{
+		CACert:        testing.CACert,
+		ControllerTag: names.NewControllerTag(testing.ControllerTag.Id()),
+	}
+}
+
+func (s *restrictReadOnlySuite) TestAllowedMethods(c *gc.C) {
+	root := apiserver.TestingReadOnlyRoot(s.redirectErr)
+	checkAllowed := func(facade, method string) {
+		caller, err := root.FindMethod(facade, 1, method)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(caller, gc.NotNil)
+	}
+	checkAllowed("Client", "FullStatus")
+	checkAllowed("Client", "StatusHistory")
+	checkAllowed("Client", "WatchAll")
+	checkAllowed("ModelManager", "ListModels")
+	checkAllowed("Storage", "ListPools")
+	checkAllowed("Pinger", "Ping")
+}
+
+func (s *restrictReadOnlySuite) TestFindDisallowedMethod(c *gc.C) {
+	root := apiserver.TestingReadOnlyRoot(s.redirectErr)
+	caller, err := root.FindMethod("Client", 1, "ModelSet")
+	c.Assert(errors.Cause(err), gc.Equals, s.redirectErr)
+	c.Assert(caller, gc.IsNil)
+}
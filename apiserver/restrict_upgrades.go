@@ -16,17 +16,25 @@ func upgradeMethodsOnly(facadeName, methodName string) error {
 	return nil
 }
 
+// IsMethodAllowedDuringUpgrade reports whether users may call the given
+// facade method while an upgrade is in progress.
 func IsMethodAllowedDuringUpgrade(facadeName, methodName string) bool {
 	methods, ok := allowedMethodsDuringUpgrades[facadeName]
 	if !ok {
 		return false
 	}
-	return methods.Contains(methodName)
+	return methods.Contains(allowAllMethods) || methods.Contains(methodName)
 }
 
+// allowAllMethods is a sentinel entry in the table below that allows
+// every method of a facade during upgrades.
+const allowAllMethods = "*"
+
 // allowedMethodsDuringUpgrades stores api calls
 // that are not blocked during the upgrade process
-// as well as  their respective facade names.
+// as well as their respective facade names.
+// Only methods that are explicitly safe - read-only, or needed to
+// manage the upgrade itself - belong here.
 // When needed, at some future point, this solution
 // will need to be adjusted to cater for different
 // facade versions as well.
@@ -35,17 +43,15 @@ var allowedMethodsDuringUpgrades = map[string]set.Strings{
 		"FullStatus",          // for "juju status"
 		"FindTools",           // for "juju upgrade-model", before we can reset upgrade to re-run
 		"AbortCurrentUpgrade", // for "juju upgrade-model", so that we can reset upgrade to re-run
-
 	),
-	"SSHClient": set.NewStrings( // allow all SSH client related calls
-		"PublicAddress",
-		"PrivateAddress",
-		"BestAPIVersion",
-		"AllAddresses",
-		"PublicKeys",
-		"Proxy",
-		"Leader",
+	"ModelManager": set.NewStrings(
+		// Read-only calls behind "juju models" and "juju show-model".
+		"ListModels",
+		"ListModelSummaries",
+		"ModelInfo",
 	),
+	// All SSH client related calls are read-only.
+	"SSHClient": set.NewStrings(allowAllMethods),
 	"Pinger": set.NewStrings(
 		"Ping",
 	),
@@ -21,16 +21,35 @@ var _ = gc.Suite(&restrictUpgradesSuite{})
 
 func (r *restrictUpgradesSuite) TestAllowedMethods(c *gc.C) {
 	root := apiserver.TestingUpgradingRoot()
-	checkAllowed := func(facade, method string) {
-		caller, err := root.FindMethod(facade, 1, method)
+	checkAllowed := func(facade string, version int, method string) {
+		caller, err := root.FindMethod(facade, version, method)
 		c.Check(err, jc.ErrorIsNil)
 		c.Check(caller, gc.NotNil)
 	}
-	checkAllowed("Client", "FullStatus")
-	checkAllowed("Client", "AbortCurrentUpgrade")
-	checkAllowed("SSHClient", "PublicAddress")
-	checkAllowed("SSHClient", "Proxy")
-	checkAllowed("Pinger", "Ping")
+	checkAllowed("Client", 1, "FullStatus")
+	checkAllowed("Client", 1, "AbortCurrentUpgrade")
+	checkAllowed("SSHClient", 1, "PublicAddress")
+	checkAllowed("SSHClient", 1, "Proxy")
+	checkAllowed("ModelManager", 9, "ListModels")
+	checkAllowed("ModelManager", 9, "ListModelSummaries")
+	checkAllowed("ModelManager", 9, "ModelInfo")
+	checkAllowed("Pinger", 1, "Ping")
+}
+
+func (r *restrictUpgradesSuite) TestFacadeWideAllowedMethods(c *gc.C) {
+	// SSHClient is allowed wholesale; methods not named individually
+	// in the restriction table must still resolve.
+	root := apiserver.TestingUpgradingRoot()
+	caller, err := root.FindMethod("SSHClient", 1, "PrivateAddress")
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(caller, gc.NotNil)
+}
+
+func (r *restrictUpgradesSuite) TestDisallowedMutatingMethod(c *gc.C) {
+	root := apiserver.TestingUpgradingRoot()
+	caller, err := root.FindMethod("ModelManager", 9, "CreateModel")
+	c.Assert(errors.Cause(err), gc.Equals, params.UpgradeInProgressError)
+	c.Assert(caller, gc.IsNil)
 }
 
 func (r *restrictUpgradesSuite) TestFindDisallowedMethod(c *gc.C) {
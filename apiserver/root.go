@@ -55,6 +55,11 @@ type apiHandler struct {
 	shared    *sharedServerContext
 	entity    state.Entity
 
+	// maxPermission, when non-empty, caps the permission level of the
+	// authenticated entity. It is set when the login presented a
+	// scoped credential, such as a personal access token.
+	maxPermission permission.Access
+
 	// An empty modelUUID means that the user has logged in through the
 	// root of the API server rather than the /model/:model-uuid/api
 	// path, logins processed with v2 or later will only offer the
@@ -199,6 +204,9 @@ type apiRoot struct {
 	objectMutex     sync.RWMutex
 	objectCache     map[objectKey]reflect.Value
 	requestRecorder facade.RequestRecorder
+
+	deprecationMutex sync.Mutex
+	warnedDeprecated map[string]bool
 }
 
 type apiRootHandler interface {
@@ -268,6 +276,14 @@ func restrictAPIRoot(
 		if clientVersion.Major != jujuversion.Current.Major {
 			apiRoot = restrictRoot(apiRoot, checkClientVersion(auth.userLogin, clientVersion))
 		}
+		if _, ok := auth.tag.(names.UserTag); ok {
+			// When this API server is a read-only replica, users may
+			// only call methods that cannot modify state; anything
+			// else is redirected to the primary.
+			if redirect := srv.readOnlyReplicaRedirect(); redirect != nil {
+				apiRoot = restrictRoot(apiRoot, readOnlyMethodsOnly(redirect))
+			}
+		}
 	}
 	if auth.controllerOnlyLogin {
 		apiRoot = restrictRoot(apiRoot, controllerFacadesOnly)
@@ -354,6 +370,9 @@ func (r *apiRoot) FindMethod(rootName string, version int, methodName string) (r
 	if err != nil {
 		return nil, err
 	}
+	if removalTarget, ok := r.facades.IsDeprecated(rootName, version); ok {
+		r.warnDeprecated(rootName, version, removalTarget)
+	}
 
 	creator := func(id string) (reflect.Value, error) {
 		objKey := objectKey{name: rootName, version: version, objId: id}
@@ -432,6 +451,22 @@ func (r *apiRoot) lookupMethod(rootName string, version int, methodName string)
 	return goType, objMethod, nil
 }
 
+// warnDeprecated logs once per connection when a client places a call
+// on a facade version that has been marked deprecated.
+func (r *apiRoot) warnDeprecated(rootName string, version int, removalTarget string) {
+	key := fmt.Sprintf("%s(%d)", rootName, version)
+	r.deprecationMutex.Lock()
+	defer r.deprecationMutex.Unlock()
+	if r.warnedDeprecated[key] {
+		return
+	}
+	if r.warnedDeprecated == nil {
+		r.warnedDeprecated = make(map[string]bool, 1)
+	}
+	r.warnedDeprecated[key] = true
+	logger.Warningf("client used deprecated facade %s, scheduled for removal in %s", key, removalTarget)
+}
+
 func (r *apiRoot) dispose(key objectKey) {
 	r.objectMutex.Lock()
 	defer r.objectMutex.Unlock()
@@ -715,9 +750,30 @@ func (r *apiHandler) ConnectedModel() string {
 
 // HasPermission returns true if the logged in user can perform <operation> on <target>.
 func (r *apiHandler) HasPermission(operation permission.Access, target names.Tag) (bool, error) {
+	if !r.permissionAllowed(operation, target) {
+		return false, nil
+	}
 	return common.HasPermission(r.state.UserPermission, r.entity.Tag(), operation, target)
 }
 
+// permissionAllowed applies the permission cap of the credential the
+// connection authenticated with, if any. A capped connection never
+// exceeds the cap on models, and never holds more than login access
+// on the controller, regardless of the permissions of the entity
+// itself.
+func (r *apiHandler) permissionAllowed(operation permission.Access, target names.Tag) bool {
+	if r.maxPermission == "" {
+		return true
+	}
+	switch target.Kind() {
+	case names.ModelTagKind:
+		return r.maxPermission.EqualOrGreaterModelAccessThan(operation)
+	case names.ControllerTagKind:
+		return operation == permission.LoginAccess
+	}
+	return true
+}
+
 // UserHasPermission returns true if the passed in user can perform <operation> on <target>.
 func (r *apiHandler) UserHasPermission(user names.UserTag, operation permission.Access, target names.Tag) (bool, error) {
 	return common.HasPermission(r.state.UserPermission, user, operation, target)
@@ -0,0 +1,73 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package stateauthenticator
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/state"
+)
+
+// authenticateAPIToken authenticates a login that presented a personal
+// access token instead of a password. Tokens are minted against the
+// controller, so they are always looked up in the system state; st is
+// the state of the model being logged in to, used to enforce the
+// token's model scope and to resolve the user entity.
+//
+// The resulting AuthInfo carries the token's access level as a
+// permission cap, so a token scoped to read access cannot be used to
+// mutate a model however privileged its owner is.
+func (a *Authenticator) authenticateAPIToken(
+	st *state.State, authTag names.Tag, credential string,
+) (httpcontext.AuthInfo, error) {
+	systemState, err := a.statePool.SystemState()
+	if err != nil {
+		return httpcontext.AuthInfo{}, errors.Trace(err)
+	}
+	token, err := systemState.APITokenByCredential(credential)
+	if err != nil {
+		logger.Debugf("rejected API token login: %v", err)
+		return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+	}
+	userTag := token.UserTag()
+	if authTag != nil && authTag.String() != userTag.String() {
+		logger.Debugf("rejected API token login: token belongs to %q, not %q", userTag, authTag)
+		return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+	}
+	if expiry := token.Expiry(); expiry != nil && a.authContext.clock.Now().After(*expiry) {
+		logger.Debugf("rejected expired API token %q of user %q", token.Name(), userTag.Id())
+		return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+	}
+	if models := token.Models(); len(models) > 0 {
+		inScope := false
+		for _, uuid := range models {
+			if uuid == st.ModelUUID() {
+				inScope = true
+				break
+			}
+		}
+		if !inScope {
+			logger.Debugf("rejected API token %q of user %q: model %q not in token scope",
+				token.Name(), userTag.Id(), st.ModelUUID())
+			return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+		}
+	}
+	// The token only vouches for the user; a disabled or removed user
+	// cannot log in with a previously minted token.
+	user, err := systemState.User(userTag)
+	if err != nil || user.IsDisabled() {
+		return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+	}
+	entity, err := modelUserEntityFinder{st}.FindEntity(userTag)
+	if err != nil {
+		return httpcontext.AuthInfo{}, errors.Trace(apiservererrors.ErrBadCreds)
+	}
+	return httpcontext.AuthInfo{
+		Entity:        entity,
+		MaxPermission: token.Access(),
+	}, nil
+}
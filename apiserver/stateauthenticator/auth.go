@@ -179,6 +179,9 @@ func (a *Authenticator) checkCreds(
 	userLogin bool,
 	authenticator authentication.Authenticator,
 ) (httpcontext.AuthInfo, error) {
+	if userLogin && state.IsAPITokenCredential(req.Credentials) {
+		return a.authenticateAPIToken(st, authTag, req.Credentials)
+	}
 	var entityFinder authentication.EntityFinder = st
 	if userLogin {
 		// When looking up model users, use a custom
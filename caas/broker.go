@@ -275,6 +275,21 @@ type Upgrader interface {
 	Upgrade(appName string, vers version.Number) error
 }
 
+// NetworkPolicyManager is implemented by brokers that can create the
+// network policies required for Juju traffic in clusters with
+// default-deny networking.
+type NetworkPolicyManager interface {
+	// EnsureModelNetworkPolicies creates or updates the network
+	// policies required for Juju operator, workload and controller
+	// traffic in the model's namespace, returning the names of the
+	// policies ensured.
+	EnsureModelNetworkPolicies() ([]string, error)
+
+	// ModelNetworkPolicies returns the names of the network policies
+	// Juju has created in the model's namespace.
+	ModelNetworkPolicies() ([]string, error)
+}
+
 // StorageValidator provides methods to validate storage.
 type StorageValidator interface {
 	// ValidateStorageClass returns an error if the storage config is not valid.
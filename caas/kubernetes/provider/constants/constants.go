@@ -46,6 +46,11 @@ const (
 
 	// CAASImageRepoSecretName is the name of the secret for image pull.
 	CAASImageRepoSecretName = "juju-image-pull-secret"
+
+	// CreateNetworkPoliciesKey is the model config attribute used to
+	// request creation of the network policies required for Juju
+	// traffic in clusters with default-deny networking.
+	CreateNetworkPoliciesKey = "create-network-policies"
 )
 
 // DefaultPropagationPolicy returns the default propagation policy.
@@ -334,7 +334,18 @@ func (k *kubernetesClient) EnsureModelOperator(
 		isLegacyLabels: k.IsLegacyLabels,
 	}
 
-	return ensureModelOperator(modelUUID, agentPath, k.clock, config, bridge)
+	if err := ensureModelOperator(modelUUID, agentPath, k.clock, config, bridge); err != nil {
+		return errors.Trace(err)
+	}
+
+	if k.createNetworkPoliciesEnabled() {
+		policyNames, err := k.EnsureModelNetworkPolicies()
+		if err != nil {
+			return errors.Annotate(err, "ensuring model network policies")
+		}
+		logger.Debugf("ensured network policies %v for model %q", policyNames, k.CurrentModel())
+	}
+	return nil
 }
 
 // ModelOperator return the model operator config used to create the current
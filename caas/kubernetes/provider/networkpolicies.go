@@ -0,0 +1,112 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+	"github.com/juju/juju/caas/kubernetes/provider/utils"
+)
+
+const (
+	// modelTrafficPolicyName is the network policy allowing traffic
+	// between pods in the model's namespace, so that operators can
+	// reach the workloads they manage.
+	modelTrafficPolicyName = "juju-model-traffic"
+
+	// controllerEgressPolicyName is the network policy allowing
+	// egress from the model's pods, so that agents can reach the
+	// controller API and cluster DNS.
+	controllerEgressPolicyName = "juju-controller-egress"
+)
+
+// createNetworkPoliciesEnabled reports whether the model is configured
+// to have Juju create the network policies required for its traffic.
+func (k *kubernetesClient) createNetworkPoliciesEnabled() bool {
+	enabled, ok := k.Config().UnknownAttrs()[k8sconstants.CreateNetworkPoliciesKey].(bool)
+	return ok && enabled
+}
+
+// modelNetworkPolicies returns the minimal network policies required
+// for Juju operator, workload and controller traffic in the given
+// namespace. Network policies are additive, so in clusters with
+// default-deny networking these restore intra-model traffic and agent
+// egress without opening the namespace to ingress from outside.
+func modelNetworkPolicies(namespace string, labels map[string]string) []*networkingv1.NetworkPolicy {
+	anyPod := v1.LabelSelector{}
+	return []*networkingv1.NetworkPolicy{{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      modelTrafficPolicyName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: anyPod,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &anyPod,
+				}},
+			}},
+		},
+	}, {
+		ObjectMeta: v1.ObjectMeta{
+			Name:      controllerEgressPolicyName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: anyPod,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      []networkingv1.NetworkPolicyEgressRule{{}},
+		},
+	}}
+}
+
+// EnsureModelNetworkPolicies creates or updates the network policies
+// required for Juju operator, workload and controller traffic in the
+// model's namespace, returning the names of the policies ensured.
+func (k *kubernetesClient) EnsureModelNetworkPolicies() ([]string, error) {
+	if k.namespace == "" {
+		return nil, errNoNamespace
+	}
+	labels := utils.LabelsMerge(
+		utils.LabelsForModel(k.CurrentModel(), k.IsLegacyLabels()),
+		utils.LabelsJuju)
+
+	var policyNames []string
+	for _, policy := range modelNetworkPolicies(k.namespace, labels) {
+		resource := resources.NewNetworkPolicy(policy.Name, k.namespace, policy)
+		if err := resource.Apply(context.TODO(), k.client()); err != nil {
+			return nil, errors.Annotatef(err, "ensuring network policy %q", policy.Name)
+		}
+		policyNames = append(policyNames, policy.Name)
+	}
+	return policyNames, nil
+}
+
+// ModelNetworkPolicies returns the names of the network policies Juju
+// has created in the model's namespace.
+func (k *kubernetesClient) ModelNetworkPolicies() ([]string, error) {
+	if k.namespace == "" {
+		return nil, errNoNamespace
+	}
+	policies, err := k.client().NetworkingV1().NetworkPolicies(k.namespace).List(context.TODO(), v1.ListOptions{
+		LabelSelector: utils.LabelsForModel(k.CurrentModel(), k.IsLegacyLabels()).String(),
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	policyNames := make([]string, len(policies.Items))
+	for i, policy := range policies.Items {
+		policyNames[i] = policy.Name
+	}
+	return policyNames, nil
+}
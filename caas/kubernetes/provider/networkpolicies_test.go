@@ -0,0 +1,49 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NetworkPoliciesSuite struct{}
+
+var _ = gc.Suite(&NetworkPoliciesSuite{})
+
+func (s *NetworkPoliciesSuite) TestModelNetworkPolicies(c *gc.C) {
+	labels := map[string]string{"model.juju.is/name": "test"}
+	policies := modelNetworkPolicies("test-namespace", labels)
+	c.Assert(policies, gc.HasLen, 2)
+
+	modelTraffic := policies[0]
+	c.Assert(modelTraffic.Name, gc.Equals, "juju-model-traffic")
+	c.Assert(modelTraffic.Namespace, gc.Equals, "test-namespace")
+	c.Assert(modelTraffic.Labels, jc.DeepEquals, labels)
+	c.Assert(modelTraffic.Spec.PolicyTypes, jc.DeepEquals, []networkingv1.PolicyType{
+		networkingv1.PolicyTypeIngress,
+	})
+	// All pods in the namespace accept traffic from all pods in the
+	// same namespace.
+	c.Assert(modelTraffic.Spec.PodSelector, jc.DeepEquals, meta.LabelSelector{})
+	c.Assert(modelTraffic.Spec.Ingress, jc.DeepEquals, []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{
+			PodSelector: &meta.LabelSelector{},
+		}},
+	}})
+
+	controllerEgress := policies[1]
+	c.Assert(controllerEgress.Name, gc.Equals, "juju-controller-egress")
+	c.Assert(controllerEgress.Namespace, gc.Equals, "test-namespace")
+	c.Assert(controllerEgress.Labels, jc.DeepEquals, labels)
+	c.Assert(controllerEgress.Spec.PolicyTypes, jc.DeepEquals, []networkingv1.PolicyType{
+		networkingv1.PolicyTypeEgress,
+	})
+	// All pods may make egress connections, restoring agent access to
+	// the controller API and cluster DNS in default-deny namespaces.
+	c.Assert(controllerEgress.Spec.PodSelector, jc.DeepEquals, meta.LabelSelector{})
+	c.Assert(controllerEgress.Spec.Egress, jc.DeepEquals, []networkingv1.NetworkPolicyEgressRule{{}})
+}
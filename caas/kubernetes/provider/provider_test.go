@@ -27,10 +27,11 @@ func fakeConfig(c *gc.C, attrs ...coretesting.Attrs) *config.Config {
 
 func fakeConfigAttrs(attrs ...coretesting.Attrs) coretesting.Attrs {
 	merged := coretesting.FakeConfig().Merge(coretesting.Attrs{
-		"type":             "kubernetes",
-		"uuid":             utils.MustNewUUID().String(),
-		"operator-storage": "",
-		"workload-storage": "",
+		"type":                    "kubernetes",
+		"uuid":                    utils.MustNewUUID().String(),
+		"operator-storage":        "",
+		"workload-storage":        "",
+		"create-network-policies": false,
 	})
 	for _, attrs := range attrs {
 		merged = merged.Merge(attrs)
@@ -43,6 +43,11 @@ var configSchema = environschema.Fields{
 		Group:       environschema.AccountGroup,
 		Immutable:   true,
 	},
+	k8sconstants.CreateNetworkPoliciesKey: {
+		Description: "Whether to create the network policies required for Juju traffic in clusters with default-deny networking.",
+		Type:        environschema.Tbool,
+		Group:       environschema.AccountGroup,
+	},
 }
 
 var providerConfigFields = func() schema.Fields {
@@ -54,8 +59,9 @@ var providerConfigFields = func() schema.Fields {
 }()
 
 var providerConfigDefaults = schema.Defaults{
-	k8sconstants.WorkloadStorageKey: "",
-	k8sconstants.OperatorStorageKey: "",
+	k8sconstants.WorkloadStorageKey:       "",
+	k8sconstants.OperatorStorageKey:       "",
+	k8sconstants.CreateNetworkPoliciesKey: false,
 }
 
 type brokerConfig struct {
@@ -157,6 +157,7 @@ type addUnitCommand struct {
 	modelcmd.ModelCommandBase
 	UnitCommandBase
 	ApplicationName string
+	Plan            bool
 	api             applicationAddUnitAPI
 
 	unknownModel bool
@@ -174,6 +175,7 @@ func (c *addUnitCommand) Info() *cmd.Info {
 func (c *addUnitCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.UnitCommandBase.SetFlags(f)
 	f.IntVar(&c.NumUnits, "n", 1, "Number of units to add")
+	f.BoolVar(&c.Plan, "plan", false, planFlagDescription)
 }
 
 func (c *addUnitCommand) Init(args []string) error {
@@ -217,6 +219,7 @@ type applicationAddUnitAPI interface {
 	ModelUUID() string
 	AddUnits(application.AddUnitsParams) ([]string, error)
 	ScaleApplication(application.ScaleApplicationParams) (params.ScaleApplicationResult, error)
+	ApplicationsInfo([]names.ApplicationTag) ([]params.ApplicationInfoResult, error)
 }
 
 func (c *addUnitCommand) getAPI() (applicationAddUnitAPI, error) {
@@ -239,6 +242,10 @@ func (c *addUnitCommand) Run(ctx *cmd.Context) error {
 	}
 	defer apiclient.Close()
 
+	if c.Plan {
+		return c.printAddUnitPlan(ctx, apiclient)
+	}
+
 	if c.unknownModel {
 		if err := c.validateArgsByModelType(); err != nil {
 			return errors.Trace(err)
@@ -291,6 +298,36 @@ func (c *addUnitCommand) Run(ctx *cmd.Context) error {
 	return block.ProcessBlockedError(err, block.BlockChange)
 }
 
+// printAddUnitPlan resolves the application against the controller and
+// prints the units the command would add without adding them.
+func (c *addUnitCommand) printAddUnitPlan(ctx *cmd.Context, apiclient applicationAddUnitAPI) error {
+	results, err := apiclient.ApplicationsInfo([]names.ApplicationTag{names.NewApplicationTag(c.ApplicationName)})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return errors.Trace(results[0].Error)
+	}
+	details := map[string]interface{}{
+		"application": c.ApplicationName,
+		"charm":       results[0].Result.Charm,
+		"num-units":   c.NumUnits,
+	}
+	if c.PlacementSpec != "" {
+		details["placement"] = c.PlacementSpec
+	}
+	if len(c.AttachStorage) > 0 {
+		details["attach-storage"] = c.AttachStorage
+	}
+	return printPlan(ctx, "add-unit", planChange{
+		Kind:    "add-units",
+		Details: details,
+	})
+}
+
 // deployTarget describes the format a machine or container target must match to be valid.
 const deployTarget = "^(" + names.ContainerTypeSnippet + ":)?" + names.MachineSnippet + "$"
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/cmd/v3/cmdtesting"
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -33,6 +34,7 @@ type AddUnitSuite struct {
 type fakeApplicationAddUnitAPI struct {
 	envType        string
 	application    string
+	charmURL       string
 	numUnits       int
 	placement      []*instance.Placement
 	attachStorage  []string
@@ -66,6 +68,21 @@ func (f *fakeApplicationAddUnitAPI) AddUnits(args apiapplication.AddUnitsParams)
 	return nil, nil
 }
 
+func (f *fakeApplicationAddUnitAPI) ApplicationsInfo(tags []names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(tags) != 1 || tags[0].Id() != f.application {
+		return nil, errors.NotFoundf("application %q", tags)
+	}
+	return []params.ApplicationInfoResult{{
+		Result: &params.ApplicationResult{
+			Tag:   tags[0].String(),
+			Charm: f.charmURL,
+		},
+	}}, nil
+}
+
 func (f *fakeApplicationAddUnitAPI) ScaleApplication(args apiapplication.ScaleApplicationParams) (params.ScaleApplicationResult, error) {
 	if f.err != nil {
 		return params.ScaleApplicationResult{}, f.err
@@ -156,6 +173,38 @@ func (s *AddUnitSuite) TestAddUnit(c *gc.C) {
 	c.Assert(s.fake.numUnits, gc.Equals, 4)
 }
 
+func (s *AddUnitSuite) TestAddUnitPlan(c *gc.C) {
+	s.fake.charmURL = "ch:mysql-42"
+	ctx, err := cmdtesting.RunCommand(c, application.NewAddUnitCommandForTest(s.fake, s.store),
+		"some-application-name", "-n", "2", "--to", "lxd:1", "--plan")
+	c.Assert(err, jc.ErrorIsNil)
+	// The plan is printed without adding any units.
+	c.Assert(s.fake.numUnits, gc.Equals, 1)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+{
+  "command": "add-unit",
+  "changes": [
+    {
+      "kind": "add-units",
+      "details": {
+        "application": "some-application-name",
+        "charm": "ch:mysql-42",
+        "num-units": 2,
+        "placement": "lxd:1"
+      }
+    }
+  ]
+}
+`[1:])
+}
+
+func (s *AddUnitSuite) TestAddUnitPlanUnknownApplication(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, application.NewAddUnitCommandForTest(s.fake, s.store),
+		"mysterious-application", "--plan")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(s.fake.numUnits, gc.Equals, 1)
+}
+
 func (s *AddUnitSuite) TestAddUnitWithPlacement(c *gc.C) {
 	err := s.runAddUnit(c, "some-application-name")
 	c.Assert(err, jc.ErrorIsNil)
@@ -4,6 +4,7 @@
 package application
 
 import (
+	"os"
 	"strconv"
 	"strings"
 
@@ -33,6 +34,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/application/deployer"
 	"github.com/juju/juju/cmd/juju/application/store"
+	"github.com/juju/juju/cmd/juju/application/utils"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -348,6 +350,10 @@ type DeployCommand struct {
 	// deployed but just output the changes.
 	DryRun bool
 
+	// Plan is used to print the intended changes as JSON without
+	// contacting the controller.
+	Plan bool
+
 	ApplicationName  string
 	ConfigOptions    common.ConfigFlag
 	ConstraintsStr   string
@@ -702,6 +708,7 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.Series, "series", "", "The series on which to deploy")
 	f.IntVar(&c.Revision, "revision", -1, "The revision to deploy")
 	f.BoolVar(&c.DryRun, "dry-run", false, "Just show what the deploy would do")
+	f.BoolVar(&c.Plan, "plan", false, planFlagDescription)
 	f.BoolVar(&c.Force, "force", false, "Allow a charm/bundle to be deployed which bypasses checks such as supported series or LXD profile allow list")
 	f.Var(storageFlag{&c.Storage, &c.BundleStorage}, "storage", "Charm storage constraints")
 	f.Var(devicesFlag{&c.Devices, &c.BundleDevices}, "device", "Charm device constraints")
@@ -839,6 +846,94 @@ func parseMachineMap(value string) (bool, map[string]string, error) {
 	return useExisting, mapping, nil
 }
 
+// printDeployPlan resolves the charm or bundle against the controller,
+// the same way a real deploy would, and prints the resolved deployment
+// as a plan document without deploying anything.
+func (c *DeployCommand) printDeployPlan(ctx *cmd.Context, resolver deployer.Resolver, defaultCharmSchema charm.Schema) error {
+	path, err := charm.EnsureSchema(c.CharmOrBundle, defaultCharmSchema)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	curl, err := charm.ParseURL(path)
+	if err != nil {
+		if _, statErr := os.Stat(c.CharmOrBundle); statErr == nil {
+			return errors.NotSupportedf("--plan with a local charm or bundle")
+		}
+		return errors.Trace(err)
+	}
+	if charm.Local.Matches(curl.Schema) {
+		return errors.NotSupportedf("--plan with a local charm or bundle")
+	}
+	platform, err := utils.DeducePlatform(c.Constraints, c.Series, c.ModelConstraints)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	urlForOrigin := curl
+	if c.Revision != -1 {
+		urlForOrigin = urlForOrigin.WithRevision(c.Revision)
+	}
+	origin, err := utils.DeduceOrigin(urlForOrigin, c.Channel, platform)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// The URL may name either a charm or a bundle; try the bundle
+	// first, the way the deployer factory does.
+	bundleURL, bundleOrigin, err := resolver.ResolveBundleURL(curl, origin)
+	if err == nil {
+		details := map[string]interface{}{
+			"bundle": bundleURL.String(),
+		}
+		if channel := bundleOrigin.CharmChannel().String(); channel != "" {
+			details["channel"] = channel
+		}
+		return printPlan(ctx, "deploy", planChange{
+			Kind:    "deploy-bundle",
+			Details: details,
+		})
+	}
+	if !errors.IsNotValid(err) {
+		return errors.Trace(err)
+	}
+
+	resolvedURL, resolvedOrigin, supportedSeries, err := resolver.ResolveCharm(curl, origin, false)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	applicationName := c.ApplicationName
+	if applicationName == "" {
+		applicationName = resolvedURL.Name
+	}
+	details := map[string]interface{}{
+		"application": applicationName,
+		"charm":       resolvedURL.String(),
+		"num-units":   c.NumUnits,
+	}
+	if channel := resolvedOrigin.CharmChannel().String(); channel != "" {
+		details["channel"] = channel
+	}
+	deploySeries := c.Series
+	if deploySeries == "" && len(supportedSeries) > 0 {
+		deploySeries = supportedSeries[0]
+	}
+	if deploySeries != "" {
+		details["series"] = deploySeries
+	}
+	if c.Trust {
+		details["trust"] = c.Trust
+	}
+	if c.ConstraintsStr != "" {
+		details["constraints"] = c.ConstraintsStr
+	}
+	if c.PlacementSpec != "" {
+		details["placement"] = c.PlacementSpec
+	}
+	return printPlan(ctx, "deploy", planChange{
+		Kind:    "deploy-charm",
+		Details: details,
+	})
+}
+
 // Run executes a deploy command with a given context.
 func (c *DeployCommand) Run(ctx *cmd.Context) error {
 	if c.unknownModel {
@@ -903,6 +998,10 @@ func (c *DeployCommand) Run(ctx *cmd.Context) error {
 		defaultCharmSchema = charm.CharmStore
 	}
 
+	if c.Plan {
+		return c.printDeployPlan(ctx, charmAdapter, defaultCharmSchema)
+	}
+
 	factory, cfg := c.getDeployerFactory(defaultCharmSchema)
 	deploy, err := factory.GetDeployer(cfg, deployAPI, charmAdapter)
 	if err != nil {
@@ -727,7 +727,7 @@ func (h *bundleHandler) addCharm(change *bundlechanges.AddCharmChange) error {
 		}
 
 		// Get the series to use.
-		resolvedOrigin.Series, err = selector.charmSeries()
+		resolvedOrigin.Series, resolvedOrigin.BaseSelection, err = selector.charmSeriesWithSelection()
 		if err != nil {
 			return errors.Trace(err)
 		}
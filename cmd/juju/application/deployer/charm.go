@@ -490,7 +490,7 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 	}
 
 	// Get the series to use.
-	series, err := selector.charmSeries()
+	series, baseSelection, err := selector.charmSeriesWithSelection()
 	logger.Tracef("Using series %q from %v to deploy %v", series, supportedSeries, userRequestedURL)
 
 	imageStream := modelCfg.ImageStream()
@@ -513,8 +513,9 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 		return errors.Trace(validationErr)
 	}
 
-	// Ensure we save the origin.
+	// Ensure we save the origin, recording how the series was selected.
 	origin = origin.WithSeries(series)
+	origin.BaseSelection = baseSelection
 
 	// In-order for the url to represent the following updates to the the origin
 	// and machine, we need to ensure that the series is actually correct as
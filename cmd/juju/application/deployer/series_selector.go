@@ -8,6 +8,8 @@ import (
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 
+	coreseries "github.com/juju/juju/core/series"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/version"
 )
 
@@ -15,6 +17,17 @@ const (
 	msgUserRequestedSeries = "with the user specified series %q"
 	msgBundleSeries        = "with the series %q defined by the bundle"
 	msgLatestLTSSeries     = "with the latest LTS series %q"
+	msgLatestSupportedLTS  = "with the latest LTS series %q supported by the charm"
+)
+
+// Base selection decisions, recorded in the charm origin so that it
+// is auditable how the series of a deployed charm was chosen.
+const (
+	baseSelectionUser         = "user"
+	baseSelectionBundle       = "bundle"
+	baseSelectionModelDefault = "model-default"
+	baseSelectionLatestLTS    = "latest-lts"
+	baseSelectionCharmDefault = "charm-default"
 )
 
 type modelConfig interface {
@@ -22,6 +35,11 @@ type modelConfig interface {
 	// for the environment, and whether the default series was
 	// explicitly configured on the environment.
 	DefaultSeries() (string, bool)
+
+	// BaseSelectionPolicy returns the configured policy used to
+	// select the base for a charm when the user does not specify
+	// one.
+	BaseSelectionPolicy() string
 }
 
 // seriesSelector is a helper type that determines what series the charm should
@@ -52,31 +70,54 @@ type seriesSelector struct {
 }
 
 // charmSeries determines what series to use with a charm.
+func (s seriesSelector) charmSeries() (selectedSeries string, err error) {
+	selectedSeries, _, err = s.charmSeriesWithSelection()
+	return selectedSeries, err
+}
+
+// charmSeriesWithSelection determines what series to use with a charm,
+// and reports how the decision was made so that it can be recorded in
+// the charm origin.
 // Order of preference is:
 //   - user requested with --series or defined by bundle when deploying
 //   - user requested in charm's url (e.g. juju deploy precise/ubuntu)
 //     old charmstore style urls only
+//   - charm's newest supported LTS, if the model's base selection
+//     policy is "latest-lts"
 //   - model default, if set, acts like --series
 //   - default from charm metadata supported series / series in url
 //   - default LTS
-func (s seriesSelector) charmSeries() (selectedSeries string, err error) {
+func (s seriesSelector) charmSeriesWithSelection() (string, string, error) {
 	// TODO(sidecar): handle systems
 
 	// User has requested a series with --series.
 	if s.seriesFlag != "" {
-		return s.userRequested(s.seriesFlag)
+		series, err := s.userRequested(s.seriesFlag)
+		return series, s.userSelection(), err
 	}
 
 	// User specified a series in the charm URL, e.g.
 	// juju deploy precise/ubuntu.
 	if s.charmURLSeries != "" {
-		return s.userRequested(s.charmURLSeries)
+		series, err := s.userRequested(s.charmURLSeries)
+		return series, s.userSelection(), err
 	}
 
 	// No series explicitly requested by the user.
+	// If the model tracks the latest supported LTS, prefer the newest
+	// LTS release supported by both the charm and Juju over the model
+	// default.
+	if s.conf.BaseSelectionPolicy() == config.BaseSelectionLatestLTS {
+		if series := s.latestSupportedLTS(); series != "" {
+			logger.Infof(msgLatestSupportedLTS, series)
+			return series, baseSelectionLatestLTS, nil
+		}
+	}
+
 	// Use model default series, if explicitly set and supported by the charm.
 	if defaultSeries, explicit := s.conf.DefaultSeries(); explicit {
-		return s.userRequested(defaultSeries)
+		series, err := s.userRequested(defaultSeries)
+		return series, baseSelectionModelDefault, err
 	}
 
 	// Next fall back to the charm's list of series, filtered to what's supported
@@ -91,7 +132,7 @@ func (s seriesSelector) charmSeries() (selectedSeries string, err error) {
 	}
 	defaultSeries, err := charm.SeriesForCharm("", supportedSeries)
 	if err == nil {
-		return defaultSeries, nil
+		return defaultSeries, baseSelectionCharmDefault, nil
 	}
 
 	// Charm hasn't specified a default (likely due to being a local charm
@@ -102,12 +143,41 @@ func (s seriesSelector) charmSeries() (selectedSeries string, err error) {
 	if !s.force {
 		// We know err is not nil due to above, so return the error
 		// returned to us from the charm call.
-		return "", err
+		return "", "", err
 	}
 
 	latestLTS := version.DefaultSupportedLTS()
 	logger.Infof(msgLatestLTSSeries, latestLTS)
-	return latestLTS, nil
+	return latestLTS, baseSelectionLatestLTS, nil
+}
+
+// userSelection reports whether a user requested series came from the
+// command line or from a bundle spec.
+func (s seriesSelector) userSelection() string {
+	if s.fromBundle {
+		return baseSelectionBundle
+	}
+	return baseSelectionUser
+}
+
+// latestSupportedLTS returns the newest Ubuntu LTS release supported
+// by both the charm and this version of Juju, or the empty string if
+// there is none.
+func (s seriesSelector) latestSupportedLTS() string {
+	var latest, latestVersion string
+	for _, charmSeries := range s.supportedSeries {
+		if !s.supportedJujuSeries.Contains(charmSeries) || !coreseries.IsLts(charmSeries) {
+			continue
+		}
+		seriesVersion, err := coreseries.SeriesVersion(charmSeries)
+		if err != nil {
+			continue
+		}
+		if seriesVersion > latestVersion {
+			latest, latestVersion = charmSeries, seriesVersion
+		}
+	}
+	return latest
 }
 
 // userRequested checks the series the user has requested, and returns it if it
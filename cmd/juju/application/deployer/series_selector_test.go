@@ -9,6 +9,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/series"
+	"github.com/juju/juju/environs/config"
 )
 
 type SeriesSelectorSuite struct{}
@@ -296,6 +297,99 @@ func (s *SeriesSelectorSuite) TestCharmSeries(c *gc.C) {
 	}
 }
 
+func (s *SeriesSelectorSuite) TestCharmSeriesWithSelection(c *gc.C) {
+	selectionTests := []struct {
+		title string
+
+		seriesSelector
+
+		expectedSeries    string
+		expectedSelection string
+	}{
+		{
+			title: "user requested series",
+			seriesSelector: seriesSelector{
+				seriesFlag:          "bionic",
+				supportedSeries:     []string{"bionic", "focal"},
+				supportedJujuSeries: set.NewStrings("bionic", "focal"),
+				conf:                latestLTSPolicy{},
+			},
+			expectedSeries:    "bionic",
+			expectedSelection: "user",
+		},
+		{
+			title: "series defined by the bundle",
+			seriesSelector: seriesSelector{
+				seriesFlag:          "bionic",
+				supportedSeries:     []string{"bionic", "focal"},
+				supportedJujuSeries: set.NewStrings("bionic", "focal"),
+				conf:                defaultSeries{},
+				fromBundle:          true,
+			},
+			expectedSeries:    "bionic",
+			expectedSelection: "bundle",
+		},
+		{
+			title: "latest-lts policy overrides the model default",
+			seriesSelector: seriesSelector{
+				supportedSeries:     []string{"bionic", "focal", "cosmic"},
+				supportedJujuSeries: set.NewStrings("bionic", "focal", "cosmic"),
+				conf:                latestLTSPolicy{defaultSeries{"bionic", true}},
+			},
+			expectedSeries:    "focal",
+			expectedSelection: "latest-lts",
+		},
+		{
+			title: "latest-lts policy ignores LTS releases juju does not support",
+			seriesSelector: seriesSelector{
+				supportedSeries:     []string{"bionic", "focal"},
+				supportedJujuSeries: set.NewStrings("bionic", "cosmic"),
+				conf:                latestLTSPolicy{},
+			},
+			expectedSeries:    "bionic",
+			expectedSelection: "latest-lts",
+		},
+		{
+			title: "latest-lts policy falls back to the model default when the charm supports no LTS",
+			seriesSelector: seriesSelector{
+				supportedSeries:     []string{"cosmic"},
+				supportedJujuSeries: set.NewStrings("cosmic"),
+				conf:                latestLTSPolicy{defaultSeries{"cosmic", true}},
+			},
+			expectedSeries:    "cosmic",
+			expectedSelection: "model-default",
+		},
+		{
+			title: "pinned policy uses the model default",
+			seriesSelector: seriesSelector{
+				supportedSeries:     []string{"bionic", "focal"},
+				supportedJujuSeries: set.NewStrings("bionic", "focal"),
+				conf:                defaultSeries{"bionic", true},
+			},
+			expectedSeries:    "bionic",
+			expectedSelection: "model-default",
+		},
+		{
+			title: "charm default when nothing else is specified",
+			seriesSelector: seriesSelector{
+				supportedSeries:     []string{"bionic", "focal"},
+				supportedJujuSeries: set.NewStrings("bionic", "focal"),
+				conf:                defaultSeries{},
+			},
+			expectedSeries:    "bionic",
+			expectedSelection: "charm-default",
+		},
+	}
+
+	for i, test := range selectionTests {
+		c.Logf("test %d [%s]", i, test.title)
+		series, selection, err := test.seriesSelector.charmSeriesWithSelection()
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(series, gc.Equals, test.expectedSeries)
+		c.Check(selection, gc.Equals, test.expectedSelection)
+	}
+}
+
 type defaultSeries struct {
 	series   string
 	explicit bool
@@ -304,3 +398,15 @@ type defaultSeries struct {
 func (d defaultSeries) DefaultSeries() (string, bool) {
 	return d.series, d.explicit
 }
+
+func (d defaultSeries) BaseSelectionPolicy() string {
+	return config.BaseSelectionPinned
+}
+
+type latestLTSPolicy struct {
+	defaultSeries
+}
+
+func (l latestLTSPolicy) BaseSelectionPolicy() string {
+	return config.BaseSelectionLatestLTS
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/cmd/v3"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/api/client/application"
 	jujucmd "github.com/juju/juju/cmd"
@@ -79,6 +80,7 @@ type exposeCommand struct {
 	ExposedEndpointsList string
 	ExposeToSpacesList   string
 	ExposeToCIDRsList    string
+	Plan                 bool
 }
 
 func (c *exposeCommand) Info() *cmd.Info {
@@ -95,6 +97,7 @@ func (c *exposeCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.ExposedEndpointsList, "endpoints", "", "Expose only the ports that charms have opened for this comma-delimited list of endpoints")
 	f.StringVar(&c.ExposeToSpacesList, "to-spaces", "", "A comma-delimited list of spaces that should be able to access the application ports once exposed")
 	f.StringVar(&c.ExposeToCIDRsList, "to-cidrs", "", "A comma-delimited list of CIDRs that should be able to access the application ports once exposed")
+	f.BoolVar(&c.Plan, "plan", false, planFlagDescription)
 }
 
 func (c *exposeCommand) Init(args []string) error {
@@ -109,6 +112,7 @@ type applicationExposeAPI interface {
 	Close() error
 	Expose(applicationName string, exposedEndpoints map[string]params.ExposedEndpoint) error
 	Unexpose(applicationName string, exposedEndpoints []string) error
+	ApplicationsInfo(applications []names.ApplicationTag) ([]params.ApplicationInfoResult, error)
 }
 
 func (c *exposeCommand) getAPI() (applicationExposeAPI, error) {
@@ -121,7 +125,7 @@ func (c *exposeCommand) getAPI() (applicationExposeAPI, error) {
 
 // Run changes the juju-managed firewall to expose any
 // ports that were also explicitly marked by units as open.
-func (c *exposeCommand) Run(_ *cmd.Context) error {
+func (c *exposeCommand) Run(ctx *cmd.Context) error {
 	client, err := c.getAPI()
 	if err != nil {
 		return err
@@ -129,9 +133,44 @@ func (c *exposeCommand) Run(_ *cmd.Context) error {
 	defer client.Close()
 
 	exposedEndpoints := c.buildExposedEndpoints()
+	if c.Plan {
+		return c.printExposePlan(ctx, client, exposedEndpoints)
+	}
+
 	return block.ProcessBlockedError(client.Expose(c.ApplicationName, exposedEndpoints), block.BlockChange)
 }
 
+// printExposePlan fetches the application's current exposure from the
+// controller and prints it alongside the requested exposure, without
+// changing anything.
+func (c *exposeCommand) printExposePlan(ctx *cmd.Context, client applicationExposeAPI, desired map[string]params.ExposedEndpoint) error {
+	results, err := client.ApplicationsInfo([]names.ApplicationTag{names.NewApplicationTag(c.ApplicationName)})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return errors.Trace(results[0].Error)
+	}
+	app := results[0].Result
+	details := map[string]interface{}{
+		"application":     c.ApplicationName,
+		"already-exposed": app.Exposed,
+	}
+	if len(app.ExposedEndpoints) > 0 {
+		details["current-exposed-endpoints"] = app.ExposedEndpoints
+	}
+	if len(desired) > 0 {
+		details["exposed-endpoints"] = desired
+	}
+	return printPlan(ctx, "expose", planChange{
+		Kind:    "expose-application",
+		Details: details,
+	})
+}
+
 func (c *exposeCommand) buildExposedEndpoints() map[string]params.ExposedEndpoint {
 	endpoints := splitCommaDelimitedList(c.ExposedEndpointsList)
 	spaces := splitCommaDelimitedList(c.ExposeToSpacesList)
@@ -0,0 +1,60 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// planFlagDescription is the help text shared by every command that
+// supports the --plan flag.
+const planFlagDescription = "Print the changes the command would make as JSON without applying them"
+
+// planDocument is the document emitted by the --plan flag of mutating
+// commands, so that CI pipelines can assert on the intended changes
+// before they are applied.
+type planDocument struct {
+	Command string       `json:"command"`
+	Changes []planChange `json:"changes"`
+}
+
+// planChange is a single intended change within a plan.
+type planChange struct {
+	Kind    string                 `json:"kind"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// printPlan writes the command's intended changes to stdout as JSON.
+// Callers must return immediately afterwards without executing them.
+func printPlan(ctx *cmd.Context, command string, changes ...planChange) error {
+	doc := planDocument{Command: command, Changes: changes}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Fprintln(ctx.Stdout, string(out))
+	return nil
+}
+
+// planEntityIds converts a slice of entity references, as returned by
+// the API, into their ids for inclusion in plan details.
+func planEntityIds(entities []params.Entity) []string {
+	ids := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			ids = append(ids, entity.Tag)
+			continue
+		}
+		ids = append(ids, tag.Id())
+	}
+	return ids
+}
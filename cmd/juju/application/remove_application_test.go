@@ -49,6 +49,48 @@ func (s *RemoveApplicationCmdSuite) TestForceFlagSet(c *gc.C) {
 	s.assertAPIForceFlag(c, []string{"real-app", "--force"}, true)
 }
 
+func (s *RemoveApplicationCmdSuite) TestPlan(c *gc.C) {
+	s.apiFunc = func() (application.RemoveApplicationAPI, int, error) {
+		return s.api, 18, nil
+	}
+	s.api.destroyApplications = func(args apiapplication.DestroyApplicationsParams) ([]params.DestroyApplicationResult, error) {
+		c.Assert(args.DryRun, jc.IsTrue)
+		return []params.DestroyApplicationResult{{
+			Info: &params.DestroyApplicationInfo{
+				DestroyedUnits:  []params.Entity{{Tag: "unit-real-app-0"}},
+				DetachedStorage: []params.Entity{{Tag: "storage-data-0"}},
+			},
+		}}, nil
+	}
+	ctx, err := s.runRemoveApplication(c, "real-app", "--plan")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+{
+  "command": "remove-application",
+  "changes": [
+    {
+      "kind": "remove-application",
+      "details": {
+        "application": "real-app",
+        "destroyed-units": [
+          "real-app/0"
+        ],
+        "detached-storage": [
+          "data/0"
+        ]
+      }
+    }
+  ]
+}
+`[1:])
+	s.api.CheckCallNames(c, "DestroyApplications", "Close")
+}
+
+func (s *RemoveApplicationCmdSuite) TestPlanNotSupported(c *gc.C) {
+	_, err := s.runRemoveApplication(c, "real-app", "--plan")
+	c.Assert(err, gc.ErrorMatches, "--plan is not supported by this controller")
+}
+
 func (s *RemoveApplicationCmdSuite) runRemoveApplication(c *gc.C, args ...string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, application.NewRemoveApplicationCommandForTest(s.apiFunc, s.store), args...)
 }
@@ -39,6 +39,7 @@ type removeApplicationCommand struct {
 	DestroyStorage   bool
 	Force            bool
 	NoWait           bool
+	Plan             bool
 	fs               *gnuflag.FlagSet
 }
 
@@ -90,6 +91,7 @@ func (c *removeApplicationCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.DestroyStorage, "destroy-storage", false, "Destroy storage attached to application units")
 	f.BoolVar(&c.Force, "force", false, "Completely remove an application and all its dependencies")
 	f.BoolVar(&c.NoWait, "no-wait", false, "Rush through application removal without waiting for each individual step to complete")
+	f.BoolVar(&c.Plan, "plan", false, planFlagDescription)
 	c.fs = f
 }
 
@@ -193,6 +195,12 @@ func (c *removeApplicationCommand) Run(ctx *cmd.Context) error {
 	}
 	defer client.Close()
 
+	if c.Plan {
+		if apiVersion < 18 {
+			return errors.New("--plan is not supported by this controller")
+		}
+		return c.printRemovalPlan(ctx, client)
+	}
 	if apiVersion < 4 {
 		return c.removeApplicationsDeprecated(ctx, client)
 	}
@@ -281,3 +289,55 @@ func (c *removeApplicationCommand) removeApplications(
 	}
 	return nil
 }
+
+// printRemovalPlan asks the controller for the projected effects of the
+// removal, via a dry run, and prints them without removing anything.
+func (c *removeApplicationCommand) printRemovalPlan(
+	ctx *cmd.Context,
+	client RemoveApplicationAPI,
+) error {
+	var maxWait *time.Duration
+	if c.Force && c.NoWait {
+		zeroSec := 0 * time.Second
+		maxWait = &zeroSec
+	}
+	results, err := client.DestroyApplications(application.DestroyApplicationsParams{
+		Applications:   c.ApplicationNames,
+		DestroyStorage: c.DestroyStorage,
+		Force:          c.Force,
+		MaxWait:        maxWait,
+		DryRun:         true,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	changes := make([]planChange, len(results))
+	for i, name := range c.ApplicationNames {
+		result := results[i]
+		if result.Error != nil {
+			return errors.Annotatef(result.Error, "cannot plan removal of application %s", name)
+		}
+		details := map[string]interface{}{
+			"application": name,
+		}
+		if c.DestroyStorage {
+			details["destroy-storage"] = true
+		}
+		if c.Force {
+			details["force"] = true
+		}
+		if info := result.Info; info != nil {
+			if ids := planEntityIds(info.DestroyedUnits); len(ids) > 0 {
+				details["destroyed-units"] = ids
+			}
+			if ids := planEntityIds(info.DestroyedStorage); len(ids) > 0 {
+				details["destroyed-storage"] = ids
+			}
+			if ids := planEntityIds(info.DetachedStorage); len(ids) > 0 {
+				details["detached-storage"] = ids
+			}
+		}
+		changes[i] = planChange{Kind: "remove-application", Details: details}
+	}
+	return printPlan(ctx, "remove-application", changes...)
+}
@@ -445,6 +445,16 @@ func (c *addCommand) tryManualProvision(client manual.ProvisioningClientAPI, con
 		return errors.Annotatef(err, "cannot reading authorized-keys")
 	}
 
+	// Pass the controller API addresses along so the provisioner can
+	// verify the target host can reach the controller before the
+	// machine is registered.
+	var apiAddrs []string
+	if controllerName, err := c.ControllerName(); err == nil {
+		if details, err := c.ClientStore().ControllerByName(controllerName); err == nil {
+			apiAddrs = details.APIEndpoints
+		}
+	}
+
 	user, host := splitUserHost(c.Placement.Directive)
 	args := manual.ProvisionMachineArgs{
 		Host:           host,
@@ -455,6 +465,7 @@ func (c *addCommand) tryManualProvision(client manual.ProvisioningClientAPI, con
 		Stderr:         ctx.Stderr,
 		AuthorizedKeys: authKeys,
 		PrivateKey:     c.PrivateKey,
+		APIAddresses:   apiAddrs,
 		UpdateBehavior: &params.UpdateBehavior{
 			EnableOSRefreshUpdate: config.EnableOSRefreshUpdate(),
 			EnableOSUpgrade:       config.EnableOSUpgrade(),
@@ -54,6 +54,7 @@ import (
 	"github.com/juju/juju/container/broker"
 	"github.com/juju/juju/container/kvm"
 	"github.com/juju/juju/controller"
+	corecontainer "github.com/juju/juju/core/container"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/machinelock"
@@ -64,6 +65,7 @@ import (
 	"github.com/juju/juju/core/raftlease"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/pluginprovider"
 	jujunames "github.com/juju/juju/juju/names"
 	"github.com/juju/juju/mongo"
 	"github.com/juju/juju/mongo/mongometrics"
@@ -631,10 +633,11 @@ func (a *MachineAgent) makeEngineCreator(
 			UnitEngineConfig: func() dependency.EngineConfig {
 				return engineConfigFunc(controllerMetricsSink)
 			},
-			SetupLogging:            agentconf.SetupAgentLogging,
-			LeaseFSM:                raftlease.NewFSM(),
-			RaftOpQueue:             queue.NewOpQueue(clock.WallClock),
-			DependencyEngineMetrics: metrics,
+			SetupLogging:             agentconf.SetupAgentLogging,
+			LeaseFSM:                 raftlease.NewFSM(),
+			RaftOpQueue:              queue.NewOpQueue(clock.WallClock),
+			DependencyEngineMetrics:  metrics,
+			DependencyEngineReporter: engine,
 		}
 		manifolds := iaasMachineManifolds(manifoldsCfg)
 		if a.isCaasAgent {
@@ -899,9 +902,11 @@ func (a *MachineAgent) setupContainerSupport(st api.Connection, logger machine.L
 	}
 	m := result[0].Machine
 
+	// A machine that is itself a container may not be able to nest
+	// every container type that its tooling supports.
 	var supportedContainers []instance.ContainerType
 	supportsContainers := container.ContainersSupported()
-	if supportsContainers {
+	if supportsContainers && corecontainer.NestingSupported(mTag.Id(), instance.LXD) {
 		supportedContainers = append(supportedContainers, instance.LXD)
 	}
 
@@ -909,7 +914,7 @@ func (a *MachineAgent) setupContainerSupport(st api.Connection, logger machine.L
 	if err != nil {
 		logger.Warningf("determining kvm support: %v\nno kvm containers possible", err)
 	}
-	if err == nil && supportsKvm {
+	if err == nil && supportsKvm && corecontainer.NestingSupported(mTag.Id(), instance.KVM) {
 		supportedContainers = append(supportedContainers, instance.KVM)
 	}
 	logger.Debugf("Supported container types %q", supportedContainers)
@@ -982,6 +987,14 @@ func (a *MachineAgent) initState(agentConfig agent.Config) (*state.StatePool, er
 	}
 	reportOpenedState(systemState)
 
+	// Register any out-of-tree provider plugins listed in controller
+	// config before the model workers start asking for environs.
+	controllerConfig, err := systemState.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pluginprovider.RegisterFromControllerConfig(controllerConfig)
+
 	return pool, nil
 }
 
@@ -46,16 +46,20 @@ import (
 	"github.com/juju/juju/worker/apiservercertwatcher"
 	"github.com/juju/juju/worker/auditconfigupdater"
 	"github.com/juju/juju/worker/authenticationworker"
+	"github.com/juju/juju/worker/backupscheduler"
 	"github.com/juju/juju/worker/caasunitsmanager"
 	"github.com/juju/juju/worker/caasupgrader"
 	"github.com/juju/juju/worker/centralhub"
+	"github.com/juju/juju/worker/certrotator"
 	"github.com/juju/juju/worker/certupdater"
 	"github.com/juju/juju/worker/common"
 	lxdbroker "github.com/juju/juju/worker/containerbroker"
+	"github.com/juju/juju/worker/controllerconfigupdater"
 	"github.com/juju/juju/worker/controllerport"
 	"github.com/juju/juju/worker/credentialvalidator"
 	"github.com/juju/juju/worker/deployer"
 	"github.com/juju/juju/worker/diskmanager"
+	"github.com/juju/juju/worker/enginehealth"
 	"github.com/juju/juju/worker/externalcontrollerupdater"
 	"github.com/juju/juju/worker/fanconfigurer"
 	"github.com/juju/juju/worker/fortress"
@@ -76,6 +80,7 @@ import (
 	"github.com/juju/juju/worker/modelcache"
 	"github.com/juju/juju/worker/modelworkermanager"
 	"github.com/juju/juju/worker/multiwatcher"
+	"github.com/juju/juju/worker/patchreporter"
 	"github.com/juju/juju/worker/peergrouper"
 	prworker "github.com/juju/juju/worker/presence"
 	"github.com/juju/juju/worker/provisioner"
@@ -286,6 +291,11 @@ type ManifoldsConfig struct {
 	// DependencyEngineMetrics creates a set of metrics for a model, so it's
 	// possible to know the lifecycle of the workers in the dependency engine.
 	DependencyEngineMetrics modelworkermanager.ModelMetrics
+
+	// DependencyEngineReporter reports on the agent's own dependency
+	// engine. Like the RaftOpQueue it exists outside the engine, so
+	// it is passed in directly rather than looked up as a resource.
+	DependencyEngineReporter enginehealth.Reporter
 }
 
 // commonManifolds returns a set of co-configured manifolds covering the
@@ -750,12 +760,26 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker: restorewatcher.NewWorker,
 		}),
 
+		backupSchedulerName: ifFullyUpgraded(backupscheduler.Manifold(backupscheduler.ManifoldConfig{
+			AgentName: agentName,
+			StateName: stateName,
+			Clock:     config.Clock,
+			Logger:    loggo.GetLogger("juju.worker.backupscheduler"),
+			NewWorker: backupscheduler.NewWorker,
+		})),
+
 		auditConfigUpdaterName: ifController(auditconfigupdater.Manifold(auditconfigupdater.ManifoldConfig{
 			AgentName: agentName,
 			StateName: stateName,
 			NewWorker: auditconfigupdater.New,
 		})),
 
+		controllerConfigUpdaterName: ifController(controllerconfigupdater.Manifold(controllerconfigupdater.ManifoldConfig{
+			StateName: stateName,
+			Logger:    loggo.GetLogger("juju.worker.controllerconfigupdater"),
+			NewWorker: controllerconfigupdater.NewWorker,
+		})),
+
 		raftTransportName: ifController(rafttransport.Manifold(rafttransport.ManifoldConfig{
 			ClockName:         clockName,
 			AgentName:         agentName,
@@ -897,6 +921,14 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewMachineAddressWatcher: certupdater.NewMachineAddressWatcher,
 		})),
 
+		certificateRotatorName: ifController(certrotator.Manifold(certrotator.ManifoldConfig{
+			AuthorityName:  certificateWatcherName,
+			CentralHubName: centralHubName,
+			Clock:          config.Clock,
+			Logger:         loggo.GetLogger("juju.worker.certrotator"),
+			NewWorker:      certrotator.NewWorker,
+		})),
+
 		// The machiner Worker will wait for the identified machine to become
 		// Dying and make it Dead; or until the machine becomes Dead by other
 		// means. This worker needs to be launched after fanconfigurer
@@ -907,6 +939,16 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			FanConfigurerName: fanConfigurerName,
 		})),
 
+		// The engine health worker summarises the dependency engine
+		// report and surfaces bouncing workers as agent status data.
+		engineHealthName: ifNotMigrating(enginehealth.Manifold(enginehealth.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			Reporter:      config.DependencyEngineReporter,
+			Clock:         config.Clock,
+			Logger:        loggo.GetLogger("juju.worker.enginehealth"),
+		})),
+
 		// The diskmanager worker periodically lists block devices on the
 		// machine it runs on. This worker will be run on all Juju-managed
 		// machines (one per machine agent).
@@ -915,6 +957,15 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			APICallerName: apiCallerName,
 		})),
 
+		// The patchreporter worker periodically reports pending OS
+		// updates and the reboot-required flag for the machine it
+		// runs on. This worker will be run on all Juju-managed
+		// machines (one per machine agent).
+		patchReporterName: ifNotMigrating(patchreporter.Manifold(patchreporter.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+		})),
+
 		// The api address updater is a leaf worker that rewrites agent config
 		// as the state server addresses change. We should only need one of
 		// these in a consolidated agent.
@@ -1182,6 +1233,8 @@ const (
 	rebootName                    = "reboot-executor"
 	loggingConfigUpdaterName      = "logging-config-updater"
 	diskManagerName               = "disk-manager"
+	patchReporterName             = "patch-reporter"
+	engineHealthName              = "engine-health"
 	proxyConfigUpdater            = "proxy-config-updater"
 	apiAddressUpdaterName         = "api-address-updater"
 	machinerName                  = "machiner"
@@ -1210,8 +1263,11 @@ const (
 	multiwatcherName              = "multiwatcher"
 	peergrouperName               = "peer-grouper"
 	restoreWatcherName            = "restore-watcher"
+	backupSchedulerName           = "backup-scheduler"
 	certificateUpdaterName        = "certificate-updater"
+	certificateRotatorName        = "certificate-rotator"
 	auditConfigUpdaterName        = "audit-config-updater"
+	controllerConfigUpdaterName   = "controller-config-updater"
 	leaseManagerName              = "lease-manager"
 	stateConverterName            = "state-converter"
 	lxdContainerProvisioner       = "lxd-container-provisioner"
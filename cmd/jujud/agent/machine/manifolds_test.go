@@ -65,14 +65,18 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"api-config-watcher",
 			"api-server",
 			"audit-config-updater",
+			"backup-scheduler",
 			"broker-tracker",
 			"central-hub",
+			"certificate-rotator",
 			"certificate-updater",
 			"certificate-watcher",
 			"clock",
+			"controller-config-updater",
 			"controller-port",
 			"deployer",
 			"disk-manager",
+			"engine-health",
 			"external-controller-updater",
 			"fan-configurer",
 			"host-key-reporter",
@@ -100,6 +104,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"model-cache-initialized-gate",
 			"model-worker-manager",
 			"multiwatcher",
+			"patch-reporter",
 			"peer-grouper",
 			"presence",
 			"proxy-config-updater",
@@ -149,9 +154,11 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *gc.C) {
 			"api-config-watcher",
 			"api-server",
 			"audit-config-updater",
+			"backup-scheduler",
 			"central-hub",
 			"certificate-watcher",
 			"clock",
+			"controller-config-updater",
 			"controller-port",
 			"external-controller-updater",
 			"http-server",
@@ -230,10 +237,13 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *gc.C) {
 		"api-config-watcher",
 		"api-server",
 		"audit-config-updater",
+		"backup-scheduler",
+		"certificate-rotator",
 		"certificate-updater",
 		"certificate-watcher",
 		"central-hub",
 		"clock",
+		"controller-config-updater",
 		"controller-port",
 		"deployer",
 		"global-clock-updater",
@@ -300,8 +310,10 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *gc.C) {
 
 	// Explicitly guarded by ifController.
 	controllerWorkers := set.NewStrings(
+		"certificate-rotator",
 		"certificate-watcher",
 		"audit-config-updater",
+		"controller-config-updater",
 		"is-primary-controller-flag",
 		"model-cache-initialized-flag",
 		"model-cache-initialized-gate",
@@ -500,6 +512,23 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"state-config-watcher",
 	},
 
+	"controller-config-updater": {
+		"agent",
+		"is-controller-flag",
+		"state",
+		"state-config-watcher",
+	},
+
+	"backup-scheduler": {
+		"agent",
+		"state",
+		"state-config-watcher",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"broker-tracker": {
 		"agent",
 		"api-caller",
@@ -514,6 +543,14 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"central-hub": {"agent", "state-config-watcher"},
 
+	"certificate-rotator": {
+		"agent",
+		"central-hub",
+		"certificate-watcher",
+		"is-controller-flag",
+		"state-config-watcher",
+	},
+
 	"certificate-updater": {
 		"agent",
 		"certificate-watcher",
@@ -563,6 +600,30 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-gate",
 	},
 
+	"patch-reporter": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
+	"engine-health": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"external-controller-updater": {
 		"agent",
 		"api-caller",
@@ -1210,6 +1271,23 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"state-config-watcher",
 	},
 
+	"controller-config-updater": {
+		"agent",
+		"is-controller-flag",
+		"state",
+		"state-config-watcher",
+	},
+
+	"backup-scheduler": {
+		"agent",
+		"state",
+		"state-config-watcher",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"central-hub": {"agent", "state-config-watcher"},
 
 	"certificate-watcher": {
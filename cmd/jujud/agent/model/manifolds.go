@@ -43,6 +43,7 @@ import (
 	"github.com/juju/juju/worker/charmrevision"
 	"github.com/juju/juju/worker/cleaner"
 	"github.com/juju/juju/worker/common"
+	"github.com/juju/juju/worker/credentialchecker"
 	"github.com/juju/juju/worker/credentialvalidator"
 	"github.com/juju/juju/worker/environ"
 	"github.com/juju/juju/worker/environupgrader"
@@ -59,6 +60,7 @@ import (
 	"github.com/juju/juju/worker/metricworker"
 	"github.com/juju/juju/worker/migrationflag"
 	"github.com/juju/juju/worker/migrationmaster"
+	"github.com/juju/juju/worker/modelalerts"
 	"github.com/juju/juju/worker/provisioner"
 	"github.com/juju/juju/worker/pruner"
 	"github.com/juju/juju/worker/remoterelations"
@@ -273,6 +275,13 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker: charmrevision.NewWorker,
 			Logger:    config.LoggingContext.GetLogger("juju.worker.charmrevision"),
 		})),
+		modelAlertsName: ifNotMigrating(modelalerts.Manifold(modelalerts.ManifoldConfig{
+			APICallerName: apiCallerName,
+			Clock:         config.Clock,
+			Logger:        config.LoggingContext.GetLogger("juju.worker.modelalerts"),
+			NewFacade:     modelalerts.NewAPIFacade,
+			NewWorker:     modelalerts.NewWorker,
+		})),
 		remoteRelationsName: ifNotMigrating(remoterelations.Manifold(remoterelations.ManifoldConfig{
 			AgentName:                agentName,
 			APICallerName:            apiCallerName,
@@ -419,6 +428,15 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker:     applicationscaler.New,
 			// No Logger defined in applicationscaler package.
 		})),
+		credentialCheckerName: ifNotMigrating(credentialchecker.Manifold(credentialchecker.ManifoldConfig{
+			APICallerName:                apiCallerName,
+			ClockName:                    clockName,
+			EnvironName:                  environTrackerName,
+			Logger:                       config.LoggingContext.GetLogger("juju.worker.credentialchecker"),
+			NewCredentialValidatorFacade: common.NewCredentialInvalidatorFacade,
+			NewFacade:                    credentialchecker.NewAPIFacade,
+			NewWorker:                    credentialchecker.NewWorker,
+		})),
 		instancePollerName: ifNotMigrating(instancepoller.Manifold(instancepoller.ManifoldConfig{
 			APICallerName:                apiCallerName,
 			EnvironName:                  environTrackerName,
@@ -700,6 +718,8 @@ const (
 	applicationScalerName    = "application-scaler"
 	instancePollerName       = "instance-poller"
 	charmRevisionUpdaterName = "charm-revision-updater"
+	modelAlertsName          = "model-alerts"
+	credentialCheckerName    = "credential-checker"
 	metricWorkerName         = "metric-worker"
 	stateCleanerName         = "state-cleaner"
 	statusHistoryPrunerName  = "status-history-pruner"
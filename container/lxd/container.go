@@ -29,6 +29,7 @@ const (
 	NetworkConfigKey    = UserNamespacePrefix + "network-config"
 	JujuModelKey        = UserNamespacePrefix + "juju-model"
 	AutoStartKey        = "boot.autostart"
+	NestingKey          = "security.nesting"
 )
 
 // ContainerSpec represents the data required to create a new container.
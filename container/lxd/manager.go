@@ -256,6 +256,9 @@ func (m *containerManager) getContainerSpec(
 		UserDataKey:      string(userData),
 		NetworkConfigKey: cloudinit.CloudInitNetworkConfigDisabled,
 		AutoStartKey:     "true",
+		// Allow nested container placements (e.g. lxd:0/lxd/1) to be
+		// provisioned inside this container later.
+		NestingKey: "true",
 		// Extra info to indicate the origin of this container.
 		JujuModelKey: m.modelUUID,
 	}
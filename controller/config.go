@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
@@ -21,6 +22,7 @@ import (
 	"gopkg.in/juju/environschema.v1"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/policy"
 	"github.com/juju/juju/docker"
 	"github.com/juju/juju/docker/registry"
 	"github.com/juju/juju/pki"
@@ -39,6 +41,13 @@ const (
 	// APIPort is the port used for api connections.
 	APIPort = "api-port"
 
+	// APIBindAddressFamily controls which IP address families the API
+	// server binds: "ipv4", "ipv6" or "dual-stack" (the default).
+	// Certificate SANs and the API addresses published to agents are
+	// restricted to the configured families, allowing controllers to be
+	// deployed on IPv6-only infrastructure.
+	APIBindAddressFamily = "api-bind-address-family"
+
 	// ControllerAPIPort is an optional port that may be set for controllers
 	// that have a very heavy load. If this port is set, this port is used by
 	// the controllers to talk to each other - used for the local API connection
@@ -75,6 +84,14 @@ const (
 	// is only used when a controller-api-port value is set.
 	APIPortOpenDelay = "api-port-open-delay"
 
+	// APIReadOnlyReplicas determines whether controller API servers
+	// that are not colocated with the MongoDB primary serve only
+	// read-only API methods to clients, redirecting anything that
+	// could modify state to the primary. This allows read-heavy
+	// status and watch traffic to be spread over the secondaries on
+	// large installations.
+	APIReadOnlyReplicas = "api-read-only-replicas"
+
 	// AuditingEnabled determines whether the controller will record
 	// auditing information.
 	AuditingEnabled = "auditing-enabled"
@@ -91,6 +108,11 @@ const (
 	// (compressed).
 	AuditLogMaxBackups = "audit-log-max-backups"
 
+	// ProviderPlugins is a list of "name=endpoint" pairs naming
+	// out-of-tree provider plugins that controller agents dial and
+	// register in the environs provider registry at startup.
+	ProviderPlugins = "provider-plugins"
+
 	// AuditLogExcludeMethods is a list of Facade.Method names that
 	// aren't interesting for audit logging purposes. A conversation
 	// with only calls to these will be excluded from the
@@ -252,6 +274,13 @@ const (
 	// for the jujud operator and mongo images.
 	CAASImageRepo = "caas-image-repo"
 
+	// CAASImageMirrorRepo sets the docker repo that OCI image
+	// resources for CAAS charms are mirrored into. When set, the
+	// controller retags image resources into this registry and hands
+	// the mirrored reference to the broker, so clusters without
+	// internet egress can still pull workload images.
+	CAASImageMirrorRepo = "caas-image-mirror-repo"
+
 	// Features allows a list of runtime changeable features to be updated.
 	Features = "features"
 
@@ -261,6 +290,17 @@ const (
 	// PublicDNSAddress is the public DNS address (and port) of the controller.
 	PublicDNSAddress = "public-dns-address"
 
+	// PolicyRules is a JSON list of built-in policy rules evaluated
+	// against mutating operations (deploy, expose, model config
+	// changes). An operation matched by a rule is rejected with a
+	// policy violation error.
+	PolicyRules = "policy-rules"
+
+	// PolicyWebhookURL is the URL of an external policy webhook.
+	// When set, mutating operations are POSTed to it for a decision
+	// before they are applied.
+	PolicyWebhookURL = "policy-webhook-url"
+
 	// Attribute Defaults
 
 	// DefaultApplicationResourceDownloadLimit allows unlimited
@@ -279,6 +319,10 @@ const (
 	// second. A token is added to the ratelimit token bucket every 250ms.
 	DefaultAgentRateLimitRate = 250 * time.Millisecond
 
+	// DefaultAPIReadOnlyReplicas contains the default value for the
+	// APIReadOnlyReplicas config value.
+	DefaultAPIReadOnlyReplicas = false
+
 	// DefaultAuditingEnabled contains the default value for the
 	// AuditingEnabled config value.
 	DefaultAuditingEnabled = true
@@ -309,6 +353,20 @@ const (
 	// It is a string representation of a time.Duration.
 	DefaultAPIPortOpenDelay = "2s"
 
+	// AddressFamilyIPv4 binds the API server to IPv4 addresses only.
+	AddressFamilyIPv4 = "ipv4"
+
+	// AddressFamilyIPv6 binds the API server to IPv6 addresses only.
+	AddressFamilyIPv6 = "ipv6"
+
+	// AddressFamilyDualStack binds the API server to both IPv4 and
+	// IPv6 addresses.
+	AddressFamilyDualStack = "dual-stack"
+
+	// DefaultAPIBindAddressFamily is the default value for
+	// api-bind-address-family.
+	DefaultAPIBindAddressFamily = AddressFamilyDualStack
+
 	// DefaultMongoMemoryProfile is the default profile used by mongo.
 	DefaultMongoMemoryProfile = MongoProfDefault
 
@@ -391,8 +449,10 @@ var (
 		AllowModelAccessKey,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		APIBindAddressFamily,
 		APIPort,
 		APIPortOpenDelay,
+		APIReadOnlyReplicas,
 		AutocertDNSNameKey,
 		AutocertURLKey,
 		CACertKey,
@@ -417,6 +477,8 @@ var (
 		ModelLogsSize,
 		PruneTxnQueryCount,
 		PruneTxnSleepTime,
+		PolicyRules,
+		PolicyWebhookURL,
 		PublicDNSAddress,
 		JujuHASpace,
 		JujuManagementSpace,
@@ -427,6 +489,7 @@ var (
 		AuditLogExcludeMethods,
 		CAASOperatorImagePath,
 		CAASImageRepo,
+		CAASImageMirrorRepo,
 		Features,
 		MeteringURL,
 		MaxCharmStateSize,
@@ -436,6 +499,7 @@ var (
 		MigrationMinionWaitMax,
 		ApplicationResourceDownloadLimit,
 		ControllerResourceDownloadLimit,
+		ProviderPlugins,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -459,11 +523,14 @@ var (
 		AgentRateLimitMax,
 		AgentRateLimitRate,
 		APIPortOpenDelay,
+		APIReadOnlyReplicas,
 		AuditingEnabled,
 		AuditLogCaptureArgs,
 		AuditLogExcludeMethods,
 		AuditLogMaxBackups,
 		AuditLogMaxSize,
+		CAASImageRepo,
+		CAASImageMirrorRepo,
 		// TODO Juju 3.0: ControllerAPIPort should be required and treated
 		// more like api-port.
 		ControllerAPIPort,
@@ -475,6 +542,8 @@ var (
 		ModelLogfileMaxSize,
 		ModelLogsSize,
 		MongoMemoryProfile,
+		PolicyRules,
+		PolicyWebhookURL,
 		PruneTxnQueryCount,
 		PruneTxnSleepTime,
 		PublicDNSAddress,
@@ -488,6 +557,23 @@ var (
 		MigrationMinionWaitMax,
 		ApplicationResourceDownloadLimit,
 		ControllerResourceDownloadLimit,
+		ProviderPlugins,
+	)
+
+	// HotReloadableConfigAttributes contains the controller config
+	// attributes that running workers can pick up and apply without an
+	// API server restart. It is necessarily a subset of
+	// AllowedUpdateConfigAttributes; anything updatable but not listed
+	// here only takes effect when jujud is bounced.
+	HotReloadableConfigAttributes = set.NewStrings(
+		AgentRateLimitMax,
+		AgentRateLimitRate,
+		AuditingEnabled,
+		AuditLogCaptureArgs,
+		AuditLogExcludeMethods,
+		CAASImageRepo,
+		ModelLogfileMaxBackups,
+		ModelLogfileMaxSize,
 	)
 
 	// DefaultAuditLogExcludeMethods is the default list of methods to
@@ -512,6 +598,12 @@ func ControllerOnlyAttribute(attr string) bool {
 	return false
 }
 
+// HotReloadableAttribute returns true if the specified attribute name
+// can be applied by running workers without restarting the API server.
+func HotReloadableAttribute(attr string) bool {
+	return HotReloadableConfigAttributes.Contains(attr)
+}
+
 // Config is a string-keyed map of controller configuration attributes.
 type Config map[string]interface{}
 
@@ -631,6 +723,15 @@ func (c Config) APIPort() int {
 	return c.mustInt(APIPort)
 }
 
+// APIBindAddressFamily returns the IP address families the API server
+// binds: ipv4, ipv6 or dual-stack.
+func (c Config) APIBindAddressFamily() string {
+	if v := c.asString(APIBindAddressFamily); v != "" {
+		return v
+	}
+	return DefaultAPIBindAddressFamily
+}
+
 // APIPortOpenDelay returns the duration to wait before opening
 // the APIPort once the controller has started up. Only used when
 // the ControllerAPIPort is non-zero.
@@ -704,6 +805,16 @@ func (c Config) AgentRateLimitRate() time.Duration {
 	return c.durationOrDefault(AgentRateLimitRate, DefaultAgentRateLimitRate)
 }
 
+// APIReadOnlyReplicas returns whether controller API servers that are
+// not colocated with the MongoDB primary should only serve read-only
+// API methods. The default is false.
+func (c Config) APIReadOnlyReplicas() bool {
+	if v, ok := c[APIReadOnlyReplicas]; ok {
+		return v.(bool)
+	}
+	return DefaultAPIReadOnlyReplicas
+}
+
 // AuditingEnabled returns whether or not auditing has been enabled
 // for the environment. The default is false.
 func (c Config) AuditingEnabled() bool {
@@ -761,6 +872,19 @@ func (c Config) Features() set.Strings {
 	return features
 }
 
+// ProviderPluginEndpoints returns the provider plugins that controller
+// agents should register at startup, keyed by provider name.
+func (c Config) ProviderPluginEndpoints() map[string]string {
+	plugins := make(map[string]string)
+	if value, ok := c[ProviderPlugins]; ok {
+		for _, item := range value.([]interface{}) {
+			name, endpoint, _ := strings.Cut(item.(string), "=")
+			plugins[name] = endpoint
+		}
+	}
+	return plugins
+}
+
 // CharmStoreURL returns the URL to use for charmstore api calls.
 func (c Config) CharmStoreURL() string {
 	url := c.asString(CharmStoreURL)
@@ -994,6 +1118,42 @@ func (c Config) CAASImageRepo() (o docker.ImageRepoDetails) {
 	return o
 }
 
+// CAASImageMirrorRepo returns the docker repo that OCI image
+// resources for CAAS charms are mirrored into, or an empty value if
+// mirroring is not configured.
+func (c Config) CAASImageMirrorRepo() (o docker.ImageRepoDetails) {
+	str := c.asString(CAASImageMirrorRepo)
+	repoDetails, err := docker.NewImageRepoDetails(str)
+	if repoDetails != nil {
+		return *repoDetails
+	}
+	// This should not happen since we have done validation in c.Valiate().
+	logger.Tracef("parsing controller config %q: %q, err %v", CAASImageMirrorRepo, str, err)
+	return o
+}
+
+// PolicyRules returns the built-in policy rules evaluated against
+// mutating operations, or nil if none are registered.
+func (c Config) PolicyRules() []policy.Rule {
+	doc := c.asString(PolicyRules)
+	if doc == "" {
+		return nil
+	}
+	rules, err := policy.ParseRules(doc)
+	if err != nil {
+		// This should not happen since we validate in c.Validate().
+		logger.Tracef("parsing controller config %q: %v", PolicyRules, err)
+		return nil
+	}
+	return rules
+}
+
+// PolicyWebhookURL returns the URL of the external policy webhook, or
+// an empty string if none is registered.
+func (c Config) PolicyWebhookURL() string {
+	return c.asString(PolicyWebhookURL)
+}
+
 // MeteringURL returns the URL to use for metering api calls.
 func (c Config) MeteringURL() string {
 	url := c.asString(MeteringURL)
@@ -1072,6 +1232,22 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[PolicyRules].(string); ok && v != "" {
+		if _, err := policy.ParseRules(v); err != nil {
+			return errors.Annotatef(err, "invalid %s", PolicyRules)
+		}
+	}
+
+	if v, ok := c[PolicyWebhookURL].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s", PolicyWebhookURL)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("%s must be an http or https URL", PolicyWebhookURL)
+		}
+	}
+
 	caCert, caCertOK := c.CACert()
 	if !caCertOK {
 		return errors.Errorf("missing CA certificate")
@@ -1113,6 +1289,15 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[APIBindAddressFamily].(string); ok {
+		switch v {
+		case AddressFamilyIPv4, AddressFamilyIPv6, AddressFamilyDualStack:
+		default:
+			return errors.Errorf("%s: expected one of %q, %q or %q got string(%q)",
+				APIBindAddressFamily, AddressFamilyIPv4, AddressFamilyIPv6, AddressFamilyDualStack, v)
+		}
+	}
+
 	if mgoMemProfile, ok := c[MongoMemoryProfile].(string); ok {
 		if mgoMemProfile != MongoProfLow && mgoMemProfile != MongoProfDefault {
 			return errors.Errorf("mongo-memory-profile: expected one of %q or %q got string(%q)", MongoProfLow, MongoProfDefault, mgoMemProfile)
@@ -1198,6 +1383,12 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[CAASImageMirrorRepo].(string); ok && v != "" {
+		if c[CAASImageMirrorRepo], err = validateCAASImageRepo(v); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	var auditLogMaxSize int
 	if v, ok := c[AuditLogMaxSize].(string); ok {
 		if size, err := utils.ParseSize(v); err != nil {
@@ -1232,6 +1423,20 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[ProviderPlugins].([]interface{}); ok {
+		for i, item := range v {
+			item := item.(string)
+			name, endpoint, found := strings.Cut(item, "=")
+			if !found || name == "" || endpoint == "" {
+				return errors.Errorf(
+					`invalid provider plugins: should be a list of "name=endpoint" pairs, got %q at position %d`,
+					item,
+					i+1,
+				)
+			}
+		}
+	}
+
 	if v, ok := c[ControllerAPIPort].(int); ok {
 		// TODO: change the validation so 0 is invalid and --reset is used.
 		// However that doesn't exist yet.
@@ -1340,12 +1545,14 @@ func (c Config) AsSpaceConstraints(spaces *[]string) *[]string {
 var configChecker = schema.FieldMap(schema.Fields{
 	AgentRateLimitMax:                schema.ForceInt(),
 	AgentRateLimitRate:               schema.TimeDuration(),
+	APIReadOnlyReplicas:              schema.Bool(),
 	AuditingEnabled:                  schema.Bool(),
 	AuditLogCaptureArgs:              schema.Bool(),
 	AuditLogMaxSize:                  schema.String(),
 	AuditLogMaxBackups:               schema.ForceInt(),
 	AuditLogExcludeMethods:           schema.List(schema.String()),
 	APIPort:                          schema.ForceInt(),
+	APIBindAddressFamily:             schema.String(),
 	APIPortOpenDelay:                 schema.String(),
 	ControllerAPIPort:                schema.ForceInt(),
 	ControllerName:                   schema.String(),
@@ -1374,6 +1581,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujuManagementSpace:              schema.String(),
 	CAASOperatorImagePath:            schema.String(),
 	CAASImageRepo:                    schema.String(),
+	CAASImageMirrorRepo:              schema.String(),
 	Features:                         schema.List(schema.String()),
 	CharmStoreURL:                    schema.String(),
 	MeteringURL:                      schema.String(),
@@ -1382,15 +1590,20 @@ var configChecker = schema.FieldMap(schema.Fields{
 	NonSyncedWritesToRaftLog:         schema.Bool(),
 	BatchRaftFSM:                     schema.Bool(),
 	MigrationMinionWaitMax:           schema.String(),
+	PolicyRules:                      schema.String(),
+	PolicyWebhookURL:                 schema.String(),
+	ProviderPlugins:                  schema.List(schema.String()),
 	ApplicationResourceDownloadLimit: schema.ForceInt(),
 	ControllerResourceDownloadLimit:  schema.ForceInt(),
 }, schema.Defaults{
 	AgentRateLimitMax:                schema.Omit,
 	AgentRateLimitRate:               schema.Omit,
 	APIPort:                          DefaultAPIPort,
+	APIBindAddressFamily:             DefaultAPIBindAddressFamily,
 	APIPortOpenDelay:                 DefaultAPIPortOpenDelay,
 	ControllerAPIPort:                schema.Omit,
 	ControllerName:                   schema.Omit,
+	APIReadOnlyReplicas:              DefaultAPIReadOnlyReplicas,
 	AuditingEnabled:                  DefaultAuditingEnabled,
 	AuditLogCaptureArgs:              DefaultAuditLogCaptureArgs,
 	AuditLogMaxSize:                  fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
@@ -1421,6 +1634,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujuManagementSpace:              schema.Omit,
 	CAASOperatorImagePath:            schema.Omit,
 	CAASImageRepo:                    schema.Omit,
+	CAASImageMirrorRepo:              schema.Omit,
 	Features:                         schema.Omit,
 	CharmStoreURL:                    csclient.ServerURL,
 	MeteringURL:                      romulus.DefaultAPIRoot,
@@ -1429,6 +1643,9 @@ var configChecker = schema.FieldMap(schema.Fields{
 	NonSyncedWritesToRaftLog:         DefaultNonSyncedWritesToRaftLog,
 	BatchRaftFSM:                     DefaultBatchRaftFSM,
 	MigrationMinionWaitMax:           DefaultMigrationMinionWaitMax,
+	PolicyRules:                      schema.Omit,
+	PolicyWebhookURL:                 schema.Omit,
+	ProviderPlugins:                  schema.Omit,
 	ApplicationResourceDownloadLimit: schema.Omit,
 	ControllerResourceDownloadLimit:  schema.Omit,
 })
@@ -1452,6 +1669,10 @@ var ConfigSchema = environschema.Fields{
 		Description: "The time taken to add a new token to the ratelimit bucket",
 		Type:        environschema.Tstring,
 	},
+	APIReadOnlyReplicas: {
+		Description: "Determines if controller API servers not colocated with the MongoDB primary only serve read-only methods",
+		Type:        environschema.Tbool,
+	},
 	AuditingEnabled: {
 		Description: "Determines if the controller records auditing information",
 		Type:        environschema.Tbool,
@@ -1476,6 +1697,11 @@ var ConfigSchema = environschema.Fields{
 		Type:        environschema.Tint,
 		Description: "The port used for api connections",
 	},
+	APIBindAddressFamily: {
+		Type: environschema.Tstring,
+		Description: `Controls which IP address families the API server
+binds: "ipv4", "ipv6" or "dual-stack" (the default).`,
+	},
 	APIPortOpenDelay: {
 		Type: environschema.Tstring,
 		Description: `The duration that the controller will wait 
@@ -1594,6 +1820,11 @@ Use "caas-image-repo" instead.`,
 		Type:        environschema.Tstring,
 		Description: `The docker repo to use for the jujud operator and mongo images`,
 	},
+	CAASImageMirrorRepo: {
+		Type: environschema.Tstring,
+		Description: `The docker repo that OCI image resources for CAAS charms are mirrored
+into so clusters without internet egress can pull workload images`,
+	},
 	Features: {
 		Type:        environschema.Tlist,
 		Description: `A list of runtime changeable features to be updated`,
@@ -1626,4 +1857,16 @@ Use "caas-image-repo" instead.`,
 		Type:        environschema.Tstring,
 		Description: `The maximum during model migrations that the migration worker will wait for agents to report on phases of the migration`,
 	},
+	PolicyRules: {
+		Type:        environschema.Tstring,
+		Description: `A JSON list of policy rules evaluated against mutating operations`,
+	},
+	ProviderPlugins: {
+		Type:        environschema.Tlist,
+		Description: `A list of "name=endpoint" pairs naming out-of-tree provider plugins to register at agent startup`,
+	},
+	PolicyWebhookURL: {
+		Type:        environschema.Tstring,
+		Description: `The URL of an external webhook consulted before mutating operations are applied`,
+	},
 }
@@ -167,6 +167,12 @@ var newConfigTests = []struct {
 		controller.AuditLogExcludeMethods: []interface{}{"Dap.Kings", "ReadOnlyMethods", "Sharon Jones"},
 	},
 	expectError: `invalid audit log exclude methods: should be a list of "Facade.Method" names \(or "ReadOnlyMethods"\), got "Sharon Jones" at position 3`,
+}, {
+	about: "invalid provider plugins",
+	config: controller.Config{
+		controller.ProviderPlugins: []interface{}{"niftycloud=localhost:7777", "badplugin"},
+	},
+	expectError: `invalid provider plugins: should be a list of "name=endpoint" pairs, got "badplugin" at position 2`,
 }, {
 	about: "invalid model log max size",
 	config: controller.Config{
@@ -456,6 +462,17 @@ func (s *ConfigSuite) TestLogConfigValues(c *gc.C) {
 	c.Assert(cfg.ModelLogsSizeMB(), gc.Equals, 35)
 }
 
+func (s *ConfigSuite) TestHotReloadableAttributes(c *gc.C) {
+	// Anything we claim can be applied without a restart must also be
+	// updatable after bootstrap.
+	for _, attr := range controller.HotReloadableConfigAttributes.SortedValues() {
+		c.Check(controller.AllowedUpdateConfigAttributes.Contains(attr), jc.IsTrue,
+			gc.Commentf("attribute %q", attr))
+	}
+	c.Check(controller.HotReloadableAttribute(controller.AuditingEnabled), jc.IsTrue)
+	c.Check(controller.HotReloadableAttribute(controller.APIPort), jc.IsFalse)
+}
+
 func (s *ConfigSuite) TestTxnLogConfigDefault(c *gc.C) {
 	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
 	c.Assert(err, jc.ErrorIsNil)
@@ -584,6 +601,24 @@ func (s *ConfigSuite) TestAuditLogValues(c *gc.C) {
 	))
 }
 
+func (s *ConfigSuite) TestProviderPluginEndpoints(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"provider-plugins": []string{"niftycloud=localhost:7777"},
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.ProviderPluginEndpoints(), gc.DeepEquals, map[string]string{
+		"niftycloud": "localhost:7777",
+	})
+
+	cfg, err = controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.ProviderPluginEndpoints(), gc.HasLen, 0)
+}
+
 func (s *ConfigSuite) TestAuditLogExcludeMethodsType(c *gc.C) {
 	_, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
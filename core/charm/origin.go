@@ -50,6 +50,11 @@ type Origin struct {
 	Channel  *charm.Channel
 	Platform Platform
 
+	// BaseSelection records how the base for the charm was chosen when
+	// the user did not specify one, e.g. pinned to the model default or
+	// tracking the latest supported LTS.
+	BaseSelection string
+
 	// InstanceKey is a unique string associated with the application. To
 	// assist with keeping KPI data in charmhub, it must be the same for every
 	// charmhub Refresh action related to an application. Create with the
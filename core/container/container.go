@@ -40,3 +40,32 @@ func TopParentId(machineId string) string {
 	idParts := strings.Split(machineId, "/")
 	return idParts[0]
 }
+
+// nestedContainerSupport records which container types can be hosted by
+// a machine that is itself a container of the keyed type. An LXD
+// container can nest both LXD and KVM guests, provided that nesting is
+// enabled and the KVM device is visible inside the container. A KVM
+// guest is a full virtual machine, so it can host LXD containers, but
+// nested KVM would require nested virtualisation support that cannot be
+// relied upon.
+var nestedContainerSupport = map[instance.ContainerType][]instance.ContainerType{
+	instance.LXD: {instance.LXD, instance.KVM},
+	instance.KVM: {instance.LXD},
+}
+
+// NestingSupported reports whether a container of the given type can be
+// created on the machine with the supplied id. Top level machines
+// support every container type; for a machine that is itself a
+// container the decision is made from the nesting support matrix.
+func NestingSupported(machineId string, guest instance.ContainerType) bool {
+	hostType := ContainerTypeFromId(machineId)
+	if hostType == "" {
+		return true
+	}
+	for _, ct := range nestedContainerSupport[hostType] {
+		if ct == guest {
+			return true
+		}
+	}
+	return false
+}
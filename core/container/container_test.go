@@ -32,6 +32,16 @@ func (s *ContainerSuite) TestParentId(c *gc.C) {
 	c.Assert(container.ParentId("0/lxd/1/kvm/0"), gc.Equals, "0/lxd/1")
 }
 
+func (s *ContainerSuite) TestNestingSupported(c *gc.C) {
+	c.Assert(container.NestingSupported("0", instance.LXD), gc.Equals, true)
+	c.Assert(container.NestingSupported("0", instance.KVM), gc.Equals, true)
+	c.Assert(container.NestingSupported("0/lxd/1", instance.LXD), gc.Equals, true)
+	c.Assert(container.NestingSupported("0/lxd/1", instance.KVM), gc.Equals, true)
+	c.Assert(container.NestingSupported("0/kvm/1", instance.LXD), gc.Equals, true)
+	c.Assert(container.NestingSupported("0/kvm/1", instance.KVM), gc.Equals, false)
+	c.Assert(container.NestingSupported("0/lxd/1/kvm/0", instance.KVM), gc.Equals, false)
+}
+
 func (s *ContainerSuite) TestContainerTypeFromId(c *gc.C) {
 	c.Assert(container.ContainerTypeFromId("0"), gc.Equals, instance.ContainerType(""))
 	c.Assert(container.ContainerTypeFromId("0/lxd/1"), gc.Equals, instance.LXD)
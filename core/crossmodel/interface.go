@@ -72,6 +72,10 @@ type ConsumeApplicationArgs struct {
 
 	// ApplicationAlias is the name of the alias to use for the application name.
 	ApplicationAlias string
+
+	// PinnedEndpointInterfaces maps endpoint names to the interface
+	// (optionally versioned) the consumer wants to pin the offer to.
+	PinnedEndpointInterfaces map[string]string
 }
 
 // String returns the offered application name.
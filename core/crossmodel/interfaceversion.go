@@ -0,0 +1,75 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package crossmodel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Versioned interface names take the form "name/vN", eg "pgsql/v2".
+// A bare interface name is treated as unversioned.
+
+// ParseInterface splits an interface name into its base name and
+// declared version. Unversioned interfaces report version zero.
+func ParseInterface(iface string) (string, int) {
+	idx := strings.LastIndex(iface, "/v")
+	if idx < 0 {
+		return iface, 0
+	}
+	version, err := strconv.Atoi(iface[idx+2:])
+	if err != nil || version <= 0 {
+		return iface, 0
+	}
+	return iface[:idx], version
+}
+
+// InterfaceMismatchError indicates that the interface a consumer pinned
+// when consuming an offer no longer matches what the offering charm
+// declares.
+type InterfaceMismatchError struct {
+	// Endpoint is the name of the relation endpoint being related.
+	Endpoint string
+
+	// Offered is the interface declared by the offering charm.
+	Offered string
+
+	// Pinned is the interface the consumer pinned.
+	Pinned string
+}
+
+// Error implements error.
+func (e *InterfaceMismatchError) Error() string {
+	return fmt.Sprintf(
+		"offered interface %q on endpoint %q does not match consumed interface %q",
+		e.Offered, e.Endpoint, e.Pinned)
+}
+
+// IsInterfaceMismatchError returns true if err is an InterfaceMismatchError.
+func IsInterfaceMismatchError(err error) bool {
+	_, ok := errors.Cause(err).(*InterfaceMismatchError)
+	return ok
+}
+
+// CheckCompatibleInterface checks the interface a consumer recorded for an
+// endpoint against the interface the offering charm declares. A consumer
+// that did not pin a version accepts any version of the same interface;
+// a pinned version must match the offered one exactly.
+func CheckCompatibleInterface(endpoint, offered, consumed string) error {
+	if consumed == "" {
+		return nil
+	}
+	offeredName, offeredVersion := ParseInterface(offered)
+	consumedName, consumedVersion := ParseInterface(consumed)
+	if offeredName != consumedName {
+		return &InterfaceMismatchError{Endpoint: endpoint, Offered: offered, Pinned: consumed}
+	}
+	if consumedVersion != 0 && offeredVersion != consumedVersion {
+		return &InterfaceMismatchError{Endpoint: endpoint, Offered: offered, Pinned: consumed}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package crossmodel_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/crossmodel"
+)
+
+type InterfaceVersionSuite struct{}
+
+var _ = gc.Suite(&InterfaceVersionSuite{})
+
+func (s *InterfaceVersionSuite) TestParseInterface(c *gc.C) {
+	for _, t := range []struct {
+		iface   string
+		name    string
+		version int
+	}{
+		{"pgsql", "pgsql", 0},
+		{"pgsql/v2", "pgsql", 2},
+		{"tls-certificates/v1", "tls-certificates", 1},
+		{"pgsql/v0", "pgsql/v0", 0},
+		{"pgsql/vtwo", "pgsql/vtwo", 0},
+	} {
+		name, version := crossmodel.ParseInterface(t.iface)
+		c.Check(name, gc.Equals, t.name)
+		c.Check(version, gc.Equals, t.version)
+	}
+}
+
+func (s *InterfaceVersionSuite) TestCheckCompatibleInterface(c *gc.C) {
+	for _, t := range []struct {
+		offered  string
+		consumed string
+		ok       bool
+	}{
+		{"pgsql", "", true},
+		{"pgsql", "pgsql", true},
+		{"pgsql/v2", "pgsql", true},
+		{"pgsql/v2", "pgsql/v2", true},
+		{"pgsql/v3", "pgsql/v2", false},
+		{"pgsql", "pgsql/v2", false},
+		{"mysql", "pgsql", false},
+	} {
+		err := crossmodel.CheckCompatibleInterface("db", t.offered, t.consumed)
+		if t.ok {
+			c.Check(err, jc.ErrorIsNil)
+		} else {
+			c.Check(crossmodel.IsInterfaceMismatchError(err), jc.IsTrue)
+			c.Check(err, gc.ErrorMatches, `offered interface .* on endpoint "db" does not match consumed interface .*`)
+		}
+	}
+}
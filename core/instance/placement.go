@@ -14,6 +14,11 @@ const (
 	// MachineScope is a special scope name that is used
 	// for machine placement directives (e.g. --to 0).
 	MachineScope = "#"
+
+	// CloudScope is a special scope name that selects the registered
+	// cloud a machine must be provisioned in (e.g. --to cloud:azure),
+	// allowing a model to span more than one cloud.
+	CloudScope = "cloud"
 )
 
 var ErrPlacementScopeMissing = fmt.Errorf("placement scope missing")
@@ -0,0 +1,73 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package life
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// RemovalStep identifies one stage of a cascading entity removal.
+type RemovalStep string
+
+const (
+	// RemovalStepUnitsDying covers the units of the removed entity
+	// progressing through Dying to removal.
+	RemovalStepUnitsDying RemovalStep = "units-dying"
+
+	// RemovalStepStorageDetaching covers the detachment of storage
+	// from the removed entity's units or machine.
+	RemovalStepStorageDetaching RemovalStep = "storage-detaching"
+
+	// RemovalStepMachineRelease covers the release of the machine's
+	// instance back to the provider.
+	RemovalStepMachineRelease RemovalStep = "machine-release"
+)
+
+// Validate returns an error if the step is not known.
+func (s RemovalStep) Validate() error {
+	switch s {
+	case RemovalStepUnitsDying, RemovalStepStorageDetaching, RemovalStepMachineRelease:
+		return nil
+	}
+	return errors.NotValidf("removal step %q", s)
+}
+
+// RemovalStepStatus indicates how far a removal step has progressed.
+type RemovalStepStatus string
+
+const (
+	// RemovalStepPending indicates the step has not started.
+	RemovalStepPending RemovalStepStatus = "pending"
+
+	// RemovalStepRunning indicates the step is making progress.
+	RemovalStepRunning RemovalStepStatus = "running"
+
+	// RemovalStepStuck indicates the step has made no progress for
+	// an extended period and may need operator intervention.
+	RemovalStepStuck RemovalStepStatus = "stuck"
+
+	// RemovalStepCompleted indicates the step has finished.
+	RemovalStepCompleted RemovalStepStatus = "completed"
+)
+
+// RemovalStepProgress reports the progress of a single removal step.
+type RemovalStepProgress struct {
+	// Step identifies the removal stage.
+	Step RemovalStep
+
+	// Status indicates how far the step has progressed.
+	Status RemovalStepStatus
+
+	// Total is the number of items the step had to process when the
+	// removal began.
+	Total int
+
+	// Remaining is the number of items still to be processed.
+	Remaining int
+
+	// Updated is when the step last made progress.
+	Updated time.Time
+}
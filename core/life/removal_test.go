@@ -0,0 +1,42 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package life_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/life"
+)
+
+type RemovalSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&RemovalSuite{})
+
+func (*RemovalSuite) TestValidateValid(c *gc.C) {
+	for i, test := range []life.RemovalStep{
+		life.RemovalStepUnitsDying,
+		life.RemovalStepStorageDetaching,
+		life.RemovalStepMachineRelease,
+	} {
+		c.Logf("test %d: %s", i, test)
+		err := test.Validate()
+		c.Check(err, jc.ErrorIsNil)
+	}
+}
+
+func (*RemovalSuite) TestValidateInvalid(c *gc.C) {
+	for i, test := range []life.RemovalStep{
+		"", "bad", "units dying", "Units-Dying",
+	} {
+		c.Logf("test %d: %s", i, test)
+		err := test.Validate()
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, `removal step ".*" not valid`)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+// FreezeMode indicates whether a model is accepting write operations.
+type FreezeMode string
+
+const (
+	// FreezeNone is the default mode; the model accepts writes as
+	// normal, subject to any blocks that have been switched on.
+	FreezeNone FreezeMode = ""
+
+	// FreezeReadOnly marks a model as fully read-only, used for
+	// migration cutovers and audits. It blocks every mutating client
+	// operation, which is more than a change block covers.
+	FreezeReadOnly FreezeMode = "read-only"
+)
@@ -3,6 +3,10 @@
 
 package model
 
+import (
+	"github.com/juju/juju/core/os"
+)
+
 // ModelType indicates a model type.
 type ModelType string
 
@@ -19,6 +23,18 @@ func (m ModelType) String() string {
 	return string(m)
 }
 
+// SupportedOSFamilies returns the OS families whose workloads a model
+// of this type is able to host. Windows unit agents are no longer
+// supported; Windows workloads can only run on CAAS models whose
+// cluster provides containerd-based Windows nodes.
+func (m ModelType) SupportedOSFamilies() []os.OSType {
+	families := []os.OSType{os.Ubuntu, os.CentOS, os.GenericLinux, os.OpenSUSE}
+	if m == CAAS {
+		families = append(families, os.Kubernetes, os.Windows)
+	}
+	return families
+}
+
 // Model represents the state of a model.
 type Model struct {
 	// Name returns the human friendly name of the model.
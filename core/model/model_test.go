@@ -10,6 +10,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/os"
 )
 
 type ModelSuite struct {
@@ -18,6 +19,15 @@ type ModelSuite struct {
 
 var _ = gc.Suite(&ModelSuite{})
 
+func (*ModelSuite) TestSupportedOSFamilies(c *gc.C) {
+	c.Check(model.IAAS.SupportedOSFamilies(), jc.DeepEquals, []os.OSType{
+		os.Ubuntu, os.CentOS, os.GenericLinux, os.OpenSUSE,
+	})
+	c.Check(model.CAAS.SupportedOSFamilies(), jc.DeepEquals, []os.OSType{
+		os.Ubuntu, os.CentOS, os.GenericLinux, os.OpenSUSE, os.Kubernetes, os.Windows,
+	})
+}
+
 func (*ModelSuite) TestValidateBranchName(c *gc.C) {
 	for _, t := range []struct {
 		branchName string
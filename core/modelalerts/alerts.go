@@ -0,0 +1,140 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package modelalerts defines the alert conditions evaluated against a
+// model and the rule thresholds that raise them. The types are shared
+// by the model alerts worker and the ModelAlerts facade.
+package modelalerts
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/status"
+)
+
+// Severity indicates how urgently an alert needs operator attention.
+type Severity string
+
+const (
+	// SeverityWarning marks conditions that need attention but are not
+	// yet service-affecting.
+	SeverityWarning Severity = "warning"
+
+	// SeverityCritical marks conditions that are service-affecting.
+	SeverityCritical Severity = "critical"
+)
+
+// Alert rule identifiers. These are stable strings surfaced via the API
+// and webhook payloads; do not rename them.
+const (
+	RuleUnitStuckInError = "unit-stuck-in-error"
+	RuleAgentLost        = "agent-lost"
+	RuleHookRetryStorm   = "hook-retry-storm"
+)
+
+// Alert describes a single active alert condition for an entity in the
+// model being watched.
+type Alert struct {
+	// Rule is the identifier of the rule that raised the alert.
+	Rule string `json:"rule"`
+
+	// Entity is the tag of the unit or machine the alert applies to.
+	Entity string `json:"entity"`
+
+	// Severity indicates how urgent the alert is.
+	Severity Severity `json:"severity"`
+
+	// Message is a human-readable description of the condition.
+	Message string `json:"message"`
+
+	// Since records when the offending condition was first observed.
+	Since time.Time `json:"since"`
+}
+
+// Rules holds the thresholds used when evaluating alert conditions.
+type Rules struct {
+	// UnitErrorThreshold is how long a unit may remain in error state
+	// before an alert is raised.
+	UnitErrorThreshold time.Duration
+
+	// HookRetryThreshold is the number of hook retries within the
+	// evaluation window that constitutes a retry storm.
+	HookRetryThreshold int
+}
+
+// DefaultRules returns the thresholds used when none are configured.
+func DefaultRules() Rules {
+	return Rules{
+		UnitErrorThreshold: 10 * time.Minute,
+		HookRetryThreshold: 5,
+	}
+}
+
+// Validate checks that the rule thresholds are sane.
+func (r Rules) Validate() error {
+	if r.UnitErrorThreshold <= 0 {
+		return errors.NotValidf("non-positive UnitErrorThreshold")
+	}
+	if r.HookRetryThreshold <= 0 {
+		return errors.NotValidf("non-positive HookRetryThreshold")
+	}
+	return nil
+}
+
+// UnitReport is a snapshot of the state of one unit, as used for alert
+// rule evaluation.
+type UnitReport struct {
+	// Tag is the unit's tag string.
+	Tag string
+
+	// AgentStatus is the current unit agent status.
+	AgentStatus status.Status
+
+	// StatusSince records when the agent entered its current status.
+	StatusSince time.Time
+
+	// HookRetryCount is the number of times the current failed hook has
+	// been retried.
+	HookRetryCount int
+}
+
+// Evaluate applies the configured rules to the supplied unit reports and
+// returns the set of active alerts.
+func (r Rules) Evaluate(now time.Time, units []UnitReport) []Alert {
+	var alerts []Alert
+	for _, unit := range units {
+		switch unit.AgentStatus {
+		case status.Error:
+			if now.Sub(unit.StatusSince) >= r.UnitErrorThreshold {
+				alerts = append(alerts, Alert{
+					Rule:     RuleUnitStuckInError,
+					Entity:   unit.Tag,
+					Severity: SeverityCritical,
+					Message:  "unit has been in error state longer than the configured threshold",
+					Since:    unit.StatusSince,
+				})
+			}
+		case status.Lost:
+			alerts = append(alerts, Alert{
+				Rule:     RuleAgentLost,
+				Entity:   unit.Tag,
+				Severity: SeverityCritical,
+				Message:  "unit agent is not reporting",
+				Since:    unit.StatusSince,
+			})
+		}
+
+		if unit.HookRetryCount >= r.HookRetryThreshold {
+			alerts = append(alerts, Alert{
+				Rule:     RuleHookRetryStorm,
+				Entity:   unit.Tag,
+				Severity: SeverityWarning,
+				Message:  "failed hook is being retried repeatedly",
+				Since:    unit.StatusSince,
+			})
+		}
+	}
+	return alerts
+}
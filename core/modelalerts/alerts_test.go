@@ -0,0 +1,72 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/modelalerts"
+	"github.com/juju/juju/core/status"
+)
+
+var _ = gc.Suite(&rulesSuite{})
+
+type rulesSuite struct{}
+
+func (s *rulesSuite) TestValidate(c *gc.C) {
+	c.Assert(modelalerts.DefaultRules().Validate(), jc.ErrorIsNil)
+
+	rules := modelalerts.DefaultRules()
+	rules.UnitErrorThreshold = 0
+	c.Assert(rules.Validate(), gc.ErrorMatches, "non-positive UnitErrorThreshold.*")
+
+	rules = modelalerts.DefaultRules()
+	rules.HookRetryThreshold = 0
+	c.Assert(rules.Validate(), gc.ErrorMatches, "non-positive HookRetryThreshold.*")
+}
+
+func (s *rulesSuite) TestEvaluate(c *gc.C) {
+	now := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	rules := modelalerts.Rules{
+		UnitErrorThreshold: 10 * time.Minute,
+		HookRetryThreshold: 5,
+	}
+
+	units := []modelalerts.UnitReport{{
+		// Long enough in error to trip the stuck-in-error rule.
+		Tag:         "unit-mysql-0",
+		AgentStatus: status.Error,
+		StatusSince: now.Add(-15 * time.Minute),
+	}, {
+		// Recently errored; no alert yet.
+		Tag:         "unit-mysql-1",
+		AgentStatus: status.Error,
+		StatusSince: now.Add(-1 * time.Minute),
+	}, {
+		// Lost agents alert immediately.
+		Tag:         "unit-wordpress-0",
+		AgentStatus: status.Lost,
+		StatusSince: now.Add(-30 * time.Second),
+	}, {
+		// Retry storm on an otherwise idle unit.
+		Tag:            "unit-wordpress-1",
+		AgentStatus:    status.Idle,
+		StatusSince:    now.Add(-1 * time.Hour),
+		HookRetryCount: 7,
+	}}
+
+	alerts := rules.Evaluate(now, units)
+	c.Assert(alerts, gc.HasLen, 3)
+	c.Check(alerts[0].Rule, gc.Equals, modelalerts.RuleUnitStuckInError)
+	c.Check(alerts[0].Entity, gc.Equals, "unit-mysql-0")
+	c.Check(alerts[0].Severity, gc.Equals, modelalerts.SeverityCritical)
+	c.Check(alerts[1].Rule, gc.Equals, modelalerts.RuleAgentLost)
+	c.Check(alerts[1].Entity, gc.Equals, "unit-wordpress-0")
+	c.Check(alerts[2].Rule, gc.Equals, modelalerts.RuleHookRetryStorm)
+	c.Check(alerts[2].Entity, gc.Equals, "unit-wordpress-1")
+	c.Check(alerts[2].Severity, gc.Equals, modelalerts.SeverityWarning)
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	"github.com/juju/errors"
+)
+
+// NICRequestType defines the kind of network interface an application
+// can request for the machines its units are assigned to.
+type NICRequestType string
+
+const (
+	// SRIOVNICRequest requests an SR-IOV capable interface, i.e. a
+	// virtual function of a physical NIC passed through to the machine.
+	SRIOVNICRequest NICRequestType = "sriov"
+
+	// BondNICRequest requests a bonded interface aggregating two or
+	// more physical NICs.
+	BondNICRequest NICRequestType = "bond"
+)
+
+// NICRequest describes a network interface that machines provisioned
+// for an application must be equipped with. Whether and how a request
+// can be satisfied is up to the provider.
+type NICRequest struct {
+	// Type is the kind of interface requested.
+	Type NICRequestType
+
+	// Parents optionally names the underlying devices to build the
+	// interface from, for providers that can target specific NICs.
+	// Only valid for bond requests.
+	Parents []string
+
+	// Count is the number of interfaces of this type required.
+	// Zero is interpreted as one.
+	Count int
+}
+
+// Validate returns an error if the request is malformed.
+func (r NICRequest) Validate() error {
+	switch r.Type {
+	case SRIOVNICRequest, BondNICRequest:
+	default:
+		return errors.NotValidf("NIC request type %q", r.Type)
+	}
+	if len(r.Parents) > 0 && r.Type != BondNICRequest {
+		return errors.NotValidf("parent devices for %q NIC request", r.Type)
+	}
+	if r.Count < 0 {
+		return errors.NotValidf("NIC request count %d", r.Count)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+)
+
+type nicRequestSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&nicRequestSuite{})
+
+func (s *nicRequestSuite) TestValidateSRIOV(c *gc.C) {
+	err := NICRequest{Type: SRIOVNICRequest, Count: 2}.Validate()
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *nicRequestSuite) TestValidateBondWithParents(c *gc.C) {
+	err := NICRequest{Type: BondNICRequest, Parents: []string{"eth1", "eth2"}}.Validate()
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *nicRequestSuite) TestValidateUnknownType(c *gc.C) {
+	err := NICRequest{Type: "teamed"}.Validate()
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, `NIC request type "teamed" not valid`)
+}
+
+func (s *nicRequestSuite) TestValidateParentsForSRIOV(c *gc.C) {
+	err := NICRequest{Type: SRIOVNICRequest, Parents: []string{"eth0"}}.Validate()
+	c.Check(err, gc.ErrorMatches, `parent devices for "sriov" NIC request not valid`)
+}
+
+func (s *nicRequestSuite) TestValidateNegativeCount(c *gc.C) {
+	err := NICRequest{Type: SRIOVNICRequest, Count: -1}.Validate()
+	c.Check(err, gc.ErrorMatches, `NIC request count -1 not valid`)
+}
@@ -32,6 +32,15 @@ type UserAccess struct {
 	DisplayName string
 	// UserName is the actual username for this access.
 	UserName string
+	// ExpiresAt, if set, is when the access grant lapses and is
+	// removed again.
+	ExpiresAt *time.Time
+}
+
+// Expired reports whether the access grant has lapsed at the
+// given time.
+func (a UserAccess) Expired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
 }
 
 // IsEmptyUserAccess returns true if the passed UserAccess instance
@@ -0,0 +1,87 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package policy provides an extension point for organisation-wide
+// guardrails. Controller admins register policy checks — either
+// built-in rules or an external webhook — which are evaluated before
+// mutating operations such as deploy, expose and model config changes
+// are applied. A failed check surfaces as a typed violation error that
+// identifies the rule that rejected the operation.
+package policy
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
+// Operation identifies a class of mutating operation that policy
+// checks are evaluated against.
+type Operation string
+
+const (
+	// OpDeploy covers deploying a new application.
+	OpDeploy Operation = "deploy"
+
+	// OpExpose covers exposing an application's endpoints.
+	OpExpose Operation = "expose"
+
+	// OpModelConfig covers changing model configuration.
+	OpModelConfig Operation = "model-config"
+)
+
+// KnownOperation reports whether the given operation is one that
+// policy checks can be registered for.
+func KnownOperation(op Operation) bool {
+	switch op {
+	case OpDeploy, OpExpose, OpModelConfig:
+		return true
+	}
+	return false
+}
+
+// Check describes a mutating operation that is about to be applied,
+// in enough detail for a policy decision to be made.
+type Check struct {
+	// Operation is the class of operation being performed.
+	Operation Operation `json:"operation"`
+
+	// ModelUUID is the UUID of the model the operation applies to.
+	ModelUUID string `json:"model-uuid"`
+
+	// Attributes holds operation-specific details, e.g. the
+	// application name and CIDRs of an expose.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Checker is implemented by policy enforcement backends. Check
+// returns nil to allow the operation, a violation error to reject it,
+// or any other error if the check could not be evaluated.
+type Checker interface {
+	Check(Check) error
+}
+
+// violationError is the typed error returned when an operation is
+// rejected by a policy check.
+type violationError struct {
+	rule    string
+	message string
+}
+
+// Error is part of the error interface.
+func (e *violationError) Error() string {
+	return fmt.Sprintf("policy violation (%s): %s", e.rule, e.message)
+}
+
+// NewViolation returns an error representing the rejection of an
+// operation by the named policy rule.
+func NewViolation(rule, message string) error {
+	return &violationError{rule: rule, message: message}
+}
+
+// IsViolation reports whether the error represents a policy
+// violation, as opposed to a failure to evaluate the policy.
+func IsViolation(err error) bool {
+	_, ok := errors.Cause(err).(*violationError)
+	return ok
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/juju/errors"
+)
+
+// Rule is a single built-in policy rule. An operation is rejected
+// when its class matches Operation and the value of the named
+// attribute matches Pattern.
+type Rule struct {
+	// Name identifies the rule in violation errors.
+	Name string `json:"name"`
+
+	// Operation is the class of operation the rule applies to.
+	Operation Operation `json:"operation"`
+
+	// Attribute is the check attribute the rule inspects. For
+	// list-valued attributes the rule matches if any element does.
+	Attribute string `json:"attribute"`
+
+	// Pattern is a glob pattern (as understood by path.Match) matched
+	// against the attribute value.
+	Pattern string `json:"pattern"`
+
+	// Message optionally overrides the default violation message.
+	Message string `json:"message,omitempty"`
+}
+
+// Validate returns an error if the rule is malformed.
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return errors.NotValidf("rule with empty name")
+	}
+	if !KnownOperation(r.Operation) {
+		return errors.NotValidf("rule %q: operation %q", r.Name, r.Operation)
+	}
+	if r.Attribute == "" {
+		return errors.NotValidf("rule %q: empty attribute", r.Name)
+	}
+	if _, err := path.Match(r.Pattern, ""); err != nil {
+		return errors.NotValidf("rule %q: pattern %q", r.Name, r.Pattern)
+	}
+	return nil
+}
+
+// ParseRules decodes a JSON list of rules, validating each one.
+func ParseRules(doc string) ([]Rule, error) {
+	var rules []Rule
+	if err := json.Unmarshal([]byte(doc), &rules); err != nil {
+		return nil, errors.Annotate(err, "parsing policy rules")
+	}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return rules, nil
+}
+
+// rulesChecker evaluates checks against a set of built-in rules.
+type rulesChecker struct {
+	rules []Rule
+}
+
+// NewRulesChecker returns a Checker that rejects any operation
+// matched by one of the given rules.
+func NewRulesChecker(rules []Rule) Checker {
+	return &rulesChecker{rules: rules}
+}
+
+// Check is part of the Checker interface.
+func (c *rulesChecker) Check(check Check) error {
+	for _, rule := range c.rules {
+		if rule.Operation != check.Operation {
+			continue
+		}
+		value, found := check.Attributes[rule.Attribute]
+		if !found {
+			continue
+		}
+		if !matchAttribute(rule.Pattern, value) {
+			continue
+		}
+		message := rule.Message
+		if message == "" {
+			message = fmt.Sprintf("%s %s=%v not allowed", check.Operation, rule.Attribute, value)
+		}
+		return NewViolation(rule.Name, message)
+	}
+	return nil
+}
+
+// matchAttribute matches a single attribute value against a rule
+// pattern. List values match if any element does.
+func matchAttribute(pattern string, value interface{}) bool {
+	switch value := value.(type) {
+	case []string:
+		for _, element := range value {
+			if matchAttribute(pattern, element) {
+				return true
+			}
+		}
+		return false
+	case []interface{}:
+		for _, element := range value {
+			if matchAttribute(pattern, element) {
+				return true
+			}
+		}
+		return false
+	}
+	matched, err := path.Match(pattern, fmt.Sprintf("%v", value))
+	return err == nil && matched
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package policy_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/policy"
+)
+
+type RulesSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&RulesSuite{})
+
+func (s *RulesSuite) TestParseRules(c *gc.C) {
+	rules, err := policy.ParseRules(`[
+		{"name": "no-public-expose", "operation": "expose", "attribute": "expose-to-cidrs", "pattern": "0.0.0.0/0"}
+	]`)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, gc.HasLen, 1)
+	c.Assert(rules[0].Name, gc.Equals, "no-public-expose")
+	c.Assert(rules[0].Operation, gc.Equals, policy.OpExpose)
+}
+
+func (s *RulesSuite) TestParseRulesBadJSON(c *gc.C) {
+	_, err := policy.ParseRules("not json")
+	c.Assert(err, gc.ErrorMatches, "parsing policy rules: .*")
+}
+
+func (s *RulesSuite) TestParseRulesUnknownOperation(c *gc.C) {
+	_, err := policy.ParseRules(`[
+		{"name": "rule", "operation": "destroy", "attribute": "model", "pattern": "*"}
+	]`)
+	c.Assert(err, gc.ErrorMatches, `rule "rule": operation "destroy" not valid`)
+}
+
+func (s *RulesSuite) TestParseRulesEmptyName(c *gc.C) {
+	_, err := policy.ParseRules(`[
+		{"operation": "deploy", "attribute": "application", "pattern": "*"}
+	]`)
+	c.Assert(err, gc.ErrorMatches, "rule with empty name not valid")
+}
+
+func (s *RulesSuite) TestCheckAllowed(c *gc.C) {
+	checker := policy.NewRulesChecker([]policy.Rule{{
+		Name:      "no-public-expose",
+		Operation: policy.OpExpose,
+		Attribute: "expose-to-cidrs",
+		Pattern:   "0.0.0.0/0",
+	}})
+	err := checker.Check(policy.Check{
+		Operation: policy.OpExpose,
+		Attributes: map[string]interface{}{
+			"application":     "mysql",
+			"expose-to-cidrs": []string{"10.0.0.0/24"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *RulesSuite) TestCheckViolation(c *gc.C) {
+	checker := policy.NewRulesChecker([]policy.Rule{{
+		Name:      "no-public-expose",
+		Operation: policy.OpExpose,
+		Attribute: "expose-to-cidrs",
+		Pattern:   "0.0.0.0/0",
+	}})
+	err := checker.Check(policy.Check{
+		Operation: policy.OpExpose,
+		Attributes: map[string]interface{}{
+			"application":     "mysql",
+			"expose-to-cidrs": []string{"10.0.0.0/24", "0.0.0.0/0"},
+		},
+	})
+	c.Assert(err, gc.ErrorMatches,
+		`policy violation \(no-public-expose\): expose expose-to-cidrs=\[10.0.0.0/24 0.0.0.0/0\] not allowed`)
+	c.Assert(policy.IsViolation(err), jc.IsTrue)
+}
+
+func (s *RulesSuite) TestCheckViolationCustomMessage(c *gc.C) {
+	checker := policy.NewRulesChecker([]policy.Rule{{
+		Name:      "no-public-expose",
+		Operation: policy.OpExpose,
+		Attribute: "expose-to-cidrs",
+		Pattern:   "0.0.0.0/0",
+		Message:   "exposing to the whole internet is not allowed",
+	}})
+	err := checker.Check(policy.Check{
+		Operation: policy.OpExpose,
+		Attributes: map[string]interface{}{
+			"expose-to-cidrs": []string{"0.0.0.0/0"},
+		},
+	})
+	c.Assert(err, gc.ErrorMatches,
+		`policy violation \(no-public-expose\): exposing to the whole internet is not allowed`)
+}
+
+func (s *RulesSuite) TestCheckIgnoresOtherOperations(c *gc.C) {
+	checker := policy.NewRulesChecker([]policy.Rule{{
+		Name:      "no-public-expose",
+		Operation: policy.OpExpose,
+		Attribute: "expose-to-cidrs",
+		Pattern:   "0.0.0.0/0",
+	}})
+	err := checker.Check(policy.Check{
+		Operation: policy.OpDeploy,
+		Attributes: map[string]interface{}{
+			"expose-to-cidrs": []string{"0.0.0.0/0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *RulesSuite) TestCheckGlobPattern(c *gc.C) {
+	checker := policy.NewRulesChecker([]policy.Rule{{
+		Name:      "no-test-charms",
+		Operation: policy.OpDeploy,
+		Attribute: "charm-url",
+		Pattern:   "cs:~testing/*",
+	}})
+	err := checker.Check(policy.Check{
+		Operation: policy.OpDeploy,
+		Attributes: map[string]interface{}{
+			"charm-url": "cs:~testing/dummy-1",
+		},
+	})
+	c.Assert(policy.IsViolation(err), jc.IsTrue)
+}
+
+func (s *RulesSuite) TestIsViolation(c *gc.C) {
+	c.Assert(policy.IsViolation(policy.NewViolation("rule", "message")), jc.IsTrue)
+	c.Assert(policy.IsViolation(nil), jc.IsFalse)
+	c.Assert(policy.IsViolation(errors.New("boom")), jc.IsFalse)
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// webhookTimeout bounds how long a policy decision from an external
+// webhook may take; a slow policy server must not hang the API.
+const webhookTimeout = 10 * time.Second
+
+// webhookDecision is the response body expected from a policy webhook
+// that rejects an operation.
+type webhookDecision struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// webhookChecker delegates policy decisions to an external HTTP
+// endpoint.
+type webhookChecker struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookChecker returns a Checker that POSTs each check to the
+// given URL as JSON. A 2xx response allows the operation; a 403
+// response rejects it with the rule and message from the response
+// body; anything else is an evaluation failure.
+func NewWebhookChecker(url string) Checker {
+	return &webhookChecker{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Check is part of the Checker interface.
+func (c *webhookChecker) Check(check Check) error {
+	body, err := json.Marshal(check)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := c.client.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Annotate(err, "calling policy webhook")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusForbidden:
+		var decision webhookDecision
+		if err := json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+			return NewViolation("webhook", "operation rejected by policy webhook")
+		}
+		if decision.Rule == "" {
+			decision.Rule = "webhook"
+		}
+		return NewViolation(decision.Rule, decision.Message)
+	default:
+		return errors.Errorf("policy webhook returned status %d", resp.StatusCode)
+	}
+}
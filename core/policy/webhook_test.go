@@ -0,0 +1,92 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package policy_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/policy"
+)
+
+type WebhookSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&WebhookSuite{})
+
+func (s *WebhookSuite) TestCheckAllowed(c *gc.C) {
+	var received policy.Check
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&received)
+		c.Check(err, jc.ErrorIsNil)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checker := policy.NewWebhookChecker(server.URL)
+	err := checker.Check(policy.Check{
+		Operation: policy.OpDeploy,
+		ModelUUID: "some-uuid",
+		Attributes: map[string]interface{}{
+			"application": "mysql",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(received.Operation, gc.Equals, policy.OpDeploy)
+	c.Assert(received.ModelUUID, gc.Equals, "some-uuid")
+	c.Assert(received.Attributes, gc.DeepEquals, map[string]interface{}{
+		"application": "mysql",
+	})
+}
+
+func (s *WebhookSuite) TestCheckRejected(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"rule":    "no-mysql",
+			"message": "mysql is not allowed here",
+		})
+	}))
+	defer server.Close()
+
+	checker := policy.NewWebhookChecker(server.URL)
+	err := checker.Check(policy.Check{Operation: policy.OpDeploy})
+	c.Assert(err, gc.ErrorMatches, `policy violation \(no-mysql\): mysql is not allowed here`)
+	c.Assert(policy.IsViolation(err), jc.IsTrue)
+}
+
+func (s *WebhookSuite) TestCheckRejectedEmptyBody(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	checker := policy.NewWebhookChecker(server.URL)
+	err := checker.Check(policy.Check{Operation: policy.OpDeploy})
+	c.Assert(policy.IsViolation(err), jc.IsTrue)
+}
+
+func (s *WebhookSuite) TestCheckServerError(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	checker := policy.NewWebhookChecker(server.URL)
+	err := checker.Check(policy.Check{Operation: policy.OpDeploy})
+	c.Assert(err, gc.ErrorMatches, "policy webhook returned status 500")
+	c.Assert(policy.IsViolation(err), jc.IsFalse)
+}
+
+func (s *WebhookSuite) TestCheckUnreachable(c *gc.C) {
+	checker := policy.NewWebhookChecker("http://127.0.0.1:0/policy")
+	err := checker.Check(policy.Check{Operation: policy.OpDeploy})
+	c.Assert(err, gc.ErrorMatches, "calling policy webhook: .*")
+}
@@ -414,6 +414,16 @@ func LatestLts() string {
 	return latestLtsSeries
 }
 
+// IsLts reports whether the given series is an Ubuntu LTS release.
+func IsLts(series string) bool {
+	seriesVersionsMutex.Lock()
+	defer seriesVersionsMutex.Unlock()
+	updateSeriesVersionsOnce()
+
+	version, ok := ubuntuSeries[SeriesName(series)]
+	return ok && version.LTS
+}
+
 // versionSeries provides a mapping between versions and series names.
 var (
 	versionSeries     map[string]string
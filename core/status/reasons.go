@@ -0,0 +1,145 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// StatusReasonKey is the key under which a reason code is stored in
+// a status's Data map.
+const StatusReasonKey = "reason"
+
+// StatusReason is a machine-readable code explaining why an entity
+// is in a blocked or maintenance state. Fleet dashboards use reason
+// codes to group and alert on blocked applications without parsing
+// free-form status messages.
+type StatusReason string
+
+// String returns a string representation of the StatusReason.
+func (r StatusReason) String() string {
+	return string(r)
+}
+
+const (
+	// ReasonMissingRelation means a required relation has not been
+	// established.
+	ReasonMissingRelation StatusReason = "missing-relation"
+
+	// ReasonMissingConfig means a mandatory configuration option
+	// has not been set.
+	ReasonMissingConfig StatusReason = "missing-config"
+
+	// ReasonInvalidConfig means a configuration option has been set
+	// to a value the charm cannot use.
+	ReasonInvalidConfig StatusReason = "invalid-config"
+
+	// ReasonMissingResource means a required charm resource has not
+	// been supplied.
+	ReasonMissingResource StatusReason = "missing-resource"
+
+	// ReasonResourceExhausted means the workload has run out of a
+	// cloud or host resource, such as disk space.
+	ReasonResourceExhausted StatusReason = "resource-exhausted"
+
+	// ReasonCredentialInvalid means credentials held by the
+	// workload have expired or been revoked.
+	ReasonCredentialInvalid StatusReason = "credential-invalid"
+
+	// ReasonUpgradeRequired means the workload cannot proceed until
+	// it is upgraded.
+	ReasonUpgradeRequired StatusReason = "upgrade-required"
+
+	// ReasonMaintenanceWindow means the workload is undergoing
+	// planned maintenance.
+	ReasonMaintenanceWindow StatusReason = "maintenance-window"
+
+	// ReasonManualIntervention means an operator must take action
+	// the charm cannot perform itself.
+	ReasonManualIntervention StatusReason = "manual-intervention"
+)
+
+// validReasonName constrains reason codes to lowercase hyphenated
+// words, matching the style of the built-in taxonomy.
+var validReasonName = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+var (
+	reasonsMu    sync.Mutex
+	knownReasons = map[StatusReason]bool{
+		ReasonMissingRelation:    true,
+		ReasonMissingConfig:      true,
+		ReasonInvalidConfig:      true,
+		ReasonMissingResource:    true,
+		ReasonResourceExhausted:  true,
+		ReasonCredentialInvalid:  true,
+		ReasonUpgradeRequired:    true,
+		ReasonMaintenanceWindow:  true,
+		ReasonManualIntervention: true,
+	}
+)
+
+// RegisterStatusReason adds a site-specific reason code to the
+// taxonomy. Registering a code that is already known is a no-op.
+func RegisterStatusReason(reason StatusReason) error {
+	if !validReasonName.MatchString(string(reason)) {
+		return errors.NotValidf("status reason %q", reason)
+	}
+	reasonsMu.Lock()
+	defer reasonsMu.Unlock()
+	knownReasons[reason] = true
+	return nil
+}
+
+// ValidStatusReason reports whether the given reason code is part of
+// the registered taxonomy.
+func ValidStatusReason(reason StatusReason) bool {
+	reasonsMu.Lock()
+	defer reasonsMu.Unlock()
+	return knownReasons[reason]
+}
+
+// KnownStatusReasons returns the registered reason codes in sorted
+// order.
+func KnownStatusReasons() []StatusReason {
+	reasonsMu.Lock()
+	defer reasonsMu.Unlock()
+	reasons := make([]StatusReason, 0, len(knownReasons))
+	for reason := range knownReasons {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	return reasons
+}
+
+// SupportsStatusReason reports whether the given status can carry a
+// reason code. Only blocked and maintenance states have reasons; the
+// other states are self-explanatory.
+func SupportsStatusReason(s Status) bool {
+	return s == Blocked || s == Maintenance
+}
+
+// ValidateStatusReasonData checks any reason code carried in the
+// given status data against the registered taxonomy. It is used by
+// the API server when operators or charms set a status.
+func ValidateStatusReasonData(s Status, data map[string]interface{}) error {
+	value, ok := data[StatusReasonKey]
+	if !ok {
+		return nil
+	}
+	reason, ok := value.(string)
+	if !ok {
+		return errors.NotValidf("status reason %v", value)
+	}
+	if !SupportsStatusReason(s) {
+		return errors.NotValidf("reason code on status %q", s)
+	}
+	if !ValidStatusReason(StatusReason(reason)) {
+		return errors.NotValidf("unknown status reason %q", reason)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/status"
+)
+
+type StatusReasonSuite struct{}
+
+var _ = gc.Suite(&StatusReasonSuite{})
+
+func (s *StatusReasonSuite) TestValidStatusReason(c *gc.C) {
+	c.Check(status.ValidStatusReason(status.ReasonMissingRelation), jc.IsTrue)
+	c.Check(status.ValidStatusReason("no-such-reason"), jc.IsFalse)
+}
+
+func (s *StatusReasonSuite) TestRegisterStatusReason(c *gc.C) {
+	err := status.RegisterStatusReason("site-specific-outage")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status.ValidStatusReason("site-specific-outage"), jc.IsTrue)
+}
+
+func (s *StatusReasonSuite) TestRegisterStatusReasonBadName(c *gc.C) {
+	err := status.RegisterStatusReason("Not A Code")
+	c.Assert(err, gc.ErrorMatches, `status reason "Not A Code" not valid`)
+}
+
+func (s *StatusReasonSuite) TestSupportsStatusReason(c *gc.C) {
+	c.Check(status.SupportsStatusReason(status.Blocked), jc.IsTrue)
+	c.Check(status.SupportsStatusReason(status.Maintenance), jc.IsTrue)
+	c.Check(status.SupportsStatusReason(status.Active), jc.IsFalse)
+}
+
+func (s *StatusReasonSuite) TestValidateStatusReasonData(c *gc.C) {
+	err := status.ValidateStatusReasonData(status.Blocked, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = status.ValidateStatusReasonData(status.Blocked, map[string]interface{}{
+		status.StatusReasonKey: "missing-relation",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = status.ValidateStatusReasonData(status.Active, map[string]interface{}{
+		status.StatusReasonKey: "missing-relation",
+	})
+	c.Assert(err, gc.ErrorMatches, `reason code on status "active" not valid`)
+
+	err = status.ValidateStatusReasonData(status.Blocked, map[string]interface{}{
+		status.StatusReasonKey: "made-up",
+	})
+	c.Assert(err, gc.ErrorMatches, `unknown status reason "made-up" not valid`)
+
+	err = status.ValidateStatusReasonData(status.Blocked, map[string]interface{}{
+		status.StatusReasonKey: 42,
+	})
+	c.Assert(err, gc.ErrorMatches, `status reason 42 not valid`)
+}
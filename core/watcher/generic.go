@@ -0,0 +1,18 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package watcher
+
+// Watcher defines a worker that wraps a channel of changes of type T,
+// exhibiting the features described in the CoreWatcher docs. The
+// concrete channel types previously spelled out per watcher kind
+// (NotifyWatcher, StringsWatcher and friends) are instantiations of
+// this interface, so code handling change values can be typed instead
+// of converting between tags, ids and strings at every boundary.
+type Watcher[T any] interface {
+	CoreWatcher
+
+	// Changes returns the channel on which changes of type T are
+	// received. It should never be closed by the watcher.
+	Changes() <-chan T
+}
@@ -0,0 +1,21 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package watcher_test
+
+import (
+	"github.com/juju/juju/core/watcher"
+)
+
+// The concrete watcher kinds are aliases of the generic Watcher
+// interface, so values flow freely in both directions without
+// conversion. These assertions pin that relationship down.
+var (
+	_ watcher.Watcher[struct{}]                    = (watcher.NotifyWatcher)(nil)
+	_ watcher.Watcher[[]string]                    = (watcher.StringsWatcher)(nil)
+	_ watcher.Watcher[watcher.RelationUnitsChange] = (watcher.RelationUnitsWatcher)(nil)
+
+	_ watcher.NotifyWatcher        = (watcher.Watcher[struct{}])(nil)
+	_ watcher.StringsWatcher       = (watcher.Watcher[[]string])(nil)
+	_ watcher.RelationUnitsWatcher = (watcher.Watcher[watcher.RelationUnitsChange])(nil)
+)
@@ -13,14 +13,11 @@ import (
 //
 // It sends a single value to indicate that the watch is active, and subsequent
 // values whenever the value(s) under observation change(s).
-type NotifyChannel <-chan struct{}
+type NotifyChannel = <-chan struct{}
 
 // NotifyWatcher conveniently ties a NotifyChannel to the worker.Worker that
 // represents its validity.
-type NotifyWatcher interface {
-	CoreWatcher
-	Changes() NotifyChannel
-}
+type NotifyWatcher = Watcher[struct{}]
 
 // NotifyHandler defines the operation of a NotifyWorker.
 type NotifyHandler interface {
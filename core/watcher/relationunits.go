@@ -34,11 +34,8 @@ type RelationUnitsChange struct {
 //
 // It feeds the joined-changed-departed logic in worker/uniter, but these events
 // do not map 1:1 with hooks.
-type RelationUnitsChannel <-chan RelationUnitsChange
+type RelationUnitsChannel = <-chan RelationUnitsChange
 
 // RelationUnitsWatcher conveniently ties a RelationUnitsChannel to the
 // worker.Worker that represents its validity.
-type RelationUnitsWatcher interface {
-	CoreWatcher
-	Changes() RelationUnitsChannel
-}
+type RelationUnitsWatcher = Watcher[RelationUnitsChange]
@@ -14,14 +14,11 @@ import (
 // It sends a single value indicating a baseline set of values, and subsequent
 // values representing additions, changes, and/or removals of those values. The
 // precise semantics may depend upon the individual watcher.
-type StringsChannel <-chan []string
+type StringsChannel = <-chan []string
 
 // StringsWatcher conveniently ties a StringsChannel to the worker.Worker that
 // represents its validity.
-type StringsWatcher interface {
-	CoreWatcher
-	Changes() StringsChannel
-}
+type StringsWatcher = Watcher[[]string]
 
 // StringsHandler defines the operation of a StringsWorker.
 type StringsHandler interface {
@@ -0,0 +1,150 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package webhook defines the deployment events delivered to webhook
+// endpoints registered on the controller, and the wire format used to
+// deliver them.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+)
+
+var logger = loggo.GetLogger("juju.core.webhook")
+
+const (
+	// EventApplicationDeployed is published when an application is
+	// successfully deployed to a model.
+	EventApplicationDeployed = "application-deployed"
+
+	// EventUpgradeCompleted is published when a charm or model
+	// upgrade completes.
+	EventUpgradeCompleted = "upgrade-completed"
+
+	// EventUnitError is published when a unit enters an error state.
+	EventUnitError = "unit-error"
+
+	// EventOfferConsumed is published when an application offer is
+	// consumed by another model.
+	EventOfferConsumed = "offer-consumed"
+)
+
+// ValidEvent reports whether eventType names a known event.
+func ValidEvent(eventType string) bool {
+	switch eventType {
+	case EventApplicationDeployed, EventUpgradeCompleted, EventUnitError, EventOfferConsumed:
+		return true
+	}
+	return false
+}
+
+// Event is a structured deployment event delivered to registered
+// webhook endpoints.
+type Event struct {
+	// Type identifies the kind of event, one of the Event constants.
+	Type string `json:"type"`
+
+	// ModelUUID is the model the event originated from.
+	ModelUUID string `json:"model-uuid,omitempty"`
+
+	// Entity names the application, unit or offer the event concerns.
+	Entity string `json:"entity,omitempty"`
+
+	// Message carries optional human-readable detail.
+	Message string `json:"message,omitempty"`
+
+	// Timestamp is when the event occurred.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Endpoint describes a webhook endpoint registered to receive events.
+type Endpoint struct {
+	// Name uniquely identifies the endpoint on the controller.
+	Name string
+
+	// URL is the http or https URL events are POSTed to.
+	URL string
+
+	// Secret is the shared secret used to sign event payloads.
+	Secret string
+
+	// EventFilters restricts delivery to the named event types. An
+	// empty filter delivers all events.
+	EventFilters []string
+}
+
+// Matches reports whether an event of the given type should be
+// delivered to the endpoint.
+func (e Endpoint) Matches(eventType string) bool {
+	if len(e.EventFilters) == 0 {
+		return true
+	}
+	for _, filter := range e.EventFilters {
+		if filter == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 signature of payload under
+// the given secret. Receivers recompute the signature to authenticate
+// the delivery.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// Notify delivers the event to the endpoint as a JSON POST. The
+// payload signature is carried in the X-Juju-Webhook-Signature header
+// and the event type in X-Juju-Webhook-Event.
+func Notify(endpoint Endpoint, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req, err := http.NewRequest("POST", endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Juju-Webhook-Event", event.Type)
+	req.Header.Set("X-Juju-Webhook-Signature", Sign(endpoint.Secret, payload))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("webhook endpoint %q returned status %d", endpoint.Name, resp.StatusCode)
+	}
+	return nil
+}
+
+// NotifyAll delivers the event to every endpoint whose filters match,
+// without blocking the caller. Delivery is best effort; failures are
+// logged and do not affect the operation that raised the event.
+func NotifyAll(endpoints []Endpoint, event Event) {
+	for _, endpoint := range endpoints {
+		if !endpoint.Matches(event.Type) {
+			continue
+		}
+		go func(endpoint Endpoint) {
+			if err := Notify(endpoint, event); err != nil {
+				logger.Warningf("cannot deliver %q event to webhook endpoint %q: %v", event.Type, endpoint.Name, err)
+			}
+		}(endpoint)
+	}
+}
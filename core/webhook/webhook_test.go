@@ -0,0 +1,95 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package webhook_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/webhook"
+)
+
+type WebhookSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&WebhookSuite{})
+
+func (s *WebhookSuite) TestValidEvent(c *gc.C) {
+	for _, eventType := range []string{
+		webhook.EventApplicationDeployed,
+		webhook.EventUpgradeCompleted,
+		webhook.EventUnitError,
+		webhook.EventOfferConsumed,
+	} {
+		c.Check(webhook.ValidEvent(eventType), jc.IsTrue)
+	}
+	c.Check(webhook.ValidEvent("model-destroyed"), jc.IsFalse)
+	c.Check(webhook.ValidEvent(""), jc.IsFalse)
+}
+
+func (s *WebhookSuite) TestMatchesEmptyFilters(c *gc.C) {
+	endpoint := webhook.Endpoint{Name: "all"}
+	c.Check(endpoint.Matches(webhook.EventApplicationDeployed), jc.IsTrue)
+	c.Check(endpoint.Matches(webhook.EventUnitError), jc.IsTrue)
+}
+
+func (s *WebhookSuite) TestMatchesFilters(c *gc.C) {
+	endpoint := webhook.Endpoint{
+		Name:         "deploys",
+		EventFilters: []string{webhook.EventApplicationDeployed, webhook.EventUpgradeCompleted},
+	}
+	c.Check(endpoint.Matches(webhook.EventApplicationDeployed), jc.IsTrue)
+	c.Check(endpoint.Matches(webhook.EventUnitError), jc.IsFalse)
+}
+
+func (s *WebhookSuite) TestNotify(c *gc.C) {
+	var (
+		gotEvent     string
+		gotSignature string
+		gotBody      []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotEvent = r.Header.Get("X-Juju-Webhook-Event")
+		gotSignature = r.Header.Get("X-Juju-Webhook-Signature")
+		gotBody, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	event := webhook.Event{
+		Type:      webhook.EventApplicationDeployed,
+		ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		Entity:    "mysql",
+		Timestamp: time.Unix(42, 0).UTC(),
+	}
+	endpoint := webhook.Endpoint{Name: "ci", URL: server.URL, Secret: "sekrit"}
+	err := webhook.Notify(endpoint, event)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(gotEvent, gc.Equals, webhook.EventApplicationDeployed)
+	c.Check(gotSignature, gc.Equals, webhook.Sign("sekrit", gotBody))
+
+	var delivered webhook.Event
+	err = json.Unmarshal(gotBody, &delivered)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(delivered, jc.DeepEquals, event)
+}
+
+func (s *WebhookSuite) TestNotifyErrorStatus(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	endpoint := webhook.Endpoint{Name: "ci", URL: server.URL, Secret: "sekrit"}
+	err := webhook.Notify(endpoint, webhook.Event{Type: webhook.EventUnitError})
+	c.Assert(err, gc.ErrorMatches, `webhook endpoint "ci" returned status 403`)
+}
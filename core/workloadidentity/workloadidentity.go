@@ -0,0 +1,174 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package workloadidentity implements the issuing of short-lived
+// workload identity tokens. Tokens are SPIFFE-style JWT-SVIDs signed by
+// the controller, carrying unit, application and model claims, that
+// charmed workloads can present to external services in place of
+// baked-in secrets.
+package workloadidentity
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+)
+
+const (
+	// DefaultTokenLifetime is the lifetime of issued tokens when the
+	// caller does not request one explicitly.
+	DefaultTokenLifetime = 15 * time.Minute
+
+	// MaxTokenLifetime bounds the lifetime that can be requested for a
+	// token. Long-lived identity tokens defeat the point of issuing
+	// them on demand.
+	MaxTokenLifetime = 12 * time.Hour
+)
+
+// Claims holds the claims carried by a workload identity token.
+type Claims struct {
+	Issuer      string   `json:"iss"`
+	Subject     string   `json:"sub"`
+	Audience    []string `json:"aud,omitempty"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
+	ModelUUID   string   `json:"model-uuid"`
+	Application string   `json:"application"`
+	Unit        string   `json:"unit"`
+}
+
+// UnitSubject returns the SPIFFE ID used as the subject of tokens
+// issued to the given unit of the given model, within the supplied
+// trust domain.
+func UnitSubject(trustDomain, modelUUID, unitName string) (string, error) {
+	appName, err := names.UnitApplication(unitName)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return fmt.Sprintf("spiffe://%s/model/%s/application/%s/unit/%s",
+		trustDomain, modelUUID, appName, unitName), nil
+}
+
+// Issuer mints workload identity tokens for units, signed with the
+// controller's workload identity key.
+type Issuer struct {
+	key         *ecdsa.PrivateKey
+	trustDomain string
+	clock       clock.Clock
+}
+
+// NewIssuer returns an Issuer that signs tokens with the given ECDSA
+// key on behalf of the given trust domain.
+func NewIssuer(key *ecdsa.PrivateKey, trustDomain string, clk clock.Clock) (*Issuer, error) {
+	if key == nil {
+		return nil, errors.NotValidf("nil signing key")
+	}
+	if trustDomain == "" {
+		return nil, errors.NotValidf("empty trust domain")
+	}
+	return &Issuer{key: key, trustDomain: trustDomain, clock: clk}, nil
+}
+
+// IssueUnitToken mints a token identifying the given unit of the given
+// model for the supplied audiences. A non-positive lifetime selects
+// DefaultTokenLifetime. The token and its expiry time are returned.
+func (i *Issuer) IssueUnitToken(modelUUID, unitName string, audience []string, lifetime time.Duration) (string, time.Time, error) {
+	if !names.IsValidUnit(unitName) {
+		return "", time.Time{}, errors.NotValidf("unit name %q", unitName)
+	}
+	if lifetime <= 0 {
+		lifetime = DefaultTokenLifetime
+	}
+	if lifetime > MaxTokenLifetime {
+		return "", time.Time{}, errors.NotValidf("token lifetime %v exceeding %v", lifetime, MaxTokenLifetime)
+	}
+	subject, err := UnitSubject(i.trustDomain, modelUUID, unitName)
+	if err != nil {
+		return "", time.Time{}, errors.Trace(err)
+	}
+	appName, _ := names.UnitApplication(unitName)
+
+	now := i.clock.Now()
+	expiry := now.Add(lifetime)
+	claims := Claims{
+		Issuer:      i.trustDomain,
+		Subject:     subject,
+		Audience:    audience,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   expiry.Unix(),
+		ModelUUID:   modelUUID,
+		Application: appName,
+		Unit:        unitName,
+	}
+	token, err := signJWT(i.key, claims)
+	if err != nil {
+		return "", time.Time{}, errors.Trace(err)
+	}
+	return token, expiry, nil
+}
+
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`))
+
+func signJWT(key *ecdsa.PrivateKey, claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	signingInput := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	// JOSE ES256 signatures are the fixed-width big-endian
+	// concatenation of r and s.
+	keyBytes := (key.Curve.Params().BitSize + 7) / 8
+	sig := make([]byte, 2*keyBytes)
+	r.FillBytes(sig[:keyBytes])
+	s.FillBytes(sig[keyBytes:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Verify checks the signature of the given token against the supplied
+// public key and returns the claims it carries. Expiry is not checked
+// here; callers are expected to compare the ExpiresAt claim against
+// their own clock.
+func Verify(token string, key *ecdsa.PublicKey) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.NotValidf("token with %d parts", len(parts))
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Annotate(err, "decoding token signature")
+	}
+	keyBytes := (key.Curve.Params().BitSize + 7) / 8
+	if len(sig) != 2*keyBytes {
+		return nil, errors.NotValidf("token signature of %d bytes", len(sig))
+	}
+	r := new(big.Int).SetBytes(sig[:keyBytes])
+	s := new(big.Int).SetBytes(sig[keyBytes:])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return nil, errors.New("token signature mismatch")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Annotate(err, "decoding token claims")
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Annotate(err, "unmarshalling token claims")
+	}
+	return &claims, nil
+}
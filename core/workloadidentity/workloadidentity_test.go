@@ -0,0 +1,100 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadidentity_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/workloadidentity"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type workloadIdentitySuite struct {
+	key   *ecdsa.PrivateKey
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&workloadIdentitySuite{})
+
+func (s *workloadIdentitySuite) SetUpTest(c *gc.C) {
+	var err error
+	s.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock = testclock.NewClock(time.Now())
+}
+
+func (s *workloadIdentitySuite) TestIssueUnitToken(c *gc.C) {
+	issuer, err := workloadidentity.NewIssuer(s.key, "controller-uuid", s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	token, expiry, err := issuer.IssueUnitToken(
+		coretesting.ModelTag.Id(), "mysql/0", []string{"vault.example.com"}, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expiry, gc.Equals, s.clock.Now().Add(time.Hour))
+
+	claims, err := workloadidentity.Verify(token, &s.key.PublicKey)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(claims, jc.DeepEquals, &workloadidentity.Claims{
+		Issuer: "controller-uuid",
+		Subject: "spiffe://controller-uuid/model/" + coretesting.ModelTag.Id() +
+			"/application/mysql/unit/mysql/0",
+		Audience:    []string{"vault.example.com"},
+		IssuedAt:    s.clock.Now().Unix(),
+		ExpiresAt:   s.clock.Now().Add(time.Hour).Unix(),
+		ModelUUID:   coretesting.ModelTag.Id(),
+		Application: "mysql",
+		Unit:        "mysql/0",
+	})
+}
+
+func (s *workloadIdentitySuite) TestIssueUnitTokenDefaultLifetime(c *gc.C) {
+	issuer, err := workloadidentity.NewIssuer(s.key, "controller-uuid", s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, expiry, err := issuer.IssueUnitToken(coretesting.ModelTag.Id(), "mysql/0", nil, 0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expiry, gc.Equals, s.clock.Now().Add(workloadidentity.DefaultTokenLifetime))
+}
+
+func (s *workloadIdentitySuite) TestIssueUnitTokenLifetimeTooLong(c *gc.C) {
+	issuer, err := workloadidentity.NewIssuer(s.key, "controller-uuid", s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = issuer.IssueUnitToken(coretesting.ModelTag.Id(), "mysql/0", nil, 48*time.Hour)
+	c.Assert(err, gc.ErrorMatches, `token lifetime 48h0m0s exceeding 12h0m0s not valid`)
+}
+
+func (s *workloadIdentitySuite) TestIssueUnitTokenBadUnitName(c *gc.C) {
+	issuer, err := workloadidentity.NewIssuer(s.key, "controller-uuid", s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = issuer.IssueUnitToken(coretesting.ModelTag.Id(), "mysql", nil, 0)
+	c.Assert(err, gc.ErrorMatches, `unit name "mysql" not valid`)
+}
+
+func (s *workloadIdentitySuite) TestVerifyRejectsWrongKey(c *gc.C) {
+	issuer, err := workloadidentity.NewIssuer(s.key, "controller-uuid", s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+	token, _, err := issuer.IssueUnitToken(coretesting.ModelTag.Id(), "mysql/0", nil, 0)
+	c.Assert(err, jc.ErrorIsNil)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = workloadidentity.Verify(token, &otherKey.PublicKey)
+	c.Assert(err, gc.ErrorMatches, "token signature mismatch")
+}
+
+func (s *workloadIdentitySuite) TestNewIssuerValidates(c *gc.C) {
+	_, err := workloadidentity.NewIssuer(nil, "controller-uuid", s.clock)
+	c.Assert(err, gc.ErrorMatches, "nil signing key not valid")
+	_, err = workloadidentity.NewIssuer(s.key, "", s.clock)
+	c.Assert(err, gc.ErrorMatches, "empty trust domain not valid")
+}
@@ -0,0 +1,408 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package imagemirror copies OCI images between docker registries so
+// that the controller can maintain an internal cache of workload
+// images for clusters without internet egress.
+package imagemirror
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/docker"
+)
+
+var logger = loggo.GetLogger("juju.docker.imagemirror")
+
+// manifestMediaTypes are the manifest formats we ask registries for,
+// in preference order.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// listMediaTypes are the manifest media types that reference other
+// manifests rather than image content directly.
+var listMediaTypes = map[string]bool{
+	"application/vnd.oci.image.index.v1+json":                   true,
+	"application/vnd.docker.distribution.manifest.list.v2+json": true,
+}
+
+// Mirrorer copies images into a mirror registry, returning the
+// reference the image can be pulled from inside the mirror.
+type Mirrorer interface {
+	// Mirror ensures the image at sourcePath is present in the mirror
+	// registry and returns its mirrored registry path. auth holds the
+	// credentials needed to pull the source image, if any.
+	Mirror(sourcePath string, auth docker.BasicAuthConfig) (string, error)
+}
+
+// NewMirrorerFunc returns a Mirrorer pushing into the given mirror repo.
+type NewMirrorerFunc func(mirror docker.ImageRepoDetails) Mirrorer
+
+type mirrorer struct {
+	mirror docker.ImageRepoDetails
+	client *http.Client
+}
+
+// New returns a Mirrorer that copies images into the given mirror repo
+// using the docker registry v2 API.
+func New(mirror docker.ImageRepoDetails) Mirrorer {
+	return &mirrorer{
+		mirror: mirror,
+		client: &http.Client{Transport: DefaultTransport},
+	}
+}
+
+// DefaultTransport is used for registry requests.
+// Override for testing.
+var DefaultTransport = http.DefaultTransport
+
+// MirrorReference returns the registry path an image at sourcePath
+// gets inside the given mirror repo: the mirror repository plus the
+// last component of the source path, preserving any tag or digest.
+func MirrorReference(mirror docker.ImageRepoDetails, sourcePath string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(sourcePath)
+	if err != nil {
+		return "", errors.NotValidf("docker image path %q", sourcePath)
+	}
+	mirrored := path.Join(mirror.Repository, path.Base(reference.Path(named)))
+	if canonical, ok := named.(reference.Canonical); ok {
+		return mirrored + "@" + canonical.Digest().String(), nil
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		return mirrored + ":" + tagged.Tag(), nil
+	}
+	return mirrored + ":latest", nil
+}
+
+// Mirror is part of the Mirrorer interface.
+func (m *mirrorer) Mirror(sourcePath string, auth docker.BasicAuthConfig) (string, error) {
+	mirrored, err := MirrorReference(m.mirror, sourcePath)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	source, err := newEndpoint(sourcePath, auth)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	target, err := newEndpoint(mirrored, m.mirror.BasicAuthConfig)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	ok, err := m.manifestExists(target)
+	if err != nil {
+		return "", errors.Annotatef(err, "checking mirror for %q", sourcePath)
+	}
+	if ok {
+		logger.Debugf("image %q already mirrored as %q", sourcePath, mirrored)
+		return mirrored, nil
+	}
+	if err := m.copyManifest(source, target, source.tagOrDigest); err != nil {
+		return "", errors.Annotatef(err, "mirroring %q into %q", sourcePath, m.mirror.Repository)
+	}
+	logger.Infof("mirrored image %q as %q", sourcePath, mirrored)
+	return mirrored, nil
+}
+
+// endpoint identifies one image in one registry.
+type endpoint struct {
+	base        string
+	repo        string
+	tagOrDigest string
+	auth        docker.BasicAuthConfig
+
+	// token caches a bearer token handed out by the registry's auth
+	// service in response to a challenge.
+	token string
+}
+
+func newEndpoint(imagePath string, auth docker.BasicAuthConfig) (*endpoint, error) {
+	named, err := reference.ParseNormalizedNamed(imagePath)
+	if err != nil {
+		return nil, errors.NotValidf("docker image path %q", imagePath)
+	}
+	domain := reference.Domain(named)
+	scheme := "https"
+	if strings.HasPrefix(domain, "localhost") || strings.Contains(domain, "127.0.0.1") {
+		scheme = "http"
+	}
+	if domain == "docker.io" {
+		// The registry API lives on a different host to the one used
+		// in image references.
+		domain = "registry-1.docker.io"
+	}
+	tagOrDigest := "latest"
+	if canonical, ok := named.(reference.Canonical); ok {
+		tagOrDigest = canonical.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		tagOrDigest = tagged.Tag()
+	}
+	return &endpoint{
+		base:        scheme + "://" + domain,
+		repo:        reference.Path(named),
+		tagOrDigest: tagOrDigest,
+		auth:        auth,
+	}, nil
+}
+
+func (e *endpoint) url(format string, args ...interface{}) string {
+	return e.base + "/v2/" + e.repo + fmt.Sprintf(format, args...)
+}
+
+// do performs the request, answering bearer token challenges from the
+// registry's auth service using the endpoint's basic credentials.
+func (m *mirrorer) do(e *endpoint, req *http.Request) (*http.Response, error) {
+	authorise := func(req *http.Request) {
+		if e.token != "" {
+			req.Header.Set("Authorization", "Bearer "+e.token)
+		} else if e.auth.Username != "" {
+			req.SetBasicAuth(e.auth.Username, e.auth.Password)
+		}
+	}
+	authorise(req)
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || req.Body != nil {
+		return resp, nil
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_ = resp.Body.Close()
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return nil, errors.Unauthorizedf("registry %q", e.base)
+	}
+	if err := m.fetchToken(e, challenge); err != nil {
+		return nil, errors.Trace(err)
+	}
+	retry, err := http.NewRequest(req.Method, req.URL.String(), nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	retry.Header = req.Header.Clone()
+	authorise(retry)
+	return m.client.Do(retry)
+}
+
+// fetchToken obtains a bearer token from the auth service named in a
+// Bearer challenge header.
+func (m *mirrorer) fetchToken(e *endpoint, challenge string) error {
+	fields := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) == 2 {
+			fields[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	realm := fields["realm"]
+	if realm == "" {
+		return errors.NotValidf("bearer challenge %q", challenge)
+	}
+	query := url.Values{}
+	if fields["service"] != "" {
+		query.Set("service", fields["service"])
+	}
+	if fields["scope"] != "" {
+		query.Set("scope", fields["scope"])
+	}
+	req, err := http.NewRequest("GET", realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if e.auth.Username != "" {
+		req.SetBasicAuth(e.auth.Username, e.auth.Password)
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("token request to %q failed: %s", realm, resp.Status)
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return errors.Trace(err)
+	}
+	e.token = body.Token
+	if e.token == "" {
+		e.token = body.AccessToken
+	}
+	if e.token == "" {
+		return errors.Errorf("token request to %q returned no token", realm)
+	}
+	return nil
+}
+
+func (m *mirrorer) manifestExists(e *endpoint) (bool, error) {
+	req, err := http.NewRequest("HEAD", e.url("/manifests/%s", e.tagOrDigest), nil)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	resp, err := m.do(e, req)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound, http.StatusUnauthorized:
+		// Some registries answer 401 rather than 404 for unknown
+		// repositories; treat it as not mirrored yet.
+		return false, nil
+	}
+	return false, errors.Errorf("unexpected response from %q: %s", e.base, resp.Status)
+}
+
+// copyManifest copies the manifest with the given tag or digest, and
+// everything it references, from source to target.
+func (m *mirrorer) copyManifest(source, target *endpoint, tagOrDigest string) error {
+	req, err := http.NewRequest("GET", source.url("/manifests/%s", tagOrDigest), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	resp, err := m.do(source, req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("fetching manifest %q: %s", tagOrDigest, resp.Status)
+	}
+	mediaType := resp.Header.Get("Content-Type")
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var manifest struct {
+		Config struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+		Layers []struct {
+			Digest string `json:"digest"`
+		} `json:"layers"`
+		Manifests []struct {
+			Digest string `json:"digest"`
+		} `json:"manifests"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return errors.Annotatef(err, "parsing manifest %q", tagOrDigest)
+	}
+	if listMediaTypes[mediaType] {
+		for _, sub := range manifest.Manifests {
+			if err := m.copyManifest(source, target, sub.Digest); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	} else {
+		if manifest.Config.Digest != "" {
+			if err := m.copyBlob(source, target, manifest.Config.Digest); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		for _, layer := range manifest.Layers {
+			if err := m.copyBlob(source, target, layer.Digest); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+	put, err := http.NewRequest("PUT", target.url("/manifests/%s", tagOrDigest), bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	put.Header.Set("Content-Type", mediaType)
+	putResp, err := m.do(target, put)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = putResp.Body.Close() }()
+	if putResp.StatusCode != http.StatusCreated && putResp.StatusCode != http.StatusOK {
+		return errors.Errorf("pushing manifest %q: %s", tagOrDigest, putResp.Status)
+	}
+	return nil
+}
+
+// copyBlob copies a single blob from source to target unless the
+// target already has it.
+func (m *mirrorer) copyBlob(source, target *endpoint, digest string) error {
+	head, err := http.NewRequest("HEAD", target.url("/blobs/%s", digest), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	headResp, err := m.do(target, head)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_ = headResp.Body.Close()
+	if headResp.StatusCode == http.StatusOK {
+		return nil
+	}
+	get, err := http.NewRequest("GET", source.url("/blobs/%s", digest), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	getResp, err := m.do(source, get)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	if getResp.StatusCode != http.StatusOK {
+		return errors.Errorf("fetching blob %q: %s", digest, getResp.Status)
+	}
+	start, err := http.NewRequest("POST", target.url("/blobs/uploads/"), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	startResp, err := m.do(target, start)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_ = startResp.Body.Close()
+	if startResp.StatusCode != http.StatusAccepted {
+		return errors.Errorf("starting blob upload for %q: %s", digest, startResp.Status)
+	}
+	location, err := startResp.Location()
+	if err != nil {
+		return errors.Annotatef(err, "blob upload for %q returned no location", digest)
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+	upload, err := http.NewRequest("PUT", location.String(), getResp.Body)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	upload.Header.Set("Content-Type", "application/octet-stream")
+	if length := getResp.Header.Get("Content-Length"); length != "" {
+		upload.Header.Set("Content-Length", length)
+	}
+	uploadResp, err := m.do(target, upload)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_ = uploadResp.Body.Close()
+	if uploadResp.StatusCode != http.StatusCreated {
+		return errors.Errorf("pushing blob %q: %s", digest, uploadResp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package imagemirror_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/docker"
+	"github.com/juju/juju/docker/imagemirror"
+)
+
+type mirrorSuite struct{}
+
+var _ = gc.Suite(&mirrorSuite{})
+
+func (s *mirrorSuite) TestMirrorReference(c *gc.C) {
+	mirror := docker.ImageRepoDetails{Repository: "registry.local/jujucache"}
+	for _, t := range []struct {
+		source   string
+		expected string
+	}{{
+		source:   "gitlab:latest",
+		expected: "registry.local/jujucache/gitlab:latest",
+	}, {
+		source:   "docker.io/me/app:1.2",
+		expected: "registry.local/jujucache/app:1.2",
+	}, {
+		source:   "example.com/ns/app@sha256:d15554d6b1c5a0b1cf1b48e74f18f34ab52dbca496b4e107eba4e0a8e0b4b8d8",
+		expected: "registry.local/jujucache/app@sha256:d15554d6b1c5a0b1cf1b48e74f18f34ab52dbca496b4e107eba4e0a8e0b4b8d8",
+	}, {
+		source:   "ubuntu",
+		expected: "registry.local/jujucache/ubuntu:latest",
+	}} {
+		c.Logf("mirroring %q", t.source)
+		mirrored, err := imagemirror.MirrorReference(mirror, t.source)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(mirrored, gc.Equals, t.expected)
+	}
+}
+
+func (s *mirrorSuite) TestMirrorReferenceInvalid(c *gc.C) {
+	mirror := docker.ImageRepoDetails{Repository: "registry.local/jujucache"}
+	_, err := imagemirror.MirrorReference(mirror, "invalid image path")
+	c.Assert(err, gc.ErrorMatches, `docker image path "invalid image path" not valid`)
+}
+
+const (
+	configDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	layerDigest  = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+// fakeRegistry is a minimal docker registry v2 implementation backed
+// by maps, sufficient for copying a single-arch image.
+type fakeRegistry struct {
+	*httptest.Server
+
+	manifests map[string]string
+	blobs     map[string]string
+	pushed    []string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	r := &fakeRegistry{
+		manifests: make(map[string]string),
+		blobs:     make(map[string]string),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serve))
+	return r
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/v2/"), "/")
+	kind := parts[len(parts)-2]
+	name := parts[len(parts)-1]
+	switch {
+	case kind == "manifests" && (req.Method == "GET" || req.Method == "HEAD"):
+		body, ok := r.manifests[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+		_, _ = w.Write([]byte(body))
+	case kind == "manifests" && req.Method == "PUT":
+		body, _ := ioutil.ReadAll(req.Body)
+		r.manifests[name] = string(body)
+		r.pushed = append(r.pushed, "manifest "+name)
+		w.WriteHeader(http.StatusCreated)
+	case kind == "blobs" && (req.Method == "GET" || req.Method == "HEAD"):
+		body, ok := r.blobs[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	case name == "" && req.Method == "POST":
+		w.Header().Set("Location", "/v2/cache/app/blobs/uploads/abc123")
+		w.WriteHeader(http.StatusAccepted)
+	case kind == "uploads" && req.Method == "PUT":
+		body, _ := ioutil.ReadAll(req.Body)
+		digest := req.URL.Query().Get("digest")
+		r.blobs[digest] = string(body)
+		r.pushed = append(r.pushed, "blob "+digest)
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.URL, "http://")
+}
+
+func (s *mirrorSuite) TestMirrorCopiesImage(c *gc.C) {
+	source := newFakeRegistry()
+	defer source.Close()
+	target := newFakeRegistry()
+	defer target.Close()
+
+	source.manifests["latest"] = fmt.Sprintf(
+		`{"config":{"digest":%q},"layers":[{"digest":%q}]}`, configDigest, layerDigest)
+	source.blobs[configDigest] = "config-content"
+	source.blobs[layerDigest] = "layer-content"
+
+	mirror := docker.ImageRepoDetails{Repository: target.host() + "/cache"}
+	m := imagemirror.New(mirror)
+	mirrored, err := m.Mirror(source.host()+"/app:latest", docker.BasicAuthConfig{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(mirrored, gc.Equals, target.host()+"/cache/app:latest")
+
+	c.Assert(target.pushed, gc.DeepEquals, []string{
+		"blob " + configDigest,
+		"blob " + layerDigest,
+		"manifest latest",
+	})
+	c.Assert(target.blobs[layerDigest], gc.Equals, "layer-content")
+	c.Assert(target.manifests["latest"], gc.Equals, source.manifests["latest"])
+}
+
+func (s *mirrorSuite) TestMirrorAlreadyMirrored(c *gc.C) {
+	source := newFakeRegistry()
+	defer source.Close()
+	target := newFakeRegistry()
+	defer target.Close()
+
+	target.manifests["latest"] = `{"layers":[]}`
+
+	mirror := docker.ImageRepoDetails{Repository: target.host() + "/cache"}
+	m := imagemirror.New(mirror)
+	mirrored, err := m.Mirror(source.host()+"/app:latest", docker.BasicAuthConfig{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(mirrored, gc.Equals, target.host()+"/cache/app:latest")
+	c.Assert(target.pushed, gc.HasLen, 0)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/core/lxdprofile"
 	corenetwork "github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/context"
 	"github.com/juju/juju/environs/imagemetadata"
@@ -108,6 +109,12 @@ type StartInstanceParams struct {
 	// the LXD container, if specified and an LXD container.  The profiles
 	// come from charms deployed on the machine.
 	CharmLXDProfiles []string
+
+	// NICRequests holds the network interfaces, such as SR-IOV or
+	// bonded interfaces, that the instance must be equipped with.
+	// Only brokers implementing NICRequester are passed a non-empty
+	// list.
+	NICRequests []corenetwork.NICRequest
 }
 
 // StartInstanceResult holds the result of an
@@ -173,6 +180,25 @@ type InstanceBroker interface {
 	AllRunningInstances(ctx context.ProviderCallContext) ([]instances.Instance, error)
 }
 
+// NICRequester is implemented by brokers that can satisfy requests for
+// specific kinds of network interface, such as SR-IOV or bonded
+// interfaces, when starting instances.
+type NICRequester interface {
+	// SupportsNICRequest reports whether the broker can satisfy the
+	// given NIC request at all; requests that are supported may still
+	// fail at StartInstance time if no capable machine is available.
+	SupportsNICRequest(corenetwork.NICRequest) bool
+}
+
+// MultiCloudBroker is implemented by brokers that can start instances
+// in registered clouds other than the model's own, enabling hybrid
+// deployments that span more than one cloud.
+type MultiCloudBroker interface {
+	// BrokerForCloudSpec returns an InstanceBroker that provisions
+	// instances in the cloud described by the given spec.
+	BrokerForCloudSpec(cloudspec.CloudSpec) (InstanceBroker, error)
+}
+
 // LXDProfiler defines an interface for dealing with lxd profiles used to
 // deploy juju machines and containers.
 type LXDProfiler interface {
@@ -211,6 +211,28 @@ const (
 	// automatically retry a hook that has failed
 	AutomaticallyRetryHooks = "automatically-retry-hooks"
 
+	// RequireEncryptedVolumesKey determines whether the storage
+	// provisioner refuses volumes that the provider does not report
+	// as encrypted at rest.
+	RequireEncryptedVolumesKey = "require-encrypted-volumes"
+
+	// LXDProfileAutoReconcileKey determines whether the instance
+	// mutater reapplies the expected lxd profiles to a machine when
+	// it detects that the applied profiles have drifted.
+	LXDProfileAutoReconcileKey = "lxd-profile-auto-reconcile"
+
+	// UnifiedAgentModeKey determines whether machine agents in the
+	// model run their units' agents inside the machine agent process.
+	UnifiedAgentModeKey = "unified-agent-mode"
+
+	// AlertWebhookURLKey is the URL that the model alerts worker
+	// delivers alert changes to. Empty disables webhook delivery.
+	AlertWebhookURLKey = "alert-webhook-url"
+
+	// MetricsRemoteWriteURLKey is the URL that unit agents forward
+	// scraped charm metrics to. Empty disables forwarding.
+	MetricsRemoteWriteURLKey = "metrics-remote-write-url"
+
 	// TransmitVendorMetricsKey is the key for whether the controller sends
 	// metrics collected in this model for anonymized aggregate analytics.
 	TransmitVendorMetricsKey = "transmit-vendor-metrics"
@@ -238,6 +260,12 @@ const (
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
+	// HookDebounceWindow is the quiet period the uniter waits for after a
+	// remote state change before dispatching hooks. Rapid successions of
+	// changes within the window are coalesced into a single dispatch, eg
+	// "5s". A value of "0s" disables debouncing.
+	HookDebounceWindow = "hook-debounce-window"
+
 	// EgressSubnets are the source addresses from which traffic from this model
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
@@ -301,6 +329,21 @@ const (
 	// DefaultSeriesKey is a key for determining the series a model should
 	// explicitly use for charms unless otherwise provided.
 	DefaultSeriesKey = "default-series"
+
+	// BaseSelectionPolicyKey is the key for the policy used to select
+	// the base for a charm when the user does not specify one.
+	BaseSelectionPolicyKey = "base-selection-policy"
+)
+
+// Allowed values for base-selection-policy.
+const (
+	// BaseSelectionPinned selects the model default series, if set,
+	// falling back to the charm's own preference order.
+	BaseSelectionPinned = "pinned"
+
+	// BaseSelectionLatestLTS selects the newest Ubuntu LTS release
+	// supported by both the charm and this version of Juju.
+	BaseSelectionLatestLTS = "latest-lts"
 )
 
 // ParseHarvestMode parses description of harvesting method and
@@ -466,6 +509,10 @@ const (
 	// UpdateStatusHookInterval
 	DefaultUpdateStatusHookInterval = "5m"
 
+	// DefaultHookDebounceWindow is the default value for
+	// HookDebounceWindow; hook dispatch is not debounced by default.
+	DefaultHookDebounceWindow = "0s"
+
 	// DefaultActionResultsAge is the default for the age of the results for an
 	// action.
 	DefaultActionResultsAge = "336h" // 2 weeks
@@ -513,6 +560,12 @@ var defaultConfigValues = map[string]interface{}{
 	"logging-config":                "",
 	LoggingOutputKey:                "",
 	AutomaticallyRetryHooks:         true,
+	UnifiedAgentModeKey:             true,
+	AlertWebhookURLKey:              "",
+	MetricsRemoteWriteURLKey:        "",
+	RequireEncryptedVolumesKey:      false,
+	LXDProfileAutoReconcileKey:      false,
+	BaseSelectionPolicyKey:          BaseSelectionPinned,
 	"enable-os-refresh-update":      true,
 	"enable-os-upgrade":             true,
 	"development":                   false,
@@ -520,6 +573,7 @@ var defaultConfigValues = map[string]interface{}{
 	DisableTelemetryKey:             false,
 	TransmitVendorMetricsKey:        true,
 	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
+	HookDebounceWindow:              DefaultHookDebounceWindow,
 	EgressSubnets:                   "",
 	FanConfig:                       "",
 	CloudInitUserDataKey:            "",
@@ -719,6 +773,27 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[BaseSelectionPolicyKey].(string); ok && v != "" {
+		switch v {
+		case BaseSelectionPinned, BaseSelectionLatestLTS:
+		default:
+			return errors.Errorf("invalid base selection policy %q in model configuration", v)
+		}
+	}
+
+	if v, ok := cfg.defined[HookDebounceWindow].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid hook debounce window in model configuration")
+		}
+		if duration < 0 {
+			return errors.Errorf("hook debounce window %v cannot be negative", duration)
+		}
+		if duration > 5*time.Minute {
+			return errors.Errorf("hook debounce window %v cannot be greater than 5m", duration)
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.Split(v, ",")
 		for _, cidr := range cidrs {
@@ -1301,6 +1376,56 @@ func (c *Config) AutomaticallyRetryHooks() bool {
 	return val
 }
 
+// RequireEncryptedVolumes returns whether the model requires storage
+// volumes to be encrypted at rest. By default this is false.
+func (c *Config) RequireEncryptedVolumes() bool {
+	val, _ := c.defined[RequireEncryptedVolumesKey].(bool)
+	return val
+}
+
+// LXDProfileAutoReconcile returns whether drifted lxd profiles on
+// machines should be automatically reapplied. By default this is
+// false.
+func (c *Config) LXDProfileAutoReconcile() bool {
+	val, _ := c.defined[LXDProfileAutoReconcileKey].(bool)
+	return val
+}
+
+// BaseSelectionPolicy returns the policy used to select the base for
+// a charm when the user does not specify one.
+func (c *Config) BaseSelectionPolicy() string {
+	val, _ := c.defined[BaseSelectionPolicyKey].(string)
+	if val == "" {
+		return BaseSelectionPinned
+	}
+	return val
+}
+
+// AlertWebhookURL returns the URL that alert changes are delivered
+// to, or the empty string when no webhook sink is configured.
+func (c *Config) AlertWebhookURL() string {
+	val, _ := c.defined[AlertWebhookURLKey].(string)
+	return val
+}
+
+// MetricsRemoteWriteURL returns the URL that scraped charm metrics are
+// forwarded to, or the empty string when forwarding is not configured.
+func (c *Config) MetricsRemoteWriteURL() string {
+	val, _ := c.defined[MetricsRemoteWriteURLKey].(string)
+	return val
+}
+
+// UnifiedAgentMode returns whether machine agents in the model run
+// their units' agents inside the machine agent process. By default
+// this should be true.
+func (c *Config) UnifiedAgentMode() bool {
+	val, ok := c.defined[UnifiedAgentModeKey].(bool)
+	if !ok {
+		return true
+	}
+	return val
+}
+
 // TransmitVendorMetrics returns whether the controller sends charm-collected metrics
 // in this model for anonymized aggregate analytics. By default this should be true.
 func (c *Config) TransmitVendorMetrics() bool {
@@ -1570,6 +1695,19 @@ func (c *Config) UpdateStatusHookInterval() time.Duration {
 	return val
 }
 
+// HookDebounceWindow is the quiet period the uniter waits for after a
+// remote state change before dispatching hooks. Zero means hooks are
+// dispatched as soon as a change is seen.
+func (c *Config) HookDebounceWindow() time.Duration {
+	raw := c.asString(HookDebounceWindow)
+	if raw == "" {
+		raw = DefaultHookDebounceWindow
+	}
+	// Value has already been validated.
+	val, _ := time.ParseDuration(raw)
+	return val
+}
+
 // EgressSubnets are the source addresses from which traffic from this model
 // originates if the model is deployed such that NAT or similar is in use.
 func (c *Config) EgressSubnets() []string {
@@ -1739,6 +1877,12 @@ var alwaysOptional = schema.Defaults{
 	"disable-network-management":    schema.Omit,
 	IgnoreMachineAddresses:          schema.Omit,
 	AutomaticallyRetryHooks:         schema.Omit,
+	UnifiedAgentModeKey:             schema.Omit,
+	AlertWebhookURLKey:              schema.Omit,
+	MetricsRemoteWriteURLKey:        schema.Omit,
+	RequireEncryptedVolumesKey:      schema.Omit,
+	LXDProfileAutoReconcileKey:      schema.Omit,
+	BaseSelectionPolicyKey:          schema.Omit,
 	TestModeKey:                     schema.Omit,
 	DisableTelemetryKey:             schema.Omit,
 	ModeKey:                         schema.Omit,
@@ -1750,6 +1894,7 @@ var alwaysOptional = schema.Defaults{
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
+	HookDebounceWindow:              schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	FanConfig:                       schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
@@ -2224,6 +2369,36 @@ where possible. (default "")`,
 		Type:        environschema.Tbool,
 		Group:       environschema.EnvironGroup,
 	},
+	RequireEncryptedVolumesKey: {
+		Description: "Determines whether the storage provisioner refuses volumes that are not encrypted at rest",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	LXDProfileAutoReconcileKey: {
+		Description: "Determines whether drifted lxd profiles on machines are automatically reapplied",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	BaseSelectionPolicyKey: {
+		Description: `How the base for a charm is selected when not specified by the user ("pinned" or "latest-lts")`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	UnifiedAgentModeKey: {
+		Description: "Determines whether machine agents run their units' agents within the machine agent process",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	AlertWebhookURLKey: {
+		Description: "The URL to deliver model alert changes to; empty disables webhook delivery",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	MetricsRemoteWriteURLKey: {
+		Description: "The URL that unit agents forward scraped charm metrics to; empty disables forwarding",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	TransmitVendorMetricsKey: {
 		Description: "Determines whether metrics declared by charms deployed into this model are sent for anonymized aggregate analytics",
 		Type:        environschema.Tbool,
@@ -2264,6 +2439,11 @@ where possible. (default "")`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	HookDebounceWindow: {
+		Description: "How long the uniter waits for a quiet period after a remote state change before dispatching hooks, in human-readable time format (default 0s, disabled, max 5m)",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	EgressSubnets: {
 		Description: "Source address(es) for traffic originating from this model",
 		Type:        environschema.Tstring,
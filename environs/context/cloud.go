@@ -5,6 +5,7 @@ package context
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 )
@@ -58,3 +59,19 @@ type CloudCallContext struct {
 func (c *CloudCallContext) InvalidateCredential(reason string) error {
 	return c.InvalidateCredentialFunc(reason)
 }
+
+// WithOperationTimeout returns a copy of the given call context that
+// carries a deadline for a single substrate operation, preserving the
+// credential invalidation callback. The returned cancel func must be
+// called to release resources, as with context.WithTimeout. A
+// non-positive timeout leaves the context unchanged.
+func WithOperationTimeout(ctx ProviderCallContext, timeout time.Duration) (ProviderCallContext, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	inner, cancel := context.WithTimeout(ctx, timeout)
+	return &CloudCallContext{
+		Context:                  inner,
+		InvalidateCredentialFunc: ctx.InvalidateCredential,
+	}, cancel
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/juju/errors"
+)
+
+// CredentialInvalidReason is a machine-readable code describing why a cloud
+// credential was marked invalid.
+type CredentialInvalidReason string
+
+const (
+	// CredentialReasonAccessDenied indicates the cloud rejected the
+	// credential outright, e.g. an HTTP 401/403 from the substrate.
+	CredentialReasonAccessDenied CredentialInvalidReason = "access-denied"
+
+	// CredentialReasonExpired indicates the credential was once valid but
+	// has passed its validity period.
+	CredentialReasonExpired CredentialInvalidReason = "expired"
+
+	// CredentialReasonRevoked indicates the credential was withdrawn on
+	// the cloud side.
+	CredentialReasonRevoked CredentialInvalidReason = "revoked"
+
+	// CredentialReasonUnknown is used when a legacy, free-form reason was
+	// recorded and no structured code is available.
+	CredentialReasonUnknown CredentialInvalidReason = "unknown"
+)
+
+// CredentialInvalidation carries structured detail about a credential
+// invalidation: the reason code, the provider operation that surfaced the
+// failure, and the human-readable message from the cloud.
+type CredentialInvalidation struct {
+	// Reason is the machine-readable code for the invalidation.
+	Reason CredentialInvalidReason
+
+	// Operation names the provider operation that was being performed
+	// when the cloud rejected the credential, e.g. "listing instances".
+	Operation string
+
+	// Message is the human-readable detail, typically the cloud's own
+	// error text.
+	Message string
+}
+
+// String encodes the invalidation in the form understood by
+// ParseCredentialInvalidation. It is this encoding that is stored against
+// the cloud credential, so existing string-based plumbing carries the
+// structured detail unchanged.
+func (i CredentialInvalidation) String() string {
+	reason := i.Reason
+	if reason == "" {
+		reason = CredentialReasonUnknown
+	}
+	return fmt.Sprintf("%s(%s): %s", reason, i.Operation, i.Message)
+}
+
+var invalidationFormat = regexp.MustCompile(`^([a-z-]+)\(([^)]*)\): (.*)$`)
+
+// ParseCredentialInvalidation decodes a stored invalidity reason. Reasons
+// recorded before structured codes existed, or set free-form via the CLI,
+// come back with the unknown reason code and the original text as the
+// message.
+func ParseCredentialInvalidation(s string) CredentialInvalidation {
+	if parts := invalidationFormat.FindStringSubmatch(s); parts != nil {
+		return CredentialInvalidation{
+			Reason:    CredentialInvalidReason(parts[1]),
+			Operation: parts[2],
+			Message:   parts[3],
+		}
+	}
+	return CredentialInvalidation{
+		Reason:  CredentialReasonUnknown,
+		Message: s,
+	}
+}
+
+// InvalidateCredential invalidates the credential used by the given call
+// context, recording the structured detail against the credential.
+func InvalidateCredential(ctx ProviderCallContext, invalidation CredentialInvalidation) error {
+	return errors.Trace(ctx.InvalidateCredential(invalidation.String()))
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type InvalidationSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&InvalidationSuite{})
+
+func (s *InvalidationSuite) TestEncodeParseRoundTrip(c *gc.C) {
+	in := CredentialInvalidation{
+		Reason:    CredentialReasonAccessDenied,
+		Operation: "listing instances",
+		Message:   "cloud denied access: 403",
+	}
+	c.Assert(ParseCredentialInvalidation(in.String()), gc.DeepEquals, in)
+}
+
+func (s *InvalidationSuite) TestStringDefaultsToUnknownReason(c *gc.C) {
+	in := CredentialInvalidation{Message: "boom"}
+	c.Assert(in.String(), gc.Equals, "unknown(): boom")
+}
+
+func (s *InvalidationSuite) TestParseLegacyReason(c *gc.C) {
+	parsed := ParseCredentialInvalidation("credential disabled by an admin")
+	c.Assert(parsed, gc.DeepEquals, CredentialInvalidation{
+		Reason:  CredentialReasonUnknown,
+		Message: "credential disabled by an admin",
+	})
+}
+
+func (s *InvalidationSuite) TestInvalidateCredential(c *gc.C) {
+	ctx := NewEmptyCloudCallContext()
+	var recorded string
+	ctx.InvalidateCredentialFunc = func(reason string) error {
+		recorded = reason
+		return nil
+	}
+	err := InvalidateCredential(ctx, CredentialInvalidation{
+		Reason:    CredentialReasonExpired,
+		Operation: "refreshing token",
+		Message:   "secret expired",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(recorded, gc.Equals, "expired(refreshing token): secret expired")
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package environs
+
+import (
+	"time"
+
+	"github.com/juju/juju/environs/context"
+)
+
+// CredentialExpiryReporter defines the interface for environ providers to
+// implement when the validity period of their cloud credential can be
+// queried; e.g. the expiry of an Azure service principal secret.
+type CredentialExpiryReporter interface {
+	// CredentialExpiry returns the time at which the credential in use
+	// stops being accepted by the cloud, or the zero time when the
+	// credential does not expire.
+	CredentialExpiry(context.ProviderCallContext) (time.Time, error)
+}
+
+// CredentialExpiry returns the expiry time of the credential used by the
+// given environ, or the zero time when the environ cannot report one.
+func CredentialExpiry(env BootstrapEnviron, ctx context.ProviderCallContext) (time.Time, error) {
+	reporter, ok := env.(CredentialExpiryReporter)
+	if !ok {
+		return time.Time{}, nil
+	}
+	return reporter.CredentialExpiry(ctx)
+}
@@ -6,6 +6,7 @@ package environs
 import (
 	stdcontext "context"
 	"io"
+	"time"
 
 	"github.com/juju/jsonschema"
 	"github.com/juju/version/v2"
@@ -663,3 +664,12 @@ type CloudEndpointChecker interface {
 	// endpoint and returns nil if no problems.
 	ValidateCloudEndpoint(ctx context.ProviderCallContext) error
 }
+
+// ScopedCredentialMinter is implemented by environs that can mint
+// short-lived provider credentials restricted to a named scope, for
+// handing to trusted charms in place of the model's own credential.
+type ScopedCredentialMinter interface {
+	// MintScopedCredential returns a credential that is only valid
+	// for the given scope, along with the time at which it expires.
+	MintScopedCredential(ctx context.ProviderCallContext, scope string) (*cloud.Credential, time.Time, error)
+}
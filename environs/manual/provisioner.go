@@ -55,6 +55,17 @@ type ProvisionMachineArgs struct {
 	// machine.
 	PrivateKey string
 
+	// APIAddresses lists the controller API addresses ("host:port")
+	// that the target machine must be able to reach. If non-empty,
+	// connectivity is verified from the target host before the machine
+	// is registered.
+	APIAddresses []string
+
+	// RegisterAsController, if true, registers the machine with the
+	// manage-model job so that it can stand in for a lost controller
+	// machine or join the controller as an HA member.
+	RegisterAsController bool
+
 	// WinRM contains keys and client interface api with the remote windows machine
 	WinRM WinRMArgs
 
@@ -5,8 +5,10 @@
 package sshprovisioner
 
 import (
+	"github.com/juju/errors"
 	"github.com/juju/loggo"
 
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs/manual"
 	"github.com/juju/juju/rpc/params"
 )
@@ -46,6 +48,18 @@ func ProvisionMachine(args manual.ProvisionMachineArgs) (machineId string, err e
 		return "", err
 	}
 
+	// Check that the host can actually run an agent for this controller
+	// before it is registered, so that a host that fails validation
+	// never appears in the model.
+	if err = validateHost(args.Host, machineParams.HardwareCharacteristics,
+		machineParams.Series, args.APIAddresses); err != nil {
+		return "", errors.Annotatef(err, "host %q failed validation", args.Host)
+	}
+
+	if args.RegisterAsController {
+		machineParams.Jobs = append(machineParams.Jobs, model.JobManageModel)
+	}
+
 	// Inform Juju that the machine exists.
 	machineId, err = manual.RecordMachineInState(args.Client, *machineParams)
 	if err != nil {
@@ -0,0 +1,92 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshprovisioner
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3"
+	"github.com/juju/utils/v3/arch"
+	"github.com/juju/utils/v3/ssh"
+
+	"github.com/juju/juju/core/instance"
+	coreseries "github.com/juju/juju/core/series"
+)
+
+// ValidateHost checks that a target host with the detected hardware
+// characteristics and series can run a machine agent for this
+// controller: the architecture must be one juju publishes agent
+// binaries for, the series must map to a known base, and when
+// controller API addresses are supplied the host must be able to
+// reach at least one of them. Validation happens before the machine
+// is registered, so a host that fails never appears in the model.
+var ValidateHost = validateHost
+
+func validateHost(host string, hc instance.HardwareCharacteristics, series string, apiAddrs []string) error {
+	if hc.Arch == nil || !arch.IsSupportedArch(*hc.Arch) {
+		detected := "unknown"
+		if hc.Arch != nil {
+			detected = *hc.Arch
+		}
+		return errors.NotSupportedf("architecture %q of host %q", detected, host)
+	}
+	if _, err := coreseries.GetBaseFromSeries(series); err != nil {
+		return errors.NotSupportedf("series %q of host %q", series, host)
+	}
+	if len(apiAddrs) > 0 {
+		if err := checkControllerReachable(host, apiAddrs); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// CheckControllerReachable verifies, from the target host, that at
+// least one of the given controller API addresses accepts TCP
+// connections.
+var CheckControllerReachable = checkControllerReachable
+
+func checkControllerReachable(host string, apiAddrs []string) error {
+	logger.Infof("Checking that %s can reach the controller API", host)
+
+	script, err := reachabilityScript(apiAddrs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cmd := ssh.Command("ubuntu@"+host, []string{"/bin/bash"}, nil)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Stdin = strings.NewReader(script)
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() != 0 {
+			err = fmt.Errorf("%v (%v)", err, strings.TrimSpace(stderr.String()))
+		}
+		return errors.Annotatef(err, "host %q cannot reach the controller API", host)
+	}
+	return nil
+}
+
+// reachabilityScript returns a bash script that exits 0 as soon as one
+// of the given "host:port" addresses accepts a TCP connection, and
+// non-zero when none of them do.
+func reachabilityScript(apiAddrs []string) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("#!/bin/bash\n")
+	for _, addr := range apiAddrs {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", errors.Annotatef(err, "invalid API address %q", addr)
+		}
+		probe := fmt.Sprintf("echo > /dev/tcp/%s/%s", host, port)
+		fmt.Fprintf(&buf, "if timeout 5 bash -c %s 2>/dev/null; then exit 0; fi\n", utils.ShQuote(probe))
+	}
+	buf.WriteString("echo 'cannot connect to any controller API address' >&2\n")
+	buf.WriteString("exit 1\n")
+	return buf.String(), nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshprovisioner_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/manual/sshprovisioner"
+	"github.com/juju/juju/testing"
+)
+
+type validationSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&validationSuite{})
+
+func hardware(a string) instance.HardwareCharacteristics {
+	return instance.HardwareCharacteristics{Arch: &a}
+}
+
+func (s *validationSuite) TestValidateHostSupported(c *gc.C) {
+	// No API addresses supplied, so no connectivity probe is run.
+	err := sshprovisioner.ValidateHost("example.com", hardware("amd64"), "focal", nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *validationSuite) TestValidateHostUnsupportedArch(c *gc.C) {
+	err := sshprovisioner.ValidateHost("example.com", hardware("z80"), "focal", nil)
+	c.Assert(err, gc.ErrorMatches, `architecture "z80" of host "example.com" not supported`)
+}
+
+func (s *validationSuite) TestValidateHostMissingArch(c *gc.C) {
+	err := sshprovisioner.ValidateHost("example.com", instance.HardwareCharacteristics{}, "focal", nil)
+	c.Assert(err, gc.ErrorMatches, `architecture "unknown" of host "example.com" not supported`)
+}
+
+func (s *validationSuite) TestValidateHostUnknownSeries(c *gc.C) {
+	err := sshprovisioner.ValidateHost("example.com", hardware("amd64"), "whistler", nil)
+	c.Assert(err, gc.ErrorMatches, `series "whistler" of host "example.com" not supported`)
+}
+
+func (s *validationSuite) TestCheckControllerReachable(c *gc.C) {
+	addrs := []string{"10.0.0.1:17070", "10.0.0.2:17070"}
+	script, err := sshprovisioner.ReachabilityScript(addrs)
+	c.Assert(err, jc.ErrorIsNil)
+	defer installFakeSSH(c, script, nil, 0)()
+	err = sshprovisioner.CheckControllerReachable("example.com", addrs)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *validationSuite) TestCheckControllerUnreachable(c *gc.C) {
+	addrs := []string{"10.0.0.1:17070"}
+	script, err := sshprovisioner.ReachabilityScript(addrs)
+	c.Assert(err, jc.ErrorIsNil)
+	defer installFakeSSH(c, script, []string{"", "cannot connect to any controller API address"}, 1)()
+	err = sshprovisioner.CheckControllerReachable("example.com", addrs)
+	c.Assert(err, gc.ErrorMatches,
+		`host "example.com" cannot reach the controller API: subprocess encountered error code 1 \(cannot connect to any controller API address\)`)
+}
+
+func (s *validationSuite) TestReachabilityScriptBadAddress(c *gc.C) {
+	_, err := sshprovisioner.ReachabilityScript([]string{"no-port"})
+	c.Assert(err, gc.ErrorMatches, `invalid API address "no-port": .*`)
+}
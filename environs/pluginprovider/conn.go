@@ -0,0 +1,80 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pluginprovider
+
+import (
+	stdcontext "context"
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Conn abstracts the gRPC connection to a provider plugin, so that
+// tests can substitute an in-process fake.
+type Conn interface {
+	// Invoke performs a unary RPC against the plugin, unmarshalling
+	// the response into reply.
+	Invoke(ctx stdcontext.Context, method string, args, reply interface{}) error
+
+	// Close closes the connection.
+	Close() error
+}
+
+// Dial connects to the provider plugin listening on the given
+// endpoint. Plugins listen on the loopback interface or a local
+// socket, so the connection is not authenticated.
+func Dial(endpoint string) (Conn, error) {
+	conn, err := grpc.Dial(endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})),
+	)
+	if err != nil {
+		return nil, errors.Annotatef(err, "dialling provider plugin at %q", endpoint)
+	}
+	return grpcConn{conn}, nil
+}
+
+// grpcConn adapts a *grpc.ClientConn to the Conn interface.
+type grpcConn struct {
+	conn *grpc.ClientConn
+}
+
+// Invoke is part of the Conn interface.
+func (c grpcConn) Invoke(ctx stdcontext.Context, method string, args, reply interface{}) error {
+	return c.conn.Invoke(ctx, method, args, reply)
+}
+
+// Close is part of the Conn interface.
+func (c grpcConn) Close() error {
+	return c.conn.Close()
+}
+
+// jsonCodec is a gRPC codec that encodes messages as JSON, so that
+// plugins do not need to share compiled protobuf definitions with
+// the juju tree.
+type jsonCodec struct{}
+
+// Marshal is part of the grpc encoding.Codec interface.
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal is part of the grpc encoding.Codec interface.
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// Name is part of the grpc encoding.Codec interface.
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+// call invokes a plugin method with the default timeout applied.
+func call(ctx stdcontext.Context, conn Conn, method string, args, reply interface{}) error {
+	ctx, cancel := stdcontext.WithTimeout(ctx, defaultCallTimeout)
+	defer cancel()
+	return errors.Trace(conn.Invoke(ctx, method, args, reply))
+}
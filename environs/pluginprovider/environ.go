@@ -0,0 +1,346 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pluginprovider
+
+import (
+	stdcontext "context"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3"
+	"github.com/juju/version/v2"
+
+	"github.com/juju/juju/cloudconfig/cloudinit"
+	"github.com/juju/juju/cloudconfig/providerinit"
+	"github.com/juju/juju/cloudconfig/providerinit/renderers"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	corenetwork "github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/os"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/storage"
+)
+
+// environ delegates the instance lifecycle of a hosted model to a
+// provider plugin.
+type environ struct {
+	provider *environProvider
+	name     string
+
+	mu  sync.Mutex
+	cfg *config.Config
+}
+
+var _ environs.Environ = (*environ)(nil)
+
+// Provider is part of the environs.Environ interface.
+func (e *environ) Provider() environs.EnvironProvider {
+	return e.provider
+}
+
+// Config is part of the environs.ConfigGetter interface.
+func (e *environ) Config() *config.Config {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.cfg
+}
+
+// SetConfig is part of the environs.ConfigSetter interface.
+func (e *environ) SetConfig(cfg *config.Config) error {
+	req := setConfigRequest{Config: cfg.AllAttrs()}
+	if err := call(stdcontext.Background(), e.provider.conn, methodSetConfig, &req, &emptyMessage{}); err != nil {
+		return errors.Trace(err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg = cfg
+	return nil
+}
+
+// PrepareForBootstrap is part of the environs.Bootstrapper interface.
+func (e *environ) PrepareForBootstrap(ctx environs.BootstrapContext, controllerName string) error {
+	return errors.NotSupportedf("bootstrapping with a plugin provider")
+}
+
+// Bootstrap is part of the environs.Bootstrapper interface. Plugin
+// clouds host models on an existing controller; see the package
+// documentation.
+func (e *environ) Bootstrap(
+	ctx environs.BootstrapContext, callCtx context.ProviderCallContext, args environs.BootstrapParams,
+) (*environs.BootstrapResult, error) {
+	return nil, errors.NotSupportedf("bootstrapping with a plugin provider")
+}
+
+// Create is part of the environs.BootstrapEnviron interface.
+func (e *environ) Create(ctx context.ProviderCallContext, args environs.CreateParams) error {
+	return errors.Trace(call(ctx, e.provider.conn, methodCreate,
+		&createRequest{ControllerUUID: args.ControllerUUID}, &emptyMessage{}))
+}
+
+// Destroy is part of the environs.CloudDestroyer interface.
+func (e *environ) Destroy(ctx context.ProviderCallContext) error {
+	return errors.Trace(call(ctx, e.provider.conn, methodDestroy,
+		&emptyMessage{}, &emptyMessage{}))
+}
+
+// DestroyController is part of the environs.ControllerDestroyer
+// interface.
+func (e *environ) DestroyController(ctx context.ProviderCallContext, controllerUUID string) error {
+	return errors.Trace(call(ctx, e.provider.conn, methodDestroyController,
+		&destroyControllerRequest{ControllerUUID: controllerUUID}, &emptyMessage{}))
+}
+
+// AdoptResources is part of the environs.ResourceAdopter interface.
+func (e *environ) AdoptResources(ctx context.ProviderCallContext, controllerUUID string, fromVersion version.Number) error {
+	return errors.Trace(call(ctx, e.provider.conn, methodAdoptResources, &adoptResourcesRequest{
+		ControllerUUID: controllerUUID,
+		FromVersion:    fromVersion.String(),
+	}, &emptyMessage{}))
+}
+
+// ConstraintsValidator is part of the environs.ConstraintsChecker
+// interface. The shim cannot know which constraints a particular
+// plugin honours, so no vocabulary is registered; unsupported
+// constraints surface as errors from the plugin at StartInstance
+// time.
+func (e *environ) ConstraintsValidator(ctx context.ProviderCallContext) (constraints.Validator, error) {
+	return constraints.NewValidator(), nil
+}
+
+// PrecheckInstance is part of the environs.InstancePrechecker
+// interface.
+func (e *environ) PrecheckInstance(ctx context.ProviderCallContext, args environs.PrecheckInstanceParams) error {
+	return nil
+}
+
+// StartInstance is part of the environs.InstanceBroker interface.
+func (e *environ) StartInstance(
+	ctx context.ProviderCallContext, args environs.StartInstanceParams,
+) (*environs.StartInstanceResult, error) {
+	userData, err := providerinit.ComposeUserData(args.InstanceConfig, nil, pluginRenderer{})
+	if err != nil {
+		return nil, errors.Annotate(err, "composing user data")
+	}
+	req := startInstanceRequest{
+		Series:           args.InstanceConfig.Series,
+		Constraints:      args.Constraints,
+		Placement:        args.Placement,
+		AvailabilityZone: args.AvailabilityZone,
+		UserData:         userData,
+		Tags:             args.InstanceConfig.Tags,
+	}
+	var resp startInstanceResponse
+	if err := call(ctx, e.provider.conn, methodStartInstance, &req, &resp); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resp.Instance.Id == "" {
+		return nil, errors.Errorf("plugin returned an instance with no id")
+	}
+	return &environs.StartInstanceResult{
+		DisplayName: resp.DisplayName,
+		Instance:    newPluginInstance(resp.Instance),
+		Hardware:    resp.Hardware,
+	}, nil
+}
+
+// StopInstances is part of the environs.InstanceBroker interface.
+func (e *environ) StopInstances(ctx context.ProviderCallContext, ids ...instance.Id) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return errors.Trace(call(ctx, e.provider.conn, methodStopInstances,
+		&stopInstancesRequest{Ids: instanceIdStrings(ids)}, &emptyMessage{}))
+}
+
+// AllInstances is part of the environs.InstanceBroker interface.
+func (e *environ) AllInstances(ctx context.ProviderCallContext) ([]instances.Instance, error) {
+	return e.listInstances(ctx, methodAllInstances)
+}
+
+// AllRunningInstances is part of the environs.InstanceBroker
+// interface.
+func (e *environ) AllRunningInstances(ctx context.ProviderCallContext) ([]instances.Instance, error) {
+	return e.listInstances(ctx, methodAllRunningInstances)
+}
+
+func (e *environ) listInstances(ctx context.ProviderCallContext, method string) ([]instances.Instance, error) {
+	var resp instancesResponse
+	if err := call(ctx, e.provider.conn, method, &emptyMessage{}, &resp); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []instances.Instance
+	for _, wire := range resp.Instances {
+		if wire == nil {
+			continue
+		}
+		result = append(result, newPluginInstance(*wire))
+	}
+	return result, nil
+}
+
+// Instances is part of the environs.InstanceLister interface.
+func (e *environ) Instances(ctx context.ProviderCallContext, ids []instance.Id) ([]instances.Instance, error) {
+	if len(ids) == 0 {
+		return nil, environs.ErrNoInstances
+	}
+	var resp instancesResponse
+	err := call(ctx, e.provider.conn, methodInstances,
+		&instancesRequest{Ids: instanceIdStrings(ids)}, &resp)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(resp.Instances) != len(ids) {
+		return nil, errors.Errorf(
+			"plugin returned %d instances, expected %d", len(resp.Instances), len(ids))
+	}
+	result := make([]instances.Instance, len(ids))
+	var found int
+	for i, wire := range resp.Instances {
+		if wire == nil {
+			continue
+		}
+		result[i] = newPluginInstance(*wire)
+		found++
+	}
+	if found == 0 {
+		return nil, environs.ErrNoInstances
+	}
+	if found < len(ids) {
+		return result, environs.ErrPartialInstances
+	}
+	return result, nil
+}
+
+// ControllerInstances is part of the environs.Environ interface.
+func (e *environ) ControllerInstances(ctx context.ProviderCallContext, controllerUUID string) ([]instance.Id, error) {
+	var resp controllerInstancesResponse
+	err := call(ctx, e.provider.conn, methodControllerInstances,
+		&controllerInstancesRequest{ControllerUUID: controllerUUID}, &resp)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(resp.Ids) == 0 {
+		return nil, environs.ErrNoInstances
+	}
+	ids := make([]instance.Id, len(resp.Ids))
+	for i, id := range resp.Ids {
+		ids[i] = instance.Id(id)
+	}
+	return ids, nil
+}
+
+// InstanceTypes is part of the environs.InstanceTypesFetcher
+// interface.
+func (e *environ) InstanceTypes(
+	ctx context.ProviderCallContext, cons constraints.Value,
+) (instances.InstanceTypesWithCostMetadata, error) {
+	var resp instanceTypesResponse
+	err := call(ctx, e.provider.conn, methodInstanceTypes,
+		&instanceTypesRequest{Constraints: cons}, &resp)
+	if err != nil {
+		return instances.InstanceTypesWithCostMetadata{}, errors.Trace(err)
+	}
+	result := instances.InstanceTypesWithCostMetadata{
+		CostUnit:     resp.CostUnit,
+		CostCurrency: resp.CostCurrency,
+		CostDivisor:  resp.CostDivisor,
+	}
+	for _, wire := range resp.InstanceTypes {
+		result.InstanceTypes = append(result.InstanceTypes, instances.InstanceType{
+			Id:       wire.Id,
+			Name:     wire.Name,
+			Arch:     wire.Arch,
+			CpuCores: wire.CpuCores,
+			Mem:      wire.MemMB,
+			RootDisk: wire.RootDisk,
+			Cost:     wire.Cost,
+		})
+	}
+	return result, nil
+}
+
+// StorageProviderTypes is part of the storage.ProviderRegistry
+// interface.
+func (e *environ) StorageProviderTypes() ([]storage.ProviderType, error) {
+	var resp storageProviderTypesResponse
+	err := call(stdcontext.Background(), e.provider.conn, methodStorageProviderTypes,
+		&emptyMessage{}, &resp)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	types := make([]storage.ProviderType, len(resp.Types))
+	for i, t := range resp.Types {
+		types[i] = storage.ProviderType(t)
+	}
+	return types, nil
+}
+
+// StorageProvider is part of the storage.ProviderRegistry interface.
+// Storage operations are not yet delegated to plugins; only the
+// enumeration of supported types is.
+func (e *environ) StorageProvider(t storage.ProviderType) (storage.Provider, error) {
+	return nil, errors.NotFoundf("storage provider %q", t)
+}
+
+func instanceIdStrings(ids []instance.Id) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strs
+}
+
+// pluginInstance is the shim's view of an instance reported by the
+// plugin. It is a snapshot: status and addresses are those reported
+// by the call that produced it.
+type pluginInstance struct {
+	id        instance.Id
+	status    instance.Status
+	addresses corenetwork.ProviderAddresses
+}
+
+func newPluginInstance(wire wireInstance) *pluginInstance {
+	return &pluginInstance{
+		id: instance.Id(wire.Id),
+		status: instance.Status{
+			Status:  status.Status(wire.Status),
+			Message: wire.StatusMessage,
+		},
+		addresses: corenetwork.NewMachineAddresses(wire.Addresses).AsProviderAddresses(),
+	}
+}
+
+// Id is part of the instances.Instance interface.
+func (i *pluginInstance) Id() instance.Id {
+	return i.id
+}
+
+// Status is part of the instances.Instance interface.
+func (i *pluginInstance) Status(ctx context.ProviderCallContext) instance.Status {
+	return i.status
+}
+
+// Addresses is part of the instances.Instance interface.
+func (i *pluginInstance) Addresses(ctx context.ProviderCallContext) (corenetwork.ProviderAddresses, error) {
+	return i.addresses, nil
+}
+
+// pluginRenderer renders user data for instances started by a
+// plugin. Plugins receive the rendered cloud-init document gzipped
+// and pass it to the instance verbatim.
+type pluginRenderer struct{}
+
+// Render is part of the renderers.ProviderRenderer interface.
+func (pluginRenderer) Render(cfg cloudinit.CloudConfig, osType os.OSType) ([]byte, error) {
+	switch osType {
+	case os.Ubuntu, os.CentOS:
+		return renderers.RenderYAML(cfg, utils.Gzip)
+	default:
+		return nil, errors.NotSupportedf("rendering user data for OS %q", osType)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pluginprovider_test
+
+import (
+	stdcontext "context"
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/environs/pluginprovider"
+	"github.com/juju/juju/storage"
+	coretesting "github.com/juju/juju/testing"
+)
+
+// fakeConn is an in-process stand-in for the gRPC connection to a
+// plugin. Responses are canned per method and copied into the reply
+// via a JSON round trip, mirroring the wire codec.
+type fakeConn struct {
+	testing.Stub
+
+	responses map[string]interface{}
+}
+
+func (c *fakeConn) Invoke(ctx stdcontext.Context, method string, args, reply interface{}) error {
+	c.MethodCall(c, "Invoke", method, args)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	resp, ok := c.responses[method]
+	if !ok {
+		return nil
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, reply)
+}
+
+func (c *fakeConn) Close() error {
+	c.MethodCall(c, "Close")
+	return c.NextErr()
+}
+
+type PluginProviderSuite struct {
+	testing.IsolationSuite
+
+	conn     fakeConn
+	provider environs.CloudEnvironProvider
+}
+
+var _ = gc.Suite(&PluginProviderSuite{})
+
+func (s *PluginProviderSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.conn = fakeConn{responses: make(map[string]interface{})}
+	s.provider = pluginprovider.NewProvider("testplugin", &s.conn)
+}
+
+func (s *PluginProviderSuite) modelConfig(c *gc.C) *config.Config {
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, jc.ErrorIsNil)
+	return cfg
+}
+
+func (s *PluginProviderSuite) openEnviron(c *gc.C) environs.Environ {
+	env, err := s.provider.Open(stdcontext.Background(), environs.OpenParams{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		Config:         s.modelConfig(c),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.conn.ResetCalls()
+	return env
+}
+
+func (s *PluginProviderSuite) TestValidateRoundTrips(c *gc.C) {
+	cfg := s.modelConfig(c)
+	s.conn.responses["/jujuplugin.Provider/ValidateConfig"] = map[string]interface{}{
+		"config": cfg.AllAttrs(),
+	}
+	valid, err := s.provider.Validate(cfg, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(valid.Name(), gc.Equals, cfg.Name())
+	s.conn.CheckCallNames(c, "Invoke")
+	c.Assert(s.conn.Calls()[0].Args[0], gc.Equals, "/jujuplugin.Provider/ValidateConfig")
+}
+
+func (s *PluginProviderSuite) TestOpen(c *gc.C) {
+	env := s.openEnviron(c)
+	c.Assert(env.Config().Name(), gc.Equals, "testmodel")
+}
+
+func (s *PluginProviderSuite) TestBootstrapNotSupported(c *gc.C) {
+	env := s.openEnviron(c)
+	err := env.PrepareForBootstrap(nil, "kontroll")
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *PluginProviderSuite) TestInstances(c *gc.C) {
+	env := s.openEnviron(c)
+	s.conn.responses["/jujuplugin.Provider/Instances"] = map[string]interface{}{
+		"instances": []interface{}{
+			map[string]interface{}{
+				"id":        "plugin-0",
+				"status":    "running",
+				"addresses": []string{"10.0.0.1"},
+			},
+			nil,
+		},
+	}
+	result, err := env.Instances(context.NewEmptyCloudCallContext(),
+		[]instance.Id{"plugin-0", "plugin-1"})
+	c.Assert(err, gc.Equals, environs.ErrPartialInstances)
+	c.Assert(result, gc.HasLen, 2)
+	c.Assert(result[0].Id(), gc.Equals, instance.Id("plugin-0"))
+	c.Assert(result[1], gc.IsNil)
+	addresses, err := result[0].Addresses(context.NewEmptyCloudCallContext())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(addresses, gc.HasLen, 1)
+	c.Assert(addresses[0].Value, gc.Equals, "10.0.0.1")
+}
+
+func (s *PluginProviderSuite) TestInstancesNoneFound(c *gc.C) {
+	env := s.openEnviron(c)
+	s.conn.responses["/jujuplugin.Provider/Instances"] = map[string]interface{}{
+		"instances": []interface{}{nil},
+	}
+	_, err := env.Instances(context.NewEmptyCloudCallContext(), []instance.Id{"plugin-0"})
+	c.Assert(err, gc.Equals, environs.ErrNoInstances)
+}
+
+func (s *PluginProviderSuite) TestControllerInstances(c *gc.C) {
+	env := s.openEnviron(c)
+	s.conn.responses["/jujuplugin.Provider/ControllerInstances"] = map[string]interface{}{
+		"ids": []string{"plugin-0"},
+	}
+	ids, err := env.ControllerInstances(context.NewEmptyCloudCallContext(),
+		coretesting.ControllerTag.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ids, jc.DeepEquals, []instance.Id{"plugin-0"})
+}
+
+func (s *PluginProviderSuite) TestControllerInstancesNotBootstrapped(c *gc.C) {
+	env := s.openEnviron(c)
+	_, err := env.ControllerInstances(context.NewEmptyCloudCallContext(),
+		coretesting.ControllerTag.Id())
+	c.Assert(err, gc.Equals, environs.ErrNoInstances)
+}
+
+func (s *PluginProviderSuite) TestStopInstances(c *gc.C) {
+	env := s.openEnviron(c)
+	err := env.StopInstances(context.NewEmptyCloudCallContext(), "plugin-0", "plugin-1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.conn.CheckCallNames(c, "Invoke")
+	c.Assert(s.conn.Calls()[0].Args[0], gc.Equals, "/jujuplugin.Provider/StopInstances")
+}
+
+func (s *PluginProviderSuite) TestStorageProviderTypes(c *gc.C) {
+	env := s.openEnviron(c)
+	s.conn.responses["/jujuplugin.Provider/StorageProviderTypes"] = map[string]interface{}{
+		"types": []string{"plugin-block"},
+	}
+	types, err := env.StorageProviderTypes()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(types, jc.DeepEquals, []storage.ProviderType{"plugin-block"})
+	_, err = env.StorageProvider("plugin-block")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *PluginProviderSuite) TestRegister(c *gc.C) {
+	unregister := environs.RegisterProvider("testplugin", s.provider)
+	defer unregister()
+	p, err := environs.Provider("testplugin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, gc.Equals, s.provider)
+}
+
+func (s *PluginProviderSuite) TestRegisterFromControllerConfig(c *gc.C) {
+	cfg := controller.Config{
+		controller.ProviderPlugins: []interface{}{"niftycloud=localhost:7777"},
+	}
+	unregister := pluginprovider.RegisterFromControllerConfig(cfg)
+	defer unregister()
+
+	p, err := environs.Provider("niftycloud")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, gc.NotNil)
+
+	unregister()
+	_, err = environs.Provider("niftycloud")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *PluginProviderSuite) TestRegisterFromControllerConfigSkipsRegistered(c *gc.C) {
+	unregister := environs.RegisterProvider("testplugin", s.provider)
+	defer unregister()
+
+	cfg := controller.Config{
+		controller.ProviderPlugins: []interface{}{"testplugin=localhost:7777"},
+	}
+	cleanup := pluginprovider.RegisterFromControllerConfig(cfg)
+	defer cleanup()
+
+	// The in-tree registration is untouched.
+	p, err := environs.Provider("testplugin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p, gc.Equals, s.provider)
+}
@@ -0,0 +1,259 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package pluginprovider implements a shim that exposes an
+// out-of-tree provider plugin as a regular member of the environs
+// provider registry. The plugin is a standalone gRPC server
+// implementing the jujuplugin.Provider service described by the
+// method names and message types in this file; all messages are
+// encoded as JSON, so plugins can be written in any language with a
+// gRPC implementation and without sharing compiled protobuf
+// definitions with the juju tree.
+//
+// The protocol covers four surfaces:
+//
+//   - environ: config validation, opening, ping, model create and
+//     destroy, and resource adoption;
+//   - instances: the full instance lifecycle used by the
+//     provisioner, plus instance type enumeration;
+//   - networking: space and subnet discovery (the message types are
+//     defined here so the wire protocol is stable, but the shim does
+//     not yet implement environs.Networking; coverage will arrive
+//     incrementally);
+//   - storage: enumeration of the storage provider types the plugin
+//     supports.
+//
+// Bootstrap is deliberately not part of the protocol: plugin-backed
+// clouds host models on an existing controller. Bootstrapping a
+// controller into a plugin cloud would require the plugin to be
+// present on the controller machine before the controller exists.
+package pluginprovider
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+)
+
+// Method names for the jujuplugin.Provider gRPC service.
+const (
+	// Environ surface.
+	methodValidateConfig    = "/jujuplugin.Provider/ValidateConfig"
+	methodPing              = "/jujuplugin.Provider/Ping"
+	methodOpen              = "/jujuplugin.Provider/Open"
+	methodSetConfig         = "/jujuplugin.Provider/SetConfig"
+	methodCreate            = "/jujuplugin.Provider/Create"
+	methodDestroy           = "/jujuplugin.Provider/Destroy"
+	methodDestroyController = "/jujuplugin.Provider/DestroyController"
+	methodAdoptResources    = "/jujuplugin.Provider/AdoptResources"
+
+	// Instances surface.
+	methodStartInstance       = "/jujuplugin.Provider/StartInstance"
+	methodStopInstances       = "/jujuplugin.Provider/StopInstances"
+	methodAllInstances        = "/jujuplugin.Provider/AllInstances"
+	methodAllRunningInstances = "/jujuplugin.Provider/AllRunningInstances"
+	methodInstances           = "/jujuplugin.Provider/Instances"
+	methodControllerInstances = "/jujuplugin.Provider/ControllerInstances"
+	methodInstanceTypes       = "/jujuplugin.Provider/InstanceTypes"
+
+	// Networking surface. Reserved: the shim does not call these
+	// yet, but plugins may already implement them.
+	methodSupportsSpaces    = "/jujuplugin.Provider/SupportsSpaces"
+	methodSubnets           = "/jujuplugin.Provider/Subnets"
+	methodNetworkInterfaces = "/jujuplugin.Provider/NetworkInterfaces"
+
+	// Storage surface.
+	methodStorageProviderTypes = "/jujuplugin.Provider/StorageProviderTypes"
+)
+
+// emptyMessage is used for requests and responses that carry no
+// payload.
+type emptyMessage struct{}
+
+// validateConfigRequest asks the plugin to validate a model
+// configuration, optionally against a previous configuration.
+type validateConfigRequest struct {
+	Config map[string]interface{} `json:"config"`
+	Old    map[string]interface{} `json:"old,omitempty"`
+}
+
+// validateConfigResponse returns the validated configuration, which
+// may include provider-applied defaults.
+type validateConfigResponse struct {
+	Config map[string]interface{} `json:"config"`
+}
+
+// pingRequest asks the plugin to verify that the given cloud
+// endpoint is reachable and valid.
+type pingRequest struct {
+	Endpoint string `json:"endpoint"`
+}
+
+// openRequest tells the plugin that juju is opening an environ for
+// the model described by the config, connecting to the cloud
+// described by the spec.
+type openRequest struct {
+	ControllerUUID string                 `json:"controller-uuid"`
+	Cloud          wireCloudSpec          `json:"cloud"`
+	Config         map[string]interface{} `json:"config"`
+}
+
+// wireCloudSpec carries the subset of a cloud spec a plugin needs to
+// establish its own connection to the cloud.
+type wireCloudSpec struct {
+	Name              string            `json:"name"`
+	Type              string            `json:"type"`
+	Region            string            `json:"region,omitempty"`
+	Endpoint          string            `json:"endpoint,omitempty"`
+	AuthType          string            `json:"auth-type,omitempty"`
+	CredentialAttrs   map[string]string `json:"credential-attrs,omitempty"`
+	CACertificates    []string          `json:"ca-certificates,omitempty"`
+	SkipTLSVerify     bool              `json:"skip-tls-verify,omitempty"`
+	IsControllerCloud bool              `json:"is-controller-cloud,omitempty"`
+}
+
+// setConfigRequest carries an updated model configuration.
+type setConfigRequest struct {
+	Config map[string]interface{} `json:"config"`
+}
+
+// createRequest tells the plugin to prepare cloud resources for a
+// new hosted model.
+type createRequest struct {
+	ControllerUUID string `json:"controller-uuid"`
+}
+
+// destroyControllerRequest tells the plugin to destroy the model's
+// resources along with those of any hosted models belonging to the
+// same controller.
+type destroyControllerRequest struct {
+	ControllerUUID string `json:"controller-uuid"`
+}
+
+// adoptResourcesRequest tells the plugin to re-tag the model's cloud
+// resources with a new controller UUID after model migration.
+type adoptResourcesRequest struct {
+	ControllerUUID string `json:"controller-uuid"`
+	FromVersion    string `json:"from-version"`
+}
+
+// startInstanceRequest asks the plugin to start an instance. The
+// user data is the fully composed cloud-init payload, gzipped and
+// base64 encoded; the plugin passes it to the instance verbatim.
+type startInstanceRequest struct {
+	Series           string            `json:"series"`
+	Constraints      constraints.Value `json:"constraints"`
+	Placement        string            `json:"placement,omitempty"`
+	AvailabilityZone string            `json:"availability-zone,omitempty"`
+	UserData         []byte            `json:"user-data,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty"`
+}
+
+// startInstanceResponse describes the started instance.
+type startInstanceResponse struct {
+	Instance    wireInstance                      `json:"instance"`
+	Hardware    *instance.HardwareCharacteristics `json:"hardware,omitempty"`
+	DisplayName string                            `json:"display-name,omitempty"`
+}
+
+// wireInstance describes a single cloud instance.
+type wireInstance struct {
+	Id            string   `json:"id"`
+	Status        string   `json:"status"`
+	StatusMessage string   `json:"status-message,omitempty"`
+	Addresses     []string `json:"addresses,omitempty"`
+}
+
+// stopInstancesRequest names the instances to shut down. Unknown ids
+// must be ignored by the plugin.
+type stopInstancesRequest struct {
+	Ids []string `json:"ids"`
+}
+
+// instancesRequest names the instances to look up.
+type instancesRequest struct {
+	Ids []string `json:"ids"`
+}
+
+// instancesResponse lists instances. For Instances calls the slice
+// is parallel to the request ids, with nil entries for instances
+// that were not found.
+type instancesResponse struct {
+	Instances []*wireInstance `json:"instances"`
+}
+
+// controllerInstancesRequest asks for the instances hosting the
+// controller with the given UUID.
+type controllerInstancesRequest struct {
+	ControllerUUID string `json:"controller-uuid"`
+}
+
+// controllerInstancesResponse lists controller instance ids.
+type controllerInstancesResponse struct {
+	Ids []string `json:"ids"`
+}
+
+// instanceTypesRequest asks for the instance types matching the
+// given constraints.
+type instanceTypesRequest struct {
+	Constraints constraints.Value `json:"constraints"`
+}
+
+// instanceTypesResponse lists the matching instance types.
+type instanceTypesResponse struct {
+	InstanceTypes []wireInstanceType `json:"instance-types"`
+	CostUnit      string             `json:"cost-unit,omitempty"`
+	CostCurrency  string             `json:"cost-currency,omitempty"`
+	CostDivisor   uint64             `json:"cost-divisor,omitempty"`
+}
+
+// wireInstanceType describes a single instance type.
+type wireInstanceType struct {
+	Id       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Arch     string `json:"arch"`
+	CpuCores uint64 `json:"cpu-cores"`
+	MemMB    uint64 `json:"mem-mb"`
+	RootDisk uint64 `json:"root-disk,omitempty"`
+	Cost     uint64 `json:"cost,omitempty"`
+}
+
+// supportsSpacesResponse reports whether the plugin's cloud supports
+// network spaces. Reserved for the networking surface.
+type supportsSpacesResponse struct {
+	Supported bool `json:"supported"`
+}
+
+// subnetsRequest asks for the subnets attached to an instance, or
+// all subnets if no instance id is given. Reserved for the
+// networking surface.
+type subnetsRequest struct {
+	InstanceId string   `json:"instance-id,omitempty"`
+	SubnetIds  []string `json:"subnet-ids,omitempty"`
+}
+
+// wireSubnet describes a single subnet. Reserved for the networking
+// surface.
+type wireSubnet struct {
+	CIDR              string   `json:"cidr"`
+	ProviderId        string   `json:"provider-id"`
+	VLANTag           int      `json:"vlan-tag,omitempty"`
+	AvailabilityZones []string `json:"availability-zones,omitempty"`
+}
+
+// subnetsResponse lists subnets. Reserved for the networking
+// surface.
+type subnetsResponse struct {
+	Subnets []wireSubnet `json:"subnets"`
+}
+
+// storageProviderTypesResponse lists the storage provider types the
+// plugin supports.
+type storageProviderTypesResponse struct {
+	Types []string `json:"types,omitempty"`
+}
+
+// defaultCallTimeout bounds each plugin call; a hung plugin must not
+// wedge the provisioner indefinitely.
+const defaultCallTimeout = 5 * time.Minute
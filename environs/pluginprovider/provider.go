@@ -0,0 +1,173 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pluginprovider
+
+import (
+	stdcontext "context"
+
+	"github.com/juju/errors"
+	"github.com/juju/jsonschema"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/context"
+)
+
+var logger = loggo.GetLogger("juju.environs.pluginprovider")
+
+// environProvider exposes a provider plugin as an
+// environs.CloudEnvironProvider.
+type environProvider struct {
+	name string
+	conn Conn
+}
+
+// NewProvider returns a CloudEnvironProvider that delegates to the
+// plugin reachable over conn. The name is the provider type the
+// shim is registered under.
+func NewProvider(name string, conn Conn) environs.CloudEnvironProvider {
+	return &environProvider{name: name, conn: conn}
+}
+
+// RegisterFromControllerConfig dials and registers every provider
+// plugin listed in the controller configuration. A plugin whose name
+// collides with an already registered provider is skipped, as is one
+// that cannot be dialled; a niche cloud being unreachable must not
+// stop a controller agent from starting. The returned function
+// unregisters everything that was registered.
+func RegisterFromControllerConfig(cfg controller.Config) func() {
+	var cleanups []func()
+	for name, endpoint := range cfg.ProviderPluginEndpoints() {
+		if _, err := environs.Provider(name); err == nil {
+			logger.Warningf("not registering provider plugin %q: the provider type is already registered", name)
+			continue
+		}
+		cleanup, err := Register(name, endpoint)
+		if err != nil {
+			logger.Errorf("cannot register provider plugin %q at %q: %v", name, endpoint, err)
+			continue
+		}
+		cleanups = append(cleanups, cleanup)
+	}
+	return func() {
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
+
+// Register dials the plugin listening on the given endpoint and
+// registers it in the global provider registry under the given
+// name. The returned function unregisters the provider and closes
+// the connection.
+func Register(name, endpoint string) (func(), error) {
+	conn, err := Dial(endpoint)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	unregister := environs.RegisterProvider(name, NewProvider(name, conn))
+	logger.Infof("registered provider plugin %q at %q", name, endpoint)
+	return func() {
+		unregister()
+		_ = conn.Close()
+	}, nil
+}
+
+// Version is part of the EnvironProvider interface.
+func (p *environProvider) Version() int {
+	return 0
+}
+
+// CloudSchema is part of the EnvironProvider interface.
+func (p *environProvider) CloudSchema() *jsonschema.Schema {
+	return nil
+}
+
+// Ping is part of the EnvironProvider interface.
+func (p *environProvider) Ping(ctx context.ProviderCallContext, endpoint string) error {
+	return errors.Trace(call(ctx, p.conn, methodPing,
+		&pingRequest{Endpoint: endpoint}, &emptyMessage{}))
+}
+
+// PrepareConfig is part of the EnvironProvider interface.
+func (p *environProvider) PrepareConfig(args environs.PrepareConfigParams) (*config.Config, error) {
+	return p.Validate(args.Config, nil)
+}
+
+// Validate is part of the config.Validator interface.
+func (p *environProvider) Validate(cfg, old *config.Config) (*config.Config, error) {
+	req := validateConfigRequest{Config: cfg.AllAttrs()}
+	if old != nil {
+		req.Old = old.AllAttrs()
+	}
+	var resp validateConfigResponse
+	if err := call(stdcontext.Background(), p.conn, methodValidateConfig, &req, &resp); err != nil {
+		return nil, errors.Annotate(err, "plugin config validation")
+	}
+	valid, err := config.New(config.NoDefaults, resp.Config)
+	if err != nil {
+		return nil, errors.Annotate(err, "plugin returned invalid config")
+	}
+	return valid, nil
+}
+
+// Open is part of the CloudEnvironProvider interface.
+func (p *environProvider) Open(ctx stdcontext.Context, args environs.OpenParams) (environs.Environ, error) {
+	req := openRequest{
+		ControllerUUID: args.ControllerUUID,
+		Cloud:          makeWireCloudSpec(args.Cloud),
+		Config:         args.Config.AllAttrs(),
+	}
+	if err := call(ctx, p.conn, methodOpen, &req, &emptyMessage{}); err != nil {
+		return nil, errors.Annotatef(err, "opening plugin environ %q", args.Config.Name())
+	}
+	return &environ{
+		provider: p,
+		name:     args.Config.Name(),
+		cfg:      args.Config,
+	}, nil
+}
+
+// CredentialSchemas is part of the ProviderCredentials interface.
+func (p *environProvider) CredentialSchemas() map[cloud.AuthType]cloud.CredentialSchema {
+	return map[cloud.AuthType]cloud.CredentialSchema{
+		cloud.EmptyAuthType: {},
+	}
+}
+
+// DetectCredentials is part of the ProviderCredentials interface.
+func (p *environProvider) DetectCredentials(cloudName string) (*cloud.CloudCredential, error) {
+	return nil, errors.NotFoundf("credentials")
+}
+
+// FinalizeCredential is part of the ProviderCredentials interface.
+func (p *environProvider) FinalizeCredential(
+	_ environs.FinalizeCredentialContext,
+	args environs.FinalizeCredentialParams,
+) (*cloud.Credential, error) {
+	return &args.Credential, nil
+}
+
+// makeWireCloudSpec flattens a cloud spec for transmission to the
+// plugin.
+func makeWireCloudSpec(spec environscloudspec.CloudSpec) wireCloudSpec {
+	wire := wireCloudSpec{
+		Name:              spec.Name,
+		Type:              spec.Type,
+		Region:            spec.Region,
+		Endpoint:          spec.Endpoint,
+		CACertificates:    spec.CACertificates,
+		SkipTLSVerify:     spec.SkipTLSVerify,
+		IsControllerCloud: spec.IsControllerCloud,
+	}
+	if spec.Credential != nil {
+		wire.AuthType = string(spec.Credential.AuthType())
+		wire.CredentialAttrs = spec.Credential.Attributes()
+	}
+	return wire
+}
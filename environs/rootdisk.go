@@ -0,0 +1,51 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package environs
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/context"
+)
+
+const (
+	// EphemeralRootDiskSource is the well-known root-disk-source
+	// constraint value requesting that an instance's OS disk be backed
+	// by provider-local ephemeral storage (e.g. Azure ephemeral OS
+	// disks or EC2 instance store), trading durability for performance
+	// and cost.
+	EphemeralRootDiskSource = "ephemeral"
+)
+
+// EphemeralRootDisk defines the interface for environ providers to
+// implement when they can back an instance's OS disk with ephemeral or
+// instance-store storage.
+type EphemeralRootDisk interface {
+	// SupportsEphemeralRootDisk reports whether an ephemeral
+	// root-disk-source request can be satisfied for the given
+	// constraints; e.g. some instance types have no local storage.
+	SupportsEphemeralRootDisk(context.ProviderCallContext, constraints.Value) (bool, error)
+}
+
+// CheckEphemeralRootDisk returns an error if the given constraints
+// request an ephemeral root disk that the environ cannot provide. It is
+// a no-op for constraints that do not name the ephemeral source.
+func CheckEphemeralRootDisk(env InstanceBroker, ctx context.ProviderCallContext, cons constraints.Value) error {
+	if !cons.HasRootDiskSource() || *cons.RootDiskSource != EphemeralRootDiskSource {
+		return nil
+	}
+	support, ok := env.(EphemeralRootDisk)
+	if !ok {
+		return errors.NotSupportedf("ephemeral root disk")
+	}
+	supported, err := support.SupportsEphemeralRootDisk(ctx, cons)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !supported {
+		return errors.NotSupportedf("ephemeral root disk with constraints %q", cons)
+	}
+	return nil
+}
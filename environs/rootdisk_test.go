@@ -0,0 +1,65 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package environs_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/context"
+)
+
+type rootDiskSuite struct{}
+
+var _ = gc.Suite(&rootDiskSuite{})
+
+// ephemeralBroker stubs an instance broker that supports ephemeral root
+// disks according to the canned supported value.
+type ephemeralBroker struct {
+	environs.InstanceBroker
+
+	supported bool
+}
+
+func (b ephemeralBroker) SupportsEphemeralRootDisk(context.ProviderCallContext, constraints.Value) (bool, error) {
+	return b.supported, nil
+}
+
+// plainBroker stubs an instance broker with no ephemeral root disk
+// support at all.
+type plainBroker struct {
+	environs.InstanceBroker
+}
+
+func (s *rootDiskSuite) TestCheckNoSourceRequested(c *gc.C) {
+	err := environs.CheckEphemeralRootDisk(plainBroker{}, nil, constraints.MustParse("mem=4G"))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *rootDiskSuite) TestCheckOtherSourceRequested(c *gc.C) {
+	cons := constraints.MustParse("root-disk-source=volume")
+	err := environs.CheckEphemeralRootDisk(plainBroker{}, nil, cons)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *rootDiskSuite) TestCheckUnsupportedBroker(c *gc.C) {
+	cons := constraints.MustParse("root-disk-source=ephemeral")
+	err := environs.CheckEphemeralRootDisk(plainBroker{}, nil, cons)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *rootDiskSuite) TestCheckSupported(c *gc.C) {
+	cons := constraints.MustParse("root-disk-source=ephemeral")
+	err := environs.CheckEphemeralRootDisk(ephemeralBroker{supported: true}, nil, cons)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *rootDiskSuite) TestCheckConstraintsNotSatisfiable(c *gc.C) {
+	cons := constraints.MustParse("root-disk-source=ephemeral")
+	err := environs.CheckEphemeralRootDisk(ephemeralBroker{supported: false}, nil, cons)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -27,6 +28,10 @@ type ResolveInfo struct {
 	Signed    bool   `yaml:"signed" json:"signed"`
 	IndexURL  string `yaml:"indexURL" json:"indexURL"`
 	MirrorURL string `yaml:"mirrorURL,omitempty" json:"mirrorURL,omitempty"`
+
+	// Attempts reports the outcome of querying each data source. It is
+	// only populated when sources are fetched in parallel.
+	Attempts []SourceFetchAttempt `yaml:"attempts,omitempty" json:"attempts,omitempty"`
 }
 
 // CloudSpec uniquely defines a specific cloud deployment.
@@ -404,6 +409,25 @@ type GetMetadataParams struct {
 	StreamsVersion   string
 	LookupConstraint LookupConstraint
 	ValueParams      ValueParams
+
+	// ParallelFetch requests that all data sources be queried
+	// concurrently, with the highest-priority successful source
+	// winning. The serial source-by-source behaviour is kept when
+	// false.
+	ParallelFetch bool
+}
+
+// SourceFetchAttempt records the outcome of querying one data source
+// for metadata, for inclusion in the resolve info report.
+type SourceFetchAttempt struct {
+	// Source is the description of the data source queried.
+	Source string `yaml:"source" json:"source"`
+
+	// Signed reports whether signature-verified metadata was found.
+	Signed bool `yaml:"signed" json:"signed"`
+
+	// Error holds the failure, if the source could not be used.
+	Error string `yaml:"error,omitempty" json:"error,omitempty"`
 }
 
 // GetMetadata returns metadata records matching the specified constraint,
@@ -413,18 +437,86 @@ type GetMetadataParams struct {
 // Each source is tried in turn until at least one signed (or unsigned) match
 // is found.
 func (s Simplestreams) GetMetadata(sources []DataSource, params GetMetadataParams) (items []interface{}, resolveInfo *ResolveInfo, err error) {
+	if params.ParallelFetch && len(sources) > 1 {
+		return s.getMetadataParallel(sources, params)
+	}
 	for _, source := range sources {
-		logger.Debugf("searching for signed metadata in datasource %q", source.Description())
-		items, resolveInfo, err = s.getMaybeSignedMetadata(source, params, true)
-		// If no items are found using signed metadata, check unsigned.
-		if err != nil && len(items) == 0 && !source.RequireSigned() {
-			logger.Debugf("falling back to search for unsigned metadata in datasource %q", source.Description())
-			items, resolveInfo, err = s.getMaybeSignedMetadata(source, params, false)
+		items, resolveInfo, err = s.getMetadataFromSource(source, params)
+		if err == nil {
+			break
+		}
+	}
+	if _, ok := err.(*noMatchingProductsError); ok {
+		// no matching products is an internal error only
+		err = nil
+	}
+	return items, resolveInfo, err
+}
+
+// getMetadataFromSource looks for signed metadata in the given source,
+// falling back to unsigned metadata where the source permits it.
+func (s Simplestreams) getMetadataFromSource(source DataSource, params GetMetadataParams) (items []interface{}, resolveInfo *ResolveInfo, err error) {
+	logger.Debugf("searching for signed metadata in datasource %q", source.Description())
+	items, resolveInfo, err = s.getMaybeSignedMetadata(source, params, true)
+	// If no items are found using signed metadata, check unsigned.
+	if err != nil && len(items) == 0 && !source.RequireSigned() {
+		logger.Debugf("falling back to search for unsigned metadata in datasource %q", source.Description())
+		items, resolveInfo, err = s.getMaybeSignedMetadata(source, params, false)
+	}
+	return items, resolveInfo, err
+}
+
+// getMetadataParallel queries all sources concurrently and selects the
+// result from the highest-priority source that succeeded, so one slow
+// or dead mirror does not hold up resolution. The outcome for every
+// mirror is recorded in the resolve info for operator inspection.
+func (s Simplestreams) getMetadataParallel(sources []DataSource, params GetMetadataParams) ([]interface{}, *ResolveInfo, error) {
+	type sourceResult struct {
+		items       []interface{}
+		resolveInfo *ResolveInfo
+		err         error
+	}
+
+	results := make([]sourceResult, len(sources))
+	var wg sync.WaitGroup
+	for i, source := range sources {
+		wg.Add(1)
+		go func(i int, source DataSource) {
+			defer wg.Done()
+			items, resolveInfo, err := s.getMetadataFromSource(source, params)
+			results[i] = sourceResult{items, resolveInfo, err}
+		}(i, source)
+	}
+	wg.Wait()
+
+	attempts := make([]SourceFetchAttempt, len(sources))
+	for i, source := range sources {
+		attempts[i] = SourceFetchAttempt{Source: source.Description()}
+		if results[i].resolveInfo != nil {
+			attempts[i].Signed = results[i].resolveInfo.Signed
+		}
+		if results[i].err != nil {
+			attempts[i].Error = results[i].err.Error()
 		}
+	}
+
+	var (
+		items       []interface{}
+		resolveInfo *ResolveInfo
+		err         error
+	)
+	for i := range results {
+		items, resolveInfo, err = results[i].items, results[i].resolveInfo, results[i].err
 		if err == nil {
 			break
 		}
+		logger.Debugf("mirror %q failed: %v", sources[i].Description(), err)
+	}
+	if resolveInfo == nil {
+		resolveInfo = &ResolveInfo{}
 	}
+	resolveInfo.Attempts = attempts
+
 	if _, ok := err.(*noMatchingProductsError); ok {
 		// no matching products is an internal error only
 		err = nil
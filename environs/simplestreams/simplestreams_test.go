@@ -359,6 +359,38 @@ func (s *simplestreamsSuite) TestGetMetadataNoMatching(c *gc.C) {
 	c.Assert(source.count, gc.Equals, 4*len(sources))
 }
 
+func (s *simplestreamsSuite) TestGetMetadataParallelNoMatching(c *gc.C) {
+	sources := []simplestreams.DataSource{
+		sstesting.VerifyDefaultCloudDataSource("test", "test:/daily"),
+		sstesting.VerifyDefaultCloudDataSource("mirror", "test:/daily"),
+	}
+	constraint := sstesting.NewTestConstraint(simplestreams.LookupParams{
+		CloudSpec: simplestreams.CloudSpec{
+			Region:   "us-east-1",
+			Endpoint: "https://ec2.us-east-1.amazonaws.com",
+		},
+		Releases: []string{"precise"},
+		Arches:   []string{"not-a-real-arch"}, // never matches
+	})
+	params := simplestreams.GetMetadataParams{
+		StreamsVersion:   s.StreamsVersion,
+		LookupConstraint: constraint,
+		ValueParams:      simplestreams.ValueParams{DataType: "image-ids"},
+		ParallelFetch:    true,
+	}
+
+	ss := simplestreams.NewSimpleStreams(sstesting.TestDataSourceFactory())
+	items, resolveInfo, err := ss.GetMetadata(sources, params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(items, gc.HasLen, 0)
+	// With no source matching, the last attempt's details are kept, as
+	// in the serial path, and the outcome for every mirror is reported.
+	c.Assert(resolveInfo.Source, gc.Equals, "mirror")
+	c.Assert(resolveInfo.Attempts, gc.HasLen, 2)
+	c.Assert(resolveInfo.Attempts[0].Source, gc.Equals, "test")
+	c.Assert(resolveInfo.Attempts[1].Source, gc.Equals, "mirror")
+}
+
 func (s *simplestreamsSuite) TestMetadataCatalog(c *gc.C) {
 	metadata := s.AssertGetMetadata(c)
 	c.Check(len(metadata.Products), gc.Equals, 6)
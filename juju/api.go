@@ -75,7 +75,7 @@ func NewAPIConnection(args NewAPIConnectionParams) (_ api.Connection, err error)
 		}
 		return nil, errors.Annotatef(err, "cannot work out how to connect")
 	}
-	if len(apiInfo.Addrs) == 0 {
+	if len(apiInfo.Addrs) == 0 && apiInfo.SRVDiscoveryDomain == "" {
 		return nil, errNoAddresses
 	}
 
@@ -186,9 +186,10 @@ func connectionInfo(args NewAPIConnectionParams) (*api.Info, *jujuclient.Control
 		return nil, nil, errors.Annotate(err, "cannot get controller details")
 	}
 	apiInfo := &api.Info{
-		Addrs:          controller.APIEndpoints,
-		CACert:         controller.CACert,
-		ControllerUUID: controller.ControllerUUID,
+		Addrs:              controller.APIEndpoints,
+		CACert:             controller.CACert,
+		ControllerUUID:     controller.ControllerUUID,
+		SRVDiscoveryDomain: controller.SRVDiscoveryDomain,
 	}
 	if controller.Proxy != nil {
 		apiInfo.Proxier = controller.Proxy.Proxier
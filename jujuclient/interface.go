@@ -35,6 +35,13 @@ type ControllerDetails struct {
 	// officially signed certificate when connecting with this host name.
 	PublicDNSName string `yaml:"public-hostname,omitempty"`
 
+	// SRVDiscoveryDomain optionally holds a DNS domain under which
+	// _juju-api._tcp SRV records advertise the controller's API
+	// addresses. When set, clients discover addresses from DNS in
+	// addition to APIEndpoints, so a change of controller IP addresses
+	// doesn't strand clients with a stale endpoint cache.
+	SRVDiscoveryDomain string `yaml:"srv-discovery-domain,omitempty"`
+
 	// CACert is a security certificate for this controller.
 	CACert string `yaml:"ca-cert"`
 
@@ -6,6 +6,7 @@ package azure
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
@@ -35,6 +36,17 @@ const (
 	// is attached to.
 	configAttrNetwork = "network"
 
+	// configAttrResourceOperationTimeout is the deadline applied to a
+	// single ARM resource operation, such as creating or deleting a
+	// disk. Operations exceeding the deadline fail with a timeout
+	// error and are retried, rather than wedging the caller.
+	configAttrResourceOperationTimeout = "resource-operation-timeout"
+
+	// defaultResourceOperationTimeout is generous: ARM operations are
+	// normally a few minutes at most, but we only want to catch
+	// operations that are genuinely stuck.
+	defaultResourceOperationTimeout = "30m"
+
 	// The below bits are internal book-keeping things, rather than
 	// configuration. Config is just what we have to work with.
 
@@ -64,13 +76,18 @@ var configSchema = environschema.Fields{
 		Type:        environschema.Tstring,
 		Immutable:   true,
 	},
+	configAttrResourceOperationTimeout: {
+		Description: "The deadline applied to a single Azure resource operation, eg creating or deleting a disk.",
+		Type:        environschema.Tstring,
+	},
 }
 
 var configDefaults = schema.Defaults{
-	configAttrStorageAccountType:  string(armstorage.SKUNameStandardLRS),
-	configAttrLoadBalancerSkuName: string(armnetwork.LoadBalancerSKUNameStandard),
-	configAttrResourceGroupName:   schema.Omit,
-	configAttrNetwork:             schema.Omit,
+	configAttrStorageAccountType:       string(armstorage.SKUNameStandardLRS),
+	configAttrLoadBalancerSkuName:      string(armnetwork.LoadBalancerSKUNameStandard),
+	configAttrResourceGroupName:        schema.Omit,
+	configAttrNetwork:                  schema.Omit,
+	configAttrResourceOperationTimeout: defaultResourceOperationTimeout,
 }
 
 // Schema returns the configuration schema for an environment.
@@ -106,10 +123,11 @@ type azureModelConfig struct {
 	*config.Config
 
 	// Azure specific config.
-	storageAccountType  string
-	loadBalancerSkuName string
-	resourceGroupName   string
-	virtualNetworkName  string
+	storageAccountType       string
+	loadBalancerSkuName      string
+	resourceGroupName        string
+	virtualNetworkName       string
+	resourceOperationTimeout time.Duration
 }
 
 // knownStorageAccountTypes returns a list of valid storage SKU names.
@@ -242,12 +260,25 @@ Please choose a model name of no more than %d characters.`,
 
 	networkName, _ := validated[configAttrNetwork].(string)
 
+	operationTimeoutStr, _ := validated[configAttrResourceOperationTimeout].(string)
+	if operationTimeoutStr == "" {
+		operationTimeoutStr = defaultResourceOperationTimeout
+	}
+	operationTimeout, err := time.ParseDuration(operationTimeoutStr)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid %q config", configAttrResourceOperationTimeout)
+	}
+	if operationTimeout <= 0 {
+		return nil, errors.Errorf("%q config must be positive, got %q", configAttrResourceOperationTimeout, operationTimeoutStr)
+	}
+
 	azureConfig := &azureModelConfig{
-		Config:              newCfg,
-		storageAccountType:  storageAccountType,
-		loadBalancerSkuName: loadBalancerSkuName,
-		resourceGroupName:   userSpecifiedResourceGroup,
-		virtualNetworkName:  networkName,
+		Config:                   newCfg,
+		storageAccountType:       storageAccountType,
+		loadBalancerSkuName:      loadBalancerSkuName,
+		resourceGroupName:        userSpecifiedResourceGroup,
+		virtualNetworkName:       networkName,
+		resourceOperationTimeout: operationTimeout,
 	}
 	return azureConfig, nil
 }
@@ -164,13 +164,26 @@ func SimpleError(err error) error {
 // If it is, the credential is invalidated.
 // Original error is returned untouched.
 func HandleCredentialError(err error, ctx context.ProviderCallContext) error {
-	MaybeInvalidateCredential(err, ctx)
+	maybeInvalidateCredential(err, ctx, "")
+	return err
+}
+
+// HandleCredentialErrorOp behaves as HandleCredentialError, additionally
+// recording the provider operation that surfaced the failure against the
+// credential, so that users can see what Juju was doing when the cloud
+// rejected it.
+func HandleCredentialErrorOp(err error, ctx context.ProviderCallContext, operation string) error {
+	maybeInvalidateCredential(err, ctx, operation)
 	return err
 }
 
 // MaybeInvalidateCredential determines if given error is related to authentication/authorisation failures.
 // If an error is related to an invalid credential, then this call will try to invalidate that credential as well.
 func MaybeInvalidateCredential(err error, ctx context.ProviderCallContext) bool {
+	return maybeInvalidateCredential(err, ctx, "")
+}
+
+func maybeInvalidateCredential(err error, ctx context.ProviderCallContext, operation string) bool {
 	if ctx == nil {
 		return false
 	}
@@ -179,7 +192,11 @@ func MaybeInvalidateCredential(err error, ctx context.ProviderCallContext) bool
 	}
 
 	converted := fmt.Errorf("azure cloud denied access: %w", common.CredentialNotValidError(err))
-	invalidateErr := ctx.InvalidateCredential(converted.Error())
+	invalidateErr := context.InvalidateCredential(ctx, context.CredentialInvalidation{
+		Reason:    context.CredentialReasonAccessDenied,
+		Operation: operation,
+		Message:   converted.Error(),
+	})
 	if invalidateErr != nil {
 		logger.Warningf("could not invalidate stored azure cloud credential on the controller: %v", invalidateErr)
 	}
@@ -66,7 +66,9 @@ func (s *ErrorSuite) TestAuthRelatedStatusCodes(c *gc.C) {
 	ctx := context.NewEmptyCloudCallContext()
 	called := false
 	ctx.InvalidateCredentialFunc = func(msg string) error {
-		c.Assert(msg, gc.Matches, "azure cloud denied access: .*")
+		invalidation := context.ParseCredentialInvalidation(msg)
+		c.Assert(invalidation.Reason, gc.Equals, context.CredentialReasonAccessDenied)
+		c.Assert(invalidation.Message, gc.Matches, "azure cloud denied access: .*")
 		called = true
 		return nil
 	}
@@ -84,6 +86,23 @@ func (s *ErrorSuite) TestAuthRelatedStatusCodes(c *gc.C) {
 	}
 }
 
+func (s *ErrorSuite) TestHandleCredentialErrorOpRecordsOperation(c *gc.C) {
+	ctx := context.NewEmptyCloudCallContext()
+	called := false
+	ctx.InvalidateCredentialFunc = func(msg string) error {
+		invalidation := context.ParseCredentialInvalidation(msg)
+		c.Assert(invalidation.Reason, gc.Equals, context.CredentialReasonAccessDenied)
+		c.Assert(invalidation.Operation, gc.Equals, "listing instances")
+		called = true
+		return nil
+	}
+
+	s.azureError.StatusCode = http.StatusUnauthorized
+	returnedErr := errorutils.HandleCredentialErrorOp(s.azureError, ctx, "listing instances")
+	c.Assert(called, jc.IsTrue)
+	c.Assert(returnedErr, gc.DeepEquals, s.azureError)
+}
+
 func (*ErrorSuite) TestNilAzureError(c *gc.C) {
 	ctx := context.NewEmptyCloudCallContext()
 	called := false
@@ -162,6 +162,25 @@ type azureVolumeSource struct {
 	maybeStorageClient  internalazurestorage.Client
 }
 
+// operationContext applies the configured per-operation deadline to the
+// given call context, so that a stuck ARM operation surfaces as a
+// timeout instead of wedging the storage provisioner.
+func (v *azureVolumeSource) operationContext(ctx context.ProviderCallContext) (context.ProviderCallContext, stdcontext.CancelFunc) {
+	v.env.mu.Lock()
+	timeout := v.env.config.resourceOperationTimeout
+	v.env.mu.Unlock()
+	return context.WithOperationTimeout(ctx, timeout)
+}
+
+// maybeOperationTimeout maps expiry of the per-operation deadline to a
+// timeout error, which callers treat as retryable.
+func maybeOperationTimeout(err error, what string) error {
+	if errors.Is(err, stdcontext.DeadlineExceeded) {
+		return errors.NewTimeout(err, fmt.Sprintf("%s: Azure operation timed out", what))
+	}
+	return err
+}
+
 // CreateVolumes is specified on the storage.VolumeSource interface.
 func (v *azureVolumeSource) CreateVolumes(ctx context.ProviderCallContext, params []storage.VolumeParams) (_ []storage.CreateVolumesResult, err error) {
 	results := make([]storage.CreateVolumesResult, len(params))
@@ -223,12 +242,15 @@ func (v *azureVolumeSource) createManagedDiskVolume(ctx context.ProviderCallCont
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	opCtx, cancel := v.operationContext(ctx)
+	defer cancel()
 	var result armcompute.DisksClientCreateOrUpdateResponse
-	poller, err := disks.BeginCreateOrUpdate(ctx, v.env.resourceGroup, diskName, diskModel, nil)
+	poller, err := disks.BeginCreateOrUpdate(opCtx, v.env.resourceGroup, diskName, diskModel, nil)
 	if err == nil {
-		result, err = poller.PollUntilDone(ctx, nil)
+		result, err = poller.PollUntilDone(opCtx, nil)
 	}
 	if err != nil || result.Properties == nil {
+		err = maybeOperationTimeout(err, fmt.Sprintf("creating disk %q", diskName))
 		return nil, errorutils.HandleCredentialError(errors.Annotatef(err, "creating disk for volume %q", p.Tag.Id()), ctx)
 	}
 
@@ -486,12 +508,15 @@ func (v *azureVolumeSource) destroyManagedDiskVolumes(ctx context.ProviderCallCo
 		if err != nil {
 			return errors.Trace(err)
 		}
-		poller, err := disks.BeginDelete(ctx, v.env.resourceGroup, volumeId, nil)
+		opCtx, cancel := v.operationContext(ctx)
+		defer cancel()
+		poller, err := disks.BeginDelete(opCtx, v.env.resourceGroup, volumeId, nil)
 		if err == nil {
-			_, err = poller.PollUntilDone(ctx, nil)
+			_, err = poller.PollUntilDone(opCtx, nil)
 		}
 		if err != nil {
 			if !errorutils.IsNotFoundError(err) {
+				err = maybeOperationTimeout(err, fmt.Sprintf("deleting disk %q", volumeId))
 				return errorutils.HandleCredentialError(errors.Annotatef(err, "deleting disk %q", volumeId), ctx)
 			}
 		}
@@ -843,14 +868,17 @@ func (v *azureVolumeSource) updateVirtualMachines(
 			results[i] = vm.err
 			continue
 		}
+		opCtx, cancel := v.operationContext(ctx)
 		poller, err := compute.BeginCreateOrUpdate(
-			ctx,
+			opCtx,
 			v.env.resourceGroup, toValue(vm.vm.Name), *vm.vm, nil,
 		)
 		if err == nil {
-			_, err = poller.PollUntilDone(ctx, nil)
+			_, err = poller.PollUntilDone(opCtx, nil)
 		}
+		cancel()
 		if err != nil {
+			err = maybeOperationTimeout(err, fmt.Sprintf("updating virtual machine %q", toValue(vm.vm.Name)))
 			if errorutils.MaybeInvalidateCredential(err, ctx) {
 				return nil, errors.Trace(err)
 			}
@@ -1189,6 +1189,9 @@ func (e *environ) StartInstance(ctx context.ProviderCallContext, args environs.S
 	if err := e.checkBroken("StartInstance"); err != nil {
 		return nil, err
 	}
+	if err := checkScriptedFault("StartInstance"); err != nil {
+		return nil, err
+	}
 	estate, err := e.state()
 	if err != nil {
 		return nil, err
@@ -1343,6 +1346,9 @@ func (e *environ) StopInstances(ctx context.ProviderCallContext, ids ...instance
 	if err := e.checkBroken("StopInstance"); err != nil {
 		return err
 	}
+	if err := checkScriptedFault("StopInstances"); err != nil {
+		return err
+	}
 	estate, err := e.state()
 	if err != nil {
 		return err
@@ -1522,6 +1528,9 @@ func (az azShim) Available() bool {
 
 // AvailabilityZones implements environs.ZonedEnviron.
 func (env *environ) AvailabilityZones(ctx context.ProviderCallContext) (network.AvailabilityZones, error) {
+	if err := checkScriptedFault("AvailabilityZones"); err != nil {
+		return nil, err
+	}
 	return network.AvailabilityZones{
 		azShim{"zone1", true},
 		azShim{"zone2", false},
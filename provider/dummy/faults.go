@@ -0,0 +1,108 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dummy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs"
+)
+
+// Fault describes a single scripted failure of a dummy environ method.
+// Faults complement the "broken" config attribute: where "broken" makes
+// a method fail unconditionally, a fault fires for a limited number of
+// calls with a caller-supplied error, so tests can exercise retry and
+// fallback paths in the provisioner.
+type Fault struct {
+	// Method is the name of the environ method the fault applies to,
+	// eg "StartInstance".
+	Method string
+
+	// Error is the error returned by the faulted call. If Error is
+	// nil the call proceeds normally after Delay, which simulates a
+	// slow provider rather than a failing one.
+	Error error
+
+	// Delay is how long the faulted call blocks before returning.
+	Delay time.Duration
+
+	// Count is the number of calls the fault fires for, defaulting
+	// to 1. A negative count fires for every matching call.
+	Count int
+}
+
+var (
+	faultsMu       sync.Mutex
+	scriptedFaults []Fault
+)
+
+// ScriptFaults schedules the given faults to be injected into
+// subsequent environ calls, in the order given. It returns a function
+// that restores the previously scripted faults.
+func ScriptFaults(faults ...Fault) func() {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	previous := scriptedFaults
+	scriptedFaults = append([]Fault(nil), faults...)
+	return func() {
+		faultsMu.Lock()
+		defer faultsMu.Unlock()
+		scriptedFaults = previous
+	}
+}
+
+// QuotaExceededError returns the kind of zone independent error a real
+// provider reports when an account quota is exhausted. The provisioner
+// will not reattempt a StartInstance failing this way in another
+// availability zone.
+func QuotaExceededError() error {
+	return environs.ZoneIndependentError(errors.New("quota exceeded"))
+}
+
+// ZoneFailedError returns an error scoped to a single availability
+// zone. A StartInstance failing this way is reattempted by the
+// provisioner in the next zone.
+func ZoneFailedError(zone string) error {
+	return errors.Errorf("availability zone %q failed", zone)
+}
+
+// nextFault pops the first pending fault scripted for method.
+func nextFault(method string) (Fault, bool) {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	for i, f := range scriptedFaults {
+		if f.Method != method {
+			continue
+		}
+		if f.Count == 0 {
+			f.Count = 1
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				scriptedFaults = append(scriptedFaults[:i:i], scriptedFaults[i+1:]...)
+			} else {
+				scriptedFaults[i] = f
+			}
+		}
+		return f, true
+	}
+	return Fault{}, false
+}
+
+// checkScriptedFault applies any pending fault for method, blocking
+// for the fault's delay and returning its error.
+func checkScriptedFault(method string) error {
+	f, ok := nextFault(method)
+	if !ok {
+		return nil
+	}
+	if f.Delay > 0 {
+		time.Sleep(f.Delay)
+	}
+	return f.Error
+}
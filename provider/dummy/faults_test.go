@@ -0,0 +1,81 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dummy
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs"
+)
+
+type faultSuite struct{}
+
+var _ = gc.Suite(&faultSuite{})
+
+func (s *faultSuite) TestNoFaultsScripted(c *gc.C) {
+	defer ScriptFaults()()
+	c.Assert(checkScriptedFault("StartInstance"), jc.ErrorIsNil)
+}
+
+func (s *faultSuite) TestFaultFiresOnce(c *gc.C) {
+	defer ScriptFaults(Fault{
+		Method: "StartInstance",
+		Error:  errors.New("boom"),
+	})()
+	c.Assert(checkScriptedFault("StopInstances"), jc.ErrorIsNil)
+	c.Assert(checkScriptedFault("StartInstance"), gc.ErrorMatches, "boom")
+	c.Assert(checkScriptedFault("StartInstance"), jc.ErrorIsNil)
+}
+
+func (s *faultSuite) TestFaultCount(c *gc.C) {
+	defer ScriptFaults(Fault{
+		Method: "StartInstance",
+		Error:  errors.New("boom"),
+		Count:  2,
+	}, Fault{
+		Method: "StartInstance",
+		Error:  errors.New("bang"),
+	})()
+	c.Assert(checkScriptedFault("StartInstance"), gc.ErrorMatches, "boom")
+	c.Assert(checkScriptedFault("StartInstance"), gc.ErrorMatches, "boom")
+	c.Assert(checkScriptedFault("StartInstance"), gc.ErrorMatches, "bang")
+	c.Assert(checkScriptedFault("StartInstance"), jc.ErrorIsNil)
+}
+
+func (s *faultSuite) TestFaultForever(c *gc.C) {
+	defer ScriptFaults(Fault{
+		Method: "AvailabilityZones",
+		Error:  errors.New("boom"),
+		Count:  -1,
+	})()
+	for i := 0; i < 3; i++ {
+		c.Assert(checkScriptedFault("AvailabilityZones"), gc.ErrorMatches, "boom")
+	}
+}
+
+func (s *faultSuite) TestFaultDelayOnly(c *gc.C) {
+	defer ScriptFaults(Fault{
+		Method: "StartInstance",
+		Delay:  10 * time.Millisecond,
+	})()
+	started := time.Now()
+	c.Assert(checkScriptedFault("StartInstance"), jc.ErrorIsNil)
+	c.Assert(time.Since(started) >= 10*time.Millisecond, jc.IsTrue)
+}
+
+func (s *faultSuite) TestQuotaExceededError(c *gc.C) {
+	err := QuotaExceededError()
+	c.Assert(err, gc.ErrorMatches, "quota exceeded")
+	c.Assert(errors.Is(err, environs.ErrAvailabilityZoneIndependent), jc.IsTrue)
+}
+
+func (s *faultSuite) TestZoneFailedError(c *gc.C) {
+	err := ZoneFailedError("zone1")
+	c.Assert(err, gc.ErrorMatches, `availability zone "zone1" failed`)
+	c.Assert(errors.Is(err, environs.ErrAvailabilityZoneIndependent), jc.IsFalse)
+}
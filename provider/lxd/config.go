@@ -16,10 +16,20 @@ var configSchema = environschema.Fields{
 		Description: "The LXD project name to use for Juju's resources.",
 		Type:        environschema.Tstring,
 	},
+	"storage-pool": {
+		Description: "The LXD storage pool in which to create container root disks. The server default is used when unset.",
+		Type:        environschema.Tstring,
+	},
+	"network": {
+		Description: "The LXD bridge/network to attach the primary NIC of new containers to. The server default is used when unset.",
+		Type:        environschema.Tstring,
+	},
 }
 
 var configDefaults = schema.Defaults{
-	"project": "default",
+	"project":      "default",
+	"storage-pool": "",
+	"network":      "",
 }
 
 var configFields = func() schema.Fields {
@@ -81,3 +91,19 @@ func (c *environConfig) project() string {
 	}
 	return project.(string)
 }
+
+func (c *environConfig) storagePool() string {
+	pool := c.attrs["storage-pool"]
+	if pool == nil {
+		return ""
+	}
+	return pool.(string)
+}
+
+func (c *environConfig) network() string {
+	network := c.attrs["network"]
+	if network == nil {
+		return ""
+	}
+	return network.(string)
+}
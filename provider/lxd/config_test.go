@@ -133,6 +133,14 @@ var newConfigTests = []configTestSpec{{
 	info:   "unknown field is not touched",
 	insert: testing.Attrs{"unknown-field": 12345},
 	expect: testing.Attrs{"unknown-field": 12345},
+}, {
+	info:   "storage-pool can be set",
+	insert: testing.Attrs{"storage-pool": "juju-zfs"},
+	expect: testing.Attrs{"storage-pool": "juju-zfs"},
+}, {
+	info:   "network can be set",
+	insert: testing.Attrs{"network": "lxdbr1"},
+	expect: testing.Attrs{"network": "lxdbr1"},
 }}
 
 func (s *configSuite) TestNewModelConfig(c *gc.C) {
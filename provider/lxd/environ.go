@@ -165,10 +165,48 @@ func (env *environ) SetCloudSpec(_ stdcontext.Context, spec environscloudspec.Cl
 		server.UseProject(project)
 	}
 
+	if err := validateServerResources(server, env.ecfgUnlocked); err != nil {
+		return errors.Trace(err)
+	}
+
 	env.serverUnlocked = server
 	return env.initProfile()
 }
 
+// validateServerResources checks that any storage pool and network named
+// in the model config actually exist on the target LXD server.
+func validateServerResources(server Server, ecfg *environConfig) error {
+	if pool := ecfg.storagePool(); pool != "" {
+		if _, _, err := server.GetStoragePool(pool); err != nil {
+			return errors.Annotatef(err, "invalid storage-pool %q", pool)
+		}
+	}
+	if netName := ecfg.network(); netName != "" {
+		networks, err := server.GetNetworks()
+		if err != nil {
+			return errors.Annotatef(err, "verifying network %q", netName)
+		}
+		found := false
+		for _, n := range networks {
+			if n.Name == netName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.NotFoundf("network %q", netName)
+		}
+	}
+	return nil
+}
+
+func (env *environ) ecfg() *environConfig {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+
+	return env.ecfgUnlocked
+}
+
 func (env *environ) server() Server {
 	env.lock.Lock()
 	defer env.lock.Unlock()
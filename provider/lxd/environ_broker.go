@@ -200,7 +200,30 @@ func (env *environ) getContainerSpec(
 		return cSpec, errors.Trace(err)
 	}
 
-	if !(len(nics) == 1 && nics["eth0"] != nil) {
+	// Apply any model-configured network override to the primary NIC so
+	// that models sharing one LXD cluster can be isolated onto separate
+	// bridges/networks.
+	netOverridden := false
+	if netName := env.ecfg().network(); netName != "" {
+		if nics == nil {
+			nics = make(map[string]map[string]string)
+		}
+		eth0 := nics["eth0"]
+		if eth0 == nil {
+			eth0 = map[string]string{
+				"name":    "eth0",
+				"type":    "nic",
+				"nictype": "bridged",
+			}
+		}
+		if eth0["parent"] != netName {
+			eth0["parent"] = netName
+			nics["eth0"] = eth0
+			netOverridden = true
+		}
+	}
+
+	if netOverridden || !(len(nics) == 1 && nics["eth0"] != nil) {
 		logger.Debugf("generating custom cloud-init networking")
 
 		cSpec.Config[lxd.NetworkConfigKey] = cloudinit.CloudInitNetworkConfigDisabled
@@ -229,6 +252,19 @@ func (env *environ) getContainerSpec(
 	//cfg[lxd.UserDataKey] = utils.Gzip(userData)
 	cSpec.Config[lxd.UserDataKey] = string(userData)
 
+	// Place the container's root disk in the model-configured storage
+	// pool, if one was specified, overriding the profile default.
+	if pool := env.ecfg().storagePool(); pool != "" {
+		if cSpec.Devices == nil {
+			cSpec.Devices = make(map[string]map[string]string)
+		}
+		cSpec.Devices["root"] = map[string]string{
+			"type": "disk",
+			"path": "/",
+			"pool": pool,
+		}
+	}
+
 	for k, v := range args.InstanceConfig.Tags {
 		if !strings.HasPrefix(k, tags.JujuTagPrefix) {
 			// Since some metadata is interpreted by LXD, we cannot allow
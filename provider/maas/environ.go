@@ -864,6 +864,36 @@ func (env *maasEnviron) networkSpaceRequirements(ctx context.ProviderCallContext
 	return positiveSpaceIds, negativeSpaceIds, nil
 }
 
+// SupportsNICRequest is part of the environs.NICRequester interface.
+// MAAS satisfies NIC requests by acquiring machines carrying the
+// matching node tag; it cannot build interfaces from specific parent
+// devices.
+func (env *maasEnviron) SupportsNICRequest(req corenetwork.NICRequest) bool {
+	switch req.Type {
+	case corenetwork.SRIOVNICRequest, corenetwork.BondNICRequest:
+		return len(req.Parents) == 0
+	}
+	return false
+}
+
+// nicRequestTags maps NIC requests onto the MAAS node tags
+// conventionally used to label machines equipped with such interfaces
+// ("sriov", "bond"). Acquiring with these tags restricts allocation to
+// capable machines.
+func nicRequestTags(requests []corenetwork.NICRequest) []string {
+	var tags []string
+	seen := set.NewStrings()
+	for _, req := range requests {
+		tag := string(req.Type)
+		if seen.Contains(tag) {
+			continue
+		}
+		seen.Add(tag)
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
 // acquireNode2 allocates a machine from MAAS2.
 func (env *maasEnviron) acquireNode2(
 	ctx context.ProviderCallContext,
@@ -872,10 +902,12 @@ func (env *maasEnviron) acquireNode2(
 	positiveSpaceIDs set.Strings,
 	negativeSpaceIDs set.Strings,
 	volumes []volumeInfo,
+	nicRequests []corenetwork.NICRequest,
 ) (maasInstance, error) {
 	acquireParams := convertConstraints2(cons)
 	addInterfaces2(&acquireParams, positiveSpaceIDs, negativeSpaceIDs)
 	addStorage2(&acquireParams, volumes)
+	acquireParams.Tags = append(acquireParams.Tags, nicRequestTags(nicRequests)...)
 	acquireParams.AgentName = env.uuid
 	if zoneName != "" {
 		acquireParams.Zone = zoneName
@@ -907,6 +939,7 @@ func (env *maasEnviron) acquireNode(
 	positiveSpaceIDs set.Strings,
 	negativeSpaceIDs set.Strings,
 	volumes []volumeInfo,
+	nicRequests []corenetwork.NICRequest,
 ) (gomaasapi.MAASObject, error) {
 
 	// TODO(axw) 2014-08-18 #1358219
@@ -921,6 +954,9 @@ func (env *maasEnviron) acquireNode(
 	acquireParams := convertConstraints(cons)
 	addInterfaces(acquireParams, positiveSpaceIDs, negativeSpaceIDs)
 	addStorage(acquireParams, volumes)
+	for _, tag := range nicRequestTags(nicRequests) {
+		acquireParams.Add("tags", tag)
+	}
 	acquireParams.Add("agent_name", env.uuid)
 	if zoneName != "" {
 		acquireParams.Add("zone", zoneName)
@@ -1060,6 +1096,7 @@ func (env *maasEnviron) StartInstance(
 			PositiveSpaceIDs: positiveSpaceIDs,
 			NegativeSpaceIDs: negativeSpaceIDs,
 			Volumes:          volumes,
+			NICRequests:      args.NICRequests,
 		})
 	if selectNodeErr != nil {
 		err := errors.Annotate(selectNodeErr, "failed to acquire node")
@@ -1372,6 +1409,7 @@ type selectNodeArgs struct {
 	PositiveSpaceIDs set.Strings
 	NegativeSpaceIDs set.Strings
 	Volumes          []volumeInfo
+	NICRequests      []corenetwork.NICRequest
 }
 
 type selectNodeError struct {
@@ -1389,6 +1427,7 @@ func (env *maasEnviron) selectNode(ctx context.ProviderCallContext, args selectN
 		args.PositiveSpaceIDs,
 		args.NegativeSpaceIDs,
 		args.Volumes,
+		args.NICRequests,
 	)
 	if err != nil {
 		common.HandleCredentialError(IsAuthorisationFailure, err, ctx)
@@ -1419,6 +1458,7 @@ func (env *maasEnviron) selectNode2(ctx context.ProviderCallContext, args select
 		args.PositiveSpaceIDs,
 		args.NegativeSpaceIDs,
 		args.Volumes,
+		args.NICRequests,
 	)
 	if err != nil {
 		return nil, &selectNodeError{
@@ -447,7 +447,7 @@ func (suite *maas2EnvironSuite) TestAcquireNodePassedAgentName(c *gc.C) {
 	suite.setupFakeTools(c)
 	env = suite.makeEnviron(c, nil)
 
-	_, err := env.acquireNode2(suite.callCtx, "", "", "", constraints.Value{}, nil, nil, nil)
+	_, err := env.acquireNode2(suite.callCtx, "", "", "", constraints.Value{}, nil, nil, nil, nil)
 
 	c.Check(err, jc.ErrorIsNil)
 }
@@ -462,7 +462,7 @@ func (suite *maas2EnvironSuite) TestAcquireNodePassesPositiveAndNegativeTags(c *
 	_, err := env.acquireNode2(suite.callCtx,
 		"", "", "",
 		constraints.Value{Tags: stringslicep("tag1", "^tag2", "tag3", "^tag4")},
-		nil, nil, nil,
+		nil, nil, nil, nil,
 	)
 	c.Check(err, jc.ErrorIsNil)
 }
@@ -510,7 +510,7 @@ func (suite *maas2EnvironSuite) TestAcquireNodePassesPositiveAndNegativeSpaces(c
 		"", "", "",
 		cons,
 		positiveSpaceIDs, negativeSpaceIDs,
-		nil,
+		nil, nil,
 	)
 	c.Check(err, jc.ErrorIsNil)
 }
@@ -561,7 +561,7 @@ func (suite *maas2EnvironSuite) TestAcquireNodeStorage(c *gc.C) {
 			return test.expected
 		}
 		env = suite.makeEnviron(c, nil)
-		_, err := env.acquireNode2(suite.callCtx, "", "", "", constraints.Value{}, nil, nil, test.volumes)
+		_, err := env.acquireNode2(suite.callCtx, "", "", "", constraints.Value{}, nil, nil, test.volumes, nil)
 		c.Check(err, jc.ErrorIsNil)
 	}
 }
@@ -642,7 +642,7 @@ func (suite *maas2EnvironSuite) TestAcquireNodeInterfaces(c *gc.C) {
 		positiveSpaceIDs, negativeSpaceIDs, err := env.networkSpaceRequirements(suite.callCtx, test.endpointBindings, cons)
 		c.Check(err, jc.ErrorIsNil)
 
-		_, err = env.acquireNode2(suite.callCtx, "", "", "", cons, positiveSpaceIDs, negativeSpaceIDs, nil)
+		_, err = env.acquireNode2(suite.callCtx, "", "", "", cons, positiveSpaceIDs, negativeSpaceIDs, nil, nil)
 		if test.expectedError != "" {
 			c.Check(err, gc.ErrorMatches, test.expectedError)
 			c.Check(err, jc.Satisfies, errors.IsNotValid)
@@ -22,3 +22,17 @@ type ConfigChangedMessage struct {
 	// different machines, and the forwarding of those messages cross each other.
 	// Adding a version could allow subscribers to ignore lower versioned messages.
 }
+
+// CertRotateRequest messages are published by the apiserver client
+// controller facade to ask the certificate rotation worker to reissue
+// the controller TLS certificates immediately, for example after a
+// suspected key compromise.
+// data: `CertRotateRequestMessage`
+const CertRotateRequest = "controller.cert-rotate-request"
+
+// CertRotateRequestMessage carries the reason an early certificate
+// rotation was requested, so it appears in the logs of every
+// controller machine that acts on it.
+type CertRotateRequestMessage struct {
+	Reason string
+}
@@ -12,3 +12,6 @@ const CodeNotImplemented = codeNotImplemented
 func (c *Conn) ClientRequestID() uint64 {
 	return c.reqId
 }
+
+// Acquire exposes PriorityScheduler.acquire for testing.
+var Acquire = (*PriorityScheduler).acquire
@@ -212,3 +212,28 @@ type ActionPruneArgs struct {
 type ActionMessageParams struct {
 	Messages []EntityString `json:"messages"`
 }
+
+// ActionOutputRequest identifies a spilled action output by the URL
+// recorded in the action results.
+type ActionOutputRequest struct {
+	URL string `json:"url"`
+}
+
+// ActionOutputRequests holds the URLs of spilled action outputs to
+// fetch.
+type ActionOutputRequests struct {
+	Requests []ActionOutputRequest `json:"requests"`
+}
+
+// ActionOutputResult holds the complete output of a single action
+// stream, or an error.
+type ActionOutputResult struct {
+	Output string `json:"output,omitempty"`
+	Error  *Error `json:"error,omitempty"`
+}
+
+// ActionOutputResults holds the results of fetching spilled action
+// outputs.
+type ActionOutputResults struct {
+	Results []ActionOutputResult `json:"results"`
+}
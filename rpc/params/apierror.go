@@ -201,9 +201,11 @@ const (
 	CodeBadRequest                = "bad request"
 	CodeMethodNotAllowed          = "method not allowed"
 	CodeForbidden                 = "forbidden"
+	CodePolicyViolation           = "policy violation"
 	CodeDischargeRequired         = "macaroon discharge required"
 	CodeRedirect                  = "redirection required"
 	CodeIncompatibleSeries        = "incompatible series"
+	CodeUnsupportedPlatform       = "unsupported platform"
 	CodeCloudRegionRequired       = "cloud region required"
 	CodeIncompatibleClouds        = "incompatible clouds"
 	CodeQuotaLimitExceeded        = "quota limit exceeded"
@@ -212,6 +214,7 @@ const (
 	CodeLeaseError                = "lease error"
 	CodeNotYetAvailable           = "not yet available; try again later"
 	CodeNotValid                  = "not valid"
+	CodeInterfaceMismatch         = "interface mismatch"
 )
 
 // ErrCode returns the error code associated with
@@ -390,10 +393,24 @@ func IsCodeIncompatibleSeries(err error) bool {
 	return ErrCode(err) == CodeIncompatibleSeries
 }
 
+func IsCodeUnsupportedPlatform(err error) bool {
+	return ErrCode(err) == CodeUnsupportedPlatform
+}
+
+func IsCodeInterfaceMismatch(err error) bool {
+	return ErrCode(err) == CodeInterfaceMismatch
+}
+
 func IsCodeForbidden(err error) bool {
 	return ErrCode(err) == CodeForbidden
 }
 
+// IsCodePolicyViolation returns true when the supplied error indicates
+// that an operation was rejected by a registered policy check.
+func IsCodePolicyViolation(err error) bool {
+	return ErrCode(err) == CodePolicyViolation
+}
+
 func IsCodeCloudRegionRequired(err error) bool {
 	return ErrCode(err) == CodeCloudRegionRequired
 }
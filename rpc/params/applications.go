@@ -4,11 +4,13 @@
 package params
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/storage"
 )
 
@@ -17,6 +19,46 @@ type ApplicationsDeploy struct {
 	Applications []ApplicationDeploy `json:"applications"`
 }
 
+// ApplicationsDeployResults holds the results of deploying one or more
+// applications.
+type ApplicationsDeployResults struct {
+	Results []ApplicationDeployResult `json:"results"`
+}
+
+// OneError returns the error from the result
+// of a bulk operation on a single value.
+func (result ApplicationsDeployResults) OneError() error {
+	if n := len(result.Results); n != 1 {
+		return fmt.Errorf("expected 1 result, got %d", n)
+	}
+	if err := result.Results[0].Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ApplicationDeployResult holds the result of deploying one application.
+type ApplicationDeployResult struct {
+	Error *Error `json:"error,omitempty"`
+
+	// PrincipalFeasibility reports, for a subordinate application,
+	// whether the machines hosting each principal application's units
+	// can satisfy the subordinate's series and architecture
+	// requirements. It is empty for principal applications.
+	PrincipalFeasibility []PrincipalFeasibility `json:"principal-feasibility,omitempty"`
+}
+
+// PrincipalFeasibility describes whether the machines hosting a principal
+// application's units can run a subordinate being deployed.
+type PrincipalFeasibility struct {
+	Principal string `json:"principal"`
+	Feasible  bool   `json:"feasible"`
+
+	// Message explains why the principal's machines cannot host the
+	// subordinate. It is empty when Feasible is true.
+	Message string `json:"message,omitempty"`
+}
+
 // CharmOrigin holds the parameters for the optional location of the source of
 // the charm.
 type CharmOrigin struct {
@@ -38,6 +80,10 @@ type CharmOrigin struct {
 
 	Architecture string `json:"architecture,omitempty"`
 	Base         Base   `json:"base,omitempty"`
+
+	// BaseSelection records how the base for the charm was chosen when
+	// the user did not specify one.
+	BaseSelection string `json:"base-selection,omitempty"`
 	// TODO(juju3) - remove series, os, channel
 	Series  string `json:"series,omitempty"`
 	OS      string `json:"os,omitempty"`
@@ -280,6 +326,11 @@ type ApplicationExpose struct {
 	// with pre 2.9 clients, if this field is empty, all opened ports
 	// for the application will be exposed to 0.0.0.0/0.
 	ExposedEndpoints map[string]ExposedEndpoint `json:"exposed-endpoints,omitempty"`
+
+	// ExposeDuration, if specified, limits how long the application
+	// remains exposed. Once the duration elapses the controller clears
+	// the exposed flag again automatically.
+	ExposeDuration *time.Duration `json:"expose-duration,omitempty"`
 }
 
 // ExposedEndpoint describes the spaces and/or CIDRs that should be able to
@@ -328,6 +379,11 @@ type ApplicationGet struct {
 	// BranchName identifies the "in-flight" branch that this
 	// request will retrieve application data for.
 	BranchName string `json:"branch"`
+
+	// ExcludeDefaults, when true, causes keys whose value comes from
+	// the charm defaults (or is unset) to be omitted from the result.
+	// Controllers that predate this option ignore it.
+	ExcludeDefaults bool `json:"exclude-defaults,omitempty"`
 }
 
 // ApplicationGetResults holds results of the application Get call.
@@ -387,6 +443,42 @@ type ApplicationConfigUnsetArgs struct {
 	Args []ApplicationUnset
 }
 
+// ConfigChangeHistoryArgs holds the application names to query
+// recorded config changes for.
+type ConfigChangeHistoryArgs struct {
+	Applications []string `json:"applications"`
+}
+
+// ConfigChangeHistoryResults holds the results of a
+// ConfigChangeHistory call, one per requested application.
+type ConfigChangeHistoryResults struct {
+	Results []ConfigChangeHistoryResult `json:"results"`
+}
+
+// ConfigChangeHistoryResult holds the recorded config changes for one
+// application, most recent first.
+type ConfigChangeHistoryResult struct {
+	Error   *Error                    `json:"error,omitempty"`
+	Changes []ApplicationConfigChange `json:"changes,omitempty"`
+}
+
+// ApplicationConfigChange describes one recorded config change for an
+// application.
+type ApplicationConfigChange struct {
+	Author    string              `json:"author"`
+	Timestamp time.Time           `json:"timestamp"`
+	Changes   []ConfigValueChange `json:"changes"`
+}
+
+// ConfigValueChange holds the old and new values of one changed config
+// key. Values of keys deemed secret are redacted rather than reported.
+type ConfigValueChange struct {
+	Key      string      `json:"key"`
+	OldValue interface{} `json:"old-value,omitempty"`
+	NewValue interface{} `json:"new-value,omitempty"`
+	Redacted bool        `json:"redacted,omitempty"`
+}
+
 // ApplicationCharmRelations holds parameters for making the application CharmRelations call.
 type ApplicationCharmRelations struct {
 	ApplicationName string `json:"application"`
@@ -469,6 +561,29 @@ type DestroyApplicationParams struct {
 	// will wait before forcing the next step to kick-off. This parameter
 	// only makes sense in combination with 'force' set to 'true'.
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
+
+	// DryRun, when true, returns the projected effects of destroying
+	// the application without applying them.
+	DryRun bool `json:"dry-run,omitempty"`
+}
+
+// ApplicationTeardownOrderArgs holds bulk parameters for the
+// Application.SetTeardownOrder call.
+type ApplicationTeardownOrderArgs struct {
+	Args []ApplicationTeardownOrder `json:"args"`
+}
+
+// ApplicationTeardownOrder declares the applications that must be
+// removed from the model before a given application is destroyed.
+type ApplicationTeardownOrder struct {
+	// ApplicationTag holds the tag of the application whose
+	// teardown is to wait.
+	ApplicationTag string `json:"application-tag"`
+
+	// After holds the tags of the applications that must be removed
+	// before the application above can be destroyed. An empty list
+	// clears any previously declared ordering.
+	After []string `json:"after"`
 }
 
 // DestroyConsumedApplicationsParams holds bulk parameters for the
@@ -637,11 +752,28 @@ type UnitResult struct {
 	Life            string                 `json:"life,omitempty"`
 	RelationData    []EndpointRelationData `json:"relation-data,omitempty"`
 
+	// Principal is set for subordinate units and describes the
+	// principal unit to which this unit is attached.
+	Principal *UnitSummary `json:"principal,omitempty"`
+
+	// Subordinates describes the subordinate units attached to
+	// this unit.
+	Subordinates []UnitSummary `json:"subordinates,omitempty"`
+
 	// The following are for CAAS models.
 	ProviderId string `json:"provider-id,omitempty"`
 	Address    string `json:"address,omitempty"`
 }
 
+// UnitSummary holds summary information about a unit related to the
+// one being described: a subordinate, or its principal.
+type UnitSummary struct {
+	Tag            string          `json:"tag"`
+	Charm          string          `json:"charm"`
+	WorkloadStatus *DetailedStatus `json:"workload-status,omitempty"`
+	AgentStatus    *DetailedStatus `json:"agent-status,omitempty"`
+}
+
 // UnitInfoResults holds an unit info result or a retrieval error.
 type UnitInfoResult struct {
 	Result *UnitResult `json:"result,omitempty"`
@@ -670,3 +802,94 @@ type ExposeInfoResult struct {
 	// for the application will be exposed to 0.0.0.0/0.
 	ExposedEndpoints map[string]ExposedEndpoint `json:"exposed-endpoints,omitempty"`
 }
+
+// RelationDataBag holds the exported settings of a single relation:
+// the application data bag plus the data bag of each unit in scope,
+// keyed by unit name.
+type RelationDataBag struct {
+	// Relation is the key of the relation, e.g. "wordpress:db mysql:server".
+	Relation string `json:"relation"`
+
+	ApplicationSettings map[string]interface{} `json:"application-settings,omitempty"`
+
+	UnitSettings map[string]map[string]interface{} `json:"unit-settings,omitempty"`
+}
+
+// RelationDataDocument is a portable export of an application's
+// relation data bags, suitable for re-importing after the application
+// has been removed and redeployed. The signature binds the document to
+// the model it was exported from and guards against corruption.
+type RelationDataDocument struct {
+	ModelUUID   string            `json:"model-uuid"`
+	Application string            `json:"application"`
+	Relations   []RelationDataBag `json:"relations,omitempty"`
+	Signature   string            `json:"signature,omitempty"`
+}
+
+// ExportRelationDataArgs identifies the application whose relation data
+// should be exported.
+type ExportRelationDataArgs struct {
+	ApplicationName string `json:"application"`
+}
+
+// RelationDataExportResult holds an exported relation data document or
+// an error.
+type RelationDataExportResult struct {
+	Document RelationDataDocument `json:"document"`
+	Error    *Error               `json:"error,omitempty"`
+}
+
+// ImportRelationDataArgs holds a previously exported relation data
+// document to apply to the model.
+type ImportRelationDataArgs struct {
+	Document RelationDataDocument `json:"document"`
+}
+
+// RelationInfoArgs identifies relations to inspect.
+type RelationInfoArgs struct {
+	RelationIds []int `json:"relation-ids"`
+
+	// IncludeData requests current data bag snapshots. They are only
+	// included for users with write access, and sensitive values are
+	// redacted.
+	IncludeData bool `json:"include-data"`
+}
+
+// RelationInfoResults holds the results of inspecting relations.
+type RelationInfoResults struct {
+	Results []RelationInfoResult `json:"results"`
+}
+
+// RelationInfoResult holds the inspection details for one relation, or
+// an error.
+type RelationInfoResult struct {
+	// Id is the integer internal relation key.
+	Id int `json:"id"`
+
+	// Key is the relation key, e.g. "wordpress:db mysql:server".
+	Key string `json:"key"`
+
+	Life            life.Value `json:"life"`
+	Suspended       bool       `json:"suspended,omitempty"`
+	SuspendedReason string     `json:"suspended-reason,omitempty"`
+	Endpoints       []Endpoint `json:"endpoints"`
+
+	// Data holds the current data bag snapshots, one per participating
+	// application, if they were requested and the user is authorised to
+	// see them.
+	Data []RelationInfoData `json:"data,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// RelationInfoData holds a snapshot of one application's data bags in a
+// relation.
+type RelationInfoData struct {
+	Application string `json:"application"`
+
+	ApplicationSettings map[string]interface{} `json:"application-settings,omitempty"`
+
+	// UnitSettings holds the settings of each unit in scope, keyed on
+	// unit name.
+	UnitSettings map[string]map[string]interface{} `json:"unit-settings,omitempty"`
+}
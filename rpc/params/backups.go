@@ -45,6 +45,27 @@ type BackupsListResult struct {
 	List []BackupsMetadataResult `json:"list"`
 }
 
+// BackupsScheduleArgs holds the args for the API SetSchedule method.
+// An empty Cron removes the schedule.
+type BackupsScheduleArgs struct {
+	Cron           string `json:"cron"`
+	RetentionCount int    `json:"retention-count"`
+	UploadTarget   string `json:"upload-target,omitempty"`
+}
+
+// BackupsScheduleResult holds the configured backup schedule and the
+// status of the most recent scheduled run, as returned by the API
+// Schedule method.
+type BackupsScheduleResult struct {
+	Cron           string `json:"cron"`
+	RetentionCount int    `json:"retention-count"`
+	UploadTarget   string `json:"upload-target,omitempty"`
+
+	LastRun      *time.Time `json:"last-run,omitempty"`
+	LastBackupID string     `json:"last-backup-id,omitempty"`
+	LastRunError string     `json:"last-run-error,omitempty"`
+}
+
 // BackupsListResult holds the list of all stored backups.
 type BackupsUploadResult struct {
 	ID string `json:"id"`
@@ -50,6 +50,33 @@ type CharmsListResult struct {
 	CharmURLs []string `json:"charm-urls"`
 }
 
+// CharmsWithInterfaceQuery holds the arguments of a charms
+// ListCharmsWithInterface call.
+type CharmsWithInterfaceQuery struct {
+	// Interface is the interface name to match endpoints on.
+	Interface string `json:"interface"`
+
+	// Role optionally restricts matches to endpoints with the given
+	// role: "provider", "requirer" or "peer".
+	Role string `json:"role,omitempty"`
+}
+
+// CharmInterfaceMatch describes one application endpoint matching a
+// charms.ListCharmsWithInterface query.
+type CharmInterfaceMatch struct {
+	ApplicationName string `json:"application-name"`
+	CharmURL        string `json:"charm-url"`
+	EndpointName    string `json:"endpoint-name"`
+	Role            string `json:"role"`
+	Interface       string `json:"interface"`
+}
+
+// CharmsWithInterfaceResult stores result from a
+// charms.ListCharmsWithInterface call.
+type CharmsWithInterfaceResult struct {
+	Results []CharmInterfaceMatch `json:"results"`
+}
+
 // IsMeteredResult stores result from a charms.IsMetered call
 type IsMeteredResult struct {
 	Metered bool `json:"metered"`
@@ -130,6 +157,10 @@ type CharmMeta struct {
 	Terms          []string                     `json:"terms,omitempty"`
 	MinJujuVersion string                       `json:"min-juju-version,omitempty"`
 
+	// SupportedOSFamilies lists the OS families the charm's workload is
+	// able to run on, derived from the series the charm declares.
+	SupportedOSFamilies []string `json:"supported-os-families,omitempty"`
+
 	Containers map[string]CharmContainer `json:"containers,omitempty"`
 
 	AssumesExpr *assumes.ExpressionTree `json:"assumes-expr,omitempty"`
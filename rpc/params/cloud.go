@@ -3,6 +3,8 @@
 
 package params
 
+import "time"
+
 // Cloud holds information about a cloud.
 type Cloud struct {
 	Type              string                            `json:"type"`
@@ -167,6 +169,30 @@ type CloudCredentialResults struct {
 	Results []CloudCredentialResult `json:"results,omitempty"`
 }
 
+// ScopedCredentialArg holds the scope for which a short-lived
+// provider credential is requested.
+type ScopedCredentialArg struct {
+	Scope string `json:"scope"`
+}
+
+// ScopedCredentialArgs holds a set of scoped credential requests.
+type ScopedCredentialArgs struct {
+	Args []ScopedCredentialArg `json:"args"`
+}
+
+// ScopedCredentialResult contains a short-lived scoped credential and
+// its expiry time, or an error.
+type ScopedCredentialResult struct {
+	Result     *CloudCredential `json:"result,omitempty"`
+	ValidUntil *time.Time       `json:"valid-until,omitempty"`
+	Error      *Error           `json:"error,omitempty"`
+}
+
+// ScopedCredentialResults contains a set of ScopedCredentialResults.
+type ScopedCredentialResults struct {
+	Results []ScopedCredentialResult `json:"results,omitempty"`
+}
+
 // UserCloud contains a user/cloud tag pair, typically used for identifying
 // a user's credentials for a cloud.
 type UserCloud struct {
@@ -248,6 +274,11 @@ type CredentialContent struct {
 	// Valid indicates whether credential is valid.
 	Valid *bool `json:"valid,omitempty"`
 
+	// InvalidReason contains the reason the credential was marked
+	// invalid, if it is. The reason is encoded as produced by
+	// environs/context.CredentialInvalidation.
+	InvalidReason string `json:"invalid-reason,omitempty"`
+
 	// Attributes contains credential values.
 	Attributes map[string]string `json:"attrs,omitempty"`
 }
@@ -352,3 +383,25 @@ type RevokeCredentialArgs struct {
 	// Credentials holds credentials to revoke.
 	Credentials []RevokeCredentialArg `json:"credentials"`
 }
+
+// CredentialExpiryArg holds the provider-reported expiry time of a
+// model's cloud credential, as recorded by the credential checker
+// worker.
+type CredentialExpiryArg struct {
+	// Expiry is the time at which the credential stops being usable.
+	Expiry time.Time `json:"expiry"`
+}
+
+// CredentialCheckResult describes the recorded validity of a model's
+// cloud credential.
+type CredentialCheckResult struct {
+	// Valid is false when the credential has been marked invalid.
+	Valid bool `json:"valid"`
+
+	// InvalidReason says why the credential was marked invalid.
+	InvalidReason string `json:"invalid-reason,omitempty"`
+
+	// Expiry, when set, is the provider-reported time at which the
+	// credential stops being usable.
+	Expiry *time.Time `json:"expiry,omitempty"`
+}
@@ -37,6 +37,26 @@ type DestroyControllerArgs struct {
 	ModelTimeout *time.Duration `json:"model-timeout,omitempty"`
 }
 
+// DestroyModelsResults holds the results of a bulk model destruction.
+type DestroyModelsResults struct {
+	// Results holds one result per model, in destruction order:
+	// models consuming cross model offers are destroyed before the
+	// models offering them.
+	Results []DestroyModelResult `json:"results"`
+
+	// Progress identifies a strings watcher that reports the models
+	// in the batch whose life changes as the destruction progresses.
+	Progress StringsWatchResult `json:"progress"`
+}
+
+// DestroyModelResult holds the result of destroying one model as part
+// of a bulk destruction.
+type DestroyModelResult struct {
+	// ModelTag is the tag of the model the result pertains to.
+	ModelTag string `json:"model-tag"`
+	Error    *Error `json:"error,omitempty"`
+}
+
 // ModelBlockInfo holds information about an model and its
 // current blocks.
 type ModelBlockInfo struct {
@@ -116,6 +136,37 @@ type ControllerConfigSet struct {
 	Config map[string]interface{} `json:"config"`
 }
 
+// CertRotateArgs holds the arguments for
+// Controller.RotateControllerCertificates.
+type CertRotateArgs struct {
+	// Reason records why an early rotation was requested, for the
+	// logs of the controller machines that act on it.
+	Reason string `json:"reason,omitempty"`
+}
+
+// AgentIntrospectionArgs requests an introspection report from one or
+// more agents, identified by tag.
+type AgentIntrospectionArgs struct {
+	Entities []Entity `json:"entities"`
+
+	// Path is the introspection endpoint to query, such as
+	// "depengine", "metrics" or "debug/pprof/goroutine".
+	Path string `json:"path"`
+}
+
+// AgentIntrospectionResult holds an agent's introspection report, or
+// an error explaining why it could not be fetched.
+type AgentIntrospectionResult struct {
+	Report string `json:"report,omitempty"`
+	Error  *Error `json:"error,omitempty"`
+}
+
+// AgentIntrospectionResults holds the results of an introspection
+// query across several agents.
+type AgentIntrospectionResults struct {
+	Results []AgentIntrospectionResult `json:"results"`
+}
+
 // ControllerAction is an action that can be performed on a model.
 type ControllerAction string
 
@@ -125,6 +176,13 @@ const (
 	RevokeControllerAccess ControllerAction = "revoke"
 )
 
+// HotReloadableConfigKeysResult holds the controller config keys that
+// can be applied by the running controller without an API server
+// restart.
+type HotReloadableConfigKeysResult struct {
+	Keys []string `json:"keys"`
+}
+
 // ControllerVersionResults holds the results from an api call
 // to get the controller's version information.
 type ControllerVersionResults struct {
@@ -4,6 +4,8 @@
 package params
 
 import (
+	"time"
+
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/charm/v8"
 	"github.com/kr/pretty"
@@ -113,6 +115,29 @@ type QueryApplicationOffersResults struct {
 	Results []ApplicationOfferAdminDetails `json:"results"`
 }
 
+// FederatedOfferResult holds the offers found on one controller during
+// a federated search.
+type FederatedOfferResult struct {
+	// ControllerTag identifies the controller the offers came from.
+	ControllerTag string `json:"controller-tag"`
+
+	// ControllerAlias is the human friendly name the controller was
+	// registered under, if any.
+	ControllerAlias string `json:"controller-alias,omitempty"`
+
+	Offers []ApplicationOfferAdminDetails `json:"offers,omitempty"`
+
+	// Error is set when this controller could not be searched; the
+	// other results are still valid.
+	Error *Error `json:"error,omitempty"`
+}
+
+// FederatedOfferResults is a result of searching application offers
+// across a fleet of controllers.
+type FederatedOfferResults struct {
+	Results []FederatedOfferResult `json:"results"`
+}
+
 // AddApplicationOffers is used when adding offers to an application directory.
 type AddApplicationOffers struct {
 	Offers []AddApplicationOffer
@@ -190,6 +215,12 @@ type ConsumeApplicationArg struct {
 
 	// ApplicationAlias is the name of the alias to use for the application name.
 	ApplicationAlias string `json:"application-alias,omitempty"`
+
+	// PinnedEndpointInterfaces maps endpoint names to the interface
+	// (optionally versioned, eg "pgsql/v2") the consumer wants to pin
+	// the offer to. Relations are refused if the offering charm stops
+	// declaring a pinned interface version.
+	PinnedEndpointInterfaces map[string]string `json:"pinned-endpoint-interfaces,omitempty"`
 }
 
 // ConsumeApplicationArgs is a collection of arg for consuming applications.
@@ -678,6 +709,10 @@ type ModifyOfferAccess struct {
 	Action   OfferAction           `json:"action"`
 	Access   OfferAccessPermission `json:"access"`
 	OfferURL string                `json:"offer-url"`
+
+	// ExpiresAt, if set when granting access, is when the grant
+	// lapses and is removed again.
+	ExpiresAt *time.Time `json:"expires-at,omitempty"`
 }
 
 // UpdateControllerForModel contains the parameters for setting
@@ -86,9 +86,40 @@ type MetadataSaveParams struct {
 // CloudImageMetadataList holds a list of cloud image metadata.
 type CloudImageMetadataList struct {
 	Metadata []CloudImageMetadata `json:"metadata,omitempty"`
+
+	// Signature is a clearsigned PGP document containing the
+	// JSON-encoded metadata list. It is required when a signing key
+	// is registered for the source the metadata belongs to.
+	Signature []byte `json:"signature,omitempty"`
 }
 
 // MetadataImageIds holds image ids and can be used to identify related image metadata.
 type MetadataImageIds struct {
 	Ids []string `json:"image-ids"`
 }
+
+// ImageSigningKey holds the public signing key trusted for image
+// metadata from a simplestreams source and stream.
+type ImageSigningKey struct {
+	// Source is the metadata source the key applies to.
+	Source string `json:"source"`
+
+	// Stream is the simplestreams stream the key applies to.
+	Stream string `json:"stream"`
+
+	// PublicKey is the armored PGP public key used to verify
+	// signatures on imported metadata.
+	PublicKey string `json:"public-key,omitempty"`
+}
+
+// ImageSigningKeyArgs holds the signing keys to add or rotate.
+// It supports bulk calls.
+type ImageSigningKeyArgs struct {
+	Keys []ImageSigningKey `json:"keys"`
+}
+
+// ListImageSigningKeysResult holds the signing keys registered on the
+// controller.
+type ListImageSigningKeysResult struct {
+	Result []ImageSigningKey `json:"result"`
+}
@@ -808,6 +808,35 @@ type ProvisioningInfoBase struct {
 	ControllerConfig  map[string]interface{}   `json:"controller-config,omitempty"`
 	CloudInitUserData map[string]interface{}   `json:"cloudinit-userdata,omitempty"`
 	CharmLXDProfiles  []string                 `json:"charm-lxd-profiles,omitempty"`
+
+	// ImageChannel, if set, names the base image channel pinned by the
+	// application the machine is provisioned for, overriding the model
+	// default.
+	ImageChannel string `json:"image-channel,omitempty"`
+
+	// NICRequests holds the network interfaces requested by the
+	// application(s) the machine is provisioned for, such as SR-IOV
+	// or bonded interfaces.
+	NICRequests []NICRequest `json:"nic-requests,omitempty"`
+
+	// CloudSpec, if set, describes the registered cloud the machine
+	// must be provisioned in instead of the model's own cloud.
+	CloudSpec *CloudSpec `json:"cloud-spec,omitempty"`
+}
+
+// NICRequest describes a network interface that the machine being
+// provisioned must be equipped with.
+type NICRequest struct {
+	// Type is the kind of interface requested, "sriov" or "bond".
+	Type string `json:"type"`
+
+	// Parents optionally names the underlying devices to build the
+	// interface from. Only valid for bond requests.
+	Parents []string `json:"parents,omitempty"`
+
+	// Count is the number of interfaces of this type required.
+	// Zero is interpreted as one.
+	Count int `json:"count,omitempty"`
 }
 
 // ProvisioningInfo holds machine provisioning info returned by
@@ -1041,3 +1070,35 @@ type ContainerTypeResult struct {
 	Type  instance.ContainerType `json:"container-type"`
 	Error *Error                 `json:"error"`
 }
+
+// WorkloadIdentityTokenArg holds the parameters for a single workload
+// identity token request.
+type WorkloadIdentityTokenArg struct {
+	// Audience lists the parties the token is intended for, carried in
+	// the token's "aud" claim.
+	Audience []string `json:"audience,omitempty"`
+
+	// Lifetime, if specified, requests a token valid for the given
+	// duration instead of the controller default.
+	Lifetime *time.Duration `json:"lifetime,omitempty"`
+}
+
+// WorkloadIdentityTokenArgs holds a set of workload identity token
+// requests.
+type WorkloadIdentityTokenArgs struct {
+	Args []WorkloadIdentityTokenArg `json:"args"`
+}
+
+// WorkloadIdentityTokenResult contains a workload identity token and
+// its expiry time, or an error.
+type WorkloadIdentityTokenResult struct {
+	Token     string     `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expires-at,omitempty"`
+	Error     *Error     `json:"error,omitempty"`
+}
+
+// WorkloadIdentityTokenResults contains a set of
+// WorkloadIdentityTokenResults.
+type WorkloadIdentityTokenResults struct {
+	Results []WorkloadIdentityTokenResult `json:"results,omitempty"`
+}
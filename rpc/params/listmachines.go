@@ -0,0 +1,61 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/life"
+)
+
+// ListMachinesArgs holds a server-side filter for listing machines.
+// Only machines matching every populated field are returned.
+type ListMachinesArgs struct {
+	// AvailabilityZone, if non-empty, matches machines provisioned
+	// into the named availability zone.
+	AvailabilityZone string `json:"availability-zone,omitempty"`
+
+	// Base, if set, matches machines running the given OS base.
+	Base *Base `json:"base,omitempty"`
+
+	// Constraints matches machines whose constraints include every
+	// attribute set here with an equal value.
+	Constraints constraints.Value `json:"constraints,omitempty"`
+
+	// Life, if non-empty, matches machines with the given life value.
+	Life life.Value `json:"life,omitempty"`
+
+	// AgentStatus, if non-empty, matches machines whose machine agent
+	// has the given status, such as "started" or "down".
+	AgentStatus string `json:"agent-status,omitempty"`
+
+	// Offset is the number of matching machines to skip, for
+	// pagination. Machines are ordered by id.
+	Offset int `json:"offset,omitempty"`
+
+	// Limit, if positive, caps the number of machines returned in a
+	// single call.
+	Limit int `json:"limit,omitempty"`
+}
+
+// ListMachinesResults holds a page of machines matching a filter.
+type ListMachinesResults struct {
+	// Total is the number of machines matching the filter, ignoring
+	// pagination.
+	Total int `json:"total"`
+
+	// Machines holds the requested page of matching machines.
+	Machines []MachineSummary `json:"machines"`
+}
+
+// MachineSummary holds the inventory details of a single machine.
+type MachineSummary struct {
+	Id               string            `json:"id"`
+	Life             life.Value        `json:"life"`
+	Base             Base              `json:"base"`
+	Series           string            `json:"series,omitempty"`
+	AvailabilityZone string            `json:"availability-zone,omitempty"`
+	Constraints      constraints.Value `json:"constraints,omitempty"`
+	AgentStatus      string            `json:"agent-status,omitempty"`
+	InstanceStatus   string            `json:"instance-status,omitempty"`
+}
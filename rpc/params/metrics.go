@@ -38,3 +38,11 @@ type MetricResult struct {
 	Unit   string            `json:"unit"`
 	Labels map[string]string `json:"labels"`
 }
+
+// MetricsScrapeConfigResult returns the model configuration consumed by
+// the metrics collector worker in unit agents.
+type MetricsScrapeConfigResult struct {
+	// RemoteWriteURL is the target that scraped samples are forwarded
+	// to. Empty means forwarding is not configured for the model.
+	RemoteWriteURL string `json:"remote-write-url,omitempty"`
+}
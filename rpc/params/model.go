@@ -187,6 +187,13 @@ type ModelInfo struct {
 	// entries (e.g. juju version) and other features that depend on the
 	// substrate the model is deployed to.
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty"`
+
+	// SupportedOSFamilies lists the OS families whose workloads this
+	// model is able to host.
+	SupportedOSFamilies []string `json:"supported-os-families,omitempty"`
+
+	// Frozen reports whether the model has been made read-only.
+	Frozen bool `json:"frozen,omitempty"`
 }
 
 // SupportedFeature describes a feature that is supported by a particular model.
@@ -387,6 +394,10 @@ type ModifyModelAccess struct {
 	Action   ModelAction          `json:"action"`
 	Access   UserAccessPermission `json:"access"`
 	ModelTag string               `json:"model-tag"`
+
+	// ExpiresAt, if set when granting access, is when the grant
+	// lapses and is removed again.
+	ExpiresAt *time.Time `json:"expires-at,omitempty"`
 }
 
 // ModelAction is an action that can be performed on a model.
@@ -502,3 +513,53 @@ type UpgradeModelResult struct {
 	ChosenVersion version.Number `json:"chosen-version"`
 	Error         *Error         `json:"error,omitempty"`
 }
+
+// ModelSnapshotArgs holds the names of model snapshots to operate on.
+type ModelSnapshotArgs struct {
+	Names []string `json:"names"`
+}
+
+// ModelSnapshot describes one named snapshot of model config and
+// constraints.
+type ModelSnapshot struct {
+	Name        string                 `json:"name"`
+	Created     time.Time              `json:"created"`
+	Config      map[string]interface{} `json:"config"`
+	Constraints string                 `json:"constraints,omitempty"`
+}
+
+// ModelSnapshotResult holds a model snapshot or an error.
+type ModelSnapshotResult struct {
+	Snapshot *ModelSnapshot `json:"snapshot,omitempty"`
+	Error    *Error         `json:"error,omitempty"`
+}
+
+// ModelSnapshotResults holds a set of ModelSnapshotResults.
+type ModelSnapshotResults struct {
+	Results []ModelSnapshotResult `json:"results"`
+}
+
+// ModelSnapshotChange describes one model config attribute that a
+// rollback to the snapshot would change. A nil Snapshot value means
+// the attribute would be removed; a nil Current value means it would
+// be added back.
+type ModelSnapshotChange struct {
+	Key      string      `json:"key"`
+	Snapshot interface{} `json:"snapshot,omitempty"`
+	Current  interface{} `json:"current,omitempty"`
+}
+
+// ModelSnapshotDiffResult holds the differences between a snapshot
+// and the model's current config and constraints, or an error.
+type ModelSnapshotDiffResult struct {
+	Changes             []ModelSnapshotChange `json:"changes,omitempty"`
+	ConstraintsChanged  bool                  `json:"constraints-changed,omitempty"`
+	SnapshotConstraints string                `json:"snapshot-constraints,omitempty"`
+	CurrentConstraints  string                `json:"current-constraints,omitempty"`
+	Error               *Error                `json:"error,omitempty"`
+}
+
+// ModelSnapshotDiffResults holds a set of ModelSnapshotDiffResults.
+type ModelSnapshotDiffResults struct {
+	Results []ModelSnapshotDiffResult `json:"results"`
+}
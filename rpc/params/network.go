@@ -1140,6 +1140,48 @@ type MoveSubnetsResults struct {
 	Results []MoveSubnetsResult `json:"results"`
 }
 
+// ReconcileSubnet describes one subnet in a space reconciliation plan.
+type ReconcileSubnet struct {
+	// CIDR identifies the subnet.
+	CIDR string `json:"cidr"`
+
+	// ProviderId is the provider's identifier for the subnet, if known.
+	ProviderId string `json:"provider-id,omitempty"`
+
+	// SpaceName is the name of the space that the subnet resides in.
+	// For provider-sourced subnets it is only populated when the
+	// provider supports space discovery.
+	SpaceName string `json:"space-name,omitempty"`
+}
+
+// ReconcileMovedCIDR describes a CIDR that the model records in one
+// space, but that the provider now reports in another.
+type ReconcileMovedCIDR struct {
+	// CIDR identifies the subnet.
+	CIDR string `json:"cidr"`
+
+	// FromSpace is the name of the space recorded in the model.
+	FromSpace string `json:"from-space"`
+
+	// ToSpace is the name of the space reported by the provider.
+	ToSpace string `json:"to-space"`
+}
+
+// ReconcileSpacesResults contains the plan that results from comparing
+// the provider's subnets and spaces with those recorded in the model.
+type ReconcileSpacesResults struct {
+	// NewSubnets are provider subnets not yet present in the model.
+	NewSubnets []ReconcileSubnet `json:"new-subnets,omitempty"`
+
+	// RemovedSubnets are provider-sourced subnets in the model that the
+	// provider no longer reports.
+	RemovedSubnets []ReconcileSubnet `json:"removed-subnets,omitempty"`
+
+	// MovedCIDRs are CIDRs recorded against one space in the model,
+	// but reported in another space by the provider.
+	MovedCIDRs []ReconcileMovedCIDR `json:"moved-cidrs,omitempty"`
+}
+
 // ShowSpaceResult holds the list of all available spaces.
 type ShowSpaceResult struct {
 	// Information about a given space.
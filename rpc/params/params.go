@@ -167,6 +167,45 @@ type RelationSuspendedArg struct {
 	Suspended  bool   `json:"suspended"`
 }
 
+// RelationNetworkCharacteristics holds operator-supplied annotations
+// describing the network path carried by a relation.
+type RelationNetworkCharacteristics struct {
+	// LatencyClass is the expected latency class of the relation's
+	// network path: "local", "regional" or "wan". Empty if the
+	// latency has not been characterised.
+	LatencyClass string `json:"latency-class,omitempty"`
+
+	// BandwidthMbps is the expected bandwidth cap for the relation's
+	// traffic in megabits per second. Zero means unknown.
+	BandwidthMbps int `json:"bandwidth-mbps,omitempty"`
+}
+
+// SetRelationNetworkArgs holds the parameters for recording the
+// network characteristics of one or more relations.
+type SetRelationNetworkArgs struct {
+	Args []SetRelationNetworkArg `json:"args"`
+}
+
+// SetRelationNetworkArg holds the new network characteristics for a
+// relation.
+type SetRelationNetworkArg struct {
+	RelationId      int                            `json:"relation-id"`
+	Characteristics RelationNetworkCharacteristics `json:"characteristics"`
+}
+
+// RelationNetworkResults holds the network characteristics of multiple
+// relations.
+type RelationNetworkResults struct {
+	Results []RelationNetworkResult `json:"results"`
+}
+
+// RelationNetworkResult holds the network characteristics of a
+// relation, or an error.
+type RelationNetworkResult struct {
+	Characteristics RelationNetworkCharacteristics `json:"characteristics"`
+	Error           *Error                         `json:"error,omitempty"`
+}
+
 // ProcessRelations holds the information required to process series of
 // relations during a model migration.
 type ProcessRelations struct {
@@ -340,6 +379,12 @@ type UpdateChannelArg struct {
 	// TODO(juju3) - remove series
 	Series  string `json:"series"`
 	Channel string `json:"channel"`
+
+	// SkipHookApplications holds the names of applications on the machine
+	// whose pre/post upgrade-series hooks should not be awaited, as
+	// acknowledged by the operator. Only known by MachineManager facade
+	// version 9 and greater.
+	SkipHookApplications []string `json:"skip-hook-applications,omitempty"`
 }
 
 // UpdateChannelArgs holds the parameters for updating the series
@@ -541,6 +586,54 @@ type ApplicationMergeBindings struct {
 	Force          bool              `json:"force"`
 }
 
+// ApplicationAssignmentStrategyArgs holds the parameters for setting the
+// unit-to-machine assignment strategy on applications.
+type ApplicationAssignmentStrategyArgs struct {
+	Args []ApplicationAssignmentStrategy `json:"args"`
+}
+
+// ApplicationAssignmentStrategy names the strategy used to assign an
+// application's units that have no explicit placement.
+type ApplicationAssignmentStrategy struct {
+	ApplicationName string `json:"application"`
+
+	// Strategy is one of "pack", "spread-by-zone", "spread-by-machine"
+	// or empty for the default behaviour.
+	Strategy string `json:"strategy"`
+}
+
+// ApplicationImageChannelArgs holds the parameters for pinning the base
+// image channel on applications.
+type ApplicationImageChannelArgs struct {
+	Args []ApplicationImageChannel `json:"args"`
+}
+
+// ApplicationImageChannel names the base image channel to use when
+// provisioning machines dedicated to an application.
+type ApplicationImageChannel struct {
+	ApplicationName string `json:"application"`
+
+	// ImageChannel is a channel such as "22.04/candidate", or empty to
+	// remove the pin and restore the model default.
+	ImageChannel string `json:"image-channel"`
+}
+
+// ApplicationNICRequestsArgs holds the parameters for setting the
+// network interface requests on applications.
+type ApplicationNICRequestsArgs struct {
+	Args []ApplicationNICRequests `json:"args"`
+}
+
+// ApplicationNICRequests holds the network interfaces that machines
+// provisioned for an application must be equipped with.
+type ApplicationNICRequests struct {
+	ApplicationName string `json:"application"`
+
+	// Requests is the full set of NIC requests for the application;
+	// an empty set removes any previous requests.
+	Requests []NICRequest `json:"requests,omitempty"`
+}
+
 // DestroyApplicationUnits holds parameters for the deprecated
 // Application.DestroyUnits call.
 type DestroyApplicationUnits struct {
@@ -550,6 +643,10 @@ type DestroyApplicationUnits struct {
 // DestroyUnitsParams holds bulk parameters for the Application.DestroyUnit call.
 type DestroyUnitsParams struct {
 	Units []DestroyUnitParams `json:"units"`
+
+	// Selectors, if set, asks the server to choose the units to
+	// destroy instead of naming them explicitly.
+	Selectors []DestroyUnitSelector `json:"selectors,omitempty"`
 }
 
 // DestroyUnitParams holds parameters for the Application.DestroyUnit call.
@@ -571,6 +668,37 @@ type DestroyUnitParams struct {
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
 }
 
+// DestroyUnitSelector describes a server-side selection of units to
+// destroy, as an alternative to naming the units explicitly.
+type DestroyUnitSelector struct {
+	// Application is the name of the application whose units are
+	// candidates for selection.
+	Application string `json:"application"`
+
+	// Count is the number of units to select; the oldest matching
+	// units are chosen first. A zero count selects all matching units.
+	Count int `json:"count,omitempty"`
+
+	// Machine restricts selection to units assigned to the machine
+	// with the given id.
+	Machine string `json:"machine,omitempty"`
+
+	// AvailabilityZone restricts selection to units on machines in
+	// the given availability zone.
+	AvailabilityZone string `json:"availability-zone,omitempty"`
+
+	// InError restricts selection to units whose workload or agent
+	// is in an error state.
+	InError bool `json:"in-error,omitempty"`
+
+	// DestroyStorage, Force and MaxWait carry the same meaning as the
+	// corresponding DestroyUnitParams fields, and apply to every unit
+	// the selector chooses.
+	DestroyStorage bool           `json:"destroy-storage,omitempty"`
+	Force          bool           `json:"force,omitempty"`
+	MaxWait        *time.Duration `json:"max-wait,omitempty"`
+}
+
 // Creds holds credentials for identifying an entity.
 type Creds struct {
 	AuthTag  string `json:"auth-tag"`
@@ -630,6 +758,148 @@ type SetConstraints struct {
 	Constraints     constraints.Value `json:"constraints"`
 }
 
+// ModelConstraintsHistoryResult holds the recorded changes to the
+// model constraints, most recent first.
+type ModelConstraintsHistoryResult struct {
+	Changes []ModelConstraintsChange `json:"changes,omitempty"`
+	Error   *Error                   `json:"error,omitempty"`
+}
+
+// ModelConstraintsChange describes one recorded change to the model
+// constraints: who made it, when, and the constraints that were set.
+type ModelConstraintsChange struct {
+	Author      string            `json:"author,omitempty"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Constraints constraints.Value `json:"constraints"`
+}
+
+// DefaultConstraintsArg identifies a cloud, or a cloud region if
+// Region is not empty, for which default application constraints
+// are queried, set or unset.
+type DefaultConstraintsArg struct {
+	CloudTag string `json:"cloud-tag"`
+	Region   string `json:"region,omitempty"`
+}
+
+// DefaultConstraintsArgs holds a set of DefaultConstraintsArgs.
+type DefaultConstraintsArgs struct {
+	Args []DefaultConstraintsArg `json:"args"`
+}
+
+// DefaultConstraintsResult holds the default application constraints
+// for one cloud or cloud region, or an error.
+type DefaultConstraintsResult struct {
+	Constraints constraints.Value `json:"constraints"`
+	Error       *Error            `json:"error,omitempty"`
+}
+
+// DefaultConstraintsResults holds a set of DefaultConstraintsResults.
+type DefaultConstraintsResults struct {
+	Results []DefaultConstraintsResult `json:"results"`
+}
+
+// SetDefaultConstraintsArg holds the default application constraints
+// to record for one cloud or cloud region.
+type SetDefaultConstraintsArg struct {
+	CloudTag    string            `json:"cloud-tag"`
+	Region      string            `json:"region,omitempty"`
+	Constraints constraints.Value `json:"constraints"`
+}
+
+// SetDefaultConstraintsArgs holds a set of SetDefaultConstraintsArgs.
+type SetDefaultConstraintsArgs struct {
+	Args []SetDefaultConstraintsArg `json:"args"`
+}
+
+// RelationSchemaArg identifies a JSON schema registered for a
+// relation interface name and version.
+type RelationSchemaArg struct {
+	Interface string `json:"interface"`
+	Version   int    `json:"version"`
+}
+
+// RelationSchemaArgs holds a set of RelationSchemaArgs.
+type RelationSchemaArgs struct {
+	Args []RelationSchemaArg `json:"args"`
+}
+
+// RelationSchemaResult holds the JSON schema registered for one
+// relation interface version, or an error.
+type RelationSchemaResult struct {
+	Schema map[string]interface{} `json:"schema,omitempty"`
+	Error  *Error                 `json:"error,omitempty"`
+}
+
+// RelationSchemaResults holds a set of RelationSchemaResults.
+type RelationSchemaResults struct {
+	Results []RelationSchemaResult `json:"results"`
+}
+
+// SetRelationSchemaArg holds the JSON schema to register for one
+// relation interface name and version.
+type SetRelationSchemaArg struct {
+	Interface string                 `json:"interface"`
+	Version   int                    `json:"version"`
+	Schema    map[string]interface{} `json:"schema"`
+}
+
+// SetRelationSchemaArgs holds a set of SetRelationSchemaArgs.
+type SetRelationSchemaArgs struct {
+	Args []SetRelationSchemaArg `json:"args"`
+}
+
+// SetProviderMetadataArg records provider-sourced instance metadata
+// for a single machine.
+type SetProviderMetadataArg struct {
+	Tag      string            `json:"tag"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// SetProviderMetadataArgs holds the machines and metadata for a
+// SetProviderMetadata call.
+type SetProviderMetadataArgs struct {
+	Args []SetProviderMetadataArg `json:"args"`
+}
+
+// ProviderMetadataResult holds the provider-sourced instance metadata
+// for a single machine, or an error.
+type ProviderMetadataResult struct {
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Error    *Error            `json:"error,omitempty"`
+}
+
+// ProviderMetadataResults holds the results of a ProviderMetadata call.
+type ProviderMetadataResults struct {
+	Results []ProviderMetadataResult `json:"results"`
+}
+
+// LoggingOverrideArg holds a temporary logging configuration for a
+// single agent.
+type LoggingOverrideArg struct {
+	Tag           string        `json:"tag"`
+	LoggingConfig string        `json:"logging-config"`
+	Duration      time.Duration `json:"duration"`
+}
+
+// LoggingOverrideArgs holds the agents and logging configurations for a
+// SetLoggingOverride call.
+type LoggingOverrideArgs struct {
+	Overrides []LoggingOverrideArg `json:"overrides"`
+}
+
+// LoggingOverrideResult holds the active logging override for a single
+// agent, or an error.
+type LoggingOverrideResult struct {
+	LoggingConfig string    `json:"logging-config,omitempty"`
+	Expiry        time.Time `json:"expiry,omitempty"`
+	Error         *Error    `json:"error,omitempty"`
+}
+
+// LoggingOverrideResults holds the results of a LoggingOverride call.
+type LoggingOverrideResults struct {
+	Results []LoggingOverrideResult `json:"results"`
+}
+
 // ResolveCharms stores charm references for a ResolveCharms call.
 type ResolveCharms struct {
 	References []string `json:"references"`
@@ -859,6 +1129,20 @@ type FacadeVersions struct {
 	Versions []int  `json:"versions"`
 }
 
+// FacadeDeprecation describes a facade version that has been marked
+// deprecated, and the release in which it is expected to be removed.
+type FacadeDeprecation struct {
+	Name          string `json:"name"`
+	Version       int    `json:"version"`
+	RemovalTarget string `json:"removal-target,omitempty"`
+}
+
+// FacadeDeprecationResults holds every facade version that the
+// controller has marked deprecated.
+type FacadeDeprecationResults struct {
+	Deprecations []FacadeDeprecation `json:"deprecations,omitempty"`
+}
+
 // RedirectInfoResult holds the result of a RedirectInfo call.
 type RedirectInfoResult struct {
 	// Servers holds an entry for each server that holds the
@@ -961,6 +1245,15 @@ type ControllersSpec struct {
 	Series string `json:"series,omitempty"`
 	// Placement defines specific machines to become new controller machines.
 	Placement []string `json:"placement,omitempty"`
+	// AvailabilityZones holds availability zone targets for any new
+	// controller machines, consumed in order. Each zone is validated
+	// against the provider before being turned into a zone placement
+	// directive.
+	AvailabilityZones []string `json:"availability-zones,omitempty"`
+	// MachineConstraints holds per-machine constraints for any new
+	// controller machines, consumed in order. Machines beyond the end
+	// of the list fall back to Constraints.
+	MachineConstraints []constraints.Value `json:"machine-constraints,omitempty"`
 }
 
 // ControllersSpecs contains all the arguments
@@ -1064,6 +1357,36 @@ type RebootActionResult struct {
 	Error  *Error       `json:"error,omitempty"`
 }
 
+// MachinePatchStatus describes the OS patch state of a machine: how
+// many package updates are pending, how many of those are security
+// updates, and whether a reboot is required to finish applying
+// updates.
+type MachinePatchStatus struct {
+	Tag                    string `json:"tag"`
+	PendingUpdates         int    `json:"pending-updates"`
+	PendingSecurityUpdates int    `json:"pending-security-updates"`
+	RebootRequired         bool   `json:"reboot-required"`
+}
+
+// SetMachinePatchStatusArgs holds the patch status reported by one or
+// more machine agents.
+type SetMachinePatchStatusArgs struct {
+	Statuses []MachinePatchStatus `json:"statuses"`
+}
+
+// MachinePatchStatusResults holds the results of a bulk patch status
+// query.
+type MachinePatchStatusResults struct {
+	Results []MachinePatchStatusResult `json:"results"`
+}
+
+// MachinePatchStatusResult holds the patch status of a single machine,
+// or an error.
+type MachinePatchStatusResult struct {
+	Result *MachinePatchStatus `json:"result,omitempty"`
+	Error  *Error              `json:"error,omitempty"`
+}
+
 // LogRecord is used to transmit log messages to the logsink API
 // endpoint.  Single character field names are used for serialisation
 // to keep the size down. These messages are going to be sent a lot.
@@ -1075,6 +1398,11 @@ type LogRecord struct {
 	Message  string    `json:"x"`
 	Entity   string    `json:"e,omitempty"`
 	Labels   []string  `json:"c,omitempty"`
+
+	// Fields holds optional structured key/value data supplied by the
+	// sender alongside the message. The logsink folds fields into the
+	// record's labels as "key=value" entries for storage and filtering.
+	Fields map[string]string `json:"f,omitempty"`
 }
 
 // PubSubMessage is used to propagate pubsub messages from one api server to the
@@ -1143,6 +1471,14 @@ type ExportBundleParams struct {
 	IncludeCharmDefaults bool `json:"include-charm-defaults,omitempty"`
 }
 
+// ExportScaffoldingParams holds parameters for exporting
+// infrastructure-as-code scaffolding from a model.
+type ExportScaffoldingParams struct {
+	// Format selects the scaffolding dialect: "terraform" (the
+	// default) or "pulumi".
+	Format string `json:"format,omitempty"`
+}
+
 // BundleChangesParams holds parameters for making Bundle.GetChanges calls.
 type BundleChangesParams struct {
 	// BundleDataYAML is the YAML-encoded charm bundle data
@@ -1290,6 +1626,118 @@ type DestroyUnitInfo struct {
 	// DestroyedStorage is the tags of storage instances that will be
 	// destroyed as a result of destroying the unit.
 	DestroyedStorage []Entity `json:"destroyed-storage,omitempty"`
+
+	// DestroyedUnits is the tags of the units chosen by a selector.
+	// It is empty for units that were named explicitly.
+	DestroyedUnits []Entity `json:"destroyed-units,omitempty"`
+}
+
+// ReplaceUnitsParams holds bulk parameters for the
+// Application.ReplaceUnit call.
+type ReplaceUnitsParams struct {
+	Units []ReplaceUnitParams `json:"units"`
+}
+
+// ReplaceUnitParams identifies one unit to replace.
+type ReplaceUnitParams struct {
+	// UnitTag holds the tag of the unit to replace.
+	UnitTag string `json:"unit-tag"`
+}
+
+// ReplaceUnitResults contains the results of a ReplaceUnit API
+// request.
+type ReplaceUnitResults struct {
+	Results []ReplaceUnitResult `json:"results,omitempty"`
+}
+
+// ReplaceUnitResult contains one of the results of a ReplaceUnit API
+// request.
+type ReplaceUnitResult struct {
+	// ReplacementUnit is the tag of the unit added in place of the
+	// replaced unit.
+	ReplacementUnit string `json:"replacement-unit,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// RemovalOperationResults holds the results of a bulk removal
+// operation query.
+type RemovalOperationResults struct {
+	Results []RemovalOperationResult `json:"results"`
+}
+
+// RemovalOperationResult holds a removal operation or an error.
+type RemovalOperationResult struct {
+	Result *RemovalOperation `json:"result,omitempty"`
+	Error  *Error            `json:"error,omitempty"`
+}
+
+// RemovalOperation describes the progress of a cascading entity
+// removal.
+type RemovalOperation struct {
+	// EntityTag is the tag of the entity being removed.
+	EntityTag string `json:"entity-tag"`
+
+	// Force is true if the removal was forced.
+	Force bool `json:"force"`
+
+	// Started is when the removal began.
+	Started time.Time `json:"started"`
+
+	// Completed is when the removal finished, if it has.
+	Completed *time.Time `json:"completed,omitempty"`
+
+	// Steps holds the progress of each removal step.
+	Steps []RemovalStepProgress `json:"steps"`
+}
+
+// RetentionStatsResult holds retention statistics for the prunable
+// collections of a model, or an error.
+type RetentionStatsResult struct {
+	Collections []CollectionRetentionStats `json:"collections,omitempty"`
+	Error       *Error                     `json:"error,omitempty"`
+}
+
+// CollectionRetentionStats describes the current size and age of one
+// prunable collection.
+type CollectionRetentionStats struct {
+	// Collection is the user-facing name of the data set, e.g.
+	// "status-history".
+	Collection string `json:"collection"`
+
+	// SizeKiB is the total size of the underlying collection in KiB,
+	// across all models hosted by the controller.
+	SizeKiB int `json:"size-kib"`
+
+	// Count is the number of documents belonging to the model.
+	Count int `json:"count"`
+
+	// Oldest is the timestamp of the model's oldest document, if the
+	// collection holds any documents for the model.
+	Oldest *time.Time `json:"oldest,omitempty"`
+
+	// Policy describes the retention policy currently applied to the
+	// collection, e.g. "max age 336h, max size 5120M".
+	Policy string `json:"policy,omitempty"`
+}
+
+// RemovalStepProgress describes the progress of one removal step.
+type RemovalStepProgress struct {
+	// Name identifies the removal step, e.g. "units-dying".
+	Name string `json:"name"`
+
+	// Status is one of "pending", "running", "stuck" or "completed".
+	Status string `json:"status"`
+
+	// Total is the number of items the step had to process when the
+	// removal began.
+	Total int `json:"total"`
+
+	// Remaining is the number of items still to be processed.
+	Remaining int `json:"remaining"`
+
+	// Updated is when the step last made progress.
+	Updated time.Time `json:"updated"`
 }
 
 // DumpModelRequest wraps the request for a dump-model call.
@@ -1403,6 +1851,50 @@ type SetProfileArg struct {
 	Profiles []string `json:"profiles"`
 }
 
+// SetProfileDriftArgs holds the profiles applied to a collection of
+// machine instances alongside the profiles expected from the
+// machines' charms.
+type SetProfileDriftArgs struct {
+	Args []SetProfileDriftArg `json:"args"`
+}
+
+// SetProfileDriftArg reports the lxd profiles applied to one
+// machine's instance and the profiles the machine should have.
+type SetProfileDriftArg struct {
+	Entity   Entity   `json:"entity"`
+	Applied  []string `json:"applied,omitempty"`
+	Expected []string `json:"expected,omitempty"`
+}
+
+// ProfileDriftResults holds the results of reporting lxd profile
+// drift for a collection of machines.
+type ProfileDriftResults struct {
+	Results []ProfileDriftResult `json:"results"`
+}
+
+// ProfileDriftResult indicates whether a machine agent should
+// reconcile the drift it reported.
+type ProfileDriftResult struct {
+	Reconcile bool   `json:"reconcile,omitempty"`
+	Error     *Error `json:"error,omitempty"`
+}
+
+// LXDProfileDriftResults holds the lxd profile drift recorded for the
+// machines of a model.
+type LXDProfileDriftResults struct {
+	Results []LXDProfileDriftResult `json:"results"`
+}
+
+// LXDProfileDriftResult describes one machine whose applied lxd
+// profiles do not match the profiles its charms declare.
+type LXDProfileDriftResult struct {
+	MachineTag string    `json:"machine-tag"`
+	Applied    []string  `json:"applied,omitempty"`
+	Expected   []string  `json:"expected,omitempty"`
+	Detected   time.Time `json:"detected"`
+	Error      *Error    `json:"error,omitempty"`
+}
+
 type SetProfileUpgradeCompleteArgs struct {
 	Args []SetProfileUpgradeCompleteArg `json:"args"`
 }
@@ -1488,6 +1980,57 @@ type Generation struct {
 	Applications []GenerationApplication `json:"applications"`
 }
 
+// ConfigItemDelta describes a single configuration change made under a
+// branch relative to the master generation.
+type ConfigItemDelta struct {
+	// Key is the setting that was changed.
+	Key string `json:"key"`
+
+	// Type is one of "addition", "modification" or "deletion".
+	Type string `json:"type"`
+
+	// Old is the master generation's value for the setting, if any.
+	Old interface{} `json:"old,omitempty"`
+
+	// New is the value set under the branch, if any.
+	New interface{} `json:"new,omitempty"`
+}
+
+// BranchApplicationDiff holds the branch configuration deltas for a single
+// application, along with the units tracking the branch (the canaries).
+type BranchApplicationDiff struct {
+	// ApplicationName is the name of the application.
+	ApplicationName string `json:"application"`
+
+	// Deltas is the configuration changes made under the branch.
+	Deltas []ConfigItemDelta `json:"deltas,omitempty"`
+
+	// UnitsTracking is the names of units set to track the branch.
+	UnitsTracking []string `json:"tracking,omitempty"`
+}
+
+// BranchDiffResult transports the configuration differences between a
+// branch and the master generation.
+type BranchDiffResult struct {
+	// BranchName is the name of the diffed branch.
+	BranchName string `json:"branch"`
+
+	// Applications holds the per-application deltas.
+	Applications []BranchApplicationDiff `json:"applications,omitempty"`
+
+	// Error holds the value of any error that occurred processing the request.
+	Error *Error `json:"error,omitempty"`
+}
+
+// BranchPromoteArg identifies a branch to promote (commit) and controls
+// the canary health gate applied before promotion.
+type BranchPromoteArg struct {
+	BranchName string `json:"branch"`
+
+	// Force skips the canary health gate.
+	Force bool `json:"force,omitempty"`
+}
+
 // BranchResults transports a collection of generation details.
 type BranchResults struct {
 	// Generations holds the details of the requested generations.
@@ -89,6 +89,25 @@ type UploadResult struct {
 	Resource Resource `json:"resource"`
 }
 
+// UploadChunkResult is the response from a single request of a chunked
+// resource upload.
+type UploadChunkResult struct {
+	ErrorResult
+
+	// Offset is the number of contiguous bytes staged on the server so
+	// far. A client resuming an interrupted upload should send its next
+	// chunk from this offset.
+	Offset int64 `json:"offset"`
+
+	// Complete is true once the final chunk has been received and the
+	// assembled resource has passed verification and been stored.
+	Complete bool `json:"complete,omitempty"`
+
+	// Resource describes the stored resource. It is only set once
+	// Complete is true.
+	Resource Resource `json:"resource"`
+}
+
 // Resource contains info about a Resource.
 type Resource struct {
 	CharmResource
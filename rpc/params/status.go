@@ -18,6 +18,11 @@ import (
 // StatusParams holds parameters for the Status call.
 type StatusParams struct {
 	Patterns []string `json:"patterns"`
+
+	// IncludeFlapping requests that the server compute
+	// RecentTransitions and Flapping for each status result. Off by
+	// default, since it requires reading status history.
+	IncludeFlapping bool `json:"include-flapping,omitempty"`
 }
 
 // TODO(ericsnow) Add FullStatusResult.
@@ -55,6 +60,10 @@ type ModelStatusInfo struct {
 	ModelStatus      DetailedStatus `json:"model-status"`
 	MeterStatus      MeterStatus    `json:"meter-status"`
 	SLA              string         `json:"sla"`
+
+	// CredentialWarning, when set, warns that the model's cloud
+	// credential is invalid or approaching its expiry time.
+	CredentialWarning string `json:"credential-warning,omitempty"`
 }
 
 // NetworkInterface holds a /etc/network/interfaces-type data and the
@@ -132,6 +141,18 @@ type MachineStatus struct {
 	// been applied to the machine
 	LXDProfiles map[string]LXDProfile `json:"lxd-profiles,omitempty"`
 
+	// PendingUpdates is the number of OS package updates the machine
+	// agent last reported as pending.
+	PendingUpdates int `json:"pending-updates,omitempty"`
+
+	// PendingSecurityUpdates is the number of pending updates that
+	// are security updates.
+	PendingSecurityUpdates int `json:"pending-security-updates,omitempty"`
+
+	// RebootRequired is true if the machine's OS reports that a
+	// reboot is needed to finish applying updates.
+	RebootRequired bool `json:"reboot-required,omitempty"`
+
 	// PrimaryControllerMachine indicates whether this machine has a primary mongo instance in replicaset and,
 	//	// thus, can be considered a primary controller machine in HA setup.
 	PrimaryControllerMachine *bool `json:"primary-controller-machine,omitempty"`
@@ -156,15 +177,19 @@ type ApplicationStatus struct {
 	Base             Base                       `json:"base"`
 	Exposed          bool                       `json:"exposed"`
 	ExposedEndpoints map[string]ExposedEndpoint `json:"exposed-endpoints,omitempty"`
-	Life             life.Value                 `json:"life"`
-	Relations        map[string][]string        `json:"relations"`
-	CanUpgradeTo     string                     `json:"can-upgrade-to"`
-	SubordinateTo    []string                   `json:"subordinate-to"`
-	Units            map[string]UnitStatus      `json:"units"`
-	MeterStatuses    map[string]MeterStatus     `json:"meter-statuses"`
-	Status           DetailedStatus             `json:"status"`
-	WorkloadVersion  string                     `json:"workload-version"`
-	EndpointBindings map[string]string          `json:"endpoint-bindings"`
+
+	// ExposeExpiry holds the time at which the application will be
+	// unexposed again, if it was exposed for a limited duration.
+	ExposeExpiry     *time.Time             `json:"expose-expiry,omitempty"`
+	Life             life.Value             `json:"life"`
+	Relations        map[string][]string    `json:"relations"`
+	CanUpgradeTo     string                 `json:"can-upgrade-to"`
+	SubordinateTo    []string               `json:"subordinate-to"`
+	Units            map[string]UnitStatus  `json:"units"`
+	MeterStatuses    map[string]MeterStatus `json:"meter-statuses"`
+	Status           DetailedStatus         `json:"status"`
+	WorkloadVersion  string                 `json:"workload-version"`
+	EndpointBindings map[string]string      `json:"endpoint-bindings"`
 
 	// The following are for CAAS models.
 	Scale         int    `json:"int,omitempty"`
@@ -260,14 +285,27 @@ func (epStatus *EndpointStatus) String() string {
 
 // DetailedStatus holds status info about a machine or unit agent.
 type DetailedStatus struct {
-	Status  string                 `json:"status"`
-	Info    string                 `json:"info"`
-	Data    map[string]interface{} `json:"data"`
-	Since   *time.Time             `json:"since"`
-	Kind    string                 `json:"kind"`
-	Version string                 `json:"version"`
-	Life    life.Value             `json:"life"`
-	Err     *Error                 `json:"err,omitempty"`
+	Status string                 `json:"status"`
+	Info   string                 `json:"info"`
+	Data   map[string]interface{} `json:"data"`
+	Since  *time.Time             `json:"since"`
+
+	// SinceDuration is how long the entity has been in its current
+	// status, computed server-side from Since.
+	SinceDuration time.Duration `json:"since-duration,omitempty"`
+
+	// RecentTransitions is the number of status transitions recorded
+	// for the entity within the server's flapping detection window.
+	RecentTransitions int `json:"recent-transitions,omitempty"`
+
+	// Flapping is true when the entity's status has changed often
+	// enough recently to suggest it is cycling rather than settling.
+	Flapping bool `json:"flapping,omitempty"`
+
+	Kind    string     `json:"kind"`
+	Version string     `json:"version"`
+	Life    life.Value `json:"life"`
+	Err     *Error     `json:"err,omitempty"`
 }
 
 // History holds many DetailedStatus.
@@ -359,3 +397,42 @@ type BranchStatus struct {
 	Created       int64               `json:"created"`
 	CreatedBy     string              `json:"created-by"`
 }
+
+// ModelAlert describes a single active alert raised by the model alert
+// rules.
+type ModelAlert struct {
+	// Rule is the identifier of the rule that raised the alert.
+	Rule string `json:"rule"`
+
+	// Entity is the tag of the unit or machine the alert applies to.
+	Entity string `json:"entity"`
+
+	// Severity indicates how urgent the alert is.
+	Severity string `json:"severity"`
+
+	// Message is a human-readable description of the condition.
+	Message string `json:"message"`
+
+	// Since records when the offending condition was first observed.
+	Since time.Time `json:"since"`
+}
+
+// ModelAlertsResult holds the set of alerts active in a model.
+type ModelAlertsResult struct {
+	Alerts []ModelAlert `json:"alerts,omitempty"`
+}
+
+// UnitAlertReport is a snapshot of the state of one unit, used for
+// alert rule evaluation.
+type UnitAlertReport struct {
+	Tag            string    `json:"tag"`
+	AgentStatus    string    `json:"agent-status"`
+	StatusSince    time.Time `json:"status-since"`
+	HookRetryCount int       `json:"hook-retry-count,omitempty"`
+}
+
+// UnitAlertReportsResult holds the alert evaluation snapshots for every
+// unit in a model.
+type UnitAlertReportsResult struct {
+	Reports []UnitAlertReport `json:"reports,omitempty"`
+}
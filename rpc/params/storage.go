@@ -200,6 +200,10 @@ type VolumeInfo struct {
 	// Size is the size of the volume in MiB.
 	Size       uint64 `json:"size"`
 	Persistent bool   `json:"persistent"`
+	// Encrypted reports whether the provider says the volume is
+	// encrypted at rest. Older controllers do not populate this
+	// field, so it may be omitted.
+	Encrypted bool `json:"encrypted,omitempty"`
 }
 
 // Volumes describes a set of storage volumes in the model.
@@ -259,6 +263,9 @@ type VolumeParams struct {
 	Attributes map[string]interface{}  `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+	// RequireEncrypted is set when the model policy requires the
+	// volume to be encrypted at rest.
+	RequireEncrypted bool `json:"require-encrypted,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -644,6 +651,50 @@ type StoragePoolsResults struct {
 	Results []StoragePoolsResult `json:"results,omitempty"`
 }
 
+// StoragePoolUsage holds aggregate usage for a single storage pool.
+type StoragePoolUsage struct {
+	// Name is the pool's name.
+	Name string `json:"name"`
+
+	// VolumeCount is the number of volumes allocated from the pool.
+	VolumeCount int `json:"volume-count"`
+
+	// FilesystemCount is the number of filesystems allocated from
+	// the pool.
+	FilesystemCount int `json:"filesystem-count"`
+
+	// TotalSizeMiB is the total provisioned capacity in mebibytes.
+	TotalSizeMiB uint64 `json:"total-size-mib"`
+
+	// Consumers attributes the pool's usage to the applications and
+	// units owning the storage.
+	Consumers []StoragePoolConsumer `json:"consumers,omitempty"`
+}
+
+// StoragePoolConsumer attributes a share of a storage pool's usage to
+// a single storage owner, either an application or a unit.
+type StoragePoolConsumer struct {
+	// OwnerTag is the tag of the application or unit owning the
+	// storage.
+	OwnerTag string `json:"owner-tag"`
+
+	// VolumeCount is the number of the owner's volumes allocated from
+	// the pool.
+	VolumeCount int `json:"volume-count"`
+
+	// FilesystemCount is the number of the owner's filesystems
+	// allocated from the pool.
+	FilesystemCount int `json:"filesystem-count"`
+
+	// TotalSizeMiB is the owner's provisioned capacity in mebibytes.
+	TotalSizeMiB uint64 `json:"total-size-mib"`
+}
+
+// StoragePoolUsageResults holds the results of a pool usage query.
+type StoragePoolUsageResults struct {
+	Results []StoragePoolUsage `json:"results"`
+}
+
 // VolumeFilter holds a filter for volume list API call.
 type VolumeFilter struct {
 	// Machines are machine tags to filter on.
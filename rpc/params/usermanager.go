@@ -67,3 +67,79 @@ type AddUserResult struct {
 	SecretKey []byte `json:"secret-key,omitempty"`
 	Error     *Error `json:"error,omitempty"`
 }
+
+// CreateAPITokenArgs holds the parameters for minting personal
+// access tokens.
+type CreateAPITokenArgs struct {
+	Tokens []CreateAPITokenArg `json:"tokens"`
+}
+
+// CreateAPITokenArg stores the parameters to mint one personal access
+// token.
+type CreateAPITokenArg struct {
+	// Tag is the tag of the user the token authenticates as.
+	Tag string `json:"tag"`
+
+	// Name is a user-assigned name identifying the token.
+	Name string `json:"name"`
+
+	// Models restricts the token to the given model UUIDs. Empty
+	// means any model the user can access.
+	Models []string `json:"models,omitempty"`
+
+	// Access is the maximum permission level the token grants on its
+	// models: "read", "write" or "admin".
+	Access string `json:"access"`
+
+	// Expiry is when the token stops being accepted. Nil means the
+	// token does not expire.
+	Expiry *time.Time `json:"expiry,omitempty"`
+}
+
+// CreateAPITokenResults holds the results of the bulk CreateAPIToken
+// API call.
+type CreateAPITokenResults struct {
+	Results []CreateAPITokenResult `json:"results"`
+}
+
+// CreateAPITokenResult returns the full credential string of a newly
+// minted personal access token, or an error. The credential cannot be
+// recovered later.
+type CreateAPITokenResult struct {
+	Token string `json:"token,omitempty"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// APITokenInfo describes a personal access token, without its secret.
+type APITokenInfo struct {
+	Name    string     `json:"name"`
+	Models  []string   `json:"models,omitempty"`
+	Access  string     `json:"access"`
+	Created time.Time  `json:"created"`
+	Expiry  *time.Time `json:"expiry,omitempty"`
+}
+
+// APITokenResults holds the results of the bulk ListAPITokens API
+// call, one result per queried user.
+type APITokenResults struct {
+	Results []APITokenResult `json:"results"`
+}
+
+// APITokenResult lists the personal access tokens of one user, or an
+// error.
+type APITokenResult struct {
+	Tokens []APITokenInfo `json:"tokens,omitempty"`
+	Error  *Error         `json:"error,omitempty"`
+}
+
+// RevokeAPITokenArgs holds the parameters for revoking personal
+// access tokens.
+type RevokeAPITokenArgs struct {
+	Tokens []RevokeAPITokenArg `json:"tokens"`
+}
+
+// RevokeAPITokenArg identifies one personal access token to revoke.
+type RevokeAPITokenArg struct {
+	Tag  string `json:"tag"`
+	Name string `json:"name"`
+}
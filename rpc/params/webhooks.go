@@ -0,0 +1,39 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// WebhookEndpoint describes a webhook endpoint registered on the
+// controller to receive deployment events.
+type WebhookEndpoint struct {
+	// Name uniquely identifies the endpoint on the controller.
+	Name string `json:"name"`
+
+	// URL is the http or https URL events are POSTed to.
+	URL string `json:"url"`
+
+	// Secret is the shared secret used to sign event payloads. It is
+	// supplied when registering an endpoint and never returned.
+	Secret string `json:"secret,omitempty"`
+
+	// EventFilters restricts delivery to the named event types. An
+	// empty filter delivers all events.
+	EventFilters []string `json:"event-filters,omitempty"`
+}
+
+// WebhookEndpointArgs holds the webhook endpoints to register.
+type WebhookEndpointArgs struct {
+	Endpoints []WebhookEndpoint `json:"endpoints"`
+}
+
+// WebhookEndpointNames holds the names of webhook endpoints to
+// operate on.
+type WebhookEndpointNames struct {
+	Names []string `json:"names"`
+}
+
+// ListWebhookEndpointsResult holds the webhook endpoints registered
+// on the controller.
+type ListWebhookEndpointsResult struct {
+	Result []WebhookEndpoint `json:"result,omitempty"`
+}
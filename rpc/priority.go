@@ -0,0 +1,122 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc
+
+import (
+	"context"
+	"sync"
+)
+
+// Priority is the dispatch priority class assigned to a request.
+type Priority int
+
+const (
+	// PriorityHigh is assigned to cheap liveness and other critical
+	// calls; they are dispatched immediately and never queued.
+	PriorityHigh Priority = iota
+
+	// PriorityNormal is the default class for requests.
+	PriorityNormal
+
+	// PriorityLow is assigned to expensive queries that should not be
+	// allowed to starve the rest of the server when it is loaded.
+	PriorityLow
+)
+
+// String is part of the fmt.Stringer interface.
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityNormal:
+		return "normal"
+	case PriorityLow:
+		return "low"
+	}
+	return "unknown"
+}
+
+// PriorityFunc returns the priority class for the given request.
+type PriorityFunc func(req Request) Priority
+
+// DepthObserver is called by a PriorityScheduler whenever the number
+// of requests queued for a priority class changes.
+type DepthObserver func(p Priority, depth int)
+
+// PriorityScheduler limits the number of normal and low priority
+// requests running concurrently across the connections sharing it,
+// queueing the excess so that high priority requests are not starved
+// behind expensive queries on a loaded server. High priority requests
+// are never queued.
+type PriorityScheduler struct {
+	observer DepthObserver
+
+	mu     sync.Mutex
+	slots  map[Priority]chan struct{}
+	queued map[Priority]int
+}
+
+// NewPriorityScheduler returns a PriorityScheduler that allows up to
+// maxNormal normal and maxLow low priority requests to run
+// concurrently. A non-positive limit leaves the class unlimited. If
+// observer is non-nil it is notified of queue depth changes.
+func NewPriorityScheduler(maxNormal, maxLow int, observer DepthObserver) *PriorityScheduler {
+	s := &PriorityScheduler{
+		observer: observer,
+		slots:    make(map[Priority]chan struct{}),
+		queued:   make(map[Priority]int),
+	}
+	if maxNormal > 0 {
+		s.slots[PriorityNormal] = make(chan struct{}, maxNormal)
+	}
+	if maxLow > 0 {
+		s.slots[PriorityLow] = make(chan struct{}, maxLow)
+	}
+	return s
+}
+
+// QueueDepth returns the number of requests of the given class
+// currently waiting for a slot.
+func (s *PriorityScheduler) QueueDepth(p Priority) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queued[p]
+}
+
+// acquire blocks until the given class has a free slot, or the
+// supplied context is done, and returns a func that releases the
+// slot. Classes without a concurrency limit acquire immediately.
+func (s *PriorityScheduler) acquire(ctx context.Context, p Priority) (func(), error) {
+	s.mu.Lock()
+	slots := s.slots[p]
+	s.mu.Unlock()
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		// There was a free slot; no need to queue.
+		return func() { <-slots }, nil
+	default:
+	}
+	s.addQueued(p, 1)
+	defer s.addQueued(p, -1)
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *PriorityScheduler) addQueued(p Priority, delta int) {
+	s.mu.Lock()
+	s.queued[p] += delta
+	depth := s.queued[p]
+	observer := s.observer
+	s.mu.Unlock()
+	if observer != nil {
+		observer(p, depth)
+	}
+}
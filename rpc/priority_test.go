@@ -0,0 +1,142 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/rpc"
+	"github.com/juju/juju/testing"
+)
+
+type prioritySuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&prioritySuite{})
+
+func (s *prioritySuite) TestPriorityString(c *gc.C) {
+	c.Check(rpc.PriorityHigh.String(), gc.Equals, "high")
+	c.Check(rpc.PriorityNormal.String(), gc.Equals, "normal")
+	c.Check(rpc.PriorityLow.String(), gc.Equals, "low")
+	c.Check(rpc.Priority(42).String(), gc.Equals, "unknown")
+}
+
+func (s *prioritySuite) TestUnlimitedClassNeverQueues(c *gc.C) {
+	scheduler := rpc.NewPriorityScheduler(0, 1, nil)
+	for i := 0; i < 10; i++ {
+		release, err := rpc.Acquire(scheduler, context.Background(), rpc.PriorityHigh)
+		c.Assert(err, jc.ErrorIsNil)
+		defer release()
+		release, err = rpc.Acquire(scheduler, context.Background(), rpc.PriorityNormal)
+		c.Assert(err, jc.ErrorIsNil)
+		defer release()
+	}
+	c.Check(scheduler.QueueDepth(rpc.PriorityHigh), gc.Equals, 0)
+	c.Check(scheduler.QueueDepth(rpc.PriorityNormal), gc.Equals, 0)
+}
+
+func (s *prioritySuite) TestLimitedClassQueues(c *gc.C) {
+	scheduler := rpc.NewPriorityScheduler(0, 1, nil)
+	release, err := rpc.Acquire(scheduler, context.Background(), rpc.PriorityLow)
+	c.Assert(err, jc.ErrorIsNil)
+
+	acquired := make(chan func())
+	go func() {
+		release, err := rpc.Acquire(scheduler, context.Background(), rpc.PriorityLow)
+		if err != nil {
+			c.Errorf("acquire failed: %v", err)
+			close(acquired)
+			return
+		}
+		acquired <- release
+	}()
+
+	select {
+	case <-acquired:
+		c.Fatal("acquired a slot while the class was full")
+	case <-time.After(testing.ShortWait):
+	}
+
+	// Releasing the held slot lets the queued request through.
+	release()
+	select {
+	case release := <-acquired:
+		release()
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for queued acquire")
+	}
+	c.Check(scheduler.QueueDepth(rpc.PriorityLow), gc.Equals, 0)
+}
+
+func (s *prioritySuite) TestAcquireCancelled(c *gc.C) {
+	scheduler := rpc.NewPriorityScheduler(0, 1, nil)
+	release, err := rpc.Acquire(scheduler, context.Background(), rpc.PriorityLow)
+	c.Assert(err, jc.ErrorIsNil)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := rpc.Acquire(scheduler, ctx, rpc.PriorityLow)
+		done <- err
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		c.Assert(err, gc.Equals, context.Canceled)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for cancelled acquire")
+	}
+	c.Check(scheduler.QueueDepth(rpc.PriorityLow), gc.Equals, 0)
+}
+
+func (s *prioritySuite) TestObserver(c *gc.C) {
+	var (
+		mu     sync.Mutex
+		depths []int
+	)
+	scheduler := rpc.NewPriorityScheduler(0, 1, func(p rpc.Priority, depth int) {
+		c.Check(p, gc.Equals, rpc.PriorityLow)
+		mu.Lock()
+		depths = append(depths, depth)
+		mu.Unlock()
+	})
+	release, err := rpc.Acquire(scheduler, context.Background(), rpc.PriorityLow)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = rpc.Acquire(scheduler, ctx, rpc.PriorityLow)
+	}()
+
+	// Wait for the queued request to be counted before cancelling it.
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		if scheduler.QueueDepth(rpc.PriorityLow) == 1 {
+			break
+		}
+		if !a.HasNext() {
+			c.Fatal("timed out waiting for queue depth to rise")
+		}
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for cancelled acquire")
+	}
+	release()
+
+	mu.Lock()
+	defer mu.Unlock()
+	c.Assert(depths, gc.DeepEquals, []int{1, 0})
+}
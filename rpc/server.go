@@ -163,6 +163,11 @@ type Conn struct {
 	inputLoopError error
 
 	recorderFactory RecorderFactory
+
+	// scheduler, if non-nil, gates execution of server requests by
+	// the priority class assigned by priorityFunc.
+	scheduler    *PriorityScheduler
+	priorityFunc PriorityFunc
 }
 
 // NewConn creates a new connection that uses the given codec for
@@ -265,6 +270,17 @@ func (conn *Conn) serve(root Root, factory RecorderFactory, transformErrors func
 	conn.transformErrors = transformErrors
 }
 
+// ServePriorities configures request prioritisation for the
+// connection. Requests are classified by f, and classes with a
+// concurrency limit contend for the slots managed by scheduler, which
+// may be shared across connections.
+func (conn *Conn) ServePriorities(scheduler *PriorityScheduler, f PriorityFunc) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	conn.scheduler = scheduler
+	conn.priorityFunc = f
+}
+
 // noopTransform is used when transformErrors is not supplied to Serve.
 func noopTransform(err error) error {
 	return err
@@ -568,6 +584,18 @@ func (conn *Conn) runRequest(
 	ctx, cancel := context.WithCancel(conn.context)
 	defer cancel()
 
+	conn.mutex.Lock()
+	scheduler, priorityFunc := conn.scheduler, conn.priorityFunc
+	conn.mutex.Unlock()
+	if scheduler != nil && priorityFunc != nil {
+		release, err := scheduler.acquire(ctx, priorityFunc(req.hdr.Request))
+		if err != nil {
+			_ = conn.writeErrorResponse(&req.hdr, req.transformErrors(err), recorder)
+			return
+		}
+		defer release()
+	}
+
 	rv, err := req.Call(ctx, req.hdr.Request.Id, arg)
 	if err != nil {
 		err = conn.writeErrorResponse(&req.hdr, req.transformErrors(err), recorder)
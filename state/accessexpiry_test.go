@@ -0,0 +1,179 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type accessExpirySuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&accessExpirySuite{})
+
+func (s *accessExpirySuite) addModelUser(c *gc.C, expiresAt *time.Time) (permission.UserAccess, error) {
+	user := s.Factory.MakeUser(c,
+		&factory.UserParams{
+			Name:        "validusername",
+			NoModelUser: true,
+		})
+	return s.Model.AddUser(
+		state.UserAccessSpec{
+			User:      user.UserTag(),
+			CreatedBy: names.NewUserTag("test-admin"),
+			Access:    permission.WriteAccess,
+			ExpiresAt: expiresAt,
+		})
+}
+
+func (s *accessExpirySuite) makeOffer(c *gc.C) (*crossmodel.ApplicationOffer, names.UserTag) {
+	s.AddTestingApplication(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	offers := state.NewApplicationOffers(s.State)
+	offer, err := offers.AddOffer(crossmodel.AddApplicationOfferArgs{
+		OfferName:       "someoffer",
+		ApplicationName: "mysql",
+		Owner:           "test-admin",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	user := s.Factory.MakeUser(c, &factory.UserParams{Name: "validusername"})
+	return offer, user.UserTag()
+}
+
+func (s *accessExpirySuite) TestAddModelUserWithExpiry(c *gc.C) {
+	expiry := s.Clock.Now().Add(time.Hour)
+	modelUser, err := s.addModelUser(c, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modelUser.ExpiresAt, gc.NotNil)
+	c.Assert(modelUser.ExpiresAt.Equal(expiry), jc.IsTrue)
+
+	// The grant works like any other until it expires.
+	access, err := s.State.UserPermission(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(access, gc.Equals, permission.WriteAccess)
+
+	// Once the expiry time passes the grant no longer authorises,
+	// even before the cleanup has removed it.
+	s.Clock.Advance(time.Hour + time.Minute)
+	_, err = s.State.UserPermission(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.UserAccess(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *accessExpirySuite) TestCleanupNotDueYet(c *gc.C) {
+	expiry := s.Clock.Now().Add(time.Hour)
+	modelUser, err := s.addModelUser(c, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Clock.Advance(30 * time.Minute)
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := s.State.UserPermission(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(access, gc.Equals, permission.WriteAccess)
+}
+
+func (s *accessExpirySuite) TestCleanupSkipsReplacedGrant(c *gc.C) {
+	expiry := s.Clock.Now().Add(time.Hour)
+	modelUser, err := s.addModelUser(c, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The expiring grant is replaced with an open-ended one before
+	// the cleanup runs.
+	err = s.State.RemoveUserAccess(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.Model.AddUser(
+		state.UserAccessSpec{
+			User:      modelUser.UserTag,
+			CreatedBy: names.NewUserTag("test-admin"),
+			Access:    permission.ReadAccess,
+		})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Clock.Advance(time.Hour + time.Minute)
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := s.State.UserPermission(modelUser.UserTag, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *accessExpirySuite) TestAddModelUserExpiryInPast(c *gc.C) {
+	expiry := s.Clock.Now().Add(-time.Minute)
+	_, err := s.addModelUser(c, &expiry)
+	c.Assert(err, gc.ErrorMatches, `access expiry time .* in the past not valid`)
+}
+
+func (s *accessExpirySuite) TestControllerAccessExpiryNotSupported(c *gc.C) {
+	user := s.Factory.MakeUser(c, &factory.UserParams{Name: "validusername"})
+	expiry := s.Clock.Now().Add(time.Hour)
+	_, err := s.State.AddControllerUser(
+		state.UserAccessSpec{
+			User:      user.UserTag(),
+			CreatedBy: names.NewUserTag("test-admin"),
+			Access:    permission.LoginAccess,
+			ExpiresAt: &expiry,
+		})
+	c.Assert(err, gc.ErrorMatches, `expiring controller access grants not supported`)
+}
+
+func (s *accessExpirySuite) TestOfferAccessWithExpiry(c *gc.C) {
+	offer, user := s.makeOffer(c)
+	expiry := s.Clock.Now().Add(time.Hour)
+	err := s.State.CreateOfferAccess(
+		names.NewApplicationOfferTag(offer.OfferName), user, permission.ConsumeAccess, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	access, err := s.State.GetOfferAccess(offer.OfferUUID, user)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(access, gc.Equals, permission.ConsumeAccess)
+
+	// Once the expiry time passes the grant no longer authorises,
+	// even before the cleanup has removed it.
+	s.Clock.Advance(time.Hour + time.Minute)
+	_, err = s.State.GetOfferAccess(offer.OfferUUID, user)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	offerUsers, err := s.State.GetOfferUsers(offer.OfferUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(offerUsers[user.Name()], gc.Equals, permission.NoAccess)
+}
+
+func (s *accessExpirySuite) TestOfferAccessExpiryInPast(c *gc.C) {
+	offer, user := s.makeOffer(c)
+	expiry := s.Clock.Now().Add(-time.Minute)
+	err := s.State.CreateOfferAccess(
+		names.NewApplicationOfferTag(offer.OfferName), user, permission.ConsumeAccess, &expiry)
+	c.Assert(err, gc.ErrorMatches, `access expiry time .* in the past not valid`)
+}
+
+func (s *accessExpirySuite) TestExpiredOfferAccessCanBeRemoved(c *gc.C) {
+	offer, user := s.makeOffer(c)
+	expiry := s.Clock.Now().Add(time.Hour)
+	err := s.State.CreateOfferAccess(
+		names.NewApplicationOfferTag(offer.OfferName), user, permission.ConsumeAccess, &expiry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// An expired grant can still be removed explicitly before the
+	// scheduled cleanup gets to it.
+	s.Clock.Advance(time.Hour + time.Minute)
+	err = s.State.RemoveOfferAccess(names.NewApplicationOfferTag(offer.OfferName), user)
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -318,8 +318,14 @@ func (a *action) Begin() (Action, error) {
 }
 
 // Finish removes action from the pending queue and captures the output
-// and end state of the action.
+// and end state of the action. The results of a completed action are
+// validated against the output schema declared by the charm, if any.
 func (a *action) Finish(results ActionResults) (Action, error) {
+	if results.Status == ActionCompleted {
+		if err := a.validateOutput(results.Results); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return a.removeAndLog(results.Status, results.Results, results.Message)
 }
 
@@ -389,6 +395,11 @@ func (a *action) removeAndLog(finalStatus ActionStatus, results map[string]inter
 		return nil, errors.Trace(err)
 	}
 
+	results, err = a.spillLargeResults(results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	completedTime := a.st.nowToTheSecond()
 	buildTxn := a.removeAndLogBuildTxn(finalStatus, results, message, m, parentOperation, completedTime)
 	if err = m.st.db().Run(buildTxn); err != nil {
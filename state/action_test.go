@@ -718,6 +718,56 @@ func (s *ActionSuite) TestAddActionFailsOnDeadUnitInTransaction(c *gc.C) {
 	c.Assert(err, gc.Equals, stateerrors.ErrDead)
 }
 
+func (s *ActionSuite) TestFinishSpillsLargeOutput(c *gc.C) {
+	s.PatchValue(state.MaxActionOutputSize, 8)
+
+	unit, err := s.State.Unit(s.unit.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	preventUnitDestroyRemove(c, unit)
+
+	operationID, err := s.Model.EnqueueOperation("a test", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	a, err := s.Model.AddAction(unit, operationID, "snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	action, err := s.Model.Action(a.Id())
+	c.Assert(err, jc.ErrorIsNil)
+
+	bigStdout := "lots and lots of output"
+	result, err := action.Finish(state.ActionResults{
+		Status: state.ActionCompleted,
+		Results: map[string]interface{}{
+			"Stdout": bigStdout,
+			"Stderr": "short",
+			"Code":   "0",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The inline stdout is truncated and carries a URL pointing at the
+	// complete output; the short stderr is untouched.
+	url := "actionoutput/" + action.Id() + "/stdout"
+	res, errstr := result.Results()
+	c.Assert(errstr, gc.Equals, "")
+	c.Assert(res, gc.DeepEquals, map[string]interface{}{
+		"Stdout":          bigStdout[:8],
+		"StdoutTruncated": true,
+		"StdoutURL":       url,
+		"Stderr":          "short",
+		"Code":            "0",
+	})
+
+	// The full output is retrievable from the blob store.
+	full, err := s.Model.ActionOutput(url)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(full), gc.Equals, bigStdout)
+
+	_, err = s.Model.ActionOutput("bogus")
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	_, err = s.Model.ActionOutput("actionoutput/deadbeef/stdout")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
 func (s *ActionSuite) TestFail(c *gc.C) {
 	// get unit, add an action, retrieve that action
 	unit, err := s.State.Unit(s.unit.Name())
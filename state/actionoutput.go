@@ -0,0 +1,88 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/juju/errors"
+
+	statestorage "github.com/juju/juju/state/storage"
+)
+
+// maxActionOutputSize is the maximum size in bytes of a single action
+// output value (e.g. Stdout) stored inline in the action results.
+// Larger values are spilled in full to the controller blob store and
+// truncated in place so that complete logs of long-running actions are
+// preserved without bloating the actions collection.
+var maxActionOutputSize = 1024 * 1024
+
+// actionOutputKeys are the action result keys whose values are spilled
+// to the blob store when they exceed maxActionOutputSize.
+var actionOutputKeys = []string{"Stdout", "Stderr"}
+
+// actionOutputPath returns the blob store path holding the full value
+// of the given action result key. The path doubles as the URL recorded
+// in the action results for later retrieval via the Action facade.
+func actionOutputPath(actionID, key string) string {
+	return fmt.Sprintf("actionoutput/%s/%s", actionID, strings.ToLower(key))
+}
+
+// spillLargeResults moves oversize output values to the controller blob
+// store. The returned results hold a truncated prefix in place of each
+// spilled value, along with keys recording that truncation occurred and
+// the URL from which the full output can be fetched.
+func (a *action) spillLargeResults(results map[string]interface{}) (map[string]interface{}, error) {
+	var spilled map[string]interface{}
+	for _, key := range actionOutputKeys {
+		value, ok := results[key].(string)
+		if !ok || len(value) <= maxActionOutputSize {
+			continue
+		}
+		if spilled == nil {
+			spilled = make(map[string]interface{}, len(results)+4)
+			for k, v := range results {
+				spilled[k] = v
+			}
+		}
+		path := actionOutputPath(a.Id(), key)
+		stor := statestorage.NewStorage(a.st.ModelUUID(), a.st.MongoSession())
+		if err := stor.Put(path, strings.NewReader(value), int64(len(value))); err != nil {
+			return nil, errors.Annotatef(err, "storing full %s of action %q", key, a.Id())
+		}
+		spilled[key] = value[:maxActionOutputSize]
+		spilled[key+"Truncated"] = true
+		spilled[key+"URL"] = path
+	}
+	if spilled == nil {
+		return results, nil
+	}
+	return spilled, nil
+}
+
+// ActionOutput returns the complete output previously spilled to the
+// controller blob store for an oversize action result, identified by
+// the URL recorded in the action results.
+func (m *Model) ActionOutput(url string) ([]byte, error) {
+	parts := strings.Split(url, "/")
+	if len(parts) != 3 || parts[0] != "actionoutput" {
+		return nil, errors.NotValidf("action output URL %q", url)
+	}
+	stor := statestorage.NewStorage(m.st.ModelUUID(), m.st.MongoSession())
+	r, _, err := stor.Get(url)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundf("action output %q", url)
+		}
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = r.Close() }()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading action output %q", url)
+	}
+	return data, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strings"
+
+	"github.com/juju/charm/v8"
+	"github.com/juju/errors"
+	"github.com/juju/gojsonschema"
+	"github.com/juju/names/v4"
+)
+
+// actionOutputSchemaKey is the key in an action's actions.yaml entry
+// under which a charm declares the schema of the action's results. The
+// charm metadata parser carries unrecognised keys through into the
+// action spec, so no metadata format change is needed.
+const actionOutputSchemaKey = "output"
+
+// actionOutputSchema returns the JSON schema declared by the charm for
+// the results of the action described by spec, if any. The "output"
+// value mirrors "params": a map of JSON-Schema properties, which is
+// wrapped in an object schema for validation.
+func actionOutputSchema(spec charm.ActionSpec) (map[string]interface{}, bool) {
+	raw, ok := spec.Params[actionOutputSchemaKey]
+	if !ok {
+		return nil, false
+	}
+	properties, ok := raw.(map[string]interface{})
+	if !ok || len(properties) == 0 {
+		return nil, false
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}, true
+}
+
+// validateOutput checks the results of a completed action against the
+// output schema declared by the charm, if there is one. Actions on
+// non-unit receivers, predefined juju actions, and charms that declare
+// no output schema are accepted as-is.
+func (a *action) validateOutput(results map[string]interface{}) error {
+	tag, err := names.ActionReceiverTag(a.Receiver())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	unitTag, ok := tag.(names.UnitTag)
+	if !ok {
+		return nil
+	}
+	unit, err := a.st.Unit(unitTag.Id())
+	if err != nil {
+		// A missing unit must not stop the action result from being
+		// recorded.
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	specs, err := unit.ActionSpecs()
+	if err != nil {
+		return nil
+	}
+	spec, ok := specs[a.Name()]
+	if !ok {
+		return nil
+	}
+	schema, ok := actionOutputSchema(spec)
+	if !ok {
+		return nil
+	}
+	doc := map[string]interface{}{}
+	if len(results) > 0 {
+		doc = results
+	}
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewGoLoader(schema),
+		gojsonschema.NewGoLoader(doc),
+	)
+	if err != nil {
+		return errors.Annotatef(err, "validating results of action %q", a.Name())
+	}
+	if result.Valid() {
+		return nil
+	}
+	violations := make([]string, len(result.Errors()))
+	for i, resultErr := range result.Errors() {
+		violations[i] = resultErr.String()
+	}
+	return errors.NotValidf(
+		"results of action %q against charm output schema: %s",
+		a.Name(), strings.Join(violations, "; "),
+	)
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/charm/v8"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type ActionOutputSchemaSuite struct {
+	ConnSuite
+	unit *state.Unit
+}
+
+var _ = gc.Suite(&ActionOutputSchemaSuite{})
+
+const schemaActionsYaml = `
+backup:
+  params:
+    outfile:
+      type: string
+  output:
+    archive:
+      type: string
+    size:
+      type: integer
+unchecked:
+  params:
+    outfile:
+      type: string
+`
+
+func (s *ActionOutputSchemaSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+
+	ch := s.AddActionsCharm(c, "dummy", schemaActionsYaml, 1)
+	app := s.AddTestingApplication(c, "dummy", ch)
+	sURL, _ := app.CharmURL()
+	c.Assert(sURL, gc.NotNil)
+
+	var err error
+	s.unit, err = app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetCharmURL(charm.MustParseURL(*sURL))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ActionOutputSchemaSuite) runAction(c *gc.C, name string) state.Action {
+	operationID, err := s.Model.EnqueueOperation("a test", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	anAction, err := s.Model.AddAction(s.unit, operationID, name, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	anAction, err = anAction.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+	return anAction
+}
+
+func (s *ActionOutputSchemaSuite) TestConformingResultsAccepted(c *gc.C) {
+	anAction := s.runAction(c, "backup")
+	anAction, err := anAction.Finish(state.ActionResults{
+		Status: state.ActionCompleted,
+		Results: map[string]interface{}{
+			"archive": "/tmp/backup.tgz",
+			"size":    42,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(anAction.Status(), gc.Equals, state.ActionCompleted)
+}
+
+func (s *ActionOutputSchemaSuite) TestNonConformingResultsRejected(c *gc.C) {
+	anAction := s.runAction(c, "backup")
+	_, err := anAction.Finish(state.ActionResults{
+		Status: state.ActionCompleted,
+		Results: map[string]interface{}{
+			"archive": 123,
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, `results of action "backup" against charm output schema: .*archive.*`)
+}
+
+func (s *ActionOutputSchemaSuite) TestNoSchemaAcceptsAnything(c *gc.C) {
+	anAction := s.runAction(c, "unchecked")
+	_, err := anAction.Finish(state.ActionResults{
+		Status: state.ActionCompleted,
+		Results: map[string]interface{}{
+			"anything": []string{"at", "all"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ActionOutputSchemaSuite) TestFailedActionNotValidated(c *gc.C) {
+	anAction := s.runAction(c, "backup")
+	_, err := anAction.Finish(state.ActionResults{
+		Status:  state.ActionFailed,
+		Message: "it broke",
+		Results: map[string]interface{}{
+			"archive": 123,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
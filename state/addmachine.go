@@ -88,6 +88,11 @@ type MachineTemplate struct {
 	// with the machine.
 	Placement string
 
+	// Cloud optionally names a registered cloud, other than the
+	// model's own, that the machine's instance must be provisioned
+	// in. An empty value selects the model's cloud.
+	Cloud string
+
 	// principals holds the principal units that will
 	// associated with the machine.
 	principals []string
@@ -238,6 +243,19 @@ func (st *State) effectiveMachineTemplate(p MachineTemplate, allowController boo
 		}
 	}
 
+	if p.Cloud != "" {
+		model, err := st.Model()
+		if err != nil {
+			return tmpl, errors.Trace(err)
+		}
+		if p.Cloud == model.CloudName() {
+			// The model's own cloud needs no overriding.
+			p.Cloud = ""
+		} else if _, err := st.Cloud(p.Cloud); err != nil {
+			return tmpl, errors.Annotate(err, "machine cloud")
+		}
+	}
+
 	if len(p.Jobs) == 0 {
 		return tmpl, errors.New("no jobs specified")
 	}
@@ -520,6 +538,7 @@ func (st *State) machineDocForTemplate(template MachineTemplate, id string) *mac
 		PreferredPrivateAddress: fromNetworkAddress(privateAddr, network.OriginMachine),
 		PreferredPublicAddress:  fromNetworkAddress(publicAddr, network.OriginMachine),
 		Placement:               template.Placement,
+		Cloud:                   template.Cloud,
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	jujutxn "github.com/juju/txn/v2"
 
 	k8sprovider "github.com/juju/juju/caas/kubernetes/provider"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/mongo"
 )
@@ -121,6 +122,10 @@ func (st *State) SetAPIHostPorts(newHostPorts []network.SpaceHostPorts) error {
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		newHostPortsForAgents, err = st.filterHostPortsForBindFamily(newHostPortsForAgents)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 		agentAddrOps, err := st.getOpsForHostPortsChange(controllers, apiHostPortsForAgentsKey, newHostPortsForAgents)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -219,6 +224,45 @@ func (st *State) filterHostPortsForManagementSpace(
 	return hostPortsForAgents, nil
 }
 
+// filterHostPortsForBindFamily filters the API addresses used by agents
+// down to the address families the API server binds, so that agents on
+// family-restricted infrastructure do not attempt unusable addresses.
+// Host names are always retained. If a server would be filtered down to
+// zero addresses, its collection is left unfiltered for safety.
+func (st *State) filterHostPortsForBindFamily(
+	apiHostPorts []network.SpaceHostPorts,
+) ([]network.SpaceHostPorts, error) {
+	config, err := st.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var keep network.AddressType
+	switch config.APIBindAddressFamily() {
+	case controller.AddressFamilyIPv4:
+		keep = network.IPv4Address
+	case controller.AddressFamilyIPv6:
+		keep = network.IPv6Address
+	default:
+		return apiHostPorts, nil
+	}
+
+	filteredHostPorts := make([]network.SpaceHostPorts, len(apiHostPorts))
+	for i, server := range apiHostPorts {
+		var filtered network.SpaceHostPorts
+		for _, hostPort := range server {
+			if hostPort.Type == keep || hostPort.Type == network.HostName {
+				filtered = append(filtered, hostPort)
+			}
+		}
+		if len(filtered) == 0 {
+			filtered = server
+		}
+		filteredHostPorts[i] = filtered
+	}
+	return filteredHostPorts, nil
+}
+
 // APIHostPortsForClients returns the collection of *all* known API addresses.
 func (st *State) APIHostPortsForClients() ([]network.SpaceHostPorts, error) {
 	isCAASCtrl, err := st.isCAASController()
@@ -152,6 +152,12 @@ func allCollections() CollectionSchema {
 			global: true,
 		},
 
+		// This collection holds personal access tokens minted for
+		// local users.
+		apiTokensC: {
+			global: true,
+		},
+
 		// This collection holds the last time the user connected to the API server.
 		userLastLoginC: {
 			global:    true,
@@ -260,6 +266,14 @@ func allCollections() CollectionSchema {
 			}},
 		},
 
+		// This collection holds progress records for cascading entity
+		// removals, keyed by the removed entity's tag.
+		removalOperationsC: {},
+
+		// This collection holds the per-model signing keys used to mint
+		// workload identity tokens for units.
+		workloadIdentityKeysC: {},
+
 		// This collection contains incrementing integers, subdivided by name,
 		// to ensure various IDs aren't reused.
 		sequenceC: {},
@@ -345,6 +359,30 @@ func allCollections() CollectionSchema {
 		rebootC:      {},
 		sshHostKeysC: {},
 
+		// This collection holds the OS patch state last reported by
+		// each machine agent.
+		machinePatchStatusC: {},
+
+		// This collection holds named snapshots of model config and
+		// constraints, used to roll back batches of risky changes.
+		modelSnapshotsC: {},
+
+		// This collection records machines whose applied lxd profiles
+		// have drifted from the profiles their charms declare.
+		machineLXDProfileDriftC: {},
+
+		// This collection holds the public signing keys trusted for
+		// custom image metadata, per simplestreams source and stream.
+		imageSigningKeysC: {
+			global: true,
+		},
+
+		// This collection holds the webhook endpoints registered on
+		// the controller to receive deployment events.
+		webhookEndpointsC: {
+			global: true,
+		},
+
 		// This collection contains information from removed machines
 		// that needs to be cleaned up in the provider.
 		machineRemovalsC: {},
@@ -506,6 +544,18 @@ func allCollections() CollectionSchema {
 				Key: []string{"model-uuid", "_id"},
 			}},
 		},
+		configChangeHistoryC: {
+			rawAccess: true,
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "application", "updated"},
+			}},
+		},
+		constraintsChangeHistoryC: {
+			rawAccess: true,
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "updated"},
+			}},
+		},
 		statusesHistoryC: {
 			rawAccess: true,
 			indexes: []mgo.Index{{
@@ -602,6 +652,8 @@ const (
 	cloudContainersC           = "cloudcontainers"
 	cloudServicesC             = "cloudservices"
 	cloudCredentialsC          = "cloudCredentials"
+	configChangeHistoryC       = "configchangehistory"
+	constraintsChangeHistoryC  = "constraintschangehistory"
 	constraintsC               = "constraints"
 	containerRefsC             = "containerRefs"
 	controllersC               = "controllers"
@@ -639,8 +691,14 @@ const (
 	podSpecsC                  = "podSpecs"
 	providerIDsC               = "providerIDs"
 	rebootC                    = "reboot"
+	machinePatchStatusC        = "machinePatchStatus"
+	machineLXDProfileDriftC    = "machineLXDProfileDrift"
+	imageSigningKeysC          = "imageSigningKeys"
+	webhookEndpointsC          = "webhookEndpoints"
+	modelSnapshotsC            = "modelSnapshots"
 	relationScopesC            = "relationscopes"
 	relationsC                 = "relations"
+	removalOperationsC         = "removalOperations"
 	restoreInfoC               = "restoreInfo"
 	sequenceC                  = "sequence"
 	applicationsC              = "applications"
@@ -669,9 +727,11 @@ const (
 	userLastLoginC             = "userLastLogin"
 	usermodelnameC             = "usermodelname"
 	usersC                     = "users"
+	apiTokensC                 = "apiTokens"
 	volumeAttachmentsC         = "volumeattachments"
 	volumeAttachmentPlanC      = "volumeattachmentplan"
 	volumesC                   = "volumes"
+	workloadIdentityKeysC      = "workloadIdentityKeys"
 
 	// Cross model relations
 	applicationOffersC   = "applicationOffers"
@@ -0,0 +1,253 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/permission"
+)
+
+// apiTokenPrefix is the prefix of every API token credential string,
+// so the login path can tell tokens apart from passwords.
+const apiTokenPrefix = "pat-"
+
+// IsAPITokenCredential reports whether the given credential string
+// looks like a personal access token rather than a password.
+func IsAPITokenCredential(credential string) bool {
+	return strings.HasPrefix(credential, apiTokenPrefix)
+}
+
+// apiTokenDoc describes a personal access token minted for a local
+// user. Only a hash of the token secret is stored; the full credential
+// is shown to the user once, at creation time.
+type apiTokenDoc struct {
+	DocID   string     `bson:"_id"`
+	Name    string     `bson:"name"`
+	User    string     `bson:"user"`
+	Hash    string     `bson:"hash"`
+	Models  []string   `bson:"models,omitempty"`
+	Access  string     `bson:"access"`
+	Created time.Time  `bson:"created"`
+	Expiry  *time.Time `bson:"expiry,omitempty"`
+}
+
+// APIToken represents a personal access token that can be presented
+// in place of a password when logging in to the API.
+type APIToken struct {
+	st  *State
+	doc apiTokenDoc
+}
+
+// ID returns the unique identifier of the token.
+func (t *APIToken) ID() string {
+	return t.doc.DocID
+}
+
+// Name returns the user-assigned name of the token.
+func (t *APIToken) Name() string {
+	return t.doc.Name
+}
+
+// UserTag returns the tag of the user the token authenticates as.
+func (t *APIToken) UserTag() names.UserTag {
+	return names.NewUserTag(t.doc.User)
+}
+
+// Models returns the UUIDs of the models the token is restricted to.
+// An empty result means the token is valid for any model the user can
+// access.
+func (t *APIToken) Models() []string {
+	return t.doc.Models
+}
+
+// Access returns the maximum permission level the token grants on its
+// models, regardless of the user's own access.
+func (t *APIToken) Access() permission.Access {
+	return permission.Access(t.doc.Access)
+}
+
+// Created returns the time the token was minted.
+func (t *APIToken) Created() time.Time {
+	return t.doc.Created
+}
+
+// Expiry returns the time the token stops being accepted, or nil if
+// it does not expire.
+func (t *APIToken) Expiry() *time.Time {
+	return t.doc.Expiry
+}
+
+// Remove revokes the token. Logins presenting it fail as soon as the
+// removal commits.
+func (t *APIToken) Remove() error {
+	ops := []txn.Op{{
+		C:      apiTokensC,
+		Id:     t.doc.DocID,
+		Remove: true,
+	}}
+	return errors.Trace(t.st.db().RunTransaction(ops))
+}
+
+// AddAPITokenArgs holds the parameters for minting a new personal
+// access token.
+type AddAPITokenArgs struct {
+	// Name is a user-assigned name identifying the token, unique
+	// among the user's tokens.
+	Name string
+
+	// User is the local user the token authenticates as.
+	User names.UserTag
+
+	// Models restricts the token to the given model UUIDs. Empty
+	// means any model the user can access.
+	Models []string
+
+	// Access is the maximum permission level the token grants on its
+	// models.
+	Access permission.Access
+
+	// Expiry is the time the token stops being accepted. Nil means
+	// the token does not expire.
+	Expiry *time.Time
+}
+
+// AddAPIToken mints a new personal access token for a local user,
+// returning the token and the full credential string. The credential
+// cannot be recovered later; only its hash is stored.
+func (st *State) AddAPIToken(args AddAPITokenArgs) (*APIToken, string, error) {
+	if args.Name == "" {
+		return nil, "", errors.NotValidf("empty token name")
+	}
+	if !args.User.IsLocal() {
+		return nil, "", errors.NotValidf("API token for external user %q", args.User.Id())
+	}
+	if err := permission.ValidateModelAccess(args.Access); err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	if _, err := st.User(args.User); err != nil {
+		return nil, "", errors.Trace(err)
+	}
+
+	existing, err := st.APITokens(args.User)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	for _, token := range existing {
+		if token.Name() == args.Name {
+			return nil, "", errors.AlreadyExistsf("API token %q for user %q", args.Name, args.User.Id())
+		}
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	doc := apiTokenDoc{
+		DocID:   id,
+		Name:    args.Name,
+		User:    args.User.Id(),
+		Hash:    hashAPITokenSecret(secret),
+		Models:  args.Models,
+		Access:  string(args.Access),
+		Created: st.clock().Now().Round(time.Second).UTC(),
+		Expiry:  args.Expiry,
+	}
+	ops := []txn.Op{{
+		C:      apiTokensC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: &doc,
+	}}
+	if err := st.db().RunTransaction(ops); err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	token := &APIToken{st: st, doc: doc}
+	return token, fmt.Sprintf("%s%s-%s", apiTokenPrefix, id, secret), nil
+}
+
+// APITokenByCredential looks up the token identified by a full
+// credential string, verifying the embedded secret against the stored
+// hash. Unauthorized is returned for a malformed credential, an
+// unknown token or a secret mismatch, without distinguishing which.
+func (st *State) APITokenByCredential(credential string) (*APIToken, error) {
+	rest := strings.TrimPrefix(credential, apiTokenPrefix)
+	parts := strings.SplitN(rest, "-", 2)
+	if !IsAPITokenCredential(credential) || len(parts) != 2 {
+		return nil, errors.Unauthorizedf("invalid API token")
+	}
+	id, secret := parts[0], parts[1]
+
+	tokens, closer := st.db().GetCollection(apiTokensC)
+	defer closer()
+
+	var doc apiTokenDoc
+	if err := tokens.FindId(id).One(&doc); err != nil {
+		return nil, errors.Unauthorizedf("invalid API token")
+	}
+	hash := hashAPITokenSecret(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(doc.Hash)) != 1 {
+		return nil, errors.Unauthorizedf("invalid API token")
+	}
+	return &APIToken{st: st, doc: doc}, nil
+}
+
+// APITokens returns the personal access tokens minted for the given
+// user, ordered by name.
+func (st *State) APITokens(user names.UserTag) ([]*APIToken, error) {
+	tokens, closer := st.db().GetCollection(apiTokensC)
+	defer closer()
+
+	var docs []apiTokenDoc
+	err := tokens.Find(map[string]interface{}{"user": user.Id()}).Sort("name").All(&docs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]*APIToken, len(docs))
+	for i, doc := range docs {
+		results[i] = &APIToken{st: st, doc: doc}
+	}
+	return results, nil
+}
+
+// RemoveAPIToken revokes the named token belonging to the given user.
+func (st *State) RemoveAPIToken(user names.UserTag, name string) error {
+	tokens, err := st.APITokens(user)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, token := range tokens {
+		if token.Name() == name {
+			return errors.Trace(token.Remove())
+		}
+	}
+	return errors.NotFoundf("API token %q for user %q", name, user.Id())
+}
+
+func hashAPITokenSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func randomHex(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Trace(err)
+	}
+	return hex.EncodeToString(buf), nil
+}
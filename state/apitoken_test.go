@@ -0,0 +1,160 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type APITokenSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&APITokenSuite{})
+
+func (s *APITokenSuite) addToken(c *gc.C, args state.AddAPITokenArgs) (*state.APIToken, string) {
+	token, credential, err := s.State.AddAPIToken(args)
+	c.Assert(err, jc.ErrorIsNil)
+	return token, credential
+}
+
+func (s *APITokenSuite) TestAddAPIToken(c *gc.C) {
+	user := s.Factory.MakeUser(c, &factory.UserParams{Name: "ci"})
+	token, credential, err := s.State.AddAPIToken(state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Models: []string{s.State.ModelUUID()},
+		Access: permission.WriteAccess,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(state.IsAPITokenCredential(credential), jc.IsTrue)
+	c.Assert(token.Name(), gc.Equals, "deploy")
+	c.Assert(token.UserTag(), gc.Equals, user.UserTag())
+	c.Assert(token.Models(), jc.DeepEquals, []string{s.State.ModelUUID()})
+	c.Assert(token.Access(), gc.Equals, permission.WriteAccess)
+	c.Assert(token.Expiry(), gc.IsNil)
+}
+
+func (s *APITokenSuite) TestAddAPITokenEmptyName(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	_, _, err := s.State.AddAPIToken(state.AddAPITokenArgs{
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	c.Assert(err, gc.ErrorMatches, "empty token name not valid")
+}
+
+func (s *APITokenSuite) TestAddAPITokenInvalidAccess(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	_, _, err := s.State.AddAPIToken(state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.SuperuserAccess,
+	})
+	c.Assert(err, gc.ErrorMatches, `.*"superuser" model access not valid`)
+}
+
+func (s *APITokenSuite) TestAddAPITokenDuplicateName(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	s.addToken(c, state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	_, _, err := s.State.AddAPIToken(state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	c.Assert(err, gc.ErrorMatches, `API token "deploy" for user ".*" already exists`)
+}
+
+func (s *APITokenSuite) TestAPITokenByCredential(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	token, credential := s.addToken(c, state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	found, err := s.State.APITokenByCredential(credential)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.ID(), gc.Equals, token.ID())
+	c.Assert(found.UserTag(), gc.Equals, user.UserTag())
+}
+
+func (s *APITokenSuite) TestAPITokenByCredentialBadSecret(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	token, _ := s.addToken(c, state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	_, err := s.State.APITokenByCredential("pat-" + token.ID() + "-wrongsecret")
+	c.Assert(err, gc.ErrorMatches, "invalid API token")
+}
+
+func (s *APITokenSuite) TestAPITokenByCredentialMalformed(c *gc.C) {
+	for _, credential := range []string{"", "password", "pat-", "pat-lonely"} {
+		_, err := s.State.APITokenByCredential(credential)
+		c.Assert(err, gc.ErrorMatches, "invalid API token")
+	}
+}
+
+func (s *APITokenSuite) TestAPITokensSortedByName(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	for _, name := range []string{"release", "deploy", "status"} {
+		s.addToken(c, state.AddAPITokenArgs{
+			Name:   name,
+			User:   user.UserTag(),
+			Access: permission.ReadAccess,
+		})
+	}
+	tokens, err := s.State.APITokens(user.UserTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tokens, gc.HasLen, 3)
+	c.Assert(tokens[0].Name(), gc.Equals, "deploy")
+	c.Assert(tokens[1].Name(), gc.Equals, "release")
+	c.Assert(tokens[2].Name(), gc.Equals, "status")
+}
+
+func (s *APITokenSuite) TestRemoveAPIToken(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	_, credential := s.addToken(c, state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+	})
+	err := s.State.RemoveAPIToken(user.UserTag(), "deploy")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.APITokenByCredential(credential)
+	c.Assert(err, gc.ErrorMatches, "invalid API token")
+
+	err = s.State.RemoveAPIToken(user.UserTag(), "deploy")
+	c.Assert(err, gc.ErrorMatches, `API token "deploy" for user ".*" not found`)
+}
+
+func (s *APITokenSuite) TestExpiryStored(c *gc.C) {
+	user := s.Factory.MakeUser(c, nil)
+	expiry := time.Now().Add(time.Hour).Round(time.Second).UTC()
+	token, _ := s.addToken(c, state.AddAPITokenArgs{
+		Name:   "deploy",
+		User:   user.UserTag(),
+		Access: permission.ReadAccess,
+		Expiry: &expiry,
+	})
+	tokens, err := s.State.APITokens(user.UserTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tokens, gc.HasLen, 1)
+	c.Assert(tokens[0].ID(), gc.Equals, token.ID())
+	c.Assert(tokens[0].Expiry(), gc.NotNil)
+	c.Assert(tokens[0].Expiry().Equal(expiry), jc.IsTrue)
+}
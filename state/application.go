@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/juju/charm/v8"
 	csparams "github.com/juju/charmrepo/v6/csclient/params"
@@ -93,9 +94,20 @@ type applicationDoc struct {
 	UnitCount            int          `bson:"unitcount"`
 	RelationCount        int          `bson:"relationcount"`
 	MinUnits             int          `bson:"minunits"`
-	Tools                *tools.Tools `bson:",omitempty"`
-	TxnRevno             int64        `bson:"txn-revno"`
-	MetricCredentials    []byte       `bson:"metric-credentials"`
+	// AssignmentStrategy controls how units without explicit placement
+	// are assigned to machines.
+	AssignmentStrategy AssignmentStrategy `bson:"assignment-strategy,omitempty"`
+	// ImageChannel, if set, pins the base image channel used when
+	// provisioning machines dedicated to this application, overriding
+	// the model default. See Application.SetImageChannel.
+	ImageChannel string `bson:"image-channel,omitempty"`
+	// NICRequests holds the network interfaces that machines
+	// provisioned for this application must be equipped with.
+	// See Application.SetNICRequests.
+	NICRequests       []nicRequestDoc `bson:"nic-requests,omitempty"`
+	Tools             *tools.Tools    `bson:",omitempty"`
+	TxnRevno          int64           `bson:"txn-revno"`
+	MetricCredentials []byte          `bson:"metric-credentials"`
 
 	// Exposed is set to true when the application is exposed.
 	Exposed bool `bson:"exposed"`
@@ -105,6 +117,16 @@ type applicationDoc struct {
 	// represents all application endpoints.
 	ExposedEndpoints map[string]ExposedEndpoint `bson:"exposed-endpoints,omitempty"`
 
+	// ExposeExpiry, when set, holds the time at which the exposed flag
+	// will be cleared again automatically. It is only ever set while the
+	// application is exposed. See Application.SetExposeDuration.
+	ExposeExpiry *time.Time `bson:"expose-expiry,omitempty"`
+
+	// TeardownAfter holds the names of applications that must be
+	// removed from the model before this application can be
+	// destroyed. See Application.SetTeardownAfter.
+	TeardownAfter []string `bson:"teardown-after,omitempty"`
+
 	// CAAS related attributes.
 	DesiredScale int    `bson:"scale"`
 	PasswordHash string `bson:"passwordhash"`
@@ -335,9 +357,24 @@ func (op *DestroyApplicationOperation) Build(attempt int) ([]txn.Op, error) {
 	return nil, err
 }
 
+// errTeardownWaiting reports that a declared teardown ordering is
+// blocking destruction of an application.
+type errTeardownWaiting struct {
+	waitingFor string
+}
+
+func (e *errTeardownWaiting) Error() string {
+	return fmt.Sprintf("waiting for application %q to be removed first", e.waitingFor)
+}
+
 // Done is part of the ModelOperation interface.
 func (op *DestroyApplicationOperation) Done(err error) error {
 	if err == nil {
+		if op.Force {
+			if err := op.app.st.EnsureRemovalOperation(op.app.Tag(), true); err != nil {
+				logger.Warningf("cannot record removal operation for application %q: %v", op.app, err)
+			}
+		}
 		if err := op.eraseHistory(); err != nil {
 			if !op.Force {
 				logger.Errorf("cannot delete history for application %q: %v", op.app, err)
@@ -346,6 +383,9 @@ func (op *DestroyApplicationOperation) Done(err error) error {
 		}
 		return nil
 	}
+	if _, ok := errors.Cause(err).(*errTeardownWaiting); ok {
+		return errors.Annotatef(err, "cannot destroy application %q", op.app)
+	}
 	connected, err2 := applicationHasConnectedOffers(op.app.st, op.app.Name())
 	if err2 != nil {
 		err = errors.Trace(err2)
@@ -450,6 +490,25 @@ func (op *DestroyApplicationOperation) destroyOps() ([]txn.Op, error) {
 	}
 	ops = append(ops, removeUnitAssignmentOps...)
 
+	// Respect any declared teardown ordering: without force, the
+	// application cannot be destroyed while the applications it is
+	// waiting for are still in the model. The asserts keep the
+	// decision stable if one of them reappears mid-transaction.
+	if !op.Force {
+		for _, name := range op.app.doc.TeardownAfter {
+			if _, err := op.app.st.Application(name); err == nil {
+				return nil, &errTeardownWaiting{waitingFor: name}
+			} else if !errors.IsNotFound(err) {
+				return nil, errors.Trace(err)
+			}
+			ops = append(ops, txn.Op{
+				C:      applicationsC,
+				Id:     op.app.st.docID(name),
+				Assert: txn.DocMissing,
+			})
+		}
+	}
+
 	// We can't delete an application if it is being offered,
 	// unless those offers have no relations.
 	if !op.RemoveOffers {
@@ -881,16 +940,62 @@ func (a *Application) setExposed(exposed bool, exposedEndpoints map[string]Expos
 		C:      applicationsC,
 		Id:     a.doc.DocID,
 		Assert: isAliveDoc,
-		Update: bson.D{{"$set", bson.D{
-			{"exposed", exposed},
-			{"exposed-endpoints", exposedEndpoints},
-		}}},
+		Update: bson.D{
+			{"$set", bson.D{
+				{"exposed", exposed},
+				{"exposed-endpoints", exposedEndpoints},
+			}},
+			// Any expose expiry only makes sense for the expose
+			// settings it was requested with; reset it here and
+			// let callers opt back in via SetExposeDuration.
+			{"$unset", bson.D{{"expose-expiry", nil}}},
+		},
 	}}
 	if err := a.st.db().RunTransaction(ops); err != nil {
 		return errors.Errorf("cannot set exposed flag for application %q to %v: %v", a, exposed, onAbort(err, applicationNotAliveErr))
 	}
 	a.doc.Exposed = exposed
 	a.doc.ExposedEndpoints = exposedEndpoints
+	a.doc.ExposeExpiry = nil
+	return nil
+}
+
+// TeardownAfter returns the names of the applications that must be
+// removed from the model before this application can be destroyed.
+func (a *Application) TeardownAfter() []string {
+	return append([]string(nil), a.doc.TeardownAfter...)
+}
+
+// SetTeardownAfter records the names of the applications that must be
+// removed from the model before this application can be destroyed.
+// Destroying the application without force fails while any of them
+// remain, so teardown of the model's applications - whether requested
+// directly or driven by model destruction - proceeds in the declared
+// order. An empty list clears any previously recorded ordering.
+func (a *Application) SetTeardownAfter(appNames []string) error {
+	after := uniqueSortedStrings(appNames)
+	for _, name := range after {
+		if name == a.doc.Name {
+			return errors.NotValidf("application %q waiting for itself", name)
+		}
+		if _, err := a.st.Application(name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	update := bson.D{{"$unset", bson.D{{"teardown-after", nil}}}}
+	if len(after) > 0 {
+		update = bson.D{{"$set", bson.D{{"teardown-after", after}}}}
+	}
+	ops := []txn.Op{{
+		C:      applicationsC,
+		Id:     a.doc.DocID,
+		Assert: isAliveDoc,
+		Update: update,
+	}}
+	if err := a.st.db().RunTransaction(ops); err != nil {
+		return errors.Errorf("cannot set teardown order for application %q: %v", a, onAbort(err, applicationNotAliveErr))
+	}
+	a.doc.TeardownAfter = after
 	return nil
 }
 
@@ -2258,6 +2363,12 @@ type applicationAddUnitOpsArgs struct {
 	storageCons   map[string]StorageConstraints
 	attachStorage []names.StorageTag
 
+	// pendingSharedStorage carries shared storage instance docs
+	// created for other units in the same transaction, so that units
+	// of an initial deployment share instances rather than each
+	// creating their own. It may be nil when only one unit is added.
+	pendingSharedStorage map[string][]*storageInstanceDoc
+
 	// These optional attributes are relevant to CAAS models.
 	providerId *string
 	address    *string
@@ -2469,6 +2580,21 @@ func (a *Application) addUnitStorageOps(
 		numStorageAttachments++
 		storageTags[si.StorageName()] = append(storageTags[si.StorageName()], storageTag)
 	}
+	pendingShared := args.pendingSharedStorage
+	if pendingShared == nil {
+		pendingShared = make(map[string][]*storageInstanceDoc)
+	}
+	sharedOps, sharedTags, sharedAttachments, err := applicationSharedStorageOps(
+		sb, a, unitTag, charm, args.storageCons, machineAssignable, pendingShared,
+	)
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	storageOps = append(storageOps, sharedOps...)
+	numStorageAttachments += sharedAttachments
+	for name, tags := range sharedTags {
+		storageTags[name] = append(storageTags[name], tags...)
+	}
 	for name, tags := range storageTags {
 		count := len(tags)
 		charmStorage := charm.Meta().Storage[name]
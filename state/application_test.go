@@ -5322,3 +5322,52 @@ func (s *ApplicationSuite) TestWatch(c *gc.C) {
 
 	wc.AssertChange("e1471e8a7299da0ac2150445ffc6d08d9d801194037d88416c54b01899b8a9b2")
 }
+
+func (s *ApplicationSuite) TestSetTeardownAfter(c *gc.C) {
+	s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	c.Assert(s.mysql.TeardownAfter(), gc.HasLen, 0)
+
+	err := s.mysql.SetTeardownAfter([]string{"wordpress"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.TeardownAfter(), jc.DeepEquals, []string{"wordpress"})
+
+	err = s.mysql.SetTeardownAfter([]string{"mysql"})
+	c.Assert(err, gc.ErrorMatches, `application "mysql" waiting for itself not valid`)
+	err = s.mysql.SetTeardownAfter([]string{"unknown"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = s.mysql.SetTeardownAfter(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.TeardownAfter(), gc.HasLen, 0)
+}
+
+func (s *ApplicationSuite) TestDestroyRespectsTeardownAfter(c *gc.C) {
+	wordpress := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := s.mysql.SetTeardownAfter([]string{"wordpress"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.mysql.Destroy()
+	c.Assert(err, gc.ErrorMatches, `cannot destroy application "mysql": waiting for application "wordpress" to be removed first`)
+	c.Assert(s.mysql.Refresh(), jc.ErrorIsNil)
+	c.Assert(s.mysql.Life(), gc.Equals, state.Alive)
+
+	err = wordpress.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ApplicationSuite) TestDestroyWithForceIgnoresTeardownAfter(c *gc.C) {
+	s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := s.mysql.SetTeardownAfter([]string{"wordpress"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	op := s.mysql.DestroyOperation()
+	op.Force = true
+	err = s.State.ApplyOperation(op)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
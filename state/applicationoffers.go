@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/juju/charm/v8"
 	"github.com/juju/collections/set"
@@ -54,6 +55,10 @@ type applicationOfferDoc struct {
 	// Endpoints are the charm endpoints supported by the application.
 	Endpoints map[string]string `bson:"endpoints"`
 
+	// MacaroonsRotatedAt, when non-zero, is the time (in UnixNano) before
+	// which macaroons issued for this offer are considered invalid.
+	MacaroonsRotatedAt int64 `bson:"macaroons-rotated-at,omitempty"`
+
 	// TxnRevno is used to assert the collection have not changed since this
 	// document was fetched.
 	TxnRevno int64 `bson:"txn-revno,omitempty"`
@@ -565,14 +570,14 @@ func (s *applicationOffers) AddOffer(offerArgs crossmodel.AddApplicationOfferArg
 	// Ensure the owner has admin access to the offer.
 	offerTag := names.NewApplicationOfferTag(doc.OfferName)
 	owner := names.NewUserTag(offerArgs.Owner)
-	err = s.st.CreateOfferAccess(offerTag, owner, permission.AdminAccess)
+	err = s.st.CreateOfferAccess(offerTag, owner, permission.AdminAccess, nil)
 	if err != nil {
 		return nil, errors.Annotate(err, "granting admin permission to the offer owner")
 	}
 	// Add in any read access permissions.
 	for _, user := range offerArgs.HasRead {
 		readerTag := names.NewUserTag(user)
-		err = s.st.CreateOfferAccess(offerTag, readerTag, permission.ReadAccess)
+		err = s.st.CreateOfferAccess(offerTag, readerTag, permission.ReadAccess, nil)
 		if err != nil {
 			return nil, errors.Annotatef(err, "granting read permission to %q", user)
 		}
@@ -974,3 +979,49 @@ func (st *State) WatchOffer(offerName string) NotifyWatcher {
 	}
 	return newNotifyCollWatcher(st, applicationOffersC, filter)
 }
+
+// RotateOfferMacaroons records the current time against the offer with
+// the given UUID. Macaroons issued for the offer before this time are
+// treated as invalid, forcing consuming controllers to discharge fresh
+// macaroons before their next use.
+func (st *State) RotateOfferMacaroons(offerUUID string) (time.Time, error) {
+	rotated := st.clock().Now()
+	offers := &applicationOffers{st}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		doc, err := offers.offerQuery(bson.D{{"offer-uuid", offerUUID}})
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				return nil, errors.NotFoundf("offer %q", offerUUID)
+			}
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:      applicationOffersC,
+			Id:     doc.DocID,
+			Assert: txn.DocExists,
+			Update: bson.M{"$set": bson.M{"macaroons-rotated-at": rotated.UnixNano()}},
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	return rotated, nil
+}
+
+// OfferMacaroonsRotatedAt returns the time before which macaroons issued
+// for the offer with the given UUID are considered invalid. A zero time
+// is returned if the offer's macaroons have never been rotated.
+func (st *State) OfferMacaroonsRotatedAt(offerUUID string) (time.Time, error) {
+	offers := &applicationOffers{st}
+	doc, err := offers.offerQuery(bson.D{{"offer-uuid", offerUUID}})
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return time.Time{}, errors.NotFoundf("offer %q", offerUUID)
+		}
+		return time.Time{}, errors.Trace(err)
+	}
+	if doc.MacaroonsRotatedAt == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, doc.MacaroonsRotatedAt), nil
+}
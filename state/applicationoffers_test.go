@@ -346,10 +346,10 @@ func (s *applicationOffersSuite) TestListOffersAllowedConsumers(c *gc.C) {
 
 	mary := names.NewUserTag("mary")
 	err := s.State.CreateOfferAccess(
-		names.NewApplicationOfferTag(offer.OfferName), mary, permission.ConsumeAccess)
+		names.NewApplicationOfferTag(offer.OfferName), mary, permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.State.CreateOfferAccess(
-		names.NewApplicationOfferTag(offer2.OfferName), mary, permission.ReadAccess)
+		names.NewApplicationOfferTag(offer2.OfferName), mary, permission.ReadAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	offers, err := sd.ListOffers(crossmodel.ApplicationOfferFilter{
 		AllowedConsumers: []string{"mary"},
@@ -919,3 +919,26 @@ func (s *applicationOffersSuite) TestWatchOffer(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	wc.AssertNoChange()
 }
+
+func (s *applicationOffersSuite) TestRotateOfferMacaroons(c *gc.C) {
+	offer := s.createDefaultOffer(c)
+
+	rotatedAt, err := s.State.OfferMacaroonsRotatedAt(offer.OfferUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotatedAt.IsZero(), jc.IsTrue)
+
+	rotated, err := s.State.RotateOfferMacaroons(offer.OfferUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated.IsZero(), jc.IsFalse)
+
+	rotatedAt, err = s.State.OfferMacaroonsRotatedAt(offer.OfferUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotatedAt.UnixNano(), gc.Equals, rotated.UnixNano())
+}
+
+func (s *applicationOffersSuite) TestRotateOfferMacaroonsNotFound(c *gc.C) {
+	_, err := s.State.RotateOfferMacaroons("not-an-offer-uuid")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	_, err = s.State.OfferMacaroonsRotatedAt("not-an-offer-uuid")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
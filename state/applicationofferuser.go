@@ -4,6 +4,8 @@
 package state
 
 import (
+	"time"
+
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/mgo/v2/bson"
@@ -19,6 +21,10 @@ func (st *State) GetOfferAccess(offerUUID string, user names.UserTag) (permissio
 	if err != nil {
 		return "", errors.Trace(err)
 	}
+	if expiry, ok := perm.expiresAt(); ok && !st.stateClock.Now().Before(expiry) {
+		// The grant has lapsed but has not been cleaned up yet.
+		return "", errors.NotFoundf("user permission for %q on %q", user.Id(), offerUUID)
+	}
 	return perm.access(), nil
 }
 
@@ -35,11 +41,16 @@ func (st *State) GetOfferUsers(offerUUID string) (map[string]permission.Access,
 	return result, nil
 }
 
-// CreateOfferAccess creates a new access permission for a user on an offer.
-func (st *State) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access) error {
+// CreateOfferAccess creates a new access permission for a user on an
+// offer. If expiresAt is set the grant lapses and is removed again at
+// that time.
+func (st *State) CreateOfferAccess(offer names.ApplicationOfferTag, user names.UserTag, access permission.Access, expiresAt *time.Time) error {
 	if err := permission.ValidateOfferAccess(access); err != nil {
 		return errors.Trace(err)
 	}
+	if expiresAt != nil && !expiresAt.After(st.stateClock.Now()) {
+		return errors.NotValidf("access expiry time %v in the past", expiresAt.UTC())
+	}
 
 	// Local users must exist.
 	if user.IsLocal() {
@@ -56,9 +67,15 @@ func (st *State) CreateOfferAccess(offer names.ApplicationOfferTag, user names.U
 	if err != nil {
 		return errors.Annotate(err, "creating offer access")
 	}
-	op := createPermissionOp(applicationOfferKey(offerUUID), userGlobalKey(userAccessID(user)), access)
+	ops := []txn.Op{
+		createPermissionWithExpiryOp(applicationOfferKey(offerUUID), userGlobalKey(userAccessID(user)), access, expiresAt),
+	}
+	if expiresAt != nil {
+		ops = append(ops, newCleanupAtOp(
+			*expiresAt, cleanupExpiredAccessGrant, user.Id(), offer.String(), *expiresAt))
+	}
 
-	err = st.db().RunTransaction([]txn.Op{op})
+	err = st.db().RunTransaction(ops)
 	if err == txn.ErrAborted {
 		err = errors.AlreadyExistsf("permission for user %q for offer %q", user.Id(), offer.Name)
 	}
@@ -173,7 +190,10 @@ func (st *State) RemoveOfferAccess(offer names.ApplicationOfferTag, user names.U
 	}
 
 	buildTxn := func(int) ([]txn.Op, error) {
-		_, err := st.GetOfferAccess(offerUUID, user)
+		// Look up the raw permission rather than GetOfferAccess so
+		// that an expired grant can still be removed before the
+		// scheduled cleanup runs.
+		_, err := st.userPermission(applicationOfferKey(offerUUID), userGlobalKey(userAccessID(user)))
 		if err != nil {
 			return nil, err
 		}
@@ -44,7 +44,7 @@ func (s *ApplicationOfferUserSuite) makeOffer(c *gc.C, access permission.Access)
 	_, err = s.State.GetOfferAccess(offer.OfferUUID, user.UserTag())
 	c.Assert(err, jc.Satisfies, errors.IsNotFound)
 
-	err = s.State.CreateOfferAccess(names.NewApplicationOfferTag(offer.OfferName), user.UserTag(), access)
+	err = s.State.CreateOfferAccess(names.NewApplicationOfferTag(offer.OfferName), user.UserTag(), access, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	return offer, user.UserTag()
 }
@@ -186,7 +186,7 @@ func (s *ApplicationOfferUserSuite) TestCreateOfferAccessNoUserFails(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.State.CreateOfferAccess(
 		names.NewApplicationOfferTag("someoffer"),
-		names.NewUserTag("validusername"), permission.ReadAccess)
+		names.NewUserTag("validusername"), permission.ReadAccess, nil)
 	c.Assert(err, gc.ErrorMatches, `user "validusername" does not exist locally: user "validusername" not found`)
 }
 
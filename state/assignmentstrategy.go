@@ -0,0 +1,187 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// AssignmentStrategy controls how units of an application that have no
+// explicit placement are assigned to machines.
+type AssignmentStrategy string
+
+const (
+	// AssignmentStrategyDefault preserves the long-standing behaviour:
+	// reuse a clean, empty machine if one exists, else add a new one.
+	AssignmentStrategyDefault AssignmentStrategy = ""
+
+	// AssignmentStrategyPack reuses any clean machine, even one already
+	// hosting units of other applications, packing the model onto as
+	// few machines as possible.
+	AssignmentStrategyPack AssignmentStrategy = "pack"
+
+	// AssignmentStrategySpreadByZone prefers clean, empty machines in
+	// the availability zone hosting the fewest units of the
+	// application.
+	AssignmentStrategySpreadByZone AssignmentStrategy = "spread-by-zone"
+
+	// AssignmentStrategySpreadByMachine places every unit on its own
+	// new machine.
+	AssignmentStrategySpreadByMachine AssignmentStrategy = "spread-by-machine"
+)
+
+// Validate returns an error if the strategy is not recognised.
+func (s AssignmentStrategy) Validate() error {
+	switch s {
+	case AssignmentStrategyDefault, AssignmentStrategyPack,
+		AssignmentStrategySpreadByZone, AssignmentStrategySpreadByMachine:
+		return nil
+	}
+	return errors.NotValidf("assignment strategy %q", s)
+}
+
+// AssignmentStrategy returns the strategy used to assign units of the
+// application that have no explicit placement.
+func (a *Application) AssignmentStrategy() AssignmentStrategy {
+	return a.doc.AssignmentStrategy
+}
+
+// SetAssignmentStrategy sets the strategy used to assign units of the
+// application that have no explicit placement. Units already assigned
+// are not moved.
+func (a *Application) SetAssignmentStrategy(strategy AssignmentStrategy) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set assignment strategy for application %q", a)
+	defer func() {
+		if err == nil {
+			a.doc.AssignmentStrategy = strategy
+		}
+	}()
+	if err := strategy.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	app := &Application{st: a.st, doc: a.doc}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := app.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if app.doc.Life != Alive {
+			return nil, errors.New("application is no longer alive")
+		}
+		if strategy == app.doc.AssignmentStrategy {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+			Update: bson.D{{"$set", bson.D{{"assignment-strategy", strategy}}}},
+		}}, nil
+	}
+	return a.st.db().Run(buildTxn)
+}
+
+// assignUnitWithStrategy assigns a unit without explicit placement
+// according to its application's assignment strategy.
+func (st *State) assignUnitWithStrategy(u *Unit, strategy AssignmentStrategy) error {
+	switch strategy {
+	case AssignmentStrategyDefault:
+		return errors.Trace(st.AssignUnit(u, AssignCleanEmpty))
+	case AssignmentStrategyPack:
+		return errors.Trace(st.AssignUnit(u, AssignClean))
+	case AssignmentStrategySpreadByMachine:
+		return errors.Trace(st.AssignUnit(u, AssignNew))
+	case AssignmentStrategySpreadByZone:
+		return errors.Trace(st.assignUnitSpreadByZone(u))
+	}
+	return errors.NotValidf("assignment strategy %q", strategy)
+}
+
+// assignUnitSpreadByZone assigns the unit to a clean, empty machine in
+// the availability zone currently hosting the fewest units of the unit's
+// application, falling back to a new machine when no candidate fits.
+func (st *State) assignUnitSpreadByZone(u *Unit) error {
+	zoneCounts, err := st.applicationZoneCounts(u)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	cons, err := u.Constraints()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	query, err := u.findCleanMachineQuery(true, cons)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	machinesCollection, closer := st.db().GetCollection(machinesC)
+	defer closer()
+	var mdocs []*machineDoc
+	if err := machinesCollection.Find(query).All(&mdocs); err != nil {
+		return errors.Trace(err)
+	}
+
+	type candidate struct {
+		machine *Machine
+		count   int
+	}
+	candidates := make([]candidate, 0, len(mdocs))
+	for _, mdoc := range mdocs {
+		m := newMachine(st, mdoc)
+		zone, err := m.AvailabilityZone()
+		if err != nil {
+			// Unprovisioned machines have no zone yet; treat them
+			// as belonging to an empty zone.
+			zone = ""
+		}
+		candidates = append(candidates, candidate{m, zoneCounts[zone]})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].count < candidates[j].count
+	})
+
+	for _, c := range candidates {
+		err := u.AssignToMachine(c.machine)
+		if err == nil {
+			return nil
+		}
+		// Racing assignments may have dirtied the machine; move on to
+		// the next candidate.
+		logger.Debugf("cannot assign unit %q to machine %q: %v", u.Name(), c.machine.Id(), err)
+	}
+	return errors.Trace(u.AssignToNewMachineOrContainer())
+}
+
+// applicationZoneCounts returns the number of the unit's application's
+// other units in each availability zone.
+func (st *State) applicationZoneCounts(u *Unit) (map[string]int, error) {
+	app, err := u.Application()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	units, err := app.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	counts := make(map[string]int)
+	for _, other := range units {
+		if other.Name() == u.Name() {
+			continue
+		}
+		zone, err := other.AvailabilityZone()
+		if err != nil {
+			// Unassigned or unprovisioned units don't count
+			// towards any zone.
+			continue
+		}
+		counts[zone]++
+	}
+	return counts, nil
+}
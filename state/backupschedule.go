@@ -0,0 +1,188 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// backupScheduleKey is the id of the controller document holding the
+// automated backup schedule, if one has been configured.
+const backupScheduleKey = "backupSchedule"
+
+type backupScheduleDoc struct {
+	Cron           string `bson:"cron"`
+	RetentionCount int    `bson:"retention-count"`
+	UploadTarget   string `bson:"upload-target,omitempty"`
+
+	LastRun      int64  `bson:"last-run,omitempty"`
+	LastBackupID string `bson:"last-backup-id,omitempty"`
+	LastRunError string `bson:"last-run-error,omitempty"`
+}
+
+// BackupSchedule describes the automated backup schedule for the
+// controller.
+type BackupSchedule struct {
+	// Cron is the cron expression controlling when backups are taken.
+	Cron string
+
+	// RetentionCount is the number of scheduled backups to keep;
+	// older scheduled backups are removed as new ones are created.
+	// Zero means keep them all.
+	RetentionCount int
+
+	// UploadTarget is an optional destination, such as a pre-signed
+	// S3 or Azure Blob URL, that each archive is uploaded to after
+	// creation. Empty means the archive is only kept locally.
+	UploadTarget string
+}
+
+// BackupScheduleStatus records the outcome of the most recent
+// scheduled backup run.
+type BackupScheduleStatus struct {
+	// LastRun is when the most recent scheduled backup ran.
+	LastRun time.Time
+
+	// LastBackupID identifies the backup created by the most recent
+	// successful run.
+	LastBackupID string
+
+	// LastRunError holds the failure message of the most recent run,
+	// empty if it succeeded.
+	LastRunError string
+}
+
+// BackupSchedule returns the configured automated backup schedule, or
+// an error satisfying errors.IsNotFound if none is configured.
+func (st *State) BackupSchedule() (*BackupSchedule, error) {
+	doc, err := st.backupScheduleDoc()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &BackupSchedule{
+		Cron:           doc.Cron,
+		RetentionCount: doc.RetentionCount,
+		UploadTarget:   doc.UploadTarget,
+	}, nil
+}
+
+// BackupScheduleStatus returns the status of the most recent scheduled
+// backup run, or an error satisfying errors.IsNotFound if no schedule
+// is configured.
+func (st *State) BackupScheduleStatus() (*BackupScheduleStatus, error) {
+	doc, err := st.backupScheduleDoc()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	status := &BackupScheduleStatus{
+		LastBackupID: doc.LastBackupID,
+		LastRunError: doc.LastRunError,
+	}
+	if doc.LastRun != 0 {
+		status.LastRun = time.Unix(0, doc.LastRun)
+	}
+	return status, nil
+}
+
+func (st *State) backupScheduleDoc() (*backupScheduleDoc, error) {
+	controllers, closer := st.db().GetCollection(controllersC)
+	defer closer()
+	var doc backupScheduleDoc
+	err := controllers.FindId(backupScheduleKey).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("backup schedule")
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &doc, nil
+}
+
+// SetBackupSchedule configures the automated backup schedule, replacing
+// any existing schedule. The status of previous runs is preserved.
+// Cron expression validation is the caller's responsibility; state only
+// requires that one is supplied.
+func (st *State) SetBackupSchedule(schedule BackupSchedule) error {
+	if schedule.Cron == "" {
+		return errors.NotValidf("empty cron expression")
+	}
+	if schedule.RetentionCount < 0 {
+		return errors.NotValidf("negative retention count")
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		_, err := st.backupScheduleDoc()
+		if errors.IsNotFound(err) {
+			return []txn.Op{{
+				C:      controllersC,
+				Id:     backupScheduleKey,
+				Assert: txn.DocMissing,
+				Insert: &backupScheduleDoc{
+					Cron:           schedule.Cron,
+					RetentionCount: schedule.RetentionCount,
+					UploadTarget:   schedule.UploadTarget,
+				},
+			}}, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:      controllersC,
+			Id:     backupScheduleKey,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"cron", schedule.Cron},
+				{"retention-count", schedule.RetentionCount},
+				{"upload-target", schedule.UploadTarget},
+			}}},
+		}}, nil
+	}
+	return errors.Trace(st.db().Run(buildTxn))
+}
+
+// RemoveBackupSchedule removes the automated backup schedule. It is
+// not an error to remove a schedule that does not exist.
+func (st *State) RemoveBackupSchedule() error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if _, err := st.backupScheduleDoc(); errors.IsNotFound(err) {
+			return nil, jujutxn.ErrNoOperations
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:      controllersC,
+			Id:     backupScheduleKey,
+			Assert: txn.DocExists,
+			Remove: true,
+		}}, nil
+	}
+	return errors.Trace(st.db().Run(buildTxn))
+}
+
+// SetBackupScheduleStatus records the outcome of a scheduled backup
+// run. It returns an error satisfying errors.IsNotFound if no schedule
+// is configured.
+func (st *State) SetBackupScheduleStatus(status BackupScheduleStatus) error {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if _, err := st.backupScheduleDoc(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:      controllersC,
+			Id:     backupScheduleKey,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"last-run", status.LastRun.UnixNano()},
+				{"last-backup-id", status.LastBackupID},
+				{"last-run-error", status.LastRunError},
+			}}},
+		}}, nil
+	}
+	return errors.Trace(st.db().Run(buildTxn))
+}
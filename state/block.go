@@ -176,6 +176,21 @@ func (st *State) SwitchBlockOff(t BlockType) error {
 //	found -> block, true, nil
 //	error -> nil, false, err
 func (st *State) GetBlockForType(t BlockType) (Block, bool, error) {
+	m, err := st.Model()
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	// A frozen model reports a block for every type, so that all
+	// block-checked operations are refused while the freeze lasts.
+	if m.FreezeMode() == model.FreezeReadOnly {
+		return &block{blockDoc{
+			DocID:     st.docID("freeze"),
+			ModelUUID: st.modelUUID(),
+			Tag:       names.NewModelTag(st.modelUUID()).String(),
+			Type:      t,
+			Message:   "model is frozen (read-only)",
+		}}, true, nil
+	}
 	return getBlockForType(st, t)
 }
 
@@ -315,7 +330,7 @@ func RemoveModelBlock(st *State, t BlockType) error {
 }
 
 func RemoveModelBlockOps(st *State, t BlockType) ([]txn.Op, error) {
-	tBlock, exists, err := st.GetBlockForType(t)
+	tBlock, exists, err := getBlockForType(st, t)
 	if err != nil {
 		return nil, errors.Annotatef(err, "removing block %v", t.String())
 	}
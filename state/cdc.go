@@ -0,0 +1,138 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/state/watcher"
+)
+
+// CDC (change data capture) events let external systems mirror Juju
+// entities without polling status. Committed document changes on the
+// collections below are published as a stream of events identifying the
+// entity kind, its id and the operation observed.
+
+// cdcKinds maps the watched collections to the entity kind published in
+// the change stream.
+var cdcKinds = map[string]string{
+	machinesC:     "machine",
+	applicationsC: "application",
+	unitsC:        "unit",
+	relationsC:    "relation",
+	charmsC:       "charm",
+}
+
+const (
+	// CDCOperationChanged indicates the entity was created or updated.
+	// The transaction log does not distinguish the two; consumers treat
+	// the first sighting of an id as creation.
+	CDCOperationChanged = "changed"
+
+	// CDCOperationRemoved indicates the entity was removed.
+	CDCOperationRemoved = "removed"
+)
+
+// CDCEvent describes a single committed change to a state document.
+type CDCEvent struct {
+	// Kind is the entity kind, eg "machine" or "application".
+	Kind string `json:"kind"`
+
+	// Id is the entity's id within the model.
+	Id string `json:"id"`
+
+	// Operation is one of CDCOperationChanged or CDCOperationRemoved.
+	Operation string `json:"operation"`
+}
+
+// WatchCDCEvents returns a watcher that emits a JSON-encoded CDCEvent for
+// every committed change to the watched collections in the model. Unlike
+// the entity watchers there is no initial listing: the stream starts at
+// the point of watching.
+func (st *State) WatchCDCEvents() StringsWatcher {
+	w := &cdcWatcher{
+		commonWatcher: newCommonWatcher(st),
+		source:        make(chan watcher.Change),
+		sink:          make(chan []string),
+	}
+	w.tomb.Go(func() error {
+		defer close(w.sink)
+		defer close(w.source)
+		return w.loop()
+	})
+	return w
+}
+
+type cdcWatcher struct {
+	commonWatcher
+	source chan watcher.Change
+	sink   chan []string
+}
+
+// Changes is part of the StringsWatcher interface.
+func (w *cdcWatcher) Changes() <-chan []string {
+	return w.sink
+}
+
+func (w *cdcWatcher) loop() error {
+	filter := isLocalID(w.backend)
+	for col := range cdcKinds {
+		w.watcher.WatchCollectionWithFilter(col, w.source, filter)
+	}
+	defer func() {
+		for col := range cdcKinds {
+			w.watcher.UnwatchCollection(col, w.source)
+		}
+	}()
+
+	var (
+		changes []string
+		in      = (<-chan watcher.Change)(w.source)
+		// The initial event is empty; only subsequent changes are
+		// streamed.
+		out = (chan<- []string)(w.sink)
+	)
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-w.watcher.Dead():
+			return stateWatcherDeadError(w.watcher.Err())
+		case ch := <-in:
+			event, err := w.translate(ch)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			changes = append(changes, event)
+			out = w.sink
+		case out <- changes:
+			changes = nil
+			out = nil
+		}
+	}
+}
+
+// translate converts a transaction log change into the published form.
+func (w *cdcWatcher) translate(ch watcher.Change) (string, error) {
+	id, ok := ch.Id.(string)
+	if !ok {
+		return "", errors.Errorf("unexpected id %v in %q change", ch.Id, ch.C)
+	}
+	operation := CDCOperationChanged
+	if ch.Revno < 0 {
+		operation = CDCOperationRemoved
+	}
+	data, err := json.Marshal(CDCEvent{
+		Kind:      cdcKinds[ch.C],
+		Id:        w.backend.localID(id),
+		Operation: operation,
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"fmt"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
+)
+
+type CDCSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&CDCSuite{})
+
+func cdcEvent(kind, id, operation string) string {
+	return fmt.Sprintf(`{"kind":%q,"id":%q,"operation":%q}`, kind, id, operation)
+}
+
+func (s *CDCSuite) TestWatchCDCEventsMachineLifecycle(c *gc.C) {
+	w := s.State.WatchCDCEvents()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+
+	// The stream starts at the point of watching: the initial event
+	// carries no changes.
+	wc.AssertChange()
+	wc.AssertNoChange()
+
+	machine, err := s.State.AddMachine("quantal", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(cdcEvent("machine", machine.Id(), state.CDCOperationChanged))
+	wc.AssertNoChange()
+
+	err = machine.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(cdcEvent("machine", machine.Id(), state.CDCOperationChanged))
+	wc.AssertNoChange()
+
+	err = machine.Remove()
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(cdcEvent("machine", machine.Id(), state.CDCOperationRemoved))
+	wc.AssertNoChange()
+}
+
+func (s *CDCSuite) TestWatchCDCEventsApplication(c *gc.C) {
+	w := s.State.WatchCDCEvents()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChange()
+	wc.AssertNoChange()
+
+	ch := s.AddTestingCharm(c, "dummy")
+	app := s.AddTestingApplication(c, "dummy", ch)
+	// Adding the application commits changes to both the charms and
+	// applications collections.
+	wc.AssertChangeInSingleEvent(
+		cdcEvent("charm", ch.URL().String(), state.CDCOperationChanged),
+		cdcEvent("application", app.Name(), state.CDCOperationChanged),
+	)
+	wc.AssertNoChange()
+}
+
+func (s *CDCSuite) TestWatchCDCEventsStop(c *gc.C) {
+	w := s.State.WatchCDCEvents()
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChange()
+	statetesting.AssertStop(c, w)
+	wc.AssertClosed()
+}
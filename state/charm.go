@@ -81,16 +81,21 @@ type CharmOrigin struct {
 	Revision *int      `bson:"revision,omitempty"`
 	Channel  *Channel  `bson:"channel,omitempty"`
 	Platform *Platform `bson:"platform,omitempty"`
+
+	// BaseSelection records how the base for the charm was chosen when
+	// the user did not specify one.
+	BaseSelection string `bson:"base-selection,omitempty"`
 }
 
 // AsCoreCharmOrigin converts a state Origin type into a core/charm.Origin.
 func (o CharmOrigin) AsCoreCharmOrigin() corecharm.Origin {
 	origin := corecharm.Origin{
-		Source:   corecharm.Source(o.Source),
-		Type:     o.Type,
-		ID:       o.ID,
-		Hash:     o.Hash,
-		Revision: o.Revision,
+		Source:        corecharm.Source(o.Source),
+		Type:          o.Type,
+		ID:            o.ID,
+		Hash:          o.Hash,
+		Revision:      o.Revision,
+		BaseSelection: o.BaseSelection,
 	}
 
 	if o.Channel != nil {
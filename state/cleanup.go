@@ -61,6 +61,14 @@ const (
 	cleanupStorageForDyingModel  cleanupKind = "modelStorage"
 	cleanupForceStorage          cleanupKind = "forceStorage"
 	cleanupBranchesForDyingModel cleanupKind = "branches"
+
+	// Time-boxed expose windows schedule a cleanup that clears the
+	// exposed flag again once the window elapses.
+	cleanupExpiredExposure cleanupKind = "expiredExposure"
+
+	// Time-boxed access grants schedule a cleanup that removes the
+	// grant again once its expiry time passes.
+	cleanupExpiredAccessGrant cleanupKind = "expiredAccessGrant"
 )
 
 // cleanupDoc originally represented a set of documents that should be
@@ -236,6 +244,10 @@ func (st *State) Cleanup() (err error) {
 			err = st.cleanupForceStorage(args)
 		case cleanupBranchesForDyingModel:
 			err = st.cleanupBranchesForDyingModel(args)
+		case cleanupExpiredExposure:
+			err = st.cleanupExpiredExposure(doc.Prefix, args)
+		case cleanupExpiredAccessGrant:
+			err = st.cleanupExpiredAccessGrant(doc.Prefix, args)
 		default:
 			err = errors.Errorf("unknown cleanup kind %q", doc.Kind)
 		}
@@ -255,6 +267,11 @@ func (st *State) Cleanup() (err error) {
 			return errors.Annotate(err, "cannot remove empty cleanup document")
 		}
 	}
+	// Cleanups are when cascading removals make progress, so bring
+	// any tracked removal operations up to date.
+	if err := st.refreshRemovalOperations(); err != nil {
+		logger.Warningf("cannot refresh removal operations: %v", err)
+	}
 	return nil
 }
 
@@ -554,6 +571,98 @@ func (st *State) cleanupBranchesForDyingModel(cleanupArgs []bson.Raw) (err error
 	return nil
 }
 
+// cleanupExpiredExposure clears the exposed flag of an application whose
+// expose window, set via Application.SetExposeDuration, has elapsed.
+func (st *State) cleanupExpiredExposure(applicationname string, cleanupArgs []bson.Raw) error {
+	var expiry time.Time
+	if n := len(cleanupArgs); n != 1 {
+		return errors.Errorf("expected 1 argument, got %d", n)
+	}
+	if err := cleanupArgs[0].Unmarshal(&expiry); err != nil {
+		return errors.Annotate(err, "unmarshalling cleanup arg 'expiry'")
+	}
+
+	app, err := st.Application(applicationname)
+	if errors.IsNotFound(err) {
+		// Nothing to do, the application is already gone.
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	current, ok := app.ExposeExpiry()
+	if !ok || current.After(expiry) {
+		// The expiry this cleanup was scheduled for has been cleared
+		// or extended since; a later cleanup will deal with it.
+		return nil
+	}
+	if !app.IsExposed() {
+		return nil
+	}
+	return errors.Trace(app.ClearExposed())
+}
+
+// cleanupExpiredAccessGrant removes a time-boxed access grant, made
+// with an expiry via Model.AddUser or State.CreateOfferAccess, once
+// its expiry time has passed.
+func (st *State) cleanupExpiredAccessGrant(username string, cleanupArgs []bson.Raw) error {
+	var (
+		targetTag string
+		expiry    time.Time
+	)
+	if n := len(cleanupArgs); n != 2 {
+		return errors.Errorf("expected 2 arguments, got %d", n)
+	}
+	if err := cleanupArgs[0].Unmarshal(&targetTag); err != nil {
+		return errors.Annotate(err, "unmarshalling cleanup arg 'targetTag'")
+	}
+	if err := cleanupArgs[1].Unmarshal(&expiry); err != nil {
+		return errors.Annotate(err, "unmarshalling cleanup arg 'expiry'")
+	}
+
+	user := names.NewUserTag(username)
+	target, err := names.ParseTag(targetTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var objectGlobalKey string
+	switch target.Kind() {
+	case names.ModelTagKind:
+		objectGlobalKey = modelKey(target.Id())
+	case names.ApplicationOfferTagKind:
+		offerUUID, err := applicationOfferUUID(st, target.Id())
+		if errors.IsNotFound(err) {
+			// Nothing to do, the offer is already gone.
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		objectGlobalKey = applicationOfferKey(offerUUID)
+	default:
+		return errors.NotValidf("%q as an access grant target", target.Kind())
+	}
+
+	perm, err := st.userPermission(objectGlobalKey, userGlobalKey(userAccessID(user)))
+	if errors.IsNotFound(err) {
+		// Nothing to do, the grant is already gone.
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	current, ok := perm.expiresAt()
+	if !ok || current.After(expiry) {
+		// The expiry this cleanup was scheduled for has been replaced
+		// by a fresh grant since; a later cleanup will deal with it.
+		return nil
+	}
+
+	switch target.Kind() {
+	case names.ModelTagKind:
+		return errors.Trace(st.RemoveUserAccess(user, target))
+	default:
+		return errors.Trace(st.RemoveOfferAccess(names.NewApplicationOfferTag(target.Id()), user))
+	}
+}
+
 // cleanupApplication checks if all references to a dying application have been removed,
 // and if so, removes the application.
 func (st *State) cleanupApplication(applicationname string, cleanupArgs []bson.Raw) (err error) {
@@ -5,6 +5,7 @@ package state
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -60,6 +61,11 @@ type cloudCredentialDoc struct {
 	// This can range from cloud messages such as an expired credential to
 	// commercial reasons set via CLI or api calls.
 	InvalidReason string `bson:"invalid-reason,omitempty"`
+
+	// Expiry, when set, is the provider-reported time at which the
+	// credential stops being usable, as recorded by the credential
+	// checker worker. Credentials with no known expiry leave it unset.
+	Expiry *time.Time `bson:"expiry,omitempty"`
 }
 
 // CloudCredential returns the cloud credential for the given tag.
@@ -269,6 +275,32 @@ func (st *State) InvalidateCloudCredential(tag names.CloudCredentialTag, reason
 	return nil
 }
 
+// SetCloudCredentialExpiry records the provider-reported time at which
+// the credential with the given tag stops being usable.
+func (st *State) SetCloudCredentialExpiry(tag names.CloudCredentialTag, expiry time.Time) error {
+	expiry = expiry.UTC()
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		_, err := st.CloudCredential(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		ops := []txn.Op{{
+			C:      cloudCredentialsC,
+			Id:     cloudCredentialDocID(tag),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"expiry", expiry},
+			}}},
+		}}
+		return ops, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "setting expiry of cloud credential %v", tag.Id())
+	}
+	return nil
+}
+
 // RemoveCloudCredential removes a cloud credential with the given tag.
 func (st *State) RemoveCloudCredential(tag names.CloudCredentialTag) error {
 	buildTxn := func(attempt int) ([]txn.Op, error) {
@@ -0,0 +1,112 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+)
+
+// configChangeDoc records a single configuration change made to an
+// application, for later inspection. These documents are written with
+// raw access, outside of any transaction: the history is advisory and
+// must never cause a config change itself to fail.
+type configChangeDoc struct {
+	ModelUUID   string                 `bson:"model-uuid"`
+	Application string                 `bson:"application"`
+	Author      string                 `bson:"author"`
+	Updated     int64                  `bson:"updated"`
+	Changes     []configValueChangeDoc `bson:"changes"`
+}
+
+type configValueChangeDoc struct {
+	Key      string      `bson:"key"`
+	OldValue interface{} `bson:"old-value,omitempty"`
+	NewValue interface{} `bson:"new-value,omitempty"`
+	Redacted bool        `bson:"redacted,omitempty"`
+}
+
+// ConfigChange describes one recorded configuration change for an
+// application: who made it, when, and which keys changed.
+type ConfigChange struct {
+	Application string
+	Author      string
+	Timestamp   time.Time
+	Changes     []ConfigValueChange
+}
+
+// ConfigValueChange holds the old and new values of one changed config
+// key. If Redacted is true the values were deemed secret at record time
+// and were not stored.
+type ConfigValueChange struct {
+	Key      string
+	OldValue interface{}
+	NewValue interface{}
+	Redacted bool
+}
+
+// RecordConfigChange appends a config change entry to the history for
+// change.Application. The entry's timestamp is assigned here; any value
+// in change.Timestamp is ignored. Recording a change with no changed
+// keys is a no-op.
+func (st *State) RecordConfigChange(change ConfigChange) error {
+	if len(change.Changes) == 0 {
+		return nil
+	}
+	changes := make([]configValueChangeDoc, len(change.Changes))
+	for i, c := range change.Changes {
+		changes[i] = configValueChangeDoc{
+			Key:      c.Key,
+			Redacted: c.Redacted,
+		}
+		if !c.Redacted {
+			changes[i].OldValue = c.OldValue
+			changes[i].NewValue = c.NewValue
+		}
+	}
+	doc := configChangeDoc{
+		ModelUUID:   st.ModelUUID(),
+		Application: change.Application,
+		Author:      change.Author,
+		Updated:     st.clock().Now().UnixNano(),
+		Changes:     changes,
+	}
+	history, closer := st.db().GetCollection(configChangeHistoryC)
+	defer closer()
+	return errors.Trace(history.Writeable().Insert(doc))
+}
+
+// ConfigChangeHistory returns the recorded configuration changes for
+// the named application, most recent first.
+func (st *State) ConfigChangeHistory(appName string) ([]ConfigChange, error) {
+	history, closer := st.db().GetCollection(configChangeHistoryC)
+	defer closer()
+
+	var docs []configChangeDoc
+	query := history.Find(bson.D{{"application", appName}}).Sort("-updated")
+	if err := query.All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]ConfigChange, len(docs))
+	for i, doc := range docs {
+		changes := make([]ConfigValueChange, len(doc.Changes))
+		for j, c := range doc.Changes {
+			changes[j] = ConfigValueChange{
+				Key:      c.Key,
+				OldValue: c.OldValue,
+				NewValue: c.NewValue,
+				Redacted: c.Redacted,
+			}
+		}
+		result[i] = ConfigChange{
+			Application: doc.Application,
+			Author:      doc.Author,
+			Timestamp:   time.Unix(0, doc.Updated),
+			Changes:     changes,
+		}
+	}
+	return result, nil
+}
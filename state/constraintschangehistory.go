@@ -0,0 +1,71 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+// constraintsChangeDoc records a single change to the model
+// constraints, for later inspection. These documents are written with
+// raw access, outside of any transaction: the history is advisory and
+// must never cause a constraints change itself to fail.
+type constraintsChangeDoc struct {
+	ModelUUID   string `bson:"model-uuid"`
+	Author      string `bson:"author"`
+	Updated     int64  `bson:"updated"`
+	Constraints string `bson:"constraints"`
+}
+
+// ConstraintsChange describes one recorded change to the model
+// constraints: who made it, when, and the constraints that were set.
+type ConstraintsChange struct {
+	Author      string
+	Timestamp   time.Time
+	Constraints constraints.Value
+}
+
+// RecordModelConstraintsChange appends an entry to the model
+// constraints history. The entry's timestamp is assigned here; any
+// value in change.Timestamp is ignored.
+func (st *State) RecordModelConstraintsChange(change ConstraintsChange) error {
+	doc := constraintsChangeDoc{
+		ModelUUID:   st.ModelUUID(),
+		Author:      change.Author,
+		Updated:     st.clock().Now().UnixNano(),
+		Constraints: change.Constraints.String(),
+	}
+	history, closer := st.db().GetCollection(constraintsChangeHistoryC)
+	defer closer()
+	return errors.Trace(history.Writeable().Insert(doc))
+}
+
+// ModelConstraintsChangeHistory returns the recorded changes to the
+// model constraints, most recent first.
+func (st *State) ModelConstraintsChangeHistory() ([]ConstraintsChange, error) {
+	history, closer := st.db().GetCollection(constraintsChangeHistoryC)
+	defer closer()
+
+	var docs []constraintsChangeDoc
+	if err := history.Find(nil).Sort("-updated").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]ConstraintsChange, len(docs))
+	for i, doc := range docs {
+		cons, err := constraints.Parse(doc.Constraints)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing recorded constraints %q", doc.Constraints)
+		}
+		result[i] = ConstraintsChange{
+			Author:      doc.Author,
+			Timestamp:   time.Unix(0, doc.Updated),
+			Constraints: cons,
+		}
+	}
+	return result, nil
+}
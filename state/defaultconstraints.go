@@ -0,0 +1,102 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+// defaultConstraintsAttr is the settings attribute under which the
+// string form of the default application constraints is stored.
+const defaultConstraintsAttr = "constraints"
+
+// defaultApplicationConstraintsKey returns the global settings key
+// under which the default application constraints for the given cloud,
+// or cloud region if region is not empty, are stored.
+func defaultApplicationConstraintsKey(cloudName, regionName string) string {
+	if regionName == "" {
+		return fmt.Sprintf("appcons#%s", cloudName)
+	}
+	return fmt.Sprintf("appcons#%s#%s", cloudName, regionName)
+}
+
+// DefaultApplicationConstraints returns the default application
+// constraints recorded for the given cloud, or cloud region if region
+// is not empty. If no default has been recorded, an error satisfying
+// errors.IsNotFound is returned.
+func (st *State) DefaultApplicationConstraints(cloudName, regionName string) (constraints.Value, error) {
+	key := defaultApplicationConstraintsKey(cloudName, regionName)
+	settings, err := readSettings(st.db(), globalSettingsC, key)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	value, ok := settings.Get(defaultConstraintsAttr)
+	if !ok {
+		return constraints.Value{}, errors.NotFoundf("default application constraints")
+	}
+	cons, err := constraints.Parse(value.(string))
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	return cons, nil
+}
+
+// SetDefaultApplicationConstraints records cons as the default
+// application constraints for the given cloud, or cloud region if
+// region is not empty. The default is applied to applications deployed
+// to matching models without explicit constraints.
+func (st *State) SetDefaultApplicationConstraints(cloudName, regionName string, cons constraints.Value) error {
+	key := defaultApplicationConstraintsKey(cloudName, regionName)
+	attrs := map[string]interface{}{defaultConstraintsAttr: cons.String()}
+	settings, err := readSettings(st.db(), globalSettingsC, key)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		// We haven't created settings for this cloud or region yet.
+		_, err := createSettings(st.db(), globalSettingsC, key, attrs)
+		return errors.Trace(err)
+	}
+	settings.Update(attrs)
+	_, err = settings.Write()
+	return errors.Trace(err)
+}
+
+// RemoveDefaultApplicationConstraints removes the default application
+// constraints recorded for the given cloud, or cloud region if region
+// is not empty. Removing defaults that are not set is not an error.
+func (st *State) RemoveDefaultApplicationConstraints(cloudName, regionName string) error {
+	key := defaultApplicationConstraintsKey(cloudName, regionName)
+	err := removeSettings(st.db(), globalSettingsC, key)
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ResolveDefaultApplicationConstraints returns the default application
+// constraints to apply for the given cloud and region, preferring a
+// region default over the cloud-wide default. If neither is recorded,
+// empty constraints are returned.
+func (st *State) ResolveDefaultApplicationConstraints(cloudName, regionName string) (constraints.Value, error) {
+	if regionName != "" {
+		cons, err := st.DefaultApplicationConstraints(cloudName, regionName)
+		if err == nil {
+			return cons, nil
+		} else if !errors.IsNotFound(err) {
+			return constraints.Value{}, errors.Trace(err)
+		}
+	}
+	cons, err := st.DefaultApplicationConstraints(cloudName, "")
+	if err == nil {
+		return cons, nil
+	} else if !errors.IsNotFound(err) {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	return constraints.Value{}, nil
+}
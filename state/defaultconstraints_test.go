@@ -0,0 +1,80 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+type defaultConstraintsSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&defaultConstraintsSuite{})
+
+func (s *defaultConstraintsSuite) TestDefaultApplicationConstraintsNotFound(c *gc.C) {
+	_, err := s.State.DefaultApplicationConstraints("dummy", "")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *defaultConstraintsSuite) TestSetDefaultApplicationConstraints(c *gc.C) {
+	cons := constraints.MustParse("arch=arm64 mem=4G")
+	err := s.State.SetDefaultApplicationConstraints("dummy", "", cons)
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtained, err := s.State.DefaultApplicationConstraints("dummy", "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, cons)
+
+	// Setting again replaces the recorded default.
+	cons = constraints.MustParse("cores=2")
+	err = s.State.SetDefaultApplicationConstraints("dummy", "", cons)
+	c.Assert(err, jc.ErrorIsNil)
+	obtained, err = s.State.DefaultApplicationConstraints("dummy", "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, cons)
+}
+
+func (s *defaultConstraintsSuite) TestRemoveDefaultApplicationConstraints(c *gc.C) {
+	cons := constraints.MustParse("arch=arm64")
+	err := s.State.SetDefaultApplicationConstraints("dummy", "dummy-region", cons)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.RemoveDefaultApplicationConstraints("dummy", "dummy-region")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.DefaultApplicationConstraints("dummy", "dummy-region")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	// Removing a default that is not set is not an error.
+	err = s.State.RemoveDefaultApplicationConstraints("dummy", "dummy-region")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *defaultConstraintsSuite) TestResolveDefaultApplicationConstraints(c *gc.C) {
+	cloudCons := constraints.MustParse("mem=2G")
+	regionCons := constraints.MustParse("arch=arm64")
+	err := s.State.SetDefaultApplicationConstraints("dummy", "", cloudCons)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetDefaultApplicationConstraints("dummy", "dummy-region", regionCons)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The region default is preferred over the cloud-wide default.
+	obtained, err := s.State.ResolveDefaultApplicationConstraints("dummy", "dummy-region")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, regionCons)
+
+	// Other regions fall back to the cloud-wide default.
+	obtained, err = s.State.ResolveDefaultApplicationConstraints("dummy", "other-region")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, cloudCons)
+
+	// Unknown clouds resolve to empty constraints.
+	obtained, err = s.State.ResolveDefaultApplicationConstraints("other", "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, constraints.Value{})
+}
@@ -87,9 +87,13 @@ func (st *State) maintainControllersOps(newIds []string, bootstrapOnly bool) ([]
 // If placement is not empty, any new machines which may be required are started
 // according to the specified placement directives until the placement list is
 // exhausted; thereafter any new machines are started according to the constraints and series.
+// If machineCons are supplied they are consumed in order by the new
+// machines, overriding cons for those machines; new machines beyond
+// the end of the list fall back to cons.
 // MachineID is the id of the machine where the apiserver is running.
 func (st *State) EnableHA(
 	numControllers int, cons constraints.Value, series string, placement []string,
+	machineCons ...constraints.Value,
 ) (ControllersChanges, error) {
 
 	if numControllers < 0 || (numControllers != 0 && numControllers%2 != 1) {
@@ -144,7 +148,7 @@ func (st *State) EnableHA(
 		logger.Infof("%d new machines; converting %v", intent.newCount, intent.convert)
 
 		var ops []txn.Op
-		ops, change, err = st.enableHAIntentionOps(intent, cons, series)
+		ops, change, err = st.enableHAIntentionOps(intent, cons, series, machineCons)
 		return ops, err
 	}
 	if err := st.db().Run(buildTxn); err != nil {
@@ -167,6 +171,7 @@ func (st *State) enableHAIntentionOps(
 	intent *enableHAIntent,
 	cons constraints.Value,
 	series string,
+	machineCons []constraints.Value,
 ) ([]txn.Op, ControllersChanges, error) {
 	var ops []txn.Op
 	var change ControllersChanges
@@ -178,20 +183,27 @@ func (st *State) enableHAIntentionOps(
 
 	// Use any placement directives that have been provided when adding new
 	// machines, until the directives have been all used up.
-	// Ignore constraints for provided machines.
+	// Ignore constraints for provided machines, unless per-machine
+	// constraints were supplied explicitly.
 	placementCount := 0
-	getPlacementConstraints := func() (string, constraints.Value) {
+	getPlacementConstraints := func(i int) (string, constraints.Value) {
+		machineConstraints := func(fallback constraints.Value) constraints.Value {
+			if i < len(machineCons) {
+				return machineCons[i]
+			}
+			return fallback
+		}
 		if placementCount >= len(intent.placement) {
-			return "", cons
+			return "", machineConstraints(cons)
 		}
 		result := intent.placement[placementCount]
 		placementCount++
-		return result, constraints.Value{}
+		return result, machineConstraints(constraints.Value{})
 	}
 
 	var controllerIds []string
 	for i := 0; i < intent.newCount; i++ {
-		placement, cons := getPlacementConstraints()
+		placement, cons := getPlacementConstraints(i)
 		template := MachineTemplate{
 			Series: series,
 			Jobs: []MachineJob{
@@ -71,6 +71,7 @@ var (
 	NewEntityWatcher              = newEntityWatcher
 	ApplicationHasConnectedOffers = applicationHasConnectedOffers
 	NewActionNotificationWatcher  = newActionNotificationWatcher
+	MaxActionOutputSize           = &maxActionOutputSize
 )
 
 type (
@@ -0,0 +1,62 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// ExposeExpiry returns the time at which the application will be
+// automatically unexposed again, if an expose duration has been set.
+func (a *Application) ExposeExpiry() (time.Time, bool) {
+	if a.doc.ExposeExpiry == nil {
+		return time.Time{}, false
+	}
+	return *a.doc.ExposeExpiry, true
+}
+
+// SetExposeDuration limits the current exposure of the application to
+// the supplied duration. Once the duration elapses the exposed flag is
+// cleared again, as if ClearExposed had been called, and the firewaller
+// closes any ports that were only open due to the application being
+// exposed. Calling MergeExposeSettings or ClearExposed removes any
+// previously set expiry.
+func (a *Application) SetExposeDuration(duration time.Duration) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set expose duration for application %q", a)
+	if duration <= 0 {
+		return errors.NotValidf("non-positive duration %v", duration)
+	}
+	expiry := a.st.stateClock.Now().Add(duration)
+	app := &Application{st: a.st, doc: a.doc}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := app.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if app.doc.Life != Alive {
+			return nil, errors.New("application is no longer alive")
+		}
+		if !app.doc.Exposed {
+			return nil, errors.New("application is not exposed")
+		}
+		return []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: append(bson.D{{"exposed", true}}, isAliveDoc...),
+			Update: bson.D{{"$set", bson.D{{"expose-expiry", expiry}}}},
+		},
+			newCleanupAtOp(expiry, cleanupExpiredExposure, app.doc.Name, expiry),
+		}, nil
+	}
+	if err := a.st.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	a.doc.ExposeExpiry = &expiry
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type exposeExpirySuite struct {
+	ConnSuite
+	app *state.Application
+}
+
+var _ = gc.Suite(&exposeExpirySuite{})
+
+func (s *exposeExpirySuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.app = s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+}
+
+func (s *exposeExpirySuite) TestSetExposeDuration(c *gc.C) {
+	err := s.app.MergeExposeSettings(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.SetExposeDuration(time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	expiry, ok := s.app.ExposeExpiry()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(expiry, gc.Equals, s.Clock.Now().Add(time.Hour))
+
+	// The expiry survives a refresh.
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok = s.app.ExposeExpiry()
+	c.Assert(ok, jc.IsTrue)
+}
+
+func (s *exposeExpirySuite) TestSetExposeDurationRequiresExposed(c *gc.C) {
+	err := s.app.SetExposeDuration(time.Hour)
+	c.Assert(err, gc.ErrorMatches, `cannot set expose duration for application "wordpress": application is not exposed`)
+}
+
+func (s *exposeExpirySuite) TestSetExposeDurationNotValid(c *gc.C) {
+	err := s.app.SetExposeDuration(-time.Minute)
+	c.Assert(err, gc.ErrorMatches, `cannot set expose duration for application "wordpress": non-positive duration -1m0s not valid`)
+}
+
+func (s *exposeExpirySuite) TestClearExposedRemovesExpiry(c *gc.C) {
+	err := s.app.MergeExposeSettings(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.SetExposeDuration(time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.app.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok := s.app.ExposeExpiry()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *exposeExpirySuite) TestCleanupClearsExposedFlag(c *gc.C) {
+	err := s.app.MergeExposeSettings(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.SetExposeDuration(time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The cleanup is not due yet, so the application stays exposed.
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.IsExposed(), jc.IsTrue)
+
+	s.Clock.Advance(time.Hour + time.Minute)
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.IsExposed(), jc.IsFalse)
+	_, ok := s.app.ExposeExpiry()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *exposeExpirySuite) TestCleanupSkipsExtendedExpiry(c *gc.C) {
+	err := s.app.MergeExposeSettings(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.SetExposeDuration(time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Extend the window before the first expiry comes due.
+	s.Clock.Advance(30 * time.Minute)
+	err = s.app.SetExposeDuration(2 * time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The original cleanup fires but must not clear the extended window.
+	s.Clock.Advance(time.Hour)
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.IsExposed(), jc.IsTrue)
+
+	// The extended cleanup clears the flag once it is due.
+	s.Clock.Advance(2 * time.Hour)
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.IsExposed(), jc.IsFalse)
+}
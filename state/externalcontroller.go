@@ -85,6 +85,7 @@ type ExternalControllers interface {
 	SaveAndMoveModels(_ crossmodel.ControllerInfo, modelUUIDs ...string) error
 	Controller(controllerUUID string) (ExternalController, error)
 	ControllerForModel(modelUUID string) (ExternalController, error)
+	AllControllers() ([]ExternalController, error)
 	Remove(controllerUUID string) error
 	Watch() StringsWatcher
 	WatchController(controllerUUID string) NotifyWatcher
@@ -240,6 +241,22 @@ func (ec *externalControllers) ControllerForModel(modelUUID string) (ExternalCon
 	return ec.st.ExternalControllerForModel(modelUUID)
 }
 
+// AllControllers returns all registered external controllers.
+func (ec *externalControllers) AllControllers() ([]ExternalController, error) {
+	coll, closer := ec.st.db().GetCollection(externalControllersC)
+	defer closer()
+
+	var docs []externalControllerDoc
+	if err := coll.Find(nil).All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]ExternalController, len(docs))
+	for i, doc := range docs {
+		result[i] = &externalController{doc}
+	}
+	return result, nil
+}
+
 // Watch returns a strings watcher that watches for addition and removal of
 // external controller documents. The strings returned will be the controller
 // UUIDs.
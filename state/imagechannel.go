@@ -0,0 +1,59 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+
+	"github.com/juju/juju/core/series"
+)
+
+// ImageChannel returns the base image channel pinned for machines
+// provisioned for the application, or empty if the model default
+// applies.
+func (a *Application) ImageChannel() string {
+	return a.doc.ImageChannel
+}
+
+// SetImageChannel pins the base image channel (e.g. "22.04/candidate")
+// used when provisioning machines dedicated to the application,
+// overriding the model default. An empty channel removes the pin.
+// Machines already provisioned are not affected.
+func (a *Application) SetImageChannel(channel string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set image channel for application %q", a)
+	defer func() {
+		if err == nil {
+			a.doc.ImageChannel = channel
+		}
+	}()
+	if channel != "" {
+		if _, err := series.ParseChannel(channel); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	app := &Application{st: a.st, doc: a.doc}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := app.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if app.doc.Life != Alive {
+			return nil, errors.New("application is no longer alive")
+		}
+		if channel == app.doc.ImageChannel {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+			Update: bson.D{{"$set", bson.D{{"image-channel", channel}}}},
+		}}, nil
+	}
+	return a.st.db().Run(buildTxn)
+}
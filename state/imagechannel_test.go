@@ -0,0 +1,48 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type imageChannelSuite struct {
+	ConnSuite
+	app *state.Application
+}
+
+var _ = gc.Suite(&imageChannelSuite{})
+
+func (s *imageChannelSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.app = s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+}
+
+func (s *imageChannelSuite) TestImageChannelUnsetByDefault(c *gc.C) {
+	c.Assert(s.app.ImageChannel(), gc.Equals, "")
+}
+
+func (s *imageChannelSuite) TestSetImageChannel(c *gc.C) {
+	err := s.app.SetImageChannel("22.04/candidate")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.ImageChannel(), gc.Equals, "22.04/candidate")
+
+	// The value survives a refresh.
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.ImageChannel(), gc.Equals, "22.04/candidate")
+
+	// An empty channel removes the pin.
+	err = s.app.SetImageChannel("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.ImageChannel(), gc.Equals, "")
+}
+
+func (s *imageChannelSuite) TestSetImageChannelInvalid(c *gc.C) {
+	err := s.app.SetImageChannel("22.04/weekly")
+	c.Assert(err, gc.ErrorMatches, `cannot set image channel for application "wordpress": risk in channel "22.04/weekly" not valid`)
+}
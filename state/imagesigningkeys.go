@@ -0,0 +1,138 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// imageSigningKeyDoc holds the public signing key trusted for custom
+// image metadata imported from a simplestreams source and stream.
+type imageSigningKeyDoc struct {
+	DocID     string `bson:"_id"`
+	Source    string `bson:"source"`
+	Stream    string `bson:"stream"`
+	PublicKey string `bson:"public-key"`
+}
+
+// ImageSigningKey holds the public signing key trusted for image
+// metadata imported from a simplestreams source and stream.
+type ImageSigningKey struct {
+	// Source is the metadata source the key applies to.
+	Source string
+
+	// Stream is the simplestreams stream the key applies to.
+	Stream string
+
+	// PublicKey is the armored PGP public key used to verify
+	// signatures on imported metadata.
+	PublicKey string
+}
+
+func (doc imageSigningKeyDoc) signingKey() ImageSigningKey {
+	return ImageSigningKey{
+		Source:    doc.Source,
+		Stream:    doc.Stream,
+		PublicKey: doc.PublicKey,
+	}
+}
+
+func imageSigningKeyID(source, stream string) string {
+	return fmt.Sprintf("%s:%s", source, stream)
+}
+
+// AddImageSigningKey registers a public signing key for image
+// metadata from the given source and stream. It is an error if a key
+// is already registered for the pair; use UpdateImageSigningKey to
+// rotate an existing key.
+func (st *State) AddImageSigningKey(key ImageSigningKey) error {
+	doc := imageSigningKeyDoc{
+		DocID:     imageSigningKeyID(key.Source, key.Stream),
+		Source:    key.Source,
+		Stream:    key.Stream,
+		PublicKey: key.PublicKey,
+	}
+	ops := []txn.Op{{
+		C:      imageSigningKeysC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: &doc,
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.AlreadyExistsf("signing key for source %q stream %q", key.Source, key.Stream)
+	}
+	return errors.Trace(err)
+}
+
+// UpdateImageSigningKey replaces the public signing key registered
+// for the given source and stream.
+func (st *State) UpdateImageSigningKey(key ImageSigningKey) error {
+	ops := []txn.Op{{
+		C:      imageSigningKeysC,
+		Id:     imageSigningKeyID(key.Source, key.Stream),
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", bson.D{{"public-key", key.PublicKey}}}},
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("signing key for source %q stream %q", key.Source, key.Stream)
+	}
+	return errors.Trace(err)
+}
+
+// RemoveImageSigningKey revokes the public signing key registered for
+// the given source and stream.
+func (st *State) RemoveImageSigningKey(source, stream string) error {
+	ops := []txn.Op{{
+		C:      imageSigningKeysC,
+		Id:     imageSigningKeyID(source, stream),
+		Assert: txn.DocExists,
+		Remove: true,
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("signing key for source %q stream %q", source, stream)
+	}
+	return errors.Trace(err)
+}
+
+// ImageSigningKey returns the public signing key registered for the
+// given source and stream, or an error satisfying errors.IsNotFound
+// if none is registered.
+func (st *State) ImageSigningKey(source, stream string) (ImageSigningKey, error) {
+	coll, closer := st.db().GetCollection(imageSigningKeysC)
+	defer closer()
+
+	var doc imageSigningKeyDoc
+	err := coll.FindId(imageSigningKeyID(source, stream)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return ImageSigningKey{}, errors.NotFoundf("signing key for source %q stream %q", source, stream)
+	} else if err != nil {
+		return ImageSigningKey{}, errors.Trace(err)
+	}
+	return doc.signingKey(), nil
+}
+
+// AllImageSigningKeys returns the public signing keys registered on
+// the controller, ordered by source and stream.
+func (st *State) AllImageSigningKeys() ([]ImageSigningKey, error) {
+	coll, closer := st.db().GetCollection(imageSigningKeysC)
+	defer closer()
+
+	var docs []imageSigningKeyDoc
+	if err := coll.Find(nil).Sort("source", "stream").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	keys := make([]ImageSigningKey, len(docs))
+	for i, doc := range docs {
+		keys[i] = doc.signingKey()
+	}
+	return keys, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+)
+
+// Keys used within an agent logging override settings document.
+const (
+	loggingOverrideConfigKey = "logging-config"
+	loggingOverrideExpiryKey = "expiry"
+)
+
+// agentLoggingOverrideKey returns the settings key under which the
+// temporary logging override for the given agent is stored.
+func agentLoggingOverrideKey(tag names.Tag) string {
+	return "alo#" + tag.String()
+}
+
+// SetAgentLoggingOverride records a temporary logging configuration for
+// the agent with the given tag. The override expires after duration, at
+// which point the agent reverts to the model's logging configuration.
+// The expiry time is returned.
+func (m *Model) SetAgentLoggingOverride(tag names.Tag, loggingConfig string, duration time.Duration) (time.Time, error) {
+	if duration <= 0 {
+		return time.Time{}, errors.NotValidf("non-positive duration")
+	}
+	expiry := m.st.clock().Now().Add(duration)
+	key := agentLoggingOverrideKey(tag)
+	values := map[string]interface{}{
+		loggingOverrideConfigKey: loggingConfig,
+		loggingOverrideExpiryKey: expiry.UnixNano(),
+	}
+	settings, err := readSettings(m.st.db(), settingsC, key)
+	if errors.IsNotFound(err) {
+		if _, err := createSettings(m.st.db(), settingsC, key, values); err != nil {
+			return time.Time{}, errors.Annotatef(err, "cannot set logging override for %q", tag)
+		}
+		return expiry, nil
+	} else if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	for k, v := range values {
+		settings.Set(k, v)
+	}
+	if _, err := settings.Write(); err != nil {
+		return time.Time{}, errors.Annotatef(err, "cannot set logging override for %q", tag)
+	}
+	return expiry, nil
+}
+
+// AgentLoggingOverride returns the logging override recorded for the agent
+// with the given tag along with its expiry time. A NotFound error is
+// returned if no override has been set, or if the recorded override has
+// already expired.
+func (m *Model) AgentLoggingOverride(tag names.Tag) (string, time.Time, error) {
+	settings, err := readSettings(m.st.db(), settingsC, agentLoggingOverrideKey(tag))
+	if errors.IsNotFound(err) {
+		return "", time.Time{}, errors.NotFoundf("logging override for %q", tag)
+	} else if err != nil {
+		return "", time.Time{}, errors.Trace(err)
+	}
+	loggingConfig, _ := settings.Get(loggingOverrideConfigKey)
+	configValue, _ := loggingConfig.(string)
+	var expiry time.Time
+	if raw, found := settings.Get(loggingOverrideExpiryKey); found {
+		if nanos, ok := raw.(int64); ok {
+			expiry = time.Unix(0, nanos)
+		}
+	}
+	if !expiry.After(m.st.clock().Now()) {
+		return "", time.Time{}, errors.NotFoundf("logging override for %q", tag)
+	}
+	return configValue, expiry, nil
+}
+
+// ClearAgentLoggingOverride removes any logging override recorded for the
+// agent with the given tag.
+func (m *Model) ClearAgentLoggingOverride(tag names.Tag) error {
+	err := removeSettings(m.st.db(), settingsC, agentLoggingOverrideKey(tag))
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// WatchAgentLoggingOverride returns a watcher that notifies when the
+// logging override for the agent with the given tag is set or cleared.
+func (m *Model) WatchAgentLoggingOverride(tag names.Tag) NotifyWatcher {
+	return newEntityWatcher(m.st, settingsC, m.st.docID(agentLoggingOverrideKey(tag)))
+}
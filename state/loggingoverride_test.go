@@ -0,0 +1,91 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	statetesting "github.com/juju/juju/state/testing"
+)
+
+type LoggingOverrideSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&LoggingOverrideSuite{})
+
+func (s *LoggingOverrideSuite) TestSetAndGet(c *gc.C) {
+	tag := names.NewMachineTag("0")
+	expiry, err := s.Model.SetAgentLoggingOverride(tag, "juju.worker=TRACE", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	loggingConfig, gotExpiry, err := s.Model.AgentLoggingOverride(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(loggingConfig, gc.Equals, "juju.worker=TRACE")
+	c.Assert(gotExpiry.UnixNano(), gc.Equals, expiry.UnixNano())
+
+	// Setting again replaces the previous override.
+	_, err = s.Model.SetAgentLoggingOverride(tag, "juju.worker=DEBUG", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	loggingConfig, _, err = s.Model.AgentLoggingOverride(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(loggingConfig, gc.Equals, "juju.worker=DEBUG")
+}
+
+func (s *LoggingOverrideSuite) TestNonPositiveDuration(c *gc.C) {
+	tag := names.NewMachineTag("0")
+	_, err := s.Model.SetAgentLoggingOverride(tag, "juju.worker=TRACE", 0)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *LoggingOverrideSuite) TestNotSet(c *gc.C) {
+	_, _, err := s.Model.AgentLoggingOverride(names.NewMachineTag("0"))
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *LoggingOverrideSuite) TestExpired(c *gc.C) {
+	tag := names.NewMachineTag("0")
+	_, err := s.Model.SetAgentLoggingOverride(tag, "juju.worker=TRACE", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Clock.Advance(2 * time.Hour)
+	_, _, err = s.Model.AgentLoggingOverride(tag)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *LoggingOverrideSuite) TestClear(c *gc.C) {
+	tag := names.NewMachineTag("0")
+	_, err := s.Model.SetAgentLoggingOverride(tag, "juju.worker=TRACE", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.Model.ClearAgentLoggingOverride(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	_, _, err = s.Model.AgentLoggingOverride(tag)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	// Clearing an absent override is a no-op.
+	err = s.Model.ClearAgentLoggingOverride(tag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *LoggingOverrideSuite) TestWatchAgentLoggingOverride(c *gc.C) {
+	tag := names.NewMachineTag("0")
+	w := s.Model.WatchAgentLoggingOverride(tag)
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	_, err := s.Model.SetAgentLoggingOverride(tag, "juju.worker=TRACE", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	err = s.Model.ClearAgentLoggingOverride(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
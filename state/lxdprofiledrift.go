@@ -0,0 +1,147 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// machineLXDProfileDriftDoc records a difference, reported by the
+// machine agent, between the lxd profiles actually applied to a
+// machine's instance and the profiles its charms declare. A document
+// only exists while the machine is drifted; it is removed again once
+// the profiles converge.
+type machineLXDProfileDriftDoc struct {
+	DocID     string   `bson:"_id"`
+	ModelUUID string   `bson:"model-uuid"`
+	MachineId string   `bson:"machineid"`
+	Applied   []string `bson:"applied"`
+	Expected  []string `bson:"expected"`
+	Detected  int64    `bson:"detected"`
+}
+
+// LXDProfileDrift describes a machine whose applied lxd profiles do
+// not match the profiles expected from its charms.
+type LXDProfileDrift struct {
+	// MachineId identifies the drifted machine.
+	MachineId string
+
+	// Applied holds the profile names currently applied to the
+	// machine's instance.
+	Applied []string
+
+	// Expected holds the profile names the machine should have.
+	Expected []string
+
+	// Detected is the time the drift was first reported.
+	Detected time.Time
+}
+
+func (doc machineLXDProfileDriftDoc) drift() LXDProfileDrift {
+	return LXDProfileDrift{
+		MachineId: doc.MachineId,
+		Applied:   doc.Applied,
+		Expected:  doc.Expected,
+		Detected:  time.Unix(0, doc.Detected),
+	}
+}
+
+func profileSetsEqual(a, b []string) bool {
+	setA := set.NewStrings(a...)
+	setB := set.NewStrings(b...)
+	return setA.Difference(setB).IsEmpty() && setB.Difference(setA).IsEmpty()
+}
+
+// SetLXDProfileDrift records the lxd profiles applied to the
+// machine's instance alongside the profiles that were expected. If
+// the two sets match, any previously recorded drift is cleared;
+// otherwise the drift is recorded, keeping the detection time of the
+// first report.
+func (m *Machine) SetLXDProfileDrift(applied, expected []string) error {
+	noDrift := profileSetsEqual(applied, expected)
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		_, err := m.LXDProfileDrift()
+		if errors.IsNotFound(err) {
+			if noDrift {
+				return nil, jujutxn.ErrNoOperations
+			}
+			doc := machineLXDProfileDriftDoc{
+				DocID:     m.st.docID(m.Id()),
+				MachineId: m.Id(),
+				Applied:   applied,
+				Expected:  expected,
+				Detected:  m.st.clock().Now().UnixNano(),
+			}
+			return []txn.Op{{
+				C:      machineLXDProfileDriftC,
+				Id:     doc.DocID,
+				Assert: txn.DocMissing,
+				Insert: &doc,
+			}}, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if noDrift {
+			return []txn.Op{{
+				C:      machineLXDProfileDriftC,
+				Id:     m.st.docID(m.Id()),
+				Assert: txn.DocExists,
+				Remove: true,
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      machineLXDProfileDriftC,
+			Id:     m.st.docID(m.Id()),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{
+				{"applied", applied},
+				{"expected", expected},
+			}}},
+		}}, nil
+	}
+	err := m.st.db().Run(buildTxn)
+	return errors.Annotatef(err, "cannot record lxd profile drift for machine %q", m.Id())
+}
+
+// LXDProfileDrift returns the recorded lxd profile drift for the
+// machine, or an error satisfying errors.IsNotFound if the machine's
+// profiles are not known to have drifted.
+func (m *Machine) LXDProfileDrift() (LXDProfileDrift, error) {
+	coll, closer := m.st.db().GetCollection(machineLXDProfileDriftC)
+	defer closer()
+
+	var doc machineLXDProfileDriftDoc
+	err := coll.FindId(m.st.docID(m.Id())).One(&doc)
+	if err == mgo.ErrNotFound {
+		return LXDProfileDrift{}, errors.NotFoundf("lxd profile drift for machine %q", m.Id())
+	} else if err != nil {
+		return LXDProfileDrift{}, errors.Trace(err)
+	}
+	return doc.drift(), nil
+}
+
+// AllLXDProfileDrifts returns the recorded lxd profile drift for
+// every machine in the model that is known to have drifted, ordered
+// by machine id.
+func (st *State) AllLXDProfileDrifts() ([]LXDProfileDrift, error) {
+	coll, closer := st.db().GetCollection(machineLXDProfileDriftC)
+	defer closer()
+
+	var docs []machineLXDProfileDriftDoc
+	if err := coll.Find(nil).Sort("machineid").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	drifts := make([]LXDProfileDrift, len(docs))
+	for i, doc := range docs {
+		drifts[i] = doc.drift()
+	}
+	return drifts, nil
+}
@@ -139,6 +139,10 @@ type machineDoc struct {
 	// an instance for the machine.
 	Placement string `bson:",omitempty"`
 
+	// Cloud optionally names a registered cloud, other than the
+	// model's own, that the machine's instance must be provisioned in.
+	Cloud string `bson:"cloud,omitempty"`
+
 	// StopMongoUntilVersion holds the version that must be checked to
 	// know if mongo must be stopped.
 	StopMongoUntilVersion string `bson:",omitempty"`
@@ -255,6 +259,11 @@ type instanceData struct {
 	// CharmProfiles contains the names of LXD profiles used by this machine.
 	// Profiles would have been defined in the charm deployed to this machine.
 	CharmProfiles []string `bson:"charm-profiles,omitempty"`
+
+	// ProviderMetadata holds extra provider-sourced metadata about the
+	// instance, such as an image end-of-life date or the current spot
+	// price, as reported by the instance poller.
+	ProviderMetadata map[string]string `bson:"provider-metadata,omitempty"`
 }
 
 func hardwareCharacteristics(instData instanceData) *instance.HardwareCharacteristics {
@@ -413,6 +422,34 @@ func (m *Machine) KeepInstance() (bool, error) {
 	return instData.KeepInstance, nil
 }
 
+// SetProviderMetadata records extra provider-sourced metadata about
+// the machine's cloud instance, such as an image end-of-life date or
+// the current spot price. This is only relevant if an instance exists.
+func (m *Machine) SetProviderMetadata(metadata map[string]string) error {
+	ops := []txn.Op{{
+		C:      instanceDataC,
+		Id:     m.doc.DocID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", bson.D{{"provider-metadata", metadata}}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		// If instance doc doesn't exist, there's nothing to describe;
+		// the metadata will be recorded once the machine is provisioned.
+		return errors.Annotatef(onAbort(err, nil), "cannot set provider metadata on machine %v", m)
+	}
+	return nil
+}
+
+// ProviderMetadata returns the provider-sourced metadata recorded for
+// the machine's cloud instance by the instance poller.
+func (m *Machine) ProviderMetadata() (map[string]string, error) {
+	instData, err := getInstanceData(m.st, m.Id())
+	if err != nil {
+		return nil, err
+	}
+	return instData.ProviderMetadata, nil
+}
+
 // CharmProfiles returns the names of any LXD profiles used by the machine,
 // which were defined in the charm deployed to that machine.
 func (m *Machine) CharmProfiles() ([]string, error) {
@@ -645,9 +682,12 @@ func (m *Machine) ForceDestroy(maxWait time.Duration) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if err := m.st.db().RunTransaction(ops); err != txn.ErrAborted {
+	if err := m.st.db().RunTransaction(ops); err != nil && err != txn.ErrAborted {
 		return errors.Annotatef(err, "failed to run transaction: %s", pretty.Sprint(ops))
 	}
+	if err := m.st.EnsureRemovalOperation(m.Tag(), true); err != nil {
+		logger.Warningf("cannot record removal operation for machine %q: %v", m.Id(), err)
+	}
 	return nil
 }
 
@@ -1125,6 +1165,7 @@ func (m *Machine) removeOps() ([]txn.Op, error) {
 		removeConstraintsOp(m.globalKey()),
 		annotationRemoveOp(m.st, m.globalKey()),
 		removeRebootDocOp(m.st, m.globalKey()),
+		removePatchStatusOp(m.st, m.Id()),
 		removeMachineBlockDevicesOp(m.Id()),
 		removeModelMachineRefOp(m.st, m.Id()),
 		removeSSHHostKeyOp(m.globalKey()),
@@ -1798,6 +1839,13 @@ func (m *Machine) Placement() string {
 	return m.doc.Placement
 }
 
+// Cloud returns the name of the registered cloud the machine's instance
+// must be provisioned in, or empty if the machine follows the model's
+// own cloud.
+func (m *Machine) Cloud() string {
+	return m.doc.Cloud
+}
+
 // Constraints returns the exact constraints that should apply when provisioning
 // an instance for the machine.
 func (m *Machine) Constraints() (constraints.Value, error) {
@@ -159,6 +159,31 @@ func (s *MachineSuite) TestSetKeepInstance(c *gc.C) {
 	c.Assert(keep, jc.IsTrue)
 }
 
+func (s *MachineSuite) TestSetProviderMetadata(c *gc.C) {
+	err := s.machine.SetProvisioned("1234", "", "nonce", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	metadata := map[string]string{
+		"image-eol":  "2024-04-30",
+		"spot-price": "0.0464",
+	}
+	err = s.machine.SetProviderMetadata(metadata)
+	c.Assert(err, jc.ErrorIsNil)
+
+	m, err := s.State.Machine(s.machine.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	obtained, err := m.ProviderMetadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.DeepEquals, metadata)
+}
+
+func (s *MachineSuite) TestSetProviderMetadataNotProvisioned(c *gc.C) {
+	// Setting metadata on an unprovisioned machine is a no-op; there is
+	// no instance to describe yet.
+	err := s.machine.SetProviderMetadata(map[string]string{"image-eol": "2024-04-30"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *MachineSuite) TestAddMachineInsideMachineModelDying(c *gc.C) {
 	model, err := s.State.Model()
 	c.Assert(err, jc.ErrorIsNil)
@@ -778,6 +778,7 @@ func (s *MigrationExportSuite) TestApplicationExposingOffers(c *gc.C) {
 		names.NewApplicationOfferTag("my-offer"),
 		fooUser.UserTag(),
 		permission.ConsumeAccess,
+		nil,
 	)
 	c.Assert(err, jc.ErrorIsNil)
 
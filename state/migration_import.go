@@ -396,7 +396,8 @@ func (i *importer) modelUsers() error {
 			user.CreatedBy(),
 			user.DisplayName(),
 			user.DateCreated(),
-			permission.Access(user.Access()))...,
+			permission.Access(user.Access()),
+			nil)...,
 		)
 	}
 	if err := i.st.db().RunTransaction(ops); err != nil {
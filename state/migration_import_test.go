@@ -920,6 +920,7 @@ func (s *MigrationImportSuite) TestApplicationsWithExposedOffers(c *gc.C) {
 		names.NewApplicationOfferTag("my-offer"),
 		fooUser.UserTag(),
 		permission.ConsumeAccess,
+		nil,
 	)
 	c.Assert(err, jc.ErrorIsNil)
 
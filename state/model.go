@@ -21,6 +21,7 @@ import (
 
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/core/constraints"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs/config"
@@ -91,6 +92,10 @@ type modelDoc struct {
 	ControllerUUID string        `bson:"controller-uuid"`
 	MigrationMode  MigrationMode `bson:"migration-mode"`
 
+	// FreezeMode is set when the model has been made read-only,
+	// e.g. for a migration cutover or an audit.
+	FreezeMode coremodel.FreezeMode `bson:"freeze-mode,omitempty"`
+
 	// EnvironVersion is the version of the Environ. As providers
 	// evolve, cloud resource representations may change; the environ
 	// version tracks the current version of that.
@@ -695,6 +700,31 @@ func (m *Model) SetMigrationMode(mode MigrationMode) error {
 	return m.Refresh()
 }
 
+// FreezeMode returns whether the model has been made read-only.
+func (m *Model) FreezeMode() coremodel.FreezeMode {
+	return m.doc.FreezeMode
+}
+
+// SetFreezeMode sets the freeze mode for the model. Freezing a model
+// makes it fully read-only until it is thawed again.
+func (m *Model) SetFreezeMode(mode coremodel.FreezeMode) error {
+	switch mode {
+	case coremodel.FreezeNone, coremodel.FreezeReadOnly:
+	default:
+		return errors.NotValidf("freeze mode %q", mode)
+	}
+	ops := []txn.Op{{
+		C:      modelsC,
+		Id:     m.doc.UUID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", bson.D{{"freeze-mode", mode}}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err != nil {
+		return errors.Trace(err)
+	}
+	return m.Refresh()
+}
+
 // Life returns whether the model is Alive, Dying or Dead.
 func (m *Model) Life() Life {
 	return m.doc.Life
@@ -0,0 +1,184 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/txn"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+// validSnapshotName constrains model snapshot names to something that
+// is safe to embed in a document id.
+var validSnapshotName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// modelSnapshotDoc records a named snapshot of the model's config and
+// constraints, so that a batch of risky changes can be rolled back in
+// one operation. It is deliberately not a full backup: only settings
+// that can be reapplied through UpdateModelConfig and
+// SetModelConstraints are captured.
+type modelSnapshotDoc struct {
+	DocID       string      `bson:"_id"`
+	ModelUUID   string      `bson:"model-uuid"`
+	Name        string      `bson:"name"`
+	Created     int64       `bson:"created"`
+	Config      settingsMap `bson:"config"`
+	Constraints string      `bson:"constraints"`
+}
+
+// ModelSnapshot holds a named snapshot of model config and
+// constraints.
+type ModelSnapshot struct {
+	// Name is the name the snapshot was created under.
+	Name string
+
+	// Created is the time the snapshot was taken.
+	Created time.Time
+
+	// Config holds the full set of model config attributes at the
+	// time the snapshot was taken.
+	Config map[string]interface{}
+
+	// Constraints holds the model constraints at the time the
+	// snapshot was taken.
+	Constraints constraints.Value
+}
+
+func (doc modelSnapshotDoc) snapshot() (ModelSnapshot, error) {
+	cons, err := constraints.Parse(doc.Constraints)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotatef(err, "parsing constraints of model snapshot %q", doc.Name)
+	}
+	return ModelSnapshot{
+		Name:        doc.Name,
+		Created:     time.Unix(0, doc.Created),
+		Config:      doc.Config,
+		Constraints: cons,
+	}, nil
+}
+
+// CreateModelSnapshot captures the model's current config and
+// constraints under the given name. It returns an error satisfying
+// errors.IsAlreadyExists if a snapshot with that name already exists.
+func (m *Model) CreateModelSnapshot(name string) (ModelSnapshot, error) {
+	if !validSnapshotName.MatchString(name) {
+		return ModelSnapshot{}, errors.NotValidf("snapshot name %q", name)
+	}
+	cfg, err := m.ModelConfig()
+	if err != nil {
+		return ModelSnapshot{}, errors.Trace(err)
+	}
+	cons, err := m.st.ModelConstraints()
+	if err != nil {
+		return ModelSnapshot{}, errors.Trace(err)
+	}
+	doc := modelSnapshotDoc{
+		DocID:       m.st.docID(name),
+		Name:        name,
+		Created:     m.st.clock().Now().UnixNano(),
+		Config:      cfg.AllAttrs(),
+		Constraints: cons.String(),
+	}
+	ops := []txn.Op{{
+		C:      modelSnapshotsC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: &doc,
+	}}
+	err = m.st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return ModelSnapshot{}, errors.AlreadyExistsf("model snapshot %q", name)
+	} else if err != nil {
+		return ModelSnapshot{}, errors.Annotatef(err, "cannot create model snapshot %q", name)
+	}
+	return doc.snapshot()
+}
+
+// ModelSnapshot returns the named snapshot of the model's config and
+// constraints, or an error satisfying errors.IsNotFound if no such
+// snapshot exists.
+func (m *Model) ModelSnapshot(name string) (ModelSnapshot, error) {
+	coll, closer := m.st.db().GetCollection(modelSnapshotsC)
+	defer closer()
+
+	var doc modelSnapshotDoc
+	err := coll.FindId(m.st.docID(name)).One(&doc)
+	if err == mgo.ErrNotFound {
+		return ModelSnapshot{}, errors.NotFoundf("model snapshot %q", name)
+	} else if err != nil {
+		return ModelSnapshot{}, errors.Trace(err)
+	}
+	return doc.snapshot()
+}
+
+// ModelSnapshots returns all snapshots taken of the model, oldest
+// first.
+func (m *Model) ModelSnapshots() ([]ModelSnapshot, error) {
+	coll, closer := m.st.db().GetCollection(modelSnapshotsC)
+	defer closer()
+
+	var docs []modelSnapshotDoc
+	if err := coll.Find(nil).Sort("created").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshots := make([]ModelSnapshot, len(docs))
+	for i, doc := range docs {
+		var err error
+		if snapshots[i], err = doc.snapshot(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return snapshots, nil
+}
+
+// RemoveModelSnapshot deletes the named snapshot. It returns an error
+// satisfying errors.IsNotFound if no such snapshot exists.
+func (m *Model) RemoveModelSnapshot(name string) error {
+	ops := []txn.Op{{
+		C:      modelSnapshotsC,
+		Id:     m.st.docID(name),
+		Assert: txn.DocExists,
+		Remove: true,
+	}}
+	err := m.st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("model snapshot %q", name)
+	}
+	return errors.Annotatef(err, "cannot remove model snapshot %q", name)
+}
+
+// RollbackModelSnapshot restores the model's config and constraints
+// to the state captured in the named snapshot. Config attributes
+// added since the snapshot was taken are removed, changed attributes
+// are reset and the model constraints are replaced wholesale.
+func (m *Model) RollbackModelSnapshot(name string) error {
+	snap, err := m.ModelSnapshot(name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cfg, err := m.ModelConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var removeAttrs []string
+	for key := range cfg.AllAttrs() {
+		if _, ok := snap.Config[key]; !ok {
+			removeAttrs = append(removeAttrs, key)
+		}
+	}
+	sort.Strings(removeAttrs)
+	if err := m.UpdateModelConfig(snap.Config, removeAttrs); err != nil {
+		return errors.Annotatef(err, "rolling back model config to snapshot %q", name)
+	}
+	if err := m.st.SetModelConstraints(snap.Constraints); err != nil {
+		return errors.Annotatef(err, "rolling back model constraints to snapshot %q", name)
+	}
+	return nil
+}
@@ -108,7 +108,7 @@ func (st *State) modelUser(modelUUID string, user names.UserTag) (userAccessDoc,
 	return modelUser, nil
 }
 
-func createModelUserOps(modelUUID string, user, createdBy names.UserTag, displayName string, dateCreated time.Time, access permission.Access) []txn.Op {
+func createModelUserOps(modelUUID string, user, createdBy names.UserTag, displayName string, dateCreated time.Time, access permission.Access, expiresAt *time.Time) []txn.Op {
 	creatorname := createdBy.Id()
 	doc := &userAccessDoc{
 		ID:          userAccessID(user),
@@ -120,7 +120,7 @@ func createModelUserOps(modelUUID string, user, createdBy names.UserTag, display
 	}
 
 	ops := []txn.Op{
-		createPermissionOp(modelKey(modelUUID), userGlobalKey(userAccessID(user)), access),
+		createPermissionWithExpiryOp(modelKey(modelUUID), userGlobalKey(userAccessID(user)), access, expiresAt),
 		{
 			C:      modelUsersC,
 			Id:     userAccessID(user),
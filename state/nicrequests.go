@@ -0,0 +1,103 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"reflect"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+
+	"github.com/juju/juju/core/network"
+)
+
+// nicRequestDoc is the persisted form of a network.NICRequest.
+type nicRequestDoc struct {
+	Type    string   `bson:"type"`
+	Parents []string `bson:"parents,omitempty"`
+	Count   int      `bson:"count,omitempty"`
+}
+
+// NICRequests returns the network interfaces that machines provisioned
+// for the application must be equipped with, or nil if none are
+// requested.
+func (a *Application) NICRequests() []network.NICRequest {
+	return nicRequestsFromDocs(a.doc.NICRequests)
+}
+
+// SetNICRequests records the network interfaces, such as SR-IOV or
+// bonded interfaces, that machines provisioned for the application must
+// be equipped with. The requests replace any previously set; an empty
+// set removes them. Machines already provisioned are not affected.
+func (a *Application) SetNICRequests(requests []network.NICRequest) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set NIC requests for application %q", a)
+	for _, req := range requests {
+		if err := req.Validate(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	docs := nicRequestDocs(requests)
+	defer func() {
+		if err == nil {
+			a.doc.NICRequests = docs
+		}
+	}()
+	app := &Application{st: a.st, doc: a.doc}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := app.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if app.doc.Life != Alive {
+			return nil, errors.New("application is no longer alive")
+		}
+		if reflect.DeepEqual(docs, app.doc.NICRequests) {
+			return nil, jujutxn.ErrNoOperations
+		}
+		update := bson.D{{"$set", bson.D{{"nic-requests", docs}}}}
+		if len(docs) == 0 {
+			update = bson.D{{"$unset", bson.D{{"nic-requests", nil}}}}
+		}
+		return []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+			Update: update,
+		}}, nil
+	}
+	return a.st.db().Run(buildTxn)
+}
+
+func nicRequestDocs(requests []network.NICRequest) []nicRequestDoc {
+	if len(requests) == 0 {
+		return nil
+	}
+	docs := make([]nicRequestDoc, len(requests))
+	for i, req := range requests {
+		docs[i] = nicRequestDoc{
+			Type:    string(req.Type),
+			Parents: req.Parents,
+			Count:   req.Count,
+		}
+	}
+	return docs
+}
+
+func nicRequestsFromDocs(docs []nicRequestDoc) []network.NICRequest {
+	if len(docs) == 0 {
+		return nil
+	}
+	requests := make([]network.NICRequest, len(docs))
+	for i, doc := range docs {
+		requests[i] = network.NICRequest{
+			Type:    network.NICRequestType(doc.Type),
+			Parents: doc.Parents,
+			Count:   doc.Count,
+		}
+	}
+	return requests
+}
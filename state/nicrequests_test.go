@@ -0,0 +1,56 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/state"
+)
+
+type nicRequestsSuite struct {
+	ConnSuite
+	app *state.Application
+}
+
+var _ = gc.Suite(&nicRequestsSuite{})
+
+func (s *nicRequestsSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.app = s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+}
+
+func (s *nicRequestsSuite) TestNICRequestsUnsetByDefault(c *gc.C) {
+	c.Assert(s.app.NICRequests(), gc.HasLen, 0)
+}
+
+func (s *nicRequestsSuite) TestSetNICRequests(c *gc.C) {
+	requests := []network.NICRequest{{
+		Type: network.SRIOVNICRequest,
+	}, {
+		Type:    network.BondNICRequest,
+		Parents: []string{"eth1", "eth2"},
+		Count:   1,
+	}}
+	err := s.app.SetNICRequests(requests)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.NICRequests(), jc.DeepEquals, requests)
+
+	// The requests survive a refresh.
+	err = s.app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.NICRequests(), jc.DeepEquals, requests)
+
+	// An empty set removes them.
+	err = s.app.SetNICRequests(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.app.NICRequests(), gc.HasLen, 0)
+}
+
+func (s *nicRequestsSuite) TestSetNICRequestsInvalid(c *gc.C) {
+	err := s.app.SetNICRequests([]network.NICRequest{{Type: "teamed"}})
+	c.Assert(err, gc.ErrorMatches, `cannot set NIC requests for application "wordpress": NIC request type "teamed" not valid`)
+}
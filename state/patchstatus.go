@@ -0,0 +1,130 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// machinePatchStatusDoc records the OS patch state last reported by a
+// machine agent: how many package updates are pending, how many of
+// those are security updates, and whether the machine needs a reboot
+// to finish applying updates.
+type machinePatchStatusDoc struct {
+	DocID                  string `bson:"_id"`
+	ModelUUID              string `bson:"model-uuid"`
+	MachineId              string `bson:"machineid"`
+	PendingUpdates         int    `bson:"pending-updates"`
+	PendingSecurityUpdates int    `bson:"pending-security-updates"`
+	RebootRequired         bool   `bson:"reboot-required"`
+	Updated                int64  `bson:"updated"`
+}
+
+// PatchStatus describes the OS patch state of a machine, as last
+// reported by its machine agent.
+type PatchStatus struct {
+	// PendingUpdates is the number of package updates that can be
+	// applied to the machine.
+	PendingUpdates int
+
+	// PendingSecurityUpdates is the number of pending updates that
+	// are security updates.
+	PendingSecurityUpdates int
+
+	// RebootRequired is true if the machine's OS reports that a
+	// reboot is needed to finish applying updates.
+	RebootRequired bool
+
+	// Updated is the time the machine agent last reported.
+	Updated time.Time
+}
+
+// SetPatchStatus records the OS patch state reported by the machine's
+// agent, replacing any previous report.
+func (m *Machine) SetPatchStatus(status PatchStatus) error {
+	coll, closer := m.st.db().GetCollection(machinePatchStatusC)
+	defer closer()
+
+	doc := machinePatchStatusDoc{
+		DocID:                  m.doc.DocID,
+		MachineId:              m.Id(),
+		PendingUpdates:         status.PendingUpdates,
+		PendingSecurityUpdates: status.PendingSecurityUpdates,
+		RebootRequired:         status.RebootRequired,
+		Updated:                m.st.clock().Now().UnixNano(),
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		count, err := coll.FindId(m.doc.DocID).Count()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops := []txn.Op{{
+			C:      machinesC,
+			Id:     m.doc.DocID,
+			Assert: notDeadDoc,
+		}}
+		if count == 0 {
+			ops = append(ops, txn.Op{
+				C:      machinePatchStatusC,
+				Id:     m.doc.DocID,
+				Assert: txn.DocMissing,
+				Insert: &doc,
+			})
+		} else {
+			ops = append(ops, txn.Op{
+				C:      machinePatchStatusC,
+				Id:     m.doc.DocID,
+				Assert: txn.DocExists,
+				Update: bson.D{{"$set", bson.D{
+					{"pending-updates", doc.PendingUpdates},
+					{"pending-security-updates", doc.PendingSecurityUpdates},
+					{"reboot-required", doc.RebootRequired},
+					{"updated", doc.Updated},
+				}}},
+			})
+		}
+		return ops, nil
+	}
+	if err := m.st.db().Run(buildTxn); err != nil {
+		return errors.Annotatef(err, "cannot set patch status of machine %v", m.Id())
+	}
+	return nil
+}
+
+// PatchStatus returns the OS patch state last reported by the
+// machine's agent, or an error satisfying errors.IsNotFound if the
+// agent has never reported.
+func (m *Machine) PatchStatus() (PatchStatus, error) {
+	coll, closer := m.st.db().GetCollection(machinePatchStatusC)
+	defer closer()
+
+	var doc machinePatchStatusDoc
+	err := coll.FindId(m.doc.DocID).One(&doc)
+	if err == mgo.ErrNotFound {
+		return PatchStatus{}, errors.NotFoundf("patch status for machine %v", m.Id())
+	} else if err != nil {
+		return PatchStatus{}, errors.Trace(err)
+	}
+	return PatchStatus{
+		PendingUpdates:         doc.PendingUpdates,
+		PendingSecurityUpdates: doc.PendingSecurityUpdates,
+		RebootRequired:         doc.RebootRequired,
+		Updated:                time.Unix(0, doc.Updated),
+	}, nil
+}
+
+// removePatchStatusOp returns an operation to remove the patch status
+// document for the given machine, if it exists.
+func removePatchStatusOp(st *State, machineId string) txn.Op {
+	return txn.Op{
+		C:      machinePatchStatusC,
+		Id:     st.docID(machineId),
+		Remove: true,
+	}
+}
@@ -551,6 +551,11 @@ func (r *Relation) removeOps(ignoreApplication string, departingUnitName string,
 	}
 	ops = append(ops, removeStatusOp(r.st, r.globalScope()))
 	ops = append(ops, removeRelationNetworksOps(r.st, r.doc.Key)...)
+	if _, err := readSettings(r.st.db(), globalSettingsC, relationNetworkKey(r.doc.Key)); err == nil {
+		ops = append(ops, removeSettingsOp(globalSettingsC, relationNetworkKey(r.doc.Key)))
+	} else if !errors.IsNotFound(err) {
+		op.AddError(err)
+	}
 	re := r.st.RemoteEntities()
 	tokenOps := re.removeRemoteEntityOps(r.Tag())
 	ops = append(ops, tokenOps...)
@@ -798,7 +798,7 @@ func (s *RelationSuite) setupRelationStatus(c *gc.C) *state.Relation {
 	c.Assert(err, jc.ErrorIsNil)
 	user := s.Factory.MakeUser(c, &factory.UserParams{Name: "fred", Access: permission.WriteAccess})
 	err = s.State.CreateOfferAccess(
-		names.NewApplicationOfferTag("hosted-mysql"), user.UserTag(), permission.ConsumeAccess)
+		names.NewApplicationOfferTag("hosted-mysql"), user.UserTag(), permission.ConsumeAccess, nil)
 	c.Assert(err, jc.ErrorIsNil)
 	_, err = s.State.AddOfferConnection(state.AddOfferConnectionParams{
 		SourceModelUUID: utils.MustNewUUID().String(),
@@ -0,0 +1,121 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
+// Latency classes that may be recorded against a relation to describe
+// the expected network path between the related applications.
+const (
+	// LatencyClassLocal indicates the applications are expected to be
+	// in the same availability zone or cluster.
+	LatencyClassLocal = "local"
+
+	// LatencyClassRegional indicates the applications are expected to
+	// be in the same region but possibly different zones.
+	LatencyClassRegional = "regional"
+
+	// LatencyClassWAN indicates the applications are expected to
+	// communicate over a wide area network, typically a cross-model
+	// relation between controllers in different regions.
+	LatencyClassWAN = "wan"
+)
+
+// RelationNetworkCharacteristics holds operator-supplied annotations
+// describing the network path carried by a relation, so charms can
+// tune themselves (e.g. replication batch sizes) for WAN relations.
+type RelationNetworkCharacteristics struct {
+	// LatencyClass is one of the LatencyClass* constants, or empty
+	// if the operator has not characterised the latency.
+	LatencyClass string
+
+	// BandwidthMbps is the expected bandwidth cap for traffic related
+	// to the relation, in megabits per second. Zero means unknown.
+	BandwidthMbps int
+}
+
+// Validate returns an error if the characteristics are malformed.
+func (c RelationNetworkCharacteristics) Validate() error {
+	switch c.LatencyClass {
+	case "", LatencyClassLocal, LatencyClassRegional, LatencyClassWAN:
+	default:
+		return errors.NotValidf("latency class %q", c.LatencyClass)
+	}
+	if c.BandwidthMbps < 0 {
+		return errors.NotValidf("bandwidth %d Mbps", c.BandwidthMbps)
+	}
+	return nil
+}
+
+// relationNetworkKey returns the global settings key under which the
+// network characteristics for the relation with the given key are
+// stored.
+func relationNetworkKey(relationKey string) string {
+	return fmt.Sprintf("relnetchar#%s", relationKey)
+}
+
+// SetNetworkCharacteristics records the given network characteristics
+// against the relation, replacing any previously recorded. Setting
+// zero-valued characteristics removes the record.
+func (r *Relation) SetNetworkCharacteristics(char RelationNetworkCharacteristics) error {
+	if err := char.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	key := relationNetworkKey(r.doc.Key)
+	if char == (RelationNetworkCharacteristics{}) {
+		err := removeSettings(r.st.db(), globalSettingsC, key)
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		return nil
+	}
+	attrs := map[string]interface{}{
+		"latency-class":  char.LatencyClass,
+		"bandwidth-mbps": char.BandwidthMbps,
+	}
+	settings, err := readSettings(r.st.db(), globalSettingsC, key)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		_, err := createSettings(r.st.db(), globalSettingsC, key, attrs)
+		return errors.Trace(err)
+	}
+	settings.Update(attrs)
+	_, err = settings.Write()
+	return errors.Trace(err)
+}
+
+// NetworkCharacteristics returns the network characteristics recorded
+// against the relation. If none have been recorded, an error
+// satisfying errors.IsNotFound is returned.
+func (r *Relation) NetworkCharacteristics() (RelationNetworkCharacteristics, error) {
+	settings, err := readSettings(r.st.db(), globalSettingsC, relationNetworkKey(r.doc.Key))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return RelationNetworkCharacteristics{}, errors.NotFoundf(
+				"network characteristics for relation %q", r)
+		}
+		return RelationNetworkCharacteristics{}, errors.Trace(err)
+	}
+	var char RelationNetworkCharacteristics
+	if value, ok := settings.Get("latency-class"); ok {
+		char.LatencyClass, _ = value.(string)
+	}
+	if value, ok := settings.Get("bandwidth-mbps"); ok {
+		switch bandwidth := value.(type) {
+		case int:
+			char.BandwidthMbps = bandwidth
+		case int64:
+			char.BandwidthMbps = int(bandwidth)
+		case float64:
+			char.BandwidthMbps = int(bandwidth)
+		}
+	}
+	return char, nil
+}
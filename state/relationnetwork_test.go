@@ -0,0 +1,95 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type relationNetworkSuite struct {
+	ConnSuite
+	rel *state.Relation
+}
+
+var _ = gc.Suite(&relationNetworkSuite{})
+
+func (s *relationNetworkSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	s.AddTestingApplication(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	eps, err := s.State.InferEndpoints("wordpress", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	s.rel, err = s.State.AddRelation(eps...)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationNetworkSuite) TestNetworkCharacteristicsNotFound(c *gc.C) {
+	_, err := s.rel.NetworkCharacteristics()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *relationNetworkSuite) TestSetNetworkCharacteristics(c *gc.C) {
+	char := state.RelationNetworkCharacteristics{
+		LatencyClass:  state.LatencyClassWAN,
+		BandwidthMbps: 100,
+	}
+	err := s.rel.SetNetworkCharacteristics(char)
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtained, err := s.rel.NetworkCharacteristics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, char)
+
+	// Replacing previously recorded characteristics.
+	char.LatencyClass = state.LatencyClassRegional
+	err = s.rel.SetNetworkCharacteristics(char)
+	c.Assert(err, jc.ErrorIsNil)
+	obtained, err = s.rel.NetworkCharacteristics()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, char)
+}
+
+func (s *relationNetworkSuite) TestSetNetworkCharacteristicsInvalid(c *gc.C) {
+	err := s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{
+		LatencyClass: "interplanetary",
+	})
+	c.Assert(err, gc.ErrorMatches, `latency class "interplanetary" not valid`)
+
+	err = s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{
+		BandwidthMbps: -1,
+	})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *relationNetworkSuite) TestSetNetworkCharacteristicsZeroRemoves(c *gc.C) {
+	err := s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{
+		LatencyClass: state.LatencyClassLocal,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.rel.NetworkCharacteristics()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	// Clearing unset characteristics is not an error.
+	err = s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationNetworkSuite) TestCharacteristicsRemovedWithRelation(c *gc.C) {
+	err := s.rel.SetNetworkCharacteristics(state.RelationNetworkCharacteristics{
+		LatencyClass: state.LatencyClassWAN,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.rel.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.rel.NetworkCharacteristics()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gojsonschema"
+)
+
+// relationInterfaceSchemaKey returns the global settings key under
+// which the JSON schemas registered for the given relation interface
+// are stored. Each recorded version is a separate attribute of the
+// settings document, keyed by its decimal version number.
+func relationInterfaceSchemaKey(interfaceName string) string {
+	return fmt.Sprintf("ifaceschema#%s", interfaceName)
+}
+
+// SetRelationInterfaceSchema records schema as the JSON schema for the
+// given relation interface name and version, replacing any schema
+// previously recorded for that version. The schema is used to validate
+// relation data written by units of endpoints with that interface.
+func (st *State) SetRelationInterfaceSchema(interfaceName string, version int, schema map[string]interface{}) error {
+	if interfaceName == "" {
+		return errors.NotValidf("empty interface name")
+	}
+	if version < 1 {
+		return errors.NotValidf("schema version %d", version)
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema)); err != nil {
+		return errors.Annotatef(err, "invalid JSON schema for interface %q", interfaceName)
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	key := relationInterfaceSchemaKey(interfaceName)
+	attrs := map[string]interface{}{strconv.Itoa(version): string(data)}
+	settings, err := readSettings(st.db(), globalSettingsC, key)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		// We haven't recorded any schemas for this interface yet.
+		_, err := createSettings(st.db(), globalSettingsC, key, attrs)
+		return errors.Trace(err)
+	}
+	settings.Update(attrs)
+	_, err = settings.Write()
+	return errors.Trace(err)
+}
+
+// RelationInterfaceSchema returns the JSON schema recorded for the
+// given relation interface name and version. If no schema has been
+// recorded, an error satisfying errors.IsNotFound is returned.
+func (st *State) RelationInterfaceSchema(interfaceName string, version int) (map[string]interface{}, error) {
+	settings, err := readSettings(st.db(), globalSettingsC, relationInterfaceSchemaKey(interfaceName))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundf("schema for interface %q version %d", interfaceName, version)
+		}
+		return nil, errors.Trace(err)
+	}
+	value, ok := settings.Get(strconv.Itoa(version))
+	if !ok {
+		return nil, errors.NotFoundf("schema for interface %q version %d", interfaceName, version)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &schema); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return schema, nil
+}
+
+// RemoveRelationInterfaceSchema removes the JSON schema recorded for
+// the given relation interface name and version. Removing a schema
+// that is not recorded is not an error.
+func (st *State) RemoveRelationInterfaceSchema(interfaceName string, version int) error {
+	key := relationInterfaceSchemaKey(interfaceName)
+	settings, err := readSettings(st.db(), globalSettingsC, key)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	settings.Delete(strconv.Itoa(version))
+	if len(settings.Map()) == 0 {
+		err := removeSettings(st.db(), globalSettingsC, key)
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.Trace(err)
+		}
+		return nil
+	}
+	_, err = settings.Write()
+	return errors.Trace(err)
+}
+
+// LatestRelationInterfaceSchema returns the highest-versioned JSON
+// schema recorded for the given relation interface name, along with
+// its version. If no schema has been recorded, an error satisfying
+// errors.IsNotFound is returned.
+func (st *State) LatestRelationInterfaceSchema(interfaceName string) (map[string]interface{}, int, error) {
+	settings, err := readSettings(st.db(), globalSettingsC, relationInterfaceSchemaKey(interfaceName))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, 0, errors.NotFoundf("schema for interface %q", interfaceName)
+		}
+		return nil, 0, errors.Trace(err)
+	}
+	latest := 0
+	for attr := range settings.Map() {
+		version, err := strconv.Atoi(attr)
+		if err != nil {
+			continue
+		}
+		if version > latest {
+			latest = version
+		}
+	}
+	if latest == 0 {
+		return nil, 0, errors.NotFoundf("schema for interface %q", interfaceName)
+	}
+	schema, err := st.RelationInterfaceSchema(interfaceName, latest)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	return schema, latest, nil
+}
+
+// ValidateRelationData validates the given relation data against the
+// latest JSON schema recorded for the given relation interface name.
+// If no schema has been recorded for the interface, the data is
+// accepted. Violations are reported as an error satisfying
+// errors.IsNotValid, listing each failed schema check.
+func (st *State) ValidateRelationData(interfaceName string, data map[string]interface{}) error {
+	schema, version, err := st.LatestRelationInterfaceSchema(interfaceName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewGoLoader(schema),
+		gojsonschema.NewGoLoader(data),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Valid() {
+		return nil
+	}
+	violations := make([]string, len(result.Errors()))
+	for i, resultErr := range result.Errors() {
+		violations[i] = resultErr.String()
+	}
+	return errors.NotValidf(
+		"relation data for interface %q (schema version %d): %s",
+		interfaceName, version, strings.Join(violations, "; "),
+	)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type relationSchemasSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&relationSchemasSuite{})
+
+var mysqlSchema = map[string]interface{}{
+	"type":     "object",
+	"required": []interface{}{"database"},
+	"properties": map[string]interface{}{
+		"database": map[string]interface{}{"type": "string"},
+	},
+}
+
+func (s *relationSchemasSuite) TestRelationInterfaceSchemaNotFound(c *gc.C) {
+	_, err := s.State.RelationInterfaceSchema("mysql", 1)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *relationSchemasSuite) TestSetRelationInterfaceSchema(c *gc.C) {
+	err := s.State.SetRelationInterfaceSchema("mysql", 1, mysqlSchema)
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtained, err := s.State.RelationInterfaceSchema("mysql", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, mysqlSchema)
+}
+
+func (s *relationSchemasSuite) TestSetRelationInterfaceSchemaInvalid(c *gc.C) {
+	err := s.State.SetRelationInterfaceSchema("mysql", 1, map[string]interface{}{
+		"type": "no-such-type",
+	})
+	c.Assert(err, gc.ErrorMatches, `invalid JSON schema for interface "mysql".*`)
+
+	err = s.State.SetRelationInterfaceSchema("mysql", 0, mysqlSchema)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *relationSchemasSuite) TestRemoveRelationInterfaceSchema(c *gc.C) {
+	err := s.State.SetRelationInterfaceSchema("mysql", 1, mysqlSchema)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.RemoveRelationInterfaceSchema("mysql", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.RelationInterfaceSchema("mysql", 1)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	// Removing a schema that is not registered is not an error.
+	err = s.State.RemoveRelationInterfaceSchema("mysql", 1)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationSchemasSuite) TestLatestRelationInterfaceSchema(c *gc.C) {
+	err := s.State.SetRelationInterfaceSchema("mysql", 1, mysqlSchema)
+	c.Assert(err, jc.ErrorIsNil)
+	v2 := map[string]interface{}{"type": "object"}
+	err = s.State.SetRelationInterfaceSchema("mysql", 2, v2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	schema, version, err := s.State.LatestRelationInterfaceSchema("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(version, gc.Equals, 2)
+	c.Assert(schema, jc.DeepEquals, v2)
+
+	_, _, err = s.State.LatestRelationInterfaceSchema("http")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *relationSchemasSuite) TestValidateRelationData(c *gc.C) {
+	// With no schema registered, any data is accepted.
+	err := s.State.ValidateRelationData("mysql", map[string]interface{}{"foo": "bar"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.SetRelationInterfaceSchema("mysql", 1, mysqlSchema)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ValidateRelationData("mysql", map[string]interface{}{"database": "wordpress"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ValidateRelationData("mysql", map[string]interface{}{"hostname": "10.0.0.1"})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `relation data for interface "mysql" \(schema version 1\): .*database.*`)
+}
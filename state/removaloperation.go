@@ -0,0 +1,322 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+	jujutxn "github.com/juju/txn/v2"
+
+	"github.com/juju/juju/core/life"
+)
+
+// removalStuckAfter is how long a removal step may go without progress
+// before it is reported as stuck.
+const removalStuckAfter = 10 * time.Minute
+
+// removalOperationDoc records the progress of a cascading entity
+// removal, so that clients waiting on a forced removal can observe it.
+type removalOperationDoc struct {
+	DocID     string           `bson:"_id"`
+	ModelUUID string           `bson:"model-uuid"`
+	EntityTag string           `bson:"entity-tag"`
+	Force     bool             `bson:"force"`
+	Started   time.Time        `bson:"started"`
+	Completed *time.Time       `bson:"completed,omitempty"`
+	Steps     []removalStepDoc `bson:"steps"`
+}
+
+// removalStepDoc records the progress of a single removal step.
+type removalStepDoc struct {
+	Name      string    `bson:"name"`
+	Status    string    `bson:"status"`
+	Total     int       `bson:"total"`
+	Remaining int       `bson:"remaining"`
+	Updated   time.Time `bson:"updated"`
+}
+
+// RemovalOperation tracks the progress of a cascading entity removal.
+type RemovalOperation struct {
+	st  *State
+	doc removalOperationDoc
+}
+
+// EntityTag returns the tag string of the entity being removed.
+func (op *RemovalOperation) EntityTag() string {
+	return op.doc.EntityTag
+}
+
+// Force reports whether the removal was forced.
+func (op *RemovalOperation) Force() bool {
+	return op.doc.Force
+}
+
+// Started returns when the removal began.
+func (op *RemovalOperation) Started() time.Time {
+	return op.doc.Started
+}
+
+// Completed returns when the removal finished, if it has.
+func (op *RemovalOperation) Completed() (time.Time, bool) {
+	if op.doc.Completed == nil {
+		return time.Time{}, false
+	}
+	return *op.doc.Completed, true
+}
+
+// Steps returns the progress of each removal step.
+func (op *RemovalOperation) Steps() []life.RemovalStepProgress {
+	steps := make([]life.RemovalStepProgress, len(op.doc.Steps))
+	for i, step := range op.doc.Steps {
+		steps[i] = life.RemovalStepProgress{
+			Step:      life.RemovalStep(step.Name),
+			Status:    life.RemovalStepStatus(step.Status),
+			Total:     step.Total,
+			Remaining: step.Remaining,
+			Updated:   step.Updated,
+		}
+	}
+	return steps
+}
+
+// Watch returns a watcher that notifies when the removal operation's
+// progress changes.
+func (op *RemovalOperation) Watch() NotifyWatcher {
+	return newEntityWatcher(op.st, removalOperationsC, op.doc.DocID)
+}
+
+// RemovalOperation returns the removal operation tracking the removal
+// of the entity with the given tag.
+func (st *State) RemovalOperation(tag names.Tag) (*RemovalOperation, error) {
+	coll, closer := st.db().GetCollection(removalOperationsC)
+	defer closer()
+
+	var doc removalOperationDoc
+	err := coll.FindId(tag.String()).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("removal operation for %q", tag)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &RemovalOperation{st: st, doc: doc}, nil
+}
+
+// WatchRemovalOperation returns a watcher that notifies when the
+// removal operation for the entity with the given tag is created or
+// its progress changes.
+func (st *State) WatchRemovalOperation(tag names.Tag) NotifyWatcher {
+	return newEntityWatcher(st, removalOperationsC, st.docID(tag.String()))
+}
+
+// EnsureRemovalOperation records the start of a cascading removal of
+// the entity with the given tag, snapshotting the work each removal
+// step has ahead of it. It is a no-op if an unfinished operation for
+// the entity already exists; a finished one is replaced.
+func (st *State) EnsureRemovalOperation(tag names.Tag, force bool) error {
+	remaining, err := st.removalRemaining(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	now := st.stateClock.Now()
+	steps := make([]removalStepDoc, len(remaining))
+	for i, step := range remaining {
+		status := life.RemovalStepPending
+		if step.Remaining == 0 {
+			status = life.RemovalStepCompleted
+		}
+		steps[i] = removalStepDoc{
+			Name:      step.Name,
+			Status:    string(status),
+			Total:     step.Remaining,
+			Remaining: step.Remaining,
+			Updated:   now,
+		}
+	}
+	doc := removalOperationDoc{
+		DocID:     st.docID(tag.String()),
+		ModelUUID: st.ModelUUID(),
+		EntityTag: tag.String(),
+		Force:     force,
+		Started:   now,
+		Steps:     steps,
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		existing, err := st.RemovalOperation(tag)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		if err == nil {
+			if _, done := existing.Completed(); !done {
+				return nil, jujutxn.ErrNoOperations
+			}
+			// A previous removal of an identically named entity
+			// finished; start tracking afresh.
+			return []txn.Op{{
+				C:      removalOperationsC,
+				Id:     doc.DocID,
+				Assert: txn.DocExists,
+				Update: bson.D{{"$set", bson.D{
+					{"force", doc.Force},
+					{"started", doc.Started},
+					{"steps", doc.Steps},
+				}}, {"$unset", bson.D{{"completed", nil}}}},
+			}}, nil
+		}
+		return []txn.Op{{
+			C:      removalOperationsC,
+			Id:     doc.DocID,
+			Assert: txn.DocMissing,
+			Insert: doc,
+		}}, nil
+	}
+	return errors.Trace(st.db().Run(buildTxn))
+}
+
+// refreshRemovalOperations brings the recorded progress of unfinished
+// removal operations up to date with the model. It is called after
+// each cleanup pass, which is when cascading removals make progress.
+func (st *State) refreshRemovalOperations() error {
+	coll, closer := st.db().GetCollection(removalOperationsC)
+	defer closer()
+
+	var docs []removalOperationDoc
+	if err := coll.Find(bson.D{{"completed", bson.D{{"$exists", false}}}}).All(&docs); err != nil {
+		return errors.Trace(err)
+	}
+	for _, doc := range docs {
+		if err := st.refreshRemovalOperation(doc); err != nil {
+			return errors.Annotatef(err, "refreshing removal operation for %q", doc.EntityTag)
+		}
+	}
+	return nil
+}
+
+func (st *State) refreshRemovalOperation(doc removalOperationDoc) error {
+	tag, err := names.ParseTag(doc.EntityTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	remaining, err := st.removalRemaining(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	counts := make(map[string]int)
+	for _, step := range remaining {
+		counts[step.Name] = step.Remaining
+	}
+
+	now := st.stateClock.Now()
+	changed := false
+	completed := true
+	steps := make([]removalStepDoc, len(doc.Steps))
+	for i, step := range doc.Steps {
+		count := counts[step.Name]
+		switch {
+		case count == 0:
+			step.Status = string(life.RemovalStepCompleted)
+			step.Remaining = 0
+			step.Updated = now
+		case count != step.Remaining:
+			step.Status = string(life.RemovalStepRunning)
+			step.Remaining = count
+			step.Updated = now
+		case now.Sub(step.Updated) > removalStuckAfter:
+			step.Status = string(life.RemovalStepStuck)
+		}
+		if step != doc.Steps[i] {
+			changed = true
+		}
+		if step.Status != string(life.RemovalStepCompleted) {
+			completed = false
+		}
+		steps[i] = step
+	}
+	if !changed && !completed {
+		return nil
+	}
+
+	update := bson.D{{"steps", steps}}
+	if completed {
+		update = append(update, bson.DocElem{Name: "completed", Value: now})
+	}
+	ops := []txn.Op{{
+		C:      removalOperationsC,
+		Id:     doc.DocID,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", update}},
+	}}
+	if err := st.db().RunTransaction(ops); err != nil && err != txn.ErrAborted {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// removalRemaining counts the work left for each removal step of the
+// entity with the given tag. An entity that no longer exists has no
+// work remaining.
+func (st *State) removalRemaining(tag names.Tag) ([]removalStepDoc, error) {
+	switch tag := tag.(type) {
+	case names.ApplicationTag:
+		units, storage := 0, 0
+		app, err := st.Application(tag.Id())
+		if err == nil {
+			allUnits, err := app.AllUnits()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			units = len(allUnits)
+			for _, unit := range allUnits {
+				storage += unit.doc.StorageAttachmentCount
+			}
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		return []removalStepDoc{
+			{Name: string(life.RemovalStepUnitsDying), Remaining: units},
+			{Name: string(life.RemovalStepStorageDetaching), Remaining: storage},
+		}, nil
+	case names.UnitTag:
+		units, storage := 0, 0
+		unit, err := st.Unit(tag.Id())
+		if err == nil {
+			units = 1
+			storage = unit.doc.StorageAttachmentCount
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		return []removalStepDoc{
+			{Name: string(life.RemovalStepUnitsDying), Remaining: units},
+			{Name: string(life.RemovalStepStorageDetaching), Remaining: storage},
+		}, nil
+	case names.MachineTag:
+		units, storage, release := 0, 0, 0
+		machine, err := st.Machine(tag.Id())
+		if err == nil {
+			release = 1
+			machineUnits, err := machine.Units()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			units = len(machineUnits)
+			attachments, err := machine.VolumeAttachments()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			storage = len(attachments)
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		return []removalStepDoc{
+			{Name: string(life.RemovalStepUnitsDying), Remaining: units},
+			{Name: string(life.RemovalStepStorageDetaching), Remaining: storage},
+			{Name: string(life.RemovalStepMachineRelease), Remaining: release},
+		}, nil
+	}
+	return nil, errors.NotSupportedf("removal operation for %q", tag)
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/life"
+	statetesting "github.com/juju/juju/state/testing"
+	"github.com/juju/juju/testing/factory"
+)
+
+type removalOperationSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&removalOperationSuite{})
+
+func (s *removalOperationSuite) TestEnsureRemovalOperation(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	s.Factory.MakeUnit(c, &factory.UnitParams{Application: app})
+	s.Factory.MakeUnit(c, &factory.UnitParams{Application: app})
+
+	err := s.State.EnsureRemovalOperation(app.Tag(), true)
+	c.Assert(err, jc.ErrorIsNil)
+
+	op, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.EntityTag(), gc.Equals, "application-wordpress")
+	c.Assert(op.Force(), jc.IsTrue)
+	_, done := op.Completed()
+	c.Assert(done, jc.IsFalse)
+
+	steps := op.Steps()
+	c.Assert(steps, gc.HasLen, 2)
+	c.Assert(steps[0].Step, gc.Equals, life.RemovalStepUnitsDying)
+	c.Assert(steps[0].Status, gc.Equals, life.RemovalStepPending)
+	c.Assert(steps[0].Total, gc.Equals, 2)
+	c.Assert(steps[0].Remaining, gc.Equals, 2)
+	c.Assert(steps[1].Step, gc.Equals, life.RemovalStepStorageDetaching)
+	c.Assert(steps[1].Status, gc.Equals, life.RemovalStepCompleted)
+}
+
+func (s *removalOperationSuite) TestEnsureRemovalOperationIdempotent(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := s.State.EnsureRemovalOperation(app.Tag(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	op, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.EnsureRemovalOperation(app.Tag(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	again, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(again.Started(), gc.Equals, op.Started())
+}
+
+func (s *removalOperationSuite) TestRemovalOperationNotFound(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	_, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *removalOperationSuite) TestForceDestroyApplicationRecordsOperation(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	s.Factory.MakeUnit(c, &factory.UnitParams{Application: app})
+
+	op := app.DestroyOperation()
+	op.Force = true
+	err := s.State.ApplyOperation(op)
+	c.Assert(err, jc.ErrorIsNil)
+
+	removal, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removal.Force(), jc.IsTrue)
+}
+
+func (s *removalOperationSuite) TestCleanupCompletesOperation(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	unit := s.Factory.MakeUnit(c, &factory.UnitParams{Application: app})
+
+	op := app.DestroyOperation()
+	op.Force = true
+	err := s.State.ApplyOperation(op)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Force removal proceeds via scheduled cleanups; each pass
+	// refreshes the recorded progress.
+	for i := 0; i < 10; i++ {
+		err = s.State.Cleanup()
+		c.Assert(err, jc.ErrorIsNil)
+		if err := unit.Refresh(); errors.IsNotFound(err) {
+			break
+		}
+	}
+	err = s.State.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+
+	removal, err := s.State.RemovalOperation(app.Tag())
+	c.Assert(err, jc.ErrorIsNil)
+	_, done := removal.Completed()
+	c.Assert(done, jc.IsTrue)
+	for _, step := range removal.Steps() {
+		c.Assert(step.Status, gc.Equals, life.RemovalStepCompleted)
+		c.Assert(step.Remaining, gc.Equals, 0)
+	}
+}
+
+func (s *removalOperationSuite) TestWatchRemovalOperation(c *gc.C) {
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	w := s.State.WatchRemovalOperation(app.Tag())
+	defer func() { _ = w.Stop() }()
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	err := s.State.EnsureRemovalOperation(app.Tag(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2/bson"
+	"github.com/juju/mgo/v2/txn"
+	"github.com/juju/names/v4"
+)
+
+// ReplaceUnit replaces the named unit of the application with a fresh
+// unit, for recovering from a corrupted unit agent. The old unit's
+// detachable storage is transferred to the replacement, and its
+// annotations are copied across; non-detachable storage follows the
+// old unit through its normal teardown. The old unit is destroyed
+// once the replacement exists, so a failure part-way leaves both
+// units rather than neither.
+func (a *Application) ReplaceUnit(unitName string) (*Unit, error) {
+	unit, err := a.st.Unit(unitName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if unit.ApplicationName() != a.Name() {
+		return nil, errors.NotValidf("replacing unit %q of application %q", unitName, a.Name())
+	}
+	if !unit.IsPrincipal() {
+		return nil, errors.NotSupportedf("replacing subordinate unit %q", unitName)
+	}
+	if unit.Life() != Alive {
+		return nil, errors.Errorf("unit %q is %s", unitName, unit.Life())
+	}
+
+	storageTags, err := a.releaseDetachableStorage(unit)
+	if err != nil {
+		return nil, errors.Annotatef(err, "releasing storage of unit %q", unitName)
+	}
+	newUnit, err := a.AddUnit(AddUnitParams{AttachStorage: storageTags})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	model, err := a.st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	annotations, err := model.Annotations(unit)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(annotations) > 0 {
+		if err := model.SetAnnotations(newUnit, annotations); err != nil {
+			return nil, errors.Annotatef(err, "copying annotations to unit %q", newUnit.Name())
+		}
+	}
+
+	if err := unit.Destroy(); err != nil {
+		return newUnit, errors.Annotatef(err, "removing replaced unit %q", unitName)
+	}
+	return newUnit, nil
+}
+
+// releaseDetachableStorage releases ownership of the unit's detachable
+// storage in a single transaction, so that it can be attached to a
+// replacement unit. Non-detachable storage is left in place to be
+// destroyed along with the unit. The tags of the released storage
+// instances are returned.
+func (a *Application) releaseDetachableStorage(unit *Unit) ([]names.StorageTag, error) {
+	sb, err := NewStorageBackend(a.st)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	attachments, err := sb.UnitStorageAttachments(unit.UnitTag())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var storageTags []names.StorageTag
+	var ops []txn.Op
+	for _, attachment := range attachments {
+		if attachment.Life() != Alive {
+			continue
+		}
+		si, err := sb.storageInstance(attachment.StorageInstance())
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if si.Life() != Alive {
+			continue
+		}
+		detachable, err := storageInstanceDetachable(sb, si)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !detachable {
+			continue
+		}
+		ops = append(ops, txn.Op{
+			C:      storageAttachmentsC,
+			Id:     storageAttachmentId(unit.Name(), si.StorageTag().Id()),
+			Assert: isAliveDoc,
+			Remove: true,
+		}, txn.Op{
+			C:      storageInstancesC,
+			Id:     si.doc.Id,
+			Assert: bson.D{{"owner", unit.Tag().String()}, {"life", Alive}},
+			Update: bson.D{
+				{"$unset", bson.D{{"owner", nil}}},
+				{"$inc", bson.D{{"attachmentcount", -1}}},
+			},
+		})
+		storageTags = append(storageTags, si.StorageTag())
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+	ops = append(ops, txn.Op{
+		C:      unitsC,
+		Id:     unit.doc.DocID,
+		Assert: isAliveDoc,
+		Update: bson.D{{"$inc", bson.D{{"storageattachmentcount", -len(storageTags)}}}},
+	})
+	if err := a.st.db().RunTransaction(ops); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return storageTags, nil
+}
+
+// storageInstanceDetachable reports whether the volume or filesystem
+// backing the storage instance can be detached from its host.
+func storageInstanceDetachable(sb *storageBackend, si *storageInstance) (bool, error) {
+	switch si.Kind() {
+	case StorageKindBlock:
+		volume, err := sb.storageInstanceVolume(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return volume.Detachable(), nil
+	case StorageKindFilesystem:
+		filesystem, err := sb.storageInstanceFilesystem(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return filesystem.Detachable(), nil
+	}
+	return false, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/bson"
+)
+
+// RetentionStats describes the current size and age of one prunable
+// collection, so that operators can see what drives database growth
+// and whether the pruners are keeping up.
+type RetentionStats struct {
+	// Collection is the user-facing name of the data set.
+	Collection string
+
+	// SizeKiB is the total size of the underlying collection in KiB.
+	// Most prunable collections are shared between models, so this
+	// reflects every model hosted by the controller.
+	SizeKiB int
+
+	// Count is the number of documents belonging to the model.
+	Count int
+
+	// Oldest is the timestamp of the model's oldest document. It is
+	// zero if the collection holds no documents for the model.
+	Oldest time.Time
+}
+
+// PrunableCollectionStats returns retention statistics for the
+// collections that are periodically trimmed by the pruner workers:
+// status history, actions and logs.
+func (st *State) PrunableCollectionStats() ([]RetentionStats, error) {
+	history, err := st.collectionRetentionStats("status-history", statusesHistoryC, "updated", NanoSeconds)
+	if err != nil {
+		return nil, errors.Annotate(err, "status history")
+	}
+	actions, err := st.collectionRetentionStats("actions", actionsC, "completed", GoTime)
+	if err != nil {
+		return nil, errors.Annotate(err, "actions")
+	}
+	logs, err := st.logRetentionStats()
+	if err != nil {
+		return nil, errors.Annotate(err, "logs")
+	}
+	return []RetentionStats{history, actions, logs}, nil
+}
+
+// collectionRetentionStats gathers statistics for a model-scoped
+// collection whose age field uses the given time encoding.
+func (st *State) collectionRetentionStats(name, collectionName, ageField string, timeUnit TimeUnit) (RetentionStats, error) {
+	// A raw collection is required to obtain the collection size, so
+	// queries below filter on model-uuid explicitly.
+	coll, closer := st.db().GetRawCollection(collectionName)
+	defer closer()
+
+	stats := RetentionStats{Collection: name}
+	var err error
+	if stats.SizeKiB, err = retentionCollSizeKiB(coll); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	modelFilter := bson.D{{"model-uuid", st.ModelUUID()}}
+	if stats.Count, err = coll.Find(modelFilter).Count(); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	if stats.Oldest, err = oldestRetentionTime(coll, modelFilter, ageField, timeUnit); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	return stats, nil
+}
+
+// logRetentionStats gathers statistics for the model's log collection,
+// which lives in the logs database rather than alongside the other
+// model-scoped collections.
+func (st *State) logRetentionStats() (RetentionStats, error) {
+	session := st.MongoSession().Copy()
+	defer session.Close()
+	coll := session.DB(logsDB).C(logCollectionName(st.ModelUUID()))
+
+	stats := RetentionStats{Collection: "logs"}
+	var err error
+	if stats.SizeKiB, err = retentionCollSizeKiB(coll); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	// The collection holds a single model's logs, so no model filter
+	// is needed.
+	if stats.Count, err = coll.Count(); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	if stats.Oldest, err = oldestRetentionTime(coll, nil, "t", NanoSeconds); err != nil {
+		return RetentionStats{}, errors.Trace(err)
+	}
+	return stats, nil
+}
+
+// retentionCollSizeKiB returns the size of the given collection in
+// KiB, or zero if the collection does not exist yet.
+func retentionCollSizeKiB(coll *mgo.Collection) (int, error) {
+	result, err := collStats(coll)
+	if errors.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	size, err := dbCollectionSizeToInt(result, coll.Name)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return size, nil
+}
+
+// oldestRetentionTime returns the timestamp of the oldest document
+// matching the filter that has its age field set, or the zero time if
+// there is none.
+func oldestRetentionTime(coll *mgo.Collection, filter bson.D, ageField string, timeUnit TimeUnit) (time.Time, error) {
+	query := append(filter, bson.DocElem{ageField, bson.D{{"$gt", retentionEpoch(timeUnit)}}})
+	var doc bson.M
+	err := coll.Find(query).Sort(ageField).Select(bson.M{ageField: 1}).One(&doc)
+	if err == mgo.ErrNotFound {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	switch timeUnit {
+	case NanoSeconds:
+		if nanos, ok := doc[ageField].(int64); ok {
+			return time.Unix(0, nanos).UTC(), nil
+		}
+	case GoTime:
+		if when, ok := doc[ageField].(time.Time); ok {
+			return when.UTC(), nil
+		}
+	}
+	return time.Time{}, errors.Errorf("unexpected %q value %v in collection %q", ageField, doc[ageField], coll.Name)
+}
+
+// retentionEpoch returns the zero value of the given time encoding,
+// used to exclude documents whose age field has not been set.
+func retentionEpoch(timeUnit TimeUnit) interface{} {
+	if timeUnit == GoTime {
+		return time.Time{}
+	}
+	return int64(0)
+}
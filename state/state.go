@@ -1404,11 +1404,13 @@ func (st *State) AddApplication(args AddApplicationArgs) (_ *Application, err er
 		}
 
 		// Collect unit-adding operations.
+		pendingSharedStorage := make(map[string][]*storageInstanceDoc)
 		for x := 0; x < args.NumUnits; x++ {
 			unitName, unitOps, err := app.addUnitOpsWithCons(applicationAddUnitOpsArgs{
-				cons:          args.Constraints,
-				storageCons:   args.Storage,
-				attachStorage: args.AttachStorage,
+				cons:                 args.Constraints,
+				storageCons:          args.Storage,
+				attachStorage:        args.AttachStorage,
+				pendingSharedStorage: pendingSharedStorage,
 			})
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -1703,7 +1705,11 @@ func (st *State) assignStagedUnit(a UnitAssignment) error {
 		return errors.Trace(err)
 	}
 	if a.Scope == "" && a.Directive == "" {
-		return errors.Trace(st.AssignUnit(u, AssignCleanEmpty))
+		app, err := u.Application()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(st.assignUnitWithStrategy(u, app.AssignmentStrategy()))
 	}
 
 	placement := &instance.Placement{Scope: a.Scope, Directive: a.Directive}
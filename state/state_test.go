@@ -845,6 +845,47 @@ func (s *StateSuite) TestAddMachineErrors(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "cannot add a new machine: duplicate job: .*")
 }
 
+func (s *StateSuite) TestAddOneMachineWithCloud(c *gc.C) {
+	err := s.State.AddCloud(cloud.Cloud{
+		Name:      "stratus",
+		Type:      "low",
+		AuthTypes: cloud.AuthTypes{cloud.AccessKeyAuthType},
+	}, s.Owner.Name())
+	c.Assert(err, jc.ErrorIsNil)
+
+	m, err := s.State.AddOneMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+		Cloud:  "stratus",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(m.Cloud(), gc.Equals, "stratus")
+
+	m, err = s.State.Machine(m.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(m.Cloud(), gc.Equals, "stratus")
+}
+
+func (s *StateSuite) TestAddOneMachineWithModelCloud(c *gc.C) {
+	// The model's own cloud needs no overriding.
+	m, err := s.State.AddOneMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+		Cloud:  "dummy",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(m.Cloud(), gc.Equals, "")
+}
+
+func (s *StateSuite) TestAddOneMachineWithUnknownCloud(c *gc.C) {
+	_, err := s.State.AddOneMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+		Cloud:  "nimbus",
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot add a new machine: machine cloud: cloud "nimbus" not found`)
+}
+
 func (s *StateSuite) TestAddMachine(c *gc.C) {
 	allJobs := []state.MachineJob{
 		state.JobHostUnits,
@@ -6,6 +6,7 @@ package state
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/juju/clock"
@@ -156,6 +157,89 @@ func (m *ModelStatus) UnitWorkload(unitName string, expectWorkload bool) (status
 	return status.UnitDisplayStatus(info, containerInfo, expectWorkload), nil
 }
 
+// StatusTransitions holds the number of recent status transitions for
+// each status-bearing entity in a model. Used to speed up flapping
+// detection in status.
+type StatusTransitions struct {
+	counts map[string]int
+}
+
+// LoadStatusTransitions counts, in a single query, the status
+// transitions recorded within the given window for every entity in the
+// model. Used by status to avoid a status history query per entity.
+func (m *Model) LoadStatusTransitions(window time.Duration) (*StatusTransitions, error) {
+	history, closer := m.st.db().GetCollection(statusesHistoryC)
+	defer closer()
+
+	updated := m.st.stateClock.Now().Add(-window)
+	var docs []historicalStatusDoc
+	err := history.Find(bson.M{
+		"model-uuid": m.UUID(),
+		"updated":    bson.M{"$gt": updated.UnixNano()},
+	}).Sort("globalkey", "-updated").Select(bson.M{"globalkey": 1, "status": 1}).All(&docs)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read status history collection")
+	}
+
+	result := &StatusTransitions{counts: make(map[string]int)}
+	for i := 1; i < len(docs); i++ {
+		if docs[i].GlobalKey == docs[i-1].GlobalKey && docs[i].Status != docs[i-1].Status {
+			result.counts[docs[i].GlobalKey]++
+		}
+	}
+	return result, nil
+}
+
+// Unit returns the number of recent workload status transitions for
+// the named unit.
+func (t *StatusTransitions) Unit(unitName string) int {
+	return t.counts[unitGlobalKey(unitName)]
+}
+
+// UnitAgent returns the number of recent agent status transitions for
+// the named unit.
+func (t *StatusTransitions) UnitAgent(unitName string) int {
+	return t.counts[unitAgentGlobalKey(unitName)]
+}
+
+// Machine returns the number of recent status transitions for the
+// machine with the given id.
+func (t *StatusTransitions) Machine(machineId string) int {
+	return t.counts[machineGlobalKey(machineId)]
+}
+
+// LoadUnitAgentErrorCounts counts, in a single query, how many error
+// statuses each unit agent in the model recorded in status history
+// within the given window. The result maps unit names to counts; units
+// with no recorded errors are absent. Used by the model alerts facade
+// to detect hook retry storms without a history query per unit.
+func (m *Model) LoadUnitAgentErrorCounts(window time.Duration) (map[string]int, error) {
+	history, closer := m.st.db().GetCollection(statusesHistoryC)
+	defer closer()
+
+	updated := m.st.stateClock.Now().Add(-window)
+	var docs []historicalStatusDoc
+	err := history.Find(bson.M{
+		"model-uuid": m.UUID(),
+		"status":     status.Error,
+		"updated":    bson.M{"$gt": updated.UnixNano()},
+	}).Select(bson.M{"globalkey": 1}).All(&docs)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read status history collection")
+	}
+
+	counts := make(map[string]int)
+	for _, doc := range docs {
+		// Unit agent global keys are "u#<name>"; workload keys carry a
+		// "#charm" suffix.
+		if !strings.HasPrefix(doc.GlobalKey, "u#") || strings.HasSuffix(doc.GlobalKey, "#charm") {
+			continue
+		}
+		counts[doc.GlobalKey[2:]]++
+	}
+	return counts, nil
+}
+
 // caasHistoryRewriteDoc determines which status should be stored as history.
 func caasHistoryRewriteDoc(jujuStatus, caasStatus status.StatusInfo, expectWorkload bool, displayStatus displayStatusFunc, clock clock.Clock) (*statusDoc, error) {
 	modifiedStatus := displayStatus(jujuStatus, caasStatus, expectWorkload)
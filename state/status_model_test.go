@@ -4,6 +4,8 @@
 package state_test
 
 import (
+	"time"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -243,3 +245,35 @@ func (s *ModelStatusSuite) TestUnitStatusWeirdness(c *gc.C) {
 	c.Check(msAgent.Status, gc.Equals, status.Idle)
 	c.Check(msWorkload.Status, gc.Equals, status.Error)
 }
+
+func (s *ModelStatusSuite) TestLoadStatusTransitions(c *gc.C) {
+	machine := s.factory.MakeMachine(c, nil)
+	unit := s.factory.MakeUnit(c, nil)
+
+	// Workload: waiting -> active -> maintenance -> active.
+	for _, st := range []status.Status{status.Active, status.Maintenance, status.Active} {
+		c.Assert(unit.SetStatus(status.StatusInfo{Status: st}), jc.ErrorIsNil)
+	}
+	// Agent: allocating -> idle -> executing.
+	for _, st := range []status.Status{status.Idle, status.Executing} {
+		c.Assert(unit.SetAgentStatus(status.StatusInfo{Status: st}), jc.ErrorIsNil)
+	}
+	// Machine: pending -> started.
+	c.Assert(machine.SetStatus(status.StatusInfo{Status: status.Started}), jc.ErrorIsNil)
+
+	transitions, err := s.model.LoadStatusTransitions(15 * time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(transitions.Unit(unit.Name()), gc.Equals, 3)
+	c.Check(transitions.UnitAgent(unit.Name()), gc.Equals, 2)
+	c.Check(transitions.Machine(machine.Id()), gc.Equals, 1)
+}
+
+func (s *ModelStatusSuite) TestLoadStatusTransitionsWindow(c *gc.C) {
+	unit := s.factory.MakeUnit(c, nil)
+	c.Assert(unit.SetStatus(status.StatusInfo{Status: status.Active}), jc.ErrorIsNil)
+
+	// A zero window excludes all recorded history.
+	transitions, err := s.model.LoadStatusTransitions(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(transitions.Unit(unit.Name()), gc.Equals, 0)
+}
@@ -885,16 +885,137 @@ func createStorageOps(
 		}
 	}
 
-	// TODO(axw) create storage attachments for each shared storage
-	// instance owned by the application.
-	//
-	// TODO(axw) prevent creation of shared storage after application
-	// creation, because the only sane time to add storage attachments
-	// is when units are added to said application.
+	// Shared storage instances are not created here: they are created
+	// lazily, owned by the application, when units are added. See
+	// applicationSharedStorageOps.
 
 	return ops, storageTags, numStorageAttachments, nil
 }
 
+// applicationSharedStorageOps returns txn.Ops to attach the given unit
+// to the application's shared storage instances, creating any that do
+// not yet exist. Shared storage instances are owned by the application;
+// the first unit that needs one creates it, and every subsequent unit
+// attaches to it, so co-located units share a single filesystem.
+//
+// pending carries instance docs created earlier in the same transaction
+// (eg for the other units of an initial deployment). Their attachment
+// counts are updated in place, before the transaction is run, to avoid
+// issuing two operations against the same document.
+func applicationSharedStorageOps(
+	sb *storageBackend,
+	app *Application,
+	unitTag names.UnitTag,
+	ch *Charm,
+	cons map[string]StorageConstraints,
+	maybeMachineAssignable machineAssignable,
+	pending map[string][]*storageInstanceDoc,
+) (ops []txn.Op, storageTags map[string][]names.StorageTag, numStorageAttachments int, err error) {
+
+	fail := func(err error) ([]txn.Op, map[string][]names.StorageTag, int, error) {
+		return nil, nil, -1, err
+	}
+
+	charmMeta := ch.Meta()
+	sharedNames := set.NewStrings()
+	for name, charmStorage := range charmMeta.Storage {
+		if charmStorage.Shared {
+			sharedNames.Add(name)
+		}
+	}
+
+	storageTags = make(map[string][]names.StorageTag)
+	for _, name := range sharedNames.SortedValues() {
+		charmStorage := charmMeta.Storage[name]
+		storageCons, ok := cons[name]
+		if !ok || storageCons.Count == 0 {
+			continue
+		}
+		if charmStorage.Type != charm.StorageFilesystem {
+			return fail(errors.NotSupportedf("shared %v storage", charmStorage.Type))
+		}
+
+		// Attach existing instances owned by the application.
+		existing, err := sb.storageInstances(bson.D{
+			{"owner", app.Tag().String()},
+			{"storagename", name},
+		})
+		if err != nil {
+			return fail(errors.Trace(err))
+		}
+		for _, si := range existing {
+			attachOps, err := sb.attachStorageOps(
+				si, unitTag, app.doc.Series, ch, maybeMachineAssignable,
+			)
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			if err != nil {
+				return fail(errors.Trace(err))
+			}
+			ops = append(ops, attachOps...)
+			numStorageAttachments++
+			storageTags[name] = append(storageTags[name], si.StorageTag())
+		}
+
+		// Attach instances created for earlier units in this
+		// transaction.
+		for _, doc := range pending[name] {
+			doc.AttachmentCount++
+			storageTag := names.NewStorageTag(doc.Id)
+			ops = append(ops, createStorageAttachmentOp(storageTag, unitTag))
+			numStorageAttachments++
+			storageTags[name] = append(storageTags[name], storageTag)
+		}
+
+		// Create any shortfall.
+		for have := len(existing) + len(pending[name]); uint64(have) < storageCons.Count; have++ {
+			id, err := newStorageInstanceId(sb.mb, name)
+			if err != nil {
+				return fail(errors.Annotate(err, "cannot generate storage instance name"))
+			}
+			storageTag := names.NewStorageTag(id)
+			doc := &storageInstanceDoc{
+				Id:              id,
+				Kind:            StorageKindFilesystem,
+				Owner:           app.Tag().String(),
+				StorageName:     name,
+				AttachmentCount: 1,
+				Constraints: storageInstanceConstraints{
+					Pool: storageCons.Pool,
+					Size: storageCons.Size,
+				},
+			}
+			ops = append(ops,
+				createStorageAttachmentOp(storageTag, unitTag),
+				txn.Op{
+					C:      storageInstancesC,
+					Id:     id,
+					Assert: txn.DocMissing,
+					Insert: doc,
+				},
+			)
+			numStorageAttachments++
+			storageTags[name] = append(storageTags[name], storageTag)
+			if maybeMachineAssignable != nil {
+				machineOps, err := unitAssignedMachineStorageOps(
+					sb, unitTag, charmMeta, app.doc.Series,
+					&storageInstance{sb, *doc},
+					maybeMachineAssignable,
+				)
+				if err != nil {
+					return fail(errors.Annotatef(
+						err, "creating machine storage for storage %s", id,
+					))
+				}
+				ops = append(ops, machineOps...)
+			}
+			pending[name] = append(pending[name], doc)
+		}
+	}
+	return ops, storageTags, numStorageAttachments, nil
+}
+
 // unitAssignedMachineStorageOps returns ops for creating volumes, filesystems
 // and their attachments to the machine that the specified unit is assigned to,
 // corresponding to the specified storage instance.
@@ -1756,11 +1877,18 @@ func validateStorageConstraintsAgainstCharm(
 			return errors.Errorf("charm %q has no store called %q", charmMeta.Name, name)
 		}
 		if charmStorage.Shared {
-			// TODO(axw) implement shared storage support.
-			return errors.Errorf(
-				"charm %q store %q: shared storage support not implemented",
-				charmMeta.Name, name,
-			)
+			if sb.modelType == ModelTypeCAAS {
+				return errors.NotSupportedf(
+					"charm %q store %q: shared storage on container models",
+					charmMeta.Name, name,
+				)
+			}
+			if charmStorage.Type != charm.StorageFilesystem {
+				return errors.NotSupportedf(
+					"charm %q store %q: shared block storage",
+					charmMeta.Name, name,
+				)
+			}
 		}
 		if err := validateCharmStorageCount(charmStorage, cons.Count); err != nil {
 			return errors.Annotatef(err, "charm %q store %q", charmMeta.Name, name)
@@ -1930,17 +2058,7 @@ func addDefaultStorageConstraints(sb *storageBackend, allCons map[string]Storage
 	}
 
 	for name, charmStorage := range charmMeta.Storage {
-		cons, ok := allCons[name]
-		if !ok {
-			if charmStorage.Shared {
-				// TODO(axw) get the model's default shared storage
-				// pool, and create constraints here.
-				return errors.Errorf(
-					"no constraints specified for shared charm storage %q",
-					name,
-				)
-			}
-		}
+		cons := allCons[name]
 		cons, err := storageConstraintsWithDefaults(sb.modelType, conf, charmStorage, name, cons)
 		if err != nil {
 			return errors.Trace(err)
@@ -2306,7 +2424,24 @@ func (sb *storageBackend) hostStorageOps(
 			})
 		}
 	}
+	hostTag := storageAttachmentHost(hostId)
+	var assertOps []txn.Op
 	for tag, filesystemAttachment := range args.filesystemAttachments {
+		if _, err := sb.FilesystemAttachment(hostTag, tag); err == nil {
+			// The filesystem is already attached to this host; this
+			// happens when a shared filesystem was attached by a
+			// co-located unit, in which case there is nothing to do
+			// beyond asserting that the attachment remains alive, so
+			// that a concurrent detach aborts the transaction.
+			assertOps = append(assertOps, txn.Op{
+				C:      filesystemAttachmentsC,
+				Id:     filesystemAttachmentId(hostId, tag.Id()),
+				Assert: isAliveDoc,
+			})
+			continue
+		} else if !errors.IsNotFound(err) {
+			return nil, nil, nil, errors.Trace(err)
+		}
 		fsAttachments = append(fsAttachments, filesystemAttachmentTemplate{
 			tag, names.StorageTag{}, filesystemAttachment, attachOnly,
 		})
@@ -2324,12 +2459,25 @@ func (sb *storageBackend) hostStorageOps(
 		})
 	}
 	for tag, volumeAttachment := range args.volumeAttachments {
+		if _, err := sb.VolumeAttachment(hostTag, tag); err == nil {
+			// Already attached to this host; see the filesystem
+			// attachment handling above.
+			assertOps = append(assertOps, txn.Op{
+				C:      volumeAttachmentsC,
+				Id:     volumeAttachmentId(hostId, tag.Id()),
+				Assert: isAliveDoc,
+			})
+			continue
+		} else if !errors.IsNotFound(err) {
+			return nil, nil, nil, errors.Trace(err)
+		}
 		volumeAttachments = append(volumeAttachments, volumeAttachmentTemplate{
 			tag, volumeAttachment, attachOnly,
 		})
 	}
 
-	ops := make([]txn.Op, 0, len(filesystemOps)+len(volumeOps)+len(fsAttachments)+len(volumeAttachments))
+	ops := make([]txn.Op, 0, len(assertOps)+len(filesystemOps)+len(volumeOps)+len(fsAttachments)+len(volumeAttachments))
+	ops = append(ops, assertOps...)
 	if len(fsAttachments) > 0 {
 		attachmentOps := createMachineFilesystemAttachmentsOps(hostId, fsAttachments)
 		ops = append(ops, filesystemOps...)
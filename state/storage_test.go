@@ -739,6 +739,108 @@ func (s *StorageStateSuite) TestStorageAttachments(c *gc.C) {
 	assertAttachments(names.NewStorageTag("multi2up/5"), u1)
 }
 
+func (s *StorageStateSuite) createSharedStorageCharm(c *gc.C, charmName string, storageType charm.StorageType) *state.Charm {
+	return s.createStorageCharm(c, charmName, charm.Storage{
+		Name:     "data",
+		Type:     storageType,
+		Shared:   true,
+		CountMin: 0,
+		CountMax: 1,
+	})
+}
+
+func (s *StorageStateSuite) assertUnitsAttachedTo(c *gc.C, storageTag names.StorageTag, units ...*state.Unit) {
+	for _, u := range units {
+		attachments, err := s.storageBackend.UnitStorageAttachments(u.UnitTag())
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(attachments, gc.HasLen, 1)
+		c.Assert(attachments[0].StorageInstance(), gc.Equals, storageTag)
+	}
+}
+
+func (s *StorageStateSuite) TestSharedStorageUnitsShareInstance(c *gc.C) {
+	ch := s.createSharedStorageCharm(c, "storage-shared", charm.StorageFilesystem)
+	app := s.AddTestingApplicationWithStorage(c, "storage-shared", ch, map[string]state.StorageConstraints{
+		"data": makeStorageCons("rootfs", 1024, 1),
+	})
+	u0, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	u1, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The first unit creates the instance, owned by the application;
+	// the second attaches to it rather than creating its own.
+	all, err := s.storageBackend.AllStorageInstances()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, gc.HasLen, 1)
+	owner, ok := all[0].Owner()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(owner, gc.Equals, app.Tag())
+	s.assertUnitsAttachedTo(c, all[0].StorageTag(), u0, u1)
+}
+
+func (s *StorageStateSuite) TestSharedStorageInitialUnitsShareInstance(c *gc.C) {
+	ch := s.createSharedStorageCharm(c, "storage-shared", charm.StorageFilesystem)
+	app, err := s.st.AddApplication(state.AddApplicationArgs{
+		Name:  "storage-shared",
+		Charm: ch,
+		Storage: map[string]state.StorageConstraints{
+			"data": makeStorageCons("rootfs", 1024, 1),
+		},
+		NumUnits: 2,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Units added in the initial deployment transaction share a
+	// pending instance doc rather than each creating their own.
+	units, err := app.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, gc.HasLen, 2)
+	all, err := s.storageBackend.AllStorageInstances()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, gc.HasLen, 1)
+	s.assertUnitsAttachedTo(c, all[0].StorageTag(), units...)
+}
+
+func (s *StorageStateSuite) TestSharedStorageCoLocatedUnitsShareFilesystemAttachment(c *gc.C) {
+	ch := s.createSharedStorageCharm(c, "storage-shared", charm.StorageFilesystem)
+	app := s.AddTestingApplicationWithStorage(c, "storage-shared", ch, map[string]state.StorageConstraints{
+		"data": makeStorageCons("rootfs", 1024, 1),
+	})
+	u0, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	err = u0.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+	machine := unitMachine(c, s.st, u0)
+	u1, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	err = u1.AssignToMachine(machine)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The machine has a single attachment to the shared filesystem:
+	// the second unit's assignment found it already attached.
+	filesystem := s.storageInstanceFilesystem(c, names.NewStorageTag("data/0"))
+	attachments, err := s.storageBackend.FilesystemAttachments(filesystem.FilesystemTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(attachments, gc.HasLen, 1)
+	c.Assert(attachments[0].Host(), gc.Equals, machine.MachineTag())
+	machineAttachments, err := s.storageBackend.MachineFilesystemAttachments(machine.MachineTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machineAttachments, gc.HasLen, 1)
+}
+
+func (s *StorageStateSuite) TestSharedBlockStorageNotSupported(c *gc.C) {
+	ch := s.createSharedStorageCharm(c, "storage-shared-block", charm.StorageBlock)
+	_, err := s.st.AddApplication(state.AddApplicationArgs{
+		Name:  "storage-shared-block",
+		Charm: ch,
+		Storage: map[string]state.StorageConstraints{
+			"data": makeStorageCons("loop", 1024, 1),
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, `.*charm "storage-shared-block" store "data": shared block storage not supported`)
+}
+
 func (s *StorageStateSuite) TestAllStorageInstancesEmpty(c *gc.C) {
 	all, err := s.storageBackend.AllStorageInstances()
 	c.Assert(err, jc.ErrorIsNil)
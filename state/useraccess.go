@@ -31,6 +31,10 @@ type UserAccessSpec struct {
 	CreatedBy   names.UserTag
 	DisplayName string
 	Access      permission.Access
+
+	// ExpiresAt, if set, is when the access grant lapses and is
+	// removed again. Expiring grants are only supported for models.
+	ExpiresAt *time.Time
 }
 
 // userAccessTarget defines the target of a user access granting.
@@ -77,6 +81,14 @@ func (st *State) addUserAccess(spec UserAccessSpec, target userAccessTarget) (pe
 			return permission.UserAccess{}, errors.Annotatef(err, "createdBy user %q does not exist locally", spec.CreatedBy.Name())
 		}
 	}
+	if spec.ExpiresAt != nil {
+		if target.globalKey != modelGlobalKey {
+			return permission.UserAccess{}, errors.NotSupportedf("expiring controller access grants")
+		}
+		if !spec.ExpiresAt.After(st.stateClock.Now()) {
+			return permission.UserAccess{}, errors.NotValidf("access expiry time %v in the past", spec.ExpiresAt.UTC())
+		}
+	}
 	var (
 		ops       []txn.Op
 		err       error
@@ -90,8 +102,13 @@ func (st *State) addUserAccess(spec UserAccessSpec, target userAccessTarget) (pe
 			spec.CreatedBy,
 			spec.DisplayName,
 			st.nowToTheSecond(),
-			spec.Access)
+			spec.Access,
+			spec.ExpiresAt)
 		targetTag = names.NewModelTag(target.uuid)
+		if spec.ExpiresAt != nil {
+			ops = append(ops, newCleanupAtOp(
+				*spec.ExpiresAt, cleanupExpiredAccessGrant, spec.User.Id(), targetTag.String(), *spec.ExpiresAt))
+		}
 	case controllerGlobalKey:
 		ops = createControllerUserOps(
 			st.ControllerUUID(),
@@ -152,6 +169,10 @@ func (st *State) UserPermission(subject names.UserTag, target names.Tag) (permis
 		if err != nil {
 			return "", errors.Trace(err)
 		}
+		if access.Expired(st.stateClock.Now()) {
+			// The grant has lapsed but has not been cleaned up yet.
+			return "", errors.NotFoundf("user permission for %q on %q", subject.Id(), target.Id())
+		}
 		return access.Access, nil
 	case names.ApplicationOfferTagKind:
 		offerUUID, err := applicationOfferUUID(st, target.Id())
@@ -176,6 +197,7 @@ func newUserAccess(perm *userPermission, userDoc userAccessDoc, object names.Tag
 		DateCreated: userDoc.DateCreated.UTC(),
 		DisplayName: userDoc.DisplayName,
 		UserName:    userDoc.UserName,
+		ExpiresAt:   perm.doc.ExpiresAt,
 	}
 }
 
@@ -5,6 +5,7 @@ package state
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/mgo/v2"
@@ -29,6 +30,9 @@ type permissionDoc struct {
 	SubjectGlobalKey string `bson:"subject-global-key"`
 	// Access is the permission level.
 	Access string `bson:"access"`
+	// ExpiresAt, if set, is when the permission lapses and is
+	// removed again.
+	ExpiresAt *time.Time `bson:"expires-at,omitempty"`
 }
 
 func stringToAccess(a string) permission.Access {
@@ -76,6 +80,15 @@ func (p *userPermission) access() permission.Access {
 	return stringToAccess(p.doc.Access)
 }
 
+// expiresAt returns the time at which the permission lapses, if an
+// expiry has been set.
+func (p *userPermission) expiresAt() (time.Time, bool) {
+	if p.doc.ExpiresAt == nil {
+		return time.Time{}, false
+	}
+	return *p.doc.ExpiresAt, true
+}
+
 func permissionID(objectGlobalKey, subjectGlobalKey string) string {
 	// example: e#deadbeef#us#jim
 	// e: object global key
@@ -114,7 +127,14 @@ func removePermissionOp(objectGlobalKey, subjectGlobalKey string) txn.Op {
 
 }
 func createPermissionOp(objectGlobalKey, subjectGlobalKey string, access permission.Access) txn.Op {
+	return createPermissionWithExpiryOp(objectGlobalKey, subjectGlobalKey, access, nil)
+}
+
+// createPermissionWithExpiryOp creates a permission that, if expiresAt
+// is set, lapses and is removed again at that time.
+func createPermissionWithExpiryOp(objectGlobalKey, subjectGlobalKey string, access permission.Access, expiresAt *time.Time) txn.Op {
 	doc := makePermissionDoc(objectGlobalKey, subjectGlobalKey, access)
+	doc.ExpiresAt = expiresAt
 	return txn.Op{
 		C:      permissionsC,
 		Id:     permissionID(objectGlobalKey, subjectGlobalKey),
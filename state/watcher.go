@@ -1995,6 +1995,12 @@ func (model *Model) WatchForModelConfigChanges() NotifyWatcher {
 	return newEntityWatcher(model.st, settingsC, model.st.docID(modelGlobalKey))
 }
 
+// WatchModelConstraints returns a NotifyWatcher waiting for the model
+// constraints to change.
+func (model *Model) WatchModelConstraints() NotifyWatcher {
+	return newEntityWatcher(model.st, constraintsC, model.st.docID(modelGlobalKey))
+}
+
 // WatchCloudSpecChanges returns a NotifyWatcher waiting for the cloud
 // to change for the model.
 func (model *Model) WatchCloudSpecChanges() NotifyWatcher {
@@ -0,0 +1,118 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/txn"
+)
+
+// webhookEndpointDoc holds a webhook endpoint registered on the
+// controller to receive deployment events.
+type webhookEndpointDoc struct {
+	DocID        string   `bson:"_id"`
+	Name         string   `bson:"name"`
+	URL          string   `bson:"url"`
+	Secret       string   `bson:"secret"`
+	EventFilters []string `bson:"event-filters,omitempty"`
+}
+
+// WebhookEndpoint describes a webhook endpoint registered on the
+// controller to receive deployment events.
+type WebhookEndpoint struct {
+	// Name uniquely identifies the endpoint on the controller.
+	Name string
+
+	// URL is the http or https URL events are POSTed to.
+	URL string
+
+	// Secret is the shared secret used to sign event payloads.
+	Secret string
+
+	// EventFilters restricts delivery to the named event types. An
+	// empty filter delivers all events.
+	EventFilters []string
+}
+
+func (doc webhookEndpointDoc) endpoint() WebhookEndpoint {
+	return WebhookEndpoint{
+		Name:         doc.Name,
+		URL:          doc.URL,
+		Secret:       doc.Secret,
+		EventFilters: doc.EventFilters,
+	}
+}
+
+// AddWebhookEndpoint registers a webhook endpoint to receive
+// deployment events. It is an error if an endpoint with the same name
+// is already registered.
+func (st *State) AddWebhookEndpoint(endpoint WebhookEndpoint) error {
+	doc := webhookEndpointDoc{
+		DocID:        endpoint.Name,
+		Name:         endpoint.Name,
+		URL:          endpoint.URL,
+		Secret:       endpoint.Secret,
+		EventFilters: endpoint.EventFilters,
+	}
+	ops := []txn.Op{{
+		C:      webhookEndpointsC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: &doc,
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.AlreadyExistsf("webhook endpoint %q", endpoint.Name)
+	}
+	return errors.Trace(err)
+}
+
+// RemoveWebhookEndpoint removes the named webhook endpoint.
+func (st *State) RemoveWebhookEndpoint(name string) error {
+	ops := []txn.Op{{
+		C:      webhookEndpointsC,
+		Id:     name,
+		Assert: txn.DocExists,
+		Remove: true,
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("webhook endpoint %q", name)
+	}
+	return errors.Trace(err)
+}
+
+// WebhookEndpoint returns the named webhook endpoint, or an error
+// satisfying errors.IsNotFound if none is registered.
+func (st *State) WebhookEndpoint(name string) (WebhookEndpoint, error) {
+	coll, closer := st.db().GetCollection(webhookEndpointsC)
+	defer closer()
+
+	var doc webhookEndpointDoc
+	err := coll.FindId(name).One(&doc)
+	if err == mgo.ErrNotFound {
+		return WebhookEndpoint{}, errors.NotFoundf("webhook endpoint %q", name)
+	} else if err != nil {
+		return WebhookEndpoint{}, errors.Trace(err)
+	}
+	return doc.endpoint(), nil
+}
+
+// AllWebhookEndpoints returns the webhook endpoints registered on the
+// controller, ordered by name.
+func (st *State) AllWebhookEndpoints() ([]WebhookEndpoint, error) {
+	coll, closer := st.db().GetCollection(webhookEndpointsC)
+	defer closer()
+
+	var docs []webhookEndpointDoc
+	if err := coll.Find(nil).Sort("name").All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	endpoints := make([]WebhookEndpoint, len(docs))
+	for i, doc := range docs {
+		endpoints[i] = doc.endpoint()
+	}
+	return endpoints, nil
+}
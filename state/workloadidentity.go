@@ -0,0 +1,93 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/mgo/v2/txn"
+	jujutxn "github.com/juju/txn/v2"
+)
+
+// workloadIdentityKeyDoc holds the model's workload identity signing
+// key. There is a single doc per model, created lazily the first time a
+// token is issued.
+type workloadIdentityKeyDoc struct {
+	DocID     string    `bson:"_id"`
+	ModelUUID string    `bson:"model-uuid"`
+	Key       []byte    `bson:"key"`
+	Created   time.Time `bson:"created"`
+}
+
+const workloadIdentityKeyID = "workloadIdentityKey"
+
+// WorkloadIdentityKey returns the ECDSA key used to sign workload
+// identity tokens issued for units of this model, generating and
+// persisting one the first time it is asked for.
+func (st *State) WorkloadIdentityKey() (*ecdsa.PrivateKey, error) {
+	readKey := func() (*ecdsa.PrivateKey, error) {
+		coll, closer := st.db().GetCollection(workloadIdentityKeysC)
+		defer closer()
+
+		var doc workloadIdentityKeyDoc
+		err := coll.FindId(workloadIdentityKeyID).One(&doc)
+		if err != nil {
+			return nil, err
+		}
+		key, err := x509.ParseECPrivateKey(doc.Key)
+		if err != nil {
+			return nil, errors.Annotate(err, "parsing workload identity key")
+		}
+		return key, nil
+	}
+
+	key, err := readKey()
+	if err == nil {
+		return key, nil
+	} else if err != mgo.ErrNotFound {
+		return nil, errors.Trace(err)
+	}
+
+	generated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Annotate(err, "generating workload identity key")
+	}
+	der, err := x509.MarshalECPrivateKey(generated)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if _, err := readKey(); err == nil {
+			// Another issuer won the race; use its key.
+			return nil, jujutxn.ErrNoOperations
+		} else if err != mgo.ErrNotFound {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:      workloadIdentityKeysC,
+			Id:     st.docID(workloadIdentityKeyID),
+			Assert: txn.DocMissing,
+			Insert: workloadIdentityKeyDoc{
+				DocID:     st.docID(workloadIdentityKeyID),
+				ModelUUID: st.ModelUUID(),
+				Key:       der,
+				Created:   st.stateClock.Now(),
+			},
+		}}, nil
+	}
+	if err := st.db().Run(buildTxn); err != nil {
+		return nil, errors.Trace(err)
+	}
+	key, err = readKey()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return key, nil
+}
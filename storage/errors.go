@@ -0,0 +1,53 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/errors"
+)
+
+const (
+	// ErrQuotaLimited classifies a provisioning failure caused by a
+	// provider quota or capacity limit. Retrying will not help until
+	// the quota is raised or capacity is freed, so retries are spaced
+	// at the maximum interval.
+	ErrQuotaLimited = errors.ConstError("quota limited")
+
+	// ErrThrottled classifies a provisioning failure caused by
+	// provider API rate limiting. These failures are expected to
+	// clear quickly, so retries begin at a short interval.
+	ErrThrottled = errors.ConstError("throttled")
+
+	// ErrInvalidParameters classifies a provisioning failure caused
+	// by invalid volume or filesystem parameters. Retrying with the
+	// same parameters cannot succeed, so no retry is scheduled.
+	ErrInvalidParameters = errors.ConstError("invalid parameters")
+)
+
+// QuotaLimitedError wraps err so that it satisfies
+// Is(err, ErrQuotaLimited). A nil error is returned as nil.
+func QuotaLimitedError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.SetLocation(errors.WithType(err, ErrQuotaLimited), 1)
+}
+
+// ThrottledError wraps err so that it satisfies
+// Is(err, ErrThrottled). A nil error is returned as nil.
+func ThrottledError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.SetLocation(errors.WithType(err, ErrThrottled), 1)
+}
+
+// InvalidParametersError wraps err so that it satisfies
+// Is(err, ErrInvalidParameters). A nil error is returned as nil.
+func InvalidParametersError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.SetLocation(errors.WithType(err, ErrInvalidParameters), 1)
+}
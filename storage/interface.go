@@ -249,6 +249,12 @@ type VolumeParams struct {
 	// once the instance is created there are still unprovisioned volumes,
 	// the dynamic storage provisioner will take care of creating them.
 	Attachment *VolumeAttachmentParams
+
+	// RequireEncrypted indicates that the model policy requires the
+	// volume to be encrypted at rest. The storage provisioner will
+	// refuse to record a volume that the provider reports as
+	// unencrypted.
+	RequireEncrypted bool
 }
 
 // VolumeAttachmentParams is a set of parameters for volume attachment or
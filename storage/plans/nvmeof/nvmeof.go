@@ -0,0 +1,252 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package nvmeof
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/retry"
+	"github.com/juju/utils/v3/exec"
+
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/plans/common"
+)
+
+var logger = loggo.GetLogger("juju.storage.plans.nvmeof")
+
+var sysfsNVMe = "/sys/class/nvme"
+
+// DefaultTransport is the fabric transport used when the provider does
+// not specify one. TCP is the only transport that works without special
+// host adapters, so it is the safe default.
+const DefaultTransport = "tcp"
+
+type nvmeofPlan struct{}
+
+func NewNVMEoFPlan() common.Plan {
+	return &nvmeofPlan{}
+}
+
+func (p *nvmeofPlan) AttachVolume(volumeInfo map[string]string) (storage.BlockDevice, error) {
+	plan, err := newNVMEoFInfo(volumeInfo)
+	if err != nil {
+		return storage.BlockDevice{}, errors.Trace(err)
+	}
+	return plan.attach()
+}
+
+func (p *nvmeofPlan) DetachVolume(volumeInfo map[string]string) error {
+	plan, err := newNVMEoFInfo(volumeInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return plan.detach()
+}
+
+type nvmeofConnectionInfo struct {
+	nqn       string
+	address   string
+	port      int
+	transport string
+}
+
+var runCommand = func(params []string) (*exec.ExecResponse, error) {
+	cmd := strings.Join(params, " ")
+	execParams := exec.RunParams{
+		Commands: cmd,
+	}
+	resp, err := exec.RunCommands(execParams)
+
+	return resp, err
+}
+
+func getHardwareInfo(name string) (storage.BlockDevice, error) {
+	cmd := []string{
+		"udevadm", "info",
+		"-q", "property",
+		"--path", fmt.Sprintf("/block/%s", name),
+	}
+
+	result, err := runCommand(cmd)
+	if err != nil {
+		return storage.BlockDevice{}, errors.Annotatef(err, "error running udevadm")
+	}
+	blockDevice := storage.BlockDevice{
+		DeviceName: name,
+	}
+	var busId, serialId string
+	s := bufio.NewScanner(bytes.NewReader(result.Stdout))
+	for s.Scan() {
+		line := s.Text()
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "=", 2)
+		if len(fields) != 2 {
+			logger.Tracef("failed to parse line %s", line)
+			continue
+		}
+
+		key := fields[0]
+		value := fields[1]
+		switch key {
+		case "ID_WWN":
+			blockDevice.WWN = value
+		case "DEVLINKS":
+			blockDevice.DeviceLinks = strings.Split(value, " ")
+		case "ID_BUS":
+			busId = value
+		case "ID_SERIAL":
+			serialId = value
+		}
+	}
+	if busId != "" && serialId != "" {
+		blockDevice.HardwareId = fmt.Sprintf("%s-%s", busId, serialId)
+	}
+	return blockDevice, nil
+}
+
+func newNVMEoFInfo(info map[string]string) (*nvmeofConnectionInfo, error) {
+	var nqn, address, port, transport string
+	var ok bool
+	if nqn, ok = info["nqn"]; !ok {
+		return nil, errors.Errorf("missing required field: nqn")
+	}
+	if address, ok = info["address"]; !ok {
+		return nil, errors.Errorf("missing required field: address")
+	}
+	if port, ok = info["port"]; !ok {
+		return nil, errors.Errorf("missing required field: port")
+	}
+
+	iPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, errors.Errorf("invalid port: %v", port)
+	}
+	if transport, ok = info["transport"]; !ok {
+		transport = DefaultTransport
+	}
+	plan := &nvmeofConnectionInfo{
+		nqn:       nqn,
+		address:   address,
+		port:      iPort,
+		transport: transport,
+	}
+	return plan, nil
+}
+
+// deviceName returns the name of the first block device (namespace)
+// exposed by the controller connected to our subsystem NQN.
+func (i *nvmeofConnectionInfo) deviceName() (string, error) {
+	controllers, err := ioutil.ReadDir(sysfsNVMe)
+	if err != nil {
+		return "", err
+	}
+
+	for _, ctrl := range controllers {
+		ctrlPath := filepath.Join(sysfsNVMe, ctrl.Name())
+		subsysnqn, err := ioutil.ReadFile(filepath.Join(ctrlPath, "subsysnqn"))
+		if err != nil {
+			logger.Tracef("failed to read subsysnqn for %s: %s", ctrl.Name(), err)
+			continue
+		}
+		if strings.TrimSpace(string(subsysnqn)) != i.nqn {
+			continue
+		}
+		entries, err := ioutil.ReadDir(ctrlPath)
+		if err != nil {
+			return "", err
+		}
+		for _, entry := range entries {
+			// Namespaces show up as nvme<ctrl>n<ns> inside the
+			// controller folder.
+			if !strings.HasPrefix(entry.Name(), ctrl.Name()+"n") {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(ctrlPath, entry.Name(), "size")); err != nil {
+				continue
+			}
+			return entry.Name(), nil
+		}
+	}
+	return "", errors.NotFoundf("device for nqn %s not found", i.nqn)
+}
+
+func (i *nvmeofConnectionInfo) isConnected() bool {
+	_, err := i.deviceName()
+	return err == nil
+}
+
+func (i *nvmeofConnectionInfo) connect() error {
+	if i.isConnected() {
+		return nil
+	}
+	connectCmd := []string{
+		"nvme", "connect",
+		"-t", i.transport,
+		"-a", i.address,
+		"-s", strconv.Itoa(i.port),
+		"-n", i.nqn,
+	}
+	result, err := runCommand(connectCmd)
+	if err != nil {
+		return errors.Annotatef(err, "nvme failed to connect to target: %s", result.Stderr)
+	}
+	return nil
+}
+
+func (i *nvmeofConnectionInfo) disconnect() error {
+	disconnectCmd := []string{
+		"nvme", "disconnect",
+		"-n", i.nqn,
+	}
+	result, err := runCommand(disconnectCmd)
+	if err != nil {
+		return errors.Annotatef(err, "nvme failed to disconnect from target: %s", result.Stderr)
+	}
+	return nil
+}
+
+func (i *nvmeofConnectionInfo) attach() (storage.BlockDevice, error) {
+	if err := i.connect(); err != nil {
+		return storage.BlockDevice{}, errors.Trace(err)
+	}
+	// Wait for device to show up
+	err := retry.Call(retry.CallArgs{
+		Func: func() error {
+			_, err := i.deviceName()
+			return err
+		},
+		Attempts: 20,
+		Delay:    time.Second,
+		Clock:    clock.WallClock,
+	})
+	if err != nil {
+		return storage.BlockDevice{}, errors.Trace(err)
+	}
+
+	devName, err := i.deviceName()
+	if err != nil {
+		return storage.BlockDevice{}, errors.Trace(err)
+	}
+	return getHardwareInfo(devName)
+}
+
+func (i *nvmeofConnectionInfo) detach() error {
+	if !i.isConnected() {
+		return nil
+	}
+	return errors.Trace(i.disconnect())
+}
@@ -16,6 +16,7 @@ var (
 		LoopProviderType:   &loopProvider{logAndExec},
 		RootfsProviderType: &rootfsProvider{logAndExec},
 		TmpfsProviderType:  &tmpfsProvider{logAndExec},
+		SANProviderType:    &sanProvider{},
 	}
 )
 
@@ -36,6 +36,7 @@ func (s *providerCommonSuite) TestCommonProvidersExported(c *gc.C) {
 		provider.LoopProviderType,
 		provider.RootfsProviderType,
 		provider.TmpfsProviderType,
+		provider.SANProviderType,
 	})
 }
 
@@ -139,3 +139,7 @@ func TmpfsFilesystemSource(etcDir, storageDir string, run func(string, ...string
 func TmpfsProvider(run func(string, ...string) (string, error)) storage.Provider {
 	return &tmpfsProvider{run}
 }
+
+func SANProvider() storage.Provider {
+	return &sanProvider{}
+}
@@ -0,0 +1,284 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/plans/nvmeof"
+)
+
+const (
+	// SANProviderType exposes externally managed SAN volumes
+	// (iSCSI or NVMe-oF targets) to Juju storage. The provider does
+	// not carve LUNs out of the SAN itself; a pool describes a
+	// pre-provisioned target, and the machine agent logs into it
+	// via the matching attachment plan.
+	SANProviderType = storage.ProviderType("san")
+
+	// SANProtocol selects the SAN fabric protocol: "iscsi" (the
+	// default) or "nvmeof".
+	SANProtocol = "protocol"
+
+	// SANAddress is the address of the target portal. Required.
+	SANAddress = "address"
+
+	// SANPort is the port of the target portal. Defaults to the
+	// protocol's well-known port if not set.
+	SANPort = "port"
+
+	// SANTarget is the target name: an IQN for iscsi, an NQN for
+	// nvmeof. Required.
+	SANTarget = "target"
+
+	// SANTransport is the fabric transport for nvmeof, e.g. "tcp"
+	// or "rdma". Defaults to "tcp".
+	SANTransport = "transport"
+
+	// SANChapUser and SANChapSecret hold CHAP credentials for
+	// iscsi targets. Both must be set together.
+	SANChapUser   = "chap-user"
+	SANChapSecret = "chap-secret"
+
+	sanProtocolISCSI  = "iscsi"
+	sanProtocolNVMEoF = "nvmeof"
+
+	defaultISCSIPort  = "3260"
+	defaultNVMEoFPort = "4420"
+)
+
+// sanProvider creates volume sources which expose externally managed
+// SAN targets as volumes.
+type sanProvider struct{}
+
+var _ storage.Provider = (*sanProvider)(nil)
+
+// ValidateConfig is defined on the Provider interface.
+func (*sanProvider) ValidateConfig(cfg *storage.Config) error {
+	protocol, ok := cfg.ValueString(SANProtocol)
+	if !ok {
+		protocol = sanProtocolISCSI
+	}
+	switch protocol {
+	case sanProtocolISCSI, sanProtocolNVMEoF:
+	default:
+		return errors.NotValidf("SAN protocol %q", protocol)
+	}
+	if address, ok := cfg.ValueString(SANAddress); !ok || address == "" {
+		return errors.New("SAN target address not specified")
+	}
+	if target, ok := cfg.ValueString(SANTarget); !ok || target == "" {
+		return errors.New("SAN target name not specified")
+	}
+	if port, ok := cfg.ValueString(SANPort); ok {
+		if _, err := strconv.Atoi(port); err != nil {
+			return errors.NotValidf("SAN target port %q", port)
+		}
+	}
+	chapUser, _ := cfg.ValueString(SANChapUser)
+	chapSecret, _ := cfg.ValueString(SANChapSecret)
+	if (chapUser == "") != (chapSecret == "") {
+		return errors.Errorf("%s and %s must be specified together", SANChapUser, SANChapSecret)
+	}
+	if protocol != sanProtocolISCSI && chapUser != "" {
+		return errors.Errorf("CHAP authentication is only supported for %s", sanProtocolISCSI)
+	}
+	if _, ok := cfg.ValueString(SANTransport); ok && protocol != sanProtocolNVMEoF {
+		return errors.Errorf("%s is only supported for %s", SANTransport, sanProtocolNVMEoF)
+	}
+	return nil
+}
+
+// VolumeSource is defined on the Provider interface.
+func (p *sanProvider) VolumeSource(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+	if err := p.ValidateConfig(sourceConfig); err != nil {
+		return nil, err
+	}
+	return &sanVolumeSource{config: sourceConfig}, nil
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (p *sanProvider) FilesystemSource(providerConfig *storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// Supports is defined on the Provider interface.
+func (*sanProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is defined on the Provider interface.
+func (*sanProvider) Scope() storage.Scope {
+	return storage.ScopeEnviron
+}
+
+// Dynamic is defined on the Provider interface.
+func (*sanProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*sanProvider) Releasable() bool {
+	// The volumes live on the SAN regardless of what Juju does,
+	// so releasing them from the model is always possible.
+	return true
+}
+
+// DefaultPools is defined on the Provider interface.
+func (*sanProvider) DefaultPools() []*storage.Config {
+	return nil
+}
+
+// sanVolumeSource exposes the SAN target described by a pool as a
+// volume. Creation and destruction of the backing LUN or namespace
+// happen out of band on the SAN itself.
+type sanVolumeSource struct {
+	config *storage.Config
+}
+
+var _ storage.VolumeSource = (*sanVolumeSource)(nil)
+
+func (s *sanVolumeSource) protocol() string {
+	protocol, ok := s.config.ValueString(SANProtocol)
+	if !ok {
+		return sanProtocolISCSI
+	}
+	return protocol
+}
+
+func (s *sanVolumeSource) target() string {
+	target, _ := s.config.ValueString(SANTarget)
+	return target
+}
+
+// planInfo returns the attachment plan the machine agent uses to log
+// into the target. The attribute keys must match what the plan for
+// the device type expects.
+func (s *sanVolumeSource) planInfo() *storage.VolumeAttachmentPlanInfo {
+	address, _ := s.config.ValueString(SANAddress)
+	attrs := map[string]string{
+		"address": address,
+	}
+	switch s.protocol() {
+	case sanProtocolNVMEoF:
+		attrs["nqn"] = s.target()
+		if port, ok := s.config.ValueString(SANPort); ok {
+			attrs["port"] = port
+		} else {
+			attrs["port"] = defaultNVMEoFPort
+		}
+		if transport, ok := s.config.ValueString(SANTransport); ok {
+			attrs["transport"] = transport
+		} else {
+			attrs["transport"] = nvmeof.DefaultTransport
+		}
+		return &storage.VolumeAttachmentPlanInfo{
+			DeviceType:       storage.DeviceTypeNVMEoF,
+			DeviceAttributes: attrs,
+		}
+	default:
+		attrs["iqn"] = s.target()
+		if port, ok := s.config.ValueString(SANPort); ok {
+			attrs["port"] = port
+		} else {
+			attrs["port"] = defaultISCSIPort
+		}
+		if chapUser, ok := s.config.ValueString(SANChapUser); ok && chapUser != "" {
+			chapSecret, _ := s.config.ValueString(SANChapSecret)
+			attrs["chap-user"] = chapUser
+			attrs["chap-secret"] = chapSecret
+		}
+		return &storage.VolumeAttachmentPlanInfo{
+			DeviceType:       storage.DeviceTypeISCSI,
+			DeviceAttributes: attrs,
+		}
+	}
+}
+
+// CreateVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) CreateVolumes(ctx context.ProviderCallContext, args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(args))
+	for i, arg := range args {
+		// The backing LUN is provisioned out of band on the SAN;
+		// all we do here is record it against the model.
+		results[i].Volume = &storage.Volume{
+			arg.Tag,
+			storage.VolumeInfo{
+				VolumeId:   s.target(),
+				Size:       arg.Size,
+				Persistent: true,
+			},
+		}
+	}
+	return results, nil
+}
+
+// ListVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) ListVolumes(ctx context.ProviderCallContext) ([]string, error) {
+	// The SAN cannot be enumerated from here; only the configured
+	// target is known.
+	return []string{s.target()}, nil
+}
+
+// DescribeVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) DescribeVolumes(ctx context.ProviderCallContext, volumeIds []string) ([]storage.DescribeVolumesResult, error) {
+	results := make([]storage.DescribeVolumesResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		if volumeId != s.target() {
+			results[i].Error = errors.NotFoundf("volume %q", volumeId)
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId:   volumeId,
+			Persistent: true,
+		}
+	}
+	return results, nil
+}
+
+// DestroyVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) DestroyVolumes(ctx context.ProviderCallContext, volumeIds []string) ([]error, error) {
+	// Destroying the backing LUN is the SAN operator's business;
+	// removing the volume from the model is all Juju can do.
+	return make([]error, len(volumeIds)), nil
+}
+
+// ReleaseVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) ReleaseVolumes(ctx context.ProviderCallContext, volumeIds []string) ([]error, error) {
+	return make([]error, len(volumeIds)), nil
+}
+
+// ValidateVolumeParams is defined on the VolumeSource interface.
+func (s *sanVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	return nil
+}
+
+// AttachVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) AttachVolumes(ctx context.ProviderCallContext, args []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	results := make([]storage.AttachVolumesResult, len(args))
+	for i, arg := range args {
+		// The actual login happens on the machine: the plan info
+		// tells the machine agent how to reach the target.
+		results[i].VolumeAttachment = &storage.VolumeAttachment{
+			arg.Volume,
+			arg.Machine,
+			storage.VolumeAttachmentInfo{
+				ReadOnly: arg.ReadOnly,
+				PlanInfo: s.planInfo(),
+			},
+		}
+	}
+	return results, nil
+}
+
+// DetachVolumes is defined on the VolumeSource interface.
+func (s *sanVolumeSource) DetachVolumes(ctx context.ProviderCallContext, args []storage.VolumeAttachmentParams) ([]error, error) {
+	// The machine agent logs out of the target when it processes
+	// the dying attachment plan; there is nothing to undo here.
+	return make([]error, len(args)), nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
+	"github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&sanSuite{})
+
+type sanSuite struct {
+	testing.BaseSuite
+
+	callCtx context.ProviderCallContext
+}
+
+func (s *sanSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.callCtx = context.NewEmptyCloudCallContext()
+}
+
+func (s *sanSuite) sanConfig(c *gc.C, attrs map[string]interface{}) *storage.Config {
+	cfg, err := storage.NewConfig("san", provider.SANProviderType, attrs)
+	c.Assert(err, jc.ErrorIsNil)
+	return cfg
+}
+
+func (s *sanSuite) TestValidateConfigMissingAddress(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"target": "iqn.2023-01.com.example:target0",
+	}))
+	c.Assert(err, gc.ErrorMatches, "SAN target address not specified")
+}
+
+func (s *sanSuite) TestValidateConfigMissingTarget(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"address": "192.168.10.1",
+	}))
+	c.Assert(err, gc.ErrorMatches, "SAN target name not specified")
+}
+
+func (s *sanSuite) TestValidateConfigBadProtocol(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"protocol": "fcoe",
+		"address":  "192.168.10.1",
+		"target":   "iqn.2023-01.com.example:target0",
+	}))
+	c.Assert(err, gc.ErrorMatches, `SAN protocol "fcoe" not valid`)
+}
+
+func (s *sanSuite) TestValidateConfigBadPort(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"address": "192.168.10.1",
+		"target":  "iqn.2023-01.com.example:target0",
+		"port":    "not-a-port",
+	}))
+	c.Assert(err, gc.ErrorMatches, `SAN target port "not-a-port" not valid`)
+}
+
+func (s *sanSuite) TestValidateConfigChapCredentialsTogether(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"address":   "192.168.10.1",
+		"target":    "iqn.2023-01.com.example:target0",
+		"chap-user": "admin",
+	}))
+	c.Assert(err, gc.ErrorMatches, "chap-user and chap-secret must be specified together")
+}
+
+func (s *sanSuite) TestValidateConfigChapRequiresISCSI(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"protocol":    "nvmeof",
+		"address":     "192.168.10.1",
+		"target":      "nqn.2023-01.com.example:subsys0",
+		"chap-user":   "admin",
+		"chap-secret": "secret",
+	}))
+	c.Assert(err, gc.ErrorMatches, "CHAP authentication is only supported for iscsi")
+}
+
+func (s *sanSuite) TestValidateConfigTransportRequiresNVMEoF(c *gc.C) {
+	p := provider.SANProvider()
+	err := p.ValidateConfig(s.sanConfig(c, map[string]interface{}{
+		"address":   "192.168.10.1",
+		"target":    "iqn.2023-01.com.example:target0",
+		"transport": "tcp",
+	}))
+	c.Assert(err, gc.ErrorMatches, "transport is only supported for nvmeof")
+}
+
+func (s *sanSuite) TestCreateVolumes(c *gc.C) {
+	p := provider.SANProvider()
+	source, err := p.VolumeSource(s.sanConfig(c, map[string]interface{}{
+		"address": "192.168.10.1",
+		"target":  "iqn.2023-01.com.example:target0",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := source.CreateVolumes(s.callCtx, []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume, jc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("0"),
+		storage.VolumeInfo{
+			VolumeId:   "iqn.2023-01.com.example:target0",
+			Size:       1024,
+			Persistent: true,
+		},
+	})
+}
+
+func (s *sanSuite) TestAttachVolumesISCSI(c *gc.C) {
+	p := provider.SANProvider()
+	source, err := p.VolumeSource(s.sanConfig(c, map[string]interface{}{
+		"address":     "192.168.10.1",
+		"target":      "iqn.2023-01.com.example:target0",
+		"chap-user":   "admin",
+		"chap-secret": "secret",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := source.AttachVolumes(s.callCtx, []storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "iqn.2023-01.com.example:target0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine: names.NewMachineTag("1"),
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].VolumeAttachment.VolumeAttachmentInfo.PlanInfo, jc.DeepEquals, &storage.VolumeAttachmentPlanInfo{
+		DeviceType: storage.DeviceTypeISCSI,
+		DeviceAttributes: map[string]string{
+			"iqn":         "iqn.2023-01.com.example:target0",
+			"address":     "192.168.10.1",
+			"port":        "3260",
+			"chap-user":   "admin",
+			"chap-secret": "secret",
+		},
+	})
+}
+
+func (s *sanSuite) TestAttachVolumesNVMEoF(c *gc.C) {
+	p := provider.SANProvider()
+	source, err := p.VolumeSource(s.sanConfig(c, map[string]interface{}{
+		"protocol": "nvmeof",
+		"address":  "192.168.10.1",
+		"target":   "nqn.2023-01.com.example:subsys0",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := source.AttachVolumes(s.callCtx, []storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "nqn.2023-01.com.example:subsys0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine: names.NewMachineTag("1"),
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].VolumeAttachment.VolumeAttachmentInfo.PlanInfo, jc.DeepEquals, &storage.VolumeAttachmentPlanInfo{
+		DeviceType: storage.DeviceTypeNVMEoF,
+		DeviceAttributes: map[string]string{
+			"nqn":       "nqn.2023-01.com.example:subsys0",
+			"address":   "192.168.10.1",
+			"port":      "4420",
+			"transport": "tcp",
+		},
+	})
+}
+
+func (s *sanSuite) TestSupports(c *gc.C) {
+	p := provider.SANProvider()
+	c.Assert(p.Supports(storage.StorageKindBlock), jc.IsTrue)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), jc.IsFalse)
+	c.Assert(p.Scope(), gc.Equals, storage.ScopeEnviron)
+	c.Assert(p.Dynamic(), jc.IsTrue)
+	c.Assert(p.Releasable(), jc.IsTrue)
+}
@@ -8,8 +8,9 @@ import "github.com/juju/names/v4"
 type DeviceType string
 
 var (
-	DeviceTypeLocal DeviceType = "local"
-	DeviceTypeISCSI DeviceType = "iscsi"
+	DeviceTypeLocal  DeviceType = "local"
+	DeviceTypeISCSI  DeviceType = "iscsi"
+	DeviceTypeNVMEoF DeviceType = "nvmeof"
 )
 
 // Volume identifies and describes a volume (disk, logical volume, etc.)
@@ -41,6 +42,12 @@ type VolumeInfo struct {
 	// Persistent reflects whether the volume is destroyed with the
 	// machine to which it is attached.
 	Persistent bool
+
+	// Encrypted reflects whether the provider reports the volume as
+	// being encrypted at rest, e.g. with Azure SSE or a customer
+	// managed key. Providers that do not report encryption state
+	// leave this false.
+	Encrypted bool
 }
 
 // VolumeAttachment identifies and describes machine-specific volume
@@ -76,6 +83,8 @@ type VolumeAttachmentPlanInfo struct {
 	// * local - a block device that is directly attached to this instance
 	// * iscsi - an iSCSI disk. This type of disk will require the machine agent
 	// to execute additional steps before the device is available
+	// * nvmeof - an NVMe over Fabrics disk. As with iscsi, the machine agent
+	// must connect to the target before the device is available
 	DeviceType DeviceType
 	// DeviceAttributes is a map that contains DeviceType specific initialization
 	// values. For example, in the case of iscsi, it may contain server address:port,
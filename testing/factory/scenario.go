@@ -0,0 +1,171 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package factory
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/state"
+)
+
+// ScenarioMachineParams describes a group of machines to create as part
+// of a scenario.
+type ScenarioMachineParams struct {
+	MachineParams
+
+	// Count is the number of machines to create with these
+	// parameters; it defaults to 1.
+	Count int
+}
+
+// ScenarioApplicationParams describes an application, and optionally
+// some units of it, to create as part of a scenario.
+type ScenarioApplicationParams struct {
+	// Name is the application name; it defaults to the charm name.
+	Name string
+
+	// CharmName is the name of the test charm to deploy.
+	CharmName string
+
+	// Series is the series of the charm to deploy.
+	Series string
+
+	// NumUnits is the number of units to add. In an IAAS model each
+	// unit is assigned to a new machine.
+	NumUnits int
+
+	// Storage holds the storage constraints for the application.
+	Storage map[string]state.StorageConstraints
+
+	// Constraints holds the application constraints.
+	Constraints constraints.Value
+}
+
+// ScenarioRelationParams describes a relation to create between
+// applications in a scenario.
+type ScenarioRelationParams struct {
+	// Endpoints holds the endpoint specifiers to relate,
+	// eg "wordpress:db", "mysql:server". Endpoints may be
+	// omitted where they can be inferred.
+	Endpoints []string
+}
+
+// ScenarioOfferParams describes an application offer to create as part
+// of a scenario.
+type ScenarioOfferParams struct {
+	// OfferName is the name of the offer; it defaults to the
+	// application name.
+	OfferName string
+
+	// ApplicationName is the name of the offered application, which
+	// must appear in the scenario's applications.
+	ApplicationName string
+
+	// Endpoints is the list of endpoint names exposed by the offer.
+	Endpoints []string
+
+	// Owner is the name of the offer owner; a new admin user is
+	// created if it is not specified.
+	Owner string
+}
+
+// ScenarioParams declares a model topology - machines, applications
+// with units, relations and offers - to be created in a single call
+// with MakeScenario.
+type ScenarioParams struct {
+	Machines     []ScenarioMachineParams
+	Applications []ScenarioApplicationParams
+	Relations    []ScenarioRelationParams
+	Offers       []ScenarioOfferParams
+}
+
+// Scenario holds the entities created by MakeScenario, keyed where
+// applicable by name so that tests can look up what they need.
+type Scenario struct {
+	Machines     []*state.Machine
+	Applications map[string]*state.Application
+	Units        map[string][]*state.Unit
+	Relations    []*state.Relation
+	Offers       map[string]*crossmodel.ApplicationOffer
+}
+
+// MakeScenario creates the machines, applications, units, relations
+// and offers declared by params, substituting sane defaults for
+// missing values. It is a declarative alternative to hand-rolling
+// long sequences of individual factory calls in feature tests.
+func (factory *Factory) MakeScenario(c *gc.C, params *ScenarioParams) *Scenario {
+	if params == nil {
+		params = &ScenarioParams{}
+	}
+	scenario := &Scenario{
+		Applications: make(map[string]*state.Application),
+		Units:        make(map[string][]*state.Unit),
+		Offers:       make(map[string]*crossmodel.ApplicationOffer),
+	}
+
+	for _, m := range params.Machines {
+		count := m.Count
+		if count <= 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			mParams := m.MachineParams
+			scenario.Machines = append(scenario.Machines, factory.MakeMachine(c, &mParams))
+		}
+	}
+
+	for _, a := range params.Applications {
+		ch := factory.MakeCharm(c, &CharmParams{
+			Name:   a.CharmName,
+			Series: a.Series,
+		})
+		app := factory.MakeApplication(c, &ApplicationParams{
+			Name:        a.Name,
+			Charm:       ch,
+			Storage:     a.Storage,
+			Constraints: a.Constraints,
+		})
+		scenario.Applications[app.Name()] = app
+		for i := 0; i < a.NumUnits; i++ {
+			unit := factory.MakeUnit(c, &UnitParams{Application: app})
+			scenario.Units[app.Name()] = append(scenario.Units[app.Name()], unit)
+		}
+	}
+
+	for _, r := range params.Relations {
+		eps, err := factory.st.InferEndpoints(r.Endpoints...)
+		c.Assert(err, jc.ErrorIsNil)
+		scenario.Relations = append(scenario.Relations, factory.MakeRelation(c, &RelationParams{
+			Endpoints: eps,
+		}))
+	}
+
+	for _, o := range params.Offers {
+		owner := o.Owner
+		if owner == "" {
+			owner = factory.MakeUser(c, nil).Name()
+		}
+		offerName := o.OfferName
+		if offerName == "" {
+			offerName = o.ApplicationName
+		}
+		eps := make(map[string]string)
+		for _, ep := range o.Endpoints {
+			eps[ep] = ep
+		}
+		offer, err := state.NewApplicationOffers(factory.st).AddOffer(crossmodel.AddApplicationOfferArgs{
+			OfferName:       offerName,
+			ApplicationName: o.ApplicationName,
+			Endpoints:       eps,
+			Owner:           owner,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		scenario.Offers[offer.OfferName] = offer
+	}
+
+	return scenario
+}
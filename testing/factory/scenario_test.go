@@ -0,0 +1,65 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package factory_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+func (s *factorySuite) TestMakeScenarioNil(c *gc.C) {
+	scenario := s.Factory.MakeScenario(c, nil)
+	c.Assert(scenario.Machines, gc.HasLen, 0)
+	c.Assert(scenario.Applications, gc.HasLen, 0)
+	c.Assert(scenario.Relations, gc.HasLen, 0)
+	c.Assert(scenario.Offers, gc.HasLen, 0)
+}
+
+func (s *factorySuite) TestMakeScenario(c *gc.C) {
+	scenario := s.Factory.MakeScenario(c, &factory.ScenarioParams{
+		Machines: []factory.ScenarioMachineParams{{
+			Count: 2,
+		}},
+		Applications: []factory.ScenarioApplicationParams{{
+			CharmName: "mysql",
+			NumUnits:  1,
+		}, {
+			CharmName: "wordpress",
+			NumUnits:  2,
+		}},
+		Relations: []factory.ScenarioRelationParams{{
+			Endpoints: []string{"wordpress:db", "mysql:server"},
+		}},
+		Offers: []factory.ScenarioOfferParams{{
+			OfferName:       "hosted-mysql",
+			ApplicationName: "mysql",
+			Endpoints:       []string{"server"},
+		}},
+	})
+
+	c.Assert(scenario.Machines, gc.HasLen, 2)
+	c.Assert(scenario.Applications, gc.HasLen, 2)
+	c.Assert(scenario.Units["mysql"], gc.HasLen, 1)
+	c.Assert(scenario.Units["wordpress"], gc.HasLen, 2)
+	c.Assert(scenario.Relations, gc.HasLen, 1)
+	c.Assert(scenario.Relations[0].Endpoints(), gc.HasLen, 2)
+	c.Assert(scenario.Offers["hosted-mysql"].OfferName, gc.Equals, "hosted-mysql")
+
+	// Everything is really in state.
+	app, err := s.State.Application("wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	units, err := app.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, gc.HasLen, 2)
+	machines, err := s.State.AllMachines()
+	c.Assert(err, jc.ErrorIsNil)
+	// Two standalone machines plus one per unit.
+	c.Assert(machines, gc.HasLen, 5)
+	offers, err := state.NewApplicationOffers(s.State).AllApplicationOffers()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(offers, gc.HasLen, 1)
+}
@@ -0,0 +1,257 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v2"
+	"github.com/juju/names/v4"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/mongo"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/backups"
+	workerstate "github.com/juju/juju/worker/state"
+)
+
+// ManifoldConfig holds the information necessary to run a backup
+// scheduler in a dependency.Engine.
+type ManifoldConfig struct {
+	AgentName string
+	StateName string
+	Clock     clock.Clock
+	Logger    Logger
+	NewWorker func(Config) (worker.Worker, error)
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.StateName == "" {
+		return errors.NotValidf("empty StateName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that will run a backup
+// scheduler.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{config.AgentName, config.StateName},
+		Start:  config.start,
+	}
+}
+
+// start is a method on ManifoldConfig because it's more readable than a closure.
+func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var a agent.Agent
+	if err := context.Get(config.AgentName, &a); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var stTracker workerstate.StateTracker
+	if err := context.Get(config.StateName, &stTracker); err != nil {
+		return nil, errors.Trace(err)
+	}
+	statePool, err := stTracker.Use()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	st, err := statePool.SystemState()
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+
+	agentConfig := a.CurrentConfig()
+	w, err := config.NewWorker(Config{
+		Backend: st,
+		Clock:   config.Clock,
+		Logger:  config.Logger,
+		CreateBackup: func() (string, string, error) {
+			return createBackup(agentConfig, st)
+		},
+		PruneBackups: func(keep int) error {
+			return pruneBackups(st, keep)
+		},
+		Upload: HTTPUpload,
+	})
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+	return &cleanupWorker{
+		Worker:  w,
+		cleanup: func() { _ = stTracker.Done() },
+	}, nil
+}
+
+// createBackup makes a controller backup, much as the Backups facade
+// does for a client-requested one, and returns the new backup's ID and
+// the path of the archive on disk.
+func createBackup(agentConfig agent.Config, st *state.State) (string, string, error) {
+	session := st.MongoSession().Copy()
+	defer session.Close()
+
+	mgoInfo, ok := agentConfig.MongoInfo()
+	if !ok {
+		return "", "", errors.New("no mongo connection info in agent config")
+	}
+	v, err := st.MongoVersion()
+	if err != nil {
+		return "", "", errors.Annotate(err, "discovering mongo version")
+	}
+	mongoVersion, err := mongo.NewVersion(v)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	dbInfo, err := backups.NewDBInfo(mgoInfo, sessionShim{session}, mongoVersion)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+
+	machineID := agentConfig.Tag().Id()
+	m, err := st.Machine(machineID)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	model, err := st.Model()
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	meta, err := backups.NewMetadataState(metadataBackend{st, model.ModelTag()}, machineID, m.Series())
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	meta.Notes = "scheduled backup"
+	meta.Controller.MachineID = machineID
+	instanceID, err := m.InstanceId()
+	if err == nil {
+		meta.Controller.MachineInstanceID = string(instanceID)
+	}
+	nodes, err := st.ControllerNodes()
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	meta.Controller.HANodes = int64(len(nodes))
+
+	paths, err := backupPaths(agentConfig, st)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	fileName, err := backups.NewBackups(paths).Create(meta, dbInfo)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	return meta.ID(), fileName, nil
+}
+
+// pruneBackups removes all but the newest keep backup archives from
+// the backup directory.
+func pruneBackups(st *state.State, keep int) error {
+	model, err := st.Model()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelConfig, err := model.ModelConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	backupDir := backups.BackupDirToUse(modelConfig.BackupDir())
+	entries, err := os.ReadDir(backupDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	var archives []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), backups.FilenamePrefix) {
+			archives = append(archives, entry.Name())
+		}
+	}
+	// Archive names embed their creation timestamp, so sorting the
+	// names sorts oldest first.
+	sort.Strings(archives)
+	if len(archives) <= keep {
+		return nil
+	}
+	for _, name := range archives[:len(archives)-keep] {
+		if err := os.Remove(filepath.Join(backupDir, name)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func backupPaths(agentConfig agent.Config, st *state.State) (*backups.Paths, error) {
+	model, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	modelConfig, err := model.ModelConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backups.Paths{
+		BackupDir: backups.BackupDirToUse(modelConfig.BackupDir()),
+		DataDir:   agentConfig.DataDir(),
+		LogsDir:   agentConfig.LogDir(),
+	}, nil
+}
+
+// metadataBackend disambiguates the model tag, which lives on the
+// model rather than on state.
+type metadataBackend struct {
+	*state.State
+	modelTag names.ModelTag
+}
+
+func (b metadataBackend) ModelTag() names.ModelTag {
+	return b.modelTag
+}
+
+type sessionShim struct {
+	*mgo.Session
+}
+
+func (s sessionShim) DB(name string) backups.Database {
+	return s.Session.DB(name)
+}
+
+type cleanupWorker struct {
+	worker.Worker
+	cleanupOnce sync.Once
+	cleanup     func()
+}
+
+func (w *cleanupWorker) Wait() error {
+	err := w.Worker.Wait()
+	w.cleanupOnce.Do(w.cleanup)
+	return err
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// uploadTimeout bounds how long a single archive upload may take.
+var uploadTimeout = 30 * time.Minute
+
+// HTTPUpload sends the archive at the given path to the target URL
+// with a plain PUT. Pre-signed S3 URLs and Azure Blob SAS URLs both
+// accept this.
+func HTTPUpload(target, archivePath string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req, err := http.NewRequest("PUT", target, f)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.ContentLength = info.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+	// Azure Blob requires the blob type header; S3 ignores it.
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+
+	client := &http.Client{Timeout: uploadTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("upload target returned status %q", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/robfig/cron/v3"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/state"
+)
+
+// pollInterval is how often the worker re-reads the schedule to pick
+// up configuration changes and check whether a backup is due.
+const pollInterval = time.Minute
+
+// Logger represents the methods used by the worker to log information.
+type Logger interface {
+	Errorf(string, ...interface{})
+	Warningf(string, ...interface{})
+	Infof(string, ...interface{})
+}
+
+// Backend provides the state functionality the scheduler needs.
+type Backend interface {
+	BackupSchedule() (*state.BackupSchedule, error)
+	BackupScheduleStatus() (*state.BackupScheduleStatus, error)
+	SetBackupScheduleStatus(state.BackupScheduleStatus) error
+}
+
+// Config holds the dependencies of a backup scheduler worker.
+type Config struct {
+	Backend Backend
+	Clock   clock.Clock
+	Logger  Logger
+
+	// CreateBackup makes a backup, returning its ID and the path of
+	// the archive on disk.
+	CreateBackup func() (id, archivePath string, err error)
+
+	// PruneBackups removes all but the newest keep backup archives.
+	PruneBackups func(keep int) error
+
+	// Upload sends the archive at the given path to the configured
+	// upload target.
+	Upload func(target, archivePath string) error
+}
+
+// Validate returns an error if the config cannot be used to start a
+// worker.
+func (config Config) Validate() error {
+	if config.Backend == nil {
+		return errors.NotValidf("nil Backend")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.CreateBackup == nil {
+		return errors.NotValidf("nil CreateBackup")
+	}
+	if config.PruneBackups == nil {
+		return errors.NotValidf("nil PruneBackups")
+	}
+	if config.Upload == nil {
+		return errors.NotValidf("nil Upload")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that runs controller backups according to
+// the configured schedule, applying the retention policy and uploading
+// archives to the configured target after each run.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &scheduler{config: config}
+	w.tomb.Go(w.loop)
+	return w, nil
+}
+
+type scheduler struct {
+	tomb   tomb.Tomb
+	config Config
+
+	// lastRun is when the most recent scheduled backup ran, from the
+	// worker's point of view. It anchors the cron schedule: the next
+	// backup is due at the first cron activation after lastRun.
+	lastRun time.Time
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *scheduler) Kill() {
+	w.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *scheduler) Wait() error {
+	return w.tomb.Wait()
+}
+
+func (w *scheduler) loop() error {
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-w.config.Clock.After(pollInterval):
+		}
+		if err := w.maybeRun(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// maybeRun runs a backup if one is due according to the schedule.
+// Backup failures are recorded in the schedule status, not returned:
+// a failing backup must not bounce the worker out of its schedule.
+func (w *scheduler) maybeRun() error {
+	schedule, err := w.config.Backend.BackupSchedule()
+	if errors.IsNotFound(err) {
+		// No schedule configured; forget any anchor so that a
+		// newly created schedule starts afresh.
+		w.lastRun = time.Time{}
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	spec, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		// The expression is validated when the schedule is set, so
+		// this means the document was modified out of band. Log and
+		// wait for it to be corrected.
+		w.config.Logger.Warningf("invalid backup schedule cron expression %q: %v", schedule.Cron, err)
+		return nil
+	}
+
+	now := w.config.Clock.Now()
+	if w.lastRun.IsZero() {
+		status, err := w.config.Backend.BackupScheduleStatus()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if status.LastRun.IsZero() {
+			// The schedule has never run. Anchor it now, so the
+			// first backup happens at the next cron activation
+			// rather than immediately on worker startup.
+			w.lastRun = now
+			return nil
+		}
+		w.lastRun = status.LastRun
+	}
+	if spec.Next(w.lastRun).After(now) {
+		return nil
+	}
+
+	w.config.Logger.Infof("starting scheduled controller backup")
+	status := state.BackupScheduleStatus{LastRun: now}
+	id, archivePath, err := w.config.CreateBackup()
+	if err == nil {
+		status.LastBackupID = id
+		if schedule.UploadTarget != "" {
+			if uploadErr := w.config.Upload(schedule.UploadTarget, archivePath); uploadErr != nil {
+				err = errors.Annotate(uploadErr, "uploading archive")
+			}
+		}
+	}
+	if err == nil && schedule.RetentionCount > 0 {
+		if pruneErr := w.config.PruneBackups(schedule.RetentionCount); pruneErr != nil {
+			// A pruning failure does not invalidate the backup.
+			w.config.Logger.Warningf("pruning old backups: %v", pruneErr)
+		}
+	}
+	if err != nil {
+		w.config.Logger.Errorf("scheduled backup failed: %v", err)
+		status.LastRunError = err.Error()
+	} else {
+		w.config.Logger.Infof("scheduled backup %q complete", id)
+	}
+	w.lastRun = now
+	return errors.Trace(w.config.Backend.SetBackupScheduleStatus(status))
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/backupscheduler"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+	backend *mockBackend
+	clock   *testclock.Clock
+	stub    testing.Stub
+	config  backupscheduler.Config
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+// epoch is the start of an hour, so the first activation of an
+// hourly schedule is exactly an hour later.
+var epoch = time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.backend = &mockBackend{
+		statusSet: make(chan state.BackupScheduleStatus, 1),
+	}
+	s.clock = testclock.NewClock(epoch)
+	s.stub.ResetCalls()
+	s.config = backupscheduler.Config{
+		Backend: s.backend,
+		Clock:   s.clock,
+		Logger:  loggo.GetLogger("test"),
+		CreateBackup: func() (string, string, error) {
+			s.stub.AddCall("CreateBackup")
+			return "20230301-110000.deadbeef", "/tmp/archive.tar.gz", s.stub.NextErr()
+		},
+		PruneBackups: func(keep int) error {
+			s.stub.AddCall("PruneBackups", keep)
+			return s.stub.NextErr()
+		},
+		Upload: func(target, archivePath string) error {
+			s.stub.AddCall("Upload", target, archivePath)
+			return s.stub.NextErr()
+		},
+	}
+}
+
+func (s *WorkerSuite) TestValidateBackend(c *gc.C) {
+	s.config.Backend = nil
+	_, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, gc.ErrorMatches, "nil Backend not valid")
+}
+
+func (s *WorkerSuite) TestValidateClock(c *gc.C) {
+	s.config.Clock = nil
+	_, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, gc.ErrorMatches, "nil Clock not valid")
+}
+
+func (s *WorkerSuite) TestStartStop(c *gc.C) {
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, w)
+}
+
+// tick advances the clock by the given duration once the worker is
+// waiting on it.
+func (s *WorkerSuite) tick(c *gc.C, d time.Duration) {
+	err := s.clock.WaitAdvance(d, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *WorkerSuite) waitStatus(c *gc.C) state.BackupScheduleStatus {
+	select {
+	case status := <-s.backend.statusSet:
+		return status
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for schedule status update")
+	}
+	panic("unreachable")
+}
+
+func (s *WorkerSuite) TestNoScheduleNoBackup(c *gc.C) {
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.tick(c, time.Minute)
+	// Wait for the worker to come back around to the clock before
+	// checking nothing happened.
+	s.tick(c, time.Minute)
+	s.stub.CheckNoCalls(c)
+}
+
+func (s *WorkerSuite) TestFirstRunAtNextActivation(c *gc.C) {
+	s.backend.schedule = &state.BackupSchedule{Cron: "0 * * * *"}
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The first poll anchors the schedule without running a backup.
+	s.tick(c, time.Minute)
+	// Advancing to the next hourly activation triggers one.
+	s.tick(c, 59*time.Minute)
+
+	status := s.waitStatus(c)
+	c.Assert(status.LastRun, gc.Equals, epoch.Add(time.Hour))
+	c.Assert(status.LastBackupID, gc.Equals, "20230301-110000.deadbeef")
+	c.Assert(status.LastRunError, gc.Equals, "")
+	s.stub.CheckCallNames(c, "CreateBackup")
+}
+
+func (s *WorkerSuite) TestUploadAndPrune(c *gc.C) {
+	s.backend.schedule = &state.BackupSchedule{
+		Cron:           "0 * * * *",
+		RetentionCount: 3,
+		UploadTarget:   "https://blob.example.com/backups?sig=abc",
+	}
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.tick(c, time.Minute)
+	s.tick(c, 59*time.Minute)
+
+	status := s.waitStatus(c)
+	c.Assert(status.LastRunError, gc.Equals, "")
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{FuncName: "CreateBackup"},
+		{FuncName: "Upload", Args: []interface{}{
+			"https://blob.example.com/backups?sig=abc", "/tmp/archive.tar.gz",
+		}},
+		{FuncName: "PruneBackups", Args: []interface{}{3}},
+	})
+}
+
+func (s *WorkerSuite) TestBackupFailureRecorded(c *gc.C) {
+	s.backend.schedule = &state.BackupSchedule{Cron: "0 * * * *"}
+	s.stub.SetErrors(errors.New("mongodump exploded"))
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.tick(c, time.Minute)
+	s.tick(c, 59*time.Minute)
+
+	status := s.waitStatus(c)
+	c.Assert(status.LastRunError, gc.Equals, "mongodump exploded")
+	c.Assert(status.LastBackupID, gc.Equals, "")
+
+	// The worker stays on schedule: the next activation runs again.
+	s.tick(c, time.Hour)
+	status = s.waitStatus(c)
+	c.Assert(status.LastRunError, gc.Equals, "")
+	c.Assert(status.LastBackupID, gc.Equals, "20230301-110000.deadbeef")
+}
+
+func (s *WorkerSuite) TestResumesFromRecordedLastRun(c *gc.C) {
+	s.backend.schedule = &state.BackupSchedule{Cron: "0 * * * *"}
+	// The last run was recorded just before the activation the worker
+	// starts up on top of, so a backup is due on the first poll.
+	s.backend.status.LastRun = epoch.Add(-30 * time.Minute)
+	w, err := backupscheduler.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.tick(c, time.Minute)
+	status := s.waitStatus(c)
+	c.Assert(status.LastRun, gc.Equals, epoch.Add(time.Minute))
+	s.stub.CheckCallNames(c, "CreateBackup")
+}
+
+type mockBackend struct {
+	mu        sync.Mutex
+	schedule  *state.BackupSchedule
+	status    state.BackupScheduleStatus
+	statusSet chan state.BackupScheduleStatus
+}
+
+func (b *mockBackend) BackupSchedule() (*state.BackupSchedule, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.schedule == nil {
+		return nil, errors.NotFoundf("backup schedule")
+	}
+	schedule := *b.schedule
+	return &schedule, nil
+}
+
+func (b *mockBackend) BackupScheduleStatus() (*state.BackupScheduleStatus, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status := b.status
+	return &status, nil
+}
+
+func (b *mockBackend) SetBackupScheduleStatus(status state.BackupScheduleStatus) error {
+	b.mu.Lock()
+	b.status = status
+	b.mu.Unlock()
+	b.statusSet <- status
+	return nil
+}
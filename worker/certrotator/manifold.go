@@ -0,0 +1,80 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package certrotator
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/pubsub/v2"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/pki"
+)
+
+// ManifoldConfig holds the information necessary to run a certificate
+// rotation worker in a dependency.Engine.
+type ManifoldConfig struct {
+	AuthorityName  string
+	CentralHubName string
+	Clock          clock.Clock
+	Logger         Logger
+	NewWorker      func(Config) (worker.Worker, error)
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AuthorityName == "" {
+		return errors.NotValidf("empty AuthorityName")
+	}
+	if config.CentralHubName == "" {
+		return errors.NotValidf("empty CentralHubName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that will run a certificate
+// rotation worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AuthorityName,
+			config.CentralHubName,
+		},
+		Start: config.start,
+	}
+}
+
+// start is a method on ManifoldConfig because it's more readable than a closure.
+func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var authority pki.Authority
+	if err := context.Get(config.AuthorityName, &authority); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var hub *pubsub.StructuredHub
+	if err := context.Get(config.CentralHubName, &hub); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return config.NewWorker(Config{
+		Authority: authority,
+		Clock:     config.Clock,
+		Hub:       hub,
+		Logger:    config.Logger,
+	})
+}
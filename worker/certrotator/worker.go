@@ -0,0 +1,212 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package certrotator
+
+import (
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/pubsub/v2"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/pki"
+	controllermsg "github.com/juju/juju/pubsub/controller"
+)
+
+const (
+	// DefaultCheckInterval is how often the worker inspects the
+	// controller certificates for approaching expiry.
+	DefaultCheckInterval = 12 * time.Hour
+
+	// DefaultRotateBefore is how long before expiry a certificate
+	// is reissued.
+	DefaultRotateBefore = 30 * 24 * time.Hour
+)
+
+// rotationGroups are the authority leaf groups holding the controller
+// serving certificates. The default group serves both API and mongo
+// connections; the IP group carries the machine address SANs
+// maintained by the certupdater worker.
+var rotationGroups = []string{pki.DefaultLeafGroup, pki.ControllerIPLeafGroup}
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Infof(string, ...interface{})
+	Errorf(string, ...interface{})
+}
+
+// Config holds the dependencies and configuration for a certificate
+// rotation worker.
+type Config struct {
+	Authority pki.Authority
+	Clock     clock.Clock
+	Hub       *pubsub.StructuredHub
+	Logger    Logger
+
+	// CheckInterval is how often expiry is checked. A zero value
+	// means DefaultCheckInterval.
+	CheckInterval time.Duration
+
+	// RotateBefore is how close to expiry a certificate must be
+	// before it is reissued. A zero value means DefaultRotateBefore.
+	RotateBefore time.Duration
+}
+
+// Validate returns an error if the config can't be used.
+func (config Config) Validate() error {
+	if config.Authority == nil {
+		return errors.NotValidf("nil Authority")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Hub == nil {
+		return errors.NotValidf("nil Hub")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that reissues the controller TLS serving
+// certificates (API server and mongo) ahead of their expiry, and
+// immediately when an early rotation is requested over the hub by the
+// controller facade. Reissued certificates are served to new
+// connections via the authority's TLS SNI getter; in an HA controller
+// the rotation request fans out to every machine over the forwarded
+// hub.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if config.CheckInterval == 0 {
+		config.CheckInterval = DefaultCheckInterval
+	}
+	if config.RotateBefore == 0 {
+		config.RotateBefore = DefaultRotateBefore
+	}
+	w := &rotator{
+		config:   config,
+		requests: make(chan controllermsg.CertRotateRequestMessage),
+	}
+	// Subscribe before the loop starts so that a rotation request
+	// published immediately after construction is not lost.
+	unsub, err := config.Hub.Subscribe(controllermsg.CertRotateRequest, w.onRotateRequest)
+	if err != nil {
+		return nil, errors.Annotate(err, "subscribing to cert rotate requests")
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: func() error {
+			defer unsub()
+			return w.loop()
+		},
+	})
+	if err != nil {
+		unsub()
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+type rotator struct {
+	catacomb catacomb.Catacomb
+	config   Config
+	requests chan controllermsg.CertRotateRequestMessage
+}
+
+func (w *rotator) onRotateRequest(topic string, data controllermsg.CertRotateRequestMessage, err error) {
+	if err != nil {
+		w.config.Logger.Errorf("cert rotate request callback error: %v", err)
+		return
+	}
+	select {
+	case w.requests <- data:
+	case <-w.catacomb.Dying():
+	}
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *rotator) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *rotator) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *rotator) loop() error {
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.config.Clock.After(w.config.CheckInterval):
+			if err := w.rotateExpiring(); err != nil {
+				return errors.Trace(err)
+			}
+		case msg := <-w.requests:
+			reason := msg.Reason
+			if reason == "" {
+				reason = "no reason given"
+			}
+			w.config.Logger.Infof("early certificate rotation requested: %s", reason)
+			if err := w.rotateGroups(rotationGroups); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// rotateExpiring reissues any controller certificate that is within
+// RotateBefore of its expiry.
+func (w *rotator) rotateExpiring() error {
+	now := w.config.Clock.Now()
+	var due []string
+	for _, group := range rotationGroups {
+		leaf, err := w.config.Authority.LeafForGroup(group)
+		if err != nil {
+			// The group has not been issued yet; nothing to rotate.
+			continue
+		}
+		cert := leaf.Certificate()
+		if cert == nil || now.Add(w.config.RotateBefore).Before(cert.NotAfter) {
+			continue
+		}
+		due = append(due, group)
+	}
+	return w.rotateGroups(due)
+}
+
+// rotateGroups reissues the certificates for the input leaf groups,
+// preserving the SANs of the certificates they replace.
+func (w *rotator) rotateGroups(groups []string) error {
+	for _, group := range groups {
+		leaf, err := w.config.Authority.LeafForGroup(group)
+		if err != nil {
+			continue
+		}
+		cert := leaf.Certificate()
+		if cert == nil {
+			continue
+		}
+		request := w.config.Authority.LeafRequestForGroup(group)
+		if len(cert.DNSNames) > 0 {
+			request.AddDNSNames(cert.DNSNames...)
+		}
+		if len(cert.IPAddresses) > 0 {
+			request.AddIPAddresses(cert.IPAddresses...)
+		}
+		if _, err := request.Commit(); err != nil {
+			return errors.Annotatef(err, "rotating certificate group %q", group)
+		}
+		w.config.Logger.Infof("rotated controller certificate group %q (previous expiry %s)",
+			group, cert.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package certrotator_test
+
+import (
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/juju/loggo"
+	"github.com/juju/pubsub/v2"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/worker/v3/workertest"
+
+	"github.com/juju/juju/pki"
+	pkitest "github.com/juju/juju/pki/test"
+	controllermsg "github.com/juju/juju/pubsub/controller"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/certrotator"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+
+	authority pki.Authority
+	hub       *pubsub.StructuredHub
+	clock     *testclock.Clock
+	config    certrotator.Config
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	authority, err := pkitest.NewTestAuthority()
+	c.Assert(err, jc.ErrorIsNil)
+	s.authority = authority
+
+	_, err = authority.LeafRequestForGroup(pki.DefaultLeafGroup).
+		AddDNSNames("juju-apiserver").
+		AddIPAddresses(net.ParseIP("10.0.0.1")).
+		Commit()
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.hub = pubsub.NewStructuredHub(nil)
+	s.clock = testclock.NewClock(time.Now())
+	s.config = certrotator.Config{
+		Authority:     authority,
+		Clock:         s.clock,
+		Hub:           s.hub,
+		Logger:        loggo.GetLogger("certrotator_worker_test"),
+		CheckInterval: time.Hour,
+	}
+}
+
+func (s *WorkerSuite) serial(c *gc.C) *big.Int {
+	leaf, err := s.authority.LeafForGroup(pki.DefaultLeafGroup)
+	c.Assert(err, jc.ErrorIsNil)
+	return leaf.Certificate().SerialNumber
+}
+
+func (s *WorkerSuite) waitForNewSerial(c *gc.C, old *big.Int) {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if s.serial(c).Cmp(old) != 0 {
+			return
+		}
+	}
+	c.Fatalf("certificate was not rotated")
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	config := s.config
+	config.Authority = nil
+	_, err := certrotator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Authority not valid")
+
+	config = s.config
+	config.Clock = nil
+	_, err = certrotator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Clock not valid")
+
+	config = s.config
+	config.Hub = nil
+	_, err = certrotator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Hub not valid")
+
+	config = s.config
+	config.Logger = nil
+	_, err = certrotator.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *WorkerSuite) TestFreshCertificateNotRotated(c *gc.C) {
+	w, err := certrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	before := s.serial(c)
+	err = s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	// Give the check a chance to (incorrectly) rotate.
+	err = s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.serial(c).Cmp(before), gc.Equals, 0)
+}
+
+func (s *WorkerSuite) TestExpiringCertificateRotated(c *gc.C) {
+	// Start the clock close enough to the certificate's expiry that
+	// it falls within the rotation window.
+	s.clock = testclock.NewClock(
+		time.Now().AddDate(pki.DefaultValidityYears, 0, -1))
+	s.config.Clock = s.clock
+
+	w, err := certrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	before := s.serial(c)
+	err = s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitForNewSerial(c, before)
+
+	// The replacement certificate preserves the SANs.
+	leaf, err := s.authority.LeafForGroup(pki.DefaultLeafGroup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leaf.Certificate().DNSNames, jc.DeepEquals, []string{"juju-apiserver"})
+	c.Check(leaf.Certificate().IPAddresses, gc.HasLen, 1)
+}
+
+func (s *WorkerSuite) TestHubRequestForcesRotation(c *gc.C) {
+	w, err := certrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	before := s.serial(c)
+	_, err = s.hub.Publish(controllermsg.CertRotateRequest,
+		controllermsg.CertRotateRequestMessage{Reason: "key compromise"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitForNewSerial(c, before)
+}
@@ -30,6 +30,7 @@ type CertificateUpdater struct {
 	addressWatcher  AddressWatcher
 	authority       pki.Authority
 	hostPortsGetter APIHostPortsGetter
+	configGetter    ControllerConfigGetter
 	addresses       network.SpaceAddresses
 }
 
@@ -52,11 +53,18 @@ type APIHostPortsGetter interface {
 	APIHostPortsForClients() ([]network.SpaceHostPorts, error)
 }
 
+// ControllerConfigGetter is an interface that is provided to
+// NewCertificateUpdater. It returns the controller configuration.
+type ControllerConfigGetter interface {
+	ControllerConfig() (controller.Config, error)
+}
+
 // Config holds the configuration for the certificate updater worker.
 type Config struct {
-	AddressWatcher     AddressWatcher
-	Authority          pki.Authority
-	APIHostPortsGetter APIHostPortsGetter
+	AddressWatcher         AddressWatcher
+	Authority              pki.Authority
+	APIHostPortsGetter     APIHostPortsGetter
+	ControllerConfigGetter ControllerConfigGetter
 }
 
 // NewCertificateUpdater returns a worker.Worker that watches for changes to
@@ -67,6 +75,7 @@ func NewCertificateUpdater(config Config) worker.Worker {
 		addressWatcher:  config.AddressWatcher,
 		authority:       config.Authority,
 		hostPortsGetter: config.APIHostPortsGetter,
+		configGetter:    config.ControllerConfigGetter,
 	})
 }
 
@@ -110,6 +119,18 @@ func (c *CertificateUpdater) updateCertificate(addresses network.SpaceAddresses)
 
 	request := c.authority.LeafRequestForGroup(pki.ControllerIPLeafGroup)
 
+	// Only include SAN addresses for the families the API server
+	// actually binds, so that a family-restricted controller does not
+	// advertise addresses it cannot serve.
+	bindFamily := controller.DefaultAPIBindAddressFamily
+	if c.configGetter != nil {
+		config, err := c.configGetter.ControllerConfig()
+		if err != nil {
+			return errors.Annotate(err, "retrieving controller config")
+		}
+		bindFamily = config.APIBindAddressFamily()
+	}
+
 	for _, addr := range addresses {
 		if addr.Value == "localhost" {
 			continue
@@ -119,6 +140,9 @@ func (c *CertificateUpdater) updateCertificate(addresses network.SpaceAddresses)
 		case network.HostName:
 			request.AddDNSNames(addr.Value)
 		case network.IPv4Address:
+			if bindFamily == controller.AddressFamilyIPv6 {
+				continue
+			}
 			ip := addr.IP()
 			if ip == nil {
 				return errors.Errorf(
@@ -126,6 +150,9 @@ func (c *CertificateUpdater) updateCertificate(addresses network.SpaceAddresses)
 			}
 			request.AddIPAddresses(ip)
 		case network.IPv6Address:
+			if bindFamily == controller.AddressFamilyIPv4 {
+				continue
+			}
 			ip := addr.IP()
 			if ip == nil {
 				return errors.Errorf(
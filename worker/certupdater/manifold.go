@@ -95,9 +95,10 @@ func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, e
 	}
 
 	w := config.NewWorker(Config{
-		AddressWatcher:     addressWatcher,
-		Authority:          authority,
-		APIHostPortsGetter: st,
+		AddressWatcher:         addressWatcher,
+		Authority:              authority,
+		APIHostPortsGetter:     st,
+		ControllerConfigGetter: st,
 	})
 	return common.NewCleanupWorker(w, func() { _ = stTracker.Done() }), nil
 }
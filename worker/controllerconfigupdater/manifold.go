@@ -0,0 +1,104 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigupdater
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/worker/common"
+	workerstate "github.com/juju/juju/worker/state"
+)
+
+// ManifoldConfig holds the information needed to run a
+// controllerconfigupdater in a dependency.Engine.
+type ManifoldConfig struct {
+	StateName string
+	Appliers  []Applier
+	Logger    Logger
+	NewWorker func(Config) (worker.Worker, error)
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.StateName == "" {
+		return errors.NotValidf("empty StateName")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold to run a
+// controllerconfigupdater.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.StateName,
+		},
+		Start:  config.start,
+		Output: output,
+	}
+}
+
+func (config ManifoldConfig) start(context dependency.Context) (_ worker.Worker, err error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var stTracker workerstate.StateTracker
+	if err := context.Get(config.StateName, &stTracker); err != nil {
+		return nil, errors.Trace(err)
+	}
+	statePool, err := stTracker.Use()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = stTracker.Done()
+		}
+	}()
+
+	st, err := statePool.SystemState()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		Source:   st,
+		Appliers: config.Appliers,
+		Logger:   config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewCleanupWorker(w, func() { _ = stTracker.Done() }), nil
+}
+
+type withCurrentConfig interface {
+	CurrentConfig() controller.Config
+}
+
+func output(in worker.Worker, out interface{}) error {
+	if w, ok := in.(*common.CleanupWorker); ok {
+		in = w.Worker
+	}
+	w, ok := in.(withCurrentConfig)
+	if !ok {
+		return errors.Errorf("expected worker implementing CurrentConfig(), got %T", in)
+	}
+	target, ok := out.(*func() controller.Config)
+	if !ok {
+		return errors.Errorf("out should be *func() controller.Config; got %T", out)
+	}
+	*target = w.CurrentConfig
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigupdater_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+	dt "github.com/juju/worker/v3/dependency/testing"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	jujutesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/controllerconfigupdater"
+)
+
+type manifoldSuite struct {
+	jujutesting.BaseSuite
+}
+
+var _ = gc.Suite(&manifoldSuite{})
+
+func (s *manifoldSuite) validConfig() controllerconfigupdater.ManifoldConfig {
+	return controllerconfigupdater.ManifoldConfig{
+		StateName: "state",
+		Logger:    loggo.GetLogger("test"),
+		NewWorker: func(controllerconfigupdater.Config) (worker.Worker, error) {
+			return nil, errors.New("not implemented")
+		},
+	}
+}
+
+func (s *manifoldSuite) TestValidate(c *gc.C) {
+	c.Assert(s.validConfig().Validate(), jc.ErrorIsNil)
+
+	config := s.validConfig()
+	config.StateName = ""
+	c.Assert(config.Validate(), gc.ErrorMatches, "empty StateName not valid")
+
+	config = s.validConfig()
+	config.Logger = nil
+	c.Assert(config.Validate(), gc.ErrorMatches, "nil Logger not valid")
+
+	config = s.validConfig()
+	config.NewWorker = nil
+	c.Assert(config.Validate(), gc.ErrorMatches, "nil NewWorker not valid")
+}
+
+func (s *manifoldSuite) TestInputs(c *gc.C) {
+	manifold := controllerconfigupdater.Manifold(s.validConfig())
+	c.Assert(manifold.Inputs, jc.SameContents, []string{"state"})
+}
+
+func (s *manifoldSuite) TestMissingState(c *gc.C) {
+	manifold := controllerconfigupdater.Manifold(s.validConfig())
+	context := dt.StubContext(nil, map[string]interface{}{
+		"state": dependency.ErrMissing,
+	})
+	_, err := manifold.Start(context)
+	c.Assert(errors.Cause(err), gc.Equals, dependency.ErrMissing)
+}
+
+func (s *manifoldSuite) TestOutput(c *gc.C) {
+	manifold := controllerconfigupdater.Manifold(s.validConfig())
+	expected := controller.Config{"api-port": 17070}
+	w := &fakeWorker{config: expected}
+
+	var getConfig func() controller.Config
+	err := manifold.Output(w, &getConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(getConfig(), jc.DeepEquals, expected)
+
+	var wrong func() string
+	err = manifold.Output(w, &wrong)
+	c.Assert(err, gc.ErrorMatches, `out should be \*func\(\) controller.Config; got \*func\(\) string`)
+}
+
+type fakeWorker struct {
+	worker.Worker
+	config controller.Config
+}
+
+func (w *fakeWorker) CurrentConfig() controller.Config {
+	return w.config
+}
@@ -0,0 +1,201 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigupdater
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/state"
+)
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Infof(string, ...interface{})
+	Warningf(string, ...interface{})
+}
+
+// ConfigSource lets us get notifications of changes to controller
+// configuration, and then get the changed config. (Primary
+// implementation is State.)
+type ConfigSource interface {
+	WatchControllerConfig() state.NotifyWatcher
+	ControllerConfig() (controller.Config, error)
+}
+
+// Applier applies changes to some subset of hot-reloadable controller
+// config keys to a running subsystem.
+type Applier interface {
+	// Name identifies the applier in logs.
+	Name() string
+
+	// Keys returns the controller config keys the applier cares
+	// about. Apply is only called when at least one of them changes.
+	Keys() []string
+
+	// Apply is called with the full new controller config whenever
+	// one of the applier's keys changes value.
+	Apply(controller.Config) error
+}
+
+// Config holds the dependencies and configuration for a
+// controller config updater worker.
+type Config struct {
+	Source   ConfigSource
+	Appliers []Applier
+	Logger   Logger
+}
+
+// Validate returns an error if the config can't be used.
+func (config Config) Validate() error {
+	if config.Source == nil {
+		return errors.NotValidf("nil Source")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	for _, applier := range config.Appliers {
+		for _, key := range applier.Keys() {
+			if !controller.HotReloadableAttribute(key) {
+				return errors.NotValidf("applier %q for non-reloadable key %q", applier.Name(), key)
+			}
+		}
+	}
+	return nil
+}
+
+// NewWorker returns a worker that watches controller config and
+// applies changes to hot-reloadable keys without an API server
+// restart. Changes to keys that aren't hot-reloadable are logged so
+// the operator knows a restart is needed for them to take effect.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	current, err := config.Source.ControllerConfig()
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting initial controller config")
+	}
+	u := &updater{
+		config:  config,
+		current: current,
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Site: &u.catacomb,
+		Work: u.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return u, nil
+}
+
+type updater struct {
+	mu       sync.Mutex
+	catacomb catacomb.Catacomb
+	config   Config
+	current  controller.Config
+}
+
+// Kill is part of the worker.Worker interface.
+func (u *updater) Kill() {
+	u.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (u *updater) Wait() error {
+	return u.catacomb.Wait()
+}
+
+func (u *updater) loop() error {
+	watcher := u.config.Source.WatchControllerConfig()
+	if err := u.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+	for {
+		select {
+		case <-u.catacomb.Dying():
+			return u.catacomb.ErrDying()
+		case _, ok := <-watcher.Changes():
+			if !ok {
+				return errors.Errorf("watcher channel closed")
+			}
+			newConfig, err := u.config.Source.ControllerConfig()
+			if err != nil {
+				return errors.Annotatef(err, "getting new config")
+			}
+			if err := u.handleChange(newConfig); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+func (u *updater) handleChange(newConfig controller.Config) error {
+	changed := changedKeys(u.CurrentConfig(), newConfig)
+	if changed.IsEmpty() {
+		return nil
+	}
+	hot := set.NewStrings()
+	for _, key := range changed.SortedValues() {
+		if controller.HotReloadableAttribute(key) {
+			hot.Add(key)
+		} else {
+			u.config.Logger.Warningf(
+				"controller config %q changed but isn't hot-reloadable; restart jujud for it to take effect", key)
+		}
+	}
+	for _, applier := range u.config.Appliers {
+		if hot.Intersection(set.NewStrings(applier.Keys()...)).IsEmpty() {
+			continue
+		}
+		u.config.Logger.Debugf("applying controller config change to %q", applier.Name())
+		if err := applier.Apply(newConfig); err != nil {
+			return errors.Annotatef(err, "applying controller config change to %q", applier.Name())
+		}
+	}
+	if !hot.IsEmpty() {
+		u.config.Logger.Infof("applied controller config changes: %v", hot.SortedValues())
+	}
+	u.update(newConfig)
+	return nil
+}
+
+func (u *updater) update(newConfig controller.Config) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.current = newConfig
+}
+
+// CurrentConfig returns the updater's up-to-date controller config.
+func (u *updater) CurrentConfig() controller.Config {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.current
+}
+
+// changedKeys returns the set of keys whose values differ between the
+// two configs, including keys only present in one of them.
+func changedKeys(old, new controller.Config) set.Strings {
+	result := set.NewStrings()
+	for key, oldValue := range old {
+		newValue, found := new[key]
+		if !found || !reflect.DeepEqual(oldValue, newValue) {
+			result.Add(key)
+		}
+	}
+	for key := range new {
+		if _, found := old[key]; !found {
+			result.Add(key)
+		}
+	}
+	return result
+}
@@ -0,0 +1,239 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllerconfigupdater_test
+
+import (
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher/watchertest"
+	jujutesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/controllerconfigupdater"
+)
+
+type updaterSuite struct {
+	jujutesting.BaseSuite
+}
+
+var _ = gc.Suite(&updaterSuite{})
+
+var ding = struct{}{}
+
+func (s *updaterSuite) TestValidate(c *gc.C) {
+	config := controllerconfigupdater.Config{
+		Logger: loggo.GetLogger("test"),
+	}
+	err := config.Validate()
+	c.Assert(err, gc.ErrorMatches, "nil Source not valid")
+
+	config = s.makeConfig(&configSource{}, &stubApplier{
+		name: "bad",
+		keys: []string{controller.APIPort},
+	})
+	err = config.Validate()
+	c.Assert(err, gc.ErrorMatches, `applier "bad" for non-reloadable key "api-port" not valid`)
+}
+
+func (s *updaterSuite) TestAppliesHotReloadableChange(c *gc.C) {
+	configChanged := make(chan struct{}, 1)
+	source := &configSource{
+		watcher: watchertest.NewNotifyWatcher(configChanged),
+		cfg:     makeControllerConfig(10, false),
+	}
+	applier := &stubApplier{
+		name: "rate-limiter",
+		keys: []string{controller.AgentRateLimitMax},
+	}
+
+	w, err := controllerconfigupdater.NewWorker(s.makeConfig(source, applier))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	source.setConfig(makeControllerConfig(20, false))
+	configChanged <- ding
+
+	waitForConfig(c, w, func(cfg controller.Config) bool {
+		return cfg.AgentRateLimitMax() == 20
+	})
+	c.Assert(applier.applied(), gc.HasLen, 1)
+	c.Assert(applier.applied()[0].AgentRateLimitMax(), gc.Equals, 20)
+}
+
+func (s *updaterSuite) TestSkipsApplierForUnrelatedChange(c *gc.C) {
+	configChanged := make(chan struct{}, 1)
+	source := &configSource{
+		watcher: watchertest.NewNotifyWatcher(configChanged),
+		cfg:     makeControllerConfig(10, false),
+	}
+	applier := &stubApplier{
+		name: "rate-limiter",
+		keys: []string{controller.AgentRateLimitMax},
+	}
+
+	w, err := controllerconfigupdater.NewWorker(s.makeConfig(source, applier))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	source.setConfig(makeControllerConfig(10, true))
+	configChanged <- ding
+
+	waitForConfig(c, w, func(cfg controller.Config) bool {
+		return cfg.AuditingEnabled()
+	})
+	c.Assert(applier.applied(), gc.HasLen, 0)
+}
+
+func (s *updaterSuite) TestApplierErrorKillsWorker(c *gc.C) {
+	configChanged := make(chan struct{}, 1)
+	source := &configSource{
+		watcher: watchertest.NewNotifyWatcher(configChanged),
+		cfg:     makeControllerConfig(10, false),
+	}
+	applier := &stubApplier{
+		name: "rate-limiter",
+		keys: []string{controller.AgentRateLimitMax},
+	}
+	applier.stub.SetErrors(errors.New("splat"))
+
+	w, err := controllerconfigupdater.NewWorker(s.makeConfig(source, applier))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	source.setConfig(makeControllerConfig(20, false))
+	configChanged <- ding
+
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, gc.ErrorMatches, `applying controller config change to "rate-limiter": splat`)
+}
+
+func (s *updaterSuite) TestNonReloadableChangeStillTracked(c *gc.C) {
+	configChanged := make(chan struct{}, 1)
+	cfg := makeControllerConfig(10, false)
+	source := &configSource{
+		watcher: watchertest.NewNotifyWatcher(configChanged),
+		cfg:     cfg,
+	}
+
+	w, err := controllerconfigupdater.NewWorker(s.makeConfig(source))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	newCfg := makeControllerConfig(10, false)
+	newCfg[controller.APIPort] = 17071
+	source.setConfig(newCfg)
+	configChanged <- ding
+
+	waitForConfig(c, w, func(cfg controller.Config) bool {
+		port, _ := cfg[controller.APIPort].(int)
+		return port == 17071
+	})
+}
+
+func (s *updaterSuite) makeConfig(source *configSource, appliers ...controllerconfigupdater.Applier) controllerconfigupdater.Config {
+	return controllerconfigupdater.Config{
+		Source:   source,
+		Appliers: appliers,
+		Logger:   loggo.GetLogger("test"),
+	}
+}
+
+func waitForConfig(c *gc.C, w worker.Worker, predicate func(controller.Config) bool) controller.Config {
+	for a := jujutesting.LongAttempt.Start(); a.Next(); {
+		config := getWorkerConfig(c, w)
+		if predicate(config) {
+			return config
+		}
+	}
+	c.Fatalf("timed out waiting for matching config")
+	return nil
+}
+
+func getWorkerConfig(c *gc.C, w worker.Worker) controller.Config {
+	getter, ok := w.(interface {
+		CurrentConfig() controller.Config
+	})
+	if !ok {
+		c.Fatalf("worker %T doesn't expose CurrentConfig()", w)
+	}
+	return getter.CurrentConfig()
+}
+
+func makeControllerConfig(rateLimitMax int, auditEnabled bool) controller.Config {
+	return map[string]interface{}{
+		"other-setting":          "something",
+		"agent-ratelimit-max":    rateLimitMax,
+		"auditing-enabled":       auditEnabled,
+		"model-logfile-max-size": "10M",
+	}
+}
+
+type stubApplier struct {
+	mu   sync.Mutex
+	stub testing.Stub
+	name string
+	keys []string
+	got  []controller.Config
+}
+
+func (a *stubApplier) Name() string {
+	return a.name
+}
+
+func (a *stubApplier) Keys() []string {
+	return a.keys
+}
+
+func (a *stubApplier) Apply(cfg controller.Config) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stub.AddCall("Apply", cfg)
+	if err := a.stub.NextErr(); err != nil {
+		return err
+	}
+	a.got = append(a.got, cfg)
+	return nil
+}
+
+func (a *stubApplier) applied() []controller.Config {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.got
+}
+
+type configSource struct {
+	mu      sync.Mutex
+	stub    testing.Stub
+	watcher *watchertest.NotifyWatcher
+	cfg     controller.Config
+}
+
+func (s *configSource) WatchControllerConfig() state.NotifyWatcher {
+	s.stub.AddCall("WatchControllerConfig")
+	return s.watcher
+}
+
+func (s *configSource) ControllerConfig() (controller.Config, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stub.AddCall("ControllerConfig")
+	if err := s.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return s.cfg, nil
+}
+
+func (s *configSource) setConfig(cfg controller.Config) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg = cfg
+}
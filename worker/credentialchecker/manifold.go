@@ -0,0 +1,114 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package credentialchecker
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/controller/credentialchecker"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/worker/common"
+)
+
+// ManifoldConfig describes the resources used by the credential checker
+// worker.
+type ManifoldConfig struct {
+	APICallerName string
+	ClockName     string
+	EnvironName   string
+	Logger        Logger
+
+	NewCredentialValidatorFacade func(base.APICaller) (common.CredentialAPI, error)
+	NewFacade                    func(base.APICaller) (ExpiryReporter, error)
+	NewWorker                    func(Config) (worker.Worker, error)
+}
+
+// Validate returns an error if the manifold config is not valid.
+func (config ManifoldConfig) Validate() error {
+	if config.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	if config.ClockName == "" {
+		return errors.NotValidf("empty ClockName")
+	}
+	if config.EnvironName == "" {
+		return errors.NotValidf("empty EnvironName")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewCredentialValidatorFacade == nil {
+		return errors.NotValidf("nil NewCredentialValidatorFacade")
+	}
+	if config.NewFacade == nil {
+		return errors.NotValidf("nil NewFacade")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var clock clock.Clock
+	if err := context.Get(config.ClockName, &clock); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var environ environs.Environ
+	if err := context.Get(config.EnvironName, &environ); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var apiCaller base.APICaller
+	if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	credentialAPI, err := config.NewCredentialValidatorFacade(apiCaller)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	reporter, err := config.NewFacade(apiCaller)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		Clock:         clock,
+		Environ:       environ,
+		Logger:        config.Logger,
+		CredentialAPI: credentialAPI,
+		Reporter:      reporter,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// NewAPIFacade returns an ExpiryReporter backed by the supplied
+// APICaller.
+func NewAPIFacade(apiCaller base.APICaller) (ExpiryReporter, error) {
+	return credentialchecker.NewClient(apiCaller), nil
+}
+
+// Manifold returns a Manifold that encapsulates the credential checker
+// worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+			config.ClockName,
+			config.EnvironName,
+		},
+		Start: config.start,
+	}
+}
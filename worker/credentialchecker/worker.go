@@ -0,0 +1,200 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package credentialchecker
+
+import (
+	stdcontext "context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/worker/common"
+)
+
+const (
+	// DefaultCheckInterval is how often the worker re-validates the
+	// model's cloud credential against the provider.
+	DefaultCheckInterval = 6 * time.Hour
+
+	// DefaultExpiryWarningWindow is how far ahead of a credential's
+	// expiry the worker starts emitting warnings.
+	DefaultExpiryWarningWindow = 30 * 24 * time.Hour
+)
+
+// ExpiryReporter records the provider-reported expiry time of the
+// model's cloud credential with the controller, so that it can be
+// surfaced in model status and credential queries.
+type ExpiryReporter interface {
+	ReportCredentialExpiry(time.Time) error
+}
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Infof(string, ...interface{})
+	Warningf(string, ...interface{})
+	Errorf(string, ...interface{})
+}
+
+// Config encapsulates the configuration options for instantiating a new
+// credential checker worker.
+type Config struct {
+	Clock         clock.Clock
+	Environ       environs.BootstrapEnviron
+	Logger        Logger
+	CredentialAPI common.CredentialAPI
+	Reporter      ExpiryReporter
+
+	// CheckInterval is how often the credential is validated. It
+	// defaults to DefaultCheckInterval when zero.
+	CheckInterval time.Duration
+
+	// ExpiryWarningWindow is how close to expiry a credential must be
+	// before warnings are emitted. It defaults to
+	// DefaultExpiryWarningWindow when zero.
+	ExpiryWarningWindow time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.NotValidf("nil clock.Clock")
+	}
+	if config.Environ == nil {
+		return errors.NotValidf("nil Environ")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.CredentialAPI == nil {
+		return errors.NotValidf("nil CredentialAPI")
+	}
+	if config.Reporter == nil {
+		return errors.NotValidf("nil Reporter")
+	}
+	return nil
+}
+
+type checkerWorker struct {
+	config          Config
+	catacomb        catacomb.Catacomb
+	callContextFunc common.CloudCallContextFunc
+
+	mu          sync.Mutex
+	lastError   error
+	expiry      time.Time
+	lastChecked time.Time
+}
+
+// NewWorker returns a worker that periodically makes a lightweight read
+// call to the provider to validate the model's cloud credential, and
+// warns ahead of time when the provider reports the credential is due
+// to expire.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = DefaultCheckInterval
+	}
+	if config.ExpiryWarningWindow <= 0 {
+		config.ExpiryWarningWindow = DefaultExpiryWarningWindow
+	}
+	w := &checkerWorker{
+		config:          config,
+		callContextFunc: common.NewCloudCallContextFunc(config.CredentialAPI),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *checkerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *checkerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report is shown in the dependency engine report.
+func (w *checkerWorker) Report() map[string]interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	report := make(map[string]interface{})
+	if !w.lastChecked.IsZero() {
+		report["last-checked"] = w.lastChecked.Format(time.RFC3339)
+	}
+	if w.lastError != nil {
+		report["last-error"] = w.lastError.Error()
+	}
+	if !w.expiry.IsZero() {
+		report["credential-expiry"] = w.expiry.Format(time.RFC3339)
+	}
+	return report
+}
+
+func (w *checkerWorker) loop() error {
+	timer := w.config.Clock.NewTimer(w.config.CheckInterval)
+	defer func() { _ = timer.Stop() }()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			w.checkOnce()
+			timer.Reset(w.config.CheckInterval)
+		}
+	}
+}
+
+// checkOnce performs a single validity check. Provider failures do not
+// stop the worker: credential invalidation happens through the call
+// context, and the next tick may well succeed once transient cloud
+// trouble has passed.
+func (w *checkerWorker) checkOnce() {
+	ctx := w.callContextFunc(stdcontext.Background())
+
+	checkErr := environs.CheckProviderAPI(w.config.Environ, ctx)
+	if checkErr != nil {
+		w.config.Logger.Warningf("credential check against provider failed: %v", checkErr)
+	}
+
+	expiry, err := environs.CredentialExpiry(w.config.Environ, ctx)
+	if err != nil {
+		w.config.Logger.Errorf("cannot determine credential expiry: %v", err)
+	} else if !expiry.IsZero() {
+		if remaining := expiry.Sub(w.config.Clock.Now()); remaining < w.config.ExpiryWarningWindow {
+			w.config.Logger.Warningf(
+				"cloud credential expires at %s (in %v); renew it before workloads start failing",
+				expiry.Format(time.RFC3339), remaining.Round(time.Minute),
+			)
+		}
+		if reportErr := w.config.Reporter.ReportCredentialExpiry(expiry); reportErr != nil {
+			w.config.Logger.Errorf("cannot record credential expiry: %v", reportErr)
+		}
+	}
+
+	w.mu.Lock()
+	w.lastChecked = w.config.Clock.Now()
+	w.lastError = checkErr
+	if err == nil {
+		w.expiry = expiry
+	}
+	w.mu.Unlock()
+}
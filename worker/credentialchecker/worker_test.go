@@ -0,0 +1,227 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package credentialchecker_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/context"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/credentialchecker"
+)
+
+type workerSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	cfg := s.validConfig(c)
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	testCfg := cfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), gc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = cfg
+	testCfg.Environ = nil
+	c.Check(testCfg.Validate(), gc.ErrorMatches, "nil Environ.*")
+
+	testCfg = cfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), gc.ErrorMatches, "nil Logger.*")
+
+	testCfg = cfg
+	testCfg.CredentialAPI = nil
+	c.Check(testCfg.Validate(), gc.ErrorMatches, "nil CredentialAPI.*")
+
+	testCfg = cfg
+	testCfg.Reporter = nil
+	c.Check(testCfg.Validate(), gc.ErrorMatches, "nil Reporter.*")
+}
+
+func (s *workerSuite) validConfig(c *gc.C) credentialchecker.Config {
+	return credentialchecker.Config{
+		Clock:         testclock.NewClock(time.Now()),
+		Environ:       &fakeEnviron{},
+		Logger:        loggo.GetLogger("test"),
+		CredentialAPI: &fakeCredentialAPI{},
+		Reporter:      &fakeReporter{},
+	}
+}
+
+func (s *workerSuite) TestChecksOnInterval(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	env := &fakeEnviron{}
+
+	w, err := credentialchecker.NewWorker(credentialchecker.Config{
+		Clock:         clk,
+		Environ:       env,
+		Logger:        loggo.GetLogger("test"),
+		CredentialAPI: &fakeCredentialAPI{},
+		Reporter:      &fakeReporter{},
+		CheckInterval: time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(clk.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	waitForChecks(c, env, 1)
+	c.Assert(clk.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	waitForChecks(c, env, 2)
+}
+
+func (s *workerSuite) TestProviderFailureDoesNotStopWorker(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	env := &fakeEnviron{endpointErr: errors.New("bad credential")}
+
+	w, err := credentialchecker.NewWorker(credentialchecker.Config{
+		Clock:         clk,
+		Environ:       env,
+		Logger:        loggo.GetLogger("test"),
+		CredentialAPI: &fakeCredentialAPI{},
+		Reporter:      &fakeReporter{},
+		CheckInterval: time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(clk.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	waitForChecks(c, env, 1)
+	workertest.CheckAlive(c, w)
+}
+
+func (s *workerSuite) TestExpiryReportedInReport(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	expiry := clk.Now().Add(7 * 24 * time.Hour).UTC()
+	env := &fakeEnviron{expiry: expiry}
+
+	w, err := credentialchecker.NewWorker(credentialchecker.Config{
+		Clock:         clk,
+		Environ:       env,
+		Logger:        loggo.GetLogger("test"),
+		CredentialAPI: &fakeCredentialAPI{},
+		Reporter:      &fakeReporter{},
+		CheckInterval: time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(clk.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	waitForChecks(c, env, 1)
+
+	reporter, ok := w.(interface{ Report() map[string]interface{} })
+	c.Assert(ok, jc.IsTrue)
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if reporter.Report()["credential-expiry"] == expiry.Format(time.RFC3339) {
+			return
+		}
+	}
+	c.Fatalf("credential expiry never surfaced in worker report: %v", reporter.Report())
+}
+
+func (s *workerSuite) TestExpiryReportedToController(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	expiry := clk.Now().Add(7 * 24 * time.Hour).UTC()
+	env := &fakeEnviron{expiry: expiry}
+	reporter := &fakeReporter{}
+
+	w, err := credentialchecker.NewWorker(credentialchecker.Config{
+		Clock:         clk,
+		Environ:       env,
+		Logger:        loggo.GetLogger("test"),
+		CredentialAPI: &fakeCredentialAPI{},
+		Reporter:      reporter,
+		CheckInterval: time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(clk.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	waitForChecks(c, env, 1)
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if reporter.reportedCount() >= 1 {
+			reporter.mu.Lock()
+			defer reporter.mu.Unlock()
+			c.Check(reporter.reported[0], gc.Equals, expiry)
+			return
+		}
+	}
+	c.Fatalf("credential expiry never reported to the controller")
+}
+
+func waitForChecks(c *gc.C, env *fakeEnviron, want int) {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if env.checkCount() >= want {
+			return
+		}
+	}
+	c.Fatalf("timed out waiting for %d credential check(s)", want)
+}
+
+// fakeEnviron implements just enough of environs.BootstrapEnviron for the
+// worker: endpoint checks via CloudEndpointChecker and expiry via
+// CredentialExpiryReporter.
+type fakeEnviron struct {
+	environs.BootstrapEnviron
+
+	mu          sync.Mutex
+	checks      int
+	endpointErr error
+	expiry      time.Time
+}
+
+func (e *fakeEnviron) ValidateCloudEndpoint(ctx context.ProviderCallContext) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.checks++
+	return e.endpointErr
+}
+
+func (e *fakeEnviron) CredentialExpiry(ctx context.ProviderCallContext) (time.Time, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.expiry, nil
+}
+
+func (e *fakeEnviron) checkCount() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.checks
+}
+
+type fakeReporter struct {
+	mu       sync.Mutex
+	reported []time.Time
+}
+
+func (r *fakeReporter) ReportCredentialExpiry(expiry time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reported = append(r.reported, expiry)
+	return nil
+}
+
+func (r *fakeReporter) reportedCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.reported)
+}
+
+type fakeCredentialAPI struct{}
+
+func (*fakeCredentialAPI) InvalidateModelCredential(reason string) error {
+	return nil
+}
@@ -60,14 +60,25 @@ func (config ManifoldConfig) newWorker(a agent.Agent, apiCaller base.APICaller)
 		return nil, errors.New("agent's tag is not a machine tag")
 	}
 	deployerFacade := apideployer.NewState(apiCaller)
+	unified, err := deployerFacade.UnifiedAgentMode()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !unified {
+		// Unit agents are still deployed and tracked so that turning
+		// the mode back on restores them, but their workers are not
+		// run inside this process.
+		config.Logger.Warningf("unified-agent-mode is disabled; unit agent workers will not run inside the machine agent")
+	}
 	contextConfig := ContextConfig{
-		Agent:            a,
-		Clock:            config.Clock,
-		Hub:              config.Hub,
-		Logger:           config.Logger,
-		UnitEngineConfig: config.UnitEngineConfig,
-		SetupLogging:     config.SetupLogging,
-		UnitManifolds:    UnitManifolds,
+		Agent:              a,
+		Clock:              config.Clock,
+		Hub:                config.Hub,
+		Logger:             config.Logger,
+		UnitEngineConfig:   config.UnitEngineConfig,
+		SetupLogging:       config.SetupLogging,
+		UnitManifolds:      UnitManifolds,
+		DisableUnitWorkers: !unified,
 	}
 
 	context, err := config.NewDeployContext(contextConfig)
@@ -65,6 +65,10 @@ type nestedContext struct {
 	hub    Hub
 	unsub  func()
 
+	// disableUnitWorkers records that the model has opted out of
+	// unified-agent mode, so unit agent workers are never started.
+	disableUnitWorkers bool
+
 	// rebootMonitorStatePurger allows the deployer to clean up the
 	// internal reboot tracking state when a unit gets removed.
 	rebootMonitorStatePurger RebootMonitorStatePurger
@@ -81,6 +85,13 @@ type ContextConfig struct {
 	SetupLogging             func(*loggo.Context, agent.Config)
 	UnitManifolds            func(config UnitManifoldsConfig) dependency.Manifolds
 	RebootMonitorStatePurger RebootMonitorStatePurger
+
+	// DisableUnitWorkers stops the context from running unit agent
+	// workers inside the machine agent process. It is set when the
+	// model's unified-agent-mode is off; unit agent configuration is
+	// still written and tracked, so re-enabling the mode and bouncing
+	// the machine agent restores the workers.
+	DisableUnitWorkers bool
 }
 
 // Validate ensures all the required values are set.
@@ -139,6 +150,7 @@ func NewNestedContext(config ContextConfig) (Context, error) {
 		}),
 		hub:                      config.Hub,
 		rebootMonitorStatePurger: config.RebootMonitorStatePurger,
+		disableUnitWorkers:       config.DisableUnitWorkers,
 	}
 
 	if context.rebootMonitorStatePurger == nil {
@@ -299,6 +311,9 @@ func (c *nestedContext) Report() map[string]interface{} {
 	result := map[string]interface{}{
 		"deployed": deployed.SortedValues(),
 	}
+	if c.disableUnitWorkers {
+		result["unit-workers-disabled"] = true
+	}
 	if c.runner != nil {
 		result["units"] = c.runner.Report()
 	}
@@ -363,6 +378,10 @@ func (c *nestedContext) DeployUnit(unitName, initialPassword string) error {
 
 func (c *nestedContext) startUnitWorkers(unitName string) error {
 	// Assumes lock is held.
+	if c.disableUnitWorkers {
+		c.logger.Infof("unified-agent-mode is disabled; not starting unit workers for %q", unitName)
+		return nil
+	}
 	c.logger.Infof("starting workers for %q", unitName)
 	agent, ok := c.units[unitName]
 	if !ok {
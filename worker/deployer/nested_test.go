@@ -182,6 +182,30 @@ func (s *NestedContextSuite) TestDeployUnit(c *gc.C) {
 	c.Assert(s.agent.CurrentConfig().AgentLogfileMaxSizeMB(), gc.Equals, 123)
 }
 
+func (s *NestedContextSuite) TestDeployUnitWorkersDisabled(c *gc.C) {
+	s.config.DisableUnitWorkers = true
+	ctx := s.newContext(c)
+	unitName := "something/0"
+	err := ctx.DeployUnit(unitName, "password")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The unit agent config is still written and the unit tracked, so
+	// that re-enabling unified-agent-mode restores the workers, but no
+	// workers are started in this process.
+	unitConfig := agent.ConfigPath(s.agent.DataDir(), names.NewUnitTag(unitName))
+	c.Assert(unitConfig, jc.IsNonEmptyFile)
+	c.Assert(s.agent.CurrentConfig().Value("deployed-units"), gc.Equals, unitName)
+
+	select {
+	case unit := <-s.workers.started:
+		c.Fatalf("unexpected start of unit workers for %q", unit)
+	case <-time.After(testing.ShortWait):
+	}
+
+	report := ctx.Report()
+	c.Assert(report["unit-workers-disabled"], gc.Equals, true)
+}
+
 func (s *NestedContextSuite) TestRecallUnit(c *gc.C) {
 	unitName := "something/0"
 	tag := names.NewUnitTag(unitName)
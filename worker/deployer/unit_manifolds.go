@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/worker/metrics/collect"
 	"github.com/juju/juju/worker/metrics/sender"
 	"github.com/juju/juju/worker/metrics/spool"
+	"github.com/juju/juju/worker/metricscollector"
 	"github.com/juju/juju/worker/migrationflag"
 	"github.com/juju/juju/worker/migrationminion"
 	"github.com/juju/juju/worker/retrystrategy"
@@ -261,6 +262,19 @@ func UnitManifolds(config UnitManifoldsConfig) dependency.Manifolds {
 			NewIsolatedStatusWorker:  meterstatus.NewIsolatedStatusWorker,
 		})),
 
+		// The metrics collector scrapes charm-declared OpenMetrics
+		// endpoints and forwards the samples to the model's configured
+		// remote-write target. It is the v2 replacement for the
+		// collect/sender pair above.
+		metricsCollectorName: ifNotMigrating(metricscollector.Manifold(metricscollector.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			CharmDirName:  charmDirName,
+			Clock:         config.Clock,
+			Logger:        config.LoggingContext.GetLogger("juju.worker.metricscollector"),
+			NewSender:     metricscollector.NewAPISender,
+		})),
+
 		// The metric sender worker periodically sends accumulated metrics to the controller.
 		metricSenderName: ifNotMigrating(sender.Manifold(sender.ManifoldConfig{
 			AgentName:       agentName,
@@ -305,8 +319,9 @@ const (
 	uniterName            = "uniter"
 	upgraderName          = "upgrader"
 
-	metricSpoolName   = "metric-spool"
-	meterStatusName   = "meter-status"
-	metricCollectName = "metric-collect"
-	metricSenderName  = "metric-sender"
+	metricSpoolName      = "metric-spool"
+	meterStatusName      = "meter-status"
+	metricCollectName    = "metric-collect"
+	metricSenderName     = "metric-sender"
+	metricsCollectorName = "metrics-collector"
 )
@@ -57,6 +57,7 @@ func (s *ManifoldsSuite) TestManifoldNames(c *gc.C) {
 		"metric-collect",
 		"metric-sender",
 		"metric-spool",
+		"metrics-collector",
 		"migration-fortress",
 		"migration-inactive-flag",
 		"migration-minion",
@@ -194,6 +195,15 @@ var expectedUnitManifoldsWithDependencies = map[string][]string{
 		"migration-inactive-flag",
 	},
 
+	"metrics-collector": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"charm-dir",
+		"migration-fortress",
+		"migration-inactive-flag",
+	},
+
 	"migration-fortress": {},
 
 	"migration-inactive-flag": {
@@ -0,0 +1,88 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package enginehealth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/worker/v3/dependency"
+)
+
+// Health summarises the state of a dependency engine's manifolds.
+type Health struct {
+	// Degraded holds the names of the manifolds that are failing to
+	// stay up, in lexical order.
+	Degraded []string
+
+	// Errors maps each degraded manifold to the last error its
+	// worker stopped with.
+	Errors map[string]string
+}
+
+// IsHealthy reports whether every manifold is either running or
+// cleanly waiting on its dependencies.
+func (h Health) IsHealthy() bool {
+	return len(h.Degraded) == 0
+}
+
+// Message returns a short description of the health suitable for an
+// agent status message, or the empty string when healthy.
+func (h Health) Message() string {
+	switch len(h.Degraded) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("degraded: %s worker restarting", h.Degraded[0])
+	}
+	return fmt.Sprintf("degraded: %d workers restarting: %s",
+		len(h.Degraded), strings.Join(h.Degraded, ", "))
+}
+
+// StatusData returns the structured form of the health for recording
+// as agent status data, or nil when healthy.
+func (h Health) StatusData() map[string]interface{} {
+	if h.IsHealthy() {
+		return nil
+	}
+	workers := make(map[string]interface{})
+	for name, errStr := range h.Errors {
+		workers[name] = errStr
+	}
+	return map[string]interface{}{"degraded-workers": workers}
+}
+
+// Analyse extracts a health summary from a dependency engine report,
+// as returned by the engine's Report method. A manifold is degraded
+// if its worker is not running and last stopped with an error;
+// manifolds cleanly waiting on a missing dependency (gated workers,
+// for example) are healthy.
+func Analyse(report map[string]interface{}) Health {
+	manifolds, ok := report[dependency.KeyManifolds].(map[string]interface{})
+	if !ok {
+		return Health{}
+	}
+	var health Health
+	for name, raw := range manifolds {
+		manifold, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if state, _ := manifold[dependency.KeyState].(string); state == "started" {
+			continue
+		}
+		errStr, _ := manifold[dependency.KeyError].(string)
+		if errStr == "" || strings.HasSuffix(errStr, dependency.ErrMissing.Error()) {
+			continue
+		}
+		if health.Errors == nil {
+			health.Errors = make(map[string]string)
+		}
+		health.Degraded = append(health.Degraded, name)
+		health.Errors[name] = errStr
+	}
+	sort.Strings(health.Degraded)
+	return health
+}
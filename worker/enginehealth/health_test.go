@@ -0,0 +1,86 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package enginehealth_test
+
+import (
+	"github.com/juju/testing"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/enginehealth"
+)
+
+type HealthSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&HealthSuite{})
+
+func report(manifolds map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"state":     "started",
+		"manifolds": manifolds,
+	}
+}
+
+func (s *HealthSuite) TestAnalyseHealthy(c *gc.C) {
+	health := enginehealth.Analyse(report(map[string]interface{}{
+		"api-caller": map[string]interface{}{"state": "started"},
+		"upgrader":   map[string]interface{}{"state": "started"},
+	}))
+	c.Check(health.IsHealthy(), gc.Equals, true)
+	c.Check(health.Message(), gc.Equals, "")
+	c.Check(health.StatusData(), gc.IsNil)
+}
+
+func (s *HealthSuite) TestAnalyseGatedManifoldHealthy(c *gc.C) {
+	// A manifold waiting on an unsatisfied dependency is normal, not
+	// degraded.
+	health := enginehealth.Analyse(report(map[string]interface{}{
+		"upgrade-steps-runner": map[string]interface{}{
+			"state": "stopped",
+			"error": "dependency not available",
+		},
+	}))
+	c.Check(health.IsHealthy(), gc.Equals, true)
+}
+
+func (s *HealthSuite) TestAnalyseDegraded(c *gc.C) {
+	health := enginehealth.Analyse(report(map[string]interface{}{
+		"uniter": map[string]interface{}{
+			"state":       "stopped",
+			"error":       "hook failed",
+			"start-count": 3,
+		},
+		"api-caller": map[string]interface{}{"state": "started"},
+	}))
+	c.Check(health.IsHealthy(), gc.Equals, false)
+	c.Check(health.Degraded, gc.DeepEquals, []string{"uniter"})
+	c.Check(health.Message(), gc.Equals, "degraded: uniter worker restarting")
+	c.Check(health.StatusData(), gc.DeepEquals, map[string]interface{}{
+		"degraded-workers": map[string]interface{}{
+			"uniter": "hook failed",
+		},
+	})
+}
+
+func (s *HealthSuite) TestAnalyseMultipleDegraded(c *gc.C) {
+	health := enginehealth.Analyse(report(map[string]interface{}{
+		"uniter": map[string]interface{}{
+			"state": "stopped",
+			"error": "hook failed",
+		},
+		"machiner": map[string]interface{}{
+			"state": "stopped",
+			"error": "connection is shut down",
+		},
+	}))
+	c.Check(health.Degraded, gc.DeepEquals, []string{"machiner", "uniter"})
+	c.Check(health.Message(), gc.Equals,
+		"degraded: 2 workers restarting: machiner, uniter")
+}
+
+func (s *HealthSuite) TestAnalyseEmptyReport(c *gc.C) {
+	health := enginehealth.Analyse(map[string]interface{}{})
+	c.Check(health.IsHealthy(), gc.Equals, true)
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package enginehealth
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/agent"
+	apimachiner "github.com/juju/juju/api/agent/machiner"
+	"github.com/juju/juju/api/base"
+)
+
+// ManifoldConfig defines the names of the manifolds on which a
+// Manifold will depend, and the engine reporter to watch. The
+// reporter is the agent's own dependency engine, which exists outside
+// the engine's resources, so it is passed in directly rather than
+// looked up by name.
+type ManifoldConfig struct {
+	AgentName     string
+	APICallerName string
+	Reporter      Reporter
+	Clock         clock.Clock
+	Logger        Logger
+}
+
+// Manifold returns a dependency manifold that runs an engine health
+// worker, using the resource names defined in the supplied config.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.APICallerName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			var a agent.Agent
+			if err := context.Get(config.AgentName, &a); err != nil {
+				return nil, err
+			}
+			var apiCaller base.APICaller
+			if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+				return nil, err
+			}
+			tag, ok := a.CurrentConfig().Tag().(names.MachineTag)
+			if !ok {
+				// Only machine agents record engine health; the
+				// manifold is harmless elsewhere.
+				return nil, dependency.ErrUninstall
+			}
+			machine, err := apimachiner.NewState(apiCaller).Machine(tag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return NewWorker(Config{
+				Reporter:     config.Reporter,
+				StatusSetter: machine,
+				Clock:        config.Clock,
+				Logger:       config.Logger,
+			})
+		},
+	}
+}
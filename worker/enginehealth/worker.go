@@ -0,0 +1,142 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package enginehealth
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/core/status"
+)
+
+// DefaultUpdateInterval is how often the engine report is inspected
+// for degraded manifolds.
+const DefaultUpdateInterval = 30 * time.Second
+
+// Reporter is implemented by the dependency engine whose manifolds
+// are being watched.
+type Reporter interface {
+	Report() map[string]interface{}
+}
+
+// StatusSetter records the agent status derived from the engine
+// health. The machiner facade's machine implements this.
+type StatusSetter interface {
+	SetStatus(machineStatus status.Status, info string, data map[string]interface{}) error
+}
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Infof(string, ...interface{})
+}
+
+// Config holds the dependencies and configuration for an engine
+// health worker.
+type Config struct {
+	Reporter     Reporter
+	StatusSetter StatusSetter
+	Clock        clock.Clock
+	Logger       Logger
+
+	// UpdateInterval is how often the report is inspected. A zero
+	// value means DefaultUpdateInterval.
+	UpdateInterval time.Duration
+}
+
+// Validate returns an error if the config can't be used.
+func (config Config) Validate() error {
+	if config.Reporter == nil {
+		return errors.NotValidf("nil Reporter")
+	}
+	if config.StatusSetter == nil {
+		return errors.NotValidf("nil StatusSetter")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that periodically summarises the
+// dependency engine report and surfaces any bouncing manifolds as
+// agent status data, so `juju status` can show which worker is
+// restarting rather than just a lost or idle agent. The status is
+// only updated when the health changes, and is reset to plain
+// "started" once the engine settles again.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if config.UpdateInterval == 0 {
+		config.UpdateInterval = DefaultUpdateInterval
+	}
+	w := &healthWorker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+type healthWorker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	// last is the most recently reported health; status is only set
+	// when the health changes, so a healthy engine costs nothing.
+	last Health
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *healthWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *healthWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *healthWorker) loop() error {
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.config.Clock.After(w.config.UpdateInterval):
+			if err := w.update(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+func (w *healthWorker) update() error {
+	health := Analyse(w.config.Reporter.Report())
+	if reflect.DeepEqual(health, w.last) {
+		return nil
+	}
+	if health.IsHealthy() {
+		w.config.Logger.Infof("dependency engine has settled")
+	} else {
+		w.config.Logger.Infof("dependency engine is degraded: %v", health.Errors)
+	}
+	err := w.config.StatusSetter.SetStatus(status.Started, health.Message(), health.StatusData())
+	if err != nil {
+		return errors.Annotate(err, "setting agent status")
+	}
+	w.last = health
+	return nil
+}
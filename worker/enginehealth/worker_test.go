@@ -0,0 +1,164 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package enginehealth_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/status"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/enginehealth"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+
+	clock    *testclock.Clock
+	reporter *fakeReporter
+	setter   *fakeStatusSetter
+	config   enginehealth.Config
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testclock.NewClock(time.Now())
+	s.reporter = &fakeReporter{report: report(map[string]interface{}{
+		"api-caller": map[string]interface{}{"state": "started"},
+	})}
+	s.setter = &fakeStatusSetter{changes: make(chan statusChange, 10)}
+	s.config = enginehealth.Config{
+		Reporter:       s.reporter,
+		StatusSetter:   s.setter,
+		Clock:          s.clock,
+		Logger:         loggo.GetLogger("enginehealth_worker_test"),
+		UpdateInterval: time.Minute,
+	}
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	s.config.Reporter = nil
+	_, err := enginehealth.NewWorker(s.config)
+	c.Assert(err, gc.ErrorMatches, "nil Reporter not valid")
+}
+
+func (s *WorkerSuite) TestHealthyEngineSetsNoStatus(c *gc.C) {
+	w, err := enginehealth.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case change := <-s.setter.changes:
+		c.Fatalf("unexpected status change: %v", change)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+func (s *WorkerSuite) TestDegradedEngineSetsStatus(c *gc.C) {
+	w, err := enginehealth.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.reporter.setReport(report(map[string]interface{}{
+		"uniter": map[string]interface{}{
+			"state": "stopped",
+			"error": "hook failed",
+		},
+	}))
+	c.Assert(s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case change := <-s.setter.changes:
+		c.Check(change.status, gc.Equals, status.Started)
+		c.Check(change.info, gc.Equals, "degraded: uniter worker restarting")
+		c.Check(change.data, gc.DeepEquals, map[string]interface{}{
+			"degraded-workers": map[string]interface{}{
+				"uniter": "hook failed",
+			},
+		})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for status change")
+	}
+
+	// The same health is not reported twice.
+	c.Assert(s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case change := <-s.setter.changes:
+		c.Fatalf("unexpected status change: %v", change)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+func (s *WorkerSuite) TestRecoveryResetsStatus(c *gc.C) {
+	s.reporter.setReport(report(map[string]interface{}{
+		"uniter": map[string]interface{}{
+			"state": "stopped",
+			"error": "hook failed",
+		},
+	}))
+	w, err := enginehealth.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case <-s.setter.changes:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for degraded status")
+	}
+
+	s.reporter.setReport(report(map[string]interface{}{
+		"uniter": map[string]interface{}{"state": "started"},
+	}))
+	c.Assert(s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case change := <-s.setter.changes:
+		c.Check(change.status, gc.Equals, status.Started)
+		c.Check(change.info, gc.Equals, "")
+		c.Check(change.data, gc.IsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for recovery status")
+	}
+}
+
+type fakeReporter struct {
+	mu     sync.Mutex
+	report map[string]interface{}
+}
+
+func (r *fakeReporter) Report() map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report
+}
+
+func (r *fakeReporter) setReport(report map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report = report
+}
+
+type statusChange struct {
+	status status.Status
+	info   string
+	data   map[string]interface{}
+}
+
+type fakeStatusSetter struct {
+	changes chan statusChange
+}
+
+func (s *fakeStatusSetter) SetStatus(machineStatus status.Status, info string, data map[string]interface{}) error {
+	s.changes <- statusChange{machineStatus, info, data}
+	return nil
+}
@@ -199,6 +199,7 @@ func (config ManifoldConfig) start(context dependency.Context) (_ worker.Worker,
 		APIPort:              controllerConfig.APIPort(),
 		APIPortOpenDelay:     controllerConfig.APIPortOpenDelay(),
 		ControllerAPIPort:    controllerConfig.ControllerAPIPort(),
+		APIBindAddressFamily: controllerConfig.APIBindAddressFamily(),
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -26,6 +26,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/pubsub/apiserver"
 )
 
@@ -49,6 +50,11 @@ type Config struct {
 	APIPort              int
 	APIPortOpenDelay     time.Duration
 	ControllerAPIPort    int
+
+	// APIBindAddressFamily selects the IP address families the
+	// server listens on; see the controller config attribute of the
+	// same name. An empty value means dual-stack.
+	APIBindAddressFamily string
 }
 
 // Validate validates the API server configuration.
@@ -306,9 +312,21 @@ type listener interface {
 	URL() string
 }
 
+// listenNetwork maps the configured API bind address family to the
+// network argument for net.Listen.
+func (w *Worker) listenNetwork() string {
+	switch w.config.APIBindAddressFamily {
+	case controller.AddressFamilyIPv4:
+		return "tcp4"
+	case controller.AddressFamilyIPv6:
+		return "tcp6"
+	}
+	return "tcp"
+}
+
 func (w *Worker) newSimpleListener() (listener, error) {
 	listenAddr := net.JoinHostPort("", strconv.Itoa(w.config.APIPort))
-	listener, err := net.Listen("tcp", listenAddr)
+	listener, err := net.Listen(w.listenNetwork(), listenAddr)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -340,7 +358,7 @@ func (w *Worker) newDualPortListener() (listener, error) {
 	// agent connections. Until that time, accepting an agent connection
 	// would be a bit of a waste of time.
 	listenAddr := net.JoinHostPort("", strconv.Itoa(w.config.ControllerAPIPort))
-	listener, err := net.Listen("tcp", listenAddr)
+	listener, err := net.Listen(w.listenNetwork(), listenAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -66,3 +66,7 @@ func GatherProfileData(m *MutaterMachine, info *instancemutater.UnitProfileInfo)
 func VerifyCurrentProfiles(m *MutaterMachine, instId string, expectedProfiles []string) (bool, error) {
 	return m.verifyCurrentProfiles(instId, expectedProfiles)
 }
+
+func CheckProfileDrift(m *MutaterMachine) error {
+	return m.checkProfileDrift()
+}
@@ -153,13 +153,24 @@ func runMachine(
 	}
 }
 
+// driftCheckInterval is how often a machine's applied lxd profiles
+// are verified against the profiles its charms declare, catching
+// profiles edited manually on the host between watcher events.
+const driftCheckInterval = time.Hour
+
 // watchProfileChanges, any error returned will cause the worker to restart.
 func (m MutaterMachine) watchProfileChangesLoop(removed <-chan struct{}, profileChangeWatcher watcher.NotifyWatcher) error {
 	m.logger.Tracef("watching change on MutaterMachine %s", m.id)
+	driftCheck := clock.WallClock.After(driftCheckInterval)
 	for {
 		select {
 		case <-m.context.dying():
 			return m.context.errDying()
+		case <-driftCheck:
+			if err := m.checkProfileDrift(); err != nil {
+				return errors.Trace(err)
+			}
+			driftCheck = clock.WallClock.After(driftCheckInterval)
 		case <-profileChangeWatcher.Changes():
 			info, err := m.machineApi.CharmProfilingInfo()
 			if err != nil {
@@ -268,6 +279,53 @@ func (m MutaterMachine) processMachineProfileChanges(info *instancemutater.UnitP
 	return report(m.machineApi.SetCharmProfiles(currentProfiles))
 }
 
+// checkProfileDrift compares the lxd profiles applied to the
+// machine's instance with the profiles expected from its charms and
+// reports any difference to the controller. If the controller asks
+// for the drift to be reconciled, the expected profiles are
+// reapplied.
+func (m MutaterMachine) checkProfileDrift() error {
+	info, err := m.machineApi.CharmProfilingInfo()
+	if err != nil {
+		if params.IsCodeNotProvisioned(errors.Cause(err)) {
+			m.logger.Tracef("machine-%s not provisioned, skipping lxd profile drift check", m.id)
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	expected := set.NewStrings(m.context.getRequiredLXDProfiles(info.ModelName)...)
+	expected = expected.Union(set.NewStrings(info.CurrentProfiles...))
+	applied, err := m.context.getBroker().LXDProfileNames(string(info.InstanceId))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	appliedSet := set.NewStrings(applied...)
+	reconcile, err := m.machineApi.SetProfileDrift(appliedSet.SortedValues(), expected.SortedValues())
+	if err != nil {
+		if params.IsCodeNotImplemented(errors.Cause(err)) {
+			// The controller predates drift reporting.
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	if appliedSet.Difference(expected).IsEmpty() && expected.Difference(appliedSet).IsEmpty() {
+		m.logger.Tracef("no lxd profile drift on machine-%s", m.id)
+		return nil
+	}
+	m.logger.Warningf("lxd profile drift on machine-%s: applied %v, expected %v",
+		m.id, appliedSet.SortedValues(), expected.SortedValues())
+	if !reconcile {
+		return nil
+	}
+	m.logger.Infof("machine-%s (%s) reapply expected lxd profiles %q", m.id, string(info.InstanceId), expected.SortedValues())
+	currentProfiles, err := m.context.getBroker().AssignLXDProfiles(string(info.InstanceId), expected.SortedValues(), nil)
+	if err != nil {
+		m.logger.Errorf("failure to assign lxd profiles %s to machine-%s: %s", expected.SortedValues(), m.id, err)
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.machineApi.SetCharmProfiles(currentProfiles))
+}
+
 func (m MutaterMachine) gatherProfileData(info *instancemutater.UnitProfileInfo) ([]lxdprofile.ProfilePost, error) {
 	var result []lxdprofile.ProfilePost
 	for _, pu := range info.ProfileChanges {
@@ -214,6 +214,58 @@ func (s *mutaterSuite) TestVerifyCurrentProfilesError(c *gc.C) {
 	c.Assert(ok, jc.IsFalse)
 }
 
+func (s *mutaterSuite) TestCheckProfileDriftNone(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	profiles := []string{"default", "juju-testme", "juju-testme-lxd-profile-0"}
+	s.expectCharmProfilingInfo(profiles)
+	s.expectLXDProfileNames(profiles, nil)
+	s.expectSetProfileDrift(profiles, profiles, false)
+
+	err := instancemutater.CheckProfileDrift(s.mutaterMachine)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *mutaterSuite) TestCheckProfileDriftDetected(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	expected := []string{"default", "juju-testme", "juju-testme-lxd-profile-0"}
+	applied := []string{"default", "juju-testme"}
+	s.expectCharmProfilingInfo(expected)
+	s.expectLXDProfileNames(applied, nil)
+	s.expectSetProfileDrift(applied, expected, false)
+
+	err := instancemutater.CheckProfileDrift(s.mutaterMachine)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *mutaterSuite) TestCheckProfileDriftReconciled(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	expected := []string{"default", "juju-testme", "juju-testme-lxd-profile-0"}
+	applied := []string{"default", "juju-testme"}
+	s.expectCharmProfilingInfo(expected)
+	s.expectLXDProfileNames(applied, nil)
+	s.expectSetProfileDrift(applied, expected, true)
+	s.expectAssignLXDProfiles(expected, nil)
+	s.expectSetCharmProfiles(expected)
+
+	err := instancemutater.CheckProfileDrift(s.mutaterMachine)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *mutaterSuite) expectCharmProfilingInfo(profiles []string) {
+	s.machine.EXPECT().CharmProfilingInfo().Return(&apiinstancemutater.UnitProfileInfo{
+		ModelName:       "testme",
+		InstanceId:      instance.Id(s.instId),
+		CurrentProfiles: profiles,
+	}, nil)
+}
+
+func (s *mutaterSuite) expectSetProfileDrift(applied, expected []string, reconcile bool) {
+	s.machine.EXPECT().SetProfileDrift(applied, expected).Return(reconcile, nil)
+}
+
 func (s *mutaterSuite) setUpMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
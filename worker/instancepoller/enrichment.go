@@ -0,0 +1,77 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package instancepoller
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/context"
+)
+
+// InstanceMetadataEnricher is implemented by environs that can attach
+// extra provider metadata to their instances, such as an image
+// end-of-life date or the current spot price. The instance poller
+// consults it opportunistically; environs that do not implement it
+// only have their status and addresses polled.
+type InstanceMetadataEnricher interface {
+	// InstanceMetadata returns extra metadata for the given instance
+	// IDs, keyed by instance ID. Instances with no metadata may be
+	// omitted from the result.
+	InstanceMetadata(ctx context.ProviderCallContext, ids []instance.Id) (map[instance.Id]map[string]string, error)
+}
+
+// syncInstanceMetadata queries the environ (when supported) for extra
+// metadata describing the given instances and records it on the
+// corresponding machines so that operators can plan image refreshes or
+// keep an eye on spot pricing.
+func (u *updaterWorker) syncInstanceMetadata(ctx context.ProviderCallContext, ids []instance.Id) error {
+	enricher, ok := u.config.Environ.(InstanceMetadataEnricher)
+	if !ok {
+		return nil
+	}
+
+	metadata, err := enricher.InstanceMetadata(ctx, ids)
+	if err != nil {
+		if errors.IsNotSupported(errors.Cause(err)) {
+			return nil
+		}
+		return errors.Annotate(err, "retrieving metadata for instances")
+	}
+
+	for _, id := range ids {
+		entry, found := u.instanceIDToGroupEntry[id]
+		if !found {
+			continue
+		}
+
+		instMetadata := metadata[id]
+		if len(instMetadata) == 0 || metadataEqual(entry.providerMetadata, instMetadata) {
+			continue
+		}
+
+		u.config.Logger.Debugf("machine %q (instance ID %q) has new provider metadata: %v",
+			entry.m.Id(), id, instMetadata)
+
+		if err := entry.m.SetProviderMetadata(instMetadata); err != nil {
+			u.config.Logger.Errorf("cannot set provider metadata on %q: %v", entry.m, err)
+			return errors.Trace(err)
+		}
+		entry.providerMetadata = instMetadata
+	}
+
+	return nil
+}
+
+func metadataEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package instancepoller
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/worker/instancepoller/mocks"
+)
+
+var _ = gc.Suite(&enrichmentSuite{})
+
+type enrichmentSuite struct{}
+
+// enrichingEnviron wraps a mock Environ with canned instance metadata
+// so we can exercise the optional InstanceMetadataEnricher path.
+type enrichingEnviron struct {
+	*mocks.MockEnviron
+
+	metadata map[instance.Id]map[string]string
+}
+
+func (e *enrichingEnviron) InstanceMetadata(_ context.ProviderCallContext, ids []instance.Id) (map[instance.Id]map[string]string, error) {
+	return e.metadata, nil
+}
+
+func (s *enrichmentSuite) TestInstanceMetadataRecordedOnMachine(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	metadata := map[string]string{
+		"image-eol":  "2024-04-30",
+		"spot-price": "0.0464",
+	}
+	environ := &enrichingEnviron{
+		MockEnviron: mocks.NewMockEnviron(ctrl),
+		metadata: map[instance.Id]map[string]string{
+			"b4dc0ffee": metadata,
+		},
+	}
+
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().SetProviderMetadata(metadata).Return(nil)
+
+	entry := &pollGroupEntry{
+		tag:        names.NewMachineTag("0"),
+		m:          machine,
+		instanceID: "b4dc0ffee",
+	}
+	u := &updaterWorker{
+		config: Config{
+			Environ: environ,
+			Logger:  loggo.GetLogger("juju.worker.instancepoller"),
+		},
+		instanceIDToGroupEntry: map[instance.Id]*pollGroupEntry{
+			"b4dc0ffee": entry,
+		},
+	}
+
+	err := u.syncInstanceMetadata(nil, []instance.Id{"b4dc0ffee"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A second sync with unchanged metadata must not write again; the
+	// mock would fail the test if SetProviderMetadata were called twice.
+	err = u.syncInstanceMetadata(nil, []instance.Id{"b4dc0ffee"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entry.providerMetadata, gc.DeepEquals, metadata)
+}
+
+func (s *enrichmentSuite) TestEnvironWithoutMetadataIsIgnored(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	u := &updaterWorker{
+		config: Config{
+			Environ: mocks.NewMockEnviron(ctrl),
+			Logger:  loggo.GetLogger("juju.worker.instancepoller"),
+		},
+	}
+
+	err := u.syncInstanceMetadata(nil, []instance.Id{"b4dc0ffee"})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package instancepoller
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/context"
+)
+
+// MaintenanceEvent describes a provider-scheduled maintenance operation
+// that is expected to affect an instance, such as an Azure scheduled
+// event or a GCE live-migration notice.
+type MaintenanceEvent struct {
+	// Code is a provider-specific identifier for the type of
+	// maintenance operation (e.g. "Reboot", "Freeze", "Redeploy").
+	Code string
+
+	// Description is a human-readable summary of the operation.
+	Description string
+
+	// NotBefore is the earliest time the provider may start the
+	// operation. A zero value means the start time is unknown.
+	NotBefore time.Time
+}
+
+// MaintenanceEventer is implemented by environs that can surface
+// provider maintenance/health event feeds for their instances. The
+// instance poller consults it opportunistically; environs that do not
+// implement it are polled for status and addresses only.
+type MaintenanceEventer interface {
+	// MaintenanceEvents returns the pending maintenance events for the
+	// given instance IDs, keyed by instance ID. Instances with no
+	// pending events may be omitted from the result.
+	MaintenanceEvents(ctx context.ProviderCallContext, ids []instance.Id) (map[instance.Id][]MaintenanceEvent, error)
+}
+
+// Keys used in the instance status data map to surface scheduled
+// maintenance information to operators and charms.
+const (
+	maintenanceScheduledKey = "maintenance-scheduled"
+	maintenanceCodeKey      = "maintenance-code"
+	maintenanceNotBeforeKey = "maintenance-not-before"
+)
+
+// syncMaintenanceEvents queries the environ (when supported) for pending
+// maintenance events affecting the given instances and records them in
+// the instance status data of the corresponding machines so that
+// operators and charms get advance warning and can drain gracefully.
+func (u *updaterWorker) syncMaintenanceEvents(ctx context.ProviderCallContext, ids []instance.Id) error {
+	eventer, ok := u.config.Environ.(MaintenanceEventer)
+	if !ok {
+		return nil
+	}
+
+	events, err := eventer.MaintenanceEvents(ctx, ids)
+	if err != nil {
+		if errors.IsNotSupported(errors.Cause(err)) {
+			return nil
+		}
+		return errors.Annotate(err, "retrieving maintenance events for instances")
+	}
+
+	for _, id := range ids {
+		entry, found := u.instanceIDToGroupEntry[id]
+		if !found {
+			continue
+		}
+
+		instEvents := events[id]
+		if len(instEvents) == 0 {
+			continue
+		}
+
+		// Report the earliest pending event; subsequent events will be
+		// surfaced once the preceding ones complete.
+		next := instEvents[0]
+		for _, ev := range instEvents[1:] {
+			if !ev.NotBefore.IsZero() && (next.NotBefore.IsZero() || ev.NotBefore.Before(next.NotBefore)) {
+				next = ev
+			}
+		}
+
+		curStatus, err := entry.m.InstanceStatus()
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		data := map[string]interface{}{
+			maintenanceScheduledKey: true,
+			maintenanceCodeKey:      next.Code,
+		}
+		if !next.NotBefore.IsZero() {
+			data[maintenanceNotBeforeKey] = next.NotBefore.Format(time.RFC3339)
+		}
+
+		u.config.Logger.Infof("machine %q (instance ID %q) has scheduled maintenance %q starting no earlier than %v",
+			entry.m.Id(), id, next.Code, next.NotBefore)
+
+		if err := entry.m.SetInstanceStatus(status.Status(curStatus.Status), curStatus.Info, data); err != nil {
+			u.config.Logger.Errorf("cannot set instance status on %q: %v", entry.m, err)
+			return errors.Trace(err)
+		}
+	}
+
+	return nil
+}
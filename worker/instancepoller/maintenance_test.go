@@ -0,0 +1,97 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package instancepoller
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/worker/instancepoller/mocks"
+)
+
+var _ = gc.Suite(&maintenanceSuite{})
+
+type maintenanceSuite struct{}
+
+// maintenanceEnviron wraps a mock Environ with a canned maintenance
+// event feed so we can exercise the optional MaintenanceEventer path.
+type maintenanceEnviron struct {
+	*mocks.MockEnviron
+
+	events map[instance.Id][]MaintenanceEvent
+}
+
+func (e *maintenanceEnviron) MaintenanceEvents(_ context.ProviderCallContext, ids []instance.Id) (map[instance.Id][]MaintenanceEvent, error) {
+	return e.events, nil
+}
+
+func (s *maintenanceSuite) TestScheduledMaintenanceRecordedInStatusData(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	notBefore := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	environ := &maintenanceEnviron{
+		MockEnviron: mocks.NewMockEnviron(ctrl),
+		events: map[instance.Id][]MaintenanceEvent{
+			"b4dc0ffee": {{
+				Code:        "Reboot",
+				Description: "host reboot for platform update",
+				NotBefore:   notBefore,
+			}},
+		},
+	}
+
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().InstanceStatus().Return(params.StatusResult{
+		Status: string(status.Running),
+		Info:   "Running wild",
+	}, nil)
+	machine.EXPECT().SetInstanceStatus(status.Running, "Running wild", map[string]interface{}{
+		maintenanceScheduledKey: true,
+		maintenanceCodeKey:      "Reboot",
+		maintenanceNotBeforeKey: notBefore.Format(time.RFC3339),
+	}).Return(nil)
+
+	u := &updaterWorker{
+		config: Config{
+			Environ: environ,
+			Logger:  loggo.GetLogger("juju.worker.instancepoller"),
+		},
+		instanceIDToGroupEntry: map[instance.Id]*pollGroupEntry{
+			"b4dc0ffee": {
+				tag:        names.NewMachineTag("0"),
+				m:          machine,
+				instanceID: "b4dc0ffee",
+			},
+		},
+	}
+
+	err := u.syncMaintenanceEvents(nil, []instance.Id{"b4dc0ffee"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *maintenanceSuite) TestEnvironWithoutEventFeedIsIgnored(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	u := &updaterWorker{
+		config: Config{
+			Environ: mocks.NewMockEnviron(ctrl),
+			Logger:  loggo.GetLogger("juju.worker.instancepoller"),
+		},
+	}
+
+	err := u.syncMaintenanceEvents(nil, []instance.Id{"b4dc0ffee"})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -194,6 +194,20 @@ func (mr *MockMachineMockRecorder) SetInstanceStatus(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceStatus", reflect.TypeOf((*MockMachine)(nil).SetInstanceStatus), arg0, arg1, arg2)
 }
 
+// SetProviderMetadata mocks base method.
+func (m *MockMachine) SetProviderMetadata(arg0 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProviderMetadata", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProviderMetadata indicates an expected call of SetProviderMetadata.
+func (mr *MockMachineMockRecorder) SetProviderMetadata(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProviderMetadata", reflect.TypeOf((*MockMachine)(nil).SetProviderMetadata), arg0)
+}
+
 // SetProviderNetworkConfig mocks base method.
 func (m *MockMachine) SetProviderNetworkConfig(arg0 network.InterfaceInfos) (network.ProviderAddresses, bool, error) {
 	m.ctrl.T.Helper()
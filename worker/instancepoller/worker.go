@@ -55,6 +55,7 @@ type Machine interface {
 	SetProviderNetworkConfig(network.InterfaceInfos) (network.ProviderAddresses, bool, error)
 	InstanceStatus() (params.StatusResult, error)
 	SetInstanceStatus(status.Status, string, map[string]interface{}) error
+	SetProviderMetadata(map[string]string) error
 	String() string
 	Refresh() error
 	Status() (params.StatusResult, error)
@@ -115,6 +116,10 @@ type pollGroupEntry struct {
 
 	shortPollInterval time.Duration
 	shortPollAt       time.Time
+
+	// providerMetadata holds the last provider metadata recorded for
+	// the machine so unchanged metadata is not re-written every poll.
+	providerMetadata map[string]string
 }
 
 func (e *pollGroupEntry) resetShortPollInterval(clk clock.Clock) {
@@ -405,6 +410,18 @@ func (u *updaterWorker) pollGroupMembers(groupType pollGroupType) error {
 		}
 	}
 
+	// Surface any provider-scheduled maintenance events for the polled
+	// instances so that operators get advance warning.
+	if err := u.syncMaintenanceEvents(u.callContextFunc(ctx), instList); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Attach any extra provider metadata (e.g. image end-of-life dates
+	// or spot prices) to the polled machines.
+	if err := u.syncInstanceMetadata(u.callContextFunc(ctx), instList); err != nil {
+		return errors.Trace(err)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,10 @@
 package logger
 
 import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
@@ -19,6 +23,13 @@ type LoggerAPI interface {
 	WatchLoggingConfig(agentTag names.Tag) (watcher.NotifyWatcher, error)
 }
 
+// OverrideAPI represents the optional API calls the logger makes to pick
+// up temporary logging overrides set for the agent.
+type OverrideAPI interface {
+	LoggingOverride(agentTag names.Tag) (string, time.Time, error)
+	WatchLoggingOverride(agentTag names.Tag) (watcher.NotifyWatcher, error)
+}
+
 // WorkerConfig contains the information required for the Logger worker
 // to operate.
 type WorkerConfig struct {
@@ -28,6 +39,12 @@ type WorkerConfig struct {
 	Logger   Logger
 	Override string
 
+	// OverrideAPI, if set, supplies temporary logging overrides that are
+	// applied on top of the base configuration and automatically reverted
+	// when they expire. Clock must be set when OverrideAPI is.
+	OverrideAPI OverrideAPI
+	Clock       clock.Clock
+
 	Callback func(string) error
 }
 
@@ -42,6 +59,9 @@ func (c *WorkerConfig) Validate() error {
 	if c.Logger == nil {
 		return errors.NotValidf("missing logger")
 	}
+	if c.OverrideAPI != nil && c.Clock == nil {
+		return errors.NotValidf("missing clock")
+	}
 	return nil
 }
 
@@ -49,7 +69,10 @@ func (c *WorkerConfig) Validate() error {
 // environment watcher tells the agent that the value has changed.
 type loggerWorker struct {
 	config     WorkerConfig
+	mu         sync.Mutex
 	lastConfig string
+	lastSaved  string
+	revert     clock.Timer
 }
 
 // NewLogger returns a worker.Worker that uses the notify watcher returned
@@ -61,6 +84,7 @@ func NewLogger(config WorkerConfig) (worker.Worker, error) {
 	logger := &loggerWorker{
 		config:     config,
 		lastConfig: config.Context.Config().String(),
+		lastSaved:  config.Context.Config().String(),
 	}
 	config.Logger.Debugf("initial log config: %q", logger.lastConfig)
 
@@ -74,6 +98,9 @@ func NewLogger(config WorkerConfig) (worker.Worker, error) {
 }
 
 func (l *loggerWorker) setLogging() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	loggingConfig := ""
 	logger := l.config.Logger
 
@@ -89,6 +116,26 @@ func (l *loggerWorker) setLogging() {
 		loggingConfig = modelLoggingConfig
 	}
 
+	// Any temporary override is layered on top of the base configuration,
+	// and a revert is scheduled for when it expires.
+	if l.revert != nil {
+		l.revert.Stop()
+		l.revert = nil
+	}
+	baseConfig := loggingConfig
+	if api := l.config.OverrideAPI; api != nil {
+		overrideConfig, expiry, err := api.LoggingOverride(l.config.Tag)
+		if err != nil {
+			logger.Errorf("%v", err)
+		} else if overrideConfig != "" {
+			if remaining := expiry.Sub(l.config.Clock.Now()); remaining > 0 {
+				logger.Infof("applying logging override %q until %v", overrideConfig, expiry)
+				loggingConfig = loggingConfig + ";" + overrideConfig
+				l.revert = l.config.Clock.AfterFunc(remaining, l.revertLogging)
+			}
+		}
+	}
+
 	if loggingConfig != l.lastConfig {
 		logger.Debugf("reconfiguring logging from %q to %q", l.lastConfig, loggingConfig)
 		context := l.config.Context
@@ -103,16 +150,24 @@ func (l *loggerWorker) setLogging() {
 		}
 		mgo.ConfigureMgoLogging()
 		l.lastConfig = loggingConfig
-		// Save the logging config in the agent.conf file.
-		if callback := l.config.Callback; callback != nil {
-			err := callback(loggingConfig)
-			if err != nil {
-				logger.Errorf("%v", err)
-			}
+	}
+	// Save the base logging config in the agent.conf file; temporary
+	// overrides deliberately never end up there.
+	if callback := l.config.Callback; callback != nil && baseConfig != l.lastSaved {
+		if err := callback(baseConfig); err != nil {
+			logger.Errorf("%v", err)
+			return
 		}
+		l.lastSaved = baseConfig
 	}
 }
 
+// revertLogging is called by the revert timer once an override expires.
+func (l *loggerWorker) revertLogging() {
+	l.config.Logger.Infof("logging override expired, reverting")
+	l.setLogging()
+}
+
 // SetUp is called by the NotifyWorker when the worker starts, and it is
 // required to return a notify watcher that is used as the event source
 // for the Handle method.
@@ -121,7 +176,21 @@ func (l *loggerWorker) SetUp() (watcher.NotifyWatcher, error) {
 	// We need to set this up initially as the NotifyWorker sucks up the first
 	// event.
 	l.setLogging()
-	return l.config.API.WatchLoggingConfig(l.config.Tag)
+	configWatcher, err := l.config.API.WatchLoggingConfig(l.config.Tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if l.config.OverrideAPI == nil {
+		return configWatcher, nil
+	}
+	overrideWatcher, err := l.config.OverrideAPI.WatchLoggingOverride(l.config.Tag)
+	if errors.IsNotSupported(err) {
+		// The controller is too old to know about overrides.
+		return configWatcher, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return watcher.NewMultiNotifyWatcher(configWatcher, overrideWatcher), nil
 }
 
 // Handle is called by the NotifyWorker whenever the notify event is fired.
@@ -132,7 +201,12 @@ func (l *loggerWorker) Handle(_ <-chan struct{}) error {
 
 // TearDown is called by the NotifyWorker when the worker is being stopped.
 func (l *loggerWorker) TearDown() error {
-	// Nothing to cleanup, only state is the watcher
+	l.mu.Lock()
+	if l.revert != nil {
+		l.revert.Stop()
+		l.revert = nil
+	}
+	l.mu.Unlock()
 	l.config.Logger.Infof("logger worker stopped")
 	return nil
 }
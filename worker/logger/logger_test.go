@@ -6,6 +6,7 @@ package logger_test
 import (
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
@@ -172,3 +173,56 @@ func (m *mockAPI) WatchLoggingConfig(agentTag names.Tag) (watcher.NotifyWatcher,
 	m.watchingTag = agentTag
 	return m.watcher, nil
 }
+
+func (s *LoggerSuite) TestLoggingOverrideAppliedAndReverted(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	s.loggerAPI.watcher = newNotifiedWatcher()
+	overrideAPI := &mockOverrideAPI{
+		watcher: newNotifiedWatcher(),
+		config:  "test=TRACE",
+		expiry:  clk.Now().Add(time.Minute),
+	}
+	s.config.OverrideAPI = overrideAPI
+	s.config.Clock = clk
+
+	loggingWorker := s.makeLogger(c)
+	defer worker.Stop(loggingWorker)
+
+	s.waitLoggingInfo(c, "<root>=DEBUG;test=TRACE")
+	// The override never makes it into the saved agent config.
+	c.Check(s.value, gc.Equals, "")
+
+	// Once the override expires the worker reverts on its own.
+	c.Assert(clk.WaitAdvance(time.Minute, testing.LongWait, 1), jc.ErrorIsNil)
+	s.waitLoggingInfo(c, "<root>=DEBUG")
+}
+
+func (s *LoggerSuite) TestMissingClock(c *gc.C) {
+	s.config.OverrideAPI = &mockOverrideAPI{}
+	w, err := logger.NewLogger(s.config)
+	c.Assert(w, gc.IsNil)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err.Error(), gc.Equals, "missing clock not valid")
+}
+
+// newNotifiedWatcher returns a mock watcher with its initial event
+// already queued, as delivered by the real API watchers.
+func newNotifiedWatcher() *mockNotifyWatcher {
+	w := &mockNotifyWatcher{changes: make(chan struct{}, 1)}
+	w.changes <- struct{}{}
+	return w
+}
+
+type mockOverrideAPI struct {
+	watcher *mockNotifyWatcher
+	config  string
+	expiry  time.Time
+}
+
+func (m *mockOverrideAPI) LoggingOverride(agentTag names.Tag) (string, time.Time, error) {
+	return m.config, m.expiry, nil
+}
+
+func (m *mockOverrideAPI) WatchLoggingOverride(agentTag names.Tag) (watcher.NotifyWatcher, error) {
+	return m.watcher, nil
+}
@@ -4,11 +4,13 @@
 package logger
 
 import (
+	"github.com/juju/clock"
 	"github.com/juju/loggo"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/dependency"
 
 	"github.com/juju/juju/agent"
+	apiagent "github.com/juju/juju/api/agent/agent"
 	"github.com/juju/juju/api/agent/logger"
 	"github.com/juju/juju/api/base"
 )
@@ -54,13 +56,19 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			}
 
 			loggerFacade := logger.NewState(apiCaller)
+			agentFacade, err := apiagent.NewState(apiCaller)
+			if err != nil {
+				return nil, err
+			}
 			workerConfig := WorkerConfig{
-				Context:  config.LoggingContext,
-				API:      loggerFacade,
-				Tag:      currentConfig.Tag(),
-				Logger:   config.Logger,
-				Override: loggingOverride,
-				Callback: config.UpdateAgentFunc,
+				Context:     config.LoggingContext,
+				API:         loggerFacade,
+				Tag:         currentConfig.Tag(),
+				Logger:      config.Logger,
+				Override:    loggingOverride,
+				OverrideAPI: agentFacade,
+				Clock:       clock.WallClock,
+				Callback:    config.UpdateAgentFunc,
 			}
 			return NewLogger(workerConfig)
 		},
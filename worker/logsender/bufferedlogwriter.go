@@ -49,7 +49,17 @@ const writerName = "buffered-logs"
 // InstallBufferedLogWriter creates and returns a new BufferedLogWriter,
 // registering it with Loggo.
 func InstallBufferedLogWriter(context *loggo.Context, maxLen int) (*BufferedLogWriter, error) {
-	writer := NewBufferedLogWriter(maxLen)
+	return installBufferedLogWriter(context, NewBufferedLogWriter(maxLen))
+}
+
+// InstallBufferedLogWriterWithSpool is InstallBufferedLogWriter with an
+// on-disk spool attached: records that overflow the in-memory buffer
+// are preserved in the spool instead of being dropped.
+func InstallBufferedLogWriterWithSpool(context *loggo.Context, maxLen int, spool *Spool) (*BufferedLogWriter, error) {
+	return installBufferedLogWriter(context, NewBufferedLogWriterWithSpool(maxLen, spool))
+}
+
+func installBufferedLogWriter(context *loggo.Context, writer *BufferedLogWriter) (*BufferedLogWriter, error) {
 	err := context.AddWriter(writerName, writer)
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to set up log buffering")
@@ -82,6 +92,7 @@ type BufferedLogWriter struct {
 	maxLen int
 	in     LogRecordCh
 	out    LogRecordCh
+	spool  *Spool
 
 	mu    sync.Mutex
 	stats LogStats
@@ -90,10 +101,19 @@ type BufferedLogWriter struct {
 // NewBufferedLogWriter returns a new BufferedLogWriter which will
 // cache up to maxLen log messages.
 func NewBufferedLogWriter(maxLen int) *BufferedLogWriter {
+	return NewBufferedLogWriterWithSpool(maxLen, nil)
+}
+
+// NewBufferedLogWriterWithSpool returns a new BufferedLogWriter which
+// will cache up to maxLen log messages in memory. If spool is not nil,
+// messages that would otherwise be dropped on overflow are appended to
+// the spool for later replay by the logsender worker.
+func NewBufferedLogWriterWithSpool(maxLen int, spool *Spool) *BufferedLogWriter {
 	w := &BufferedLogWriter{
 		maxLen: maxLen,
 		in:     make(LogRecordCh),
 		out:    make(LogRecordCh),
+		spool:  spool,
 	}
 	go w.loop()
 	return w
@@ -127,11 +147,23 @@ func (w *BufferedLogWriter) loop() {
 			w.mu.Lock()
 			w.stats.Enqueued++
 			if buffer.Len() > w.maxLen {
-				// The buffer has exceeded the limit - discard the
-				// next LogRecord from the front of the queue.
-				buffer.PopFront()
-				outRec.DroppedAfter++
-				w.stats.Dropped++
+				// The buffer has exceeded the limit - remove the
+				// next LogRecord from the front of the queue and
+				// either preserve it in the on-disk spool or, with
+				// no spool configured, discard it.
+				item, _ := buffer.PopFront()
+				spooled := false
+				if w.spool != nil {
+					// Note: not logged on failure - this writer is
+					// registered with loggo, so logging here would
+					// feed back into the writer itself. The drop
+					// counters record the loss instead.
+					spooled = w.spool.Append(item.(*LogRecord)) == nil
+				}
+				if !spooled {
+					outRec.DroppedAfter++
+					w.stats.Dropped++
+				}
 			}
 			w.mu.Unlock()
 
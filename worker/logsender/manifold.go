@@ -17,6 +17,10 @@ import (
 type ManifoldConfig struct {
 	APICallerName string
 	LogSource     LogRecordCh
+
+	// Spool, if set, holds log records preserved on disk while the
+	// API was unreachable; the worker replays them on (re)connect.
+	Spool *Spool
 }
 
 // Manifold returns a dependency manifold that runs a logger
@@ -29,5 +33,5 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 }
 
 func (config ManifoldConfig) newWorker(apiCaller base.APICaller) (worker.Worker, error) {
-	return New(config.LogSource, logsender.NewAPI(apiCaller)), nil
+	return New(config.LogSource, config.Spool, logsender.NewAPI(apiCaller)), nil
 }
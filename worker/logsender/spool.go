@@ -0,0 +1,237 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logsender
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+const (
+	// spoolActiveName and spoolOldName are the two segment files
+	// making up the on-disk ring buffer. Records are appended to the
+	// active segment; when it fills up it replaces the old segment,
+	// discarding (and counting) whatever the old segment held.
+	spoolActiveName = "logs.spool"
+	spoolOldName    = "logs.spool.1"
+)
+
+// Spool is a size-capped on-disk ring buffer of log records. The
+// BufferedLogWriter appends records here instead of discarding them
+// when its in-memory buffer overflows, and the logsender worker
+// replays the spool once API connectivity returns, preserving logs
+// across controller maintenance windows.
+type Spool struct {
+	dir     string
+	maxSize int64
+
+	mu         sync.Mutex
+	active     *os.File
+	activeSize int64
+	dropped    uint64
+}
+
+// OpenSpool opens (creating if necessary) an on-disk log spool in the
+// given directory. The spool holds at most maxSize bytes of serialised
+// log records across its two segments; once full, the oldest records
+// are discarded and counted.
+func OpenSpool(dir string, maxSize int64) (*Spool, error) {
+	if maxSize <= 0 {
+		return nil, errors.NotValidf("non-positive spool size %d", maxSize)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Annotate(err, "creating log spool directory")
+	}
+	s := &Spool{dir: dir, maxSize: maxSize}
+	if err := s.openActiveLocked(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s, nil
+}
+
+func (s *Spool) openActiveLocked() error {
+	f, err := os.OpenFile(s.activePath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Annotate(err, "opening log spool")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Trace(err)
+	}
+	s.active = f
+	s.activeSize = info.Size()
+	return nil
+}
+
+func (s *Spool) activePath() string {
+	return filepath.Join(s.dir, spoolActiveName)
+}
+
+func (s *Spool) oldPath() string {
+	return filepath.Join(s.dir, spoolOldName)
+}
+
+// Append adds a record to the spool, rotating segments and discarding
+// the oldest records if the size cap has been reached.
+func (s *Spool) Append(rec *LogRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return errors.Annotate(err, "encoding log record")
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active == nil {
+		return errors.New("log spool is closed")
+	}
+	if s.activeSize+int64(len(data)) > s.maxSize/2 && s.activeSize > 0 {
+		if err := s.rotateLocked(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	n, err := s.active.Write(data)
+	s.activeSize += int64(n)
+	return errors.Annotate(err, "writing to log spool")
+}
+
+// rotateLocked makes the active segment the old one, discarding (and
+// counting) any records the previous old segment held.
+func (s *Spool) rotateLocked() error {
+	if discarded, err := countSpoolRecords(s.oldPath()); err == nil {
+		s.dropped += discarded
+	} else if !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	if err := s.active.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	s.active = nil
+	if err := os.Rename(s.activePath(), s.oldPath()); err != nil {
+		return errors.Trace(err)
+	}
+	return s.openActiveLocked()
+}
+
+// Drain removes and returns all spooled records, oldest first. Records
+// that fail to decode (e.g. truncated by an agent crash mid-write) are
+// counted as dropped.
+func (s *Spool) Drain() ([]*LogRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active == nil {
+		return nil, errors.New("log spool is closed")
+	}
+
+	var records []*LogRecord
+	for _, path := range []string{s.oldPath(), s.activePath()} {
+		recs, malformed, err := readSpoolRecords(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Trace(err)
+		}
+		records = append(records, recs...)
+		s.dropped += malformed
+	}
+
+	if err := s.active.Close(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	s.active = nil
+	if err := os.Remove(s.oldPath()); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Trace(err)
+	}
+	if err := os.Remove(s.activePath()); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := s.openActiveLocked(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return records, nil
+}
+
+// Dropped returns the number of records discarded from the spool so
+// far, due either to the size cap or to corrupt entries.
+func (s *Spool) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+// TakeDropped returns the number of records discarded from the spool
+// and resets the counter, so callers can report each loss once.
+func (s *Spool) TakeDropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dropped := s.dropped
+	s.dropped = 0
+	return dropped
+}
+
+// Close closes the spool. Spooled records are left on disk for replay
+// after the agent restarts.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active == nil {
+		return nil
+	}
+	err := s.active.Close()
+	s.active = nil
+	return errors.Trace(err)
+}
+
+// readSpoolRecords decodes the records held in a spool segment,
+// returning the count of malformed lines skipped.
+func readSpoolRecords(path string) ([]*LogRecord, uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var (
+		records   []*LogRecord
+		malformed uint64
+	)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var rec LogRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			malformed++
+			continue
+		}
+		records = append(records, &rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	return records, malformed, nil
+}
+
+// countSpoolRecords counts the records held in a spool segment.
+func countSpoolRecords(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var count uint64
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return count, nil
+}
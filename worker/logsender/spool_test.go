@@ -0,0 +1,136 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logsender_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/logsender"
+)
+
+type spoolSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&spoolSuite{})
+
+func (s *spoolSuite) openSpool(c *gc.C, maxSize int64) *logsender.Spool {
+	spool, err := logsender.OpenSpool(c.MkDir(), maxSize)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddCleanup(func(c *gc.C) { c.Check(spool.Close(), jc.ErrorIsNil) })
+	return spool
+}
+
+func makeSpoolRecord(i int) *logsender.LogRecord {
+	return &logsender.LogRecord{
+		Time:     time.Date(2023, 1, 1, 0, 0, i, 0, time.UTC),
+		Module:   "spool-test",
+		Location: fmt.Sprintf("file.go:%d", i),
+		Level:    loggo.INFO,
+		Message:  fmt.Sprintf("message%d", i),
+	}
+}
+
+func (s *spoolSuite) TestAppendAndDrain(c *gc.C) {
+	spool := s.openSpool(c, 1024*1024)
+	for i := 0; i < 3; i++ {
+		c.Assert(spool.Append(makeSpoolRecord(i)), jc.ErrorIsNil)
+	}
+
+	records, err := spool.Drain()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(records, gc.HasLen, 3)
+	for i, rec := range records {
+		c.Check(*rec, gc.DeepEquals, *makeSpoolRecord(i))
+	}
+	c.Check(spool.Dropped(), gc.Equals, uint64(0))
+
+	// The spool is now empty.
+	records, err = spool.Drain()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(records, gc.HasLen, 0)
+}
+
+func (s *spoolSuite) TestSurvivesReopen(c *gc.C) {
+	dir := c.MkDir()
+	spool, err := logsender.OpenSpool(dir, 1024*1024)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spool.Append(makeSpoolRecord(0)), jc.ErrorIsNil)
+	c.Assert(spool.Close(), jc.ErrorIsNil)
+
+	spool, err = logsender.OpenSpool(dir, 1024*1024)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { c.Check(spool.Close(), jc.ErrorIsNil) }()
+
+	records, err := spool.Drain()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(records, gc.HasLen, 1)
+	c.Check(*records[0], gc.DeepEquals, *makeSpoolRecord(0))
+}
+
+func (s *spoolSuite) TestSizeCapDropsOldest(c *gc.C) {
+	// Each encoded record is a bit over 100 bytes, so a 600 byte cap
+	// keeps the segments small enough to force rotation.
+	spool := s.openSpool(c, 600)
+	const count = 20
+	for i := 0; i < count; i++ {
+		c.Assert(spool.Append(makeSpoolRecord(i)), jc.ErrorIsNil)
+	}
+
+	records, err := spool.Drain()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(len(records) < count, jc.IsTrue)
+	c.Check(spool.Dropped(), gc.Equals, uint64(count-len(records)))
+
+	// The newest record is always preserved, and what remains is the
+	// contiguous tail of what was appended.
+	first := count - len(records)
+	for i, rec := range records {
+		c.Check(*rec, gc.DeepEquals, *makeSpoolRecord(first + i))
+	}
+
+	c.Check(spool.TakeDropped(), gc.Equals, uint64(count-len(records)))
+	c.Check(spool.Dropped(), gc.Equals, uint64(0))
+}
+
+func (s *spoolSuite) TestWriterOverflowsToSpool(c *gc.C) {
+	spool := s.openSpool(c, 1024*1024)
+	writer := logsender.NewBufferedLogWriterWithSpool(maxLen, spool)
+	defer writer.Close()
+
+	// Write more logs than the in-memory buffer allows.
+	const count = maxLen + 4
+	for i := 0; i < count; i++ {
+		writer.Write(loggo.Entry{
+			Level:     loggo.INFO,
+			Module:    "module",
+			Filename:  "filename",
+			Line:      i,
+			Timestamp: time.Now(),
+			Message:   fmt.Sprintf("log%d", i),
+		})
+	}
+
+	// The overflow went to the spool rather than being dropped.
+	var overflowed []*logsender.LogRecord
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		records, err := spool.Drain()
+		c.Assert(err, jc.ErrorIsNil)
+		overflowed = append(overflowed, records...)
+		if len(overflowed) == count-maxLen-1 {
+			break
+		}
+	}
+	// Log 0 was in flight to the output channel, so logs 1 onwards
+	// overflowed.
+	c.Assert(overflowed, gc.Not(gc.HasLen), 0)
+	c.Check(overflowed[0].Message, gc.Equals, "log1")
+	c.Check(writer.Stats().Dropped, gc.Equals, uint64(0))
+}
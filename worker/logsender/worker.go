@@ -5,6 +5,7 @@ package logsender
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -18,8 +19,10 @@ import (
 const loggerName = "juju.worker.logsender"
 
 // New starts a logsender worker which reads log message structs from
-// a channel and sends them to the controller via the logsink API.
-func New(logs LogRecordCh, logSenderAPI *logsender.API) worker.Worker {
+// a channel and sends them to the controller via the logsink API. If
+// spool is not nil, any records preserved there while the API was
+// unreachable are replayed first.
+func New(logs LogRecordCh, spool *Spool, logSenderAPI *logsender.API) worker.Worker {
 	loop := func(stop <-chan struct{}) error {
 		// It has been observed that sometimes the logsender.API gets wedged
 		// attempting to get the LogWriter while the agent is being torn down,
@@ -54,6 +57,11 @@ func New(logs LogRecordCh, logSenderAPI *logsender.API) worker.Worker {
 			return nil
 		}
 		defer logWriter.Close()
+		if spool != nil {
+			if err := replaySpool(spool, logWriter); err != nil {
+				return errors.Annotate(err, "replaying spooled logs")
+			}
+		}
 		for {
 			select {
 			case rec := <-logs:
@@ -102,3 +110,44 @@ func New(logs LogRecordCh, logSenderAPI *logsender.API) worker.Worker {
 	}
 	return jworker.NewSimpleWorker(loop)
 }
+
+// replaySpool sends any log records preserved on disk while the API
+// was unreachable, oldest first. Records that cannot be sent are put
+// back in the spool for the next attempt.
+func replaySpool(spool *Spool, logWriter logsender.LogWriter) error {
+	records, err := spool.Drain()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for i, rec := range records {
+		err := logWriter.WriteLog(&params.LogRecord{
+			Time:     rec.Time,
+			Module:   rec.Module,
+			Location: rec.Location,
+			Level:    rec.Level.String(),
+			Message:  rec.Message,
+			Labels:   rec.Labels,
+		})
+		if err != nil {
+			// Best effort: preserve whatever has not been sent yet.
+			for _, unsent := range records[i:] {
+				if spoolErr := spool.Append(unsent); spoolErr != nil {
+					break
+				}
+			}
+			return errors.Trace(err)
+		}
+	}
+	if dropped := spool.TakeDropped(); dropped > 0 {
+		err := logWriter.WriteLog(&params.LogRecord{
+			Time:    time.Now(),
+			Module:  loggerName,
+			Level:   loggo.WARNING.String(),
+			Message: fmt.Sprintf("%d log messages dropped due to log spool size limit", dropped),
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -68,7 +68,7 @@ func (s *workerSuite) TestLogSending(c *gc.C) {
 	logsCh := make(chan *logsender.LogRecord, logCount)
 
 	// Start the logsender worker.
-	worker := logsender.New(logsCh, s.logSenderAPI())
+	worker := logsender.New(logsCh, nil, s.logSenderAPI())
 	defer func() {
 		worker.Kill()
 		c.Check(worker.Wait(), jc.ErrorIsNil)
@@ -129,7 +129,7 @@ func (s *workerSuite) TestDroppedLogs(c *gc.C) {
 	logsCh := make(logsender.LogRecordCh)
 
 	// Start the logsender worker.
-	worker := logsender.New(logsCh, s.logSenderAPI())
+	worker := logsender.New(logsCh, nil, s.logSenderAPI())
 	defer func() {
 		worker.Kill()
 		c.Check(worker.Wait(), jc.ErrorIsNil)
@@ -0,0 +1,9 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package metricscollector provides a worker that scrapes charm-declared
+// OpenMetrics endpoints from inside the unit agent and forwards the
+// collected samples to the model's configured remote-write target. It
+// replaces the deprecated metric-batch path: charms declare scrape targets
+// in their metrics.yaml and need no metrics store or collect-metrics hook.
+package metricscollector
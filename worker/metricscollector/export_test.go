@@ -0,0 +1,12 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import "net/http"
+
+// Scrape exposes the scraper to tests.
+func Scrape(client *http.Client, clock Clock, target ScrapeTarget) ([]Sample, error) {
+	s := &scraper{client: client, clock: clock}
+	return s.scrape(target)
+}
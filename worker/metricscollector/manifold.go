@@ -0,0 +1,201 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/api/base"
+	jworker "github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/fortress"
+	"github.com/juju/juju/worker/uniter"
+)
+
+var defaultPeriod = 30 * time.Second
+
+// Clock represents time methods used by this package.
+type Clock interface {
+	Now() time.Time
+}
+
+// Logger represents the logging methods used in this package.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Tracef(string, ...interface{})
+	Warningf(string, ...interface{})
+}
+
+// ManifoldConfig identifies the resource names upon which the collector
+// manifold depends.
+type ManifoldConfig struct {
+	Period *time.Duration
+
+	AgentName     string
+	APICallerName string
+	CharmDirName  string
+
+	Clock  Clock
+	Logger Logger
+
+	// NewSender returns the sender that scraped samples are forwarded
+	// to, resolved from the model's scrape configuration over the API.
+	NewSender func(base.APICaller) (Sender, error)
+}
+
+// Validate returns an error if the config cannot drive a collector.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	if config.CharmDirName == "" {
+		return errors.NotValidf("empty CharmDirName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewSender == nil {
+		return errors.NotValidf("nil NewSender")
+	}
+	return nil
+}
+
+// Manifold returns a metrics-collector manifold.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.APICallerName,
+			config.CharmDirName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			collector, err := newCollector(config, context)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return jworker.NewPeriodicWorker(collector.Do, collector.period, jworker.NewTimer), nil
+		},
+	}
+}
+
+func newCollector(config ManifoldConfig, context dependency.Context) (*collector, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	period := defaultPeriod
+	if config.Period != nil {
+		period = *config.Period
+	}
+
+	var agent agent.Agent
+	if err := context.Get(config.AgentName, &agent); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var charmdir fortress.Guest
+	if err := context.Get(config.CharmDirName, &charmdir); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var apiCaller base.APICaller
+	if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	agentConfig := agent.CurrentConfig()
+	tag := agentConfig.Tag()
+	unitTag, ok := tag.(names.UnitTag)
+	if !ok {
+		return nil, errors.Errorf("expected a unit tag, got %v", tag)
+	}
+	sender, err := config.NewSender(apiCaller)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	paths := uniter.NewWorkerPaths(agentConfig.DataDir(), unitTag, "metrics-scrape", nil)
+	return &collector{
+		period:   period,
+		unitTag:  unitTag,
+		charmDir: paths.GetCharmDir(),
+		charmdir: charmdir,
+		sender:   sender,
+		scraper: &scraper{
+			client: &http.Client{Timeout: 10 * time.Second},
+			clock:  config.Clock,
+		},
+		clock:      config.Clock,
+		logger:     config.Logger,
+		lastScrape: make(map[string]time.Time),
+	}, nil
+}
+
+type collector struct {
+	period   time.Duration
+	unitTag  names.UnitTag
+	charmDir string
+	charmdir fortress.Guest
+	sender   Sender
+	scraper  *scraper
+	clock    Clock
+	logger   Logger
+
+	// lastScrape records when each target was last scraped, so that
+	// per-target intervals longer than the worker period are honoured.
+	lastScrape map[string]time.Time
+}
+
+// Do satisfies the worker.PeriodicWorkerCall function type.
+func (w *collector) Do(stop <-chan struct{}) error {
+	err := w.charmdir.Visit(w.collect, stop)
+	if err == fortress.ErrAborted {
+		w.logger.Tracef("cannot scrape metrics: %v", err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// collect reads the charm's scrape declarations, scrapes each target and
+// forwards the samples. Individual target failures are logged rather than
+// bouncing the worker: a workload restarting must not stop collection from
+// its healthy siblings.
+func (w *collector) collect() error {
+	targets, err := ReadTargets(w.charmDir)
+	if err != nil {
+		return errors.Annotate(err, "reading charm scrape targets")
+	}
+	if len(targets) == 0 {
+		w.logger.Tracef("no scrape targets declared")
+		return nil
+	}
+	for _, target := range targets {
+		now := w.clock.Now()
+		if target.Interval > 0 && now.Sub(w.lastScrape[target.URL()]) < target.Interval {
+			continue
+		}
+		w.lastScrape[target.URL()] = now
+		samples, err := w.scraper.scrape(target)
+		if err != nil {
+			w.logger.Warningf("cannot scrape %s: %v", target.URL(), err)
+			continue
+		}
+		if err := w.sender.Send(w.unitTag.Id(), samples); err != nil {
+			w.logger.Warningf("cannot forward %d samples from %s: %v", len(samples), target.URL(), err)
+		} else {
+			w.logger.Debugf("forwarded %d samples from %s", len(samples), target.URL())
+		}
+	}
+	return nil
+}
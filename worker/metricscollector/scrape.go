@@ -0,0 +1,140 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Sample is a single scraped metric value.
+type Sample struct {
+	// Name is the metric name as exposed by the workload.
+	Name string
+
+	// Labels hold the metric's label pairs, if any.
+	Labels map[string]string
+
+	// Value is the sample value.
+	Value float64
+
+	// Timestamp is when the sample was scraped, or the timestamp the
+	// workload attached to it.
+	Timestamp time.Time
+}
+
+type scraper struct {
+	client *http.Client
+	clock  Clock
+}
+
+// scrape fetches the target's metrics endpoint and parses the exposition
+// into samples.
+func (s *scraper) scrape(target ScrapeTarget) ([]Sample, error) {
+	resp, err := s.client.Get(target.URL())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("scraping %s: %s", target.URL(), resp.Status)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing metrics from %s", target.URL())
+	}
+	return flattenFamilies(families, s.clock.Now()), nil
+}
+
+// flattenFamilies converts parsed metric families into flat samples,
+// expanding histograms and summaries into their component series the way
+// the text exposition format writes them.
+func flattenFamilies(families map[string]*dto.MetricFamily, now time.Time) []Sample {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var samples []Sample
+	for _, name := range names {
+		family := families[name]
+		for _, metric := range family.GetMetric() {
+			labels := labelMap(metric)
+			ts := now
+			if metric.GetTimestampMs() != 0 {
+				ts = time.Unix(0, metric.GetTimestampMs()*int64(time.Millisecond))
+			}
+			add := func(suffix string, extraLabels map[string]string, value float64) {
+				merged := labels
+				if len(extraLabels) > 0 {
+					merged = make(map[string]string, len(labels)+len(extraLabels))
+					for k, v := range labels {
+						merged[k] = v
+					}
+					for k, v := range extraLabels {
+						merged[k] = v
+					}
+				}
+				samples = append(samples, Sample{
+					Name:      name + suffix,
+					Labels:    merged,
+					Value:     value,
+					Timestamp: ts,
+				})
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add("", nil, metric.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add("", nil, metric.GetGauge().GetValue())
+			case dto.MetricType_UNTYPED:
+				add("", nil, metric.GetUntyped().GetValue())
+			case dto.MetricType_SUMMARY:
+				summary := metric.GetSummary()
+				for _, quantile := range summary.GetQuantile() {
+					add("", map[string]string{
+						"quantile": formatFloat(quantile.GetQuantile()),
+					}, quantile.GetValue())
+				}
+				add("_sum", nil, summary.GetSampleSum())
+				add("_count", nil, float64(summary.GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				histogram := metric.GetHistogram()
+				for _, bucket := range histogram.GetBucket() {
+					add("_bucket", map[string]string{
+						"le": formatFloat(bucket.GetUpperBound()),
+					}, float64(bucket.GetCumulativeCount()))
+				}
+				add("_sum", nil, histogram.GetSampleSum())
+				add("_count", nil, float64(histogram.GetSampleCount()))
+			}
+		}
+	}
+	return samples
+}
+
+func labelMap(metric *dto.Metric) map[string]string {
+	if len(metric.GetLabel()) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, pair := range metric.GetLabel() {
+		labels[pair.GetName()] = pair.GetValue()
+	}
+	return labels
+}
+
+func formatFloat(f float64) string {
+	return fmt.Sprintf("%g", f)
+}
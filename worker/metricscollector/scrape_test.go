@@ -0,0 +1,88 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/metricscollector"
+)
+
+type ScrapeSuite struct{}
+
+var _ = gc.Suite(&ScrapeSuite{})
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+const exposition = `
+# HELP requests_total Total requests served.
+# TYPE requests_total counter
+requests_total{code="200"} 42
+requests_total{code="500"} 1
+# TYPE queue_depth gauge
+queue_depth 7
+`
+
+func (s *ScrapeSuite) TestScrape(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, gc.Equals, "/metrics")
+		_, _ = w.Write([]byte(exposition))
+	}))
+	defer server.Close()
+
+	now := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	samples, err := metricscollector.Scrape(server.Client(), fixedClock{now}, scrapeTarget(c, server))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(samples, jc.DeepEquals, []metricscollector.Sample{{
+		Name:      "queue_depth",
+		Value:     7,
+		Timestamp: now,
+	}, {
+		Name:      "requests_total",
+		Labels:    map[string]string{"code": "200"},
+		Value:     42,
+		Timestamp: now,
+	}, {
+		Name:      "requests_total",
+		Labels:    map[string]string{"code": "500"},
+		Value:     1,
+		Timestamp: now,
+	}})
+}
+
+func (s *ScrapeSuite) TestScrapeErrorStatus(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := metricscollector.Scrape(server.Client(), fixedClock{time.Now()}, scrapeTarget(c, server))
+	c.Assert(err, gc.ErrorMatches, `scraping http://localhost:\d+/metrics: 503 .*`)
+}
+
+// scrapeTarget points a target declaration at the test server's port.
+func scrapeTarget(c *gc.C, server *httptest.Server) metricscollector.ScrapeTarget {
+	parsed, err := url.Parse(server.URL)
+	c.Assert(err, jc.ErrorIsNil)
+	port, err := strconv.Atoi(parsed.Port())
+	c.Assert(err, jc.ErrorIsNil)
+	return metricscollector.ScrapeTarget{
+		Port:   port,
+		Path:   "/metrics",
+		Scheme: "http",
+	}
+}
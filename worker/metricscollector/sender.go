@@ -0,0 +1,99 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/agent/metricscollector"
+	"github.com/juju/juju/api/base"
+)
+
+// Sender forwards scraped samples to wherever they are kept. The agent
+// wires in a remote-write sender when the model configures a target.
+type Sender interface {
+	Send(unit string, samples []Sample) error
+}
+
+// NewAPISender resolves the model's scrape configuration over the API
+// and returns the sender that samples should be forwarded to. When the
+// model does not configure a remote-write target the returned sender
+// discards samples, so that charms declaring scrape targets keep being
+// scraped and start flowing as soon as an operator configures one.
+func NewAPISender(apiCaller base.APICaller) (Sender, error) {
+	cfg, err := metricscollector.NewClient(apiCaller).ScrapeConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if cfg.RemoteWriteURL == "" {
+		return dropSender{}, nil
+	}
+	return NewRemoteWriteSender(cfg.RemoteWriteURL), nil
+}
+
+// dropSender discards samples; it is used when the model has no
+// remote-write target configured.
+type dropSender struct{}
+
+// Send is part of the Sender interface.
+func (dropSender) Send(string, []Sample) error {
+	return nil
+}
+
+// remoteWriteSender pushes samples to an operator-configured endpoint in
+// the text exposition format, labelled with the originating unit.
+type remoteWriteSender struct {
+	url    string
+	client *http.Client
+}
+
+// NewRemoteWriteSender returns a Sender that POSTs samples to the given URL.
+func NewRemoteWriteSender(url string) Sender {
+	return &remoteWriteSender{
+		url: url,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Send is part of the Sender interface.
+func (s *remoteWriteSender) Send(unit string, samples []Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, sample := range samples {
+		writeSample(&buf, unit, sample)
+	}
+	resp, err := s.client.Post(s.url, "text/plain; version=0.0.4", &buf)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("remote write to %s: %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+func writeSample(buf *bytes.Buffer, unit string, sample Sample) {
+	labelNames := make([]string, 0, len(sample.Labels))
+	for name := range sample.Labels {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+
+	fmt.Fprintf(buf, "%s{juju_unit=%q", sample.Name, unit)
+	for _, name := range labelNames {
+		fmt.Fprintf(buf, ",%s=%q", name, sample.Labels[name])
+	}
+	fmt.Fprintf(buf, "} %g %d\n", sample.Value, sample.Timestamp.UnixNano()/int64(time.Millisecond))
+}
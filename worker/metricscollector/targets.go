@@ -0,0 +1,116 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// metricsDeclarationFile is the charm file holding the scrape
+	// declarations. It is the same file that held the old v1 metric
+	// definitions; the two formats coexist under different keys.
+	metricsDeclarationFile = "metrics.yaml"
+
+	defaultScrapePath   = "/metrics"
+	defaultScrapeScheme = "http"
+)
+
+// ScrapeTarget describes a single OpenMetrics endpoint declared by a charm.
+type ScrapeTarget struct {
+	// Port is the port the workload serves metrics on.
+	Port int
+
+	// Path is the HTTP path of the metrics endpoint.
+	Path string
+
+	// Scheme is either "http" or "https".
+	Scheme string
+
+	// Interval is an optional per-target scrape interval; zero means
+	// the worker's default period applies.
+	Interval time.Duration
+}
+
+// URL returns the address to scrape, relative to the unit's own host.
+func (t ScrapeTarget) URL() string {
+	return fmt.Sprintf("%s://localhost:%d%s", t.Scheme, t.Port, t.Path)
+}
+
+// Validate returns an error if the target declaration is malformed.
+func (t ScrapeTarget) Validate() error {
+	if t.Port <= 0 || t.Port > 65535 {
+		return errors.NotValidf("scrape port %d", t.Port)
+	}
+	if t.Scheme != "http" && t.Scheme != "https" {
+		return errors.NotValidf("scrape scheme %q", t.Scheme)
+	}
+	if t.Path == "" || t.Path[0] != '/' {
+		return errors.NotValidf("scrape path %q", t.Path)
+	}
+	return nil
+}
+
+// scrapeDeclaration is the wire form of the "scrape" section of a charm's
+// metrics.yaml.
+type scrapeDeclaration struct {
+	Scrape []struct {
+		Port     int    `yaml:"port"`
+		Path     string `yaml:"path"`
+		Scheme   string `yaml:"scheme"`
+		Interval string `yaml:"interval"`
+	} `yaml:"scrape"`
+}
+
+// ReadTargets parses the scrape targets declared in the charm directory's
+// metrics.yaml. A charm with no metrics.yaml, or one that only contains v1
+// metric definitions, yields no targets and no error.
+func ReadTargets(charmDir string) ([]ScrapeTarget, error) {
+	data, err := os.ReadFile(filepath.Join(charmDir, metricsDeclarationFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return parseTargets(data)
+}
+
+func parseTargets(data []byte) ([]ScrapeTarget, error) {
+	var decl scrapeDeclaration
+	if err := yaml.Unmarshal(data, &decl); err != nil {
+		return nil, errors.Annotate(err, "parsing metrics.yaml")
+	}
+	targets := make([]ScrapeTarget, 0, len(decl.Scrape))
+	for i, raw := range decl.Scrape {
+		target := ScrapeTarget{
+			Port:   raw.Port,
+			Path:   raw.Path,
+			Scheme: raw.Scheme,
+		}
+		if target.Path == "" {
+			target.Path = defaultScrapePath
+		}
+		if target.Scheme == "" {
+			target.Scheme = defaultScrapeScheme
+		}
+		if raw.Interval != "" {
+			interval, err := time.ParseDuration(raw.Interval)
+			if err != nil {
+				return nil, errors.Annotatef(err, "parsing interval of scrape target %d", i)
+			}
+			target.Interval = interval
+		}
+		if err := target.Validate(); err != nil {
+			return nil, errors.Annotatef(err, "scrape target %d", i)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
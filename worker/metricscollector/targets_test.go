@@ -0,0 +1,97 @@
+// Copyright 2022 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package metricscollector_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/metricscollector"
+)
+
+type TargetsSuite struct{}
+
+var _ = gc.Suite(&TargetsSuite{})
+
+func (s *TargetsSuite) writeMetricsYAML(c *gc.C, content string) string {
+	dir := c.MkDir()
+	err := os.WriteFile(filepath.Join(dir, "metrics.yaml"), []byte(content), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	return dir
+}
+
+func (s *TargetsSuite) TestNoMetricsYAML(c *gc.C) {
+	targets, err := metricscollector.ReadTargets(c.MkDir())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(targets, gc.HasLen, 0)
+}
+
+func (s *TargetsSuite) TestV1MetricsOnly(c *gc.C) {
+	dir := s.writeMetricsYAML(c, `
+metrics:
+  pings:
+    type: gauge
+    description: ping counter
+`)
+	targets, err := metricscollector.ReadTargets(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(targets, gc.HasLen, 0)
+}
+
+func (s *TargetsSuite) TestScrapeTargets(c *gc.C) {
+	dir := s.writeMetricsYAML(c, `
+scrape:
+  - port: 9100
+  - port: 8443
+    path: /-/metrics
+    scheme: https
+    interval: 5m
+`)
+	targets, err := metricscollector.ReadTargets(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(targets, jc.DeepEquals, []metricscollector.ScrapeTarget{{
+		Port:   9100,
+		Path:   "/metrics",
+		Scheme: "http",
+	}, {
+		Port:     8443,
+		Path:     "/-/metrics",
+		Scheme:   "https",
+		Interval: 5 * time.Minute,
+	}})
+	c.Assert(targets[0].URL(), gc.Equals, "http://localhost:9100/metrics")
+}
+
+func (s *TargetsSuite) TestInvalidPort(c *gc.C) {
+	dir := s.writeMetricsYAML(c, `
+scrape:
+  - port: 123456
+`)
+	_, err := metricscollector.ReadTargets(dir)
+	c.Assert(err, gc.ErrorMatches, `scrape target 0: scrape port 123456 not valid`)
+}
+
+func (s *TargetsSuite) TestInvalidScheme(c *gc.C) {
+	dir := s.writeMetricsYAML(c, `
+scrape:
+  - port: 9100
+    scheme: gopher
+`)
+	_, err := metricscollector.ReadTargets(dir)
+	c.Assert(err, gc.ErrorMatches, `scrape target 0: scrape scheme "gopher" not valid`)
+}
+
+func (s *TargetsSuite) TestInvalidInterval(c *gc.C) {
+	dir := s.writeMetricsYAML(c, `
+scrape:
+  - port: 9100
+    interval: fortnightly
+`)
+	_, err := metricscollector.ReadTargets(dir)
+	c.Assert(err, gc.ErrorMatches, `parsing interval of scrape target 0: .*`)
+}
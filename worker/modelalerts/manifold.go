@@ -0,0 +1,102 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/controller/modelalerts"
+	coremodelalerts "github.com/juju/juju/core/modelalerts"
+)
+
+// Facade has the controller methods used by the model alerts worker.
+type Facade interface {
+	UnitReporter
+
+	// WebhookURL returns the model's configured alert webhook URL, or
+	// the empty string when no webhook sink is configured.
+	WebhookURL() (string, error)
+}
+
+// ManifoldConfig describes how to create a model alerts worker.
+type ManifoldConfig struct {
+	APICallerName string
+	Clock         clock.Clock
+	Logger        Logger
+	NewFacade     func(base.APICaller) (Facade, error)
+	NewWorker     func(Config) (worker.Worker, error)
+}
+
+// Validate checks whether the manifold configuration settings are valid.
+func (config ManifoldConfig) Validate() error {
+	if config.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewFacade == nil {
+		return errors.NotValidf("nil NewFacade")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that runs a model alerts
+// worker according to the supplied configuration.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+		},
+		Start: config.start,
+	}
+}
+
+func (config ManifoldConfig) start(context dependency.Context) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var apiCaller base.APICaller
+	if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+		return nil, errors.Trace(err)
+	}
+	facade, err := config.NewFacade(apiCaller)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot create facade")
+	}
+	webhookURL, err := facade.WebhookURL()
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot retrieve webhook URL")
+	}
+	var sink Sink
+	if webhookURL != "" {
+		sink = NewWebhookSink(webhookURL)
+	}
+	w, err := config.NewWorker(Config{
+		Clock:    config.Clock,
+		Reporter: facade,
+		Logger:   config.Logger,
+		Rules:    coremodelalerts.DefaultRules(),
+		Sink:     sink,
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot create worker")
+	}
+	return w, nil
+}
+
+// NewAPIFacade returns a Facade backed by the supplied APICaller.
+func NewAPIFacade(apiCaller base.APICaller) (Facade, error) {
+	return modelalerts.NewClient(apiCaller), nil
+}
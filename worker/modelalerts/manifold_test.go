@@ -0,0 +1,143 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/dependency"
+	dt "github.com/juju/worker/v3/dependency/testing"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	coremodelalerts "github.com/juju/juju/core/modelalerts"
+	"github.com/juju/juju/worker/modelalerts"
+)
+
+type ManifoldSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ManifoldSuite{})
+
+func (s *ManifoldSuite) validConfig() modelalerts.ManifoldConfig {
+	return modelalerts.ManifoldConfig{
+		APICallerName: "api-caller",
+		Clock:         testclock.NewClock(time.Now()),
+		Logger:        loggo.GetLogger("juju.worker.modelalerts"),
+		NewFacade: func(base.APICaller) (modelalerts.Facade, error) {
+			return nil, errors.New("unexpected")
+		},
+		NewWorker: func(modelalerts.Config) (worker.Worker, error) {
+			return nil, errors.New("unexpected")
+		},
+	}
+}
+
+func (s *ManifoldSuite) TestInputs(c *gc.C) {
+	manifold := modelalerts.Manifold(s.validConfig())
+	c.Check(manifold.Inputs, jc.DeepEquals, []string{"api-caller"})
+}
+
+func (s *ManifoldSuite) TestValidate(c *gc.C) {
+	config := s.validConfig()
+	c.Check(config.Validate(), jc.ErrorIsNil)
+
+	config.APICallerName = ""
+	c.Check(config.Validate(), gc.ErrorMatches, "empty APICallerName not valid")
+
+	config = s.validConfig()
+	config.Clock = nil
+	c.Check(config.Validate(), gc.ErrorMatches, "nil Clock not valid")
+
+	config = s.validConfig()
+	config.Logger = nil
+	c.Check(config.Validate(), gc.ErrorMatches, "nil Logger not valid")
+
+	config = s.validConfig()
+	config.NewFacade = nil
+	c.Check(config.Validate(), gc.ErrorMatches, "nil NewFacade not valid")
+
+	config = s.validConfig()
+	config.NewWorker = nil
+	c.Check(config.Validate(), gc.ErrorMatches, "nil NewWorker not valid")
+}
+
+func (s *ManifoldSuite) TestMissingAPICaller(c *gc.C) {
+	manifold := modelalerts.Manifold(s.validConfig())
+	_, err := manifold.Start(dt.StubContext(nil, map[string]interface{}{
+		"api-caller": dependency.ErrMissing,
+	}))
+	c.Check(errors.Cause(err), gc.Equals, dependency.ErrMissing)
+}
+
+func (s *ManifoldSuite) TestStartConfiguresWebhookSink(c *gc.C) {
+	facade := &stubFacade{webhookURL: "https://alerts.example.com/hook"}
+	fakeWorker := &fakeWorker{}
+
+	var gotConfig modelalerts.Config
+	config := s.validConfig()
+	config.NewFacade = func(base.APICaller) (modelalerts.Facade, error) {
+		return facade, nil
+	}
+	config.NewWorker = func(cfg modelalerts.Config) (worker.Worker, error) {
+		gotConfig = cfg
+		return fakeWorker, nil
+	}
+
+	manifold := modelalerts.Manifold(config)
+	w, err := manifold.Start(dt.StubContext(nil, map[string]interface{}{
+		"api-caller": fakeAPICaller{},
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(w, gc.Equals, fakeWorker)
+	c.Check(gotConfig.Reporter, gc.Equals, facade)
+	c.Check(gotConfig.Rules, gc.DeepEquals, coremodelalerts.DefaultRules())
+	c.Check(gotConfig.Sink, gc.NotNil)
+}
+
+func (s *ManifoldSuite) TestStartNoWebhookNoSink(c *gc.C) {
+	var gotConfig modelalerts.Config
+	config := s.validConfig()
+	config.NewFacade = func(base.APICaller) (modelalerts.Facade, error) {
+		return &stubFacade{}, nil
+	}
+	config.NewWorker = func(cfg modelalerts.Config) (worker.Worker, error) {
+		gotConfig = cfg
+		return &fakeWorker{}, nil
+	}
+
+	manifold := modelalerts.Manifold(config)
+	_, err := manifold.Start(dt.StubContext(nil, map[string]interface{}{
+		"api-caller": fakeAPICaller{},
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(gotConfig.Sink, gc.IsNil)
+}
+
+type stubFacade struct {
+	webhookURL string
+}
+
+func (f *stubFacade) UnitReports() ([]coremodelalerts.UnitReport, error) {
+	return nil, nil
+}
+
+func (f *stubFacade) WebhookURL() (string, error) {
+	return f.webhookURL, nil
+}
+
+type fakeAPICaller struct {
+	base.APICaller
+}
+
+type fakeWorker struct {
+	worker.Worker
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/modelalerts"
+)
+
+// webhookTimeout bounds how long a single webhook delivery may take.
+const webhookTimeout = 10 * time.Second
+
+// WebhookSink posts the active alert set as a JSON document to an
+// operator-supplied HTTP endpoint whenever it changes.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink that delivers alert changes to the
+// given URL.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Publish implements Sink.
+func (s *WebhookSink) Publish(alerts []modelalerts.Alert) error {
+	payload := struct {
+		Alerts []modelalerts.Alert `json:"alerts"`
+	}{Alerts: alerts}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Annotatef(err, "posting alerts to %q", s.url)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("webhook %q returned status %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
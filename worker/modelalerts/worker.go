@@ -0,0 +1,173 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/core/modelalerts"
+)
+
+// EvalInterval is how often the worker re-evaluates the alert rules
+// against the current state of the model.
+var EvalInterval = 1 * time.Minute
+
+// Logger represents the methods used by the worker to log details.
+type Logger interface {
+	Debugf(string, ...interface{})
+	Infof(string, ...interface{})
+	Warningf(string, ...interface{})
+	Errorf(string, ...interface{})
+}
+
+// UnitReporter provides the unit state snapshots that the alert rules
+// are evaluated against.
+type UnitReporter interface {
+	// UnitReports returns a snapshot of every unit in the model.
+	UnitReports() ([]modelalerts.UnitReport, error)
+}
+
+// Sink receives the current set of active alerts each time it changes.
+// Implementations must be safe to call from the worker's loop goroutine.
+type Sink interface {
+	Publish(alerts []modelalerts.Alert) error
+}
+
+// Config encapsulates the configuration options for instantiating a new
+// model alerts worker.
+type Config struct {
+	Clock    clock.Clock
+	Reporter UnitReporter
+	Logger   Logger
+	Rules    modelalerts.Rules
+
+	// Sink, if non-nil, is notified whenever the set of active alerts
+	// changes. Failures to publish are logged and do not stop the
+	// worker.
+	Sink Sink
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.NotValidf("nil clock.Clock")
+	}
+	if config.Reporter == nil {
+		return errors.NotValidf("nil Reporter")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if err := config.Rules.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+type alertsWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	mu      sync.Mutex
+	current []modelalerts.Alert
+}
+
+// NewWorker returns a worker that periodically evaluates the configured
+// alert rules against the model's units and tracks the set of active
+// alerts, optionally publishing changes to a sink.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &alertsWorker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *alertsWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *alertsWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// CurrentAlerts returns the set of alerts active as of the last rule
+// evaluation. It is safe to call from any goroutine.
+func (w *alertsWorker) CurrentAlerts() []modelalerts.Alert {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	alerts := make([]modelalerts.Alert, len(w.current))
+	copy(alerts, w.current)
+	return alerts
+}
+
+func (w *alertsWorker) loop() error {
+	timer := w.config.Clock.NewTimer(EvalInterval)
+	defer func() { _ = timer.Stop() }()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.evaluateOnce(); err != nil {
+				return errors.Trace(err)
+			}
+			timer.Reset(EvalInterval)
+		}
+	}
+}
+
+func (w *alertsWorker) evaluateOnce() error {
+	units, err := w.config.Reporter.UnitReports()
+	if err != nil {
+		return errors.Annotate(err, "retrieving unit reports")
+	}
+
+	alerts := w.config.Rules.Evaluate(w.config.Clock.Now(), units)
+
+	w.mu.Lock()
+	changed := !alertsEqual(w.current, alerts)
+	w.current = alerts
+	w.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+
+	w.config.Logger.Infof("active model alerts changed; %d alert(s) now active", len(alerts))
+	if w.config.Sink != nil {
+		if err := w.config.Sink.Publish(alerts); err != nil {
+			w.config.Logger.Errorf("cannot publish model alerts: %v", err)
+		}
+	}
+	return nil
+}
+
+func alertsEqual(a, b []modelalerts.Alert) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
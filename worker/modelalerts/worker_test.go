@@ -0,0 +1,95 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelalerts
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/modelalerts"
+	"github.com/juju/juju/core/status"
+	coretesting "github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&workerSuite{})
+
+type workerSuite struct{}
+
+type fakeReporter struct {
+	reports []modelalerts.UnitReport
+}
+
+func (r *fakeReporter) UnitReports() ([]modelalerts.UnitReport, error) {
+	return r.reports, nil
+}
+
+type recordingSink struct {
+	publishedCh chan []modelalerts.Alert
+}
+
+func (s *recordingSink) Publish(alerts []modelalerts.Alert) error {
+	s.publishedCh <- alerts
+	return nil
+}
+
+func (s *workerSuite) TestConfigValidation(c *gc.C) {
+	origCfg := Config{
+		Clock:    testclock.NewClock(time.Now()),
+		Reporter: &fakeReporter{},
+		Logger:   loggo.GetLogger("juju.worker.modelalerts"),
+		Rules:    modelalerts.DefaultRules(),
+	}
+	c.Assert(origCfg.Validate(), jc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.Clock = nil
+	c.Assert(testCfg.Validate(), gc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.Reporter = nil
+	c.Assert(testCfg.Validate(), gc.ErrorMatches, "nil Reporter.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Assert(testCfg.Validate(), gc.ErrorMatches, "nil Logger.*")
+}
+
+func (s *workerSuite) TestAlertsPublishedToSink(c *gc.C) {
+	clk := testclock.NewClock(time.Now())
+	reporter := &fakeReporter{reports: []modelalerts.UnitReport{{
+		Tag:         "unit-mysql-0",
+		AgentStatus: status.Lost,
+		StatusSince: clk.Now().Add(-time.Minute),
+	}}}
+	sink := &recordingSink{publishedCh: make(chan []modelalerts.Alert, 1)}
+
+	w, err := NewWorker(Config{
+		Clock:    clk,
+		Reporter: reporter,
+		Logger:   loggo.GetLogger("juju.worker.modelalerts"),
+		Rules:    modelalerts.DefaultRules(),
+		Sink:     sink,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(clk.WaitAdvance(EvalInterval, coretesting.ShortWait, 1), jc.ErrorIsNil)
+
+	select {
+	case alerts := <-sink.publishedCh:
+		c.Assert(alerts, gc.HasLen, 1)
+		c.Check(alerts[0].Rule, gc.Equals, modelalerts.RuleAgentLost)
+		c.Check(alerts[0].Entity, gc.Equals, "unit-mysql-0")
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for alerts to be published")
+	}
+
+	aw := w.(*alertsWorker)
+	c.Assert(aw.CurrentAlerts(), gc.HasLen, 1)
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build linux
+// +build linux
+
+package patchreporter
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+const (
+	// rebootRequiredFile is created by update-notifier when a
+	// package installation requires the host to be rebooted.
+	rebootRequiredFile = "/var/run/reboot-required"
+
+	// aptCheckPath is the update-notifier helper that prints the
+	// number of pending updates and pending security updates,
+	// semicolon-separated, on stderr.
+	aptCheckPath = "/usr/lib/update-notifier/apt-check"
+)
+
+func init() {
+	DefaultCheckPatchStatus = checkPatchStatus
+}
+
+func checkPatchStatus() (PatchStatus, error) {
+	var status PatchStatus
+	if _, err := os.Stat(rebootRequiredFile); err == nil {
+		status.RebootRequired = true
+	} else if !os.IsNotExist(err) {
+		return PatchStatus{}, errors.Trace(err)
+	}
+	pending, security, err := aptCheck()
+	if err != nil {
+		// Not all Linux hosts have update-notifier; report the
+		// reboot flag on its own rather than failing outright.
+		logger.Debugf("cannot count pending updates: %v", err)
+		return status, nil
+	}
+	status.PendingUpdates = pending
+	status.PendingSecurityUpdates = security
+	return status, nil
+}
+
+func aptCheck() (pending, security int, err error) {
+	// apt-check writes "<updates>;<security>" to stderr.
+	out, err := exec.Command(aptCheckPath).CombinedOutput()
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	fields := strings.Split(strings.TrimSpace(string(out)), ";")
+	if len(fields) != 2 {
+		return 0, 0, errors.Errorf("unexpected apt-check output %q", out)
+	}
+	pending, err = strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	security, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	return pending, security, nil
+}
@@ -0,0 +1,24 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build !linux
+// +build !linux
+
+package patchreporter
+
+import (
+	"runtime"
+)
+
+func checkPatchStatus() (PatchStatus, error) {
+	// Report nothing pending each time.
+	return PatchStatus{}, nil
+}
+
+func init() {
+	logger.Infof(
+		"patch status reporting has not been implemented for %s",
+		runtime.GOOS,
+	)
+	DefaultCheckPatchStatus = checkPatchStatus
+}
@@ -0,0 +1,8 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter
+
+var (
+	DoWork = doWork
+)
@@ -0,0 +1,80 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter
+
+import (
+	"time"
+
+	"github.com/juju/loggo"
+	"github.com/juju/worker/v3"
+
+	jworker "github.com/juju/juju/worker"
+)
+
+var logger = loggo.GetLogger("juju.worker.patchreporter")
+
+// checkPatchStatusPeriod is the time period between patch status
+// checks. Package indexes change infrequently, so there is no value
+// in polling aggressively.
+const checkPatchStatusPeriod = 30 * time.Minute
+
+// PatchStatus describes the patch state of the local host.
+type PatchStatus struct {
+	// PendingUpdates is the number of package updates that can be
+	// applied to the host.
+	PendingUpdates int
+
+	// PendingSecurityUpdates is the number of pending updates that
+	// are security updates.
+	PendingSecurityUpdates int
+
+	// RebootRequired is true if the host's OS reports that a reboot
+	// is needed to finish applying updates.
+	RebootRequired bool
+}
+
+// PatchStatusSetter is an interface that is supplied to NewWorker for
+// reporting the patch status of the local host.
+type PatchStatusSetter interface {
+	SetPatchStatus(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error
+}
+
+// CheckPatchStatusFunc is the type of a function that is supplied to
+// NewWorker for checking the patch status of the local host.
+type CheckPatchStatusFunc func() (PatchStatus, error)
+
+// DefaultCheckPatchStatus is the default function for checking the
+// patch status for the operating system of the local host.
+var DefaultCheckPatchStatus CheckPatchStatusFunc
+
+// NewWorker returns a worker that periodically checks the patch state
+// of the machine it runs on, and reports it to the controller.
+var NewWorker = func(check CheckPatchStatusFunc, setter PatchStatusSetter) worker.Worker {
+	var old *PatchStatus
+	f := func(stop <-chan struct{}) error {
+		return doWork(check, setter, &old)
+	}
+	return jworker.NewPeriodicWorker(f, checkPatchStatusPeriod, jworker.NewTimer)
+}
+
+func doWork(check CheckPatchStatusFunc, setter PatchStatusSetter, old **PatchStatus) error {
+	status, err := check()
+	if err != nil {
+		return err
+	}
+	if *old != nil && status == **old {
+		logger.Tracef("no changes to patch status detected")
+		return nil
+	}
+	logger.Infof("patch status changed: %+v", status)
+	if err := setter.SetPatchStatus(
+		status.PendingUpdates,
+		status.PendingSecurityUpdates,
+		status.RebootRequired,
+	); err != nil {
+		return err
+	}
+	*old = &status
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package patchreporter_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/patchreporter"
+)
+
+var _ = gc.Suite(&PatchReporterWorkerSuite{})
+
+type PatchReporterWorkerSuite struct {
+	coretesting.BaseSuite
+}
+
+type patchStatusSetterFunc func(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error
+
+func (f patchStatusSetterFunc) SetPatchStatus(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error {
+	return f(pendingUpdates, pendingSecurityUpdates, rebootRequired)
+}
+
+func (s *PatchReporterWorkerSuite) TestWorker(c *gc.C) {
+	done := make(chan struct{})
+	var setStatus patchStatusSetterFunc = func(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error {
+		close(done)
+		return nil
+	}
+
+	var check patchreporter.CheckPatchStatusFunc = func() (patchreporter.PatchStatus, error) {
+		return patchreporter.PatchStatus{PendingUpdates: 1}, nil
+	}
+
+	w := patchreporter.NewWorker(check, setStatus)
+	defer w.Wait()
+	defer w.Kill()
+
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for patchreporter to report")
+	}
+}
+
+func (s *PatchReporterWorkerSuite) TestPatchStatusChanges(c *gc.C) {
+	var old *patchreporter.PatchStatus
+	var reported []patchreporter.PatchStatus
+	var setStatus patchStatusSetterFunc = func(pendingUpdates, pendingSecurityUpdates int, rebootRequired bool) error {
+		reported = append(reported, patchreporter.PatchStatus{
+			PendingUpdates:         pendingUpdates,
+			PendingSecurityUpdates: pendingSecurityUpdates,
+			RebootRequired:         rebootRequired,
+		})
+		return nil
+	}
+
+	status := patchreporter.PatchStatus{PendingUpdates: 3, PendingSecurityUpdates: 1}
+	var check patchreporter.CheckPatchStatusFunc = func() (patchreporter.PatchStatus, error) {
+		return status, nil
+	}
+
+	err := patchreporter.DoWork(check, setStatus, &old)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reported, gc.HasLen, 1)
+
+	// patchreporter only calls the PatchStatusSetter when it sees a
+	// change in the patch state.
+	err = patchreporter.DoWork(check, setStatus, &old)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reported, gc.HasLen, 1)
+
+	status.RebootRequired = true
+	err = patchreporter.DoWork(check, setStatus, &old)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reported, gc.HasLen, 2)
+
+	c.Assert(reported[0], gc.DeepEquals, patchreporter.PatchStatus{
+		PendingUpdates: 3, PendingSecurityUpdates: 1,
+	})
+	c.Assert(reported[1], gc.DeepEquals, patchreporter.PatchStatus{
+		PendingUpdates: 3, PendingSecurityUpdates: 1, RebootRequired: true,
+	})
+}
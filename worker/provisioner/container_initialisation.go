@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/container/broker"
 	"github.com/juju/juju/container/kvm"
 	"github.com/juju/juju/container/lxd"
+	corecontainer "github.com/juju/juju/core/container"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/network"
@@ -74,6 +75,10 @@ func NewContainerSetup(params ContainerSetupParams) *ContainerSetup {
 
 func (cs *ContainerSetup) initialiseContainers(abort <-chan struct{}) error {
 	cs.logger.Debugf("setup for %s containers", cs.containerType)
+	if hostID := cs.mTag.Id(); !corecontainer.NestingSupported(hostID, cs.containerType) {
+		return errors.NotSupportedf("%s containers on %s container machine %s",
+			cs.containerType, corecontainer.ContainerTypeFromId(hostID), hostID)
+	}
 	managerConfig, err := containerManagerConfig(cs.containerType, cs.provisioner)
 	if err != nil {
 		return errors.Annotate(err, "generating container manager config")
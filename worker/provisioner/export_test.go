@@ -70,7 +70,8 @@ func SetupToStartMachine(p ProvisionerTask, machine apiprovisioner.MachineProvis
 	environs.StartInstanceParams,
 	error,
 ) {
-	return p.(*provisionerTask).setupToStartMachine(machine, version)
+	startInstanceParams, _, err := p.(*provisionerTask).setupToStartMachine(machine, version)
+	return startInstanceParams, err
 }
 
 func MachineSupportsContainers(cfg ContainerManifoldConfig, pr ContainerMachineGetter, mTag names.MachineTag) (ContainerMachine, error) {
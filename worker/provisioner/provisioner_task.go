@@ -20,6 +20,7 @@ import (
 	"github.com/juju/worker/v3/catacomb"
 
 	apiprovisioner "github.com/juju/juju/api/agent/provisioner"
+	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/cloudconfig/instancecfg"
 	"github.com/juju/juju/container"
 	"github.com/juju/juju/controller"
@@ -35,6 +36,7 @@ import (
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/workerpool"
 	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/context"
 	"github.com/juju/juju/environs/imagemetadata"
@@ -912,6 +914,27 @@ func (task *provisionerTask) constructStartInstanceParams(
 		}
 	}
 
+	var nicRequests []network.NICRequest
+	if len(provisioningInfo.NICRequests) > 0 {
+		requester, ok := task.broker.(environs.NICRequester)
+		if !ok {
+			return environs.StartInstanceParams{}, errors.NotSupportedf(
+				"%q network interface request: provider cannot create requested interfaces", provisioningInfo.NICRequests[0].Type)
+		}
+		nicRequests = make([]network.NICRequest, len(provisioningInfo.NICRequests))
+		for i, req := range provisioningInfo.NICRequests {
+			nicRequests[i] = network.NICRequest{
+				Type:    network.NICRequestType(req.Type),
+				Parents: req.Parents,
+				Count:   req.Count,
+			}
+			if !requester.SupportsNICRequest(nicRequests[i]) {
+				return environs.StartInstanceParams{}, errors.NotSupportedf(
+					"%q network interface request: provider cannot create requested interfaces", req.Type)
+			}
+		}
+	}
+
 	startInstanceParams := environs.StartInstanceParams{
 		ControllerUUID:    controllerUUID,
 		Constraints:       provisioningInfo.Constraints,
@@ -926,6 +949,7 @@ func (task *provisionerTask) constructStartInstanceParams(
 		StatusCallback:    machine.SetInstanceStatus,
 		Abort:             task.catacomb.Dying(),
 		CharmLXDProfiles:  provisioningInfo.CharmLXDProfiles,
+		NICRequests:       nicRequests,
 	}
 	if provisioningInfo.RootDisk != nil {
 		startInstanceParams.RootDisk = &storage.VolumeParams{
@@ -1321,16 +1345,28 @@ func (task *provisionerTask) doStartMachine(ctx context.ProviderCallContext, mac
 		return errors.Trace(err)
 	}
 
-	startInstanceParams, err := task.setupToStartMachine(machine, v)
+	startInstanceParams, machineBroker, err := task.setupToStartMachine(machine, v)
 	if err != nil {
 		return errors.Trace(task.setErrorStatus("%v %v", machine, err))
 	}
+	// Availability zone bookkeeping only makes sense for machines
+	// provisioned by the model's own broker; machines placed in another
+	// cloud have their own, disjoint set of zones.
+	usingModelBroker := machineBroker == task.broker
 
 	// Figure out if the zones available to use for a new instance are
 	// restricted based on placement, and if so exclude those machines
 	// from being started in any other zone.
-	if err := task.populateExcludedMachines(ctx, machine.Id(), startInstanceParams); err != nil {
-		return err
+	if usingModelBroker {
+		if err := task.populateExcludedMachines(ctx, machine.Id(), startInstanceParams); err != nil {
+			return err
+		}
+	}
+
+	// Fail fast if an ephemeral/instance-store root disk was requested
+	// but the provider cannot supply one for these constraints.
+	if err := environs.CheckEphemeralRootDisk(machineBroker, ctx, startInstanceParams.Constraints); err != nil {
+		return task.setErrorStatus("cannot start instance for machine %q: %v", machine, err)
 	}
 
 	// TODO ProvisionerParallelization 2017-10-03
@@ -1344,17 +1380,19 @@ func (task *provisionerTask) doStartMachine(ctx context.ProviderCallContext, mac
 	// one of the StartInstance calls returns an error satisfying
 	// Is(err, environs.ErrAvailabilityZoneIndependent)
 	for attemptsLeft := task.retryStartInstanceStrategy.retryCount; attemptsLeft >= 0; {
-		if startInstanceParams.AvailabilityZone, err = task.machineAvailabilityZoneDistribution(
-			machine.Id(), distributionGroupMachineIds, startInstanceParams.Constraints,
-		); err != nil {
-			return task.setErrorStatus("cannot start instance for machine %q: %v", machine, err)
+		if usingModelBroker {
+			if startInstanceParams.AvailabilityZone, err = task.machineAvailabilityZoneDistribution(
+				machine.Id(), distributionGroupMachineIds, startInstanceParams.Constraints,
+			); err != nil {
+				return task.setErrorStatus("cannot start instance for machine %q: %v", machine, err)
+			}
 		}
 		if startInstanceParams.AvailabilityZone != "" {
 			task.logger.Infof("trying machine %s StartInstance in availability zone %s",
 				machine, startInstanceParams.AvailabilityZone)
 		}
 
-		attemptResult, err := task.broker.StartInstance(ctx, startInstanceParams)
+		attemptResult, err := machineBroker.StartInstance(ctx, startInstanceParams)
 		if err == nil {
 			result = attemptResult
 			break
@@ -1449,7 +1487,7 @@ func (task *provisionerTask) doStartMachine(ctx context.ProviderCallContext, mac
 		if err2 := task.setErrorStatus("cannot register instance for machine %v: %v", machine, err); err2 != nil {
 			task.logger.Errorf("%v", errors.Annotate(err2, "setting machine status"))
 		}
-		if err2 := task.broker.StopInstances(ctx, instanceID); err2 != nil {
+		if err2 := machineBroker.StopInstances(ctx, instanceID); err2 != nil {
 			task.logger.Errorf("%v", errors.Annotate(err2, "after failing to set instance info"))
 		}
 		return errors.Annotate(err, "setting instance info")
@@ -1475,16 +1513,22 @@ func (task *provisionerTask) doStartMachine(ctx context.ProviderCallContext, mac
 // and StartInstanceParams to be used by startMachine.
 func (task *provisionerTask) setupToStartMachine(machine apiprovisioner.MachineProvisioner, version *version.Number) (
 	environs.StartInstanceParams,
+	environs.InstanceBroker,
 	error,
 ) {
 	pInfo, err := machine.ProvisioningInfo()
 	if err != nil {
-		return environs.StartInstanceParams{}, errors.Annotatef(err, "fetching provisioning info for machine %q", machine)
+		return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "fetching provisioning info for machine %q", machine)
+	}
+
+	machineBroker, err := task.brokerForMachine(pInfo)
+	if err != nil {
+		return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "getting broker for machine %q", machine)
 	}
 
 	instanceCfg, err := task.constructInstanceConfig(machine, task.auth, pInfo)
 	if err != nil {
-		return environs.StartInstanceParams{}, errors.Annotatef(err, "creating instance config for machine %q", machine)
+		return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "creating instance config for machine %q", machine)
 	}
 
 	// We default to amd64 unless otherwise specified.
@@ -1497,12 +1541,12 @@ func (task *provisionerTask) setupToStartMachine(machine apiprovisioner.MachineP
 	if mSeries == "" {
 		mSeries, err = series.GetSeriesFromChannel(pInfo.Base.Name, pInfo.Base.Channel)
 		if err != nil {
-			return environs.StartInstanceParams{}, errors.Annotatef(err, "converting machine base %q to series", pInfo.Base)
+			return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "converting machine base %q to series", pInfo.Base)
 		}
 	}
 	possibleTools, err := task.toolsFinder.FindTools(*version, mSeries, agentArch)
 	if err != nil {
-		return environs.StartInstanceParams{}, errors.Annotatef(err, "finding agent binaries for machine %q", machine)
+		return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "finding agent binaries for machine %q", machine)
 	}
 
 	startInstanceParams, err := task.constructStartInstanceParams(
@@ -1513,10 +1557,52 @@ func (task *provisionerTask) setupToStartMachine(machine apiprovisioner.MachineP
 		possibleTools,
 	)
 	if err != nil {
-		return environs.StartInstanceParams{}, errors.Annotatef(err, "constructing params for machine %q", machine)
+		return environs.StartInstanceParams{}, nil, errors.Annotatef(err, "constructing params for machine %q", machine)
 	}
 
-	return startInstanceParams, nil
+	return startInstanceParams, machineBroker, nil
+}
+
+// brokerForMachine returns the broker to start the machine's instance
+// with. A machine placed in a registered cloud other than the model's
+// own is started through a broker for that cloud, which the model's
+// broker must supply by implementing environs.MultiCloudBroker.
+func (task *provisionerTask) brokerForMachine(pInfo *params.ProvisioningInfoV10) (environs.InstanceBroker, error) {
+	if pInfo.CloudSpec == nil {
+		return task.broker, nil
+	}
+	multi, ok := task.broker.(environs.MultiCloudBroker)
+	if !ok {
+		return nil, errors.NotSupportedf(
+			"placement in cloud %q: provider cannot provision across clouds", pInfo.CloudSpec.Name)
+	}
+	var credential *jujucloud.Credential
+	if pInfo.CloudSpec.Credential != nil {
+		credentialValue := jujucloud.NewCredential(
+			jujucloud.AuthType(pInfo.CloudSpec.Credential.AuthType),
+			pInfo.CloudSpec.Credential.Attributes,
+		)
+		credential = &credentialValue
+	}
+	spec := environscloudspec.CloudSpec{
+		Type:             pInfo.CloudSpec.Type,
+		Name:             pInfo.CloudSpec.Name,
+		Region:           pInfo.CloudSpec.Region,
+		Endpoint:         pInfo.CloudSpec.Endpoint,
+		IdentityEndpoint: pInfo.CloudSpec.IdentityEndpoint,
+		StorageEndpoint:  pInfo.CloudSpec.StorageEndpoint,
+		CACertificates:   pInfo.CloudSpec.CACertificates,
+		SkipTLSVerify:    pInfo.CloudSpec.SkipTLSVerify,
+		Credential:       credential,
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, errors.Annotatef(err, "validating cloud spec for cloud %q", spec.Name)
+	}
+	broker, err := multi.BrokerForCloudSpec(spec)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting broker for cloud %q", spec.Name)
+	}
+	return broker, nil
 }
 
 // populateExcludedMachines, translates the results of DeriveAvailabilityZones
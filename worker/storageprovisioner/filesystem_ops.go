@@ -70,13 +70,27 @@ func createFilesystems(ctx *context, ops map[names.FilesystemTag]*createFilesyst
 			})
 			entityStatus := &statuses[len(statuses)-1]
 			if result.Error != nil {
-				// Reschedule the filesystem creation.
-				reschedule = append(reschedule, ops[filesystemParams[i].Tag])
+				if errors.Is(result.Error, storage.ErrInvalidParameters) {
+					// The filesystem cannot be provisioned with the
+					// parameters as they are, so retrying cannot
+					// help; surface the error and do not reschedule.
+					entityStatus.Status = status.Error.String()
+					entityStatus.Info = result.Error.Error()
+					ctx.config.Logger.Errorf(
+						"failed to create %s: %v",
+						names.ReadableString(filesystemParams[i].Tag),
+						result.Error,
+					)
+					continue
+				}
+				// Reschedule the filesystem creation, backing off
+				// according to the classification of the error.
+				op := ops[filesystemParams[i].Tag]
+				op.classifyError(result.Error)
+				reschedule = append(reschedule, op)
 
-				// Note: we keep the status as "pending" to indicate
-				// that we will retry. When we distinguish between
-				// transient and permanent errors, we will set the
-				// status to "error" for permanent errors.
+				// We keep the status as "pending" to
+				// indicate that we will retry.
 				entityStatus.Status = status.Pending.String()
 				entityStatus.Info = result.Error.Error()
 				ctx.config.Logger.Debugf(
@@ -155,17 +169,32 @@ func attachFilesystems(ctx *context, ops map[params.MachineStorageId]*attachFile
 			})
 			entityStatus := &statuses[len(statuses)-1]
 			if result.Error != nil {
-				// Reschedule the filesystem attachment.
+				if errors.Is(result.Error, storage.ErrInvalidParameters) {
+					// The attachment cannot be made with the
+					// parameters as they are, so retrying cannot
+					// help; surface the error and do not reschedule.
+					entityStatus.Status = status.Error.String()
+					entityStatus.Info = result.Error.Error()
+					ctx.config.Logger.Errorf(
+						"failed to attach %s to %s: %v",
+						names.ReadableString(p.Filesystem),
+						names.ReadableString(p.Machine),
+						result.Error,
+					)
+					continue
+				}
+				// Reschedule the filesystem attachment, backing off
+				// according to the classification of the error.
 				id := params.MachineStorageId{
 					MachineTag:    p.Machine.String(),
 					AttachmentTag: p.Filesystem.String(),
 				}
-				reschedule = append(reschedule, ops[id])
+				op := ops[id]
+				op.classifyError(result.Error)
+				reschedule = append(reschedule, op)
 
-				// Note: we keep the status as "attaching" to
-				// indicate that we will retry. When we distinguish
-				// between transient and permanent errors, we will
-				// set the status to "error" for permanent errors.
+				// We keep the status as "attaching" to
+				// indicate that we will retry.
 				entityStatus.Status = status.Attaching.String()
 				entityStatus.Info = result.Error.Error()
 				ctx.config.Logger.Debugf(
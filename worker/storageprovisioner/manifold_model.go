@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/api/agent/storageprovisioner"
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
 	"github.com/juju/juju/worker/common"
 )
 
@@ -43,6 +44,10 @@ func ModelManifold(config ModelManifoldConfig) dependency.Manifold {
 			if err := context.Get(config.StorageRegistryName, &registry); err != nil {
 				return nil, errors.Trace(err)
 			}
+			// Chain in the common storage providers so that
+			// environ-scoped ones that are not cloud specific,
+			// such as the SAN provider, can be provisioned here.
+			registry = storage.ChainedProviderRegistry{registry, provider.CommonStorageProviders()}
 
 			api, err := storageprovisioner.NewState(apiCaller)
 			if err != nil {
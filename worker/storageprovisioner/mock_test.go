@@ -144,6 +144,7 @@ type mockVolumeAccessor struct {
 	provisionedVolumes     map[string]params.Volume
 	provisionedAttachments map[params.MachineStorageId]params.VolumeAttachment
 	blockDevices           map[params.MachineStorageId]storage.BlockDevice
+	requireEncrypted       bool
 
 	setVolumeInfo               func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo     func([]params.VolumeAttachment) ([]params.ErrorResult, error)
@@ -232,6 +233,7 @@ func (v *mockVolumeAccessor) VolumeParams(volumes []names.VolumeTag) ([]params.V
 			Tags: map[string]string{
 				"very": "fancy",
 			},
+			RequireEncrypted: v.requireEncrypted,
 		}
 		if tag.Id() != noAttachmentVolumeId {
 			volumeParams.Attachment = &params.VolumeAttachmentParams{
@@ -3,7 +3,13 @@
 
 package storageprovisioner
 
-import "time"
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/storage"
+)
 
 // minRetryDelay is the minimum delay to apply
 // to operation retries; this does not apply to
@@ -15,6 +21,12 @@ const minRetryDelay = 30 * time.Second
 // up to this ceiling.
 const maxRetryDelay = 30 * time.Minute
 
+// minThrottledRetryDelay is the minimum delay to apply
+// to retries of operations that failed because the
+// provider throttled our requests; throttling is
+// expected to clear much sooner than other failures.
+const minThrottledRetryDelay = 5 * time.Second
+
 // scheduleOperations schedules the given operations
 // by calculating the current time once, and then
 // adding each operation's delay to that time. By
@@ -50,17 +62,45 @@ type scheduleOp interface {
 // backoff for operations that may be rescheduled.
 type exponentialBackoff struct {
 	d time.Duration
+
+	// min and max bound the retry delay; they are set according
+	// to the classification of the last error, and default to
+	// minRetryDelay and maxRetryDelay when zero.
+	min, max time.Duration
 }
 
 func (s *exponentialBackoff) delay() time.Duration {
+	min, max := s.min, s.max
+	if min == 0 {
+		min = minRetryDelay
+	}
+	if max == 0 {
+		max = maxRetryDelay
+	}
 	current := s.d
-	if s.d < minRetryDelay {
-		s.d = minRetryDelay
+	if s.d < min {
+		s.d = min
 	} else {
 		s.d *= 2
-		if s.d > maxRetryDelay {
-			s.d = maxRetryDelay
+		if s.d > max {
+			s.d = max
 		}
 	}
 	return current
 }
+
+// classifyError adjusts the backoff bounds according to the
+// classification of the given provisioning error: throttling
+// errors are retried promptly since they are expected to clear
+// quickly, while quota errors are retried at the ceiling since
+// retrying sooner cannot help.
+func (s *exponentialBackoff) classifyError(err error) {
+	switch {
+	case errors.Is(err, storage.ErrThrottled):
+		s.min, s.max = minThrottledRetryDelay, 0
+	case errors.Is(err, storage.ErrQuotaLimited):
+		s.min, s.max = maxRetryDelay, maxRetryDelay
+	default:
+		s.min, s.max = 0, 0
+	}
+}
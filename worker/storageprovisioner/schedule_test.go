@@ -0,0 +1,59 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/storage"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type scheduleSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&scheduleSuite{})
+
+func (s *scheduleSuite) TestExponentialBackoff(c *gc.C) {
+	var b exponentialBackoff
+	var delays []time.Duration
+	for i := 0; i < 4; i++ {
+		delays = append(delays, b.delay())
+	}
+	c.Assert(delays, jc.DeepEquals, []time.Duration{
+		0, minRetryDelay, 2 * minRetryDelay, 4 * minRetryDelay,
+	})
+	for b.delay() < maxRetryDelay {
+	}
+	c.Assert(b.delay(), gc.Equals, maxRetryDelay)
+}
+
+func (s *scheduleSuite) TestThrottledBackoff(c *gc.C) {
+	var b exponentialBackoff
+	b.classifyError(storage.ThrottledError(errors.New("slow down")))
+	c.Assert(b.delay(), gc.Equals, time.Duration(0))
+	c.Assert(b.delay(), gc.Equals, minThrottledRetryDelay)
+	c.Assert(b.delay(), gc.Equals, 2*minThrottledRetryDelay)
+}
+
+func (s *scheduleSuite) TestQuotaLimitedBackoff(c *gc.C) {
+	var b exponentialBackoff
+	b.classifyError(storage.QuotaLimitedError(errors.New("quota exceeded")))
+	c.Assert(b.delay(), gc.Equals, time.Duration(0))
+	c.Assert(b.delay(), gc.Equals, maxRetryDelay)
+	c.Assert(b.delay(), gc.Equals, maxRetryDelay)
+}
+
+func (s *scheduleSuite) TestUnclassifiedErrorResetsBounds(c *gc.C) {
+	var b exponentialBackoff
+	b.classifyError(storage.ThrottledError(errors.New("slow down")))
+	b.classifyError(errors.New("boom"))
+	c.Assert(b.delay(), gc.Equals, time.Duration(0))
+	c.Assert(b.delay(), gc.Equals, minRetryDelay)
+}
@@ -216,6 +216,52 @@ func (s *storageProvisionerSuite) TestCreateVolumeCreatesAttachment(c *gc.C) {
 	assertNoEvent(c, attachVolumesCalled, "AttachVolumes called")
 }
 
+func (s *storageProvisionerSuite) TestCreateVolumeRequireEncrypted(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.requireEncrypted = true
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		c.Errorf("unexpected call to SetVolumeInfo")
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	s.provider.createVolumesFunc = func(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+		c.Check(args[0].RequireEncrypted, jc.IsTrue)
+		// The provider does not report the volume as encrypted.
+		return []storage.CreateVolumesResult{{
+			Volume: &storage.Volume{
+				Tag:        args[0].Tag,
+				VolumeInfo: storage.VolumeInfo{VolumeId: "vol-ume"},
+			},
+		}}, nil
+	}
+	volumesDestroyed := make(chan interface{}, 1)
+	s.provider.destroyVolumesFunc = func(volumeIds []string) ([]error, error) {
+		volumesDestroyed <- volumeIds
+		return make([]error, len(volumeIds)), nil
+	}
+	statusSet := make(chan interface{}, 1)
+	statusSetter := &mockStatusSetter{setStatus: func(args []params.EntityStatusArgs) error {
+		statusSet <- args
+		return nil
+	}}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry, statusSetter: statusSetter}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	destroyed := waitChannel(c, volumesDestroyed, "waiting for volume to be destroyed")
+	c.Assert(destroyed, jc.DeepEquals, []string{"vol-ume"})
+	statusArgs := waitChannel(c, statusSet, "waiting for status to be set")
+	c.Assert(statusArgs, jc.DeepEquals, []params.EntityStatusArgs{{
+		Tag:    "volume-1",
+		Status: "error",
+		Info:   "volume is not encrypted at rest, but the model requires encrypted volumes",
+	}})
+}
+
 func (s *storageProvisionerSuite) TestCreateVolumeRetry(c *gc.C) {
 	volumeInfoSet := make(chan interface{})
 	volumeAccessor := newMockVolumeAccessor()
@@ -436,6 +482,50 @@ func (s *storageProvisionerSuite) TestAttachVolumeRetry(c *gc.C) {
 	})
 }
 
+func (s *storageProvisionerSuite) TestAttachVolumesDeterministicOrder(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	for _, id := range []string{"1", "2", "10"} {
+		volumeAccessor.provisionVolume(names.NewVolumeTag(id))
+	}
+
+	attachedOrder := make(chan interface{}, 1)
+	s.provider.attachVolumesFunc = func(args []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+		order := make([]string, len(args))
+		results := make([]storage.AttachVolumesResult, len(args))
+		for i, arg := range args {
+			order[i] = arg.Volume.String()
+			results[i] = storage.AttachVolumesResult{
+				VolumeAttachment: &storage.VolumeAttachment{
+					Volume:  arg.Volume,
+					Machine: arg.Machine,
+				},
+			}
+		}
+		attachedOrder <- order
+		return results, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag: "machine-1", AttachmentTag: "volume-10",
+	}, {
+		MachineTag: "machine-1", AttachmentTag: "volume-1",
+	}, {
+		MachineTag: "machine-1", AttachmentTag: "volume-2",
+	}}
+	volumeAccessor.volumesWatcher.changes <- []string{"10", "1", "2"}
+
+	// The attachments must be made in natural order of volume,
+	// regardless of the order in which they were notified.
+	order := waitChannel(c, attachedOrder, "waiting for volumes to be attached").([]string)
+	c.Assert(order, jc.DeepEquals, []string{"volume-1", "volume-2", "volume-10"})
+}
+
 func (s *storageProvisionerSuite) TestAttachFilesystemRetry(c *gc.C) {
 	filesystemInfoSet := make(chan interface{})
 	filesystemAccessor := newMockFilesystemAccessor()
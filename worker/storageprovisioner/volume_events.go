@@ -563,6 +563,7 @@ func volumesFromStorage(in []storage.Volume) []params.Volume {
 				"", // pool
 				v.Size,
 				v.Persistent,
+				v.Encrypted,
 			},
 		}
 	}
@@ -607,6 +608,7 @@ func volumeFromParams(in params.Volume) (storage.Volume, error) {
 			in.Info.WWN,
 			in.Info.Size,
 			in.Info.Persistent,
+			in.Info.Encrypted,
 		},
 	}, nil
 }
@@ -655,6 +657,7 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		in.Attributes,
 		in.Tags,
 		attachment,
+		in.RequireEncrypted,
 	}, nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v4"
+	"github.com/juju/naturalsort"
 
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/status"
@@ -66,13 +67,27 @@ func createVolumes(ctx *context, ops map[names.VolumeTag]*createVolumeOp) error
 			})
 			entityStatus := &statuses[len(statuses)-1]
 			if result.Error != nil {
-				// Reschedule the volume creation.
-				reschedule = append(reschedule, ops[volumeParams[i].Tag])
+				if errors.Is(result.Error, storage.ErrInvalidParameters) {
+					// The volume cannot be provisioned with the
+					// parameters as they are, so retrying cannot
+					// help; surface the error and do not reschedule.
+					entityStatus.Status = status.Error.String()
+					entityStatus.Info = result.Error.Error()
+					ctx.config.Logger.Errorf(
+						"failed to create %s: %v",
+						names.ReadableString(volumeParams[i].Tag),
+						result.Error,
+					)
+					continue
+				}
+				// Reschedule the volume creation, backing off
+				// according to the classification of the error.
+				op := ops[volumeParams[i].Tag]
+				op.classifyError(result.Error)
+				reschedule = append(reschedule, op)
 
-				// Note: we keep the status as "pending" to indicate
-				// that we will retry. When we distinguish between
-				// transient and permanent errors, we will set the
-				// status to "error" for permanent errors.
+				// We keep the status as "pending" to
+				// indicate that we will retry.
 				entityStatus.Status = status.Pending.String()
 				entityStatus.Info = result.Error.Error()
 				ctx.config.Logger.Debugf(
@@ -82,6 +97,33 @@ func createVolumes(ctx *context, ops map[names.VolumeTag]*createVolumeOp) error
 				)
 				continue
 			}
+			if volumeParams[i].RequireEncrypted && !result.Volume.Encrypted {
+				// The model requires volumes to be encrypted at
+				// rest, and this one is not. Destroy it rather
+				// than record it; retrying cannot help, so
+				// surface the error and do not reschedule.
+				entityStatus.Status = status.Error.String()
+				entityStatus.Info = "volume is not encrypted at rest, but the model requires encrypted volumes"
+				ctx.config.Logger.Errorf(
+					"not recording %s: %s",
+					names.ReadableString(volumeParams[i].Tag),
+					entityStatus.Info,
+				)
+				destroyErrs, err := volumeSource.DestroyVolumes(
+					ctx.config.CloudCallContextFunc(stdcontext.Background()),
+					[]string{result.Volume.VolumeId},
+				)
+				if err == nil && len(destroyErrs) == 1 {
+					err = destroyErrs[0]
+				}
+				if err != nil {
+					ctx.config.Logger.Errorf(
+						"destroying unencrypted volume %q: %v",
+						result.Volume.VolumeId, err,
+					)
+				}
+				continue
+			}
 			volumes = append(volumes, *result.Volume)
 			if result.VolumeAttachment != nil {
 				entityStatus.Status = status.Attached.String()
@@ -128,19 +170,35 @@ func createVolumes(ctx *context, ops map[names.VolumeTag]*createVolumeOp) error
 
 // attachVolumes creates volume attachments with the specified parameters.
 func attachVolumes(ctx *context, ops map[params.MachineStorageId]*attachVolumeOp) error {
+	var reschedule []scheduleOp
+	var statuses []params.EntityStatusArgs
 	volumeAttachmentParams := make([]storage.VolumeAttachmentParams, 0, len(ops))
 	for _, op := range ops {
+		if op.args.InstanceId == "" {
+			// The machine's cloud instance is not ready yet; hold the
+			// attachment back rather than racing the machine provisioner,
+			// and try again once the machine has come up.
+			if machineTag, ok := op.args.Machine.(names.MachineTag); ok {
+				watchMachine(ctx, machineTag)
+			}
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    op.args.Volume.String(),
+				Status: status.Attaching.String(),
+				Info:   "waiting for machine to become ready",
+			})
+			reschedule = append(reschedule, op)
+			continue
+		}
 		volumeAttachmentParams = append(volumeAttachmentParams, op.args)
 	}
+	sortVolumeAttachmentParams(volumeAttachmentParams)
 	paramsBySource, volumeSources, err := volumeAttachmentParamsBySource(
 		ctx.config.StorageDir, volumeAttachmentParams, ctx.config.Registry,
 	)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	var reschedule []scheduleOp
 	var volumeAttachments []storage.VolumeAttachment
-	var statuses []params.EntityStatusArgs
 	for sourceName, volumeAttachmentParams := range paramsBySource {
 		ctx.config.Logger.Debugf("attaching volumes: %+v", volumeAttachmentParams)
 		volumeSource := volumeSources[sourceName]
@@ -163,17 +221,32 @@ func attachVolumes(ctx *context, ops map[params.MachineStorageId]*attachVolumeOp
 			})
 			entityStatus := &statuses[len(statuses)-1]
 			if result.Error != nil {
-				// Reschedule the volume attachment.
+				if errors.Is(result.Error, storage.ErrInvalidParameters) {
+					// The attachment cannot be made with the
+					// parameters as they are, so retrying cannot
+					// help; surface the error and do not reschedule.
+					entityStatus.Status = status.Error.String()
+					entityStatus.Info = result.Error.Error()
+					ctx.config.Logger.Errorf(
+						"failed to attach %s to %s: %v",
+						names.ReadableString(p.Volume),
+						names.ReadableString(p.Machine),
+						result.Error,
+					)
+					continue
+				}
+				// Reschedule the volume attachment, backing off
+				// according to the classification of the error.
 				id := params.MachineStorageId{
 					MachineTag:    p.Machine.String(),
 					AttachmentTag: p.Volume.String(),
 				}
-				reschedule = append(reschedule, ops[id])
+				op := ops[id]
+				op.classifyError(result.Error)
+				reschedule = append(reschedule, op)
 
-				// Note: we keep the status as "attaching" to
-				// indicate that we will retry. When we distinguish
-				// between transient and permanent errors, we will
-				// set the status to "error" for permanent errors.
+				// We keep the status as "attaching" to
+				// indicate that we will retry.
 				entityStatus.Status = status.Attaching.String()
 				entityStatus.Info = result.Error.Error()
 				ctx.config.Logger.Debugf(
@@ -199,6 +272,26 @@ func attachVolumes(ctx *context, ops map[params.MachineStorageId]*attachVolumeOp
 	return nil
 }
 
+// sortVolumeAttachmentParams sorts the given attachment parameters into a
+// stable order: by machine, and then by volume in natural order. Some
+// providers assign device addresses from the order in which attachments are
+// made - most notably Azure, where out-of-order LUN selection can hide disks
+// from the machine - so the order must be deterministic across restarts and
+// retries.
+func sortVolumeAttachmentParams(attachmentParams []storage.VolumeAttachmentParams) {
+	keys := make([]string, len(attachmentParams))
+	byKey := make(map[string]storage.VolumeAttachmentParams, len(attachmentParams))
+	for i, p := range attachmentParams {
+		key := p.Machine.String() + " " + p.Volume.String()
+		keys[i] = key
+		byKey[key] = p
+	}
+	naturalsort.Sort(keys)
+	for i, key := range keys {
+		attachmentParams[i] = byKey[key]
+	}
+}
+
 // createVolumeAttachmentPlans creates a volume info plan in state, which notifies the machine
 // agent of the target instance that something has been attached to it.
 func createVolumeAttachmentPlans(ctx *context, volumeAttachments []storage.VolumeAttachment) error {
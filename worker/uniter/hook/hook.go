@@ -9,6 +9,12 @@ import (
 	"github.com/juju/names/v4"
 )
 
+// PreRemove is dispatched when a unit has been slated for destruction,
+// before its relations are broken, giving the charm a bounded window in
+// which to hand off data. It is defined here until the kind lands
+// upstream in the charm package.
+const PreRemove = hooks.Kind("pre-remove")
+
 // Info holds details required to execute a hook. Not all fields are
 // relevant to all Kind values.
 type Info struct {
@@ -90,6 +96,8 @@ func (hi Info) Validate() error {
 		return nil
 	case hooks.LeaderElected, hooks.LeaderDeposed, hooks.LeaderSettingsChanged:
 		return nil
+	case PreRemove:
+		return nil
 	}
 	return errors.Errorf("unknown hook kind %q", hi.Kind)
 }
@@ -69,6 +69,7 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.PebbleReady, WorkloadName: "gitlab"}, ""},
 	{hook.Info{Kind: hooks.PreSeriesUpgrade, SeriesUpgradeTarget: "focal"}, ""},
+	{hook.Info{Kind: hook.PreRemove}, ""},
 }
 
 func (s *InfoSuite) TestValidate(c *gc.C) {
@@ -174,6 +174,11 @@ func (rh *runHook) beforeHook(state State) error {
 				Info:   status.MessageInstallingCharm,
 			})
 		}
+	case hook.PreRemove:
+		err = rh.runner.Context().SetUnitStatus(jujuc.StatusInfo{
+			Status: string(status.Maintenance),
+			Info:   "handing off data prior to removal",
+		})
 	case hooks.Stop:
 		err = rh.runner.Context().SetUnitStatus(jujuc.StatusInfo{
 			Status: string(status.Maintenance),
@@ -301,6 +306,8 @@ func (rh *runHook) Commit(state State) (*State, error) {
 	case hooks.Start:
 		newState.Started = true
 		newState.Stopped = false
+	case hook.PreRemove:
+		newState.PreRemoved = true
 	case hooks.Stop:
 		newState.Stopped = true
 	case hooks.Remove:
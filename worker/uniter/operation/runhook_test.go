@@ -714,10 +714,11 @@ func (s *RunHookSuite) testQueueNothing_BlankSlate(c *gc.C, hookInfo hook.Info)
 			hookInfo,
 			operation.State{},
 			operation.State{
-				Installed: hookInfo.Kind == hooks.Install,
-				Kind:      operation.Continue,
-				Step:      operation.Pending,
-				Stopped:   hookInfo.Kind == hooks.Stop,
+				Installed:  hookInfo.Kind == hooks.Install,
+				Kind:       operation.Continue,
+				Step:       operation.Pending,
+				Stopped:    hookInfo.Kind == hooks.Stop,
+				PreRemoved: hookInfo.Kind == hook.PreRemove,
 			},
 		)
 	}
@@ -734,11 +735,12 @@ func (s *RunHookSuite) testQueueNothing_Preserve(c *gc.C, hookInfo hook.Info) {
 			hookInfo,
 			overwriteState,
 			operation.State{
-				Kind:      operation.Continue,
-				Step:      operation.Pending,
-				Installed: hookInfo.Kind == hooks.Install,
-				Started:   true,
-				Stopped:   hookInfo.Kind == hooks.Stop,
+				Kind:       operation.Continue,
+				Step:       operation.Pending,
+				Installed:  hookInfo.Kind == hooks.Install,
+				Started:    true,
+				Stopped:    hookInfo.Kind == hooks.Stop,
+				PreRemoved: hookInfo.Kind == hook.PreRemove,
 			},
 		)
 	}
@@ -768,6 +770,18 @@ func (s *RunHookSuite) TestQueueNothing_Stop_Preserve(c *gc.C) {
 	})
 }
 
+func (s *RunHookSuite) TestQueueNothing_PreRemove_BlankSlate(c *gc.C) {
+	s.testQueueNothing_BlankSlate(c, hook.Info{
+		Kind: hook.PreRemove,
+	})
+}
+
+func (s *RunHookSuite) TestQueueNothing_PreRemove_Preserve(c *gc.C) {
+	s.testQueueNothing_Preserve(c, hook.Info{
+		Kind: hook.PreRemove,
+	})
+}
+
 func (s *RunHookSuite) TestQueueNothing_RelationJoined_BlankSlate(c *gc.C) {
 	s.testQueueNothing_BlankSlate(c, hook.Info{
 		Kind:              hooks.RelationJoined,
@@ -73,6 +73,10 @@ type State struct {
 	// Removed indicates whether the remove hook has run.
 	Removed bool `yaml:"removed"`
 
+	// PreRemoved indicates whether the pre-remove hook has run, giving
+	// the charm its data handoff window before relations are broken.
+	PreRemoved bool `yaml:"pre-removed,omitempty"`
+
 	// StatusSet indicates whether the charm being deployed has ever invoked
 	// the status-set hook tool.
 	StatusSet bool `yaml:"status-set"`
@@ -181,6 +185,7 @@ func (st State) Report() map[string]interface{} {
 	result["stopped"] = st.Stopped
 	result["installed"] = st.Installed
 	result["removed"] = st.Removed
+	result["pre-removed"] = st.PreRemoved
 	result["hook-kind"] = st.Kind
 	result["hook-step"] = st.Step
 	result["leader"] = st.Leader
@@ -110,6 +110,7 @@ type mockState struct {
 	storageAttachmentWatchers   map[names.StorageTag]*mockNotifyWatcher
 	updateStatusInterval        time.Duration
 	updateStatusIntervalWatcher *mockNotifyWatcher
+	hookDebounceWindow          time.Duration
 	charm                       *mockCharm
 }
 
@@ -213,6 +214,10 @@ func (st *mockState) WatchUpdateStatusHookInterval() (watcher.NotifyWatcher, err
 	return st.updateStatusIntervalWatcher, nil
 }
 
+func (st *mockState) HookDebounceWindow() (time.Duration, error) {
+	return st.hookDebounceWindow, nil
+}
+
 type mockUnit struct {
 	tag                              names.UnitTag
 	life                             life.Value
@@ -32,6 +32,7 @@ type State interface {
 	WatchStorageAttachment(names.StorageTag, names.UnitTag) (watcher.NotifyWatcher, error)
 	WatchUpdateStatusHookInterval() (watcher.NotifyWatcher, error)
 	UpdateStatusHookInterval() (time.Duration, error)
+	HookDebounceWindow() (time.Duration, error)
 }
 
 type Unit interface {
@@ -52,6 +52,7 @@ type RemoteStateWatcher struct {
 	storageAttachmentChanges      chan storageAttachmentChange
 	leadershipTracker             leadership.Tracker
 	updateStatusChannel           UpdateStatusTimerFunc
+	hookDebounceChannel           UpdateStatusTimerFunc
 	commandChannel                <-chan string
 	retryHookChannel              watcher.NotifyChannel
 	containerRunningStatusChannel watcher.NotifyChannel
@@ -65,6 +66,11 @@ type RemoteStateWatcher struct {
 	out     chan struct{}
 	mu      sync.Mutex
 	current Snapshot
+
+	// coalescedEvents counts the remote state changes that were folded
+	// into a pending debounced notification rather than waking the
+	// observer themselves.
+	coalescedEvents uint64
 }
 
 // ContainerRunningStatus is used on CAAS models to upgrade charms/block actions.
@@ -79,12 +85,22 @@ type ContainerRunningStatus struct {
 // model to determine if the unit is running and ready to execute actions.
 type ContainerRunningStatusFunc func(providerID string) (*ContainerRunningStatus, error)
 
+// debounceWaiter is the default Waiter used for the hook dispatch
+// debounce window when no HookDebounceChannel is supplied.
+type debounceWaiter time.Duration
+
+// After is part of the Waiter interface.
+func (w debounceWaiter) After() <-chan time.Time {
+	return time.After(time.Duration(w))
+}
+
 // WatcherConfig holds configuration parameters for the
 // remote state watcher.
 type WatcherConfig struct {
 	State                         State
 	LeadershipTracker             leadership.Tracker
 	UpdateStatusChannel           UpdateStatusTimerFunc
+	HookDebounceChannel           UpdateStatusTimerFunc
 	CommandChannel                <-chan string
 	RetryHookChannel              watcher.NotifyChannel
 	ContainerRunningStatusChannel watcher.NotifyChannel
@@ -123,6 +139,12 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 	if err := config.validate(); err != nil {
 		return nil, errors.Trace(err)
 	}
+	hookDebounceChannel := config.HookDebounceChannel
+	if hookDebounceChannel == nil {
+		hookDebounceChannel = func(wait time.Duration) Waiter {
+			return debounceWaiter(wait)
+		}
+	}
 	w := &RemoteStateWatcher{
 		st:                            config.State,
 		relations:                     make(map[names.RelationTag]*wrappedRelationUnitsWatcher),
@@ -131,6 +153,7 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 		storageAttachmentChanges:      make(chan storageAttachmentChange),
 		leadershipTracker:             config.LeadershipTracker,
 		updateStatusChannel:           config.UpdateStatusChannel,
+		hookDebounceChannel:           hookDebounceChannel,
 		commandChannel:                config.CommandChannel,
 		retryHookChannel:              config.RetryHookChannel,
 		containerRunningStatusChannel: config.ContainerRunningStatusChannel,
@@ -182,6 +205,20 @@ func (w *RemoteStateWatcher) RemoteStateChanged() <-chan struct{} {
 	return w.out
 }
 
+// CoalescedEvents returns the number of remote state changes that have
+// been coalesced into debounced notifications since the watcher started.
+func (w *RemoteStateWatcher) CoalescedEvents() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.coalescedEvents
+}
+
+func (w *RemoteStateWatcher) eventCoalesced() {
+	w.mu.Lock()
+	w.coalescedEvents++
+	w.mu.Unlock()
+}
+
 func (w *RemoteStateWatcher) Snapshot() Snapshot {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -444,16 +481,29 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 		}
 	}
 
+	var hookDebounceWindow time.Duration
+	var debounceTimer <-chan time.Time
+
 	// fire will, once the first event for each watcher has
-	// been observed, send a signal on the out channel.
+	// been observed, send a signal on the out channel. If a hook
+	// debounce window is configured, the signal is deferred until
+	// the window elapses without further changes, so that a storm
+	// of changes results in a single hook dispatch.
 	fire := func() {
 		if eventsObserved != requiredEvents {
 			return
 		}
-		select {
-		case w.out <- struct{}{}:
-		default:
+		if hookDebounceWindow <= 0 {
+			select {
+			case w.out <- struct{}{}:
+			default:
+			}
+			return
+		}
+		if debounceTimer != nil {
+			w.eventCoalesced()
 		}
+		debounceTimer = w.hookDebounceChannel(hookDebounceWindow).After()
 	}
 
 	// Check the initial leadership status, and then we can flip-flop
@@ -631,6 +681,10 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			if err != nil {
 				return errors.Trace(err)
 			}
+			hookDebounceWindow, err = w.st.HookDebounceWindow()
+			if err != nil {
+				return errors.Trace(err)
+			}
 			wasActive := updateStatusTimer != nil
 			resetUpdateStatusTimer()
 			if wasActive {
@@ -662,6 +716,15 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 				return errors.Trace(err)
 			}
 
+		case <-debounceTimer:
+			w.logger.Debugf("hook debounce window expired for %s; dispatching coalesced changes", w.unit.Tag().Id())
+			debounceTimer = nil
+			select {
+			case w.out <- struct{}{}:
+			default:
+			}
+			continue
+
 		case <-updateStatusTimer:
 			w.logger.Debugf("update status timer triggered for %s", w.unit.Tag().Id())
 			w.updateStatusChanged()
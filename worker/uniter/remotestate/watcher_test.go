@@ -174,6 +174,9 @@ func (s *WatcherSuite) setupWatcherConfig() remotestate.WatcherConfig {
 	statusTicker := func(wait time.Duration) remotestate.Waiter {
 		return dummyWaiter{s.clock.After(wait)}
 	}
+	debounceTicker := func(wait time.Duration) remotestate.Waiter {
+		return dummyWaiter{s.clock.After(wait)}
+	}
 	return remotestate.WatcherConfig{
 		Logger:                       loggo.GetLogger("test"),
 		State:                        s.st,
@@ -183,6 +186,7 @@ func (s *WatcherSuite) setupWatcherConfig() remotestate.WatcherConfig {
 		LeadershipTracker:            s.leadership,
 		UnitTag:                      s.st.unit.tag,
 		UpdateStatusChannel:          statusTicker,
+		HookDebounceChannel:          debounceTicker,
 		CanApplyCharmProfile:         s.modelType == model.IAAS,
 		WorkloadEventChannel:         s.workloadEventChannel,
 		ShutdownChannel:              s.shutdownChannel,
@@ -930,6 +934,28 @@ func (s *WatcherSuite) TestUpdateStatusIntervalChanges(c *gc.C) {
 	c.Assert(s.watcher.Snapshot().UpdateStatusVersion, gc.Equals, initial.UpdateStatusVersion+2)
 }
 
+func (s *WatcherSuite) TestHookDebounceWindow(c *gc.C) {
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	// Configure a debounce window; the loop re-reads it when the
+	// model config watcher fires.
+	s.st.hookDebounceWindow = 10 * time.Second
+	s.st.updateStatusIntervalWatcher.changes <- struct{}{}
+
+	// A burst of config changes within the window is coalesced into a
+	// single notification once the window expires.
+	s.st.unit.configSettingsWatcher.changes <- []string{"confighash2"}
+	s.st.unit.configSettingsWatcher.changes <- []string{"confighash3"}
+	assertNoNotifyEvent(c, s.watcher.RemoteStateChanged(), "remote state change")
+
+	s.waitAlarmsStable(c)
+	s.clock.Advance(10 * time.Second)
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ConfigHash, gc.Equals, "confighash3")
+	c.Assert(s.watcher.CoalescedEvents(), gc.Equals, uint64(1))
+}
+
 // waitAlarmsStable is used to wait until the remote watcher's loop has
 // stopped churning (at least for testing.ShortWait), so that we can
 // then Advance the clock with some confidence that the SUT really is
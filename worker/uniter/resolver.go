@@ -320,6 +320,14 @@ func (s *uniterResolver) nextOp(
 			}
 		}
 	case life.Dying:
+		// Before anything is torn down, give the charm its bounded
+		// window to hand off data: the pre-remove hook runs while
+		// relations are still intact, and the teardown sequence below
+		// is blocked until it has completed.
+		if localState.Installed && !localState.PreRemoved {
+			return opFactory.NewRunHook(hook.Info{Kind: hook.PreRemove})
+		}
+
 		// Normally we handle relations last, but if we're dying we
 		// must ensure that all relations are broken first.
 		op, err := s.config.Relations.NextOp(localState, remoteState, opFactory)
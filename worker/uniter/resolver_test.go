@@ -14,6 +14,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/worker/uniter"
@@ -179,6 +180,57 @@ func (s *resolverSuite) TestNotStartedNotInstalled(c *gc.C) {
 	c.Assert(op.String(), gc.Equals, "run install hook")
 }
 
+// TestDyingRunsPreRemoveHookFirst tests that a dying unit runs the
+// pre-remove hook before any of its relations are broken.
+func (s *resolverSuite) TestDyingRunsPreRemoveHookFirst(c *gc.C) {
+	s.remoteState.Life = life.Dying
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: true,
+			Started:   true,
+		},
+	}
+	op, err := s.resolver.NextOp(localState, s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run pre-remove hook")
+}
+
+// TestDyingPreRemovedRunsStopHook tests that teardown proceeds as
+// before once the pre-remove hook has completed.
+func (s *resolverSuite) TestDyingPreRemovedRunsStopHook(c *gc.C) {
+	s.remoteState.Life = life.Dying
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:       operation.Continue,
+			Installed:  true,
+			Started:    true,
+			PreRemoved: true,
+		},
+	}
+	op, err := s.resolver.NextOp(localState, s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run stop hook")
+}
+
+// TestDyingNotInstalledSkipsPreRemoveHook tests that a dying unit that
+// never installed its charm terminates without running pre-remove.
+func (s *resolverSuite) TestDyingNotInstalledSkipsPreRemoveHook(c *gc.C) {
+	s.remoteState.Life = life.Dying
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: false,
+			Started:   false,
+		},
+	}
+	_, err := s.resolver.NextOp(localState, s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrTerminate)
+}
+
 func (s *iaasResolverSuite) TestUpgradeSeriesPrepareStatusChanged(c *gc.C) {
 	localState := resolver.LocalState{
 		CharmURL:            s.charmURL,
@@ -800,6 +800,23 @@ func (ctx *HookContext) CloudSpec() (*params.CloudSpec, error) {
 	return ctx.cloudSpec, nil
 }
 
+// ScopedCredential returns a short-lived provider credential limited
+// to the given scope, minted by the controller for the running unit's
+// model. Implements jujuc.HookContext.ContextUnit, part of runner.Context.
+func (ctx *HookContext) ScopedCredential(scope string) (*params.CloudCredential, *time.Time, error) {
+	if ctx.modelType == model.CAAS {
+		return nil, nil, errors.NotSupportedf("credential-get on a %q model", model.CAAS)
+	}
+	return ctx.state.ScopedCredential(scope)
+}
+
+// WorkloadIdentityToken returns a short-lived workload identity token
+// minted by the controller for the running unit. Implements
+// jujuc.HookContext.ContextUnit, part of runner.Context.
+func (ctx *HookContext) WorkloadIdentityToken(audience []string) (string, time.Time, error) {
+	return ctx.state.WorkloadIdentityToken(audience)
+}
+
 // ActionParams simply returns the arguments to the Action.
 // Implements jujuc.ActionHookContext.actionHookContext, part of runner.Context.
 func (ctx *HookContext) ActionParams() (map[string]interface{}, error) {
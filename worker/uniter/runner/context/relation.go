@@ -39,6 +39,10 @@ type Relation interface {
 
 	// Life returns the relation's current life state.
 	Life() life.Value
+
+	// NetworkCharacteristics returns the network characteristics the
+	// operator has recorded against the relation.
+	NetworkCharacteristics() (params.RelationNetworkCharacteristics, error)
 }
 
 type RelationUnit interface {
@@ -183,3 +187,13 @@ func (ctx *ContextRelation) RemoteApplicationName() string {
 func (ctx *ContextRelation) Life() life.Value {
 	return ctx.ru.Relation().Life()
 }
+
+// NetworkCharacteristics returns the network characteristics the
+// operator has recorded against the relation.
+func (ctx *ContextRelation) NetworkCharacteristics() (params.RelationNetworkCharacteristics, error) {
+	char, err := ctx.ru.Relation().NetworkCharacteristics()
+	if err != nil {
+		return params.RelationNetworkCharacteristics{}, errors.Trace(err)
+	}
+	return char, nil
+}
@@ -162,6 +162,16 @@ type ContextUnit interface {
 
 	// CloudSpec returns the unit's cloud specification
 	CloudSpec() (*params.CloudSpec, error)
+
+	// ScopedCredential returns a short-lived provider credential
+	// limited to the given scope, along with its expiry time.
+	ScopedCredential(scope string) (*params.CloudCredential, *time.Time, error)
+
+	// WorkloadIdentityToken returns a short-lived workload identity
+	// token minted by the controller for the running unit,
+	// identifying it to the given audiences, along with the token's
+	// expiry time.
+	WorkloadIdentityToken(audience []string) (string, time.Time, error)
 }
 
 // ContextStatus is the part of a hook context related to the unit's status.
@@ -348,6 +358,11 @@ type ContextRelation interface {
 
 	// Life returns the relation's current life state.
 	Life() life.Value
+
+	// NetworkCharacteristics returns the network characteristics the
+	// operator has recorded against the relation. An empty result is
+	// returned if none have been recorded.
+	NetworkCharacteristics() (params.RelationNetworkCharacteristics, error)
 }
 
 // ContextStorageAttachment expresses the capabilities of a hook with
@@ -14,8 +14,9 @@ import (
 // CredentialGetCommand implements the leader-get command.
 type CredentialGetCommand struct {
 	cmd.CommandBase
-	ctx Context
-	out cmd.Output
+	ctx   Context
+	out   cmd.Output
+	scope string
 }
 
 // NewCredentialGetCommand returns a new CredentialGetCommand with the given context.
@@ -27,6 +28,11 @@ func NewCredentialGetCommand(ctx Context) (cmd.Command, error) {
 func (c *CredentialGetCommand) Info() *cmd.Info {
 	doc := `
 credential-get returns the cloud specification used by the unit's model.
+
+With --scope, instead of the model's own credential, a short-lived
+credential limited to the given scope is minted by the controller and
+returned along with its expiry time. Not all clouds support minting
+scoped credentials.
 `
 	return jujucmd.Info(&cmd.Info{
 		Name:    "credential-get",
@@ -39,6 +45,7 @@ credential-get returns the cloud specification used by the unit's model.
 // SetFlags is part of the cmd.Command interface.
 func (c *CredentialGetCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.out.AddFlags(f, "smart", cmd.DefaultFormatters.Formatters())
+	f.StringVar(&c.scope, "scope", "", "mint a short-lived credential limited to this scope")
 }
 
 // Init is part of the cmd.Command interface.
@@ -48,6 +55,20 @@ func (c *CredentialGetCommand) Init(args []string) error {
 
 // Run is part of the cmd.Command interface.
 func (c *CredentialGetCommand) Run(ctx *cmd.Context) error {
+	if c.scope != "" {
+		credential, validUntil, err := c.ctx.ScopedCredential(c.scope)
+		if err != nil {
+			return errors.Annotatef(err, "cannot mint scoped credential")
+		}
+		out := map[string]interface{}{
+			"auth-type": credential.AuthType,
+			"attrs":     credential.Attributes,
+		}
+		if validUntil != nil {
+			out["valid-until"] = validUntil.Format("2006-01-02T15:04:05Z07:00")
+		}
+		return c.out.Write(ctx, out)
+	}
 	credential, err := c.ctx.CloudSpec()
 	if err != nil {
 		return errors.Annotatef(err, "cannot access cloud credentials")
@@ -38,3 +38,10 @@ func (s *CredentialGetSuite) TestCommandRun(c *gc.C) {
 	exitSuccess := 0
 	c.Assert(exitCode, gc.Equals, exitSuccess)
 }
+
+func (s *CredentialGetSuite) TestCommandRunScoped(c *gc.C) {
+	ctx, exitCode := runCredentialGetCommand(s, c, []string{"--scope", "blob-read"})
+	exitSuccess := 0
+	c.Assert(exitCode, gc.Equals, exitSuccess)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Not(gc.Equals), "")
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+)
+
+// IdentityGetCommand implements the identity-get command.
+type IdentityGetCommand struct {
+	cmd.CommandBase
+	ctx      Context
+	out      cmd.Output
+	audience []string
+}
+
+// NewIdentityGetCommand returns a new IdentityGetCommand with the given context.
+func NewIdentityGetCommand(ctx Context) (cmd.Command, error) {
+	return &IdentityGetCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *IdentityGetCommand) Info() *cmd.Info {
+	doc := `
+identity-get returns a short-lived workload identity token minted by the
+controller for the running unit. The token is a SPIFFE-style JWT carrying
+unit, application and model claims, signed by the controller, that the
+workload can present to external services in place of baked-in secrets.
+
+Use --audience to name the parties the token is intended for; the flag
+may be repeated.
+`
+	return jujucmd.Info(&cmd.Info{
+		Name:    "identity-get",
+		Args:    "",
+		Purpose: "obtain a workload identity token for the unit",
+		Doc:     doc,
+	})
+}
+
+// SetFlags is part of the cmd.Command interface.
+func (c *IdentityGetCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.out.AddFlags(f, "smart", cmd.DefaultFormatters.Formatters())
+	f.Var(cmd.NewAppendStringsValue(&c.audience), "audience", "intended audience of the token (may be repeated)")
+}
+
+// Init is part of the cmd.Command interface.
+func (c *IdentityGetCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run is part of the cmd.Command interface.
+func (c *IdentityGetCommand) Run(ctx *cmd.Context) error {
+	token, _, err := c.ctx.WorkloadIdentityToken(c.audience)
+	if err != nil {
+		return errors.Annotatef(err, "cannot obtain workload identity token")
+	}
+	return c.out.Write(ctx, token)
+}
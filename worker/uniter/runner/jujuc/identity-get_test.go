@@ -0,0 +1,47 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type IdentityGetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&IdentityGetSuite{})
+
+func runIdentityGetCommand(s *IdentityGetSuite, c *gc.C, args []string) (*cmd.Context, int) {
+	hctx := s.GetHookContext(c, -1, "")
+	com, err := jujuc.NewCommand(hctx, cmdString("identity-get"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, args)
+	return ctx, code
+}
+
+func (s *IdentityGetSuite) TestCommandRun(c *gc.C) {
+	ctx, exitCode := runIdentityGetCommand(s, c, []string{})
+	c.Assert(exitCode, gc.Equals, 0)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "test-token\n")
+}
+
+func (s *IdentityGetSuite) TestCommandRunAudience(c *gc.C) {
+	ctx, exitCode := runIdentityGetCommand(s, c, []string{
+		"--audience", "vault.example.com", "--audience", "registry.example.com",
+	})
+	c.Assert(exitCode, gc.Equals, 0)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "test-token\n")
+}
+
+func (s *IdentityGetSuite) TestCommandRunRejectsArgs(c *gc.C) {
+	_, exitCode := runIdentityGetCommand(s, c, []string{"unexpected"})
+	c.Assert(exitCode, gc.Not(gc.Equals), 0)
+}
@@ -33,6 +33,8 @@ type Relation struct {
 	RemoteApplicationName string
 	// The current life value.
 	Life life.Value
+	// NetworkCharacteristics is data for jujuc.ContextRelation
+	NetworkCharacteristics params.RelationNetworkCharacteristics
 }
 
 // Reset clears the Relation's settings.
@@ -174,3 +176,13 @@ func (r *ContextRelation) RemoteApplicationName() string {
 	r.stub.AddCall("RemoteApplicationName")
 	return r.info.RemoteApplicationName
 }
+
+// NetworkCharacteristics implements jujuc.ContextRelation.
+func (r *ContextRelation) NetworkCharacteristics() (params.RelationNetworkCharacteristics, error) {
+	r.stub.AddCall("NetworkCharacteristics")
+	if err := r.stub.NextErr(); err != nil {
+		return params.RelationNetworkCharacteristics{}, errors.Trace(err)
+	}
+
+	return r.info.NetworkCharacteristics, nil
+}
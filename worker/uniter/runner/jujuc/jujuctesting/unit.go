@@ -4,6 +4,8 @@
 package jujuctesting
 
 import (
+	"time"
+
 	"github.com/juju/charm/v8"
 	"github.com/juju/errors"
 
@@ -96,3 +98,21 @@ func (c *ContextUnit) CloudSpec() (*params.CloudSpec, error) {
 	c.info.CloudSpec = params.CloudSpec{}
 	return &c.info.CloudSpec, nil
 }
+
+func (c *ContextUnit) ScopedCredential(scope string) (*params.CloudCredential, *time.Time, error) {
+	c.stub.AddCall("ScopedCredential", scope)
+	if err := c.stub.NextErr(); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	credential := params.CloudCredential{}
+	validUntil := time.Time{}
+	return &credential, &validUntil, nil
+}
+
+func (c *ContextUnit) WorkloadIdentityToken(audience []string) (string, time.Time, error) {
+	c.stub.AddCall("WorkloadIdentityToken", audience)
+	if err := c.stub.NextErr(); err != nil {
+		return "", time.Time{}, errors.Trace(err)
+	}
+	return "test-token", time.Time{}, nil
+}
@@ -556,6 +556,22 @@ func (mr *MockContextMockRecorder) RequestReboot(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReboot", reflect.TypeOf((*MockContext)(nil).RequestReboot), arg0)
 }
 
+// ScopedCredential mocks base method.
+func (m *MockContext) ScopedCredential(arg0 string) (*params.CloudCredential, *time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScopedCredential", arg0)
+	ret0, _ := ret[0].(*params.CloudCredential)
+	ret1, _ := ret[1].(*time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ScopedCredential indicates an expected call of ScopedCredential.
+func (mr *MockContextMockRecorder) ScopedCredential(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScopedCredential", reflect.TypeOf((*MockContext)(nil).ScopedCredential), arg0)
+}
+
 // SetActionFailed mocks base method.
 func (m *MockContext) SetActionFailed() error {
 	m.ctrl.T.Helper()
@@ -798,6 +814,22 @@ func (mr *MockContextMockRecorder) UpdateActionResults(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActionResults", reflect.TypeOf((*MockContext)(nil).UpdateActionResults), arg0, arg1)
 }
 
+// WorkloadIdentityToken mocks base method.
+func (m *MockContext) WorkloadIdentityToken(arg0 []string) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadIdentityToken", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WorkloadIdentityToken indicates an expected call of WorkloadIdentityToken.
+func (mr *MockContextMockRecorder) WorkloadIdentityToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadIdentityToken", reflect.TypeOf((*MockContext)(nil).WorkloadIdentityToken), arg0)
+}
+
 // WorkloadName mocks base method.
 func (m *MockContext) WorkloadName() (string, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +108,21 @@ func (mr *MockContextRelationMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockContextRelation)(nil).Name))
 }
 
+// NetworkCharacteristics mocks base method.
+func (m *MockContextRelation) NetworkCharacteristics() (params.RelationNetworkCharacteristics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkCharacteristics")
+	ret0, _ := ret[0].(params.RelationNetworkCharacteristics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkCharacteristics indicates an expected call of NetworkCharacteristics.
+func (mr *MockContextRelationMockRecorder) NetworkCharacteristics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkCharacteristics", reflect.TypeOf((*MockContextRelation)(nil).NetworkCharacteristics))
+}
+
 // ReadApplicationSettings mocks base method.
 func (m *MockContextRelation) ReadApplicationSettings(arg0 string) (params.Settings, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,89 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+)
+
+// RelationNetworkGetCommand implements the relation-network-get command.
+type RelationNetworkGetCommand struct {
+	cmd.CommandBase
+	ctx             Context
+	RelationId      int
+	relationIdProxy gnuflag.Value
+	out             cmd.Output
+}
+
+// relationNetworkDetails is the serialization shape of the command output.
+type relationNetworkDetails struct {
+	LatencyClass  string `yaml:"latency-class" json:"latency-class"`
+	BandwidthMbps int    `yaml:"bandwidth-mbps" json:"bandwidth-mbps"`
+}
+
+func NewRelationNetworkGetCommand(ctx Context) (cmd.Command, error) {
+	c := &RelationNetworkGetCommand{ctx: ctx}
+
+	rV, err := NewRelationIdValue(c.ctx, &c.RelationId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	c.relationIdProxy = rV
+
+	return c, nil
+}
+
+func (c *RelationNetworkGetCommand) Info() *cmd.Info {
+	doc := `
+relation-network-get prints the network characteristics the operator has
+recorded against a relation: the expected latency class ("local",
+"regional" or "wan") and a bandwidth cap in Mbps. Charms can use the
+values to tune themselves for cross-model relations spanning a WAN.
+Unset values are reported as empty or zero.
+`
+	if _, err := c.ctx.HookRelation(); err != nil {
+		doc += "\n-r must be specified when not in a relation hook"
+	}
+	return jujucmd.Info(&cmd.Info{
+		Name:    "relation-network-get",
+		Purpose: "get relation network characteristics",
+		Doc:     doc,
+	})
+}
+
+func (c *RelationNetworkGetCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+	f.Var(c.relationIdProxy, "r", "Specify a relation by id")
+	f.Var(c.relationIdProxy, "relation", "")
+}
+
+func (c *RelationNetworkGetCommand) Init(args []string) error {
+	if c.RelationId == -1 {
+		return fmt.Errorf("no relation id specified")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *RelationNetworkGetCommand) Run(ctx *cmd.Context) error {
+	r, err := c.ctx.Relation(c.RelationId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	char, err := r.NetworkCharacteristics()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return c.out.Write(ctx, relationNetworkDetails{
+		LatencyClass:  char.LatencyClass,
+		BandwidthMbps: char.BandwidthMbps,
+	})
+}
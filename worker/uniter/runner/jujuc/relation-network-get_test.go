@@ -0,0 +1,100 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type RelationNetworkGetSuite struct {
+	relationSuite
+}
+
+var _ = gc.Suite(&RelationNetworkGetSuite{})
+
+var relationNetworkGetTests = []struct {
+	summary         string
+	relid           int
+	characteristics params.RelationNetworkCharacteristics
+	args            []string
+	code            int
+	out             string
+}{
+	{
+		summary: "no default relation, no arg",
+		relid:   -1,
+		code:    2,
+		out:     "no relation id specified",
+	}, {
+		summary: "no default relation, bad arg",
+		relid:   -1,
+		args:    []string{"-r", "bad"},
+		code:    2,
+		out:     `invalid value "bad" for option -r: invalid relation id`,
+	}, {
+		summary: "default relation, nothing recorded",
+		relid:   1,
+		out:     "latency-class: \"\"\nbandwidth-mbps: 0",
+	}, {
+		summary: "default relation, characteristics recorded",
+		relid:   1,
+		characteristics: params.RelationNetworkCharacteristics{
+			LatencyClass:  "wan",
+			BandwidthMbps: 50,
+		},
+		out: "latency-class: wan\nbandwidth-mbps: 50",
+	}, {
+		summary: "alternative relation",
+		relid:   1,
+		characteristics: params.RelationNetworkCharacteristics{
+			LatencyClass: "regional",
+		},
+		args: []string{"-r", "ignored:1"},
+		out:  "latency-class: regional\nbandwidth-mbps: 0",
+	}, {
+		summary: "json formatting",
+		relid:   1,
+		characteristics: params.RelationNetworkCharacteristics{
+			LatencyClass:  "local",
+			BandwidthMbps: 1000,
+		},
+		args: []string{"--format", "json"},
+		out:  `{"latency-class":"local","bandwidth-mbps":1000}`,
+	},
+}
+
+func (s *RelationNetworkGetSuite) TestRelationNetworkGet(c *gc.C) {
+	for i, t := range relationNetworkGetTests {
+		c.Logf("test %d: %s", i, t.summary)
+		hctx, info := s.newHookContext(t.relid, "", "")
+		if t.relid >= 0 {
+			info.rels[t.relid].NetworkCharacteristics = t.characteristics
+		}
+		com, err := jujuc.NewCommand(hctx, cmdString("relation-network-get"))
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+		c.Assert(code, gc.Equals, t.code)
+		if code == 0 {
+			c.Check(bufferString(ctx.Stderr), gc.Equals, "")
+			expect := t.out
+			if expect != "" {
+				expect += "\n"
+			}
+			c.Check(bufferString(ctx.Stdout), gc.Equals, expect)
+		} else {
+			c.Check(bufferString(ctx.Stdout), gc.Equals, "")
+			expect := fmt.Sprintf(`(.|\n)*ERROR %s\n`, t.out)
+			c.Check(bufferString(ctx.Stderr), gc.Matches, expect)
+		}
+	}
+}
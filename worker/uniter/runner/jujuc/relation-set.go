@@ -34,6 +34,14 @@ too long to fit within the command length limit of the shell or
 operating system. The file will contain a YAML map containing the
 settings.  Settings in the file will be overridden by any duplicate
 key-value arguments. A value of "-" for the filename means <stdin>.
+
+The --batch option stages changes to the unit settings and, when run
+by the leader unit, the application settings together in a single
+invocation. The file passed via --file must contain a YAML map with
+"unit" and "application" keys, each holding a map of settings. All
+staged changes are committed atomically when the hook completes
+successfully, so a hook that dies midway leaves no partial writes.
+--batch cannot be combined with --app or key=value arguments.
 `
 
 // RelationSetCommand implements the relation-set command.
@@ -46,6 +54,14 @@ type RelationSetCommand struct {
 	settingsFile    cmd.FileVar
 	formatFlag      string // deprecated
 	Application     bool
+	Batch           bool
+}
+
+// relationBatch holds the unit and application settings staged by a
+// single "relation-set --batch" invocation.
+type relationBatch struct {
+	Unit        map[string]string `yaml:"unit"`
+	Application map[string]string `yaml:"application"`
 }
 
 func NewRelationSetCommand(ctx Context) (cmd.Command, error) {
@@ -79,6 +95,8 @@ func (c *RelationSetCommand) SetFlags(f *gnuflag.FlagSet) {
 
 	f.BoolVar(&c.Application, "app", false, `pick whether you are setting "application" settings or "unit" settings`)
 
+	f.BoolVar(&c.Batch, "batch", false, "stage unit and application settings from a single YAML document")
+
 	f.StringVar(&c.formatFlag, "format", "", "deprecated format flag")
 }
 
@@ -87,6 +105,19 @@ func (c *RelationSetCommand) Init(args []string) error {
 		return errors.Errorf("no relation id specified")
 	}
 
+	if c.Batch {
+		if c.Application {
+			return errors.Errorf("--app cannot be used with --batch")
+		}
+		if len(args) > 0 {
+			return errors.Errorf("cannot specify key=value arguments in --batch mode")
+		}
+		if c.settingsFile.Path == "" {
+			return errors.Errorf("--batch requires --file")
+		}
+		return nil
+	}
+
 	// The overrides will be applied during Run when c.settingsFile is handled.
 	overrides, err := keyvalues.Parse(args, true)
 	if err != nil {
@@ -134,10 +165,71 @@ func (c *RelationSetCommand) handleSettingsFile(ctx *cmd.Context) error {
 	return nil
 }
 
+func (c *RelationSetCommand) runBatch(ctx *cmd.Context) error {
+	file, err := c.settingsFile.Open(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var batch relationBatch
+	if err := goyaml.Unmarshal(data, &batch); err != nil {
+		return errors.Trace(err)
+	}
+
+	r, err := c.ctx.Relation(c.RelationId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(batch.Application) > 0 {
+		isLeader, err := c.ctx.IsLeader()
+		if err != nil {
+			return errors.Annotate(err, "cannot determine leadership status")
+		} else if !isLeader {
+			return errors.Errorf("cannot write relation settings")
+		}
+	}
+	// Read both data bags before applying any changes, so a failure
+	// leaves the hook context untouched.
+	unitSettings, err := r.Settings()
+	if err != nil {
+		return errors.Annotate(err, "cannot read relation settings")
+	}
+	var appSettings Settings
+	if len(batch.Application) > 0 {
+		appSettings, err = r.ApplicationSettings()
+		if err != nil {
+			return errors.Annotate(err, "cannot read relation application settings")
+		}
+	}
+	applySettings(unitSettings, batch.Unit)
+	if appSettings != nil {
+		applySettings(appSettings, batch.Application)
+	}
+	return nil
+}
+
+func applySettings(settings Settings, changes map[string]string) {
+	for k, v := range changes {
+		if v != "" {
+			settings.Set(k, v)
+		} else {
+			settings.Delete(k)
+		}
+	}
+}
+
 func (c *RelationSetCommand) Run(ctx *cmd.Context) (err error) {
 	if c.formatFlag != "" {
 		fmt.Fprintf(ctx.Stderr, "--format flag deprecated for command %q", c.Info().Name)
 	}
+	if c.Batch {
+		return c.runBatch(ctx)
+	}
 	if err := c.handleSettingsFile(ctx); err != nil {
 		return errors.Trace(err)
 	}
@@ -164,12 +256,6 @@ func (c *RelationSetCommand) Run(ctx *cmd.Context) (err error) {
 			return errors.Annotate(err, "cannot read relation settings")
 		}
 	}
-	for k, v := range c.Settings {
-		if v != "" {
-			settings.Set(k, v)
-		} else {
-			settings.Delete(k)
-		}
-	}
+	applySettings(settings, c.Settings)
 	return nil
 }
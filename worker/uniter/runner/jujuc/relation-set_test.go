@@ -48,6 +48,8 @@ set relation settings
 Options:
 --app  (= false)
     pick whether you are setting "application" settings or "unit" settings
+--batch  (= false)
+    stage unit and application settings from a single YAML document
 --file  (= )
     file containing key-value pairs
 --format (= "")
@@ -72,6 +74,14 @@ too long to fit within the command length limit of the shell or
 operating system. The file will contain a YAML map containing the
 settings.  Settings in the file will be overridden by any duplicate
 key-value arguments. A value of "-" for the filename means <stdin>.
+
+The --batch option stages changes to the unit settings and, when run
+by the leader unit, the application settings together in a single
+invocation. The file passed via --file must contain a YAML map with
+"unit" and "application" keys, each holding a map of settings. All
+staged changes are committed atomically when the hook completes
+successfully, so a hook that dies midway leaves no partial writes.
+--batch cannot be combined with --app or key=value arguments.
 `[1:], t.expect))
 		c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
 	}
@@ -387,6 +397,91 @@ func (s *RelationSetSuite) TestRun(c *gc.C) {
 	}
 }
 
+func (s *RelationSetSuite) TestRunBatch(c *gc.C) {
+	hctx, info := s.newHookContext(0, "", "")
+	info.IsLeader = true
+	info.rels[0].Units["u/0"] = jujuctesting.Settings{"base": "value"}
+	info.rels[0].SetLocalApplicationSettings(jujuctesting.Settings{"app-base": "value"})
+
+	content := "unit:\n  foo: bar\n  base: \"\"\napplication:\n  ham: eggs\n"
+	filename := filepath.Join(c.MkDir(), "batch.yaml")
+	err := ioutil.WriteFile(filename, []byte(content), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	com, err := jujuc.NewCommand(hctx, cmdString("relation-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	com = jujuc.NewJujucCommandWrappedForTest(com)
+	_, err = cmdtesting.RunCommand(c, com, "--batch", "--file", filename)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(info.rels[0].Units["u/0"], gc.DeepEquals, jujuctesting.Settings{"foo": "bar"})
+	c.Assert(info.rels[0].LocalApplicationSettings, gc.DeepEquals,
+		jujuctesting.Settings{"app-base": "value", "ham": "eggs"})
+}
+
+func (s *RelationSetSuite) TestRunBatchNotLeader(c *gc.C) {
+	hctx, info := s.newHookContext(0, "", "")
+	info.IsLeader = false
+	info.rels[0].Units["u/0"] = jujuctesting.Settings{"base": "value"}
+
+	content := "application:\n  ham: eggs\n"
+	filename := filepath.Join(c.MkDir(), "batch.yaml")
+	err := ioutil.WriteFile(filename, []byte(content), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	com, err := jujuc.NewCommand(hctx, cmdString("relation-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	com = jujuc.NewJujucCommandWrappedForTest(com)
+	_, err = cmdtesting.RunCommand(c, com, "--batch", "--file", filename)
+	c.Assert(err, gc.ErrorMatches, "cannot write relation settings")
+
+	// The unit settings must be untouched.
+	c.Assert(info.rels[0].Units["u/0"], gc.DeepEquals, jujuctesting.Settings{"base": "value"})
+}
+
+func (s *RelationSetSuite) TestRunBatchUnitOnlyNotLeader(c *gc.C) {
+	hctx, info := s.newHookContext(0, "", "")
+	info.IsLeader = false
+	info.rels[0].Units["u/0"] = jujuctesting.Settings{"base": "value"}
+
+	content := "unit:\n  foo: bar\n"
+	filename := filepath.Join(c.MkDir(), "batch.yaml")
+	err := ioutil.WriteFile(filename, []byte(content), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	com, err := jujuc.NewCommand(hctx, cmdString("relation-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	com = jujuc.NewJujucCommandWrappedForTest(com)
+	_, err = cmdtesting.RunCommand(c, com, "--batch", "--file", filename)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(info.rels[0].Units["u/0"], gc.DeepEquals,
+		jujuctesting.Settings{"base": "value", "foo": "bar"})
+}
+
+func (s *RelationSetSuite) TestBatchInitErrors(c *gc.C) {
+	for i, t := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--batch", "--app"},
+		err:  "--app cannot be used with --batch",
+	}, {
+		args: []string{"--batch", "--file", "spam", "foo=bar"},
+		err:  "cannot specify key=value arguments in --batch mode",
+	}, {
+		args: []string{"--batch"},
+		err:  "--batch requires --file",
+	}} {
+		c.Logf("test %d", i)
+		hctx, _ := s.newHookContext(0, "", "")
+		com, err := jujuc.NewCommand(hctx, cmdString("relation-set"))
+		c.Assert(err, jc.ErrorIsNil)
+		err = cmdtesting.InitCommand(com, t.args)
+		c.Assert(err, gc.ErrorMatches, t.err)
+	}
+}
+
 func (s *RelationSetSuite) TestRunDeprecationWarning(c *gc.C) {
 	hctx, _ := s.newHookContext(0, "", "")
 	com, _ := jujuc.NewCommand(hctx, cmdString("relation-set"))
@@ -82,6 +82,16 @@ func (c *RestrictedContext) CloudSpec() (*params.CloudSpec, error) {
 	return nil, ErrRestrictedContext
 }
 
+// ScopedCredential implements hooks.Context.
+func (c *RestrictedContext) ScopedCredential(string) (*params.CloudCredential, *time.Time, error) {
+	return nil, nil, ErrRestrictedContext
+}
+
+// WorkloadIdentityToken implements hooks.Context.
+func (c *RestrictedContext) WorkloadIdentityToken([]string) (string, time.Time, error) {
+	return "", time.Time{}, ErrRestrictedContext
+}
+
 // SetUnitStatus implements hooks.Context.
 func (*RestrictedContext) SetUnitStatus(StatusInfo) error { return ErrRestrictedContext }
 
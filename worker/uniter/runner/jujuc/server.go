@@ -46,6 +46,7 @@ var baseCommands = map[string]creator{
 	"relation-get" + cmdSuffix:            NewRelationGetCommand,
 	"relation-ids" + cmdSuffix:            NewRelationIdsCommand,
 	"relation-list" + cmdSuffix:           NewRelationListCommand,
+	"relation-network-get" + cmdSuffix:    NewRelationNetworkGetCommand,
 	"relation-set" + cmdSuffix:            NewRelationSetCommand,
 	"unit-get" + cmdSuffix:                NewUnitGetCommand,
 	"add-metric" + cmdSuffix:              NewAddMetricCommand,
@@ -64,6 +65,7 @@ var baseCommands = map[string]creator{
 
 	"goal-state" + cmdSuffix:     NewGoalStateCommand,
 	"credential-get" + cmdSuffix: NewCredentialGetCommand,
+	"identity-get" + cmdSuffix:   NewIdentityGetCommand,
 
 	"action-get" + cmdSuffix:  NewActionGetCommand,
 	"action-set" + cmdSuffix:  NewActionSetCommand,
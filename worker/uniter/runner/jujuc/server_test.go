@@ -260,6 +260,7 @@ var newCommandTests = []struct {
 	{"relation-get", ""},
 	{"relation-ids", ""},
 	{"relation-list", ""},
+	{"relation-network-get", ""},
 	{"relation-set", ""},
 	{"unit-get", ""},
 	{"storage-add", ""},
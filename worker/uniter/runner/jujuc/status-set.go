@@ -18,6 +18,7 @@ type StatusSetCommand struct {
 	ctx         Context
 	status      string
 	message     string
+	reason      string
 	application bool
 }
 
@@ -31,6 +32,11 @@ func (c *StatusSetCommand) Info() *cmd.Info {
 Sets the workload status of the charm. Message is optional.
 The "last updated" attribute of the status is set, even if the
 status and message are the same as what's already set.
+
+The --reason option attaches a machine-readable reason code to a
+blocked or maintenance status, drawn from a registered taxonomy, so
+that dashboards can group and alert on why applications are blocked
+without parsing free-form messages.
 `
 	return jujucmd.Info(&cmd.Info{
 		Name:    "status-set",
@@ -49,6 +55,7 @@ var validStatus = []status.Status{
 
 func (c *StatusSetCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.application, "application", false, "set this status for the application to which the unit belongs if the unit is the leader")
+	f.StringVar(&c.reason, "reason", "", "attach a machine-readable reason code to a blocked or maintenance status")
 }
 
 func (c *StatusSetCommand) Init(args []string) error {
@@ -66,6 +73,14 @@ func (c *StatusSetCommand) Init(args []string) error {
 		return errors.Errorf("invalid status %q, expected one of %v", args[0], validStatus)
 	}
 	c.status = args[0]
+	if c.reason != "" {
+		if !status.SupportsStatusReason(status.Status(c.status)) {
+			return errors.Errorf("--reason can only be used with blocked or maintenance status")
+		}
+		if !status.ValidStatusReason(status.StatusReason(c.reason)) {
+			return errors.Errorf("invalid reason %q, expected one of %v", c.reason, status.KnownStatusReasons())
+		}
+	}
 	if len(args) > 1 {
 		c.message = args[1]
 		return cmd.CheckEmpty(args[2:])
@@ -78,6 +93,11 @@ func (c *StatusSetCommand) Run(ctx *cmd.Context) error {
 		Status: c.status,
 		Info:   c.message,
 	}
+	if c.reason != "" {
+		statusInfo.Data = map[string]interface{}{
+			status.StatusReasonKey: c.reason,
+		}
+	}
 	if c.application {
 		return c.ctx.SetApplicationStatus(statusInfo)
 	}
@@ -31,6 +31,9 @@ var statusSetInitTests = []struct {
 	{[]string{}, `invalid args, require <status> \[message\]`},
 	{[]string{"maintenance", "hello", "extra"}, `unrecognized args: \["extra"\]`},
 	{[]string{"foo", "hello"}, `invalid status "foo", expected one of \[maintenance blocked waiting active\]`},
+	{[]string{"--reason", "missing-relation", "blocked", "hello"}, ""},
+	{[]string{"--reason", "missing-relation", "active"}, `--reason can only be used with blocked or maintenance status`},
+	{[]string{"--reason", "because", "blocked"}, `invalid reason "because", expected one of .*missing-relation.*`},
 }
 
 func (s *statusSetSuite) TestStatusSetInit(c *gc.C) {
@@ -59,11 +62,18 @@ func (s *statusSetSuite) TestHelp(c *gc.C) {
 		"Options:\n" +
 		"--application  (= false)\n" +
 		"    set this status for the application to which the unit belongs if the unit is the leader\n" +
+		"--reason (= \"\")\n" +
+		"    attach a machine-readable reason code to a blocked or maintenance status\n" +
 		"\n" +
 		"Details:\n" +
 		"Sets the workload status of the charm. Message is optional.\n" +
 		"The \"last updated\" attribute of the status is set, even if the\n" +
-		"status and message are the same as what's already set.\n"
+		"status and message are the same as what's already set.\n" +
+		"\n" +
+		"The --reason option attaches a machine-readable reason code to a\n" +
+		"blocked or maintenance status, drawn from a registered taxonomy, so\n" +
+		"that dashboards can group and alert on why applications are blocked\n" +
+		"without parsing free-form messages.\n"
 
 	c.Assert(bufferString(ctx.Stdout), gc.Equals, expectedHelp)
 	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
@@ -90,6 +100,25 @@ func (s *statusSetSuite) TestStatus(c *gc.C) {
 	}
 }
 
+func (s *statusSetSuite) TestStatusReason(c *gc.C) {
+	hctx := s.GetStatusHookContext(c)
+	com, err := jujuc.NewCommand(hctx, "status-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"--reason", "missing-relation", "blocked", "waiting for database",
+	})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	status, err := hctx.UnitStatus()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(status.Status, gc.Equals, "blocked")
+	c.Assert(status.Info, gc.Equals, "waiting for database")
+	c.Assert(status.Data, jc.DeepEquals, map[string]interface{}{
+		"reason": "missing-relation",
+	})
+}
+
 func (s *statusSetSuite) TestApplicationStatus(c *gc.C) {
 	for i, args := range [][]string{
 		{"--application", "maintenance", "doing some work"},